@@ -2,14 +2,17 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"html/template"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
-	"runtime/debug"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/Debian/debiman/internal/auxserver"
 	"github.com/Debian/debiman/internal/bundled"
@@ -26,10 +29,38 @@ var (
 		"localhost:2431",
 		"host:port address to listen on")
 
+	adminListenAddr = flag.String("admin_listen",
+		"localhost:2432",
+		"host:port address on which to serve the operational endpoints (/status, /healthz, /readyz, /metrics and /reload), which must not be exposed publicly")
+
 	injectAssets = flag.String("inject_assets",
 		"",
 		"If non-empty, a file system path to a directory containing assets to overwrite")
 
+	watch = flag.Bool("watch",
+		true,
+		"Automatically reload the index when it changes on disk (in addition to SIGHUP and POST /reload)")
+
+	watchPollInterval = flag.Duration("watch_poll_interval",
+		30*time.Second,
+		"How often to check the index for changes when inotify is unavailable")
+
+	reloadDebounce = flag.Duration("reload_debounce",
+		2*time.Second,
+		"How long the index must remain unchanged before it is reloaded")
+
+	reloadTokenFile = flag.String("reload_token_file",
+		"",
+		"If non-empty, path to a file containing a token which POST /reload requests must specify as “Authorization: Bearer <token>”")
+
+	reloadAllowLoopback = flag.Bool("reload_allow_loopback",
+		false,
+		"Accept POST /reload requests from the loopback interface without a token. Only enable this if no reverse proxy on the same machine forwards requests to -admin_listen")
+
+	maxIndexAge = flag.Duration("max_index_age",
+		0,
+		"If non-zero, /readyz fails when the loaded index was created longer ago than this, e.g. 72h")
+
 	baseURL = flag.String("base_url",
 		"https://manpages.debian.org",
 		"Base URL (without trailing slash) to the site. Used where absolute URLs are required, e.g. sitemaps.")
@@ -49,7 +80,7 @@ func main() {
 		}
 	}
 
-	idx, err := redirect.IndexFromProto(*indexPath)
+	idx, err := redirect.IndexFromFile(*indexPath)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -58,45 +89,57 @@ func main() {
 	notFoundTmpl := template.Must(commonTmpls.New("notfound").Parse(bundled.Asset("notfound.tmpl")))
 	server := auxserver.NewServer(idx, notFoundTmpl, debimanVersion)
 
+	var reloadToken string
+	if *reloadTokenFile != "" {
+		b, err := ioutil.ReadFile(*reloadTokenFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reloadToken = strings.TrimSpace(string(b))
+	}
+	server.EnableReload(*indexPath, reloadToken)
+	server.SetReloadAllowLoopback(*reloadAllowLoopback)
+	server.SetMaxIndexAge(*maxIndexAge)
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGHUP)
 	go func() {
 		for _ = range c {
 			log.Printf("SIGHUP received, trying to reload index")
-
-			newidx, err := redirect.IndexFromProto(*indexPath)
-			if err != nil {
-				log.Printf("Could not load new index from %q: %v", *indexPath, err)
-				continue
-			}
-
-			log.Printf("Loaded %d manpage entries, %d suites, %d languages from new index %q",
-				len(newidx.Entries), len(newidx.Suites), len(newidx.Langs), *indexPath)
-
-			if err := server.SwapIndex(newidx); err != nil {
-				log.Printf("Swapping index failed: %v", err)
-				continue
-			}
-
-			log.Printf("Index swapped")
-			// Force the garbage collector to return all unused memory to the
-			// operating system. Even though, on Linux, unused memory can
-			// apparently be reclaimed by the kernel, preemptively returning the
-			// memory is less confusing for sysadmins who aren’t intimately
-			// familiar with Go’s memory model.
-			debug.FreeOSMemory()
+			server.Reload()
 		}
 	}()
 
+	if *watch {
+		go auxserver.WatchIndex(context.Background(), *indexPath, *watchPollInterval, *reloadDebounce, func() {
+			log.Printf("Index %q changed on disk, trying to reload index", *indexPath)
+			server.Reload()
+		})
+	}
+
 	basePath := commontmpl.BaseURLPath()
 	mux := http.NewServeMux()
-	mux.HandleFunc("/jump", server.HandleJump)
-	mux.HandleFunc("/suggest", server.HandleSuggest)
-	mux.HandleFunc("/", server.HandleRedirect)
+	mux.HandleFunc("/jump", server.Instrument("jump", server.HandleJump))
+	mux.HandleFunc("/suggest", server.Instrument("suggest", server.HandleSuggest))
+	mux.HandleFunc("/", server.Instrument("redirect", server.HandleRedirect))
 	http.Handle("/", http.StripPrefix(basePath, mux))
 
-	log.Printf("Loaded %d manpage entries, %d suites, %d languages from index %q",
-		len(idx.Entries), len(idx.Suites), len(idx.Langs), *indexPath)
+	// The operational endpoints are served separately from the
+	// redirects, which the web server forwards all unknown paths to.
+	adminMux := http.NewServeMux()
+	adminMux.HandleFunc("/reload", server.Instrument("reload", server.HandleReload))
+	adminMux.HandleFunc("/healthz", server.HandleHealthz)
+	adminMux.HandleFunc("/readyz", server.HandleReadyz)
+	adminMux.HandleFunc("/status", server.HandleStatus)
+	adminMux.HandleFunc("/metrics", server.HandleMetrics)
+
+	log.Printf("Loaded %d manpage entries, %d suites, %d languages from index %q (%v)",
+		idx.Entries.Len(), len(idx.Suites), len(idx.Langs), *indexPath, idx.Meta)
+
+	log.Printf("Starting admin HTTP listener on %q", *adminListenAddr)
+	go func() {
+		log.Fatal(http.ListenAndServe(*adminListenAddr, adminMux))
+	}()
 
 	log.Printf("Starting HTTP listener on %q", *listenAddr)
 	log.Fatal(http.ListenAndServe(*listenAddr, nil))
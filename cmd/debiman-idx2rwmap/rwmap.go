@@ -70,9 +70,7 @@ func (op oncePrinter) mustPrint(key string, template redirect.IndexEntry) {
 	op.printed[key] = true
 }
 
-func printAll(bufw *bufio.Writer, idx redirect.Index, name string) {
-	variants := idx.Entries[name]
-
+func printAll(bufw *bufio.Writer, idx redirect.Index, variants []redirect.IndexEntry) {
 	op := oncePrinter{
 		printed:  make(map[string]bool),
 		w:        bufw,
@@ -201,14 +199,14 @@ func printAll(bufw *bufio.Writer, idx redirect.Index, name string) {
 func main() {
 	flag.Parse()
 
-	idx, err := redirect.IndexFromProto(*indexPath)
+	idx, err := redirect.IndexFromFile(*indexPath)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	log.Printf("Loaded %d index entries from %q", len(idx.Entries), *indexPath)
+	log.Printf("Loaded %d index entries from %q", idx.Entries.Len(), *indexPath)
 
-	work := make(chan string)
+	work := make(chan []redirect.IndexEntry)
 	var wg sync.WaitGroup
 	workers := *concurrency
 	if workers <= 0 {
@@ -224,8 +222,8 @@ func main() {
 			}
 			defer f.Close()
 			bufw := bufio.NewWriter(f)
-			for name := range work {
-				printAll(bufw, idx, name)
+			for variants := range work {
+				printAll(bufw, idx, variants)
 			}
 			if err := bufw.Flush(); err != nil {
 				log.Fatal(err)
@@ -233,9 +231,9 @@ func main() {
 		}(i)
 	}
 
-	for name, _ := range idx.Entries {
-		work <- name
-	}
+	idx.Entries.ForEach(func(_ string, variants []redirect.IndexEntry) {
+		work <- variants
+	})
 	close(work)
 
 	wg.Wait()
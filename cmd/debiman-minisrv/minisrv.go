@@ -81,7 +81,7 @@ func serveFile(w http.ResponseWriter, r *http.Request) error {
 func main() {
 	flag.Parse()
 
-	idx, err := redirect.IndexFromProto(filepath.Join(*servingDir, "auxserver.idx"))
+	idx, err := redirect.IndexFromFile(filepath.Join(*servingDir, "auxserver.idx"))
 	if err != nil {
 		log.Fatalf("Could not load auxserver index: %v", err)
 	}
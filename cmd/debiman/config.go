@@ -0,0 +1,177 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Debian/debiman/internal/toml"
+	"github.com/Debian/debiman/pipeline"
+)
+
+var configPath = flag.String("config",
+	"",
+	"If non-empty, path to a TOML configuration file. Top-level keys set the flag of the same name, [suite.<name>] tables override settings for one suite (see README.md). Flags specified on the command line take precedence over the file")
+
+// suiteSettings lists the settings which can be overridden per suite,
+// i.e. in a [suite.<name>] table of the configuration file.
+var suiteSettings = map[string]bool{
+	"remote_mirror":   true,
+	"local_mirror":    true,
+	"keyring":         true,
+	"components":      true,
+	"force_rerender":  true,
+	"force_reextract": true,
+}
+
+var (
+	// suiteOverrides maps from distribution name (as specified in
+	// -sync_codenames or -sync_suites) to the [suite.<name>] table of
+	// the configuration file.
+	suiteOverrides = make(map[string]toml.Table)
+
+	// commandLineFlags contains the names of the flags which were
+	// specified on the command line.
+	commandLineFlags = make(map[string]bool)
+)
+
+// configValue converts v into the string representation expected by
+// flag.Value.Set. Arrays are joined by commas, like -sync_codenames.
+func configValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []interface{}:
+		parts := make([]string, len(v))
+		for idx, e := range v {
+			s, err := configValue(e)
+			if err != nil {
+				return "", err
+			}
+			parts[idx] = s
+		}
+		return strings.Join(parts, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", v)
+	}
+}
+
+// applyConfig sets the flags from the top-level keys of cfg, except
+// for those in commandLine, and returns the [suite.<name>] tables.
+func applyConfig(fs *flag.FlagSet, cfg toml.Table, commandLine map[string]bool) (map[string]toml.Table, error) {
+	keys := make([]string, 0, len(cfg))
+	for key := range cfg {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	suites := make(map[string]toml.Table)
+	for _, key := range keys {
+		if key == "suite" {
+			tables, ok := cfg[key].(toml.Table)
+			if !ok {
+				return nil, fmt.Errorf("suite: expected [suite.<name>] tables")
+			}
+			for name, t := range tables {
+				table, ok := t.(toml.Table)
+				if !ok {
+					return nil, fmt.Errorf("suite.%s: expected a table", name)
+				}
+				for setting, v := range table {
+					if !suiteSettings[setting] {
+						return nil, fmt.Errorf("suite.%s: %q cannot be overridden per suite", name, setting)
+					}
+					if _, err := configValue(v); err != nil {
+						return nil, fmt.Errorf("suite.%s.%s: %v", name, setting, err)
+					}
+				}
+				suites[name] = table
+			}
+			continue
+		}
+		if key == "config" {
+			return nil, fmt.Errorf("config: configuration files cannot include other configuration files")
+		}
+		if fs.Lookup(key) == nil {
+			return nil, fmt.Errorf("%s: unknown setting (expected a flag name, see -help)", key)
+		}
+		value, err := configValue(cfg[key])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+		if commandLine[key] {
+			continue // flags take precedence
+		}
+		if err := fs.Set(key, value); err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+	return suites, nil
+}
+
+// loadConfig applies the configuration file at path (see -config).
+func loadConfig(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	cfg, err := toml.Parse(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	flag.Visit(func(f *flag.Flag) {
+		commandLineFlags[f.Name] = true
+	})
+	suiteOverrides, err = applyConfig(flag.CommandLine, cfg, commandLineFlags)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// suiteOptions converts the [suite.<name>] tables of the configuration
+// file into pipeline.SuiteOptions. Settings whose flag was specified on
+// the command line are ignored, as flags take precedence.
+func suiteOptions() (map[string]pipeline.SuiteOptions, error) {
+	res := make(map[string]pipeline.SuiteOptions, len(suiteOverrides))
+	for dist, override := range suiteOverrides {
+		var o pipeline.SuiteOptions
+		for setting, v := range override {
+			if commandLineFlags[setting] {
+				continue // flags take precedence
+			}
+			value, _ := configValue(v) // validated in applyConfig
+			switch setting {
+			case "remote_mirror":
+				o.RemoteMirrors = splitList(value)
+			case "local_mirror":
+				o.LocalMirror = &value
+			case "keyring":
+				o.Keyring = &value
+			case "components":
+				o.Components = splitList(value)
+			case "force_rerender", "force_reextract":
+				b, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("suite.%s.%s: %v", dist, setting, err)
+				}
+				if setting == "force_rerender" {
+					o.ForceRerender = &b
+				} else {
+					o.ForceReextract = &b
+				}
+			}
+		}
+		res[dist] = o
+	}
+	return res, nil
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Debian/debiman/internal/toml"
+)
+
+func TestApplyConfig(t *testing.T) {
+	const cfg = `
+serving_dir = "/srv/man"
+sync_codenames = ["jessie", "stretch"]
+download_concurrency = 4
+force_rerender = true
+
+[suite.sid]
+remote_mirror = "http://deb.debian.org/"
+components = ["main", "contrib", "non-free"]
+`
+	parsed, err := toml.Parse(strings.NewReader(cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs := flag.NewFlagSet("debiman", flag.ContinueOnError)
+	servingDir := fs.String("serving_dir", "", "")
+	codenames := fs.String("sync_codenames", "", "")
+	concurrency := fs.Int("download_concurrency", 0, "")
+	force := fs.Bool("force_rerender", false, "")
+	fs.String("config", "", "")
+	if err := fs.Parse([]string{"-serving_dir=/tmp/man"}); err != nil {
+		t.Fatal(err)
+	}
+
+	suites, err := applyConfig(fs, parsed, map[string]bool{"serving_dir": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := *servingDir, "/tmp/man"; got != want {
+		t.Errorf("-serving_dir: got %q, want %q (flags must take precedence)", got, want)
+	}
+	if got, want := *codenames, "jessie,stretch"; got != want {
+		t.Errorf("-sync_codenames: got %q, want %q", got, want)
+	}
+	if got, want := *concurrency, 4; got != want {
+		t.Errorf("-download_concurrency: got %d, want %d", got, want)
+	}
+	if !*force {
+		t.Errorf("-force_rerender: got false, want true")
+	}
+	sid, ok := suites["sid"]
+	if !ok {
+		t.Fatalf("suite overrides for sid not found: %v", suites)
+	}
+	if got, _ := configValue(sid["components"]); got != "main,contrib,non-free" {
+		t.Errorf("suite.sid.components: got %q, want %q", got, "main,contrib,non-free")
+	}
+
+	for _, tt := range []struct {
+		cfg  string
+		want string
+	}{
+		{`unknown = 1`, "unknown setting"},
+		{`config = "other.toml"`, "cannot include"},
+		{`download_concurrency = "many"`, "download_concurrency"},
+		{"[suite.sid]\nserving_dir = \"/srv\"", "cannot be overridden per suite"},
+	} {
+		parsed, err := toml.Parse(strings.NewReader(tt.cfg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = applyConfig(fs, parsed, nil)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("applyConfig(%q): got %v, want error containing %q", tt.cfg, err, tt.want)
+		}
+	}
+}
+
+// TestConfigFlagTypes verifies that every flag of debiman can be set
+// from the configuration file, by setting each flag to a value of its
+// type in a copy of the flag set.
+func TestConfigFlagTypes(t *testing.T) {
+	fs := flag.NewFlagSet("debiman", flag.ContinueOnError)
+	var (
+		cfg  strings.Builder
+		want = make(map[string]string)
+	)
+	flag.CommandLine.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" || strings.HasPrefix(f.Name, "test.") {
+			return // not settable, or registered by package testing
+		}
+		switch v := f.Value.(flag.Getter).Get().(type) {
+		case string:
+			fs.String(f.Name, v, "")
+			fmt.Fprintf(&cfg, "%s = \"x,y\"\n", f.Name)
+			want[f.Name] = "x,y"
+		case bool:
+			fs.Bool(f.Name, v, "")
+			fmt.Fprintf(&cfg, "%s = %v\n", f.Name, !v)
+			want[f.Name] = strconv.FormatBool(!v)
+		case int:
+			fs.Int(f.Name, v, "")
+			fmt.Fprintf(&cfg, "%s = %d\n", f.Name, v+3)
+			want[f.Name] = strconv.Itoa(v + 3)
+		case float64:
+			fs.Float64(f.Name, v, "")
+			fmt.Fprintf(&cfg, "%s = %s\n", f.Name, "2.5")
+			want[f.Name] = "2.5"
+		default:
+			t.Errorf("-%s: flag type %T not covered by this test", f.Name, v)
+		}
+	})
+	parsed, err := toml.Parse(strings.NewReader(cfg.String()))
+	if err != nil {
+		t.Fatalf("%v, config:\n%s", err, cfg.String())
+	}
+	if _, err := applyConfig(fs, parsed, nil); err != nil {
+		t.Fatal(err)
+	}
+	for name, w := range want {
+		if got := fs.Lookup(name).Value.String(); got != w {
+			t.Errorf("-%s: got %q, want %q", name, got, w)
+		}
+	}
+}
+
+func TestSuiteOptions(t *testing.T) {
+	defer func(old map[string]toml.Table) { suiteOverrides = old }(suiteOverrides)
+	defer func(old map[string]bool) { commandLineFlags = old }(commandLineFlags)
+
+	suiteOverrides = map[string]toml.Table{
+		"sid": {
+			"components":      []interface{}{"main", "non-free"},
+			"force_reextract": true,
+			"force_rerender":  true,
+			"remote_mirror":   []interface{}{"http://localhost:3142/deb.debian.org/", "http://deb.debian.org/"},
+		},
+		"jessie": {
+			"force_reextract": "sometimes",
+		},
+	}
+	commandLineFlags = map[string]bool{"force_rerender": true}
+
+	if _, err := suiteOptions(); err == nil || !strings.Contains(err.Error(), "suite.jessie.force_reextract") {
+		t.Fatalf("suiteOptions: got %v, want error mentioning suite.jessie.force_reextract", err)
+	}
+	delete(suiteOverrides, "jessie")
+
+	suites, err := suiteOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sid := suites["sid"]
+	if got, want := strings.Join(sid.Components, ","), "main,non-free"; got != want {
+		t.Errorf("sid components: got %q, want %q", got, want)
+	}
+	if sid.ForceReextract == nil || !*sid.ForceReextract {
+		t.Errorf("sid ForceReextract: got %v, want true", sid.ForceReextract)
+	}
+	if sid.ForceRerender != nil {
+		t.Errorf("sid ForceRerender: got %v, want nil (flags must take precedence)", *sid.ForceRerender)
+	}
+	if got, want := strings.Join(sid.RemoteMirrors, ","), "http://localhost:3142/deb.debian.org/,http://deb.debian.org/"; got != want {
+		t.Errorf("sid RemoteMirrors: got %q, want %q", got, want)
+	}
+	if sid.LocalMirror != nil {
+		t.Errorf("sid LocalMirror: got %q, want nil", *sid.LocalMirror)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var (
+	logFormat = flag.String("log_format",
+		"text",
+		"Log output format: “text” (key=value pairs) or “json” (one object per line)")
+
+	logLevel = flag.String("log_level",
+		"info",
+		"Minimum severity of log messages to output: debug, info, warn or error. debug includes every .so and symlink lookup")
+)
+
+// newLogHandler returns a slog.Handler writing to w in the specified
+// format, discarding messages below level.
+func newLogHandler(w io.Writer, format, level string) (slog.Handler, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %v", level, err)
+	}
+	opts := &slog.HandlerOptions{
+		AddSource: true,
+		Level:     l,
+	}
+	switch strings.ToLower(format) {
+	case "text":
+		return slog.NewTextHandler(w, opts), nil
+	case "json":
+		return slog.NewJSONHandler(w, opts), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q: expected text or json", format)
+	}
+}
+
+// setupLogging configures the default slog logger according to
+// -log_format and -log_level. Messages logged via the log package are
+// passed through at info level.
+func setupLogging() error {
+	h, err := newLogHandler(os.Stderr, *logFormat, *logLevel)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(slog.New(h))
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	h, err := newLogHandler(&buf, "json", "warn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := slog.New(h).With("stage", "extract", "suite", "jessie", "binarypkg", "i3-wm")
+	logger.Debug("searching reference", "so", "man1/i3.1")
+	logger.Info("package extracted")
+	logger.Warn("possibly dangling symlink", "manpage", "./usr/share/man/man1/x-window-manager.1.gz")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("expected exactly one JSON object, got %q: %v", buf.String(), err)
+	}
+	for key, want := range map[string]string{
+		"level":     "WARN",
+		"msg":       "possibly dangling symlink",
+		"stage":     "extract",
+		"suite":     "jessie",
+		"binarypkg": "i3-wm",
+		"manpage":   "./usr/share/man/man1/x-window-manager.1.gz",
+	} {
+		if got[key] != want {
+			t.Errorf("%s: got %v, want %q", key, got[key], want)
+		}
+	}
+
+	for _, tt := range []struct{ format, level string }{
+		{"xml", "info"},
+		{"json", "verbose"},
+	} {
+		if _, err := newLogHandler(&buf, tt.format, tt.level); err == nil {
+			t.Errorf("newLogHandler(%q, %q) unexpectedly succeeded", tt.format, tt.level)
+		}
+	}
+}
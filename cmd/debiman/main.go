@@ -1,23 +1,19 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
-	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
-	"golang.org/x/crypto/openpgp"
-
 	_ "net/http/pprof"
 
-	"github.com/Debian/debiman/internal/bundled"
-	"github.com/Debian/debiman/internal/commontmpl"
-	"github.com/Debian/debiman/internal/write"
+	"github.com/Debian/debiman/pipeline"
 
 	"pault.ag/go/archive"
 )
@@ -31,6 +27,10 @@ var (
 		"<serving_dir>/auxserver.idx",
 		"Path to an auxserver index to generate")
 
+	stateDir = flag.String("state_dir",
+		"",
+		"Directory in which to persist state between runs, e.g. the run journal. Must not be served publicly. Defaults to -serving_dir with a .debiman suffix, e.g. /srv/man.debiman for /srv/man")
+
 	syncCodenames = flag.String("sync_codenames",
 		"",
 		"Debian codenames to synchronize (e.g. wheezy, jessie, …)")
@@ -53,7 +53,15 @@ var (
 
 	remoteMirror = flag.String("remote_mirror",
 		"http://localhost:3142/deb.debian.org/",
-		"URL of a Debian mirror to fetch packages from. localhost:3142 is provided by apt-cacher-ng")
+		"Comma-separated list of URLs of Debian mirrors to fetch packages from, in order of preference. Requests fail over to the next mirror when a mirror fails. localhost:3142 is provided by apt-cacher-ng")
+
+	mirrorRateLimit = flag.Float64("mirror_rate_limit",
+		0,
+		"If non-zero, the maximum number of requests per second to each -remote_mirror")
+
+	partialFetchMinSize = flag.String("partial_fetch_min_size",
+		"64M",
+		"Packages of at least this size (e.g. 64M) are read using HTTP range requests until all their manpages were seen, instead of being downloaded as a whole. 0 disables partial fetches. Ignored with -local_mirror")
 
 	localMirror = flag.String("local_mirror",
 		"",
@@ -67,10 +75,50 @@ var (
 		"",
 		"If non-empty, a directory containing JSON-encoded lists of slave alternative links, named after the suite (e.g. sid.json.gz, testing.json.gz, etc.)")
 
+	alternativesFromMaintscripts = flag.Bool("alternatives_from_maintscripts",
+		true,
+		"Derive slave alternative links from the update-alternatives invocations in the postinst maintainer script of each package, in addition to -alternatives_dir. Only packages which are extracted are inspected: in an existing -serving_dir, the links of unchanged packages appear once they are updated, or after one run with -force_reextract")
+
+	components = flag.String("components",
+		"main,contrib",
+		"Comma-separated list of archive components to synchronize (e.g. main, contrib, non-free)")
+
+	extraManRoots = flag.String("extra_man_roots",
+		"",
+		"Comma-separated list of directories (in addition to /usr/share/man) from which to extract manpages, e.g. /usr/lib/*/man,/opt/*/share/man. Path components may contain shell patterns. Changing this flag extracts all packages again")
+
 	keyring = flag.String("keyring",
 		"",
 		"If non-empty, the specified GPG public keyring will be used for validating archive signatures instead of "+archive.DebianArchiveKeyring)
 
+	indexCache = flag.Bool("index_cache",
+		false,
+		"Keep uncompressed copies of the Contents and Packages files for which the archive publishes PDiffs in -state_dir and update them using these PDiffs. Uncompressed Contents files need several GB of disk space. Ignored with -local_mirror")
+
+	memoryBudget = flag.String("memory_budget",
+		"unlimited",
+		"Amount of memory debiman may use, e.g. 1.5G or 512M. Concurrency levels are lowered to fit. “auto” uses the available memory (respecting cgroup limits), “unlimited” (the default) disables the budget")
+
+	downloadConcurrency = flag.Int("concurrency_download",
+		10,
+		"Concurrency level for downloading and extracting Debian packages")
+
+	manwalkConcurrency = flag.Int("concurrency_manwalk",
+		1000, // below the default 1024 open file descriptor limit
+		"Concurrency level for walking through binary package man directories (ulimit -n must be higher!)")
+
+	renderConcurrency = flag.Int("concurrency_render",
+		5,
+		"Concurrency level for rendering manpages using mandoc")
+
+	gzipLevel = flag.Int("gzip",
+		9,
+		"gzip compression level to use for compressing HTML versions of manpages. defaults to 9 to keep network traffic minimal, but useful to reduce for development/disaster recovery (level 1 results in a 2x speedup!)")
+
+	baseURL = flag.String("base_url",
+		"https://manpages.debian.org",
+		"Base URL (without trailing slash) to the site. Used where absolute URLs are required, e.g. sitemaps.")
+
 	showVersion = flag.Bool("version",
 		false,
 		"Show debiman version and exit")
@@ -79,139 +127,114 @@ var (
 // use go build -ldflags "-X main.debimanVersion=<version>" to set the version
 var debimanVersion = "HEAD"
 
-// TODO: handle deleted packages, i.e. packages which are present on
-// disk but not in pkgs
-
-// TODO(later): add memory usage estimates to the big structures, set
-// parallelism level according to available memory on the system
-func logic() error {
-	start := time.Now()
-
-	ar := &archive.Downloader{
-		Parallel:            10,
-		MaxTransientRetries: 3,
-		Mirror:              *remoteMirror + "/debian",
-		LocalMirror:         *localMirror,
-	}
-
-	if *keyring != "" {
-		f, err := os.Open(*keyring)
-		if err != nil {
-			return fmt.Errorf("loading -keyring: %v", err)
-		}
-		defer f.Close()
-		ar.Keyring, err = openpgp.ReadKeyRing(f)
-		if err != nil {
-			return fmt.Errorf("ReadKeyRing(%s): %v", *keyring, err)
+// splitList splits the comma-separated list s, e.g. -sync_codenames.
+func splitList(s string) []string {
+	var res []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			res = append(res, e)
 		}
 	}
+	return res
+}
 
-	// Stage 1: all Debian packages of all architectures of the
-	// specified suites are discovered.
-	globalView, err := buildGlobalView(ar, distributions(
-		strings.Split(*syncCodenames, ","),
-		strings.Split(*syncSuites, ",")),
-		*alternativesDir,
-		start)
+// options returns the pipeline.Options specified by the flags and the
+// configuration file.
+func options() (pipeline.Options, error) {
+	budget, err := pipeline.ParseMemoryBudget(*memoryBudget)
 	if err != nil {
-		return fmt.Errorf("gathering packages: %v", err)
-	}
-
-	log.Printf("gathered packages of all suites, total %d packages", len(globalView.pkgs))
-
-	// Stage 2: man pages and auxiliary files (e.g. content fragment
-	// files which are included by a number of manpages) are extracted
-	// from the identified Debian packages.
-	if err := parallelDownload(ar, globalView); err != nil {
-		return fmt.Errorf("extracting manpages: %v", err)
+		return pipeline.Options{}, fmt.Errorf("parsing -memory_budget: %v", err)
 	}
-
-	log.Printf("Extracted all manpages, now rendering")
-
-	// Stage 3: all man pages are rendered into an HTML representation
-	// using mandoc(1), directory index files are rendered, contents
-	// files are rendered.
-	if err := renderAll(globalView); err != nil {
-		return fmt.Errorf("rendering manpages: %v", err)
-	}
-
-	log.Printf("Rendered all manpages, writing index")
-
-	// Stage 4: write the index only after all rendering is complete,
-	// otherwise debiman-auxserver might serve redirects to pages
-	// which cannot be served yet.
-	path := strings.Replace(*indexPath, "<serving_dir>", *servingDir, -1)
-	log.Printf("Writing debiman-auxserver index to %q", path)
-	if err := writeIndex(path, globalView); err != nil {
-		return fmt.Errorf("writing index: %v", err)
+	partialFetchMin, err := pipeline.ParseSize(*partialFetchMinSize)
+	if err != nil {
+		return pipeline.Options{}, fmt.Errorf("parsing -partial_fetch_min_size: %v", err)
 	}
-
-	if err := renderAux(*servingDir, globalView); err != nil {
-		return fmt.Errorf("rendering aux files: %v", err)
+	suites, err := suiteOptions()
+	if err != nil {
+		return pipeline.Options{}, err
 	}
-
-	fmt.Printf("total number of packages: %d\n", len(globalView.pkgs))
-	fmt.Printf("packages extracted:       %d\n", globalView.stats.PackagesExtracted)
-	fmt.Printf("packages deleted:         %d\n", globalView.stats.PackagesDeleted)
-	fmt.Printf("manpages rendered:        %d\n", globalView.stats.ManpagesRendered)
-	fmt.Printf("total manpage bytes:      %d\n", globalView.stats.ManpageBytes)
-	fmt.Printf("total HTML bytes:         %d\n", globalView.stats.HTMLBytes)
-	fmt.Printf("auxserver index bytes:    %d\n", globalView.stats.IndexBytes)
-	fmt.Printf("wall-clock runtime (s):   %d\n", int(time.Now().Sub(start).Seconds()))
-
-	return write.Atomically(filepath.Join(*servingDir, "metrics.txt"), false, func(w io.Writer) error {
-		if err := writeMetrics(w, globalView, start); err != nil {
-			return fmt.Errorf("writing metrics: %v", err)
-		}
-		return nil
-	})
+	return pipeline.Options{
+		ServingDir:          *servingDir,
+		IndexPath:           strings.Replace(*indexPath, "<serving_dir>", *servingDir, -1),
+		StateDir:            strings.Replace(*stateDir, "<serving_dir>", *servingDir, -1),
+		SyncCodenames:       splitList(*syncCodenames),
+		SyncSuites:          splitList(*syncSuites),
+		Components:          splitList(*components),
+		ExtraManRoots:       splitList(*extraManRoots),
+		Suites:              suites,
+		OnlyRenderPkgs:      splitList(*onlyRender),
+		ForceRerender:       *forceRerender,
+		ForceReextract:      *forceReextract,
+		RemoteMirrors:       splitList(*remoteMirror),
+		MirrorRateLimit:     *mirrorRateLimit,
+		PartialFetchMinSize: partialFetchMin,
+		LocalMirror:         *localMirror,
+		Keyring:             *keyring,
+		AlternativesDir:     *alternativesDir,
+		InjectAssets:        *injectAssets,
+		IndexCache:          *indexCache,
+		MemoryBudget:        budget,
+		DownloadConcurrency: *downloadConcurrency,
+		ManwalkConcurrency:  *manwalkConcurrency,
+		RenderConcurrency:   *renderConcurrency,
+		GzipLevel:           *gzipLevel,
+		BaseURL:             *baseURL,
+
+		AlternativesFromMaintscripts: *alternativesFromMaintscripts,
+	}, nil
 }
 
 func main() {
-	flag.Parse()
+	flag.Usage = usage
+	name, err := parseCommandLine(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
 
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	if *configPath != "" {
+		if err := loadConfig(*configPath); err != nil {
+			log.Fatalf("loading -config: %v", err)
+		}
+	}
+	if err := setupLogging(); err != nil {
+		log.Fatal(err)
+	}
 
 	if *showVersion {
 		fmt.Printf("debiman %s\n", debimanVersion)
 		return
 	}
+	pipeline.Version = debimanVersion
 
-	if *injectAssets != "" {
-		if err := bundled.Inject(*injectAssets); err != nil {
-			log.Fatal(err)
-		}
-
-		commonTmpls = commontmpl.MustParseCommonTmpls()
-		contentsTmpl = mustParseContentsTmpl()
-		pkgindexTmpl = mustParsePkgindexTmpl()
-		srcpkgindexTmpl = mustParseSrcPkgindexTmpl()
-		indexTmpl = mustParseIndexTmpl()
-		faqTmpl = mustParseFaqTmpl()
-		aboutTmpl = mustParseAboutTmpl()
-		manpageTmpl = mustParseManpageTmpl()
-		manpageerrorTmpl = mustParseManpageerrorTmpl()
-		manpagefooterextraTmpl = mustParseManpagefooterextraTmpl()
-	}
-
-	// All of our .so references are relative to *servingDir. For
-	// mandoc(1) to find the files, we need to change the working
-	// directory now.
-	//
-	// We turn *servingDir into an absolute path so that it still refers to the
-	// same location even after our os.Chdir() call (see issue #152).
-	abs, err := filepath.Abs(*servingDir)
+	opts, err := options()
 	if err != nil {
 		log.Fatal(err)
 	}
-	*servingDir = abs
-	if err := os.Chdir(*servingDir); err != nil {
-		log.Fatal(err)
-	}
-
-	go http.ListenAndServe(":4414", nil)
-
-	if err := logic(); err != nil {
+	opts.Metrics = pipeline.NewMetrics()
+	http.Handle("/metrics", opts.Metrics)
+	srv := &http.Server{Addr: ":4414"}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Warn("serving metrics failed", "addr", srv.Addr, "err", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go handleSignals(cancel)
+	go func() {
+		// Stop accepting metrics and pprof requests once a signal was
+		// received (or the run is done).
+		<-ctx.Done()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := runSubcommand(ctx, name, opts); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Debian/debiman/internal/write"
+)
+
+// handleSignals cancels the run on the first SIGINT or SIGTERM, upon
+// which the pipeline stops starting new work, completes the work in
+// progress and records its progress in the run journal. A second
+// signal removes the temporary files of writes in progress and exits
+// immediately.
+func handleSignals(cancel context.CancelFunc) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-c
+	slog.Warn("received signal, completing work in progress (send again to exit immediately)", "signal", sig)
+	cancel()
+	sig = <-c
+	removed := write.RemoveTempFiles()
+	slog.Warn("received signal, exiting immediately", "signal", sig, "temp_files_removed", removed)
+	os.Exit(1)
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Debian/debiman/pipeline"
+)
+
+// subcommand runs one or all stages (or fsck). All stages but discover
+// operate on the outputs of the previous stages which are persisted in
+// -state_dir and -serving_dir, so that e.g. the manpages can be
+// re-rendered after a template change without re-discovering the
+// archive.
+type subcommand struct {
+	name string
+	help string
+
+	// stage is the stage which the subcommand runs, or empty to run
+	// all stages.
+	stage pipeline.Stage
+
+	// run, if non-nil, is called instead of running stages.
+	run func(ctx context.Context, opts pipeline.Options) error
+}
+
+var subcommands = []subcommand{
+	{"sync", "run all stages, skipping those whose inputs are unchanged (default)", "", nil},
+	{"discover", "fetch Release, Contents and Packages files of all suites", pipeline.StageDiscover, nil},
+	{"extract", "extract manpages from the packages found by discover", pipeline.StageExtract, nil},
+	{"render", "render the extracted manpages to HTML", pipeline.StageRender, nil},
+	{"index", "write the debiman-auxserver index", pipeline.StageIndex, nil},
+	{"aux", "render the index, FAQ and about pages and the changelog feeds", pipeline.StageAux, nil},
+	{"fsck", "verify the consistency of -serving_dir (see -repair)", "", fsck},
+}
+
+var repair = flag.Bool("repair",
+	false,
+	"With debiman fsck, remove orphaned temporary files, dangling symlinks and a corrupt index, and make the next run re-extract and re-render inconsistent packages and manpages")
+
+// fsck implements the fsck subcommand.
+func fsck(ctx context.Context, opts pipeline.Options) error {
+	problems, err := pipeline.Fsck(ctx, opts, *repair)
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(problems) == 0:
+		fmt.Printf("%s: no problems found\n", opts.ServingDir)
+	case *repair:
+		fmt.Printf("%s: %d problems found and repaired, run debiman to re-extract and re-render\n", opts.ServingDir, len(problems))
+	default:
+		return fmt.Errorf("%s: %d problems found, use -repair to repair them", opts.ServingDir, len(problems))
+	}
+	return nil
+}
+
+func lookupSubcommand(name string) (subcommand, bool) {
+	for _, s := range subcommands {
+		if s.name == name {
+			return s, true
+		}
+	}
+	return subcommand{}, false
+}
+
+// parseCommandLine parses args, which may contain a subcommand name
+// either before or after the flags, and returns the subcommand name.
+func parseCommandLine(fs *flag.FlagSet, args []string) (string, error) {
+	var name string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() > 0 {
+		if name != "" {
+			return "", fmt.Errorf("unexpected arguments %q", fs.Args())
+		}
+		name = fs.Arg(0)
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			return "", err
+		}
+		if fs.NArg() > 0 {
+			return "", fmt.Errorf("unexpected arguments %q", fs.Args())
+		}
+	}
+	if name == "" {
+		name = "sync"
+	}
+	if _, ok := lookupSubcommand(name); !ok {
+		return "", fmt.Errorf("unknown subcommand %q", name)
+	}
+	return name, nil
+}
+
+func usage() {
+	w := flag.CommandLine.Output()
+	fmt.Fprintf(w, "Usage: %s [subcommand] [flags]\n\nSubcommands:\n", os.Args[0])
+	for _, s := range subcommands {
+		fmt.Fprintf(w, "  %-10s %s\n", s.name, s.help)
+	}
+	fmt.Fprintf(w, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// runSubcommand runs the subcommand called name and prints a summary.
+func runSubcommand(ctx context.Context, name string, opts pipeline.Options) error {
+	s, ok := lookupSubcommand(name)
+	if !ok {
+		return fmt.Errorf("unknown subcommand %q", name)
+	}
+	if s.run != nil {
+		return s.run(ctx, opts)
+	}
+	start := time.Now()
+	var (
+		stats *pipeline.Stats
+		err   error
+	)
+	if s.stage == "" {
+		stats, err = pipeline.Run(ctx, opts)
+	} else {
+		stats, err = pipeline.RunStage(ctx, opts, s.stage)
+	}
+	// Report how far an interrupted run got.
+	var interrupted *pipeline.InterruptedError
+	if err != nil && !errors.As(err, &interrupted) {
+		return err
+	}
+	if stats == nil || (s.stage != "" && interrupted == nil) {
+		return err
+	}
+	fmt.Printf("total number of packages: %d\n", stats.Packages)
+	fmt.Printf("packages extracted:       %d\n", stats.PackagesExtracted)
+	fmt.Printf("packages failed:          %d\n", stats.PackagesFailed)
+	fmt.Printf("packages deleted:         %d\n", stats.PackagesDeleted)
+	fmt.Printf("manpages rendered:        %d\n", stats.ManpagesRendered)
+	fmt.Printf("total manpage bytes:      %d\n", stats.ManpageBytes)
+	fmt.Printf("total HTML bytes:         %d\n", stats.HTMLBytes)
+	fmt.Printf("auxserver index bytes:    %d\n", stats.IndexBytes)
+	fmt.Printf("wall-clock runtime (s):   %d\n", int(time.Now().Sub(start).Seconds()))
+	return err
+}
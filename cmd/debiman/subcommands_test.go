@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestParseCommandLine(t *testing.T) {
+	for _, tt := range []struct {
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{args: nil, want: "sync"},
+		{args: []string{"-serving_dir=/tmp"}, want: "sync"},
+		{args: []string{"render", "-serving_dir=/tmp"}, want: "render"},
+		{args: []string{"-serving_dir=/tmp", "index"}, want: "index"},
+		{args: []string{"frobnicate"}, wantErr: "unknown subcommand"},
+		{args: []string{"render", "index"}, wantErr: "unexpected arguments"},
+		{args: []string{"-serving_dir=/tmp", "render", "-serving_dir=/srv", "aux"}, wantErr: "unexpected arguments"},
+	} {
+		fs := flag.NewFlagSet("debiman", flag.ContinueOnError)
+		servingDir := fs.String("serving_dir", "", "")
+		got, err := parseCommandLine(fs, tt.args)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseCommandLine(%q): got err %v, want error containing %q", tt.args, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseCommandLine(%q): %v", tt.args, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseCommandLine(%q): got %q, want %q", tt.args, got, tt.want)
+		}
+		if len(tt.args) > 0 && *servingDir != "/tmp" {
+			t.Errorf("parseCommandLine(%q): -serving_dir: got %q, want %q", tt.args, *servingDir, "/tmp")
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Debian/debiman/internal/commontmpl"
 	"github.com/Debian/debiman/internal/manpage"
@@ -23,6 +24,22 @@ type Server struct {
 	notFoundTmpl   *template.Template
 	debimanVersion string
 	sortedNames    []string
+
+	// loaded, lastReload and lastReloadErr are guarded by idxMu.
+	loaded        time.Time
+	lastReload    time.Time
+	lastReloadErr error
+
+	// maxIndexAge is the age after which an index is considered
+	// stale, see HandleReadyz.
+	maxIndexAge time.Duration
+
+	metrics *serverMetrics
+
+	reloadMu            sync.Mutex // serializes reloads, guards the fields below
+	indexPath           string
+	reloadToken         string
+	reloadAllowLoopback bool
 }
 
 func NewServer(idx redirect.Index, notFoundTmpl *template.Template, debimanVersion string) *Server {
@@ -30,7 +47,9 @@ func NewServer(idx redirect.Index, notFoundTmpl *template.Template, debimanVersi
 		idx:            idx,
 		notFoundTmpl:   notFoundTmpl,
 		debimanVersion: debimanVersion,
+		loaded:         time.Now(),
 	}
+	s.metrics = newServerMetrics(s)
 	s.prepareSuggest()
 	return s
 }
@@ -39,11 +58,11 @@ func NewServer(idx redirect.Index, notFoundTmpl *template.Template, debimanVersi
 // <name>.<section> strings found in idx.
 func (s *Server) prepareSuggest() {
 	names := make(map[string]bool)
-	for name, entries := range s.idx.Entries {
+	s.idx.Entries.ForEach(func(name string, entries []redirect.IndexEntry) {
 		for _, entry := range entries {
 			names[name+"."+entry.Section] = true
 		}
-	}
+	})
 
 	result := make([]string, 0, len(names))
 	for name := range names {
@@ -69,15 +88,21 @@ func (s *Server) SwapIndex(idx redirect.Index) error {
 	}
 	s.idxMu.Lock()
 	defer s.idxMu.Unlock()
+	old := s.idx
 	s.idx = idx
+	s.loaded = time.Now()
 	s.prepareSuggest()
-	return nil
+	// No request can refer to the old index anymore: entries are
+	// copied out of the index while holding idxMu.
+	return old.Entries.Close()
 }
 
 func (s *Server) redirect(r *http.Request) (string, error) {
 	s.idxMu.RLock()
-	defer s.idxMu.RUnlock()
-	return s.idx.Redirect(r)
+	redir, info, err := s.idx.RedirectWithInfo(r)
+	s.idxMu.RUnlock()
+	s.metrics.observeRedirect(info)
+	return redir, err
 }
 
 func (s *Server) HandleRedirect(w http.ResponseWriter, r *http.Request) {
@@ -153,6 +178,19 @@ func (s *Server) suggest(q string) []string {
 	return result
 }
 
+// writeJSON writes v to w as indented JSON.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		http.Error(w, fmt.Sprintf("encoding response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	io.Copy(w, &buf)
+}
+
 func (s *Server) HandleSuggest(w http.ResponseWriter, r *http.Request) {
 	q := r.FormValue("q")
 	if strings.TrimSpace(q) == "" {
@@ -1,7 +1,9 @@
 package auxserver
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"testing"
@@ -9,8 +11,16 @@ import (
 	"github.com/Debian/debiman/internal/redirect"
 )
 
+func mustNewTable(entries map[string][]redirect.IndexEntry) *redirect.Table {
+	t, err := redirect.NewTable(entries)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
 var i3OnlyIdx = redirect.Index{
-	Entries: map[string][]redirect.IndexEntry{
+	Entries: mustNewTable(map[string][]redirect.IndexEntry{
 		"i3": []redirect.IndexEntry{
 			{
 				Name:      "i3",
@@ -20,7 +30,7 @@ var i3OnlyIdx = redirect.Index{
 				Language:  "en",
 			},
 		},
-	},
+	}),
 	Suites: map[string]string{
 		"jessie": "jessie",
 	},
@@ -63,7 +73,7 @@ func TestIndexSwapSucceed(t *testing.T) {
 	}
 
 	updatedIdx := redirect.Index{
-		Entries: map[string][]redirect.IndexEntry{
+		Entries: mustNewTable(map[string][]redirect.IndexEntry{
 			"i3": []redirect.IndexEntry{
 				{
 					Name:      "i3",
@@ -83,7 +93,7 @@ func TestIndexSwapSucceed(t *testing.T) {
 					Language:  "en",
 				},
 			},
-		},
+		}),
 		Suites: map[string]string{
 			"jessie": "jessie",
 		},
@@ -146,6 +156,32 @@ func TestSuggest(t *testing.T) {
 	}
 }
 
+func TestIndexStatus(t *testing.T) {
+	idx := i3OnlyIdx
+	idx.Meta = redirect.IndexMeta{
+		Format:           "binary",
+		SchemaVersion:    redirect.SchemaVersion,
+		GeneratorVersion: "v1.2.3",
+		Names:            1,
+		SuiteEntries:     map[string]int{"jessie": 1},
+	}
+	s := NewServer(idx, nil, "")
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/status", nil)
+	req.Header.Set("Accept", "application/json")
+	s.HandleStatus(rec, req)
+	if got, want := rec.Code, http.StatusOK; got != want {
+		t.Fatalf("unexpected HTTP status: got %d, want %d", got, want)
+	}
+	var got serverStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Index, idx.Meta) {
+		t.Fatalf("unexpected index status: got %+v, want %+v", got.Index, idx.Meta)
+	}
+}
+
 func BenchmarkSuggest(b *testing.B) {
 	// TODO: load representative index
 	s := NewServer(i3OnlyIdx, nil, "")
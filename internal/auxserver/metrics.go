@@ -0,0 +1,150 @@
+package auxserver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Debian/debiman/internal/metrics"
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+type serverMetrics struct {
+	registry        *metrics.Registry
+	requests        *metrics.CounterVec
+	requestDuration *metrics.HistogramVec
+	narrowFallbacks *metrics.CounterVec
+	urlForms        *metrics.CounterVec
+	reloads         *metrics.CounterVec
+}
+
+func newServerMetrics(s *Server) *serverMetrics {
+	r := metrics.NewRegistry()
+	m := &serverMetrics{
+		registry: r,
+		requests: r.NewCounterVec("auxserver_requests_total",
+			"HTTP requests by handler and outcome (redirect, not_found, ok, error).",
+			"handler", "outcome"),
+		requestDuration: r.NewHistogramVec("auxserver_request_duration_seconds",
+			"HTTP request latency by handler.",
+			metrics.DefBuckets,
+			"handler"),
+		narrowFallbacks: r.NewCounterVec("auxserver_narrow_fallbacks_total",
+			"Redirects for which the field was not specified (or not available) and fell back to a default.",
+			"field"),
+		urlForms: r.NewCounterVec("auxserver_redirect_url_forms_total",
+			"Redirect requests by recognized URL form. legacy is true for URL forms of the old manpages.debian.org or man.freebsd.org.",
+			"form", "legacy"),
+		reloads: r.NewCounterVec("auxserver_index_reloads_total",
+			"Index reload attempts by result (success, failure).",
+			"result"),
+	}
+	r.NewGaugeFunc("auxserver_index_names",
+		"Distinct manpage names in the loaded index.",
+		func() float64 {
+			s.idxMu.RLock()
+			defer s.idxMu.RUnlock()
+			return float64(s.idx.Entries.Len())
+		})
+	r.NewGaugeFunc("auxserver_index_entries",
+		"Entries in the loaded index.",
+		func() float64 {
+			s.idxMu.RLock()
+			defer s.idxMu.RUnlock()
+			var entries int
+			for _, n := range s.idx.Meta.SuiteEntries {
+				entries += n
+			}
+			return float64(entries)
+		})
+	r.NewGaugeFunc("auxserver_index_size_bytes",
+		"Size of the loaded index file.",
+		func() float64 {
+			s.idxMu.RLock()
+			defer s.idxMu.RUnlock()
+			return float64(s.idx.Meta.Size)
+		})
+	r.NewGaugeFunc("auxserver_index_loaded_timestamp_seconds",
+		"Time at which the current index was loaded, in seconds since the epoch.",
+		func() float64 {
+			s.idxMu.RLock()
+			defer s.idxMu.RUnlock()
+			return float64(s.loaded.UnixNano()) / 1e9
+		})
+	return m
+}
+
+// observeRedirect records how a redirect request was interpreted.
+func (m *serverMetrics) observeRedirect(info redirect.RedirectInfo) {
+	if info.Form == "" {
+		return // rejected before parsing
+	}
+	m.urlForms.With(info.Form, strconv.FormatBool(info.Legacy)).Inc()
+	for _, f := range []struct {
+		field     string
+		defaulted bool
+	}{
+		{"suite", info.Narrow.Suite},
+		{"section", info.Narrow.Section},
+		{"language", info.Narrow.Language},
+		{"binarypkg", info.Narrow.Binarypkg},
+	} {
+		if f.defaulted {
+			m.narrowFallbacks.With(f.field).Inc()
+		}
+	}
+}
+
+// outcome classifies an HTTP status code for the requests metric.
+func outcome(status int) string {
+	switch {
+	case status >= 300 && status < 400:
+		return "redirect"
+	case status == http.StatusNotFound:
+		return "not_found"
+	case status >= 200 && status < 300:
+		return "ok"
+	default:
+		return "error"
+	}
+}
+
+// statusRecorder remembers the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	if sr.status == 0 {
+		sr.status = status
+	}
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+func (sr *statusRecorder) Write(b []byte) (int, error) {
+	if sr.status == 0 {
+		sr.status = http.StatusOK
+	}
+	return sr.ResponseWriter.Write(b)
+}
+
+// Instrument wraps h such that its requests are counted (by outcome)
+// and timed under the specified handler name.
+func (s *Server) Instrument(handler string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sr := &statusRecorder{ResponseWriter: w}
+		h(sr, r)
+		if sr.status == 0 {
+			sr.status = http.StatusOK
+		}
+		s.metrics.requestDuration.With(handler).Observe(time.Since(start).Seconds())
+		s.metrics.requests.With(handler, outcome(sr.status)).Inc()
+	}
+}
+
+// HandleMetrics serves metrics in the Prometheus text exposition format.
+func (s *Server) HandleMetrics(w http.ResponseWriter, r *http.Request) {
+	s.metrics.registry.ServeHTTP(w, r)
+}
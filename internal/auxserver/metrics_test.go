@@ -0,0 +1,47 @@
+package auxserver
+
+import (
+	"flag"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// HandleRedirect uses commontmpl.BaseURLPath, which reads the -base_url
+// flag defined by debiman-auxserver.
+var _ = flag.String("base_url", "https://manpages.debian.org", "")
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	notFoundTmpl := template.Must(template.New("notfound").Parse("{{ .Manpage }} not found"))
+	s := NewServer(i3OnlyIdx, notFoundTmpl, "")
+	handler := s.Instrument("redirect", s.HandleRedirect)
+	for _, path := range []string{"/i3", "/man/1/i3", "/jessie/i3.1", "/w3m"} {
+		handler(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+
+	rec := httptest.NewRecorder()
+	s.HandleMetrics(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if got, want := rec.Code, http.StatusOK; got != want {
+		t.Fatalf("unexpected HTTP status code: got %d, want %d", got, want)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		`auxserver_requests_total{handler="redirect",outcome="redirect"} 3`,
+		`auxserver_requests_total{handler="redirect",outcome="not_found"} 1`,
+		`auxserver_request_duration_seconds_count{handler="redirect"} 4`,
+		`auxserver_redirect_url_forms_total{form="/<name>",legacy="false"} 2`,
+		`auxserver_redirect_url_forms_total{form="/<suite>/<name>",legacy="false"} 1`,
+		`auxserver_redirect_url_forms_total{form="/man/<section>/<name>",legacy="true"} 1`,
+		`auxserver_narrow_fallbacks_total{field="suite"} 2`,
+		`auxserver_narrow_fallbacks_total{field="section"} 1`,
+		`auxserver_index_names 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, body)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package auxserver
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+// EnableReload configures s to reload its index from path (see Reload).
+// POST /reload requests must carry token as a bearer token, unless
+// they are allowed via SetReloadAllowLoopback. With an empty token, only
+// the latter are accepted.
+func (s *Server) EnableReload(path, token string) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	s.indexPath = path
+	s.reloadToken = token
+}
+
+// SetReloadAllowLoopback configures whether POST /reload requests from
+// the loopback interface are accepted without a token. This must only
+// be enabled if no reverse proxy on the same machine forwards requests
+// to /reload.
+func (s *Server) SetReloadAllowLoopback(allow bool) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	s.reloadAllowLoopback = allow
+}
+
+// Reload loads the index from the path configured with EnableReload
+// and swaps it in. Concurrent calls are serialized.
+func (s *Server) Reload() error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	if s.indexPath == "" {
+		return fmt.Errorf("reloading is not enabled")
+	}
+	err := s.reload()
+	s.idxMu.Lock()
+	s.lastReload = time.Now()
+	s.lastReloadErr = err
+	s.idxMu.Unlock()
+	if err != nil {
+		s.metrics.reloads.With("failure").Inc()
+		log.Printf("Reloading index %q failed, keeping the current index: %v", s.indexPath, err)
+		return err
+	}
+	s.metrics.reloads.With("success").Inc()
+	return nil
+}
+
+func (s *Server) reload() error {
+	idx, err := redirect.IndexFromFile(s.indexPath)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Loaded %d manpage entries, %d suites, %d languages from new index %q (%v)",
+		idx.Entries.Len(), len(idx.Suites), len(idx.Langs), s.indexPath, idx.Meta)
+
+	if err := s.SwapIndex(idx); err != nil {
+		idx.Entries.Close()
+		return fmt.Errorf("swapping index: %v", err)
+	}
+
+	log.Printf("Index swapped")
+	// Force the garbage collector to return all unused memory to the
+	// operating system. Even though, on Linux, unused memory can
+	// apparently be reclaimed by the kernel, preemptively returning the
+	// memory is less confusing for sysadmins who aren’t intimately
+	// familiar with Go’s memory model.
+	debug.FreeOSMemory()
+	return nil
+}
+
+// isLoopback returns true if r was sent from the loopback interface.
+func isLoopback(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (s *Server) reloadAuthorized(r *http.Request) bool {
+	s.reloadMu.Lock()
+	token := s.reloadToken
+	allowLoopback := s.reloadAllowLoopback
+	s.reloadMu.Unlock()
+	if allowLoopback && isLoopback(r) {
+		return true
+	}
+	if token == "" {
+		return false
+	}
+	got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
+
+// HandleReload reloads the index upon POST requests which carry the
+// configured token or, if allowed, come from the loopback interface.
+func (s *Server) HandleReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.reloadAuthorized(r) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	log.Printf("Reload requested by %s", r.RemoteAddr)
+	if err := s.Reload(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.idxMu.RLock()
+	meta := s.idx.Meta
+	s.idxMu.RUnlock()
+	fmt.Fprintf(w, "Index reloaded (%v)\n", meta)
+}
@@ -0,0 +1,148 @@
+package auxserver
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+func TestHandleReload(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "debiman-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "auxserver.idx")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := redirect.WriteIndex(f, i3OnlyIdx); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name          string
+		token         string
+		allowLoopback bool
+		method        string
+		remoteAddr    string
+		header        http.Header
+		want          int
+	}{
+		{
+			name:       "local",
+			method:     "POST",
+			remoteAddr: "127.0.0.1:1234",
+			want:       http.StatusForbidden,
+		},
+
+		{
+			name:          "local allowed",
+			allowLoopback: true,
+			method:        "POST",
+			remoteAddr:    "127.0.0.1:1234",
+			want:          http.StatusOK,
+		},
+
+		{
+			name:          "GET",
+			allowLoopback: true,
+			method:        "GET",
+			remoteAddr:    "127.0.0.1:1234",
+			want:          http.StatusMethodNotAllowed,
+		},
+
+		{
+			name:          "remote",
+			allowLoopback: true,
+			method:        "POST",
+			remoteAddr:    "192.0.2.1:1234",
+			want:          http.StatusForbidden,
+		},
+
+		{
+			// A reverse proxy on the same machine which does not
+			// set X-Forwarded-For.
+			name:       "proxied",
+			token:      "secret",
+			method:     "POST",
+			remoteAddr: "[::1]:1234",
+			want:       http.StatusForbidden,
+		},
+
+		{
+			name:       "token",
+			token:      "secret",
+			method:     "POST",
+			remoteAddr: "192.0.2.1:1234",
+			header:     http.Header{"Authorization": []string{"Bearer secret"}},
+			want:       http.StatusOK,
+		},
+
+		{
+			name:       "wrong token",
+			token:      "secret",
+			method:     "POST",
+			remoteAddr: "127.0.0.1:1234",
+			header:     http.Header{"Authorization": []string{"Bearer guess"}},
+			want:       http.StatusForbidden,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(i3OnlyIdx, nil, "")
+			s.EnableReload(path, tt.token)
+			s.SetReloadAllowLoopback(tt.allowLoopback)
+			r := httptest.NewRequest(tt.method, "/reload", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.header {
+				r.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			s.HandleReload(rec, r)
+			if got, want := rec.Code, tt.want; got != want {
+				t.Fatalf("unexpected HTTP status: got %d, want %d (body: %s)", got, want, rec.Body.String())
+			}
+			if tt.want == http.StatusOK {
+				if got, want := s.idx.Meta.Format, "binary"; got != want {
+					t.Fatalf("index not reloaded: got format %q, want %q", got, want)
+				}
+				mustRedirectI3(t, s)
+			}
+		})
+	}
+}
+
+func TestReloadRejectsCorruptIndex(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "debiman-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "auxserver.idx")
+	if err := ioutil.WriteFile(path, []byte("debiman-idx\ntruncated"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewServer(i3OnlyIdx, nil, "")
+	s.EnableReload(path, "")
+	if err := s.Reload(); err == nil {
+		t.Fatalf("Reload unexpectedly succeeded")
+	}
+	if s.lastReloadErr == nil {
+		t.Fatalf("lastReloadErr not recorded")
+	}
+	mustRedirectI3(t, s)
+}
@@ -0,0 +1,143 @@
+package auxserver
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+// SetMaxIndexAge configures the age after which the index is considered
+// stale, which makes HandleReadyz fail. 0 disables the check.
+func (s *Server) SetMaxIndexAge(d time.Duration) {
+	s.idxMu.Lock()
+	defer s.idxMu.Unlock()
+	s.maxIndexAge = d
+}
+
+type serverStatus struct {
+	DebimanVersion  string             `json:"debiman_version"`
+	IndexPath       string             `json:"index_path"`
+	Loaded          time.Time          `json:"loaded"`
+	Names           int                `json:"names"`
+	Entries         int                `json:"entries"`
+	Suites          int                `json:"suites"`
+	Languages       int                `json:"languages"`
+	Index           redirect.IndexMeta `json:"index"`
+	LastReload      time.Time          `json:"last_reload"`
+	LastReloadError string             `json:"last_reload_error"`
+	Ready           bool               `json:"ready"`
+	NotReadyReason  string             `json:"not_ready_reason,omitempty"`
+}
+
+// notReadyReason returns why the server cannot serve redirects, or the
+// empty string if it can. idxMu must be held.
+func (s *Server) notReadyReason(now time.Time) string {
+	if s.idx.Entries.Len() == 0 {
+		return "no index loaded"
+	}
+	if s.maxIndexAge > 0 && !s.idx.Meta.Created.IsZero() {
+		if age := now.Sub(s.idx.Meta.Created); age > s.maxIndexAge {
+			return fmt.Sprintf("index is stale: created %v ago (maximum: %v)", age.Round(time.Second), s.maxIndexAge)
+		}
+	}
+	return ""
+}
+
+func (s *Server) status() serverStatus {
+	s.reloadMu.Lock()
+	indexPath := s.indexPath
+	s.reloadMu.Unlock()
+
+	s.idxMu.RLock()
+	defer s.idxMu.RUnlock()
+	st := serverStatus{
+		DebimanVersion: s.debimanVersion,
+		IndexPath:      indexPath,
+		Loaded:         s.loaded,
+		Names:          s.idx.Entries.Len(),
+		Suites:         len(s.idx.Meta.SuiteEntries),
+		Languages:      len(s.idx.Langs),
+		Index:          s.idx.Meta,
+		LastReload:     s.lastReload,
+		NotReadyReason: s.notReadyReason(time.Now()),
+	}
+	for _, n := range s.idx.Meta.SuiteEntries {
+		st.Entries += n
+	}
+	if s.lastReloadErr != nil {
+		st.LastReloadError = s.lastReloadErr.Error()
+	}
+	st.Ready = st.NotReadyReason == ""
+	return st
+}
+
+// HandleHealthz reports that the process is up.
+func (s *Server) HandleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	io.WriteString(w, "ok\n")
+}
+
+// HandleReadyz reports whether an index is loaded which is not stale
+// (see SetMaxIndexAge).
+func (s *Server) HandleReadyz(w http.ResponseWriter, r *http.Request) {
+	s.idxMu.RLock()
+	reason := s.notReadyReason(time.Now())
+	s.idxMu.RUnlock()
+	if reason != "" {
+		http.Error(w, reason, http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	io.WriteString(w, "ok\n")
+}
+
+var statusTmpl = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>debiman-auxserver status</title>
+</head>
+<body>
+<h1>debiman-auxserver status</h1>
+<table>
+<tr><th>Build version</th><td>{{ .DebimanVersion }}</td></tr>
+<tr><th>Ready</th><td>{{ if .Ready }}yes{{ else }}no: {{ .NotReadyReason }}{{ end }}</td></tr>
+<tr><th>Index path</th><td>{{ .IndexPath }}</td></tr>
+<tr><th>Index loaded</th><td>{{ .Loaded }}</td></tr>
+<tr><th>Index created</th><td>{{ .Index.Created }} by debiman {{ .Index.GeneratorVersion }}</td></tr>
+<tr><th>Index format</th><td>{{ .Index.Format }} (schema version {{ .Index.SchemaVersion }})</td></tr>
+<tr><th>Index checksum</th><td>{{ .Index.SHA256 }}</td></tr>
+<tr><th>Manpage names</th><td>{{ .Names }}</td></tr>
+<tr><th>Entries</th><td>{{ .Entries }}</td></tr>
+<tr><th>Suites</th><td>{{ .Suites }}{{ range $suite, $n := .Index.SuiteEntries }}<br>{{ $suite }}: {{ $n }} entries{{ end }}</td></tr>
+<tr><th>Languages</th><td>{{ .Languages }}</td></tr>
+<tr><th>Last reload</th><td>{{ if .LastReload.IsZero }}never{{ else }}{{ .LastReload }}{{ end }}</td></tr>
+<tr><th>Last reload error</th><td>{{ if .LastReloadError }}{{ .LastReloadError }}{{ else }}none{{ end }}</td></tr>
+</table>
+</body>
+</html>
+`))
+
+// HandleStatus serves an overview of the server and its index, as HTML
+// or (when requested via ?format=json or the Accept header) as JSON.
+func (s *Server) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	st := s.status()
+	if r.FormValue("format") == "json" ||
+		strings.Contains(r.Header.Get("Accept"), "application/json") {
+		writeJSON(w, &st)
+		return
+	}
+	var buf bytes.Buffer
+	if err := statusTmpl.Execute(&buf, &st); err != nil {
+		http.Error(w, fmt.Sprintf("rendering status: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	io.Copy(w, &buf)
+}
@@ -0,0 +1,110 @@
+package auxserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+func TestReadyz(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name        string
+		idx         redirect.Index
+		maxIndexAge time.Duration
+		want        int
+	}{
+		{
+			name: "ready",
+			idx:  i3OnlyIdx,
+			want: http.StatusOK,
+		},
+
+		{
+			name: "empty",
+			idx:  redirect.Index{},
+			want: http.StatusServiceUnavailable,
+		},
+
+		{
+			name: "stale",
+			idx: redirect.Index{
+				Entries: i3OnlyIdx.Entries,
+				Meta:    redirect.IndexMeta{Created: time.Now().Add(-96 * time.Hour)},
+			},
+			maxIndexAge: 72 * time.Hour,
+			want:        http.StatusServiceUnavailable,
+		},
+
+		{
+			name: "fresh",
+			idx: redirect.Index{
+				Entries: i3OnlyIdx.Entries,
+				Meta:    redirect.IndexMeta{Created: time.Now().Add(-1 * time.Hour)},
+			},
+			maxIndexAge: 72 * time.Hour,
+			want:        http.StatusOK,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(tt.idx, nil, "")
+			s.SetMaxIndexAge(tt.maxIndexAge)
+			rec := httptest.NewRecorder()
+			s.HandleReadyz(rec, httptest.NewRequest("GET", "/readyz", nil))
+			if got, want := rec.Code, tt.want; got != want {
+				t.Fatalf("unexpected HTTP status: got %d, want %d (body: %s)", got, want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	idx := i3OnlyIdx
+	idx.Meta = redirect.IndexMeta{
+		Format:       "binary",
+		SuiteEntries: map[string]int{"jessie": 1},
+	}
+	s := NewServer(idx, nil, "v1.2.3")
+	s.EnableReload("/srv/man/auxserver.idx", "")
+	s.lastReloadErr = errors.New("checksum mismatch")
+
+	rec := httptest.NewRecorder()
+	s.HandleStatus(rec, httptest.NewRequest("GET", "/status?format=json", nil))
+	var st serverStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &st); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := st.DebimanVersion, "v1.2.3"; got != want {
+		t.Errorf("unexpected version: got %q, want %q", got, want)
+	}
+	if got, want := st.IndexPath, "/srv/man/auxserver.idx"; got != want {
+		t.Errorf("unexpected index path: got %q, want %q", got, want)
+	}
+	if got, want := st.Entries, 1; got != want {
+		t.Errorf("unexpected number of entries: got %d, want %d", got, want)
+	}
+	if got, want := st.LastReloadError, "checksum mismatch"; got != want {
+		t.Errorf("unexpected last reload error: got %q, want %q", got, want)
+	}
+	if !st.Ready {
+		t.Errorf("unexpectedly not ready: %s", st.NotReadyReason)
+	}
+
+	rec = httptest.NewRecorder()
+	s.HandleStatus(rec, httptest.NewRequest("GET", "/status", nil))
+	if got, want := rec.Header().Get("Content-Type"), "text/html; charset=utf-8"; got != want {
+		t.Fatalf("unexpected Content-Type: got %q, want %q", got, want)
+	}
+	if !strings.Contains(rec.Body.String(), "checksum mismatch") {
+		t.Fatalf("status page does not contain the last reload error")
+	}
+}
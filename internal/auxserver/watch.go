@@ -0,0 +1,99 @@
+package auxserver
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// WatchIndex calls reload whenever the file at path is replaced or
+// modified, e.g. by the atomic rename which debiman uses to write the
+// index. inotify(7) is used where available, with polling every
+// pollInterval as a fallback (also when reading inotify events fails
+// later on). Bursts of changes are coalesced: reload is called once no
+// further change happened for debounce. WatchIndex returns once ctx is
+// done.
+func WatchIndex(ctx context.Context, path string, pollInterval, debounce time.Duration, reload func()) {
+	watchIndex(ctx, path, pollInterval, debounce, reload, notifyEvents)
+}
+
+func watchIndex(ctx context.Context, path string, pollInterval, debounce time.Duration, reload func(), notify func(context.Context, string) (<-chan struct{}, error)) {
+	events, err := notify(ctx, path)
+	if err != nil {
+		log.Printf("Cannot watch %q using inotify (%v), polling every %v instead", path, err, pollInterval)
+		debounceEvents(pollEvents(ctx, path, pollInterval), debounce, reload)
+		return
+	}
+	log.Printf("Watching %q for changes using inotify", path)
+	debounceEvents(events, debounce, reload)
+	if ctx.Err() != nil {
+		return
+	}
+	// notify closes events when reading events fails, after logging
+	// the error.
+	log.Printf("Watching %q using inotify stopped, polling every %v instead", path, pollInterval)
+	// Changes might have been missed in the meantime.
+	reload()
+	debounceEvents(pollEvents(ctx, path, pollInterval), debounce, reload)
+}
+
+// debounceEvents calls fn after each burst of events, i.e. once no
+// further event was received for d. It returns when events is closed.
+func debounceEvents(events <-chan struct{}, d time.Duration, fn func()) {
+	for range events {
+		timer := time.NewTimer(d)
+	burst:
+		for {
+			select {
+			case _, ok := <-events:
+				if !ok {
+					timer.Stop()
+					return
+				}
+				timer.Reset(d)
+			case <-timer.C:
+				break burst
+			}
+		}
+		fn()
+	}
+}
+
+// pollEvents sends an event whenever the file at path is replaced or
+// modified, by comparing its os.FileInfo every interval. events is
+// closed once ctx is done.
+func pollEvents(ctx context.Context, path string, interval time.Duration) <-chan struct{} {
+	events := make(chan struct{})
+	prev, _ := os.Stat(path)
+	go func() {
+		defer close(events)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			cur, err := os.Stat(path)
+			if err != nil {
+				// The file might be missing for a moment. Only report
+				// changes once it is back.
+				continue
+			}
+			if prev == nil ||
+				!os.SameFile(prev, cur) ||
+				!prev.ModTime().Equal(cur.ModTime()) ||
+				prev.Size() != cur.Size() {
+				select {
+				case events <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			prev = cur
+		}
+	}()
+	return events
+}
@@ -0,0 +1,73 @@
+//go:build linux
+// +build linux
+
+package auxserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// notifyEvents sends an event whenever the file at path is written to
+// or replaced. The containing directory is watched so that renames
+// onto path are noticed. events is closed once ctx is done.
+func notifyEvents(ctx context.Context, path string) (<-chan struct{}, error) {
+	// The file descriptor is non-blocking so that reads use the Go
+	// runtime poller and can be interrupted by closing f.
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	const mask = unix.IN_MOVED_TO | unix.IN_CLOSE_WRITE | unix.IN_CREATE
+	if _, err := unix.InotifyAddWatch(fd, dir, mask); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	f := os.NewFile(uintptr(fd), "inotify")
+	stop := context.AfterFunc(ctx, func() { f.Close() })
+	events := make(chan struct{})
+	go func() {
+		defer close(events)
+		defer func() {
+			if stop() {
+				f.Close()
+			}
+		}()
+		buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				if ctx.Err() == nil || !errors.Is(err, os.ErrClosed) {
+					log.Printf("Reading inotify events failed: %v", err)
+				}
+				return
+			}
+			for off := 0; off+unix.SizeofInotifyEvent <= n; {
+				ev := (*unix.InotifyEvent)(unsafe.Pointer(&buf[off]))
+				nameStart := off + unix.SizeofInotifyEvent
+				name := buf[nameStart : nameStart+int(ev.Len)]
+				off = nameStart + int(ev.Len)
+				if ev.Mask&unix.IN_Q_OVERFLOW != 0 ||
+					string(bytes.TrimRight(name, "\x00")) == base {
+					select {
+					case events <- struct{}{}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return events, nil
+}
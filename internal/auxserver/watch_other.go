@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package auxserver
+
+import (
+	"context"
+	"errors"
+)
+
+func notifyEvents(ctx context.Context, path string) (<-chan struct{}, error) {
+	return nil, errors.New("inotify is only available on Linux")
+}
@@ -0,0 +1,153 @@
+package auxserver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDebounceEvents(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan struct{})
+	calls := make(chan struct{}, 10)
+	done := make(chan struct{})
+	go func() {
+		debounceEvents(events, 50*time.Millisecond, func() { calls <- struct{}{} })
+		close(done)
+	}()
+
+	// A burst of events results in one call.
+	for i := 0; i < 5; i++ {
+		events <- struct{}{}
+	}
+	select {
+	case <-calls:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("debounced function not called")
+	}
+	select {
+	case <-calls:
+		t.Fatalf("debounced function unexpectedly called twice")
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	close(events)
+	<-done
+}
+
+// replaceFile atomically replaces path, like write.Atomically.
+func replaceFile(t *testing.T, path, content string) {
+	tmp := filepath.Join(filepath.Dir(path), "debiman-tmp")
+	if err := ioutil.WriteFile(tmp, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testWatch(t *testing.T, watch func(ctx context.Context, path string) (<-chan struct{}, error)) {
+	dir, err := ioutil.TempDir("", "debiman-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "auxserver.idx")
+	replaceFile(t, path, "old")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := watch(ctx, path)
+	if err != nil {
+		t.Skipf("watching not supported: %v", err)
+	}
+	// Changes to other files in the same directory are ignored.
+	if err := ioutil.WriteFile(filepath.Join(dir, "unrelated"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	replaceFile(t, path, "new contents")
+	select {
+	case <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no event received after replacing %q", path)
+	}
+
+	// events is closed once ctx is done, i.e. watching stops.
+	cancel()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-events:
+			if ok {
+				continue
+			}
+		case <-timeout:
+			t.Fatalf("events not closed after canceling the context")
+		}
+		break
+	}
+}
+
+func TestNotifyEvents(t *testing.T) {
+	t.Parallel()
+	testWatch(t, notifyEvents)
+}
+
+func TestPollEvents(t *testing.T) {
+	t.Parallel()
+	testWatch(t, func(ctx context.Context, path string) (<-chan struct{}, error) {
+		return pollEvents(ctx, path, 10*time.Millisecond), nil
+	})
+}
+
+func TestWatchIndexFallback(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "debiman-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "auxserver.idx")
+	replaceFile(t, path, "old")
+
+	// failing simulates an inotify watch whose reads fail right away.
+	failing := func(context.Context, string) (<-chan struct{}, error) {
+		events := make(chan struct{})
+		close(events)
+		return events, nil
+	}
+	reloads := make(chan struct{}, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		watchIndex(ctx, path, 10*time.Millisecond, 10*time.Millisecond, func() { reloads <- struct{}{} }, failing)
+		close(done)
+	}()
+
+	// One reload when falling back, as changes might have been missed.
+	select {
+	case <-reloads:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no reload after inotify failed")
+	}
+	// Further changes are noticed by polling.
+	time.Sleep(50 * time.Millisecond)
+	replaceFile(t, path, "new contents")
+	select {
+	case <-reloads:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no reload after replacing %q", path)
+	}
+	// Polling stops once ctx is done.
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("watchIndex did not return after canceling the context")
+	}
+}
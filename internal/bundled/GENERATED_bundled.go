@@ -21,6 +21,7 @@ var assets = map[string]string{
 	"assets/Roboto-Bold.woff2":       assets_16,
 	"assets/Roboto-Regular.woff":     assets_17,
 	"assets/Roboto-Regular.woff2":    assets_18,
+	"assets/status.tmpl":             assets_19,
 }
 var assets_0 = "\x3c\x21\x44\x4f\x43\x54\x59\x50\x45\x20\x68\x74\x6d\x6c\x3e\x0a\x7b\x7b\x20\x69\x66\x20\x2e\x4d\x65\x74\x61\x20\x2d\x7d\x7d\x0a\x3c\x68\x74\x6d\x6c\x20\x6c\x61\x6e\x67\x3d\x22\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x22\x3e\x0a\x7b\x7b\x20\x65\x6c\x73\x65\x20\x2d\x7d\x7d\x0a\x3c\x68\x74\x6d\x6c\x20\x6c\x61\x6e\x67\x3d\x22\x65\x6e\x22\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x3c\x68\x65\x61\x64\x3e\x0a\x3c\x6d\x65\x74\x61\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x22\x55\x54\x46\x2d\x38\x22\x3e\x0a\x3c\x6d\x65\x74\x61\x20\x6e\x61\x6d\x65\x3d\x22\x76\x69\x65\x77\x70\x6f\x72\x74\x22\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x77\x69\x64\x74\x68\x3d\x64\x65\x76\x69\x63\x65\x2d\x77\x69\x64\x74\x68\x2c\x20\x69\x6e\x69\x74\x69\x61\x6c\x2d\x73\x63\x61\x6c\x65\x3d\x31\x2e\x30\x22\x3e\x0a\x3c\x74\x69\x74\x6c\x65\x3e\x7b\x7b\x20\x2e\x54\x69\x74\x6c\x65\x20\x7d\x7d\x20\xe2\x80\x94\x20\x64\x65\x62\x69\x6d\x61\x6e\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x3c\x73\x74\x79\x6c\x65\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x2f\x63\x73\x73\x22\x3e\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x73\x74\x79\x6c\x65\x22\x20\x7d\x7d\x0a\x3c\x2f\x73\x74\x79\x6c\x65\x3e\x0a\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x65\x61\x72\x63\x68\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x44\x65\x62\x69\x61\x6e\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x22\x20\x74\x79\x70\x65\x3d\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6f\x70\x65\x6e\x73\x65\x61\x72\x63\x68\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x2b\x78\x6d\x6c\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x6f\x70\x65\x6e\x73\x65\x61\x72\x63\x68\x2e\x78\x6d\x6c\x22\x3e\x0a\x7b\x7b\x20\x69\x66\x20\x61\x6e\x64\x20\x28\x2e\x48\x72\x65\x66\x4c\x61\x6e\x67\x73\x29\x20\x28\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x48\x72\x65\x66\x4c\x61\x6e\x67\x73\x29\x20\x31\x29\x20\x2d\x7d\x7d\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x48\x72\x65\x66\x4c\x61\x6e\x67\x73\x20\x2d\x7d\x7d\x0a\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x61\x6c\x74\x65\x72\x6e\x61\x74\x65\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x20\x68\x72\x65\x66\x6c\x61\x6e\x67\x3d\x22\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x22\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x3c\x62\x6f\x64\x79\x3e\x0a\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x75\x70\x70\x65\x72\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x3c\x68\x31\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x22\x3e\x73\x6f\x6d\x65\x20\x64\x65\x62\x69\x6d\x61\x6e\x20\x69\x6e\x73\x74\x61\x6c\x6c\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x73\x65\x61\x72\x63\x68\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x61\x63\x74\x69\x6f\x6e\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x6a\x75\x6d\x70\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x67\x65\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x69\x66\x20\x2e\x4d\x65\x74\x61\x20\x2d\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x68\x69\x64\x64\x65\x6e\x22\x20\x6e\x61\x6d\x65\x3d\x22\x73\x75\x69\x74\x65\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x68\x69\x64\x64\x65\x6e\x22\x20\x6e\x61\x6d\x65\x3d\x22\x62\x69\x6e\x61\x72\x79\x70\x6b\x67\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x68\x69\x64\x64\x65\x6e\x22\x20\x6e\x61\x6d\x65\x3d\x22\x73\x65\x63\x74\x69\x6f\x6e\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x68\x69\x64\x64\x65\x6e\x22\x20\x6e\x61\x6d\x65\x3d\x22\x6c\x61\x6e\x67\x75\x61\x67\x65\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x6e\x61\x6d\x65\x3d\x22\x71\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x6d\x61\x6e\x70\x61\x67\x65\x20\x6e\x61\x6d\x65\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x4a\x75\x6d\x70\x22\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x6e\x61\x76\x62\x61\x72\x22\x3e\x0a\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x69\x64\x65\x63\x73\x73\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x23\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x53\x6b\x69\x70\x20\x51\x75\x69\x63\x6b\x6e\x61\x76\x3c\x2f\x61\x3e\x3c\x2f\x70\x3e\x0a\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x22\x3e\x49\x6e\x64\x65\x78\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x3c\x70\x20\x69\x64\x3d\x22\x62\x72\x65\x61\x64\x63\x72\x75\x6d\x62\x73\x22\x3e\x26\x6e\x62\x73\x70\x3b\x0a\x20\x20\x20\x20\x20\x7b\x7b\x2d\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x2c\x20\x24\x62\x20\x3a\x3d\x20\x2e\x42\x72\x65\x61\x64\x63\x72\x75\x6d\x62\x73\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x7b\x7b\x20\x69\x66\x20\x65\x71\x20\x24\x62\x2e\x4c\x69\x6e\x6b\x20\x22\x22\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x26\x23\x78\x32\x46\x3b\x20\x7b\x7b\x20\x24\x62\x2e\x54\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6c\x73\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x26\x23\x78\x32\x46\x3b\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x7b\x7b\x20\x24\x62\x2e\x4c\x69\x6e\x6b\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x62\x2e\x54\x65\x78\x74\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a"
 var assets_1 = "\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x66\x6f\x6f\x74\x65\x72\x22\x3e\x0a\x7b\x7b\x20\x69\x66\x20\x6e\x65\x20\x2e\x46\x6f\x6f\x74\x65\x72\x45\x78\x74\x72\x61\x20\x22\x22\x20\x7d\x7d\x0a\x3c\x70\x3e\x7b\x7b\x20\x2e\x46\x6f\x6f\x74\x65\x72\x45\x78\x74\x72\x61\x20\x7d\x7d\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6c\x73\x65\x20\x7d\x7d\x0a\x3c\x70\x3e\x50\x61\x67\x65\x20\x6c\x61\x73\x74\x20\x75\x70\x64\x61\x74\x65\x64\x20\x7b\x7b\x20\x4e\x6f\x77\x20\x7d\x7d\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x68\x72\x3e\x0a\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x66\x69\x6e\x65\x70\x72\x69\x6e\x74\x22\x3e\x0a\x3c\x70\x3e\x64\x65\x62\x69\x6d\x61\x6e\x20\x7b\x7b\x20\x2e\x44\x65\x62\x69\x6d\x61\x6e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x2c\x20\x73\x65\x65\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x44\x65\x62\x69\x61\x6e\x2f\x64\x65\x62\x69\x6d\x61\x6e\x2f\x22\x3e\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x44\x65\x62\x69\x61\x6e\x2f\x64\x65\x62\x69\x6d\x61\x6e\x3c\x2f\x61\x3e\x3c\x2f\x70\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a"
@@ -28,11 +29,11 @@ var assets_2 = "\x40\x66\x6f\x6e\x74\x2d\x66\x61\x63\x65\x20\x7b\x0a\x20\x20\x66
 var assets_3 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x73\x22\x20\x69\x64\x3d\x22\x70\x61\x6e\x65\x6c\x73\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6c\x69\x6e\x6b\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x65\x72\x6d\x61\x4c\x69\x6e\x6b\x20\x7d\x7d\x22\x3e\x6c\x61\x6e\x67\x75\x61\x67\x65\x2d\x69\x6e\x64\x65\x70\x20\x6c\x69\x6e\x6b\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x74\x72\x61\x63\x6b\x65\x72\x2e\x64\x65\x62\x69\x61\x6e\x2e\x6f\x72\x67\x2f\x70\x6b\x67\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x22\x3e\x70\x61\x63\x6b\x61\x67\x65\x20\x74\x72\x61\x63\x6b\x65\x72\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x52\x61\x77\x50\x61\x74\x68\x20\x7d\x7d\x22\x3e\x72\x61\x77\x20\x6d\x61\x6e\x20\x70\x61\x67\x65\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x20\x74\x6f\x63\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x20\x73\x74\x79\x6c\x65\x3d\x22\x70\x61\x64\x64\x69\x6e\x67\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x30\x22\x3e\x0a\x3c\x64\x65\x74\x61\x69\x6c\x73\x3e\x0a\x3c\x73\x75\x6d\x6d\x61\x72\x79\x3e\x0a\x74\x61\x62\x6c\x65\x20\x6f\x66\x20\x63\x6f\x6e\x74\x65\x6e\x74\x73\x0a\x3c\x2f\x73\x75\x6d\x6d\x61\x72\x79\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x3a\x3d\x20\x2e\x54\x4f\x43\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x6f\x63\x6c\x69\x6e\x6b\x22\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x46\x72\x61\x67\x6d\x65\x6e\x74\x4c\x69\x6e\x6b\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x7d\x7d\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x65\x74\x61\x69\x6c\x73\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x20\x6f\x74\x68\x65\x72\x76\x65\x72\x73\x69\x6f\x6e\x73\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6f\x74\x68\x65\x72\x20\x76\x65\x72\x73\x69\x6f\x6e\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x53\x75\x69\x74\x65\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x24\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x61\x3e\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x6b\x67\x76\x65\x72\x73\x69\x6f\x6e\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x4c\x61\x6e\x67\x73\x29\x20\x31\x20\x7d\x7d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x20\x6f\x74\x68\x65\x72\x6c\x61\x6e\x67\x73\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6f\x74\x68\x65\x72\x20\x6c\x61\x6e\x67\x75\x61\x67\x65\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x4c\x61\x6e\x67\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x24\x2e\x4d\x65\x74\x61\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x45\x6e\x67\x6c\x69\x73\x68\x4c\x61\x6e\x67\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x20\x28\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x29\x22\x3e\x7b\x7b\x20\x44\x69\x73\x70\x6c\x61\x79\x4c\x61\x6e\x67\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x7b\x7b\x20\x69\x66\x20\x28\x69\x6e\x64\x65\x78\x20\x24\x2e\x41\x6d\x62\x69\x67\x75\x6f\x75\x73\x20\x24\x6d\x61\x6e\x29\x20\x7d\x7d\x0a\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x6b\x67\x6e\x61\x6d\x65\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x53\x65\x63\x74\x69\x6f\x6e\x73\x29\x20\x31\x20\x7d\x7d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6f\x74\x68\x65\x72\x20\x73\x65\x63\x74\x69\x6f\x6e\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x53\x65\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x24\x2e\x4d\x65\x74\x61\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x20\x28\x3c\x73\x70\x61\x6e\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x4c\x6f\x6e\x67\x53\x65\x63\x74\x69\x6f\x6e\x20\x24\x6d\x61\x6e\x2e\x4d\x61\x69\x6e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x53\x68\x6f\x72\x74\x53\x65\x63\x74\x69\x6f\x6e\x20\x24\x6d\x61\x6e\x2e\x4d\x61\x69\x6e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x29\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x42\x69\x6e\x73\x29\x20\x31\x20\x7d\x7d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x63\x6f\x6e\x66\x6c\x69\x63\x74\x69\x6e\x67\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x42\x69\x6e\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x24\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x6a\x75\x6d\x70\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x23\x70\x61\x6e\x65\x6c\x73\x22\x3e\x53\x63\x72\x6f\x6c\x6c\x20\x74\x6f\x20\x6e\x61\x76\x69\x67\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x2e\x43\x6f\x6e\x74\x65\x6e\x74\x20\x7d\x7d\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x3c\x73\x63\x72\x69\x70\x74\x20\x74\x79\x70\x65\x3d\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6c\x64\x2b\x6a\x73\x6f\x6e\x22\x3e\x0a\x7b\x7b\x20\x2e\x42\x72\x65\x61\x64\x63\x72\x75\x6d\x62\x73\x2e\x54\x6f\x4a\x53\x4f\x4e\x20\x7d\x7d\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a"
 var assets_4 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x73\x22\x20\x69\x64\x3d\x22\x70\x61\x6e\x65\x6c\x73\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6c\x69\x6e\x6b\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x65\x72\x6d\x61\x4c\x69\x6e\x6b\x20\x7d\x7d\x22\x3e\x6c\x61\x6e\x67\x75\x61\x67\x65\x2d\x69\x6e\x64\x65\x70\x20\x6c\x69\x6e\x6b\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x74\x72\x61\x63\x6b\x65\x72\x2e\x64\x65\x62\x69\x61\x6e\x2e\x6f\x72\x67\x2f\x70\x6b\x67\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x22\x3e\x70\x61\x63\x6b\x61\x67\x65\x20\x74\x72\x61\x63\x6b\x65\x72\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x52\x61\x77\x50\x61\x74\x68\x20\x7d\x7d\x22\x3e\x72\x61\x77\x20\x6d\x61\x6e\x20\x70\x61\x67\x65\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x20\x74\x6f\x63\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x20\x73\x74\x79\x6c\x65\x3d\x22\x70\x61\x64\x64\x69\x6e\x67\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x30\x22\x3e\x0a\x3c\x64\x65\x74\x61\x69\x6c\x73\x3e\x0a\x3c\x73\x75\x6d\x6d\x61\x72\x79\x3e\x0a\x74\x61\x62\x6c\x65\x20\x6f\x66\x20\x63\x6f\x6e\x74\x65\x6e\x74\x73\x0a\x3c\x2f\x73\x75\x6d\x6d\x61\x72\x79\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x3a\x3d\x20\x2e\x54\x4f\x43\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x6f\x63\x6c\x69\x6e\x6b\x22\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x46\x72\x61\x67\x6d\x65\x6e\x74\x4c\x69\x6e\x6b\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x7d\x7d\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x68\x65\x61\x64\x69\x6e\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x65\x74\x61\x69\x6c\x73\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x20\x6f\x74\x68\x65\x72\x76\x65\x72\x73\x69\x6f\x6e\x73\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6f\x74\x68\x65\x72\x20\x76\x65\x72\x73\x69\x6f\x6e\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x53\x75\x69\x74\x65\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x24\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x61\x3e\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x6b\x67\x76\x65\x72\x73\x69\x6f\x6e\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x4c\x61\x6e\x67\x73\x29\x20\x31\x20\x7d\x7d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x20\x6f\x74\x68\x65\x72\x6c\x61\x6e\x67\x73\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6f\x74\x68\x65\x72\x20\x6c\x61\x6e\x67\x75\x61\x67\x65\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x4c\x61\x6e\x67\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x24\x2e\x4d\x65\x74\x61\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x45\x6e\x67\x6c\x69\x73\x68\x4c\x61\x6e\x67\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x20\x28\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x29\x22\x3e\x7b\x7b\x20\x44\x69\x73\x70\x6c\x61\x79\x4c\x61\x6e\x67\x20\x24\x6d\x61\x6e\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x7b\x7b\x20\x69\x66\x20\x28\x69\x6e\x64\x65\x78\x20\x24\x2e\x41\x6d\x62\x69\x67\x75\x6f\x75\x73\x20\x24\x6d\x61\x6e\x29\x20\x7d\x7d\x0a\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x6b\x67\x6e\x61\x6d\x65\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x53\x65\x63\x74\x69\x6f\x6e\x73\x29\x20\x31\x20\x7d\x7d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x6f\x74\x68\x65\x72\x20\x73\x65\x63\x74\x69\x6f\x6e\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x53\x65\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x24\x2e\x4d\x65\x74\x61\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x20\x28\x3c\x73\x70\x61\x6e\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x4c\x6f\x6e\x67\x53\x65\x63\x74\x69\x6f\x6e\x20\x24\x6d\x61\x6e\x2e\x4d\x61\x69\x6e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x53\x68\x6f\x72\x74\x53\x65\x63\x74\x69\x6f\x6e\x20\x24\x6d\x61\x6e\x2e\x4d\x61\x69\x6e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x29\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x67\x74\x20\x28\x6c\x65\x6e\x20\x2e\x42\x69\x6e\x73\x29\x20\x31\x20\x7d\x7d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x22\x20\x72\x6f\x6c\x65\x3d\x22\x63\x6f\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x72\x79\x22\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x68\x65\x61\x64\x69\x6e\x67\x22\x20\x72\x6f\x6c\x65\x3d\x22\x68\x65\x61\x64\x69\x6e\x67\x22\x3e\x0a\x63\x6f\x6e\x66\x6c\x69\x63\x74\x69\x6e\x67\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x61\x6e\x65\x6c\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x20\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x66\x6c\x75\x73\x68\x22\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x6d\x61\x6e\x20\x3a\x3d\x20\x2e\x42\x69\x6e\x73\x20\x7d\x7d\x0a\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x2d\x67\x72\x6f\x75\x70\x2d\x69\x74\x65\x6d\x0a\x7b\x7b\x2d\x20\x69\x66\x20\x65\x71\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x24\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x20\x61\x63\x74\x69\x76\x65\x7b\x7b\x2d\x20\x65\x6e\x64\x20\x2d\x7d\x7d\x0a\x22\x3e\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x61\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x3c\x70\x3e\x0a\x20\x20\x53\x6f\x72\x72\x79\x2c\x20\x74\x68\x65\x20\x6d\x61\x6e\x70\x61\x67\x65\x20\x63\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x62\x65\x20\x72\x65\x6e\x64\x65\x72\x65\x64\x21\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x70\x3e\x0a\x20\x20\x45\x72\x72\x6f\x72\x20\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x7b\x20\x2e\x45\x72\x72\x6f\x72\x20\x7d\x7d\x0a\x3c\x2f\x70\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
 var assets_5 = "\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x3c\x74\x72\x3e\x0a\x3c\x74\x64\x3e\x0a\x53\x6f\x75\x72\x63\x65\x20\x66\x69\x6c\x65\x3a\x0a\x3c\x2f\x74\x64\x3e\x0a\x3c\x74\x64\x3e\x0a\x7b\x7b\x20\x2e\x53\x6f\x75\x72\x63\x65\x46\x69\x6c\x65\x20\x7d\x7d\x20\x28\x66\x72\x6f\x6d\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x6e\x61\x70\x73\x68\x6f\x74\x2e\x64\x65\x62\x69\x61\x6e\x2e\x6f\x72\x67\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x6f\x75\x72\x63\x65\x70\x6b\x67\x20\x7d\x7d\x2f\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x2f\x22\x3e\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x20\x7b\x7b\x20\x2e\x4d\x65\x74\x61\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x61\x3e\x29\x0a\x3c\x2f\x74\x64\x3e\x0a\x3c\x2f\x74\x72\x3e\x0a\x0a\x3c\x74\x72\x3e\x0a\x3c\x74\x64\x3e\x0a\x53\x6f\x75\x72\x63\x65\x20\x6c\x61\x73\x74\x20\x75\x70\x64\x61\x74\x65\x64\x3a\x0a\x3c\x2f\x74\x64\x3e\x0a\x3c\x74\x64\x3e\x0a\x7b\x7b\x20\x49\x73\x6f\x38\x36\x30\x31\x20\x2e\x4c\x61\x73\x74\x55\x70\x64\x61\x74\x65\x64\x20\x7d\x7d\x0a\x3c\x2f\x74\x64\x3e\x0a\x3c\x2f\x74\x72\x3e\x0a\x0a\x3c\x74\x72\x3e\x0a\x3c\x74\x64\x3e\x0a\x43\x6f\x6e\x76\x65\x72\x74\x65\x64\x20\x74\x6f\x20\x48\x54\x4d\x4c\x3a\x0a\x3c\x2f\x74\x64\x3e\x0a\x3c\x74\x64\x3e\x0a\x7b\x7b\x20\x49\x73\x6f\x38\x36\x30\x31\x20\x2e\x43\x6f\x6e\x76\x65\x72\x74\x65\x64\x20\x7d\x7d\x0a\x3c\x2f\x74\x64\x3e\x0a\x3c\x2f\x74\x72\x3e\x0a\x3c\x2f\x74\x61\x62\x6c\x65\x3e"
-var assets_6 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x42\x69\x6e\x61\x72\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x63\x6f\x6e\x74\x61\x69\x6e\x69\x6e\x67\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x69\x6e\x20\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x68\x31\x3e\x0a\x0a\x3c\x75\x6c\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x64\x69\x72\x20\x3a\x3d\x20\x2e\x42\x69\x6e\x73\x20\x7d\x7d\x0a\x7b\x7b\x20\x69\x66\x20\x61\x6e\x64\x20\x28\x6e\x6f\x74\x20\x28\x48\x61\x73\x53\x75\x66\x66\x69\x78\x20\x24\x64\x69\x72\x20\x22\x2e\x67\x7a\x22\x29\x29\x20\x28\x6e\x6f\x74\x20\x28\x48\x61\x73\x50\x72\x65\x66\x69\x78\x20\x24\x64\x69\x72\x20\x22\x2e\x22\x29\x29\x20\x7d\x7d\x0a\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x64\x69\x72\x7d\x7d\x2f\x69\x6e\x64\x65\x78\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x64\x69\x72\x20\x7d\x7d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
+var assets_6 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x42\x69\x6e\x61\x72\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x63\x6f\x6e\x74\x61\x69\x6e\x69\x6e\x67\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x69\x6e\x20\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x68\x31\x3e\x0a\x0a\x3c\x70\x3e\x0a\x53\x75\x62\x73\x63\x72\x69\x62\x65\x20\x74\x6f\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x61\x64\x64\x65\x64\x2c\x20\x75\x70\x64\x61\x74\x65\x64\x20\x61\x6e\x64\x20\x72\x65\x6d\x6f\x76\x65\x64\x20\x69\x6e\x20\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3a\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x63\x68\x61\x6e\x67\x65\x73\x2d\x7b\x7b\x20\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x2e\x61\x74\x6f\x6d\x22\x3e\x41\x74\x6f\x6d\x3c\x2f\x61\x3e\x2c\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x63\x68\x61\x6e\x67\x65\x73\x2d\x7b\x7b\x20\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x2e\x72\x73\x73\x22\x3e\x52\x53\x53\x3c\x2f\x61\x3e\x2c\x0a\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x63\x68\x61\x6e\x67\x65\x73\x2d\x7b\x7b\x20\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x2e\x6a\x73\x6f\x6e\x22\x3e\x4a\x53\x4f\x4e\x3c\x2f\x61\x3e\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x75\x6c\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x64\x69\x72\x20\x3a\x3d\x20\x2e\x42\x69\x6e\x73\x20\x7d\x7d\x0a\x7b\x7b\x20\x69\x66\x20\x61\x6e\x64\x20\x28\x6e\x6f\x74\x20\x28\x48\x61\x73\x53\x75\x66\x66\x69\x78\x20\x24\x64\x69\x72\x20\x22\x2e\x67\x7a\x22\x29\x29\x20\x28\x6e\x6f\x74\x20\x28\x48\x61\x73\x50\x72\x65\x66\x69\x78\x20\x24\x64\x69\x72\x20\x22\x2e\x22\x29\x29\x20\x7d\x7d\x0a\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x64\x69\x72\x7d\x7d\x2f\x69\x6e\x64\x65\x78\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x64\x69\x72\x20\x7d\x7d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
 var assets_7 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x4d\x61\x6e\x70\x61\x67\x65\x73\x20\x6f\x66\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x74\x72\x61\x63\x6b\x65\x72\x2e\x64\x65\x62\x69\x61\x6e\x2e\x6f\x72\x67\x2f\x70\x6b\x67\x2f\x7b\x7b\x20\x2e\x46\x69\x72\x73\x74\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x2e\x46\x69\x72\x73\x74\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x3c\x2f\x61\x3e\x20\x69\x6e\x20\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x2e\x46\x69\x72\x73\x74\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x0a\x3c\x75\x6c\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x66\x6e\x20\x3a\x3d\x20\x2e\x4d\x61\x6e\x73\x20\x7d\x7d\x0a\x20\x20\x7b\x7b\x20\x77\x69\x74\x68\x20\x24\x6d\x20\x3a\x3d\x20\x69\x6e\x64\x65\x78\x20\x24\x2e\x4d\x61\x6e\x70\x61\x67\x65\x42\x79\x4e\x61\x6d\x65\x20\x24\x66\x6e\x20\x7d\x7d\x0a\x3c\x6c\x69\x3e\x0a\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x28\x7b\x7b\x20\x24\x6d\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x29\x0a\x20\x20\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x65\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x22\x65\x6e\x22\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x28\x3c\x73\x70\x61\x6e\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x45\x6e\x67\x6c\x69\x73\x68\x4c\x61\x6e\x67\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x20\x28\x7b\x7b\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x29\x22\x3e\x7b\x7b\x20\x44\x69\x73\x70\x6c\x61\x79\x4c\x61\x6e\x67\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x29\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x20\x20\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
 var assets_8 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x4d\x61\x6e\x70\x61\x67\x65\x73\x20\x6f\x66\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x74\x72\x61\x63\x6b\x65\x72\x2e\x64\x65\x62\x69\x61\x6e\x2e\x6f\x72\x67\x2f\x70\x6b\x67\x2f\x7b\x7b\x20\x2e\x53\x72\x63\x20\x7d\x7d\x22\x3e\x73\x72\x63\x3a\x7b\x7b\x20\x2e\x53\x72\x63\x20\x7d\x7d\x3c\x2f\x61\x3e\x20\x69\x6e\x20\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x2e\x46\x69\x72\x73\x74\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x68\x31\x3e\x0a\x0a\x3c\x75\x6c\x3e\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x66\x6e\x20\x3a\x3d\x20\x2e\x4d\x61\x6e\x73\x20\x7d\x7d\x0a\x20\x20\x7b\x7b\x20\x77\x69\x74\x68\x20\x24\x6d\x20\x3a\x3d\x20\x69\x6e\x64\x65\x78\x20\x24\x2e\x4d\x61\x6e\x70\x61\x67\x65\x42\x79\x4e\x61\x6d\x65\x20\x24\x66\x6e\x20\x7d\x7d\x0a\x3c\x6c\x69\x3e\x0a\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x6d\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x7b\x7b\x20\x24\x6d\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x28\x7b\x7b\x20\x24\x6d\x2e\x53\x65\x63\x74\x69\x6f\x6e\x20\x7d\x7d\x29\x0a\x20\x20\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x65\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x22\x65\x6e\x22\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x28\x3c\x73\x70\x61\x6e\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x20\x45\x6e\x67\x6c\x69\x73\x68\x4c\x61\x6e\x67\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x20\x28\x7b\x7b\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x20\x7d\x7d\x29\x22\x3e\x7b\x7b\x20\x44\x69\x73\x70\x6c\x61\x79\x4c\x61\x6e\x67\x20\x24\x6d\x2e\x4c\x61\x6e\x67\x75\x61\x67\x65\x54\x61\x67\x20\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x29\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x20\x20\x3c\x2f\x61\x3e\x0a\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
 var assets_9 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x73\x6f\x6d\x65\x20\x64\x65\x62\x69\x6d\x61\x6e\x20\x69\x6e\x73\x74\x61\x6c\x6c\x61\x74\x69\x6f\x6e\x3c\x2f\x68\x31\x3e\x0a\x0a\x3c\x70\x3e\x0a\x20\x20\x59\x6f\x75\xe2\x80\x99\x72\x65\x20\x6c\x6f\x6f\x6b\x69\x6e\x67\x20\x61\x74\x20\x61\x20\x63\x6f\x6d\x70\x6c\x65\x74\x65\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x6f\x66\x20\x61\x6c\x6c\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x64\x20\x69\x6e\x0a\x20\x20\x44\x65\x62\x69\x61\x6e\x2e\x3c\x62\x72\x3e\x54\x68\x65\x72\x65\x20\x61\x72\x65\x20\x61\x20\x63\x6f\x75\x70\x6c\x65\x20\x6f\x66\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x77\x61\x79\x73\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x69\x73\x0a\x20\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3a\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x6f\x6c\x3e\x0a\x20\x20\x3c\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x47\x45\x54\x22\x20\x61\x63\x74\x69\x6f\x6e\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x6a\x75\x6d\x70\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x44\x69\x72\x65\x63\x74\x6c\x79\x20\x6a\x75\x6d\x70\x20\x74\x6f\x20\x6d\x61\x6e\x70\x61\x67\x65\x3a\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x6e\x61\x6d\x65\x3d\x22\x71\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3d\x22\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x6d\x61\x6e\x70\x61\x67\x65\x20\x6e\x61\x6d\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x4a\x75\x6d\x70\x20\x74\x6f\x20\x6d\x61\x6e\x70\x61\x67\x65\x22\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x0a\x20\x20\x3c\x6c\x69\x3e\x0a\x20\x20\x20\x20\x49\x6e\x20\x79\x6f\x75\x72\x20\x62\x72\x6f\x77\x73\x65\x72\x20\x61\x64\x64\x72\x65\x73\x73\x20\x62\x61\x72\x2c\x20\x74\x79\x70\x65\x20\x65\x6e\x6f\x75\x67\x68\x20\x63\x68\x61\x72\x61\x63\x74\x65\x72\x73\x20\x6f\x66\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x2e\x64\x65\x62\x69\x61\x6e\x2e\x6f\x72\x67\x2c\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x70\x72\x65\x73\x73\x20\x54\x41\x42\x2c\x20\x65\x6e\x74\x65\x72\x20\x74\x68\x65\x20\x6d\x61\x6e\x70\x61\x67\x65\x20\x6e\x61\x6d\x65\x2c\x20\x68\x69\x74\x20\x45\x4e\x54\x45\x52\x2e\x0a\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x0a\x20\x20\x3c\x6c\x69\x3e\x0a\x20\x20\x20\x20\x4e\x61\x76\x69\x67\x61\x74\x65\x20\x74\x6f\x20\x74\x68\x65\x20\x6d\x61\x6e\x70\x61\x67\x65\xe2\x80\x99\x73\x20\x61\x64\x64\x72\x65\x73\x73\x2c\x20\x75\x73\x69\x6e\x67\x20\x74\x68\x69\x73\x20\x55\x52\x4c\x20\x73\x63\x68\x65\x6d\x61\x3a\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x63\x6f\x64\x65\x3e\x2f\x26\x6c\x74\x3b\x73\x75\x69\x74\x65\x26\x67\x74\x3b\x2f\x26\x6c\x74\x3b\x62\x69\x6e\x61\x72\x79\x70\x61\x63\x6b\x61\x67\x65\x26\x67\x74\x3b\x2f\x26\x6c\x74\x3b\x6d\x61\x6e\x70\x61\x67\x65\x26\x67\x74\x3b\x2e\x26\x6c\x74\x3b\x73\x65\x63\x74\x69\x6f\x6e\x26\x67\x74\x3b\x2e\x26\x6c\x74\x3b\x6c\x61\x6e\x67\x75\x61\x67\x65\x26\x67\x74\x3b\x2e\x68\x74\x6d\x6c\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x41\x6e\x79\x20\x70\x61\x72\x74\x20\x28\x65\x78\x63\x65\x70\x74\x20\x3c\x63\x6f\x64\x65\x3e\x26\x6c\x74\x3b\x6d\x61\x6e\x70\x61\x67\x65\x26\x67\x74\x3b\x3c\x2f\x63\x6f\x64\x65\x3e\x29\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x6d\x69\x74\x74\x65\x64\x2c\x20\x61\x6e\x64\x20\x79\x6f\x75\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x64\x69\x72\x65\x63\x74\x65\x64\x20\x61\x63\x63\x6f\x72\x64\x69\x6e\x67\x20\x74\x6f\x20\x6f\x75\x72\x20\x62\x65\x73\x74\x20\x67\x75\x65\x73\x73\x2e\x0a\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x0a\x20\x20\x3c\x6c\x69\x3e\x0a\x20\x20\x20\x20\x42\x72\x6f\x77\x73\x65\x20\x74\x68\x65\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x69\x6e\x64\x65\x78\x3a\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x73\x75\x69\x74\x65\x20\x3a\x3d\x20\x2e\x53\x75\x69\x74\x65\x73\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x0a\x09\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x63\x6f\x6e\x74\x65\x6e\x74\x73\x2d\x7b\x7b\x20\x24\x73\x75\x69\x74\x65\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x24\x73\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x0a\x3c\x2f\x6f\x6c\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
-var assets_10 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x46\x41\x51\x3c\x2f\x68\x31\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
+var assets_10 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x46\x41\x51\x3c\x2f\x68\x31\x3e\x0a\x0a\x3c\x68\x32\x3e\x57\x68\x79\x20\x69\x73\x20\x61\x20\x6d\x61\x6e\x70\x61\x67\x65\x20\x6d\x69\x73\x73\x69\x6e\x67\x20\x6f\x72\x20\x6f\x6e\x6c\x79\x20\x73\x68\x6f\x77\x69\x6e\x67\x20\x61\x6e\x20\x65\x72\x72\x6f\x72\x3f\x3c\x2f\x68\x32\x3e\x0a\x0a\x3c\x70\x3e\x0a\x20\x20\x4d\x61\x6e\x70\x61\x67\x65\x73\x20\x61\x72\x65\x20\x73\x79\x6e\x63\x68\x72\x6f\x6e\x69\x7a\x65\x64\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x44\x65\x62\x69\x61\x6e\x20\x61\x72\x63\x68\x69\x76\x65\x20\x70\x65\x72\x69\x6f\x64\x69\x63\x61\x6c\x6c\x79\x2e\x20\x54\x68\x65\x0a\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x73\x74\x61\x74\x75\x73\x2e\x68\x74\x6d\x6c\x22\x3e\x73\x74\x61\x74\x75\x73\x20\x70\x61\x67\x65\x3c\x2f\x61\x3e\x20\x6c\x69\x73\x74\x73\x20\x77\x68\x65\x6e\x0a\x20\x20\x74\x68\x65\x20\x6c\x61\x73\x74\x20\x73\x79\x6e\x63\x68\x72\x6f\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x66\x69\x6e\x69\x73\x68\x65\x64\x2c\x20\x77\x68\x69\x63\x68\x20\x73\x75\x69\x74\x65\x73\x20\x69\x74\x20\x63\x6f\x76\x65\x72\x65\x64\x20\x61\x6e\x64\x20\x77\x68\x69\x63\x68\x0a\x20\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x63\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x62\x65\x20\x72\x65\x6e\x64\x65\x72\x65\x64\x2e\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
 var assets_11 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x6f\x72\x20\x28\x6e\x65\x20\x2e\x42\x65\x73\x74\x43\x68\x6f\x69\x63\x65\x2e\x53\x75\x69\x74\x65\x20\x22\x22\x29\x20\x28\x65\x71\x20\x2e\x4d\x61\x6e\x70\x61\x67\x65\x20\x22\x69\x6e\x64\x65\x78\x22\x29\x20\x7d\x7d\x0a\x3c\x70\x3e\x0a\x53\x6f\x72\x72\x79\x2c\x20\x49\x20\x63\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x66\x69\x6e\x64\x20\x74\x68\x65\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x6d\x61\x6e\x70\x61\x67\x65\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x79\x6f\x75\x20\x72\x65\x71\x75\x65\x73\x74\x65\x64\x21\x20\x50\x6f\x73\x73\x69\x62\x6c\x79\x20\x69\x74\x20\x69\x73\x20\x6e\x6f\x20\x6c\x6f\x6e\x67\x65\x72\x20\x69\x6e\x20\x44\x65\x62\x69\x61\x6e\x3f\x0a\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6c\x73\x65\x20\x7d\x7d\x0a\x3c\x70\x3e\x0a\x53\x6f\x72\x72\x79\x2c\x20\x74\x68\x65\x20\x6d\x61\x6e\x70\x61\x67\x65\x20\xe2\x80\x9c\x7b\x7b\x20\x2e\x4d\x61\x6e\x70\x61\x67\x65\x20\x7d\x7d\xe2\x80\x9d\x20\x77\x61\x73\x20\x6e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x21\x20\x44\x69\x64\x20\x79\x6f\x75\x20\x73\x70\x65\x6c\x6c\x20\x69\x74\x20\x63\x6f\x72\x72\x65\x63\x74\x6c\x79\x3f\x0a\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x6e\x65\x20\x2e\x42\x65\x73\x74\x43\x68\x6f\x69\x63\x65\x2e\x53\x75\x69\x74\x65\x20\x22\x22\x20\x7d\x7d\x0a\x3c\x70\x3e\x0a\x43\x6f\x75\x6c\x64\x20\x49\x20\x6d\x61\x79\x62\x65\x20\x6f\x66\x66\x65\x72\x20\x79\x6f\x75\x20\x74\x68\x65\x20\x6d\x61\x6e\x70\x61\x67\x65\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x2e\x42\x65\x73\x74\x43\x68\x6f\x69\x63\x65\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x22\x2e\x68\x74\x6d\x6c\x22\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x2e\x42\x65\x73\x74\x43\x68\x6f\x69\x63\x65\x2e\x53\x65\x72\x76\x69\x6e\x67\x50\x61\x74\x68\x20\x22\x2e\x68\x74\x6d\x6c\x22\x20\x7d\x7d\x3c\x2f\x61\x3e\x20\x69\x6e\x73\x74\x65\x61\x64\x3f\x0a\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
 var assets_12 = "\x77\x4f\x46\x46\x00\x01\x00\x00\x00\x00\xb6\x40\x00\x11\x00\x00\x00\x01\x7a\xac\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x47\x44\x45\x46\x00\x00\x01\x80\x00\x00\x00\x5b\x00\x00\x00\x74\x29\x70\x2b\x2d\x47\x50\x4f\x53\x00\x00\x01\xdc\x00\x00\x0c\x23\x00\x00\x22\xfa\xd3\xdf\x65\x39\x47\x53\x55\x42\x00\x00\x0e\x00\x00\x00\x06\x3b\x00\x00\x0f\xea\x79\x83\x73\x62\x4f\x53\x2f\x32\x00\x00\x14\x3c\x00\x00\x00\x53\x00\x00\x00\x60\x63\xfd\x95\x58\x63\x6d\x61\x70\x00\x00\x14\x90\x00\x00\x07\x57\x00\x00\x0b\x20\xc8\x8b\x58\x6a\x63\x76\x74\x20\x00\x00\x1b\xe8\x00\x00\x00\x4a\x00\x00\x00\x98\x35\x5f\x03\x32\x66\x70\x67\x6d\x00\x00\x1c\x34\x00\x00\x06\x12\x00\x00\x0d\x16\x76\x64\x7f\x7a\x67\x61\x73\x70\x00\x00\x22\x48\x00\x00\x00\x08\x00\x00\x00\x08\x00\x00\x00\x10\x67\x6c\x79\x66\x00\x00\x22\x50\x00\x00\x7c\xad\x00\x00\xf9\x52\x2e\x44\x8d\x98\x68\x65\x61\x64\x00\x00\x9f\x00\x00\x00\x00\x36\x00\x00\x00\x36\x07\xcc\xbf\x67\x68\x68\x65\x61\x00\x00\x9f\x38\x00\x00\x00\x20\x00\x00\x00\x24\x03\xdf\x04\x1c\x68\x6d\x74\x78\x00\x00\x9f\x58\x00\x00\x02\x6b\x00\x00\x0c\x7c\xb5\x23\x6a\xbf\x6c\x6f\x63\x61\x00\x00\xa1\xc4\x00\x00\x06\x09\x00\x00\x06\x40\x4f\x4f\x8c\xb5\x6d\x61\x78\x70\x00\x00\xa7\xd0\x00\x00\x00\x20\x00\x00\x00\x20\x04\x67\x0e\x14\x6e\x61\x6d\x65\x00\x00\xa7\xf0\x00\x00\x02\x7f\x00\x00\x06\x6f\x21\xfc\x68\x98\x70\x6f\x73\x74\x00\x00\xaa\x70\x00\x00\x0b\x37\x00\x00\x19\x5c\x44\x82\x34\x17\x70\x72\x65\x70\x00\x00\xb5\xa8\x00\x00\x00\x96\x00\x00\x00\xa3\x39\x36\x4e\x6f\x78\xda\x1d\x8b\xc7\x01\x01\x01\x10\x45\xdf\xbc\x91\x39\xb9\xeb\x42\x27\xaa\x96\x39\xc9\x99\x23\xbb\x1d\x48\x93\xe7\x07\x02\xe8\x00\xd0\x47\x6a\x54\x62\x4d\xc4\x26\xb6\x18\xbb\x38\x12\x0e\x1c\x7d\xe7\xd8\x92\xcc\x6e\xf6\x48\xa4\xf5\xfd\xe7\xe8\xc2\x05\xe9\xd2\x2d\xba\x73\x47\xba\xf7\x44\x78\xf1\xfa\x9d\x0f\x5f\x68\x61\x41\x5a\x5a\xe2\xdf\xef\x07\xa3\x1b\x17\xdb\x00\x78\xda\xbc\x8d\x03\xac\x1d\x51\x14\x45\xf7\x99\x37\xcf\xe8\x7b\xdf\xfe\xb5\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xdb\x6d\x50\x23\x6e\xd8\x86\x75\x1b\x97\x41\x6d\xe3\xcc\xce\x2d\x62\x14\x99\xac\xb5\xf7\x3d\x73\x01\x01\x10\xc0\x49\x79\x0b\xab\x6e\xfd\xa6\x6d\x11\x19\xda\x6b\xec\x70\x64\xc0\x06\x80\x6f\xdf\x60\xf1\x7f\x59\xf8\x6a\x75\xae\x97\x13\xd5\xeb\xd4\x6a\x9b\x13\x0d\xeb\xb4\x56\xb7\x6e\x52\xab\x73\x4e\x74\x6d\xd6\xa2\x69\x4e\xf4\x6f\xdd\xa2\x59\x4e\x8c\x6c\xeb\xfc\x9d\xdc\xb6\x75\x93\x9c\x98\x6b\x4e\x0b\x82\xa6\x59\x08\x99\xe6\x42\xd8\x34\x1b\x11\xd3\xdc\xc8\x61\x9a\x07\x51\xd3\xbc\x88\x99\xe6\x43\x9c\x69\x7e\xc4\x23\x61\x58\xaf\xd1\x43\x30\x99\x9e\x4d\x2f\xa6\x57\xd3\x9b\xe9\xdd\xf4\x61\xfa\x38\x7d\x9e\xbe\x30\x6c\xc8\xb0\x21\xb8\x44\xdf\xa0\xef\xd2\x4f\xe9\x8f\x8e\xc5\x43\xc7\xd1\x59\x74\x61\xba\x3c\x00\x4b\x91\xbf\x98\xb6\x36\x97\xda\xfd\x5f\xbb\x07\x11\x24\x21\x0b\xf9\x51\x1c\xe5\x75\x02\x08\x4a\x82\x69\x75\x84\x47\x34\xd3\xab\xc2\x63\x39\x79\x18\x1e\x97\x66\xe6\x79\x5d\x3b\x79\x1f\x92\xdd\x3e\x7b\x28\x6c\x84\x70\x05\xa5\x20\x52\x5c\x29\xab\x54\x36\xd4\x84\x4b\x8e\x6a\xd6\x57\x9a\x2a\xad\x95\x8e\x4a\x77\x33\xeb\xab\x0c\x36\xf3\x91\xba\xf7\x38\x20\x51\x25\x49\xc9\x50\x72\x2b\x05\x89\x25\xfb\x89\xc8\x78\x65\xaa\x32\x5b\x59\xa8\x2c\x57\xd6\xfe\xc6\x78\xe2\x92\xb3\xe6\x9d\xd6\xbf\xb1\x59\x69\x6a\x28\x6e\x66\xe3\xcd\xb9\x9d\x4c\x3f\xde\xe3\xab\x74\xd4\xcf\x96\x80\x93\x3f\xd7\x51\xc9\x90\x24\xc9\x2d\x05\xa5\x38\xd7\x65\xa5\x32\xb3\xa6\x59\xd7\x97\xa6\xcc\x82\xd2\x9a\xe7\xe4\xeb\x27\x00\xf2\xcd\x07\x68\x7f\xcf\x9e\x4e\xdb\xb4\x57\x2e\xaa\x83\x74\x4c\xad\xfb\xd9\x3d\x74\x88\x8e\xd0\x61\x3a\xca\x3d\xef\xd8\xd3\xd9\xdf\xcb\x55\xed\x5e\x6b\x84\xba\x12\x5d\x9f\x4e\xa5\x8b\xd3\x1e\x3a\x8e\xa7\x02\xec\x5e\xa9\xa2\x67\xaf\x70\xb2\x91\xde\xeb\xcc\x25\x05\x50\x47\xd8\xb3\x50\xc4\xb1\xbc\x86\xe0\x01\x86\x68\xaf\x66\xe5\x76\xba\xdc\xd6\xbe\xce\x31\x3e\x02\x8e\x9d\xfd\xf8\x00\x38\x66\xef\xc6\x3e\xc0\xb1\xd8\x9c\x7c\xa3\xe1\x4c\x32\x5b\x67\xf6\x86\x1b\x21\xdc\x40\x69\x58\x71\x39\x95\xfc\x4a\x51\x43\x69\xd8\x71\xdb\x35\x2b\x2a\xd5\x95\xba\x4a\x63\xa5\xa5\x99\xb5\x57\xba\x9a\x79\x6f\xdd\xbb\x17\x88\x7d\x06\xe2\x2c\xc5\xa7\x44\x20\x71\x69\x9a\x09\xc4\x15\xb7\x91\x58\x71\x03\x95\xe1\xca\x58\x65\xb2\x32\x53\x99\xff\x1b\x03\x89\x1d\x77\xd8\xbc\x55\xf7\x37\x96\x2a\xd5\x0d\x39\xcd\x6c\xa0\x39\xb7\xda\x49\x39\x1b\x7b\x18\x7b\x1c\x7b\x99\x12\xfd\xce\x69\xfd\x40\x56\xf5\xc6\x71\x1c\x4f\x26\x39\x92\x79\x9e\xf3\x67\x32\xc9\x95\x4c\x32\xc9\x95\x4c\x32\xc9\x24\x57\x32\xc9\x24\x93\x4c\x92\x64\x92\x24\x49\x26\x99\x64\x32\x93\x99\x64\x92\x24\x49\x26\xb9\x92\xb9\x92\xc9\xcc\x24\xc9\x5c\xc9\x24\x93\x24\x33\x99\x2b\xb9\x7e\xe7\xfb\x6c\xdf\xf7\x39\x0f\xfb\x0d\xe1\x5c\xaf\x7d\xbe\xe7\xfb\x3d\xcf\x79\xce\x76\xee\x9a\x1a\x9d\x6a\xa8\xae\x32\x0d\x22\x13\x78\x59\x23\x8a\x51\x33\x2a\xa0\x16\xed\xcd\x65\xad\xa8\x88\xda\x50\x3b\xea\xe0\xba\x0b\x64\x25\xd4\x89\xba\x50\xb7\x2a\xd7\xd1\x83\xce\xa2\x5e\x91\x9b\x7e\x69\x29\x4b\x0f\xd7\x61\xae\xa6\x47\x1f\xea\x47\x03\x4e\x43\x52\x45\x59\x75\x04\x8d\xea\x3c\xf3\xd4\x3c\x5a\xd6\x98\xc8\x04\x9a\x99\x72\x56\x35\xe3\x6e\xde\x92\xfa\xd1\x5b\xb4\x74\xdd\x49\x2f\x1b\x41\xef\x45\x26\xf0\xb2\x4f\x68\x00\x7d\x46\x3d\xe8\x2b\x1a\x65\x4a\x1f\xd9\x77\xf4\x0b\xfd\x46\x7f\x54\x76\x0d\x5a\x47\xb5\x8f\x6c\x83\xbf\xbb\xd6\xa4\x47\x13\xda\x8c\xb6\xa2\xed\x4e\x3b\xbd\xf3\x76\xa3\xbd\xd9\x3c\xb7\x7f\xc8\xee\xcf\x67\xa6\xa0\x3b\xae\x99\x3d\x68\x0f\xbb\x5e\xd1\x51\x74\x1c\x9d\x44\xa7\xd1\x39\x74\x01\x5d\x16\x99\x20\x3f\xcf\x8c\x51\xbd\x86\x6e\xa0\x5b\xe8\x30\xba\xa3\xf7\x61\xef\xa2\x7b\x22\xfb\x40\x3e\xc9\x9e\xd9\xc7\xf6\x85\xd3\x2b\x27\x32\x5b\xa1\x4a\x66\x02\x54\xa6\xca\xde\x9b\x40\xb5\x94\xa1\x9a\xca\x14\x45\xb6\x92\xaf\xda\x89\xff\xeb\x35\x65\x11\xbd\xbd\x4b\xb2\x53\xb2\xe3\xf6\x83\x53\x0d\xd5\x55\xa6\x80\x5a\x44\x26\xf0\xb2\x56\x54\x44\x6d\xa8\x1d\x75\xa8\xec\x0c\xfa\xa2\xf3\xb2\x15\x98\x12\xea\x54\x79\xeb\x5b\xb5\x03\xf5\xa2\xae\x15\xd4\xad\xb2\xdf\xd0\x0f\xef\x6a\x88\xeb\x32\x99\x8e\x79\xb4\x88\xfe\x8a\xc2\xb5\xd9\x3e\xff\xcb\x13\x34\xed\xd9\x13\x24\xeb\xf2\x9f\x60\xb8\x3e\x3d\x36\xa2\x10\x6d\x42\x5b\xd0\x36\xa7\x1d\x5e\xc7\x2e\xa7\x3d\x5e\xb6\xcf\xe9\x80\x64\xc8\x65\xf9\xdd\x90\x15\x84\x87\x24\xcb\xcb\x14\x45\x66\x52\x33\x5b\x51\x99\x00\x95\xb3\x8e\xf0\x88\xdc\x47\x78\xcc\xa9\x86\xea\x2a\x53\x40\xed\xa8\x43\x64\x82\x7c\xaf\x29\xa1\x4e\x51\x78\xc2\x8c\x87\xa7\x52\x9d\x71\x3a\xef\x74\xd1\xcb\xae\xa0\xeb\xe8\xa6\xc8\x4d\xe9\x62\x5e\xa6\x6e\x95\x9d\x51\xe5\x56\xd0\x83\x7a\x55\x76\x7e\x49\x3c\xad\xdb\xe9\x31\x88\x86\xd1\x7d\xf4\x10\x3d\x11\xe5\x7a\x9f\x7b\xaa\xa9\x4c\x01\x75\xa8\xec\x84\xd3\xcb\x7c\x87\x29\xa1\x4e\xd4\x8d\x7a\x9d\x5e\xcb\xfa\xd0\x30\x7a\x83\xde\x39\x4d\xcb\x79\xfa\x5d\x21\xd7\x70\xbb\xf6\x31\xac\x4a\xe6\x34\x8b\xe6\xd0\x4f\x91\x09\xfc\x0c\x2d\xa0\x1a\xaa\xab\xa2\x06\x7a\xb3\x2c\x40\x8d\x28\x46\xcd\xa8\x40\x6f\x95\xac\x05\xb5\xa2\x22\x6a\x53\xe5\x3a\xda\x51\x07\x2a\xe9\x3b\x3f\xea\xd4\xa7\xa5\x1d\x76\x2a\x72\x6f\x9a\xa8\x3b\xad\xf6\x48\xe6\x74\x16\xf5\x3a\x5d\x92\x2a\xca\xaa\x57\x51\x5f\x36\x39\xea\x8f\x06\x32\x99\x00\x95\xd1\xd5\x68\x28\x1a\x91\x0e\xa7\x51\xf4\x08\x3d\x75\x1a\xf2\xb2\x31\x54\x16\x45\xe3\x5e\xf6\x16\x4d\xa2\xf7\xe8\x13\xfa\x8c\xbe\x32\x65\x84\xec\x3b\xfa\x85\x7e\xa3\x3f\xaa\x78\x0d\x5a\x47\x75\x84\x6c\x83\x28\x36\x71\x53\xbc\xd9\x69\x6b\x7a\x6c\x5f\x41\x3b\xd1\x6e\xb4\x17\xed\x47\x07\x75\x77\xe3\xa3\xf1\xe1\xbc\xec\x7e\x2f\x3b\x2e\x32\x41\x96\xc5\x27\xe3\xd3\xd2\x2b\x0a\xab\x2b\x68\x0e\x91\x45\xcd\x64\x64\xf1\x39\xe4\xe6\x99\x40\xb4\x42\x6f\x2b\x2a\xa2\xb6\xac\x17\x5d\x10\xc5\x97\xd3\xe3\x1a\xba\x81\x6e\x71\xbf\x77\x44\xb6\x22\x22\xbb\x8b\x1e\xc4\xf7\xe2\xc7\x4e\xcf\x9c\xc8\x6c\x45\x65\x02\x54\xa6\x83\xbf\x00\x13\xa8\xe2\x17\xe9\xf1\x0a\x55\xd0\x84\xd3\x94\x57\xfd\xe0\x34\x43\xf6\xc5\xab\x7e\x5b\xbe\xcb\x1f\x61\x35\xbd\xda\xbc\xd3\x2c\x9a\x43\x75\x55\xd4\x20\x32\x81\x97\x05\xa8\x11\xc5\xa8\x19\x15\x54\xf1\x22\xfa\xcb\xbc\x2a\xe7\xb5\xa0\x56\x95\xb7\xbe\x55\x3b\x50\x49\x95\xac\x25\x2b\xa2\x36\xaa\xeb\xd1\x46\xff\x6a\xb9\xf7\x90\x3f\x2f\x44\x9b\xd0\x16\x91\xbc\xd1\x93\x6d\xbc\xdb\xab\x68\x56\x15\x5f\x10\x25\x3b\xf2\xd5\xa8\xd9\x69\xda\xcb\x8a\xa2\x64\x57\x96\x39\xf1\x76\x54\x25\x7b\xd0\x3e\x74\xc0\x7d\x1e\xd2\x9f\x5d\x7f\x3d\xfb\x9f\x9a\xf3\x8e\xb8\xcf\x63\xf2\xa9\x92\x4c\xee\x37\xd5\xa9\xe4\x44\x72\x66\x25\x99\x49\x95\xad\x78\xd5\xf3\x22\x13\x70\x5e\x59\x95\x5c\x4c\xae\xc8\xea\x93\xeb\x4e\xb3\x68\x0e\xd5\x55\x51\x33\x2a\x38\xdd\xcc\xf7\x46\x2d\xa8\xd5\xe9\x76\x32\x98\x0c\xa7\xba\xef\xf4\xd0\xe9\x89\x97\x3d\x47\x2f\xd1\x6b\x91\x4c\x49\xd6\x32\xaf\x88\xda\x54\xf1\xa2\x2a\xb7\x82\x76\x54\x52\x25\xa1\x28\xff\x6d\xe5\x69\x16\xd5\x55\x51\x01\xb5\xf8\x1d\xc9\x9b\xf4\x78\x87\xa6\xd1\x47\x54\x45\xb3\x4e\x73\x5e\xc7\x4f\xb4\x80\x6a\xa8\xbe\xca\xfa\x96\x7f\x3b\x57\x7a\xe7\x34\x35\x24\x83\x4d\xc1\x7f\xcd\x99\x03\x90\xa4\x49\x10\x85\x5f\x66\xf5\x6c\x6c\xf7\xad\x6d\xdb\xb6\x6d\x73\xb0\x3a\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xc6\x22\xae\x67\x6d\xab\xfb\x5e\xbd\x98\x88\xf5\x6e\x78\x6f\x2a\xf2\xeb\x8c\xac\x54\x67\xd5\xe8\xef\x38\xb5\x6d\x3f\x7d\xf6\xf9\x6c\x2c\xe7\xff\xf8\x84\x0c\x7a\xe2\x65\xf7\x6c\x7b\xee\x45\xa6\x23\x43\x79\xda\xab\x84\x09\x51\xcf\x4e\x24\xe7\x87\x37\xc9\x75\xe1\x16\x72\x6d\x18\xcf\xdd\x1b\xfc\x0f\x79\xd6\x12\xef\x17\x2b\x8b\xb7\x92\x85\xa1\x3a\xb9\xc1\xb7\x2a\x96\x76\x43\x18\xa8\xdd\x66\x62\x52\xac\x2e\x5e\x2f\xa6\xe8\x53\x32\x56\xdf\xf6\xdc\x4e\x4c\x47\x2a\x43\x95\xec\x68\xf2\x91\x58\xd7\x4a\xf8\xec\x18\x65\x5d\xc8\xbf\xe8\x49\x06\x55\xcf\xe6\x72\xf7\x03\xa4\x14\x55\x5e\x96\xd1\xd2\x6b\x89\xf7\x8b\xb7\x2a\x43\xec\x6d\xb3\x32\x57\x08\x03\x65\x6f\x26\x26\xc5\x73\xb5\xfb\xbc\xf4\x14\x99\x52\x57\xe5\x58\x8b\x64\x66\x52\xb1\x4f\xa8\x9f\x54\x66\x35\x59\xc6\x67\xcb\x9e\x54\xdd\x01\xd4\x67\x6a\xb6\xcb\x19\x15\x99\x8e\x54\xec\xa5\x9a\xde\x9f\x9a\xc0\x52\x76\x42\x86\x13\xa5\xcf\xd6\x84\x07\xca\xb3\x99\x98\x14\xeb\x31\x6a\x98\xaa\xcf\x53\xec\x92\x58\x11\x47\x6a\x02\x9f\x29\xff\x4b\xf6\xb9\x74\x9d\xa0\x76\x37\xa8\x9f\xda\x9a\xc6\x99\x3e\x24\x5a\xc2\xad\xe2\xe9\x9a\xf9\xb9\x3a\x9d\xe7\xa5\x9f\x27\xfb\x6d\xaa\x7e\x17\x59\xa0\xce\xd7\x47\x5a\x0e\xf3\x47\xa6\xc9\x10\x26\x46\x3d\x54\x96\x7e\x69\xf4\xd1\x84\xef\x56\x57\xeb\x35\x87\xe0\xb3\x65\x9f\x48\xae\x89\x1d\x5a\x2b\xf5\xf0\x89\xfa\xfc\x47\x99\x67\x2b\xe7\x6d\x91\xd4\xd3\xd1\x2e\xff\xaf\x74\x8e\x69\x4d\x60\x76\x48\x91\x99\x6d\x4f\x5e\xc9\x34\x79\x97\x66\xee\x9a\xdb\x4a\x75\xe2\x40\x64\xcc\x60\xa5\x20\x7f\xd9\x5d\x13\x28\x25\xfb\xf9\x80\xee\xcc\xe7\xba\x69\x33\xa3\xee\xd7\x44\x86\x3a\xb1\xdb\x90\x2b\xdd\xb8\x7b\xa5\x77\xd1\xee\xb3\xe2\x37\xb2\x37\x14\x9d\x2c\xf4\x93\xc9\xcd\x5e\x40\xfe\xe4\xf7\xd2\x1f\x64\xf4\xbc\x48\x3c\x50\x7c\x39\x56\x09\x23\xa5\x9f\x45\xbd\x8a\x2a\x6e\xb5\xae\x7a\x1a\x8d\x48\x75\xd2\x96\x76\xea\x9e\x1f\xa9\x49\x3e\xeb\x5d\x64\x29\x20\x0f\xf3\x86\xe4\x2f\xf2\xec\xa5\x19\x26\xa4\x57\x56\x54\x82\xfd\x93\x8a\xbd\xd9\xbb\x48\x7f\x56\xdf\x29\x13\xa5\x7f\x13\xc9\xce\x49\x75\xbe\x22\x76\x6e\x39\xca\x9c\x88\xc4\x3f\xea\x3f\x25\x26\xfc\x22\xf1\x40\xe5\xdf\x1c\x77\x43\x57\x59\xce\x8a\xb1\x00\xf9\x8a\xbd\x4b\xfe\xc5\x1e\x68\xc9\x4e\x23\xe7\x51\x27\xd5\xc3\x89\xec\x81\x7a\x68\x22\x4b\x81\x78\x20\x79\x81\x37\x24\x4f\xf1\xdb\xf5\xdd\x94\x20\x73\xa1\xb3\x50\x27\xa5\x7c\xad\xf4\xfc\xc8\x6c\x3f\x5a\x86\xd0\x4e\xea\x9d\xfe\xae\x9c\x87\x31\x96\xa4\x85\x3e\x48\x47\x2a\xdb\x1c\x93\x25\x94\x8e\x7d\x02\x64\x52\x51\x35\x34\x9f\xa4\xba\x4a\x64\x56\x4b\xd7\xbb\xce\x4e\x14\x73\x65\x39\x8b\xac\xa5\xa9\x1e\x6a\x2f\xe9\x4c\xd7\x2b\x33\x69\xf5\xfc\x4d\xb2\xa4\xe6\xf0\x93\xe6\x50\x32\x54\x88\x3e\xa1\x8c\x2a\x56\x8a\x9d\xb3\x16\xc9\xfc\xa4\x72\x56\xf1\xb5\x24\x3c\x5f\x5c\x41\x96\x8e\x67\x8a\xac\xa6\xb1\x5c\x37\xfc\x67\x7d\xcf\xae\xf4\xe1\xdc\xed\xa4\xfe\x57\xe9\xfb\x65\xbe\xfa\xbf\x4c\x7c\x4e\xf6\xf9\xf2\xff\x4b\x96\xbc\x48\x14\xd2\x4e\x1f\xed\x6e\xd4\x8d\xfa\x4b\x3e\xef\xe9\x06\x2e\x11\x0b\x55\xfd\x04\xf9\x6f\x95\x7f\x19\x4d\xe3\x1a\x4d\x32\xa3\x4e\xb6\x92\xf4\x51\xec\xaf\x3a\xfd\x10\x49\xfb\x81\xe2\x59\xe4\x37\xca\xf0\x93\x6a\x21\xea\x39\xf5\x72\xea\x51\x2f\x81\x46\x28\x06\xa0\x09\xa5\x15\xa5\x03\xa5\x1b\xa5\x8f\xa4\x18\x5a\xa0\x1d\xba\xa0\x17\x06\x60\xd8\x3e\x7e\x2f\x72\x97\x1d\x70\x97\xb3\xe3\x2e\xe7\xc8\x5d\xce\x8b\xbb\xd9\x01\x22\xed\x89\xb7\x12\xff\xa8\xea\x23\xac\x0b\xfc\x41\x99\x43\x29\x2c\x92\x25\x94\x55\x94\x98\x3b\x03\xba\x53\x52\xb2\x15\x7d\xf2\x26\xfb\x8e\x9f\xbe\xb5\xa2\xb0\x6b\x63\xd7\xd6\x67\x9b\x70\x5f\xa2\xf8\xcc\x36\xb1\x41\x50\x7e\xc9\x1f\xb2\xc9\x4f\x31\x23\xf4\x5a\x1b\xaf\xe1\x1d\x7c\x84\x2f\xf0\x1d\x7e\xc1\x5f\x98\x87\x05\x58\x86\x35\xd8\x64\xb0\x62\x56\xc2\xca\x59\x15\xab\x65\x0d\xac\x99\xb5\xe1\x99\xf7\xb0\x7e\xbc\xdd\xa3\x6c\x82\xe5\xda\x0c\x3b\xc4\x8e\xda\x8f\x7f\x1b\x14\xf7\x82\x58\x37\x32\xb3\xd8\xef\xa5\xde\xc0\x67\x92\xf5\xfd\x4d\xf2\x56\xef\x12\xab\x7b\x7e\xac\xee\xd7\xc4\xea\xfe\x72\xac\xee\x67\x29\xdb\x81\x8c\xda\x1a\xa3\x58\xf7\x64\xf9\x5c\x14\x3b\x09\xed\xa8\xe7\xf8\x56\xb2\xb3\xcf\x26\xdb\x87\xca\xea\x24\x1d\x3b\x09\xcd\xc8\x7b\xfc\x8f\x98\x21\xda\xc9\xf2\x31\x43\xa8\x2e\xa6\x94\x9f\x15\xb1\x36\xe6\x44\x3a\xf6\x53\xf4\x53\x28\x13\x92\xd2\x33\xb0\x80\x90\xd2\xbd\xa8\x86\x00\xa0\x02\xa5\x1a\xa5\x0e\x9c\xb6\x72\xbb\xfb\x7b\xc7\x4f\xf0\x39\xd8\x76\x93\x5e\xa2\xbc\x41\x79\xaf\x48\x3e\xa1\x7c\x45\xf9\x81\xf2\x1b\xe5\x1f\xca\xbf\x45\xb6\x45\x94\x15\x45\xf6\x75\x94\x2d\x80\x39\xa5\x38\xa5\x14\xa5\x02\xa5\xda\x36\xe1\xbe\x44\xf1\xbf\x6d\x13\xab\x03\xe5\x97\xbc\x24\x9b\xfc\x14\xd7\x48\xaf\x25\x70\x03\xd7\x6d\xb8\x07\x0f\xe1\x09\x3c\x87\x57\xf0\x16\x3e\xc0\x67\xf8\x66\x3f\xde\x91\x52\xa1\x4e\xac\x1b\x72\xa3\x3d\x98\x6e\xc4\xb3\xa2\xba\x0a\x0d\xe5\xe3\xd2\x6b\xe9\x3c\xef\x8f\xb1\x45\x67\x7b\x2b\x1c\xc1\xbf\xf4\xd9\x80\xcf\xf5\x15\xa8\x18\xca\x85\x9a\x60\x0c\x92\x3e\xde\x27\xf8\x44\x9f\xe2\x79\x3e\xd3\x0f\xf4\x83\xfc\x50\x38\x9c\xbe\x9c\xb5\xbc\x1a\xc1\x51\x03\x09\x0a\x50\x0b\x8d\x51\x15\x4d\xb9\xea\xa3\x39\xfa\xa3\x01\x06\x62\x16\x7a\xe2\x20\xae\x3c\x1c\xc2\x95\x8f\xc3\x70\x14\x0a\x70\x0c\xd7\x2c\x1c\x87\xbb\x70\x20\xee\xe1\xba\x0e\xf7\xe1\x45\x5c\x8f\x97\x2d\xe0\x61\xcb\xb1\xd2\x58\x63\xe5\xac\x19\xb2\xd6\xc2\x5a\x5a\x15\x7e\x4f\x4e\xb4\x6a\x36\x99\xab\x8f\x4d\xe5\xea\x6b\x79\x36\xcb\xfa\xd9\x41\x76\xbe\x0d\xb3\x0b\xed\x52\x3b\xcc\x2e\xe7\x3a\xda\xae\xb2\xeb\xed\x18\xbb\xd1\x6e\xb6\x93\xec\x56\xbb\xcf\x4e\xb5\x07\xec\x57\x7a\xfd\xcd\xf5\xaa\x7a\xff\xab\xe8\x7d\xce\x63\xd7\x8e\x1c\xbe\x97\x6f\x01\xff\xde\xff\xa0\x9e\xf6\x65\x28\xed\x2b\x7c\x05\xaa\xea\xdd\x55\x83\x23\xb9\x27\x0f\x5f\xe5\xab\x50\x2d\xf0\x0b\xd5\x43\xf1\x50\x1c\x35\x42\x2a\xa4\x50\x33\x94\x0d\x65\xc1\x29\x23\xf8\x77\xfe\xa7\x2f\xa7\xe6\x7a\x75\x98\xb2\xe2\x3f\x1d\x78\xac\xd9\x00\x78\xda\x2c\xcc\x53\x76\x20\x51\x10\x00\xd0\x1b\x3b\x63\xab\xc7\xb6\x1d\xdb\x36\xc6\xb6\x11\xdb\xfa\xce\x2a\xb2\x82\x2c\x26\xcb\x88\xfb\xb1\x70\x4f\x89\x40\x62\xc4\x42\x42\xb2\xc8\xac\x9c\xa2\x2a\xa9\xef\xda\xbf\x7d\x70\x43\x34\xac\xac\x48\x45\xa2\x9d\x0e\x3b\xe3\x9e\x2c\x25\xea\x3c\xf1\xce\x0f\x5d\x46\xdc\x10\x9f\xde\x90\x1d\xa8\xc9\x4c\xaf\x0a\xfc\xc9\xac\x58\x7b\xe7\x0b\xd3\x1b\x02\x8b\xc5\xa5\x45\x81\xa5\x8a\xd2\xe2\x20\x22\xb1\x6a\xad\x1b\x71\xa9\xaa\xa2\x30\x88\xa8\x08\xa7\x46\x48\xb2\xcb\x11\x67\xdd\x97\xad\x54\xbd\xa7\xde\xfb\xa9\xdb\xe8\x86\xd8\x22\x52\xb2\xdd\x02\xe7\x5c\xf3\x40\x8e\x32\x0d\x9e\xf9\xe0\x97\x1e\x63\xa1\x89\x92\x62\x8f\xa3\xce\xbb\xee\xa1\x5c\xe5\x1a\x3d\xf7\xd1\x6f\xbd\xc6\x43\x13\x2d\xd5\x5e\xc7\x5c\x70\xc3\x23\x79\x2a\x34\x79\xe1\x93\x3f\xfa\x4c\x84\x26\x66\xed\xee\x73\xdc\x45\x37\x3d\x96\xaf\x52\xb3\x97\x3e\xfb\xab\xdf\x64\x68\x62\x6d\xb5\xdf\x09\x97\xdc\x92\xa6\x40\x95\x16\xaf\x7c\xf1\xcf\x80\xa9\xd0\xc4\xd9\xe6\x80\x93\x2e\xbb\x2d\x5d\xa1\x6a\xad\x5e\xfb\xea\xbf\x41\xd3\xa1\x89\xb7\xdd\x41\xa7\x5c\x71\x47\x86\x22\x35\xda\xbc\xf1\x4d\x87\x21\x33\xa1\x49\xb0\xc3\x21\xa7\x5d\x75\x57\xa6\x62\xb5\xda\xbd\xf5\x5d\xa7\x61\xb3\xe6\xda\xdb\x57\x39\xa2\x07\xd8\x4a\x02\x00\x80\x82\x9b\x76\x71\xb6\x6d\xdb\xb6\x6d\xbb\x36\x3e\xce\xb6\x6d\xdb\xb6\x5d\xdb\xb6\x6d\xfb\x05\x13\x73\xcc\xd5\xba\x5b\x70\x0f\x1e\xc1\x33\x78\x05\xef\xe0\x13\x7c\x83\x5f\xf0\x8f\xde\x7a\x95\x81\xae\x13\x7a\xa0\x1f\x86\x60\x14\x26\x60\x1a\xe6\x60\x51\x95\xa2\xa0\xa7\x67\x61\x2d\xca\x58\x07\x9b\x61\x3b\xec\x86\xfd\x70\x18\x8e\xc3\x69\x38\x4f\xdf\xd2\xca\x42\x5c\x86\x6b\x50\x1f\x4d\xd1\x1a\xb5\xb8\x0d\xf7\xe0\x21\x3c\x61\x68\xbb\x5e\x4f\x3c\x87\x37\xf0\x11\xbe\xc1\x6f\x68\x87\x1e\x18\x84\x31\x98\x66\x6e\xa5\x67\x2e\x16\x60\x59\x95\x92\x88\x35\xb0\x1e\x36\xc1\x56\xd8\xc1\x52\x63\x61\x2b\x75\xc3\x3e\x38\x08\x47\xe0\x38\x9c\x82\xb3\x70\x01\x2e\xc3\x35\x56\xb6\xfa\x96\x92\x3e\x9a\xa3\x1a\xb7\xe1\x3e\x3c\x86\xe7\xf0\x1a\xde\xc3\x67\xaa\xca\x1d\xe9\x1d\x7e\xc1\x5f\x68\x87\x2e\xe8\x85\x01\x18\x86\x31\x98\xa4\x52\xf5\xeb\x2f\x65\x60\x1e\x96\x54\x29\xeb\x60\x35\xac\x83\x8d\xb0\x05\xb6\xc3\x2e\x95\x0e\x90\x7b\xe1\x00\x1c\x86\x63\x70\x12\xce\xc0\x79\xb8\x04\x57\xe1\x86\x4a\x07\xca\xc6\x68\x89\x6a\xdc\x82\xbb\xf0\x00\x1e\xc3\x33\x78\x09\x6f\xa8\x34\x1b\x54\xf2\x3d\x7c\x82\xaf\xf0\x03\x7e\xc3\x3f\xe8\x80\x6e\xe8\x83\x41\x2a\x8d\xb5\x4a\x8e\xc0\x38\x4c\xc1\x2c\x2c\xc0\xb2\x2a\x15\x11\x6b\x60\x3d\x6c\xb2\xc5\xc0\xd6\x4a\x69\x85\x1d\xb0\x1b\xf6\xc1\x41\x38\x02\xc7\xe1\x14\x9c\x85\x0b\x04\x41\xd0\x11\x04\xa1\xa2\xd6\x72\x80\x95\x25\x0d\xa2\xf0\x39\xa7\xc6\x33\xd7\xbe\x83\xb5\x6d\xdb\x46\xb4\xe1\x7a\xa2\x75\xb8\x0c\xd7\x0c\xd6\xf6\x06\x6b\xdb\xde\x7d\xb6\x6d\xdb\xef\x55\xff\xb7\x33\x79\xf6\x4b\x52\xdf\x39\x5d\x5d\x7f\x77\xa5\x9a\xdc\x59\x1a\xa2\x7d\xbb\x29\x08\x06\xc0\x82\x26\x6a\x99\xed\x57\xa2\x71\xbb\x69\x68\x42\x33\x5a\x76\xa9\x23\x0a\x81\xc9\xc0\x7c\x60\x26\x30\x1d\x98\x0a\xcc\x06\xe6\x02\x1b\xb6\x9b\x42\x2b\xda\x76\xa2\x12\x9d\x3b\xc0\xae\x1d\x60\xf7\x0e\xb0\xb8\x03\xac\xdb\x01\xd6\xef\x00\x3b\xb6\x93\x65\x9c\x8c\xb3\x71\x71\xf4\x9f\x11\xfe\x70\xee\xc3\x03\x78\x02\xcf\xe0\x15\xbc\x83\x0f\xf0\x05\x7e\xc0\x1f\xe8\x85\x41\x18\x85\x29\x98\x87\x65\x14\x73\x6c\x66\x37\xf7\xe4\x81\x20\x00\xda\x89\xb0\xa0\x2f\x85\x3b\x31\x81\x49\x58\xc2\x02\x2b\x61\x4b\x5e\xd7\xf3\x5f\x45\x9e\x1c\x67\x5e\xe1\x27\x60\xc8\x7c\x17\xeb\x2f\xb1\xfe\x15\xeb\x1f\xb1\xfe\xb6\xde\xfe\x9f\x62\xfd\x61\xbd\xf5\x7d\x62\xed\x15\xeb\x7f\xb1\xfe\x13\x9f\xf1\x2f\x0e\x8b\xdd\x38\x2e\x88\xbb\x59\xd1\x53\xa3\x4c\xac\xc7\xc6\x7a\x6a\xac\x67\xc6\x7a\x6e\xac\x97\xf6\xa8\x5d\x07\x0b\xfa\x89\xc7\x37\x21\x57\x8a\x33\xaf\x41\xf6\x95\xbd\x57\xcb\xd1\x3e\x0b\x81\xb5\x32\xde\xa9\xbd\x01\xac\xb3\xee\x29\x8f\x5e\x6b\xd5\x7c\xe7\x71\x5f\xad\x86\xf6\x13\x0c\x05\x1c\x8a\xcb\x91\x40\x0e\x8d\x68\x47\xc9\x3a\x20\xfd\x6f\xed\xce\x5e\xd6\xe5\x1c\x68\x9d\xce\xc1\x6b\x55\xb4\x84\x8a\xe6\x50\xd1\x16\x2a\x5a\x43\x85\x21\x83\x7a\xb4\x5a\x11\xd2\x64\xeb\x76\x4e\xb1\xb2\x73\x56\x38\xf7\x35\x1e\xd5\x48\xd7\xea\xe7\x2a\x8f\x2b\x23\x8d\x73\xb2\x0b\x91\xc0\x47\xf8\x86\x2f\xf3\x1d\xc8\x4e\x5e\x6b\x2b\xba\x1b\x32\xce\xa3\x03\x0f\x0d\xdc\x3f\x70\xcf\x98\x05\xc8\xf6\x45\x5e\x59\xe5\x94\x57\x41\x75\xaa\x57\x83\x1a\xd5\xa4\x66\xdd\x0c\x1a\xb0\x3b\xa8\x65\x68\x77\x5f\xbf\x7a\xbc\xfb\x59\x9e\x31\xd0\x32\x51\x66\x9d\x69\xa6\x40\xad\x58\x3b\xa3\xdf\x3c\x56\xad\x93\xf9\xc4\x63\x41\x2d\x43\x4d\x73\x97\xf1\x48\xe8\x1b\x18\xab\x3a\x10\x52\x0e\x9d\x9a\xa8\x49\x9a\xac\x29\x9a\xaa\x69\x9a\xae\x19\x9a\xa9\x59\x9a\x6d\x59\xcb\x59\xde\x0a\x56\x67\xf5\xd6\x60\x8d\xd6\x64\xdd\x56\xb4\x52\x98\xd8\x3b\x48\x72\x9a\x8a\x2a\xa9\xac\x0a\xa4\xb7\x60\x3a\x4a\x0f\xeb\x6f\x48\xcf\xad\xe5\xef\xf3\xba\xa9\xea\x50\xa7\xba\xd4\x0d\xea\x01\xa4\x21\x3d\x84\x23\x39\x9c\x23\xa2\xc9\xb9\x5e\xc2\x11\xd1\xfc\xb8\x88\x8b\xb9\x84\x4b\xb9\x8c\xcb\xb9\x82\x2b\xb9\xca\x8f\x72\xa4\x8e\xd6\x31\x6a\xd1\xcd\xd1\xf1\xb6\xb5\xcf\x70\xbe\x06\xec\x8d\x03\x37\xfa\xe7\xfd\x5c\x78\xce\xc5\xb3\x79\x0a\x52\x9c\xae\x2c\x57\x73\x21\xa7\x71\x2a\x12\x9c\xa1\x9c\xc0\x45\xee\x66\x2a\x2f\x72\xb1\xbb\x59\x2a\x48\x5c\xe2\x6e\xb6\xea\x64\x5c\xea\x6e\x8e\xea\x95\xe0\x32\x77\x73\xd5\xa0\x24\x97\xbb\x9b\xa7\x46\xa5\xb8\xc2\xdd\x7c\x35\x29\xcd\x95\xee\x16\xa8\x59\x19\xae\x82\x54\x54\x87\xb3\xa4\x4e\x18\x17\xaa\xac\x2e\x48\x15\x75\x43\xbe\xe2\x80\x68\x2a\x1e\xa7\x84\x99\x2c\xdc\xc8\x44\x8e\xdc\xf6\x19\x40\x3c\x99\x17\x43\x90\xfe\x55\x6f\x40\x7d\x35\x0c\xc9\xb0\x3d\x5c\x23\x01\x8d\xd6\x04\x18\x84\x74\x6d\xff\x40\x24\x7d\xdf\x70\xe4\xb4\x42\xab\x90\x37\x5a\x02\xf5\x96\xb6\x0c\x9a\xad\xdd\xba\xd0\x0a\x41\x48\x60\x1a\xc0\x51\x1c\x85\xc9\x10\xcc\x3b\x9b\x0d\x78\x27\x4d\xbe\xa2\xdb\xca\x5e\x45\x98\x1f\xad\x7f\xb8\xef\xc8\x2b\x23\x6a\x8f\x88\xa8\x7a\x24\xf0\x01\xbe\xe6\x8b\x7c\x3b\xec\x3d\x19\x72\x8e\xe6\x14\x00\x82\x7a\x9c\x6e\xd5\xad\xc8\x85\x75\x87\x07\xb6\x84\xaa\xd5\xca\x00\xc1\x2d\x64\xb8\xe3\x43\x37\xd3\x83\xde\xcd\xaa\x2b\x71\x87\x47\xd2\x8f\x32\xdd\x6b\x56\x2b\x1b\x7a\xd9\x4d\x77\xe9\x3b\x77\xd2\x21\x3a\xd4\xf5\x48\x5f\x75\x77\xd4\x05\xa6\xf3\x62\x56\xa3\x5e\x04\x51\x92\x29\xa1\xa4\x52\x4a\x2b\xe3\xab\xf6\x88\xea\x75\xb8\x77\xe3\xeb\x7d\x4a\xff\xe9\x7f\xf5\x52\x6f\xf5\x55\x3f\xf5\xd7\x00\x0d\xd4\x70\xad\xd0\x4a\xad\x32\x9a\xcc\x2c\x11\xcd\xca\x27\xd5\x61\x9d\x3e\x2d\xa2\x81\x27\x7b\x27\x63\x38\x96\xe3\x38\x9e\x13\x38\x91\x93\x38\x99\x53\xd6\xea\xed\x80\xd0\xc9\x74\x9e\x1c\xfa\x58\xbd\x91\x2e\xf6\xd8\xd6\xf3\xae\x01\x2e\x75\xb0\x82\x00\x78\xda\x25\xc5\xb1\x01\x01\x31\x00\x00\xc0\xfb\x24\x81\xd6\x04\x99\xe1\x37\x00\x95\x4e\x03\xb0\x82\x3d\x34\x60\x21\xad\x5d\x94\x4a\x00\xd7\x9c\x54\xdd\xaa\xbd\x4c\xd8\x86\x29\x46\xff\xab\xb5\xba\xea\x40\x6e\x03\x85\x26\x2a\x80\xfe\x6c\x3c\x74\xe6\xf5\x88\x8b\x57\x8f\x78\xad\xea\xa2\x3a\x41\x75\x09\x1b\x14\xf1\x0d\x46\x55\x0d\x43\x00\x78\xda\x8c\x87\x03\xac\x18\x5a\x00\x43\xdb\x73\xdf\x37\x66\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x8d\x68\xb6\x6d\x07\xb3\x6d\x44\xb3\x1d\x73\x75\x25\x85\x9f\x26\xba\x2c\x49\x8a\xaf\x20\xff\xf8\xbf\xfb\xad\xa4\xbf\xf4\x46\x41\x3f\x11\xf4\x2b\x08\xa4\x24\x03\x69\xa8\xc5\x48\xd6\x90\x91\xbc\xe4\x23\x11\x1d\x49\x4e\x61\xd2\x92\xd9\x37\x7d\xcb\xb7\x7d\xc7\x77\x7d\xcf\xf7\xfd\xc0\x0f\xfd\x88\x64\x64\xa2\x07\x5d\xe9\x4e\x3a\x56\x2b\x42\x89\x94\x58\xa9\x94\x41\x25\x54\x52\x15\x54\x55\x4d\xd4\x4c\x2d\xd5\x59\x5d\xd5\x4f\x0b\xb5\x58\x4b\xb4\x5c\x5b\xb4\x5b\xe7\x74\x5e\x57\x74\x55\xf7\xc9\x4d\x62\xf2\xb0\x8b\x2c\x21\xe8\xa9\x7f\xf7\x9f\x8e\xe4\x18\xce\xe4\xcc\xce\xe5\xfc\x2e\xef\x4a\xae\xea\x7a\x6e\xe0\x96\x1e\xe3\x71\x1e\xef\x99\x5e\xea\x15\xde\xef\x03\x3e\xea\x63\x3e\x43\x4e\x36\x93\x8b\xbe\x92\xa2\x29\xae\x92\x29\xa3\xfa\x6a\x84\x0e\xe8\x99\x3e\xea\x85\xde\x3b\xd8\xfe\xc7\x31\x9d\xdc\x71\x9d\xc4\x05\x5d\xd2\x85\x5d\xd4\x2d\xdc\xca\xdd\xdd\xc6\x9d\x3d\xdc\x2b\xbd\xde\xab\xbd\x96\xad\x6c\xa2\x3a\x2d\x59\x47\x52\xd6\x7a\x09\x1b\x58\xcf\x46\x3e\x06\xd3\x4d\x09\x34\x8b\xfe\xf4\xa6\x17\x3d\x69\xcd\x70\x46\x30\x94\x21\x12\x03\x7d\xd5\xd7\x24\x47\x78\x28\xe9\x49\x45\x3f\x86\x51\x97\x3e\x0c\xa6\x18\xc5\x49\x41\x65\xc5\x50\x7c\xcd\xd1\x02\x8f\xa6\x00\xf9\x29\x4d\x19\xca\x52\x8e\x2e\x2c\xf6\x49\xdd\x20\x1a\x75\x28\x41\x49\x5f\xf6\x15\xb6\x93\x84\xf2\x94\x62\x94\xfe\x55\x56\xfd\xa6\x3c\xca\xaf\x6a\xaa\xa1\x5a\xaa\xa7\xfe\x1a\x28\x69\x8c\xf6\x68\x9f\x0e\xb9\x40\x50\xf8\x33\xfc\xc6\xa7\x40\xf8\x83\x2f\x21\x22\xfc\xce\x67\x45\xfc\xf5\xaf\xe4\x3f\x2d\xfd\xa1\x3f\x25\xfd\xa7\x4c\x1a\xe8\x2c\x2e\xed\x81\x9e\xe4\xa9\x9e\xed\x25\x5e\xe7\xed\x3e\xee\x87\x7e\x4a\x3c\x32\x90\x8d\x5c\x54\xa0\x0e\xfd\x98\xcd\x5c\x76\xb1\x97\x53\x5c\xff\x4a\x4d\x3d\x00\xdb\x0d\x44\x61\x00\xde\xe4\xa4\xb6\x7d\xe6\x64\xaf\x3a\xb5\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xe3\xce\x7b\xc9\xad\x6d\x63\xbb\x6f\x1f\xeb\x8e\xa7\x7f\x66\xe3\xd5\x17\x80\x01\x51\x21\x06\xc4\x86\x24\xe0\x84\xe4\x90\x06\xd2\x43\x16\xe8\x8c\x91\x30\x06\x26\xc0\x64\x68\xa2\x0b\x53\x62\x5a\xcc\x8a\x39\xb1\x38\x56\xc4\x9a\xd8\x1c\xdb\x62\x4f\x1c\x8c\x63\x71\x22\x4e\xc5\x39\xf8\x81\xa2\x50\x4c\x8a\x47\x89\x09\x89\x93\x9b\xd2\x51\x46\xca\x41\x85\xa8\x1d\x75\xa7\x9e\xd4\x97\x86\xd3\x7c\x5a\x44\x4b\x69\x39\xad\xa6\x0d\xb4\x99\x76\xd0\x6e\x33\x8e\x19\xdf\xe4\xa6\xd3\x74\x9b\x69\xcc\xe9\xe6\x5d\xf3\x21\xd7\x79\x78\x1e\x91\xc7\xe0\xb1\x79\x3c\x9e\x80\x27\xe6\xc8\x53\xf2\x62\xbc\x1e\x6f\xcc\x27\x3b\x74\x47\x42\x87\xc7\x91\xd2\x31\xd2\x71\xc6\xe9\x70\x1d\x75\x9d\x74\x9d\x75\xe7\x74\xd7\x73\x37\x74\x37\xf1\xc4\xf3\x24\x4c\x19\x21\xe5\xe4\x34\xb1\x3f\xe9\xe2\x8b\x10\x8c\x29\x09\x62\xe9\xd8\x3c\x2d\x93\x56\x22\x48\x62\x9e\xb6\x56\xdb\x26\xdf\xa0\xbb\xda\x43\xed\x63\xc0\xdb\xfd\x9d\xc4\x76\x7d\x8f\x7e\x44\xbf\x26\x95\xc3\x4b\x89\x58\x52\xc2\x01\x6e\x25\x91\x59\x4a\x44\xc4\xa8\x18\x17\x93\x22\xa1\x13\x53\x60\x1a\xcc\x82\x39\xb0\x00\x96\xc2\x1a\x58\x07\x5b\x61\x37\xec\x87\x63\x70\x82\x92\x98\x47\x11\xa4\x44\x6c\x4a\x48\x49\x89\x94\x44\x06\xca\xae\x24\x3a\x29\x89\x7e\x52\x62\x21\x2d\x91\x12\x2b\x69\xbd\x94\xd8\x4e\xbb\xbe\x93\x18\x27\x25\xee\x49\x89\x70\x4a\x22\x96\x92\x48\x14\x22\xd1\x28\x48\xc2\x15\x22\x71\xc4\x75\x42\x4a\x64\x57\x12\x8d\x3d\x71\x3c\x09\x52\x86\x53\x12\x9a\x92\xd0\xc4\x1b\x16\x12\xe1\x66\xc7\xbe\x9e\x66\x3f\x45\xc4\x17\xc5\xbe\x5e\x12\x09\xbe\xb6\xfd\x3a\xfe\xbb\xf3\xc7\xc5\x31\xb1\x4f\x6c\x17\xdb\xc4\x46\xb1\x5e\xac\x11\x95\xd9\x3f\xe5\x56\x4c\x5f\x7c\x26\xe3\x4b\x2f\x4b\x06\x26\x63\x0b\x5f\x55\xdf\x64\x5f\x54\xfb\xac\x3d\x5d\x2e\x2f\xed\x3a\x76\x4b\x7b\x93\x3c\xbf\xd3\xde\xa3\xae\xcf\x51\xeb\xc5\xf6\x22\x7b\xbc\xb5\xc3\xae\x6e\x8f\x66\xcc\x5a\x2f\xcb\x40\x59\x1a\x59\x1d\xe4\xba\x8a\x55\x41\xae\xeb\x58\x15\xad\xf4\x56\x1c\xc6\xfc\x5f\xf9\xef\xf6\x5f\x2d\xb7\x53\xfd\x33\xfa\xbd\xf7\x9b\xe1\xbd\xc6\x98\xf7\xb4\xb7\x99\xb7\x90\xb7\x80\x37\xff\xcd\xc9\x37\x27\x31\x76\xd5\xb8\xa4\xc6\xc1\x34\xb5\x32\x34\xae\xed\xd1\x2d\x88\xc8\x54\xa0\x05\xb4\x82\x36\xd0\x0e\x3a\x41\x17\xe8\x06\x3b\x60\x1f\x0b\x93\x80\x63\x38\x00\x67\xe0\x1a\x58\xec\xa7\xc0\x0d\xf0\x82\x0f\xee\xc3\x43\x78\x0c\x4f\xe1\x39\xbc\x84\xd7\xf0\xd6\x30\x8c\xd8\x46\x5c\x23\x85\x91\xce\xc8\x62\xe4\x33\x0a\x30\x19\xb9\x7d\x29\xd7\xef\x65\xf9\x1c\x8e\xfd\x3a\xc6\x53\xb5\x7e\x6c\x3c\x97\xeb\xe7\xc6\xcb\xef\xae\xdd\x0f\xae\xad\x8e\x3e\xaa\xf5\xfb\x90\xab\x2f\x43\x5a\xf8\xae\xc6\x77\x2d\x78\x03\xd7\x86\xdf\xff\xfe\xbf\xd7\xcb\xe9\x1e\xf5\xb7\xae\xa2\x37\xd3\xb7\xe8\xeb\xe0\x5b\x8b\xe5\x80\x40\x85\x02\x00\xc1\xd9\xcd\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xb6\xfb\x66\xb6\xdd\xab\x43\x0c\xe4\x4d\xba\xe3\x22\xee\xe3\xc2\xde\xfc\xcd\x8d\x5b\xdd\xd3\x61\x1c\xd6\x1f\x3d\xd4\x1b\x9c\xc8\x5f\x1c\x5a\x77\x5d\xd4\xd1\x1c\xd5\xd1\x9d\x96\x68\x04\x23\x2c\x71\x03\xd5\x88\x47\x12\xf2\x92\x9e\xcc\xe4\xa2\xce\x4f\xe7\xa6\xa4\x37\xa3\x02\xde\x9d\xc5\x30\x77\x64\x06\x47\x03\xf6\x3d\xcc\x5d\x16\x68\x11\x1f\x79\xc8\x0b\x05\xe1\xbd\xa4\xa0\x0a\xa3\xa4\x8a\x1e\x28\x4c\x71\xe5\x0f\x14\x26\xa2\x9a\xa9\xab\x5a\xa8\x95\x86\xaa\xa3\x3b\x69\xb0\x36\x69\xcd\xf7\xce\xe8\x94\x46\xeb\x0c\x31\xf9\x42\x70\x1e\x11\x4b\x90\x48\xa1\x48\xa6\x70\x24\x57\x78\x12\x2b\x34\xa9\x14\x89\xd4\x8a\x4c\x01\xa5\x20\x83\x62\x90\x5b\x89\x29\xac\xd4\x64\x54\x4c\x4a\x29\x0b\x25\x94\x89\x32\xca\x46\x69\x65\xa5\xa2\x72\x53\x5e\x39\x69\xa8\x32\xd4\x57\x29\xaa\xaa\x00\x0d\x54\x9a\x5a\xca\x47\x63\x95\xa7\xa9\x2a\xaa\x12\x2d\x55\x85\xd6\xaa\x46\x2b\x55\xa5\x8d\xaa\xd3\x41\xb5\xe9\xa2\xfa\x74\x53\x43\xba\xaa\x01\x3d\xd5\x84\x29\xea\x4f\x3f\xb5\x64\x92\xfa\x7e\xef\x15\x8b\x35\x8e\xa5\x9a\xc0\x12\x8d\x67\xb5\x66\xb0\x41\x73\x59\xaf\x39\xac\xd5\x2c\x76\x69\x19\x3b\xb5\x94\x1d\x5a\xc2\x65\x1d\xe0\xbc\xf6\x70\x40\x6b\xb9\xa4\xfd\x9c\xd5\x2e\x2e\x6a\x1f\x37\x74\x94\x5f\x74\x9a\x5f\xf9\x57\x57\xf8\x5f\xd7\xf8\x4f\x57\x19\xa3\xee\x1c\xd7\x16\x8a\x2a\x3d\x2d\x68\xa7\x9a\x94\x54\x66\xa6\x6b\x10\x71\x64\xe2\x2b\x18\x33\x35\x84\xc8\xbc\x23\x06\x9f\xc9\xa9\x84\xe4\x57\x72\xaa\xab\x10\xf5\x54\x92\xa1\xea\xc0\x64\xf5\x63\x99\x26\xb2\x42\x53\x38\xa4\xf5\x9c\xd3\x6e\x36\x6a\x1e\xbb\xb5\x9c\xe1\xea\xc4\x5c\x8d\x60\x84\x3a\xf3\x97\x2e\xfa\xb5\x5f\xf8\xb9\x9f\xf9\xad\xdf\x04\xc1\x9f\xfd\xc1\x2f\xfd\xce\xaf\xfc\xfe\x47\x3b\xbf\xf9\x3d\x64\x90\xa0\x3e\xe7\xf3\xbe\xe4\x6b\xbe\xee\x2b\x3e\xeb\x33\xbe\xe1\xab\xbe\xe0\xcb\x24\x55\x58\xd2\x28\x0a\x69\x15\x95\x42\x4a\x45\x41\xa5\x24\x93\x62\x51\x56\xd9\xa9\xa4\x3c\x54\x50\x2e\x6a\xaa\x08\x6d\x55\x83\xf6\xaa\x45\x27\xd5\xa5\xbb\x1a\xd1\x43\x8d\xe9\xa5\xa6\xcc\xd6\x30\xe6\x68\x38\xd3\x34\x90\xa9\x1a\xc0\x72\x4d\x62\xa5\xa6\xb2\x49\xf3\xd9\xac\x05\xac\xd1\x4c\xd6\x69\x36\x5b\xb4\x90\x3d\x5a\xc9\x5e\xad\xe2\x8a\x0e\x72\x41\x7b\xb9\xa5\xe3\x5c\xd7\x11\x6e\xea\x18\xbf\xe9\x2c\xf7\x74\x9d\xa8\x7c\x20\x3a\x9f\x08\xc7\x4b\x22\xf0\x9a\x88\xbc\x21\x12\x6f\x09\xcf\x2b\x42\xf0\x98\x50\x3c\x25\x34\xcf\x08\xc3\x73\x42\xf2\x84\x3c\x4a\x42\x3e\x25\xa3\x88\xd2\x92\x45\x71\xc8\xa6\x78\x64\x57\x7c\x72\x28\x01\x59\x15\x97\xba\x2a\x41\x6d\x15\x63\xa4\xba\x30\x5a\xdd\x18\xa0\xd6\x0c\x52\x5b\x06\xab\x1d\x43\xd4\x9e\x81\x6a\xc3\x58\xf5\x60\xbc\x7a\x31\x41\xbd\x99\xa8\x3e\x8c\x53\x4f\x8e\x68\x23\xc7\xb4\x99\x13\xda\xca\x29\x6d\xe7\xb4\x76\x70\x46\x3b\x39\xa9\x6d\xfc\xa1\xf3\xfc\xae\x73\xfc\xa9\x0b\xfc\xad\x4b\x2e\xe7\x0a\xae\xe4\xf2\xae\xec\xfc\x81\x03\x2a\x10\x78\xa0\x12\xde\xe1\x5d\x4e\xec\x3a\xae\xef\x9a\xae\xe5\x7a\x6e\xe2\xba\x6e\xe6\x06\x6e\xe8\xa6\x9e\xe8\xd1\x1e\xeb\xf1\x9e\xec\x29\x9e\xe4\x31\x1e\xe7\x09\x9e\xea\x69\x9e\xee\x83\xee\xe7\x81\x1e\xec\x0e\x6e\xed\x21\x81\x1b\x3b\xea\x23\x3e\xe4\x85\x9e\xe7\x39\xdf\xdb\xfd\xfd\xa4\x82\xc4\xff\x0a\x0d\x5d\x92\xcf\x00\x78\xda\x63\x20\x04\x7c\x80\xd0\x86\xc1\x86\xa9\x80\x81\x81\x69\x0a\xe3\x49\x06\x86\xff\x91\xcc\x6f\xfe\x9d\x63\x2a\xfb\xff\x0d\xc8\xbf\xf2\xff\xdb\xff\x20\x10\x1f\xa6\x8e\xb1\x16\x55\x1d\x63\xcb\xff\xdf\x10\x75\x10\x3e\x92\x79\x7f\x51\xcc\x6b\x60\xf8\x0e\x55\x17\x02\xe2\x03\x00\xce\x87\x32\x9b\x00\x00\x78\xda\xac\x55\xd7\x76\xdb\x46\x10\x5d\xb0\xa9\xf7\x96\x18\x29\x03\xaf\xa9\x28\xc2\x82\xee\xb6\x7a\x01\x44\xd1\xdd\xaa\xe7\x00\xa9\x80\xda\x7b\xf2\x94\x5f\x08\xbf\x66\xa0\xf4\xb7\x7c\x5a\xee\x00\xa4\x7a\x49\xb3\x25\x0d\x76\xfa\xbd\x33\x58\xb0\x32\xc4\x6a\x37\xac\x47\x44\xaf\x7f\x57\xfd\x9b\xaf\xb9\xb2\xfd\x45\xc8\x4f\x6c\x9e\x8a\xe2\x23\x6a\xee\x86\x5c\xa8\x26\x7f\x74\xaa\x4e\xb5\xbf\xaf\xf7\x6c\xc7\x61\x15\xb1\x0a\xf4\xda\xb1\xb2\x54\x10\xfb\x1e\x5b\x86\x29\x3e\xf2\xb8\x60\xe8\x80\xf8\xcf\x0d\x2e\x4d\x7e\x71\x3c\x65\x75\x07\xf5\xfd\x3a\x57\xea\xa1\xc3\xc5\x6a\xb4\xf5\x65\xe8\x68\xc7\x6e\x86\xc4\x1b\x1b\x50\xad\x44\x36\xf1\x8c\x3c\xcd\x44\x11\xa5\xb9\x77\x72\xc0\x53\x50\xb5\x4e\xc4\x0f\xc4\xfe\x00\x9e\xc8\x1a\x12\xba\x69\x26\xc4\xdd\x1b\x61\x0c\x0d\x89\xad\x5b\x9e\x9e\xc9\xd3\xb3\xd8\x8e\xa3\x28\xb2\xd9\x72\xa3\x48\xb3\xda\x08\x0f\xa3\xc8\xe3\xa2\x21\xe4\x29\x55\x13\x74\x56\x0e\x36\x42\x2e\x6b\x9f\x2b\xda\x07\x8e\x88\xad\xd8\xe3\x92\xd1\xe8\x8b\x0e\xd2\xf2\x9e\x4f\x62\x91\x8e\xed\xbc\x03\xf9\xcb\xc5\xb8\xbe\xcf\xc5\x69\x07\xc6\x80\x9a\xd4\x44\x81\xf4\x41\xb9\x0a\x90\x9b\x61\xbc\x61\x27\x5b\x51\xa8\x23\x27\x22\x5e\xd9\x0e\x61\xb3\x01\xad\x5d\xdf\xe3\xb2\xe1\x8e\xc0\x3d\x56\x85\x9c\xa9\x0a\x8e\xda\xd7\x60\x5c\xfb\x09\x17\xf6\x8e\xd8\xda\x47\x17\x5c\x9e\xf6\xb8\xc3\x90\xb4\xda\x13\xec\xff\x5e\x52\x7b\x24\x19\x78\x25\x8e\xc4\x25\x5e\xcb\x5a\xed\x34\xc7\x1d\x3d\x2a\xa8\xfb\xd3\xce\x09\xf7\x5d\xe6\xfc\x2c\xba\xf3\x2c\x96\xab\xa1\x07\xee\x98\xea\x4d\x9d\xd0\x41\x8b\x2f\x65\x0b\xa7\x4c\x36\xaf\x9c\xb0\x84\xe9\xe8\x64\x2d\x2f\xd1\x73\x4d\x38\xdf\x43\x94\x42\xd4\x55\x41\xbd\x26\x03\x74\xdc\xd3\x5d\xc4\xb0\x6d\xed\x44\xd3\x8e\xc7\x7d\x26\x2d\x14\xea\x7c\x90\xac\x79\xdc\x6f\xe0\x48\xc4\xbd\xc1\x2b\x09\xc7\x83\xf6\x23\xee\x93\xd3\x16\x4e\x7d\x38\x79\x3c\x80\x34\x83\x19\x25\x04\x06\xf6\x51\x97\xfb\x83\x98\x9a\x31\x71\x3f\x48\xf3\x78\xd0\xbc\xde\x09\xd3\xd2\xc1\x5a\x74\x8f\xfb\x0e\xf5\x0f\x1e\x0f\x99\xd7\x9b\xe1\xeb\xed\x5c\x69\x3b\xd0\x8f\x64\xfa\x61\x93\xaa\x81\x60\x37\x4c\x07\x06\x02\xb6\x12\x9f\x07\x5c\xd9\x59\x6c\xb2\x9f\xf6\xca\x9f\x3e\xfc\x61\x6b\x5c\x13\x70\x6c\x84\xa9\x90\x07\xb4\x7e\xb3\x49\x59\xd9\x69\x47\x23\xac\xfd\x6c\xe7\x76\x84\xe0\x37\xd3\x44\x40\xd2\x40\xff\x0d\x68\xcf\x8f\xea\x9a\x01\xa6\x4a\x8d\x68\xb0\x15\xb0\x5a\x3a\xb6\x2c\x2b\x9b\xd5\x88\x51\xa9\x2a\xd4\x77\x42\x1e\xd0\x3e\xd5\xb9\x47\xfb\xdc\xad\xb1\x70\x3e\xc5\x28\xff\xcb\xd0\x90\xa5\xfa\x95\xef\x37\xe3\x74\xb8\xe2\xf2\x8f\xae\x7d\x17\x34\x8d\x02\xdb\x88\xeb\xf1\x98\x49\x2d\x91\xe3\xe0\x59\xe4\x84\x49\x8b\x22\x3f\x30\x69\x49\xe4\x87\x26\x2d\x8b\xbc\x63\xd2\x8a\x48\xdb\xa4\x1d\x22\x3f\x32\x69\xa7\xc8\x8f\x4d\xda\x25\xf2\x73\xa3\xdb\xbc\x73\x25\x06\xc3\x9a\x6a\x6c\x7d\x2d\x2f\x88\xc7\xd3\x67\x8c\xe3\x27\xc6\xef\x72\xa3\x7b\xc6\x38\x79\x62\xfc\x3e\x37\x7e\x62\x14\xf7\xb9\xff\x02\xdf\xa7\xc0\xf7\x09\xfa\x22\xe0\x13\xe9\x00\x9f\xc8\xbb\xc0\x27\x52\x03\x9f\xc8\x7b\xc0\x27\xb2\x0a\x7c\x22\x27\x81\x4f\xe4\x67\xc0\x27\x72\x0a\xf8\x44\x1a\x43\x0b\xd9\x9a\x7a\x06\x65\x87\x62\x0a\x34\x8e\x81\x8c\x12\x92\x8d\xec\x6a\xcd\xb0\xe7\xb2\x87\xb7\xf0\xbe\x21\x6a\xd0\x35\x53\xd4\xc9\x8c\x96\x1b\xf1\x46\x0f\x5b\xd0\x3f\x38\x19\xad\x35\xce\xf7\xa7\xd3\xb2\x35\x56\x0f\x1f\x44\x19\xc0\x87\x39\x33\xd7\x99\x1f\x19\x7a\x9a\xf5\xfb\x18\x7e\x56\xfd\x72\x11\xb6\x5c\x14\xbf\x5a\xaf\xc6\x7f\x56\xf2\x6f\x6d\x49\xcf\xa4\x8f\xac\x31\x01\xf7\x04\x04\x50\xe3\x9a\x86\x59\x05\xc9\x8c\xc7\x4f\x4d\x6d\x62\xc1\xe3\x67\xb7\xb9\x62\x83\xf7\xe1\xfe\x1c\x33\x51\xe3\x55\xaa\x51\x43\xde\x7c\x70\xf9\xb2\xd9\x6c\xe8\x86\x4e\x28\xdc\x03\x01\x6c\xe1\x3a\x78\x66\x59\x63\xa3\xa0\x74\xc6\xa0\x2d\x1e\x84\x5b\xa9\x8a\x9f\xcc\x8d\xbb\x02\xf7\xb0\x59\xd3\x44\x0b\x4d\xe4\x9b\x3d\xef\x42\xb5\x3c\x17\x97\xa0\x81\x27\x71\x2c\x97\xc6\xca\x66\xf8\x53\x81\x8a\x64\xff\x54\x98\x2c\xde\x89\x7c\xb9\x48\x3b\x71\x27\xeb\xcc\x5b\xaf\xe3\x15\xc6\x58\x2f\x74\x4d\xa0\x25\xff\x6e\x14\x82\xf8\x00\xdb\x17\x24\x07\x1b\x21\x0e\x89\x8d\xe7\x58\x2e\xb2\x8b\x31\x89\x26\x94\x9e\xd4\xeb\x18\xa6\x46\x85\x75\xe0\x83\xc8\xaa\xc4\x74\x55\x11\x2d\x55\x34\x2c\x31\x24\x97\xb1\x59\xe5\x4b\x59\x91\x51\x10\x55\xb3\x26\xf0\x77\x23\xbf\x2a\x4f\x6b\x61\xf6\x73\x6d\x1e\x08\xda\xf2\x64\x8b\x07\xbd\x00\x8a\xe6\xdb\x26\x60\xce\xec\xeb\xba\x21\x45\x65\x7a\x0b\x6d\x5b\x06\xa6\xc5\xb0\xda\x09\x6b\xb4\xa0\x1d\x1b\x9a\xb6\x12\x49\x4f\x47\x50\xa9\xe2\xf4\xf2\xec\xd7\x3d\x1f\xde\x55\x6b\xdd\x9a\x94\x96\xdd\x5e\x3c\xd3\x49\xd0\x1e\x55\xcc\x14\x1f\x5d\x84\xdc\x1e\xef\x92\xd1\x54\x13\x16\xd7\x79\x30\x08\x37\x6c\x7c\x32\x69\x21\xaa\xa5\x35\x6b\xd4\xf5\x78\xf9\x9c\x75\xcb\xde\x38\x67\x5d\xb9\x32\xf6\xa6\x88\x55\xc3\x33\xee\x4d\x05\x7d\xc3\xb3\x6e\x13\xbd\xc9\x7e\x01\xd4\xb5\xae\x18\x68\x8d\x6b\x88\x08\x04\x72\xb6\x9b\x98\xc9\xc9\x02\x6b\x5a\xa0\x9a\x9e\x69\x65\x5d\xc3\xbd\x83\x4f\x08\x1c\xff\xe9\x12\x37\xfe\xaf\xbd\x15\x14\x72\x45\x2d\x68\xdc\x42\x67\x36\xc4\x89\x5a\x3d\xd6\x4d\xaa\x66\xdc\x36\x0f\xeb\x38\xcd\xba\x8e\x6e\x31\xa1\x67\xce\x83\x6e\x00\xf4\x58\xfe\x82\x1f\x2b\x79\x97\x47\x6a\xfc\x04\xef\xf3\x8b\x6b\xf4\x2f\x91\xce\x1a\x1d\xe1\xa7\x78\x7e\x65\xf8\x39\xc4\x6b\xe1\xad\x0e\x82\xe9\xaf\x1e\xeb\x02\xa9\x81\x20\x08\xa0\xe8\x1a\x76\x0f\x4a\x19\x2e\x80\xbb\xbb\xbb\x07\x77\x0d\xee\x7a\x99\xd8\x05\xe9\xdf\x3d\xd9\xd2\xd7\xd4\x1f\x21\xde\xf3\x27\xf7\xf0\xe7\x0e\x39\x5e\xc2\xf9\x41\xe1\xb0\x2b\x06\x41\x97\x60\x44\x10\x82\x51\x57\x0c\x03\x30\x26\x08\xc1\x38\x4d\xb7\x60\x82\x06\x4c\xd2\x80\x29\x1a\x30\xed\x4a\x41\x10\xb4\x8b\x66\x44\xa1\x6a\xd6\x95\x42\xfb\xdb\x9c\x28\x54\xcd\xd3\x85\x68\x81\x4e\xb5\x48\xa7\x5a\xa2\x53\x2d\x73\x66\xa7\x60\x45\x10\x82\x55\xce\x04\x6b\x82\x10\xac\xd3\xf4\x08\x36\x68\xc0\x26\x0d\xd8\xa2\x01\x19\xbd\x57\x87\x68\x5b\x14\xaa\x76\x38\x4f\xb5\x2b\x0a\x55\x7b\x7a\x2f\xb4\x4f\xa7\x3a\xa0\x53\x1d\xd2\xa9\x8e\xe4\x31\x6e\x48\x9f\xc0\x63\x9d\xf2\x2d\xc2\x13\x63\xab\xf0\x94\x07\x5d\xa7\x36\x99\xce\x5c\x21\xf4\xcd\xb9\x91\xe6\x42\x9b\xd0\x37\x97\xb2\xb8\x31\xdd\xf5\x8a\xc9\x56\x5c\x1b\x59\x71\x63\x24\xcf\xca\x3e\x3e\xb8\x35\x12\xdc\x19\x09\xee\xa5\x6d\x4a\xf7\x7b\x60\xb2\xfc\xd1\x48\xfe\x64\x24\x7f\x96\x95\x3e\x78\x31\x12\xbc\x1a\x09\xde\xa4\x6d\x4e\xf7\x7b\x67\xb2\xfc\xc3\x48\xfe\x69\x24\xff\x92\x95\x3e\xf8\x36\x12\xfc\x18\x09\x7e\x5d\xa9\x26\x89\xca\x3f\x56\x3b\xea\xf2\xd5\x99\x7c\x5c\x3b\x96\x2d\x7f\x0f\xd7\xff\x03\xa1\x67\xba\x32\x00\x00\x00\x01\x00\x01\xff\xff\x00\x0f\x78\xda\xd4\x7d\x07\x7c\x9c\xc5\xb5\xef\x39\xf3\xb5\x5d\x49\x96\xb6\xef\x4a\xab\xd5\x4a\xfb\x6d\x51\x6f\x2b\x69\xd5\xb5\x96\x65\x55\xdb\xb2\x8d\x6d\x5c\x30\x12\x18\xd9\x80\x71\x6c\x7a\x09\x8f\x9e\x46\xe7\x85\x66\xd2\x73\xb9\x24\x90\x38\xd7\x24\x17\x87\xbc\x94\x0b\x69\xc4\x89\x4d\xc7\x84\xc4\x21\x2f\x85\x12\x83\x09\xb9\x94\x94\x1b\xaf\xde\xcc\x7c\xdf\x8e\xbe\xf5\x4a\x76\xb8\xaf\x13\xc0\xfc\xfe\x7b\x66\xe6\xcc\x39\x67\xce\x9c\x39\x73\xe6\x0b\x10\x70\x00\xe0\xef\xc9\x15\xa0\x41\x01\xc4\xd2\x91\x02\x85\x48\xa3\x20\x51\x50\x82\x19\x99\x10\x44\x2f\x2e\x03\xb0\xdb\x28\x81\xe6\x74\x2a\x9a\xaf\xae\x2a\xa1\x6b\xba\x3b\xe9\x8e\x25\x13\xf8\xe9\x81\xfd\x03\x4b\x5f\xb5\xbd\x9a\xf9\xc1\xfd\xab\xf7\xed\x23\x57\x1c\xfb\xe8\x9b\x6f\x01\xc0\xec\xac\xd1\xaf\xe4\x87\x08\xc8\x14\x90\xc8\xeb\xf8\x1b\x0b\xee\x16\xf8\x9b\xf8\xae\x05\xff\xa8\x89\xeb\x06\x8e\x1a\x6d\x37\x0b\x3b\xc1\xf6\xb0\x84\x7b\x77\xee\x4d\xd6\x19\xb4\xb3\xd3\xac\x0f\x41\x7b\x98\xd2\xe4\xf7\xf5\xed\xbc\xbe\x5e\xc3\x7f\x85\xfb\x8d\xbe\xee\xe7\x7d\x09\xda\x6f\xe6\xd1\xbe\x88\x7f\x85\x3f\x1b\xb4\x7f\xce\xa5\xbd\x33\x8f\xf6\x6d\x7c\xc5\x4a\x3b\xef\xfc\x8f\xcc\xf1\x46\xca\xa4\x6b\xb3\x7d\x50\xfc\x1d\x63\x9e\xe4\x0b\x70\xae\xd1\xc7\xb9\xb9\xf3\xf4\xe7\xcd\xf3\x48\xce\x3c\xbf\x60\xe9\xcb\x9c\x27\xfd\xf3\x46\xa3\xaf\x1b\x73\xf9\xf9\xa2\x85\xf6\x6d\x63\x9e\xe4\x12\xf8\xad\x41\xfb\xdb\x5c\xda\x7b\xe6\x68\xc5\x3c\x8f\xc0\xdf\x0c\xda\xbf\xe5\xd2\xae\x34\x69\x13\xe4\x20\xde\x0f\x0f\x50\xda\x83\x64\x08\x1e\x00\xc7\xc3\x04\xf7\x3e\x40\x69\x59\x9b\x07\x72\xdb\x78\x85\x6c\x5e\xc1\x77\xc4\x7c\xa9\x2d\x0a\x9c\xcf\x37\x5f\x96\x4c\x8f\x16\x7c\xab\x81\xf3\xb9\x48\xf0\x00\xe5\x8f\xe4\x8d\x55\x2b\x68\x5e\xc2\x37\x17\xa0\x71\x0b\x9a\x43\xf8\x6e\x2e\x0d\x10\xca\x5b\x88\xf3\xe6\x01\x3f\xd4\xa4\xe3\x45\x88\x80\xe3\x7e\x27\x5d\x2d\xaa\x42\x70\x24\xbb\x64\x08\xf2\x15\xe3\x74\x3b\x43\xb2\x16\xa8\x93\x55\xaf\xc7\xe7\xd7\xe2\xf1\xf6\xb6\x8e\x94\xb9\x6e\x74\xb6\x70\x1e\xaa\x0f\x87\x1d\xc9\xbe\x96\x26\x73\x01\x79\x32\x2f\xde\xbf\xba\x62\x30\xe8\xab\x90\x9b\xb5\xc6\x98\x3e\x52\xf9\x48\x76\x39\x49\x26\x7f\x41\x70\x41\x05\x54\x41\x4b\xba\xb1\xca\x21\x4b\xaa\x8c\xe3\x40\x40\x91\x89\xb2\x0d\x54\x4a\xa1\xc2\x56\x90\x65\xdf\x84\x64\xb0\xe0\x8e\x46\x7c\x71\xa7\x43\xd3\x82\x75\x31\x36\x30\xe7\x21\xd9\xea\xf3\x7a\x54\xcd\xad\x13\x5f\xb2\xb5\xa3\xbd\x2d\xae\x47\x54\xaf\x44\xf9\xd9\x4c\xd9\xf8\x8d\xde\x9c\x8a\xc5\x3a\x9a\xf4\xdf\x8c\xfe\xac\xd4\xe9\x0c\x04\x9c\xae\x40\x2d\x5f\xd7\xdb\xe5\xd6\x48\xb4\xad\x2d\x56\xd5\x2a\x1f\xbb\x8b\xec\x2d\x2b\x73\x7b\xca\xca\x3c\x99\x1a\xb6\xd6\x41\x36\xf9\xfb\x01\x48\xe0\xa3\x32\x8c\x41\x2a\xdd\x16\xf3\xd8\x38\x87\x12\x41\x89\x8c\x9e\x9c\x4f\x0f\xe3\x13\xb5\x44\xca\x7d\x72\x5e\x37\x3e\xe8\xbf\xb7\x6e\x61\x7e\xa5\xff\xd2\x57\xbb\xe1\xef\xef\x9c\x88\x6b\xa1\xf3\x90\xb0\xa9\xb7\xf0\x1d\xae\xe7\x12\x8a\xff\x91\xec\xa2\x7f\xba\x61\x20\xdd\x4b\x11\xc9\x46\xa4\x19\xb0\x81\x2a\xdb\xd4\x19\x3b\x22\xc2\x66\x4a\xe9\x99\xa0\x93\x50\x36\x17\x68\x44\x51\xbc\xca\x32\x00\xb7\xcb\xe9\xa0\xad\x4a\x9c\xc6\x5f\x85\x74\x3e\x49\x77\x95\x57\xf7\x26\x8d\x7f\xda\x75\x2d\x95\x74\xea\x25\x3f\xc4\xf2\xaf\xdc\x71\xc7\x83\xaf\x5e\xd7\x31\x74\x51\x21\xd9\xd5\x7f\x30\xfd\x5a\xff\x83\x0f\xfe\x0e\x27\xb9\xdf\x34\xc6\xe7\xb6\x1e\x32\xfd\xe6\xbb\xec\x4f\x68\xa6\xf8\x7e\xb2\x13\x8a\xc1\x47\x7f\xe9\x4b\x77\x6b\xa8\x10\x1c\x57\xd1\x10\xed\x0c\x48\x92\xc9\x97\x86\xb2\x8c\x9b\x81\x0b\x37\xe0\x77\x94\x94\x97\xf9\x43\x81\x90\xd7\x5d\xe2\x73\xf8\x6a\x2b\x6d\xd4\x83\xbb\x4d\xd1\xfa\xd8\xbf\x75\xdd\xdd\xca\x84\xab\x7b\xd9\x9f\xf4\x8f\xe6\x87\xcf\xde\xd6\x52\xd7\xb6\xf8\xa2\x8b\xf7\x7e\xe9\x93\x83\xc3\x3b\x2e\xfd\xe4\xfd\x5b\xc9\xce\x55\xc3\x8d\xcb\x4b\x5c\x1b\xda\x56\x6f\xc6\x1d\x5b\x1a\x52\x4f\x64\x9e\xbe\xe4\xea\xf7\x40\x82\x9a\xd9\xf7\xf1\x05\x72\x11\x84\xa9\xde\xab\x99\x6d\xc6\xa2\xba\xcb\x29\x11\x4d\x22\xcc\x3e\x09\x4e\x01\x62\xf9\x84\x8c\x94\xbf\x29\x00\xca\x3b\x95\x15\xa5\xae\xa8\x8e\xc7\x14\xcd\x5f\x17\xed\x48\xf9\x55\x55\x8f\x70\x95\x33\x9d\xfb\xb5\x48\x5b\x4b\x9c\x2b\xdb\xe3\xf3\x25\x5d\x2d\xad\x7e\x2d\xe1\x4b\xa4\x70\x60\xc8\xdb\x16\x5e\xd3\x3b\xbc\x63\xf1\xf0\x79\xfd\x83\x67\x94\xf6\x62\x59\x3a\x31\xb9\xa9\x71\xb2\xf9\x62\xa2\x6a\x92\x43\x25\xad\x35\x7a\x24\x99\x5c\x7a\xeb\xf6\x0b\x6f\xeb\x1f\xec\x2a\xc7\xb6\xda\x4f\x5c\xb1\xfc\xb2\x41\xdc\xab\x4a\x5a\x91\x0d\xb9\x7c\x39\xbf\x5c\xbe\x95\xa6\x7c\xdf\x9b\x17\x7f\x83\xd8\x00\xf8\xfc\x7a\xf8\xfc\x5a\x20\x05\xdd\xf0\xaf\xe9\x40\xaa\xa3\x3d\xaa\xd3\xf9\xa9\x8a\x86\x44\x72\x20\x92\x12\x04\x94\xc7\x27\xf6\x3a\x56\xae\x4f\x27\x80\x48\x28\x51\xfb\x51\x14\x79\x0a\x64\xb9\x9c\xaa\x43\x55\xa5\x29\x90\xa4\xd0\x04\x20\x72\x19\x54\xc1\xb2\xe0\xc4\x5e\x3f\x25\xaf\x36\xc8\xf1\xaa\x93\xd2\xa7\x63\x82\x14\x01\x10\xb6\xcc\xd7\x42\x5a\xb6\x61\x43\x9a\x5a\x10\xe5\xb6\xb9\x3a\xe6\xd4\xf5\x7a\x9b\x56\x66\x8a\x58\x33\xd5\x1d\x49\xa4\x7c\x49\x43\xe3\xa9\x78\x3c\xf1\x0f\x08\x3d\x38\xd2\xa2\xd4\xd6\xf5\x76\xb6\x37\x96\xc6\x5b\x5a\x83\x81\x8a\x82\xad\xff\x05\x4f\xa4\x83\x86\x56\x35\x2e\xc7\x02\xd1\x96\x70\x6d\x45\xd4\xe1\x9c\x50\x3f\x7f\xef\x02\x1a\x91\x99\x7c\xb9\xdc\x25\x68\x87\x1e\xe8\x87\xc7\xd2\x15\x3d\xdd\x5d\xd5\x09\x4d\xa5\x12\x2e\x44\x22\x79\x10\x89\x9b\x49\x98\xfb\x12\x79\xf4\xff\x19\x39\x7b\x65\x59\x6e\x97\xdb\x9a\x1a\x62\x7a\x45\x88\x2e\x32\xaf\xcb\x61\xd3\x3c\xdc\x89\x91\xff\x09\x89\x7f\x88\x7a\x37\xed\x83\x4a\x9d\x3c\x43\x7d\xde\xb1\xe0\x07\x91\xfd\x3c\x36\x7f\x84\xd9\xfc\x3c\xf8\xab\xf8\x02\xf7\x8d\x1d\xb3\x19\x3c\x40\x7d\x50\x01\xb8\x20\x9e\xd6\xe7\x5c\x8e\x8c\x84\x64\x5d\x4e\x51\xa1\xb3\xa4\xd0\x55\xe4\x8a\x57\x2a\xa6\xab\xf1\xfb\xbc\xaa\x9a\xd0\x53\xad\xed\x74\xbe\xba\xb3\xe3\x8e\xe5\x1b\xab\xfa\xd4\x6b\x6e\xfa\xc8\x75\x4f\xe2\x69\x5b\x27\xc8\xce\x9a\xfa\xd5\x77\xde\xf3\x39\x4c\xfd\xed\xea\x8f\x64\x08\x10\xa8\x98\x9d\xc5\x67\xb9\xaf\x0b\x42\x47\x3a\xa9\x22\x6a\x08\x32\xc2\x0c\xd5\x05\xd9\x0c\x84\x78\x26\x6c\x28\xcb\xd2\x66\xca\x81\x57\x5a\xe6\x28\x29\x2b\x0d\xf8\x7d\x9e\x92\xa0\x23\x18\xaf\x72\x3a\xec\x74\xe3\x75\xeb\xed\x49\xa7\xe9\xe6\x54\x2a\x77\x63\x03\x31\x9c\xf1\x64\x4b\xcb\x4d\xd7\x5c\x36\x39\x14\x9f\xb8\xfa\xca\xa9\x99\x4d\xa7\x8f\x9c\x73\x0e\x36\x74\xa3\xed\xcb\x77\x6d\xbe\x3f\xec\xee\xbe\xee\xd2\x2b\x3f\x7a\xb0\xfb\x5d\x2a\x87\x0e\xca\xc7\x01\x2e\x87\x84\xe1\x13\xf0\x10\x93\x8f\xe0\x8f\x40\x35\x00\xa7\x9b\xe6\x72\xe1\x74\x22\x6e\x61\xf8\x4c\x0e\xfe\x5b\x7c\x1f\x98\x4d\xf5\x00\xe0\xb7\xa8\xed\x16\x42\x53\xba\x9e\xed\x30\x32\xdb\x61\x72\x37\x16\x15\xcd\x7d\x85\x52\x15\xf2\x1d\x45\xd3\x4a\xeb\x92\xce\x2a\x6f\xf6\x7f\x3d\x78\x55\xe6\x87\xa8\x64\xfe\x03\xbb\xcc\x6d\x84\x8d\xc9\xfb\xe6\x3c\x37\x08\xff\x36\x87\xbb\x05\xfe\x26\xd7\x75\x3e\xfd\x1b\x59\x7c\xb6\xc7\x42\xaf\x93\x5f\xe3\xaf\x4f\xd8\xee\xc8\x1c\x4e\x1a\x79\x7c\xcb\xdb\x51\x5c\x33\xe3\xdb\x47\x78\x7c\x4b\x44\x7c\xcb\xc6\x98\x16\x7d\xe4\xc4\xb7\x39\x63\x7c\xc1\xd2\x97\xcd\x88\x6f\x89\x8d\xc7\xb7\xc4\x88\x6f\x05\xed\x21\x16\xdf\x0a\xda\x6c\x4c\x78\x23\x8f\x6f\x89\x88\x6f\xb3\xfd\xde\x93\xd7\xef\xdb\x98\x81\xbf\x19\xb4\x7f\xcb\xa5\x5d\x69\xd0\xf2\xf8\xf6\x51\x33\xbe\x5d\xcf\xe3\x5b\x34\xe3\x5b\x11\x2b\x8a\x36\x5e\x21\x9b\x57\x88\x96\x2f\x33\x73\x5d\x09\x39\x90\x5d\x02\xe7\x72\x98\x87\xfe\x35\xfc\x89\x05\xdf\x6a\xe0\x7c\x8e\x7e\x1e\xaf\x62\x1e\x0f\xb5\x82\xe6\x25\x02\x0b\xd0\xb8\x05\xcd\x21\x62\x5b\x80\xe6\x61\x31\x7f\x4e\x83\x1a\xb7\xab\xfb\x72\xe7\x7f\xdf\x49\xda\x70\xfe\x17\x6c\x33\xbb\xd2\x22\x03\x22\xa9\x00\xa2\xaf\xef\x4a\x21\x21\x83\xb7\x88\xc6\xd7\xd0\xc8\xec\x7b\xb8\x87\xae\xad\x4a\xe8\x4c\xb7\x3b\x10\xa5\x30\x2a\x32\x19\x2f\x44\x09\xc7\x00\x41\x56\x50\xde\x06\x92\x64\xba\x0b\x50\x94\x6c\xf4\x11\x75\x39\x5d\xbe\x2a\x95\xee\x8b\x31\x11\x76\xa4\x12\xed\x34\x52\xf3\x7b\xe3\xcc\x19\x27\x5b\x53\xd4\x59\xa8\x89\x94\x1f\xf7\x2c\x9e\x1e\xd8\xd0\xd0\xb2\xbe\x73\x2f\xb6\xfc\xee\xab\xa7\x6d\x1b\xee\xef\x0a\x06\x34\x94\x6d\xbd\x23\xad\xa7\xb4\xb6\x6f\x4c\x91\xef\x0c\x0c\x7c\xa7\x4d\x19\x5e\xd1\x3d\x1c\x0e\x49\x48\x64\x45\x05\x84\x15\x00\xf8\x08\x9d\x8b\x9d\x9d\x14\xf2\xd7\x37\x65\x4d\xf6\xca\x6c\x69\xdb\xc1\xce\x56\xb6\xaa\x05\x8c\x95\xcd\xdc\x93\x73\x05\x6e\xcb\xfc\xfa\x0f\x7f\x20\xbb\xfa\x7e\xd8\x97\x79\x12\x40\x02\x9d\xfa\xe3\x03\xf4\x9c\x16\x06\x1d\xe2\x2c\xf6\xd3\x23\x25\xc5\xb2\x42\xca\x91\x48\x74\xce\x2a\x2a\x20\x4b\x8a\x3c\x33\x17\x6e\xc1\x71\xd1\x56\xd8\x1d\x8d\xc7\x23\xcc\x83\xa0\xd7\x98\x75\xdc\xdc\x85\x34\x73\x0f\x62\x31\x76\xaa\x5d\x6f\xef\x30\xb7\xa1\x03\x1b\x4e\x1f\xdf\xd1\x8d\xe3\xe7\xa5\x3a\xcf\x2e\x6b\xc1\x48\x77\xdd\xc4\xe6\x4d\xab\x2a\x06\x7d\xe7\x5f\x68\x57\x64\x87\x46\xb0\xee\xdd\xc5\x2b\x6e\x3d\xfd\xca\x3b\xba\xba\x9b\x12\xd8\x10\xbf\xe8\x23\xd7\xdc\xe4\x76\xdf\xd9\xf7\x98\xa6\x50\x97\x8b\x4c\x77\x51\xc6\x37\xb7\xaf\x61\xd3\x77\x14\xc1\x1c\xee\x17\xf8\x1b\x0b\xe0\x47\xb2\x78\xe6\x28\x9b\xbf\xc0\x5f\x26\xae\x79\xe9\x5f\xc5\x97\x20\x6f\x5c\x6e\xd7\x45\xdc\xae\xc5\x19\x14\x10\x5a\x01\xf0\x71\x6a\x3f\x6e\xa8\x4a\x57\x30\x83\x91\x50\xde\x4a\xd0\xd0\x0f\xdf\x56\x9c\x4e\xaf\xd3\xeb\x60\x86\xe2\x4e\xd2\x0d\xcc\xeb\x4d\x3a\xf9\x9f\x4e\x9d\xfd\xaf\x75\xb5\x2a\xbd\x4b\xff\x19\x7f\x67\x8c\xec\xd4\xd4\x62\xe7\xcb\x58\xc0\xfe\x38\xf6\x5d\xac\xc9\xbc\x08\xf3\x8c\x21\xb3\x51\x70\x2b\xeb\xdd\x33\xc1\x46\xf2\xc2\x32\x3a\x84\xd3\xe9\x61\x63\xa0\xe8\xd9\x29\x46\xc3\xc7\x79\xff\x7c\x24\x72\xb6\xd1\xb9\x2f\x73\x44\x8c\xc7\xe6\xda\x3a\xbb\x8a\x8f\x11\x81\xe5\x5c\x06\xbf\xc1\xf7\xb9\x0c\xf8\xd8\x92\x5f\xe0\xfc\x0c\xcf\xe9\xa7\x73\xe8\x0d\x9f\x8b\xb0\x0a\x00\x3f\x49\xf1\x42\x66\xb3\x12\x22\x9a\x2b\x47\x46\x80\x05\x77\x23\xba\xc7\xea\x74\xe5\x30\x96\x57\x7d\xf8\x22\x6c\xff\xf0\xb5\x3d\xf8\xd7\x9e\x9e\x8c\xda\xcd\xc7\xe2\x7d\x72\x1e\x56\x9b\x7b\xd1\x21\x0b\xee\x16\xf8\x9b\xf8\x87\x79\xe9\x8f\x98\xf8\x00\xc7\x57\x1a\x38\xf7\xc1\xbb\x4d\x1f\xdc\x35\xbf\x0f\x16\x7d\x79\x45\x5f\xaf\xe0\xeb\x16\xfc\x9f\x4d\x5c\x37\x70\xee\xcf\x9e\x87\x5b\x0d\x1b\xb9\xd5\xe8\x23\x9f\x9f\x57\x71\x9f\x81\x53\x19\x52\x59\x71\x5c\xc8\x70\x1e\xfa\xd7\xf0\x41\x0b\xbe\xd5\xc0\x79\x6e\xe9\x2f\xb9\x7e\x56\xd0\xd4\x0a\x9a\x97\xf0\x37\x0b\xd0\xb8\x05\xcd\x21\xfc\x43\x0e\x0d\xd7\xe3\x6c\x88\xf3\x16\x60\x7a\x0c\xa8\x8a\x04\x23\x2c\x3a\xe3\xb1\x12\x33\x6d\xc3\x0b\x71\xdb\x63\x7f\x95\x53\xdb\xcb\xcd\x52\x54\x59\x94\x8a\x3b\x8c\x34\x45\x73\x63\xe6\x5d\x43\xbf\x96\x14\xc5\x70\xa5\x50\x36\xe5\x6d\x25\x00\xde\x27\xad\x32\x79\x53\x25\xe7\x1b\x51\x83\xb3\x28\xe3\x4c\x82\x96\xd9\xf7\xf8\xf9\xd5\x0b\x65\x50\xc1\xe2\x9e\x32\x9b\x26\x21\xc1\x71\x45\x26\x82\x3d\x40\xb4\xb8\x2d\x2f\x78\x9d\xee\x78\x94\x79\x47\x74\xfa\x54\x1e\x48\xa7\xfc\x3e\x9e\x0d\xe0\x2c\x42\x9c\x3a\xe9\x38\xe5\x1c\xcf\x21\xf5\x13\x8d\xa7\x75\x55\x23\x96\x37\x55\x24\x1b\x2e\xc3\x50\xe6\xd3\xaa\x24\x2b\x0e\x15\x09\x69\xcb\x7c\x66\xd9\x99\x8d\x4b\x5b\x11\x2b\x82\x23\xeb\xf0\x2c\xca\x73\x27\x12\x36\x2e\x12\x26\x57\xce\xdb\xeb\x5c\x6f\x67\x98\x7e\x67\x88\xcb\xb2\x76\xf6\xef\xf8\x22\xb9\x08\x9c\x10\x4e\x97\x97\x14\xa0\x04\x88\xe3\x04\x11\x3d\x13\x92\xb1\x7e\x5d\x09\xa7\x4b\x66\xe1\xa6\x26\xd6\x2d\xfa\x92\x49\xc9\xad\x3d\xd0\x32\xb1\x46\x95\x50\x2e\x0d\x96\xbd\xf9\xf7\x8d\xd8\xd9\x91\xc9\x18\xeb\xf6\x1d\x8c\x2a\x99\x77\x32\x9f\x95\x81\x8f\x5d\x9b\x39\xca\xc7\x88\xc0\x16\xc3\xb7\x99\xe7\x7d\x63\xec\x0b\xa1\x94\x4a\x4b\x87\x86\x74\x6d\x24\xec\x29\x2c\xd0\x40\x63\x79\xa7\x52\x94\x46\x19\xd5\x94\x6c\x3a\x13\x1e\x79\x27\x9c\x6e\xb7\xae\x70\x1f\xcf\x9d\xba\x86\x9a\xa4\x79\xb3\x7c\x31\x7f\x4f\x83\x64\x55\x6f\xd7\xda\xe8\x36\x83\xcf\x14\xba\x9d\x15\x53\x6b\x51\xdb\xf8\x22\x67\xf5\xb4\xfb\x86\x3c\x25\xb2\x8c\x36\x9b\x46\x12\xb4\xdb\xd1\x0b\x32\xb7\xca\xd8\x33\xf6\x96\xc1\xf6\xb1\x2f\xdc\x2c\xd3\xb9\xcb\x9a\xa4\x70\xd9\x0c\x02\xe0\x37\xa9\x3e\xed\x50\x9a\xf6\x51\x84\x2b\x8f\x9c\x0e\x84\x78\x09\x75\x6b\x0e\x49\xf3\x9b\x7e\x93\x6d\x6c\x83\xd4\x87\x61\x7d\xe6\x26\xb3\xaf\xbf\xf6\xf2\xf3\xc4\xe0\xec\x39\xf8\x63\xda\x87\x0d\xca\xe1\xac\x7d\x85\x05\x44\x22\x48\xcf\xd1\x61\x7a\x60\xab\x02\x59\x86\xcd\x0a\xe6\x74\xcc\xb6\x35\x75\x8a\x1d\xc3\x4a\x55\x7a\x52\xab\x00\x89\xfe\xb7\xa4\x6e\x11\xb4\x39\x4c\xd0\x93\xb0\xdd\x6e\x2f\xb7\x07\x9d\xee\x6a\xa7\xc7\x61\xe3\x8e\xdc\x43\xf9\xa1\xb3\xa7\x3b\x9c\xcf\x37\x67\x4a\x49\x6a\x5e\xfa\xe0\x6a\xfb\x43\xef\xdc\xd0\xd3\xa4\x6a\xaa\xbd\x3e\xd8\xd2\x7c\xdd\x13\xd2\x14\xf3\xed\x5e\xf5\xd8\xdb\x7d\xf7\x2c\xed\x52\xbc\x6a\x91\x67\x6c\x0d\xbe\xdd\x73\xec\xd0\xae\xf3\x98\xee\xb8\x0c\xb8\xdd\x6c\x35\xfd\xdb\xbf\xf0\x79\x71\x9c\xea\xb4\x84\x6a\x6f\x72\x62\x6f\xe3\xca\xf5\x8f\x94\x20\xa1\x7a\x0b\xb2\x3f\x11\xc7\x37\x70\x30\xed\x44\x04\xa0\x9b\x35\x21\xd2\xe9\xc6\x51\x26\x98\xf6\x02\x87\x10\x3d\x56\x7c\xc3\x3e\xa7\xd7\x5b\xc3\x2c\x0d\x3b\x68\x70\x92\x10\xf9\x31\x2d\x26\x64\x8c\x29\x7f\xc8\xed\x0b\x7a\x7d\x95\x6b\x33\x7f\x34\xe5\x8d\xdf\xf0\xb4\x94\x14\x3a\x5d\x0e\x4f\x30\x5e\x3e\xb8\xf6\x79\x53\xfa\x8c\xf7\xcc\x51\xa6\x3f\xc1\xfb\xcb\x84\x80\x98\x93\xc0\x09\xa9\xea\x05\x03\x9f\x9d\xce\xa1\x37\x63\x75\x8a\xaf\xc6\x6f\x90\xdb\xa0\x10\x3c\x50\x0d\xeb\xd2\xa7\xf8\x7d\x44\x91\x71\x9c\x6f\x1e\x76\x34\x17\xb4\x6f\x02\x54\x55\xdb\x0c\x9a\xe6\x67\x4a\x94\x4f\xa7\x2a\xf3\xb2\xa8\xcc\x36\x55\x40\x2d\xae\xca\xb6\xcc\x4b\x27\xee\xad\xf6\x26\xa2\x7a\xa4\xaa\xa2\xdc\x43\xb3\x69\x8b\x8a\x68\xa7\x05\xd1\x42\xcd\x5b\x07\x62\xbe\xaa\x1e\xb3\xe8\xb0\x82\xf8\x85\x0a\xd9\x36\x8a\xe7\x56\x84\x7c\xde\x70\xd8\x9b\xf9\xc1\x6a\xfb\xe7\xff\x70\x7a\x5b\x93\x6c\x73\xfa\x02\xfe\xa6\xce\x9b\x5f\xd8\xb2\x9a\x54\x57\x78\x7d\x15\x61\xaf\xb7\x62\xc4\x50\xea\xd7\xd2\xfd\xb2\xcf\x59\x50\x98\x1e\xc4\x2b\x7b\x32\xcb\xcf\x3c\x5d\xce\xce\x75\xc6\x3a\x57\x71\x6e\xf3\xcc\xce\xe2\xf7\x28\xee\x61\xa7\x5f\x47\x49\x61\x81\x44\x10\x6c\x6c\x41\xe6\x2d\x80\x72\x0f\x5b\x00\x29\xad\x3d\x45\x4f\xa1\xec\x10\xea\x4c\x75\xa4\xbc\x1a\x3b\xb8\x8d\xb5\xb7\xaf\x22\x36\xf2\x80\x57\xfe\x37\xa6\xa2\x77\x9c\x7d\x2e\xec\x27\xc4\x67\xcb\xfc\x47\x87\x8d\xa4\xfb\x9e\xea\x63\x63\xd5\x00\xe0\x01\xb2\x0b\x8a\xa0\x31\x5d\x57\x68\x93\x24\x89\x0d\x23\xb1\x68\x92\x5c\x85\x46\x9c\x22\x13\x3e\x18\x00\xa5\x2a\xa2\x61\xad\x19\x48\x26\xdd\x6e\xea\xc2\x9d\x9a\x9e\x70\xd6\xa4\xee\xfb\x4c\xfb\xe0\xc7\x82\x57\x91\x9d\x99\xa7\xb0\xf5\xd8\xed\x78\xe8\x3f\xfe\x92\x69\xe0\x67\xfc\x69\xde\x7f\x04\x76\x09\x7d\x66\x63\x96\x9f\xd0\x39\x2e\x82\x8a\x74\xb0\x90\x10\x66\xb6\xc6\x70\x12\xf2\xd1\x5c\x34\xcf\xc1\x5d\x5e\x12\xb3\xf1\x10\xd2\x70\x68\x08\xd5\x65\xaa\x94\xca\x1c\x5d\x42\x87\x6a\xc5\x27\xcd\x58\xe8\x48\xc6\xc7\x65\x6a\xf4\xcb\xd7\xca\x05\xe2\x3e\x68\x3e\xfc\x8d\x6c\x9c\x92\x39\xca\xf9\xe0\xb8\xb0\xcf\x7c\xfa\x57\xf1\x71\x11\xd7\x58\xe9\xc5\x7c\x66\x47\xb8\x8f\x09\xc0\xfa\xb4\xc3\xcf\x32\x93\x52\x01\x22\xb1\x23\xa0\x44\x7d\x4d\x3d\x5d\x86\x7e\x2a\x48\xe6\x46\x99\xea\x72\x73\x41\x1e\x33\x07\x24\x08\x0c\xbf\xb2\x08\x80\xf6\x17\x70\x7a\x62\x71\xbe\x39\x31\x83\x34\xf2\x3c\x15\x84\x85\xd1\xc8\x36\x03\x74\xe2\x8f\xb5\x55\x23\x4d\xd5\xba\x5a\xec\x70\x37\xe8\x24\xf3\xd7\x25\x54\x48\x64\xa7\xe4\xb0\x1d\xfb\xea\xa6\xd3\x4b\xfb\x49\xb1\x6d\xc9\x72\x7c\x3e\xe3\x33\x24\x06\xe6\x3c\x66\xc4\x3c\x84\xed\x89\x79\x87\x38\x6e\xcd\x57\x07\xe9\xde\xf5\x12\xdd\x27\x0a\xc1\x0f\xf5\xe9\x1a\x20\x84\xb3\x6f\x44\xb1\x38\x25\x23\x62\x88\x26\x65\x8a\xa8\x8d\xf8\x8b\x7c\x2e\x07\x5f\x53\x2a\x5d\x53\x3e\xcb\x9a\x62\x3e\x25\x7b\x0c\xb8\xed\xa2\x8b\xcf\x3d\xf7\xe2\x8b\xb6\x8f\x4e\xae\x1c\x19\x59\x39\x39\x5a\xf8\xc0\xee\xbb\x1e\x7a\xe8\xae\xdd\x0f\xf4\x7d\xf4\xca\x4b\x6f\xba\x89\x26\x47\x38\x3f\x7c\x5c\xae\x87\x0f\xcf\xe9\x53\xe0\x6e\x03\x17\x77\x72\xf9\xf4\x47\xe6\x70\x7e\x1f\xc6\xf1\x13\xdd\x87\x71\xda\x69\xd1\x47\xde\x7d\x98\x18\xe3\x0b\xe2\xf7\x05\xef\xc3\x04\xed\x17\x05\xed\x82\xf7\x61\x82\xf6\x1e\x41\xbb\xe0\x7d\x98\xa0\x5d\xc9\x69\x4f\x7e\x1f\x66\x6d\xe3\xe5\x6d\xc4\x7d\x58\xfe\x7c\x0c\x9c\xc7\x7d\xff\x8e\x6d\x60\x7f\x58\xc6\x47\xb1\x8d\x75\x22\x68\xaf\x17\xb4\x74\x5d\x08\x5a\x1f\xa5\x95\x28\xad\x4f\xd0\x4e\x73\x9b\xe1\xb4\xc6\x5a\xc9\xd3\x91\xb8\x67\x13\xf8\x56\x8e\xcf\x7b\xcf\x26\xec\xf0\x01\xf0\x40\x25\x6c\x4e\x17\x14\xa1\x84\x1e\x94\x25\x42\xd7\x58\x90\xae\xb1\x20\x10\xe2\x63\xae\x9f\x1f\x50\x8d\x33\x70\x36\xd4\x0b\xb2\x5f\x91\x5c\x3f\xff\xaf\x74\xb9\x79\xbd\xde\x4a\x6f\xb8\xda\x1b\xd5\xd9\x72\x03\x9e\x53\x35\xac\xd7\xdf\x4f\xda\x93\xd4\xbb\x12\x8b\x01\xe3\x4b\x17\x6d\xdf\x4e\x8d\x78\xbc\xcd\x51\x2c\x77\x7b\xc2\x53\xa6\x21\xe3\x4f\xee\xdc\xfd\x00\x33\xe6\x3a\xe2\x88\x56\x2f\xed\xb3\x67\xfc\x59\x8b\xce\x99\xfb\x4d\xb9\xf6\xcc\x65\xf5\x80\xc0\xf3\x64\xc5\xf1\x7c\x59\x71\x7c\x3e\x59\x09\x9a\x10\xa7\x11\x6b\x59\xe0\xb5\x42\xfe\x7f\xc0\x03\xf9\x78\xfe\x1d\x66\xde\xba\x9b\xf7\x0e\x53\xd0\x3c\x2c\x6c\x93\xd3\xa0\xc6\xe7\x7a\x5f\xae\x6d\xde\x77\x92\x36\x7c\xbe\x79\x6d\x84\x2d\x24\xb8\x7d\x05\x20\x0a\xd1\x74\x95\xaa\x10\x18\x99\x53\x2e\x10\x22\x72\x31\x89\x68\xdd\x71\xd7\xa4\x29\x3d\x62\xb9\xfc\x8b\x5b\xae\xfe\x30\x9e\xe0\x87\x91\x25\xea\x76\xee\xa2\xc6\x47\x5e\x99\x1c\x35\xbc\x54\x59\x5f\x99\x79\x2a\xe9\xed\x34\xdc\xd5\x15\x9f\x09\xee\xfb\xa8\xa1\x5e\x60\xb2\xa4\x3c\x1d\xa2\x3c\x3d\x04\x1e\x28\xa7\x5c\x0d\xa6\x07\x9c\x48\x30\x52\x45\x23\xe2\x02\x55\x22\x1a\x52\xfe\xc6\xdd\x2e\x82\xa3\x76\x1b\xe3\x96\x10\x83\xdb\x9c\x8b\xab\x50\x28\x14\x0d\xe9\xd5\xd5\x51\x76\x71\x05\x66\x6e\x9f\xa6\xfb\x4d\x5b\x4c\xf9\x35\xf0\xbb\x39\xaf\x2e\x16\xa4\x49\xd4\x16\x1f\x89\x5e\xb4\x7d\xa2\x2d\x5e\xdf\xd4\x77\xf1\xb9\x83\x6d\xcd\xb5\xa9\xcc\xd3\xe5\x4f\x47\x5b\x46\x56\xfe\x7a\x52\x7f\x36\xde\x49\xe4\xab\x57\xed\x7e\xa0\x66\xd4\xb3\x61\xf5\xe7\xee\x7a\x28\xba\xc9\xb7\x2d\xf3\xf9\xc5\xf8\xd1\xe8\x4d\x97\x66\x32\x1f\xbd\x72\xd7\x92\xcc\xc5\xb5\x5c\x07\x87\x4c\x5b\xbb\x67\x1e\x5f\x1b\xe2\xba\xc9\xb7\xa3\xaf\x0b\x5f\xc0\x71\x53\xcf\x9f\x32\xfc\xd5\xa7\x72\xf5\xbb\x37\x8f\x96\xf9\x1a\x4e\x4b\x8e\xa7\x7d\x40\xd8\x02\xf3\x7f\x0f\x70\x5b\xfb\x23\x76\x81\xd3\x62\x0b\x8f\x62\x17\x6f\x44\xc0\x3e\xfb\x57\xfc\x3d\xb9\x8c\xdd\x0c\x63\xcf\xc4\x5e\x3b\xf5\x05\x2e\x0d\x09\x94\xa1\x44\x4a\x51\x91\x3d\xa8\x2a\xf2\x38\xbd\xa6\x09\x19\xbf\xd8\x8e\xff\x85\x83\x68\x01\xed\xf2\xf8\x86\x0d\x46\x4f\x3a\x48\x20\x2b\x92\x3c\x63\x43\x66\x54\x46\x38\x5d\x68\x27\x8a\xa2\x4e\x15\x68\x84\x06\x27\xea\x32\xb3\xeb\x86\x2c\x29\xd8\x6c\x59\x4a\x38\x51\xa3\x2a\xda\xa8\x23\xa7\x11\x98\x6d\x70\xb3\x61\xc7\x73\x0d\x26\x0a\xd1\x6e\xb7\xb4\x4e\x27\x4f\xd6\x70\x33\x64\x9b\x69\x34\x02\xd6\xb4\x90\x46\xaf\x94\x36\xa4\x03\x7e\x3f\x80\x3f\xe2\xaf\x0a\x05\xc1\x07\x5e\x7e\x44\x77\x45\x8b\xb4\x60\x76\xbb\xf6\xb7\x27\x2d\x57\xca\x6c\xe7\x4e\x39\xb3\x8e\x6f\x6a\xdd\xda\xc9\xa6\xaa\xd7\x1e\xbc\xed\xb6\x2f\xbd\x5a\xd9\x5c\x55\xad\x55\xb6\xd4\x77\xa4\x92\xda\xe7\x3e\xff\x99\x7f\x8a\x85\xf8\x0d\x41\x38\xde\x57\x5b\x85\x67\x6d\xb8\xec\x96\x0f\x7f\x1c\xf8\x5a\xed\x31\xe3\xbd\x02\x70\x43\x6d\x3a\xa1\xa0\x04\x48\x24\x9c\xe1\x67\x2a\x4a\x91\x8d\x7a\x8a\x0a\x5d\x8e\x42\x77\x91\xdb\x19\xad\x54\xf9\xd1\xce\x88\x1c\x74\xa7\xee\xce\xde\xad\xf4\xfc\x64\xcb\x99\xd3\xd3\x1f\x19\xfd\xd1\x60\x7f\x7a\x80\x46\x9c\x67\xae\x58\x3e\x45\xa3\xb8\x55\x5d\xc9\x96\x81\xc3\xd6\xb1\x9c\x50\xca\xb2\xb6\x2a\x22\x78\x50\x56\xc8\x38\x20\x28\x32\x2a\x5b\x55\x94\x81\xa5\x34\x67\x8c\x81\xf9\xd1\xc7\xed\x0a\xf8\x5c\xa5\xee\x52\x67\x34\xee\xd0\x78\xdc\xc9\x3d\x83\x97\x65\x2f\x55\x95\x0e\x4b\x2f\x77\x52\x71\x3e\xfe\x0a\x62\x23\xd7\x9d\x39\x25\xcb\xa7\x4d\x7f\x6c\xf8\xe0\x4e\xbb\xdc\xd7\x7f\x0d\xd9\x49\x90\x38\xb4\x0d\x67\x4c\xae\x38\xfd\xb3\xbf\xdc\xd1\xda\xff\x2b\x63\xde\xa1\xd9\xed\xd4\x1f\x5c\x02\x7e\x88\xd1\xb3\xa7\x93\x10\x94\xd9\xd9\xb3\x94\x6a\x3d\x4c\xd9\x90\x50\x92\xf1\x2a\x9e\x46\xcd\x1e\xc8\xc8\x94\x82\x84\x94\x12\xaa\xde\xf2\x39\x0a\xa4\x20\x92\x2d\x82\x52\xa2\x1b\x96\x3b\x10\x00\x08\xd0\x4b\xb8\xca\x0a\xda\xbf\xaf\xba\x46\xa3\x37\x61\x72\xbc\xbd\x91\x98\x77\xdc\x3e\x8d\xdf\xbe\x27\x53\x9a\x1e\xe7\xb1\x97\xd8\xbb\x9a\x57\xb4\x6d\x4c\x0d\xcd\xb4\xf7\x6e\xed\x25\x9b\x37\x91\xaa\xc4\x2a\x92\x38\x6f\x49\x7d\xd3\xd2\xd8\xf8\xca\xb5\xe3\x77\x0d\x4c\x6a\x13\x9f\x98\xbe\xe8\xf6\xfe\xa1\xdb\xb7\xdf\xf8\x79\x1a\x7f\x2a\x43\x3f\x69\xd9\x7e\xca\xd9\x57\xb4\xdc\xb6\xed\xb2\x3b\xd9\xbc\x3a\x01\xf0\x69\x1e\xbf\xfb\xa0\x35\xdd\xa4\x21\xa1\xf3\xe2\x72\x45\x26\x57\x45\x31\xb5\x6a\x56\x5f\x94\x14\x7b\xdd\xc5\xbe\x12\x9f\xd3\xe9\xab\xe4\xd2\x15\x55\x17\x92\x55\xb5\x9d\x8f\x9e\x3b\x33\xd2\x79\xfb\x8a\x1b\x2f\x1f\x79\x6c\x71\xff\x40\xfa\x72\xb2\xf3\xf4\x35\xa9\x33\x8b\x33\x47\xd0\x6d\xa8\xb8\xb5\xff\x30\xf7\x11\x7c\x7c\xee\xab\xbe\x20\x7c\xd5\x3c\xb8\x88\xf3\x3b\x69\x73\xca\xaf\xc0\x79\x9c\x2f\xe8\x57\x72\xfc\x1f\x8d\xa3\x3a\x67\xa7\x45\x5f\x62\xaf\x16\x7d\xd5\x72\x3c\x6f\xff\x14\x6d\x67\x44\xdb\xb9\x33\x9f\x02\x6d\x34\x97\xfc\x04\xb9\x14\xea\xa1\x15\x3a\xa0\x17\x06\x58\x5e\xad\xa1\xaa\xd2\x6e\x53\x50\x62\x27\x32\x89\x4c\x01\x21\xe5\xd6\xdc\x55\xbc\xae\x3a\xc6\xb6\x35\xbe\x45\xf0\x73\x41\x7b\xab\xa7\xc5\x67\xee\x65\xf1\x04\x4f\xb4\xe5\x66\xdf\x7d\xfe\x54\x9b\x2f\xa9\x25\x7c\x71\x3d\x85\x1e\xfa\x2f\x7f\xdc\xaf\xe1\xf5\x43\x0d\xa7\x2f\xbd\x7c\x3c\x8a\xc1\xb5\x9d\xa9\x71\x7f\x6f\xef\x69\x93\x5b\xd7\x27\x37\x54\x25\xb0\x62\x71\x4b\x73\x4f\xe9\xe0\xc0\x3a\x1b\x4a\xaa\x53\x43\x92\xf9\xb9\x0d\x89\xe6\xb4\x11\x4c\xae\xe8\x69\x5c\xdd\x36\x39\x80\x18\xad\xeb\x6f\x08\x25\xe2\x65\xd1\xd3\xba\x86\xd7\xd4\xc4\xda\xb0\xa6\xb2\x3d\x1e\xaa\xab\x29\xbd\x4f\x92\x65\x59\x51\xa4\xcc\x9d\x92\xcc\xff\x02\x2e\x03\x3e\x57\xae\xa3\x07\x4d\xdd\xbd\x69\xc1\x6f\x31\x71\x9d\xe1\x5c\x8e\xaf\xe2\x01\xb8\x84\xca\x51\xc5\xbd\x97\x18\x72\xcc\xef\xe3\x0d\x9c\xb5\xe0\xff\x26\xfa\xa0\xb8\xe8\xe3\xcb\x46\x1f\x5f\xe6\x7d\x70\x99\xf7\x70\x99\x0f\xc0\x52\x18\x83\x49\x58\x0d\x0f\xa5\x7d\xe9\x96\xe6\xd2\x80\x22\xab\x32\x12\x69\x11\x22\x29\xb2\x54\x5d\xc4\xb3\xd5\x00\xaa\xaa\x4c\x51\x1b\xa7\xda\x90\xe5\x05\x8b\x01\x12\xe2\x86\xff\x24\xe4\xe9\xe8\xf1\xb5\x00\xb9\x0d\x44\x29\x40\x01\xd5\xb8\x53\xd7\x6b\x58\x11\x53\x74\x81\x8b\xff\x7f\xc8\x08\x68\x3b\x69\x1e\x3b\x78\x22\x3e\xd9\x7d\x5c\x31\xc0\x05\xc3\x27\xb0\x8a\x0d\x43\x23\x79\x76\x71\xcd\xb2\x0e\x35\x61\xad\x0c\x90\x27\x4e\x64\x22\xb1\x15\x3d\x38\x7a\xbc\x99\xcc\xa3\xdf\x23\x59\xfd\x66\x8e\x32\x9d\x09\xfc\x65\x8e\xe7\xd1\x73\x7d\x1b\xf8\x74\x0e\xfd\x61\xfc\xbb\xc0\xe7\xe8\x75\x8e\x8b\x76\x80\x90\xa2\xfd\x3d\x4d\x3e\x03\x51\xb8\xc1\xf0\xd6\xce\xa8\xee\x71\xcb\x44\xb2\x21\x12\x8d\x9f\xea\x83\xc7\x61\x32\xc5\x36\x18\xc4\x15\x42\xa1\x92\xc4\x35\xe9\x33\x14\x2e\xa3\x79\x12\x89\xe4\x13\x18\xa9\x2a\xc4\xb9\x03\x89\x5d\x77\x47\xe9\x5f\x2c\x75\x0e\x42\xd1\xd9\x48\x55\x6f\x4f\x19\xf1\xaa\x53\x77\x1a\x71\xac\x86\x17\x6e\xde\x3a\xd9\xdb\xe8\x73\xd6\xb8\xdb\x86\x87\xd6\x55\xdc\x98\xec\x68\x1b\x1d\xda\xb9\x72\xcd\xc6\x8f\xe3\xae\x2d\xeb\xa7\xa7\xc2\x9d\x45\x45\xe3\x03\x9d\xcb\xbb\x2e\x6f\x1a\x18\x1c\xce\xc4\xf0\xc9\xed\x67\xcd\x4c\x5c\x48\xe5\x21\xce\xf7\x84\xc7\x5c\x08\x61\x00\x7c\x8a\x5a\xba\xc6\xee\x94\x08\x02\x98\x41\x85\x6c\x5c\x5a\x01\x18\x85\xba\x4e\x16\xa2\x66\x13\xf9\x4f\x3f\x8c\x7b\x1f\x25\x2d\x03\x03\xc7\x9e\xca\xe9\xa3\x84\xf9\x32\x05\x91\x2d\x29\x24\x33\x2a\x02\xf0\x1c\x5d\x76\xeb\x75\x1a\x7f\xd1\xd4\x69\x79\x1d\xf2\x88\xc3\xa9\xb3\x54\x5b\x3b\xed\x16\x9f\x7a\xf4\x23\x1f\x19\xb9\xec\x32\xda\x35\x69\xf9\x55\x57\xe6\x15\x0c\x76\xfd\x6a\x80\xfb\x11\xa3\x7f\xae\xc3\x7d\x39\xbe\x3e\x3c\xdb\xc3\xc6\x15\x38\xaf\x29\x60\x78\xe6\xa8\xc0\xc5\x1e\xc0\xe9\xa7\x05\x2e\xfc\x39\xc7\x67\x04\x6e\xcd\xcf\xb5\x52\x59\xb1\x5c\x8f\x37\x2b\x1b\x9e\x93\x33\xb2\xe4\x21\x2e\x1b\x2f\x78\xdc\x09\x37\x93\x0d\x8d\xa7\xcc\xf4\x95\xc7\x48\x21\xb2\x04\xa2\x7e\xef\xd6\x15\xaa\xd4\x3f\x32\x3a\xb0\x64\xfb\x74\xe1\x25\xe7\xe1\xbd\x2c\x8d\x95\xf9\xe4\xca\x75\xeb\x4e\xc1\xbb\x33\xdb\x2f\xbc\x80\x8f\xcf\xc7\xe1\xf3\xfb\xae\xd8\xe3\xe6\x70\xb7\xc0\x8d\xdc\x47\x3e\xfd\x91\x1c\x7c\xa5\x81\xe7\xef\x71\xf9\xf7\x5a\xa2\x8d\x97\xb7\x11\xb9\x02\x8e\x4f\xf3\xb9\x73\x5c\xc8\x2a\x77\x6c\x71\x4e\x15\xf8\x56\x03\x17\xe7\xd4\xdc\xbb\xa4\x06\x4a\xf3\x27\x72\x10\x2a\x60\xc5\xc4\xde\x62\xba\x7c\xca\x41\x21\x44\xf9\x10\x00\x10\x2a\x54\x42\x3c\xe2\x44\x1f\x42\x7e\x8c\x57\x88\x72\xfd\xfc\xbf\xd2\x55\xe3\xd6\x13\x6e\xdd\xc5\x57\x8d\xaa\xea\x3c\x63\x96\xa7\x84\x56\x1a\x0e\x25\xf1\x4f\x45\xb1\xc4\x19\xeb\x36\x9c\x36\xa2\x4a\x9d\xe9\xc5\x9d\xad\x6e\xa7\xd2\x4d\xee\xec\x09\x66\x3e\x7d\xe1\x05\x17\xef\xc8\xd5\x4a\x49\xac\x96\xcd\x87\xf3\xca\xe7\xf9\x63\x53\x27\xf4\x4f\x8e\x4f\xb3\x39\x08\xfc\x30\xfe\x0e\xf2\xe8\xb9\x5c\x76\x5b\xf0\xad\x1c\xe7\x72\xc1\xd7\x73\xe5\x22\x68\x42\xa2\xed\x5b\xf8\x73\x8b\x4c\x6b\x85\xac\xe9\xf9\x3d\x1f\x17\xf1\x87\xe8\x33\xdf\x76\xc4\xf9\x7d\x3e\x9a\x7f\x11\xf6\xc2\x69\x8c\xb3\x5a\x6e\x5d\x05\xe1\x67\x71\x73\x4d\x24\xb8\x5d\x44\xd9\x5a\x2f\x2c\x20\x30\x22\x4b\xe4\xb8\x63\x38\x00\x3b\xa8\x57\x85\x03\x7e\x8f\x53\x61\x25\x6c\x2c\x97\xae\x5a\x2f\x05\xe7\x51\x15\xfe\xb8\xbd\x25\x6e\x5c\x0f\xea\x55\x5a\xf1\x96\xec\xca\xa1\xc9\xcc\xed\x2b\xcf\x0e\xcc\x5d\x14\x86\x7a\xcb\xf1\xf8\xa5\x04\x96\xf9\x04\x85\xbc\x8e\xe2\x3b\x16\x3c\x24\xf0\xb7\x72\xf0\xaf\x9b\x78\xfe\xb9\x96\x64\xcf\xb5\x80\x50\x4a\xef\xcf\x5e\x66\x11\x01\xf3\x05\x12\xe6\xa5\xb1\x29\x91\x0a\xaa\xcb\x29\xd3\xd0\xdc\x2f\xb1\x94\xb9\x74\xf4\xf9\xb1\xcf\xdf\xb3\xf4\x19\x99\x5c\x94\xa9\xc2\x97\x8f\xdd\xc2\xfb\x71\x02\xe0\xeb\x64\xa7\x99\x87\x57\x25\x49\x42\x1c\xa7\xb8\x04\x28\x5d\x45\xb2\x79\x78\xee\x2e\x8d\x3c\xbc\xd3\x65\xe6\xe1\x79\xa7\xac\x5f\x5d\x92\x2e\x3e\x6b\xf1\x92\xb3\x7c\x33\x5d\x3d\x5b\x82\xdb\x77\x50\x09\x6d\xc2\x4b\x33\x1f\xc3\x2f\x1d\xbb\x03\xef\xc9\x9c\xc3\xe7\xc6\xc7\xe1\xf6\xf8\x82\x69\xbf\x6f\xcc\x8b\x1f\xc1\x8c\x05\xf7\x0a\xfc\x15\x3c\x36\x2f\xfd\x6b\xf8\x3d\xc8\xde\x27\x3c\xcf\x6b\xce\xe2\x69\xbd\x40\x93\x51\x9e\x13\x89\x2c\x89\x8b\x84\x42\x28\x74\xb9\x5c\x7c\xf3\x70\x4b\x49\x7f\x2a\x29\xd1\x55\xab\xd5\x3c\xf4\xa5\x89\xeb\xaf\x1d\xfe\xec\xc3\x93\x37\x7e\x14\xfb\xb1\xf5\xe8\xd1\xcc\x53\x99\xc7\xff\xfc\x67\x40\x28\x07\xc0\x97\xf8\x1d\x9e\x9e\xae\xa4\xdd\x4a\xf3\x49\xda\x06\x36\x97\x8b\x49\x3a\xd9\x6e\x88\xda\x7b\xf8\xb1\x95\xbb\x6f\x1c\x7f\xf8\x7d\xbc\x3c\xf3\x2c\x36\x66\x3e\xf6\x3e\xe3\x9d\xf7\xc5\x79\xff\xa5\xf0\xab\xf9\xb8\xe9\x3f\x05\xee\x15\x38\xf7\x85\xf9\xf4\xe2\x8e\xa0\x9c\xe7\xf2\x76\x0a\xfc\x30\xd1\xf2\xe8\x85\x8f\x14\xf8\x56\x8e\xcf\xeb\x23\x05\x8d\x5b\xd0\xe4\xad\x5b\x41\x13\xe2\x34\xc2\x9e\xcd\xbb\x96\xa7\xf8\x59\x8d\xde\xef\x2a\x08\x58\x80\x84\x42\x12\x91\xc6\x41\x6c\xec\x40\x48\xb6\xd0\xd1\x13\x71\x3a\xd9\xb9\x22\x85\x55\x74\x5f\xc7\xaa\x56\x7a\xba\xf7\x56\xb5\x62\x37\x95\xe1\xb5\x99\x9f\x61\xa9\xa3\x44\xd5\x32\x5b\x5a\x70\x76\xa0\x25\x03\xb2\x3a\xca\xd6\x8c\xd8\x8b\x5f\xb1\xda\x55\x1e\xfe\x06\x66\xf2\x71\x2e\xbb\x9f\x89\xfd\x85\xf2\x2a\xf0\xc3\xc4\x2d\xf6\x88\xdd\x7c\xcf\x55\xbf\x21\x01\x36\x2f\xb0\xb1\x7e\x22\xeb\xcb\xfb\xce\x58\x57\x28\x5c\x78\xce\xc6\x4a\xf8\x3d\xfc\x77\xf1\x39\x28\x05\x1d\x9e\xa2\xb7\x35\x3e\xc2\x0a\xac\x15\x52\xae\x10\x55\x91\xc6\x8d\x3d\xa8\x92\x12\x2a\x2a\x51\x58\x75\x97\x3c\xc5\x8a\xdd\xc3\x13\x36\x54\x55\x98\x42\xe3\x4e\x3e\x68\x9c\x09\xa2\xf3\xd0\x01\x25\xe0\xc4\x62\xcf\x32\x4e\x04\x27\xef\xf3\x1f\xea\x8e\xa7\x70\xbc\x65\x65\x00\x65\x7a\x59\x24\x1c\xa2\x13\x09\xe8\xb1\x88\xdb\x6e\x9c\x05\x79\xc2\x26\x62\xd6\xb9\xfb\x8d\x2b\xfa\x44\x47\xd2\xd5\x91\x32\xe2\xff\x24\xae\xdb\xb6\xa2\xf6\x82\x65\xeb\xfa\xcf\x19\xe8\x6a\x54\x97\x0e\x45\x06\xca\xf4\xf4\x96\xe6\x75\x72\x43\xdb\xb2\xf5\x67\xe0\x73\x5b\xaf\x78\x67\x78\xd5\xd2\xae\xe6\xd1\x84\xba\x7c\xa0\xac\xb4\x31\x9d\xd9\x70\xea\x6a\xad\x35\x39\x41\xf5\xc3\x65\x47\x1e\xa2\xfa\x79\x83\xeb\xe7\x49\x56\x3b\x23\xf0\x5b\x05\xfe\x1c\xbe\x39\x87\x4b\x0d\x26\xae\x1b\x38\x6a\xb4\xdd\xbb\x70\xbd\x91\x23\xbc\x9e\xdb\x2f\xa7\x9d\x16\x7d\x58\xef\x5d\x4e\xd6\xd7\xc1\xfc\xbe\x4c\xda\x81\x3c\xda\x17\xf1\x18\x5c\x6d\xd0\x5e\x9d\x4b\xeb\xb1\xd0\xbe\x6e\xde\xbb\xbc\x06\x97\x1a\xb4\x97\xe6\xd0\x92\x3d\x62\x9e\xcf\xe0\xbb\x02\x7f\x55\x8a\x8b\x3e\x18\xce\xe7\x49\x2e\x85\x2b\x8d\x3e\xae\xcc\x9d\xe7\x9e\xbc\x79\xf2\x36\x82\x9f\x1e\x4b\x5f\xef\x1b\xf3\xa4\x7e\xf5\x0e\xa3\xaf\x3b\xac\xfc\xfc\x44\x8a\x5a\x68\xdf\x33\x7d\xc8\x95\x70\x96\x41\x7b\x56\xee\x3c\x6b\xf3\x68\xdf\xa6\xb2\xb9\xc5\xa0\xbd\x25\x87\x56\xe8\x59\xe7\x31\x23\x80\xc4\x62\x46\xcb\xef\xbb\x85\x1c\x7e\x86\xef\xcc\xcd\x0d\x9f\x13\x38\x9f\xdb\x3c\x76\x73\x30\xc7\x6e\x1e\x13\x38\xf5\x7d\x16\xfc\x06\x81\xd3\x58\xc6\x82\xef\x10\x38\xf3\x83\xc6\x9a\x5e\xc9\xc7\xad\x81\x24\xae\x4a\x07\x22\x55\x44\x56\x82\x68\x93\xeb\x1d\xc4\x6e\x53\x11\x24\x0d\x11\x94\x71\x23\x9f\xdb\x0c\xc4\x2e\x01\x95\xa6\x0c\x36\xbb\x6c\xdb\xc6\x53\xac\xa0\xaa\xe1\x89\x02\xb4\xdb\x25\xba\xec\xf8\x11\x1d\x00\xa7\x01\xb1\x8a\xaf\x5f\xe6\x13\x5a\x58\x3b\x3b\x91\xae\xfa\x00\x0d\xb9\x93\x68\x07\xa2\x49\xda\xbc\x0d\x41\xd3\xbc\x66\x6b\xc8\x6f\xec\xff\xcf\x8d\xfa\x9f\x1c\x70\x03\x73\x2c\xae\xda\xda\xda\x64\x6d\x6b\xd4\x1d\xa5\x3e\xc5\xa3\xeb\x85\x5a\xa8\x4e\x36\xea\x55\xbd\x86\x5f\x49\x25\xe7\x75\x30\xad\xcc\xf5\xc4\xe7\xbc\xcc\x6a\x47\x11\xbd\xc9\x88\x27\x6a\x57\x16\xe6\x7b\x9b\x33\xb7\xf9\xaf\x33\x1d\xce\x44\xb0\xc8\xe1\x6f\xf4\x47\x82\xad\x8b\x73\xdd\x0e\xf5\x43\xf5\x86\xe3\x01\x8b\xee\x1f\x11\xba\x7f\x1e\x8f\x5a\xec\xfa\x6e\x61\xab\x1c\x47\x8d\xfb\xa6\x37\xa9\x5d\xcb\xb8\xf7\xcd\x5c\xbb\xfe\x8a\xe8\xe3\x05\x3c\xc2\xfe\x84\x02\x8a\xbf\x45\xed\x27\x0e\x0d\xd0\x01\x5f\x37\x6e\x13\x3d\x85\x88\xc4\x51\x40\x00\xf5\x10\x51\xe4\x0a\xf3\xa6\x20\x17\x2e\x30\xe0\x0d\x46\x93\xf8\x22\x3b\x81\x02\x94\x15\x90\xb7\xd9\x90\x65\x73\x59\x78\x12\x9e\x28\x2e\xe4\x89\xff\x22\x96\xba\x0f\xb1\xd4\x7d\x1d\xc8\x05\x20\xaf\x65\xe4\x50\xa0\x40\xc1\x89\xa8\x37\xa4\x2b\x1b\x1b\x13\x09\x80\xc6\x8e\xc6\xf6\x96\xe6\x44\x43\xa2\xa1\xae\x86\xb2\x1a\xd3\x75\x57\x54\x8f\x78\x4a\xb2\x89\x7b\x9e\x12\x30\x74\xe1\x67\xca\xf1\x7a\x35\x43\x73\x7e\xa6\xb0\x84\xaa\xea\x68\x24\x0d\xd4\x8e\x76\x1e\x78\x27\x27\x16\x9f\x7b\x4e\xbc\xa6\x21\xac\xd7\x8d\x45\x7b\x1c\xce\xae\x8a\x6b\x9e\xee\x6c\x6e\x0c\xea\xf5\xc3\xf1\x25\x1e\x67\x6f\x14\x23\x55\xb1\x78\xed\xc6\xd0\xea\xc5\x91\x68\xe1\x78\xef\xd8\xa4\xbc\x64\x71\x5d\x7d\xaa\x2a\x14\xaa\x7a\x22\xae\x35\x77\x0f\xc7\x6a\xdb\x63\x95\x95\x31\x4c\xa4\x07\x96\x0e\xbc\xb2\x76\x8d\x5c\xd3\x56\x5d\x69\xe8\x8b\xcb\x94\xad\x79\x94\xcd\xbd\xc2\x58\xab\xbd\xbc\x46\x6f\x37\x78\xa1\x12\x1e\xdd\x57\x48\x78\x21\x8f\xb1\xc2\x42\x80\xc8\x53\x21\x64\x0a\x98\x20\x34\x64\x15\xd5\xaa\x44\xac\x5b\x6e\x55\x2e\x11\xdf\x1f\x39\xa5\x34\xa5\xf2\x23\x38\x5f\x39\x27\xef\xed\xe4\x1d\xf1\x9d\x96\x26\xcc\x00\x7c\x95\xbe\x70\x79\x19\x3b\xd8\x27\x9c\x6e\x1b\x3f\xd8\x27\x34\x51\xf3\x66\xde\x23\x5a\x2b\x1c\x92\xbf\x19\x0e\x94\x36\xae\x56\x25\xdf\x44\x62\xdd\xd6\x8e\xf5\x2d\x0d\xa3\x4b\xba\x3a\x87\xaf\x2c\x8c\x45\x7a\xc9\x6e\x16\x96\x1c\x89\xd5\x5f\x76\xe1\x8a\x9d\xa9\xf4\xda\x4d\xeb\x37\x9c\x35\xf9\x35\x26\xa3\x9c\x3a\x0b\x9d\xd5\x60\x83\x6c\x43\x45\x92\x69\x40\x20\x4a\x2e\x34\x5e\x72\xa1\x1a\x25\x17\x81\x00\x2b\xba\x08\xe8\x81\x48\x45\xb9\xa5\xf4\xc2\x7e\x82\xd2\x8b\xb8\x05\x3f\xbe\x0c\xc3\x51\x56\xea\x76\xd3\x27\x8c\xf9\xe5\x18\xbf\x28\x73\xb9\xcb\x82\x2e\x57\x19\xe7\xb3\x93\xe9\x10\x9f\x85\x72\xaa\xc3\x28\xab\xb3\xac\x74\x94\x10\x54\xac\xaf\xf1\xc2\xc7\xbf\xc6\x2b\x87\x20\x75\x16\xfc\x35\x1e\x37\x48\xce\x87\x35\x37\x69\xbe\x51\xf2\xb3\xbc\x64\x22\x85\x5b\x5b\x6b\x97\x9c\x39\x78\x76\x4f\xcf\xd9\x8b\x53\x1b\xcb\x1b\x30\xd4\xd7\x90\x58\x52\x5d\x33\xd6\x40\xaf\x5c\x14\x87\x42\x48\x47\x67\xcb\x68\xff\x8e\xa1\xa1\xf3\xfa\x1b\xaa\xeb\x31\x1e\xae\x5b\x91\x4c\xae\x6e\xc4\xb0\x26\x2b\x2c\x7f\xcb\x6c\x90\xf3\xc9\x6d\xd0\x66\xd8\x20\x59\x04\x73\xf8\x5e\x81\x3f\x4b\x5c\xe6\xbc\x7a\xf8\xbc\x9a\x20\x09\x29\xf8\x7a\xda\x97\xd4\x23\x74\x5e\xaa\xa2\x20\x91\x8a\x11\xc9\xa2\x13\xbc\xc2\x0b\x7f\xc0\xd7\x61\xe1\x0f\xfc\x3a\x2c\x3c\xdf\x2b\xbc\x26\x68\x8c\xc7\x9d\xba\x5e\xcb\x5f\xe1\xa5\xfc\xf3\x26\x86\x1b\xc9\x49\x85\xdd\x35\x7e\x5c\xf2\x77\xe0\xac\xce\x13\xc9\xbe\xb7\xc0\xfa\x04\x6c\x9c\xa4\xb7\x0f\x2e\xa8\x0a\x00\x99\xc9\x96\xeb\x42\xe2\xb2\xed\x81\x47\xd3\xe5\xa9\x44\x9c\x8a\x56\x55\xec\x48\x24\x17\x22\x71\x5a\x5f\xe0\xfd\x3f\x23\x63\x59\x96\x93\x72\x2b\x97\x71\x23\x95\x31\x7f\x7b\xe7\xfa\x4f\xca\xf9\xca\x99\xf8\x9a\xaa\x0f\x28\x6b\x72\xdd\xc7\xca\xee\x3a\x76\xf3\x07\x92\xb8\xb0\xf1\x3d\xc2\xc6\x9f\x21\x01\x8b\xed\xdf\x2e\xf0\x03\xfc\x8c\x45\xa0\x81\xe6\xbc\x1f\xa7\x7e\x39\x08\x09\x38\x7b\x5f\xa0\xc0\xf4\xcb\xa5\x56\x4f\xca\xc5\x67\x78\x52\x32\xa5\x9a\x07\x7a\xe1\x49\xc5\xef\x40\xc8\xf1\x9e\x34\xed\x29\x2f\x07\x28\x4f\x94\xc7\x23\x95\x74\x88\xb2\x80\x3b\x6e\xf8\x51\xe1\x05\x0c\x6f\xea\xa6\xe9\x20\x9d\x82\x6a\xae\xd3\xfa\xd6\xba\x8e\xce\xf5\x2d\x8d\x4b\x5d\xcb\x54\x09\x25\x6d\x44\x93\x7c\x4b\xeb\xc6\xba\x07\x06\x7a\xd3\x4b\x06\x0a\x52\x1f\x5a\x31\x79\x6e\xb2\x36\x86\x6e\x5e\x10\xf8\x8b\x40\xd8\xe1\x08\x07\xe2\x75\x03\x67\xae\x5c\x75\xe6\x86\xe5\xeb\xb7\xb1\xf9\x55\xcf\xbe\x8b\x07\xc8\xe7\xe9\xe0\x35\xec\xcd\xb5\x9d\x67\x6b\xa8\x02\xdd\x4e\x47\xf1\xa2\xa2\xc2\x02\x18\xcd\xfa\xd8\x70\x6e\x59\x1b\x67\xbc\xa6\xbc\xda\x60\x3c\x6e\x94\xb5\x89\xf8\x87\x97\x66\x50\x0b\x60\x85\x19\x73\xbe\x56\x68\xfe\xc7\x5b\x7a\xba\x37\x27\x57\xb4\x54\xb5\x5f\xe3\x3a\xbb\xb9\x63\x55\x73\xe2\x3c\xe7\xda\xf3\xb7\x9f\x91\x5a\xb6\xb2\x23\xd2\x5f\x1d\x4b\xc7\x8b\xfa\xce\x1b\x5c\xbc\x6b\x49\xdb\x64\x77\xb4\xb9\xbc\x3a\xe0\x08\xea\xa1\xfa\xd2\x6b\xbe\x7b\xc5\x6d\xfd\xa7\x6d\xdc\xb0\xb6\x7a\x32\xd5\x7e\x4a\x23\xf3\x4d\xa5\x54\x3f\x2f\x53\xfd\x94\x42\x02\x92\x70\x59\xba\xa8\x32\x4c\x00\x7d\x05\x44\x55\x48\xf6\x4e\xd9\x26\x13\xae\x09\x45\x31\x27\x62\x47\x55\x25\x53\x9a\xd0\x94\x6e\xa5\x10\xba\xe2\x64\x3c\x92\x34\x74\x55\x56\x5d\xcd\xce\x97\xd5\xc9\xea\xd6\xc6\xfa\xb2\x44\x59\x9c\x9d\x31\x7d\xee\x78\xc1\xf1\x1a\xf3\x30\x85\x51\x7d\xb5\x33\x7d\x01\x9d\xbe\x46\xa5\xd1\xd1\xce\x36\x18\x62\xd1\xde\x8d\x23\xb5\x4d\xe3\x35\xd1\x8e\xe2\xe5\x05\xa8\x8c\xca\xce\xde\x0a\xf4\x78\x95\x64\x6d\xb1\xea\x77\x1d\x6c\x69\xac\x49\xb6\xb5\x35\x17\x74\x7c\x68\xd2\x54\xa2\xea\xd1\x8e\x7d\xc3\xef\x29\xf4\x27\xe2\x0d\xe4\x9f\x3c\x0e\xfb\xa2\x2b\x1d\xf5\x9e\x8a\x4a\xb7\xf7\xd8\xa7\xb7\x2e\x5b\x75\xe6\xfa\x15\x54\xa3\xc2\x66\xdf\xa5\x32\xd1\xa1\x09\x2e\xdb\xe7\xb7\xd3\x68\x3f\x6b\xb3\x71\x0d\x15\x19\x41\x52\x60\x2b\xa8\x2a\x9d\xb1\xcd\xc6\x17\xb5\x11\x36\xe3\x94\x59\xce\x1e\x4c\xd7\x2e\x48\x08\x94\x82\x53\xf3\x00\x21\x44\xa8\x64\x5c\xf4\x46\xa6\x29\xda\x18\xf3\x3a\x9d\xee\x78\xcc\xe3\x60\xe1\x31\xea\xc2\x68\x73\xb6\xb5\x76\x76\x89\x61\x84\x08\x39\xd2\xc0\x77\xfb\x85\x09\xb7\x64\xed\xfa\x96\x5b\xa8\x65\xf7\x3f\x6e\x5a\x34\xb1\x65\x06\xb2\x46\x9c\x35\xee\xeb\xba\xd6\x33\xeb\xae\x3f\xf6\xae\xb0\x6a\x91\x7b\x66\xeb\x36\x82\x25\xe2\xcc\x65\xe0\x33\x02\x17\xf7\x17\xfc\xac\xf4\x3e\x7e\x17\x9f\x07\x0f\x04\x59\x84\xe1\x72\x12\x82\x38\x4e\x85\x00\x48\x14\x9c\xc9\x59\xc5\x62\xfd\xf2\xc2\xe9\xa0\x37\x58\xea\xa7\xed\xdc\x11\x4f\x8c\x2f\x5e\x73\xaa\x4c\xef\x55\x3e\x33\xd0\x74\xf3\x79\x6a\x0f\x5d\xde\x39\xd5\x73\xca\x36\x92\xf9\xb6\xbc\x6e\x70\xb4\xb1\xba\x7b\xeb\x59\x8b\xdb\x5b\xc7\xed\x05\x57\x9e\x3f\xb1\xa3\xeb\x43\xdb\x0b\x7d\x6b\xd6\x25\x13\xc3\x58\x9e\x9e\x18\x5e\xca\xf9\xe5\x7c\xf1\xbd\xda\x6b\xc6\x8b\xef\x58\xf0\x5b\x05\xfe\x1c\x9b\x87\xc0\xf7\x0a\xfc\x59\xfc\x0b\xfb\x93\xe2\x3d\x9c\x7e\x11\xb4\x43\x2f\xfc\x2d\x5d\xe6\xf3\x12\x56\xd6\xa3\x6a\x11\x54\xd4\x2a\x94\x69\xb8\x5e\x60\x97\x88\x0c\xe6\x1e\xd3\x09\xaa\xa6\x68\xea\x2e\x80\x42\x44\x1b\xe0\xb6\x22\x2c\x00\x22\x15\x90\x19\xb0\xdb\x6d\x53\xd4\x1e\xa8\x2c\x24\x49\x9b\x02\x4d\x0b\x4d\x64\xf7\x89\x2a\x39\xbb\xe9\x74\x19\xed\x95\xab\x3e\x78\x07\xe9\x8e\x13\xb4\x55\x80\xb2\xba\x65\x9e\x2e\x34\xb6\x2d\x55\x76\x74\x00\x74\xf4\x76\xf4\x76\x77\x26\x5b\xea\xeb\x6a\xab\x63\x7a\x65\x85\xc7\xe5\x28\xa1\x13\x2f\x8a\x2d\xa2\x4e\xca\x15\x37\x2f\x0c\xf9\xb7\x0f\x5c\x42\x45\xf3\x5e\x1c\x0b\x55\x26\x0c\xfd\x1d\x1a\xf3\xb7\x39\xea\x2b\x2a\xea\x1d\x6d\xfe\xb1\xc6\x6f\x09\x3d\x36\x8e\x1d\xb7\x73\xcd\x5c\xca\x15\x3d\xc6\xb5\x4b\xca\x9a\xeb\x03\x7a\x55\x95\x1e\xa8\x6f\xce\x1c\xe2\x2a\x4e\x15\x55\x5b\xef\x83\xf1\xca\x5d\x5c\xff\xfe\xe3\xf4\xbe\x47\xe8\xf1\x19\xcc\x08\xfc\x6d\x29\x6e\xe2\x3a\xc7\x8d\x9c\xca\xb5\x0b\xe5\x54\x04\xed\x61\x12\xca\xeb\x8b\xe5\x54\x44\x5f\x04\xcc\x9c\xca\x15\xd6\x9c\x8a\xa0\x7d\x56\x8a\x5a\xc6\x9d\x35\x73\x2a\x1f\xcd\xcd\xa9\x88\x7e\x6b\xf3\x68\xdf\xc6\xf7\xf3\x73\x2a\x56\xfb\xe6\x39\x95\xaf\x99\x39\x95\x15\x96\xdf\x77\x0b\x39\xfc\x0c\x8f\x59\xf0\xdb\x05\x7e\x00\xff\x6a\xc9\xb5\x3c\x2f\x70\x3e\xe7\x79\xd6\xd1\xc1\x9c\x75\xf4\x98\xc0\x5f\x24\x8b\x2c\xf8\x0d\x02\x7f\x29\x67\x7d\xed\x10\xf8\xa1\x1c\x59\x0e\x89\x79\x70\x9c\xe9\x85\xdf\x0f\xd9\x44\x8d\xe6\x89\x68\x0f\xce\x4b\xbb\x52\xcc\x47\xbc\xcd\x05\x02\xa9\xd9\xbf\xe0\x53\xf8\x1c\x78\xa0\x0e\x86\xd3\x4b\xaa\x50\x22\xac\x30\x8a\x2e\x66\x59\x1a\x37\x2b\xe0\xc4\xf1\xc8\x88\x4d\xc4\xf1\x88\xbb\xad\x3a\x6f\x6d\x9c\x16\x49\x05\x4b\x99\xeb\xaa\xb3\x89\xed\x3b\x95\xb5\xf9\xbc\xc3\x1b\xcd\x75\x88\x62\xa9\xc7\xd6\xb7\x34\xd5\xf4\x36\xac\x68\xee\x9f\x6a\xeb\x38\x23\x3d\xb0\x6c\xbc\xbf\xa1\x71\x93\x83\x76\x63\x6b\xee\x4e\xb7\xd8\xaa\x97\xd6\xd6\x76\xdb\x23\xdd\x7a\x67\x65\xdf\x79\x4b\x97\x6e\xef\xeb\x3d\x7d\xcd\xe4\x99\xb1\x70\x3f\x2e\x41\x92\x88\xc7\x9b\xe6\x64\xf9\x15\x21\xcb\x17\xf0\x5d\x73\x6e\xef\xe1\x0f\xd8\xdc\x84\x2f\x46\x76\x84\x42\xbe\x25\x29\x33\xc2\x03\x87\x45\x70\x15\x82\xf9\x7d\x31\xf0\x49\x18\x09\x9a\xaa\xb8\x99\x07\x90\x8c\x07\xb7\x38\x6e\x3a\x63\x5c\x4a\x17\xf1\x48\x93\x70\xc6\xf8\x5c\xae\x37\xce\xfc\x9e\xad\x4a\xca\xef\xa6\xd9\x49\x7c\x1c\xf7\xa3\x06\x43\xe0\x99\xdd\x48\x2b\xbb\x01\x1f\x9d\xdd\xc8\x54\xc5\x7d\xec\x00\xbf\x8f\xf9\x1c\x04\xa0\x02\x22\xb0\x6e\x5f\x69\x80\x20\xa0\xf9\xd2\xa1\x9c\x92\xf0\x6c\x93\xcf\xfc\x64\x83\x82\x88\xfe\x89\xec\xdb\xa3\x32\xf3\xfb\x3c\xdb\xf2\x7f\xa4\x35\x2e\xba\x93\xfe\xe5\xd6\x8d\x0f\xf5\x18\x4a\xb0\x94\x04\x98\xb5\x2c\xbc\x78\x25\xe5\x8b\xeb\xf8\x0d\x2c\x4f\xe8\x4d\x1d\x77\xdf\xbd\x74\xcb\x96\x4d\xcb\xeb\x27\x02\xad\xac\x24\xa5\x50\xd5\x90\xd4\xa1\x1e\xe8\xec\x8f\x77\x65\x76\xe1\xed\x5d\xf1\xf5\xeb\x2b\xc3\x9d\xac\xc0\x44\xb2\x29\xc6\x1c\xe2\xb3\x6b\xf0\x97\xf8\x0b\x68\x84\x6e\x18\x83\xdf\x1a\x8f\xa5\x3c\x35\xfc\x91\xae\x06\x95\x85\x04\xb5\x74\x39\xb1\x11\x9e\xf3\xa1\xb0\x92\x0b\x8b\x9c\x4f\x6d\x01\xc5\x91\x68\xb8\x4d\x45\x00\x79\x4a\x31\xb2\xf6\x84\x70\xb7\xed\x9d\x00\x33\xd7\x56\x45\x27\x6f\x0c\x52\x2d\x5a\x00\x01\x9b\x9d\xd8\xac\x2d\x73\xe8\xd3\xad\x0b\x90\x02\xa3\x64\xff\xc9\xcb\x47\x72\x1a\xb1\x9c\x45\x53\xd3\xc8\xd2\x81\xbe\xa6\xee\xa6\xae\xb8\xde\x14\x8d\xc5\x2b\x0b\xa9\x3c\x81\x1a\x45\xb6\x56\xcc\x78\x8b\x6d\x6c\x03\xd9\xb5\x20\x36\x01\x96\x3c\xf2\xfb\x55\x4b\xc8\x42\x17\x0c\x6b\x64\xb4\xea\x68\xc7\x67\x9d\xcd\x95\xce\xc4\x58\x73\xbc\xca\xd5\x37\x79\xc6\xc8\xf4\xd6\x33\x77\x56\x87\xab\x6a\x4a\xcb\x28\x36\x56\x1f\x48\x86\x9d\xf6\x27\xbb\xba\xea\xea\xe8\x3f\xbb\x9d\xad\xad\xa3\x75\x01\xf7\xb2\xa1\xc1\xc5\xf8\x29\x6f\xa4\xa6\x61\xac\xb6\xd8\xe7\x09\x78\x7b\xba\x7a\x26\x7b\xbb\xa3\x6d\x25\xbe\x44\x75\xbd\xaf\x3f\x56\x3f\x56\xdb\xe4\xf3\x62\xcf\x0f\xba\xe9\x82\x62\xff\xbc\x19\x5c\xb4\xc8\x11\x29\xa7\x99\xab\x08\x5b\x43\x5c\x5f\xd4\x07\x47\xb0\x99\xbf\x19\x3b\x66\x07\x98\xc3\xf7\x72\xdc\x88\x03\x0e\x59\xf0\x3d\x02\x7f\x06\x0f\xcf\xe1\x52\x9d\x81\xa3\x46\x7e\x81\x47\x66\xdf\x37\xde\x2e\xcc\xbe\xcf\x2c\x5c\xb4\xbd\x5d\xb4\x3d\x80\x2f\x5a\xfa\xdc\x21\xf0\x43\xac\x4f\x40\x6a\x3f\x80\x7b\xa9\xef\x0e\x40\x22\x1d\x55\x91\x48\xfc\xb1\x16\x7f\x8a\x20\x92\x21\x3c\x02\xf7\xe8\xee\x84\x83\xbd\x9e\x74\x5b\x73\x47\xd4\xba\xcd\x23\x83\x53\x1f\x9b\x54\xa5\xe0\xaa\xda\xc5\xe3\xc3\x8d\xf1\xca\xfe\x9a\x61\x23\x5b\xf4\x5e\x6d\xeb\x69\x5b\x32\x6f\xa0\x7b\xa8\x5f\x5f\x5c\x9b\xf9\x2b\x20\x80\x39\x66\x25\xf4\xa4\x3b\x43\x08\xc8\x2b\x30\x35\x94\x6c\xb2\xb4\x35\x5b\x74\x64\x9b\xb2\x23\x8b\x1d\xcc\x97\xe0\x00\x94\x3c\x6c\xbc\x07\xd7\xdd\x05\xb4\xe2\x06\x72\x47\x77\x5a\x62\x56\xaf\xce\xd8\xc3\xad\x59\x4e\xc6\x18\x6b\x57\x5f\x4d\x99\xc3\xe7\x73\x98\x21\x36\x33\x34\xed\xba\xbd\xb6\x95\xcb\x69\x6c\x76\x15\xe7\x2d\x82\x29\xeb\x7b\xf1\xd9\x9f\x33\x9e\xa5\xc5\x1c\x67\xb2\x3f\x82\xbb\xa0\xd6\xb8\xc7\xac\x65\xa2\x37\xda\x4e\x5b\xdb\x8a\x78\xf6\x2c\x00\xbc\x99\xeb\xa4\xcf\xd4\xc9\x1f\xb9\xec\x39\x8e\xfb\xc1\xce\x64\x0f\xe6\x93\x72\x3f\x7f\x3b\x2b\x6f\x9e\xff\x2b\x08\xfc\xf1\x31\x7b\x68\x7f\xd6\xc5\x3b\xbf\xb9\xbd\x1b\xd7\x76\x67\xce\xef\x9e\x1b\xe3\x5b\xe6\x18\x84\xfc\x9d\x85\x94\x02\xbf\x95\xe3\xe2\x4e\x4a\xe0\x7b\xb2\xf4\x12\x50\x98\xe1\xe3\x1c\x7f\x88\xe3\x79\xf7\x27\xa2\xdd\x6e\xd1\x0e\xad\xe3\x48\xfd\xa2\x1d\xbf\x57\xe1\x7b\xeb\x11\xb8\xd3\xd8\x2f\xef\xe4\x72\x5a\x40\x1e\x1c\x9f\x9d\xb6\xe0\x3a\xc7\xf3\xe5\xf8\x6d\x31\xc7\xf7\x9e\xb4\xce\xf1\x31\x8e\x8b\xbb\x18\x81\xdf\x20\xf0\x97\x72\xe6\xbe\xc3\xc4\xc5\x5d\x0c\x1d\x3f\xc4\xc7\x2f\x84\x08\xf4\xa7\x7b\x22\xec\x35\xdc\x88\x26\xde\x68\x1a\x1f\x9e\xa1\xf6\xe9\xa7\xfe\x8b\x7f\x3a\xc3\xbc\xbf\xaf\xaa\x0c\x57\x84\xca\x83\x65\xa5\x01\x9e\xc2\xb4\xb1\x08\xd6\x92\xaa\x74\x59\x2b\x45\x74\xa1\xc1\xdf\x9b\x6f\x31\xc5\x1b\xf2\x7f\x67\x2a\x25\x35\xd9\x47\x98\xc7\xbe\x65\x7d\x4b\x6e\xaa\xda\xc2\xff\x67\x04\xff\xf4\x9d\x01\xc7\x9b\x67\x57\xe3\x87\xb9\xfc\x96\x9b\xf9\x11\xfe\x3b\xc7\xcf\xa7\x76\xe6\x86\x52\x08\xb1\x2f\x06\xd9\x34\x5e\xce\x0d\x32\x41\x22\xe3\x55\xfc\x0a\x9d\x25\x0e\xfc\xd6\x44\x13\x40\xa9\x9f\x36\x71\xbb\x13\x3a\xab\x68\x47\xa3\x20\x29\xe7\x89\xb9\xe4\xe1\x2f\xcc\x5b\xfd\x1a\x9e\x7f\xe6\xf2\xfa\x65\x65\xd5\x88\x9e\x96\x48\x53\xe7\x43\x65\xaa\x24\xa9\xc5\x44\x22\xb8\x3f\xb3\x7c\xcb\x19\x95\x95\x1d\x88\x65\x15\xc6\x43\x52\x8a\x12\x59\xa6\x63\x66\x79\xfe\x35\xd9\x23\x78\x7e\x86\x0c\x1a\xef\xa7\x67\xff\x4e\xf1\xdd\xe0\x64\x75\x13\xfc\x6d\x39\xc1\x71\x9e\xac\x11\x6b\xc4\xf2\xbe\x3c\x25\xde\x71\x3b\xe9\xc9\x20\xa9\xb9\xb5\x27\x7a\xd9\x77\x21\x5e\x29\x2d\x2f\x7b\xec\xbf\x6f\x7e\xa5\x73\x8f\xe1\x8c\x32\x37\x3e\xaf\x3c\x92\xf9\x63\xf6\x1d\x6b\xe6\x28\x1f\x23\x82\x93\xa2\xae\x4e\x8c\x8d\x4f\x82\x83\xbd\x6b\x2f\xb6\xcf\xbd\x6b\x9f\x1b\x37\x6e\x1d\xd7\x43\x7d\x8e\x65\x58\xfb\xab\x74\xd4\xc7\xf9\xa8\xb8\x5f\x76\xc9\x8f\xbe\xa8\x3c\x6a\x8c\x09\x08\x74\x24\xfc\x67\xb2\x3b\x77\xcd\xfb\xfe\xb1\x35\x3f\xf9\xb1\x6b\x7f\xfa\xd1\x6e\x12\xeb\x3e\xf6\x05\xbe\xe6\x79\x5f\xdc\x27\xad\x16\x75\x3e\xb9\x3e\xc9\xa0\xd9\x4f\x3e\xc7\x69\xd8\x1c\xff\x04\xf7\x1b\x38\x9d\x3b\xe3\x83\xe3\x62\xee\x04\x96\x52\xfa\xc7\x38\x7f\x5e\xf6\x06\x01\x14\x1b\xaa\xa0\xa8\xdb\x8e\x67\x55\x33\x58\x2d\x28\x00\x28\xf0\x16\x78\x1c\xc5\x59\x96\xed\x39\x2c\x13\xcb\x3a\x58\x7a\xe1\x8e\x7d\xf7\x35\x47\xf4\x80\x3f\xa6\x97\x9a\xd3\x40\x57\x55\x20\x50\x15\xf1\xfb\x23\x8c\xa7\xd9\x69\xc1\x93\xf5\x0d\xb5\x9b\xda\xc8\xd7\x4d\x9e\x6a\xe0\x4b\xfb\x02\x7e\xa2\xc9\x68\xbe\x9c\xeb\x12\x4f\x0f\x7c\x13\x45\xa8\xaa\xc6\x7f\xf3\x64\x88\x7d\x33\x0d\x2e\xb2\xfe\xb4\x90\x31\xcc\x2e\x11\x0b\xa6\x16\x61\x41\x41\x55\x01\x8d\x51\x52\x62\x56\xbc\x29\x8f\x48\xfe\x91\x96\x1b\xd2\xf4\x44\xca\xa6\xee\xab\xf1\x55\xc7\xa2\x3a\xad\x65\xc8\x15\x42\x71\x8e\x10\xac\xce\x80\xe4\xbf\xca\x76\x2f\xee\xb9\xe1\xca\x95\xa6\x37\x78\x39\xf7\x4d\xb6\x29\x26\x8b\x57\xa8\x99\xe7\x51\x36\x97\xdd\x8c\x90\x9d\xc8\x99\x58\xec\xcd\x09\x6d\xe9\x16\x96\x2d\x54\x15\x1a\x48\x32\x83\x5e\xd8\xf8\x9c\xc0\x3e\xed\x92\x35\xbe\x76\xad\x3d\xc5\x59\x65\xef\xb3\x99\x11\x4e\x4e\x5e\xfb\xd3\x4d\x9b\x3e\xda\xfd\xab\xe0\x60\x10\xd5\xee\xcc\x4b\x55\x4b\xc3\xef\x75\x03\x42\x1c\x00\x5f\xc4\xe7\x21\x0a\xb7\xed\xb3\x11\x22\x89\xdb\x31\xaf\xca\x1f\x2c\x12\x24\x00\xfe\x09\x4d\xe1\xf5\x60\xd9\x5b\xb1\x52\x60\x20\xa3\x30\x52\x5b\x61\x2b\x81\x7f\xe1\xd6\x0b\x37\xe4\xb7\x5f\x85\x1e\x9d\x06\x0a\x51\x9d\x57\xe7\xd2\x98\xa5\x43\x5c\x2e\xfa\xb4\x9c\xb8\x41\xfc\x57\x7c\xd0\xd1\x1a\xac\x68\x50\x17\x75\x46\x1a\x1a\xc8\xa0\xcf\xeb\x8a\x78\x97\x78\x43\x25\x61\xcf\x20\xee\x6f\x28\x0d\xc7\x43\x15\xb1\xc5\x6d\x99\x2f\xe0\x50\x57\x24\xd2\x5e\x96\x79\x16\xfb\x3b\x13\xc1\x96\xca\xcc\x53\x3c\xbe\x9a\xc6\x17\xf8\x19\x70\x53\xce\xfb\xea\x51\x00\xfc\x17\x9e\xb3\xba\xea\x11\x82\x44\xca\x8a\xc4\xc5\x5f\xc4\x20\xb2\x29\x98\x11\x96\x29\x0f\x1f\x9f\x56\x5e\xfc\xc5\x85\x31\x7f\xbb\x85\x9a\x50\x31\x7c\x93\xca\x40\xcf\x86\x6d\xf9\x21\xdb\xe8\x70\x70\x75\x63\xdf\x18\x0d\x93\xc2\x03\x35\xc3\xb8\x7f\x55\x6d\xeb\xe6\x33\x8d\xf8\x28\xba\xb8\x26\xf3\x57\xc3\x4f\xf2\x39\xf0\x98\x60\xb3\x99\xdf\xfa\x8b\x05\xdf\x2b\xf0\x67\xf1\x98\x81\x67\x8e\xb2\x39\x0b\xfc\x65\x52\x64\xa1\xbf\x5d\xe0\x07\x30\x63\xe0\x54\x76\x56\xfa\xc3\xc4\xce\x65\x37\x44\xcf\xd5\x7b\x29\x1e\x86\x7d\xe9\x62\x2f\x12\x24\x08\x04\x51\x01\xc9\x14\x62\xb9\x28\x5f\xf6\x9b\xaf\xd3\xd9\xb1\x42\x45\x45\xa9\x52\xb2\xe2\xac\x04\x73\x5f\x33\x8e\x1e\xf3\xd2\xf9\x4f\xda\xd7\x49\xbb\xe1\x36\x57\x6c\x7c\xba\x53\x77\x7a\xa2\x31\x8d\xca\xdc\x67\xbc\x78\x6f\x6f\x9f\xb3\xb6\xac\x16\x98\x2f\x78\xba\x2e\x42\x8a\xca\x4a\xcf\xa0\x41\xea\x40\xf5\xf8\x78\x19\x8d\x9d\x97\x6d\x5e\xbe\x37\xb2\x04\xdd\xc5\xf7\xbd\x6a\xaa\x80\x2b\xe5\xb4\x2d\xbf\xba\xf8\x42\x53\x56\x33\x39\xb2\xfa\x2d\x99\xd3\xd1\xbf\x92\xaf\x08\xfc\x05\x64\xca\x23\x90\xa0\x39\x88\x83\xf8\x1c\x94\x40\x48\xbc\x7f\xcf\xbf\x28\x70\x38\x00\x1c\x21\x47\xb9\xdf\x4b\x09\x8b\xad\x17\x05\x22\xc9\xd0\x4f\xc4\xa1\xca\x97\xfc\xee\x74\x47\xc7\x74\x5f\x1f\xff\x77\xa2\x4f\x5f\xd6\xd3\xb3\x4c\xef\x4b\xd8\x7a\x69\x1a\xe1\xbc\xbe\x3e\xf6\xef\xde\xde\xc6\x55\xc9\x15\x5b\xb6\xac\x48\xae\x6a\x64\xfc\x71\x3e\xb8\x0d\x6d\x15\xf5\x57\x73\xf8\xad\x1c\x17\xf1\xa9\xc0\xf7\x08\x9c\xd6\x18\x09\x9c\xd7\x2b\x71\x7c\xe1\x7a\x25\x83\x76\x5a\xf4\x91\x5f\xaf\x64\x8e\x21\xf5\x88\xdf\x17\xac\x57\x12\xb4\x51\x41\xbb\x60\xbd\x92\xa0\xad\x15\xb4\x0b\xd7\x2b\xe5\xc8\x25\x3f\xde\x16\xbf\xef\x16\x72\xe0\x71\xb5\x18\x03\x39\x6e\x8d\xb7\x0f\xe1\x7b\x3c\xde\x96\x45\xbc\x9d\x4f\x4b\xe3\x6a\x51\x8b\x29\x62\x73\xab\xcc\xf0\x39\x4e\x2b\xfc\xd8\x3c\xfa\x3b\x98\xa3\xbf\xc7\x38\x2e\x62\x6f\x20\x26\x6e\xe3\xef\x07\xb7\x3e\xe2\x47\xe3\x4a\xce\x6f\x2c\x48\x94\xae\xe7\x51\x1c\xc8\xb2\xe9\xb1\xd8\x22\x9a\x7b\xef\xae\x9b\x24\x28\x23\x91\xaf\x9a\x9f\x8a\xae\xb6\x40\x80\x3d\x22\xac\x71\x39\xe3\x6c\xb5\x89\x44\x92\x25\x19\x60\x3e\x7e\xef\xb0\x98\x2e\x3e\x5c\x2e\x4c\xb7\xbf\xd9\xb1\x28\xed\x0e\x5e\x22\x2c\x18\xcf\x5d\xd3\xc2\x6c\x97\xdb\x71\x18\x23\x2d\xdd\x5d\x85\x99\x87\x2c\x96\x7c\x9c\x2c\x2e\xc9\xb5\x65\x6e\x6f\x36\x81\xe7\xc9\x8e\xe3\x79\xb2\xe3\xb8\x90\x9d\xc0\xbf\xc2\x71\x51\xeb\x23\xf0\x6f\x0b\x59\x3f\x95\xd3\xff\x0d\x02\x7f\x29\x67\x0d\xed\x10\xf8\xa1\x1c\xbb\x1f\x12\xf6\x40\x71\x51\x77\x94\x9b\xdb\x5c\x98\xf6\x60\x2e\xad\xa9\xf3\x04\xb7\x9d\x20\xd4\xe4\xbd\x6b\x0f\x5b\x0b\xea\x63\x35\xf1\xf9\xde\xb5\x5b\x1d\x8e\x16\x67\xa7\x0c\xa1\x39\xac\xa9\xe3\xa7\xa4\x11\x7b\x5f\xd6\xf5\x0c\x75\x1f\xa6\x1a\x33\xbd\x8f\x47\x9c\x95\x7a\x7b\x84\x17\x5a\x7e\x51\xd9\x83\xab\x1a\x4d\xed\x81\x04\xd5\xb3\x8f\xe1\x01\xa2\x81\x17\x2a\x20\x01\xe9\x74\x5f\x91\x9d\x10\x88\x45\x2b\x24\x89\x78\x90\xa0\x24\x8d\xb3\x17\x00\xa3\x5e\xc4\x91\x39\x47\x29\xe3\xdc\x2b\x8d\x70\x38\x9c\x08\xc7\xa3\x35\x71\x56\x0c\xe2\xcf\xa6\x61\xb3\x17\xa8\xfc\xb9\xbb\xdb\xbc\x41\xf5\xfb\x3a\xcc\x47\xee\xc3\xf5\xe3\x3d\x67\x75\xc7\xab\x63\xed\x89\xf1\xd4\xe6\xae\xd6\xba\xea\x7d\xa5\xc1\xe8\x40\x2c\x72\x4b\x5f\x34\xf6\x99\x50\x65\x6c\xd5\xc8\xb9\xc3\xbb\xd2\xce\xa5\xa1\xb1\xa1\x8b\x26\x76\xf6\xf8\xc6\x71\xff\xa2\xfa\x15\x2d\xcb\x5a\x7a\x6b\x27\xdb\x56\xb7\x64\x7e\xe3\xe4\x76\x67\xf0\xcf\xed\xe8\x3a\x51\x97\x94\x6b\x2f\x5b\xf3\xed\x45\xda\x2c\x74\x47\x71\xa1\xe7\x6f\x18\xba\xfb\x46\xae\x9e\x97\xe5\xd1\x32\xbf\xc2\x69\xc9\xf1\xb4\x95\x79\xb4\xd4\x36\x72\xfb\x05\x09\x54\x7a\x77\xfd\x16\x3e\x0b\xec\x15\x43\x1b\xdc\x9e\x76\x14\xa2\x8a\xb1\x00\x91\xa5\x52\x24\x20\x4b\xe3\x46\x62\x31\x5a\x88\x1a\x48\xb2\x26\xcd\xf0\x7a\x61\x33\x9e\xa3\xfa\x61\x91\x5f\x81\x42\x4c\xc7\xd0\x28\xc8\x40\x50\xb1\x78\x7d\x81\x06\x1b\xd2\x15\xba\xce\x2e\xc1\xf5\x36\x3d\xd9\xd4\x50\x4e\x53\x72\x95\x15\xec\x2a\x5c\x77\x47\xa2\x91\x45\xd4\x67\xc8\x89\xb9\x6f\x65\x98\x95\x80\x15\x44\x5c\x07\x9a\x15\x67\x09\x4a\x60\xbd\x05\x6d\x6a\xad\xeb\xec\x3b\x75\xfd\x92\xde\x48\xa2\x3f\x3d\xfc\x7b\xf4\xd7\x45\xfa\x74\xbd\x6d\xb8\x87\x3e\x73\x6c\x9a\x0c\x49\x05\xf6\x72\x4f\x75\x2a\xd9\x58\x54\x53\xbd\xe3\xba\x0f\x5f\xd8\xd8\xb8\x79\x63\xff\xf0\x44\x28\x5e\xd7\x81\x91\x91\xfe\xe1\x81\xdf\xfb\x9c\xfe\x9e\x25\x5d\xfd\x8a\xbb\x74\xf5\xda\xd3\xa7\xcc\xda\xb2\x0d\xec\xae\x80\x7f\xc3\x7e\x7a\x9f\x83\x58\x6a\x18\xca\x41\x96\xcd\x90\x8f\x7b\x40\xe3\x4e\x97\x85\x7b\xa5\x12\x8f\x4c\x00\xac\xae\x34\x8f\x84\x7a\x4a\xb7\xdb\x5d\xe1\x0e\xc5\xa8\x6d\x3a\x34\x4b\x2c\x98\x75\x98\x09\xef\xdc\x1d\x02\x5d\x76\xbd\x63\x25\xe3\xad\x6d\xa7\xb6\x75\x6c\x68\xad\x19\xf5\x8f\x1f\x9b\x18\xec\xeb\x1a\x21\x83\x5d\xb8\x7f\x63\x6b\x6b\xfb\xb9\xcb\x96\xef\xec\x8c\x47\x7e\xf7\xcb\x53\x4e\x5f\xbb\x69\xea\x8c\xc9\xd5\x73\xfc\xf3\xda\xb8\x2a\x76\x6e\x74\x2b\x7c\x02\x46\xda\x64\x61\xd6\x7c\x3e\x5f\x95\xaf\x92\xf2\x15\x37\xf8\xe2\x47\xe9\x13\xb2\xb6\x52\xf3\x2f\x4f\x30\xde\xd6\xb7\xd6\x72\xde\x86\xfa\xba\x96\x92\x81\x6e\x7a\xbe\x2f\x2d\x7c\x23\x5a\x3f\xc7\xde\x8a\x0d\xeb\xb7\x4c\x6f\xdc\xb8\x02\x38\x7f\xb5\x94\xbf\xff\x86\x2f\x50\xf9\x86\xa8\x7c\x8b\xc1\x94\xaf\xdd\x90\xaf\x38\x41\x88\x1d\xc6\x22\xdf\x05\xb7\xaa\x1c\xf9\x86\xdc\xe5\x51\xa7\xdb\xf8\x88\x68\x2c\xf7\x3a\x9d\x1d\x04\xe3\x74\x12\x59\xfb\xb9\xc0\x3b\xde\xd0\xb6\xae\xb5\xe3\xb4\x54\xd3\xa0\x6b\x68\x74\x64\xe9\xc5\xbd\xcb\x96\xf6\x8c\x25\xea\xfb\x76\x0c\x8f\xed\xe8\xaa\x89\x2f\x39\x76\xfb\xe1\xcd\x53\x0f\x6e\x3c\xf5\x94\x33\xd8\x1a\xba\x74\x76\x16\x7f\x8a\xcf\x53\xde\x7d\x50\x0e\xd7\xa4\x8b\x5c\x08\xa4\xbc\x6c\x91\x84\x40\xcc\x48\x98\x79\x08\xa3\x08\x93\x07\xa9\x5e\x51\xc1\xeb\x05\x0e\x01\x88\xca\x00\xf3\x47\xff\xfc\xad\xe6\x6f\xc0\xa2\xdb\x7d\x34\xac\xd5\x99\xd7\x46\xaf\x59\x1f\xc4\x72\x1f\x1a\x77\x80\x2a\xcd\x03\x69\x6d\xd4\x95\x27\xf0\xa1\x50\xa2\x2c\x9a\xae\x1e\x9b\x20\xee\xc9\xa6\xb1\xe6\x52\x8d\x90\x42\xfe\x05\x91\x73\x31\xe8\x8a\x8e\xa5\xde\xc1\x9f\xae\x69\x68\x6d\x1d\x60\xee\x56\x42\xa2\x98\xb9\x18\x3e\x47\xee\xdb\x6e\xce\xd9\x53\xcf\xe4\xf8\x5e\x81\x3f\x8b\x6f\x19\xf4\x99\xa3\x4c\x26\x02\x7f\x99\xf8\x38\x3e\x6e\xe9\x27\x2f\x9e\xe2\xe3\x4c\x8b\x76\xe2\x9d\x06\xaf\x51\x99\xc4\xd7\xf1\xa7\x50\xc3\x2b\xe8\x3a\xd2\xd4\x5d\x2c\x52\x65\x22\x15\x21\x92\x6a\xfe\x44\x16\xcc\x93\x81\xb1\x17\x30\x83\xc8\x66\xca\xdc\x9e\x9a\x58\x15\x3b\x63\x29\x4c\xed\xa9\x14\xd7\xbe\xe5\x3d\x33\x2d\x2b\x49\xba\xf9\xfd\x83\x4f\xeb\x68\x6f\x8e\xd0\x14\x5a\x8c\x67\xce\xd4\x0e\xbf\x86\xaf\xeb\x5d\x6d\xcb\x11\x5d\xe3\xf5\x61\x7a\xfc\x44\x6f\xb4\xb4\xaa\x27\x3e\x46\xa2\xe5\x9a\x47\x53\x2b\x58\x7d\x4b\xe6\x3c\x95\x10\x75\x11\x91\xc8\x4d\xf1\xf4\xe8\xd5\x8d\xc9\x40\x59\x27\x62\xc0\x13\x1d\xef\x78\x27\xb3\x48\x4f\x48\xa8\x50\x87\xaa\xd8\xbe\x47\x90\xc8\x12\x22\x9a\x32\x3d\x8f\xcb\xe2\x06\x31\x57\x1e\x17\x70\x19\xcc\xe4\xc8\xe0\xb7\xa4\x80\xcb\x40\x37\xec\x8c\x7f\x81\x32\x0a\x7b\xd2\x45\x65\x08\x6a\x34\xe2\xb5\xd8\x59\x70\xee\x39\x2c\x00\x18\x1f\x1b\xa4\x52\xb1\x9e\xe7\xc3\x16\x12\xfe\x23\x00\xbf\xfc\x0f\xcf\x91\xf9\x4f\xd6\xd3\x49\x3b\xe1\x27\x7c\x7e\xe3\x46\x8d\x92\x9d\xf0\x85\x51\xf2\xec\xbf\xce\x2b\x58\x16\x34\xce\xf3\xcf\x1f\x5b\xb3\x66\x21\x03\x5d\xd4\xb5\x67\x4f\xd7\xef\xf3\x6c\x14\x14\xe8\xa2\x77\xa1\x8f\x52\xf9\xc4\xa1\x96\x5a\x49\x1b\x74\x42\x5d\xba\xba\xad\xb5\xb6\x3c\x68\xd3\x34\xeb\xd7\x0e\xc2\x39\x5f\x3b\xa8\x89\x47\xd9\xb2\x89\x9a\xa5\x0b\x22\x81\xda\x16\x4f\xe4\xbf\x71\xf7\xf3\x0f\x0c\x33\x96\x15\x8f\xc8\xad\x8e\x9c\x71\xda\x45\x2b\xaa\x11\xc3\x83\x5d\x1d\xfd\xad\x8b\xbf\x7a\xea\xaa\xfe\xb5\x7a\x0d\x7a\x97\x57\xd7\xa5\x3a\xc7\xa6\x6d\x92\x52\xcc\x4c\x34\xf3\x9a\xdd\x48\xba\xbe\xd3\xd7\xd2\xbf\x6a\x74\x08\xb1\xba\x3a\x11\x09\xc5\xbd\x2d\x9b\xdb\xfb\xf5\x58\x2b\x56\xc6\xe8\x5d\x72\xad\x77\x52\x95\x15\x42\x08\x4a\xcf\xc9\xb2\xc2\xa4\x3c\x3b\x6b\xcc\x8d\xaf\x9b\xbb\x73\x62\x0b\x8e\x4b\xd5\x26\xae\x1b\x38\x6a\xe4\x00\x59\x04\xf7\x1a\xdf\x25\xb8\x97\xef\xf5\xa2\x8f\xbd\xa2\x8f\x67\x73\xfa\xd0\x45\x1f\x1c\x67\x7d\xe0\x7b\xf0\x49\xa3\x8f\x4f\xf2\x3e\xb8\x8c\x7b\xb8\x8c\xbb\xa1\x1f\x96\xc0\x28\x2c\x83\xaf\xa6\x03\xa3\xc3\xfd\x0d\xf5\xa5\x01\x4d\x56\x25\x34\x96\x64\xe1\x09\xbe\x6e\x10\xfe\x60\x5f\x37\x08\x7f\xd0\xaf\x1b\x84\xf3\xbe\x6e\x50\x13\xaf\xd2\xf5\x6a\xfe\x75\x83\x79\x6b\x54\x4e\xae\x75\xda\x26\x9e\xaf\xf8\x47\x87\x07\x8f\xab\x59\xb1\x9f\xc0\x0e\xd6\x8d\xf4\xe5\x59\xc2\xea\x65\x45\x35\xd6\x22\x96\x85\x8d\xa2\xac\xaf\xe5\xc5\x05\xed\x62\x8f\xd0\xe9\x33\x59\x9d\x66\x8e\x32\x3d\x09\xfc\x65\xab\xae\xc9\xed\x02\x3f\x20\xf0\xe9\x1c\xfa\xc3\x56\x5c\xd0\xeb\x1c\x17\xed\x2c\xdf\x33\xa8\x83\x9d\xe9\xe2\x28\x22\x21\x08\xdc\xc3\x49\xd9\xca\x41\x20\x94\x8c\xe0\x36\xcb\x17\x09\xd8\x3d\xb3\xa6\x12\x96\xcf\xe4\xa7\xc9\x7c\x0a\x96\xd1\x15\x6e\x87\xbf\x54\x28\x01\xa0\x83\xd4\xea\xee\x68\x55\x8c\x7a\x94\x32\x96\xbc\x11\x27\x10\xbd\x9d\xab\x4e\x7c\xb7\x40\x9c\x52\xcc\x38\xe5\xd7\x8d\x7a\x45\x5d\x79\x7b\xe5\xf2\xe5\x15\xd1\xea\xfe\x74\x53\x67\xff\xd0\xd4\xaa\xb6\x53\x5b\x6b\x63\x5d\x83\x0d\xa7\xa4\x0a\xc2\x9d\x81\x58\x3a\xd1\x3d\xda\xd5\x93\x4c\x0e\xcf\xac\xcd\x9c\x8a\x5f\xba\xec\xaa\x96\x55\x8d\x75\xa3\x6e\xd7\x19\x03\xed\xa7\x26\xf9\x5c\x87\x67\xff\x8a\xdf\x21\xab\x20\xcc\xea\xd2\xc3\xa8\x20\x8e\xbb\x8a\x8b\x24\x32\x2a\xf3\xef\x4d\x9b\xfb\xf4\xdc\x11\x38\xe6\x0c\x38\xbd\xe2\x53\xed\xec\x10\xc5\xae\x97\x06\x08\xff\xff\xd6\xa0\x6e\x50\xcb\x7e\xb7\x1c\xbf\x33\x38\xb9\x6c\x80\xd8\x67\xce\xd1\x56\xb2\xaf\x29\x61\xe1\xbd\x9f\xb6\x93\x58\x4d\x45\x6b\x65\x6d\xcb\x69\x67\x3b\x6b\xef\xee\xb9\x62\x11\xaa\x05\xc5\x25\x9b\x7a\x3e\x55\xbd\x68\x65\x97\x2f\xc0\xf8\xe9\x33\xf9\xd1\xd9\x6d\x98\x8e\x76\xca\x0f\xcd\x71\x4b\xf2\xa8\x86\xc4\x86\x68\x27\x38\xc3\x2e\xc2\x8c\xd4\xb1\x9f\xdd\xe4\x67\x39\xab\x0a\x53\xc7\x1c\xa0\xff\xb8\xab\x0a\xb4\x90\xc9\x1e\x63\x2e\x97\x41\xf6\x8f\xea\xb3\xb0\x88\x67\x6d\xd3\xac\x4c\x6a\xf7\x7d\x86\x94\xe8\x21\x83\xcf\x99\x48\xd7\xe5\x73\x7c\x5e\xde\x35\x34\x1a\xa4\x7c\xce\xce\x72\xb9\x3d\x47\xbe\x0f\x11\xfc\x22\xf7\x2b\x7f\x82\xaf\x81\x6e\xdc\x83\xe8\xdc\xaf\x70\xd9\xf6\xf0\xb9\xb4\xc3\xb7\xd2\xa1\x26\xd4\xa4\x66\x27\xb1\x69\xed\x74\x22\xd9\x0f\x63\x28\xe3\xf4\x25\x82\x28\xdf\xae\x06\x3a\x45\x1b\xd9\x95\x33\x41\x4d\xe3\x95\x11\xf3\xb9\x95\x1a\x83\x1e\xaf\x3a\x79\x83\x74\xdc\xa0\x15\x8e\x65\xde\x26\x36\xe6\x5a\x8a\xa2\x4c\xc7\x01\x5d\x8f\xda\xa9\x18\xe5\x3c\xc7\x92\x8c\x9f\x40\xeb\x7e\x46\xab\x61\xda\xea\x40\x0a\xd5\xc6\xea\x79\xed\xc0\x37\x38\x59\xa8\x28\xc9\x39\x57\xb1\xb6\x64\xd3\x1a\xc7\x3c\x86\xd1\xd5\x82\x35\x4c\xe6\x99\xa3\x5c\x9e\x5c\xe6\x96\x6f\x52\x70\x1b\x96\x82\x42\x17\x3f\xc3\x23\x70\x1d\xd3\x05\xd9\x7b\x1d\xd7\x05\xa7\x99\x16\x6d\x45\x9e\x84\xe3\x33\x56\x5c\xdc\x61\x0c\xcc\xbe\x47\x63\xf7\xfd\x10\x80\x8f\x3f\xe2\x45\x84\x6c\x0e\xdd\x4d\xf8\x99\x88\x27\x6e\x15\x39\xe7\x52\xc1\x6f\xfe\x44\x31\x23\x69\xcb\xa4\x1b\x12\x85\x8f\xf3\xb6\x5c\xa8\x11\x55\x44\xf6\x9b\xa6\x7e\x8f\x5b\x77\xb3\x7b\x11\x1f\x0d\xf3\x92\x66\x4d\x09\xbb\xc5\x11\x55\xb4\x77\xad\x43\x1c\xee\x6a\x6a\x1b\x19\x96\xb4\xa5\x9a\xe4\x9b\x68\x28\xdc\x7e\xee\xec\xec\xea\xb1\x55\xcb\xff\x9e\xb9\x8a\x56\xc6\x96\x54\xfa\xe3\x75\x46\x7c\x96\x66\xf3\xe2\x7b\xee\x43\x73\x31\xaf\xc0\x6f\xe5\xb8\xc8\x89\x0a\x7c\x0f\xc7\x85\x2f\xce\xed\x27\x3f\xe6\x15\xbf\xef\x16\xed\x78\xae\x90\xe3\xd3\x4c\xae\x02\x3f\x2c\xc6\xc9\xe5\xeb\x60\x0e\x5f\x8f\x71\xdc\x9a\xaf\xaa\xa6\xf8\xab\x24\x44\xf1\x17\x39\xfe\x4b\x7c\xdb\x82\x3f\x64\xe2\xba\x81\xf3\x79\xbe\x60\xfe\x3e\x2d\xda\x89\xdf\x39\x1f\xbf\x39\x61\xfb\x83\xa2\x3d\xfb\xfd\xb1\xbc\xdf\x5f\xc4\x3f\x5a\x7e\xff\x4c\xde\xef\x6f\xe3\x21\x31\x9f\x6f\x93\x6f\x8b\xf9\xf0\x7c\x9a\x98\xe7\x0d\x02\x7f\x29\x47\x2e\x3b\x04\x7e\xc8\x2a\x7f\x29\x29\xe4\xcf\x71\x6e\xfb\x46\xad\xa0\xf8\xde\x04\xb7\xe5\x10\x97\x79\x0c\x0e\x18\x46\x5c\xb4\x08\xd9\xe3\xa3\x88\x44\x24\x18\x31\xad\xd7\x61\x62\x08\x38\x26\x11\x82\x0c\xf7\xe7\xd1\xe6\x93\x51\x43\x35\x6f\x97\x64\x24\x84\x5b\xf2\xf1\xcf\xbe\x7d\xe6\x2f\x14\x63\x3f\xe7\x3c\xf4\x9e\xbf\xdd\x02\x4d\xf8\xed\x12\x5d\x0d\xb1\x52\x76\xf2\x91\x45\x81\x83\xa8\x26\x3f\x7e\x81\xd0\xd8\x1c\xcf\x36\x4b\x1d\x4a\xf8\xca\x18\xb5\xac\x95\x78\xfd\x1d\xa2\xd6\x81\x2f\x91\xdc\x55\x53\x31\x18\xb4\xae\x99\x47\xb8\xac\xc5\x5b\x4a\x81\x7f\x85\xe3\x22\x07\x26\x74\xb3\xd9\xc4\xf3\x73\x60\x44\xe4\xaa\x10\xaa\x01\xf0\x09\xaa\x9b\x02\xf6\x4d\x0c\x8d\xd7\x25\x31\xb1\xa2\x31\x77\x5e\x83\x54\x00\x05\x4e\x37\xfb\x52\x03\xaa\x9a\xa4\x4b\x49\x77\x8a\x6e\x5d\x4f\x38\x92\x3b\x3b\x1f\x19\xbd\x65\xbc\xb1\x98\xde\xd6\x5c\x9a\x99\xc5\xfd\x99\xfb\x1e\x3c\x7b\x2d\xeb\xd3\x31\x3b\x8b\xbf\xa1\x7d\x3a\xa0\x3a\x1d\x2b\x56\x48\xde\xf7\x31\xfc\xd9\x4f\x13\xb9\x5c\x5e\x97\x71\x53\xc7\x3f\x8c\xc1\x4f\x8d\xaa\xa6\x4b\x92\xee\x18\x18\x5f\x51\x7f\x46\x79\xf1\x92\x8a\xc6\xa1\x35\x2b\x17\xd3\xde\xef\xc0\xa1\xcc\x77\xbf\x7d\x71\x53\xc9\xeb\xbf\xc5\xea\xcc\xcf\xe9\x3c\xf9\x38\x7c\xad\x7c\x7f\xce\x97\x08\x7c\x0f\xc7\x85\xcf\x10\xf8\x6e\x03\x17\x3e\x21\xb7\x1f\xb1\xf6\x01\xa1\x89\x7d\x9b\x10\xf7\xcf\xf3\x7d\x0c\xff\x02\xdf\xc7\x48\x25\xd8\xf7\x31\x34\xbf\x9e\xd0\x9a\xbe\xf0\xd9\xc9\x4b\x2f\x18\xbf\xf3\xcb\xab\xaf\xba\xf4\xf5\xdf\x3c\xf8\xe0\xcb\x6f\x7c\xfd\xeb\x20\x81\x8f\xde\xeb\x7f\x9b\xf6\x59\x0a\x61\xd0\x59\xcc\x53\x11\xf2\xba\x0a\x14\xe3\xeb\xd3\x64\x9c\x77\xcb\x9d\x34\x4c\x67\x4b\x52\x28\x6d\xa9\x3b\xce\xd3\xaf\xa8\x52\x15\xa8\xd6\x13\x55\x8a\xeb\x83\x2a\xc4\xa3\xb3\x08\xda\x97\xd4\xf0\xdb\x6a\xa9\xff\x02\xff\x78\x32\x5d\x13\x46\x74\x44\x03\xb1\x7a\xef\xb7\x27\x6e\xed\xf5\x79\x95\xcc\x93\xc4\x26\xab\x0e\x05\x11\xf7\xd7\xac\x1c\xcc\x7c\x2f\xdd\x9d\x68\x41\x2c\x29\x6e\xeb\xc5\x03\x99\xff\xfa\xe8\xd2\xb1\xea\x63\xaf\x10\x92\x8d\x83\x39\xaf\x5c\x2e\x3f\x31\xe4\xcb\x7c\x82\xc0\xf7\x08\xfc\x19\xfc\xb3\x05\xdf\x2d\xf0\x9f\xe1\xfb\x16\xfc\x76\x81\x1f\xc0\x7f\x17\x38\x95\x85\xc0\x0f\x93\x4b\x05\x6e\x1d\xf7\x60\xce\xb8\x8f\x09\xfc\x45\xa2\x5a\xf0\x1d\x02\x3f\x94\xc3\xcf\x57\x04\xfe\x02\x1e\x15\xdf\xd8\xf8\x3e\xee\x3f\xd1\x37\x36\xfc\xff\xc8\x37\x36\x82\x99\xbf\xe1\x96\xcc\x11\x24\xfc\x1b\x1b\xbb\x5a\x70\xc3\x40\x4b\xe6\x81\xb9\x6f\x6c\x7c\x9f\xcf\xe1\xd9\x39\xdb\x14\xf8\x5e\x8e\x8b\xdc\x8e\xc0\x6f\x37\x71\x22\x11\x00\xf1\x8d\x0d\xca\x2b\xc7\xad\x77\xec\x09\xba\xc6\x6f\xfb\xff\x24\x3e\xb8\x6c\x15\xe2\x60\x47\x43\xeb\x92\x41\x49\x1b\x54\x25\xef\xff\x68\xed\x2b\x00\xdc\x3a\x8e\x86\x77\xf6\x49\x7a\x62\x66\x9d\x74\x62\x3a\x49\x27\xd6\xe9\x50\xc7\xb6\xef\xcc\x78\x31\xb3\x63\xd7\x76\x1a\x68\xd8\x49\xe3\x30\x34\xdc\x24\x0d\x15\x83\x4d\xe3\xb4\x0d\x35\x29\xa7\xdc\x94\xdb\x14\x43\xdf\xd7\x36\xc5\xaf\x4c\xa9\xa5\x7f\x41\x7a\xd6\x9d\xcf\x71\xd2\xbf\x67\xbf\x27\xe9\xbd\xa5\x99\xdd\x9d\x99\x9d\x9d\x9d\x99\xec\xd2\xec\xdd\x37\x9f\x7c\xc0\x78\x45\x03\xbe\xcf\xfc\x7d\x28\x3e\xe6\x54\x42\xb6\x8b\x06\xc8\x6c\x69\x35\xc4\x68\xac\x4d\xe3\x31\x34\x3c\x74\x95\x60\x5f\x9e\x1b\xcf\x05\xb5\x5a\xa3\xcf\x1b\xa8\xc5\xb7\xbc\x56\x86\x99\xde\x75\xe9\x52\x6e\x5c\xa5\x33\x99\x72\xcb\xd3\x47\x2a\x5c\x8f\x11\x40\x08\x9e\xc0\xf7\xb2\x93\xba\x31\xe6\xb9\xf1\x9a\x9a\xd6\x01\x48\x4c\x25\x63\x82\x12\xe1\xa6\x35\x74\xd7\x31\x6b\x68\x2d\x28\x95\x4c\xff\x62\x9f\xd2\x69\xe8\xbc\x84\x8d\x2a\x39\xa3\x8c\x6a\x68\x22\x27\x41\x8d\xa3\x95\x0a\xb4\xfb\xa4\x69\x67\x6a\x9e\x74\xda\xe9\x4c\xe7\xd3\xf9\x5c\xb6\x3b\x43\x8f\x00\x47\x9b\xc6\xd3\x7a\xb1\xe3\x44\xc6\xd3\x8e\xf6\x53\xc1\xf4\x48\x62\xa8\x48\x16\xcc\x4c\xb7\x6a\x82\x0e\xb0\x7a\x6d\xfe\xf8\x39\xe7\x8c\xc7\x62\x8b\x06\xc6\xe3\xb9\xa6\x35\xcd\x6e\x85\x02\xab\xc8\x52\x38\xf1\xf6\x05\x78\x14\xac\xc6\x5a\x25\x4a\xac\xe8\xe0\xfa\x5e\x62\x55\xdd\x35\xd5\xb2\xa9\x71\x29\x30\xc8\xe5\x72\xd9\xa3\xbd\xf5\x0a\x5c\xcf\xf5\xc5\x14\x47\x0f\x33\x1c\x79\xd0\x43\x53\x47\xa2\x04\x1f\x6a\xd2\x7e\xac\x01\x72\x5b\xe4\x61\x3f\x44\xfe\x63\x86\xbf\xee\x64\xda\x1f\x91\x19\x1f\xc9\x99\xe3\x39\x19\x50\xa8\x55\x4a\xc9\x67\x2e\xc5\x6a\x88\x61\x86\xa5\x45\x27\x4c\x5a\x0b\x20\x8c\xa8\xae\x63\x37\x49\x3d\x7f\x1a\x32\xe6\x4c\x64\x03\xd4\xe3\xf4\x98\x09\xe6\x22\x21\x93\x91\x1a\xc5\x5a\xda\x6c\xcd\xdb\xd1\x18\x30\x15\x4c\x0b\x62\xb1\xa5\x23\xc1\x1e\x73\xcc\xe2\xb0\x47\x52\x04\x57\xe0\x5e\xc8\x0d\xcc\x9d\x8e\xb2\xdb\xc4\x2d\xce\xf1\x1d\x47\x77\x53\x5d\xe0\x34\xba\x1d\x1e\xc4\x07\x90\x9d\x60\x22\x02\x8a\x9a\x41\xab\xc1\x08\x5c\xa0\x90\x5b\x41\x50\xb4\x16\xdf\x49\x44\x70\xa0\x14\x95\x5b\x11\x00\x83\xa7\x5f\x5a\x9f\x4e\x4e\xa9\xd9\xd1\x3c\x15\x9d\x3e\x03\x6c\xe2\x85\x48\x8e\xcc\x1b\xe5\x40\x24\x25\xcb\x26\x23\xc6\xbf\x32\xd9\x00\x9b\x8f\x59\xe6\x4a\x42\x50\xc8\x14\x82\xec\xd0\x49\xea\x6b\xcb\x58\xeb\x96\xf2\x34\x97\xff\x52\xde\xf9\x73\xd0\x59\xec\x0d\x06\xa9\x87\xe1\x60\x24\x18\x09\x87\x1c\x01\x87\xbf\xc3\x4d\xfd\x0c\x5b\x42\xc1\x82\x56\xe5\x64\x56\x9d\x64\x24\xb6\x9c\x15\xd8\x9b\x3e\x6b\x08\xab\x6e\xed\x0d\x89\x84\x28\x3e\x34\xf6\x11\x43\x30\x18\x1f\xf4\x07\xf3\xab\x26\x06\x87\x32\x3d\xa5\x82\xd9\x5f\xad\x84\x7c\x77\xc2\x46\x80\x81\xd4\x99\x38\x97\x4a\x25\x7a\xf0\xd8\xe8\x8f\x03\x5d\xe5\xde\x0a\xee\x24\xde\xdb\xef\x2d\x97\x51\x3b\xde\xf5\x4c\x0b\xbb\x83\x83\xdf\xc9\x0e\xd3\xcb\xe4\x5b\x5b\xbb\x92\xfd\x6d\x67\xe9\x07\x80\x00\xeb\xe5\x46\xbc\x78\x77\x2b\x65\xfb\x7b\x7a\x2e\xd2\xe3\x31\x18\x10\xf2\xf8\x3c\xc4\x52\xd5\xe0\x36\xb8\x6c\x16\x52\x85\x2e\xaa\x52\x51\x5b\x55\x02\xd6\x7c\xae\x00\x38\x38\x5f\x1f\x8b\x27\x27\x12\xb5\x55\xc9\xf1\x44\xbc\x58\xa4\x69\xa3\x0f\x11\x40\x50\x7a\x79\xb1\xbc\x26\xbb\x75\x55\xcf\xaa\x74\xef\x70\x6f\xdf\xc4\x44\x6f\xef\xc8\xfb\xcb\x24\x26\xa4\x64\xf3\x44\x66\x3e\x9b\x4b\x79\xc9\xe7\xae\x09\x29\x1f\x37\xea\xb5\x22\xce\x76\x01\x14\x38\xef\x28\x51\x4e\x02\x05\x08\xc1\xb7\x48\xd8\x48\xee\xa2\x09\x84\x7a\x03\xee\xaa\xdf\x34\x00\xb8\x0f\x0f\x1e\xfd\xad\x4c\x51\xcc\x61\x4b\xd7\xd1\x67\xb0\x1b\x09\xa8\xca\xe4\x76\xea\x6b\x80\x7a\x2b\xef\xad\x55\xdc\x2e\x93\x51\x46\x45\x61\x6a\xfa\xc7\x8d\x5a\xac\xcc\x46\xf7\xd8\xc1\x13\xea\x6c\xc0\xd6\x61\xf3\x68\xad\x5a\x0b\xb3\xd2\x15\x69\xdc\x94\x63\xc7\x08\x0a\x6c\xcf\xd6\x42\xe6\x8c\xe4\x9b\x7c\xcd\xce\x1d\x1b\x37\xee\xd8\xb9\xa1\x5a\x53\x7d\xcf\x50\x08\x56\x6b\xe2\xf7\xfc\x23\xf8\x0c\xea\x5c\x80\x3a\x19\x18\xb8\xe6\xec\xe1\x3c\x98\xb3\x85\xa3\xff\xbe\xfc\x82\xde\x64\xfd\x4f\xeb\x28\xcd\xa6\xb7\x94\xe4\x57\x0d\x83\x20\x85\x3f\x69\x1e\xdb\xe4\x8e\xd5\xac\x02\xa9\xbe\x60\x12\x63\x84\xa4\xff\xe2\x90\xf1\x03\x39\xec\x8a\xc4\x56\x1f\xbd\x81\xd1\xfd\x5e\x72\x7b\x92\xc0\x17\xa7\x16\x3b\x11\xbb\xd5\x22\xe3\x2c\x19\x33\x96\xbc\xf9\x38\xb7\x57\xb1\xee\x18\x63\xc9\x95\x96\x8d\x8e\xa8\x60\x67\x81\xa8\x2a\x39\x26\x75\xac\x28\x36\x11\xbe\x70\xf9\xf2\xe5\xd9\xde\x6c\xd7\x42\xad\x42\x0b\x40\xd0\xa4\x2a\xf8\xf6\x0c\x2f\xdb\x5e\x24\x2a\xe3\xd7\x18\xfe\x6f\xea\x59\xb1\x7c\xc3\xfa\xdc\x40\x39\xea\xd0\x38\xe8\x99\x76\x85\x3c\xde\x3b\xb4\x65\x45\x79\xd7\x86\xc9\x71\x99\x82\x46\xf3\x1e\x27\x7d\xf0\x30\x69\x63\x02\x65\x50\x9e\xf6\x41\xc0\x8f\x05\x59\x02\xb0\xe0\x50\xab\x04\xc0\xec\x80\x13\xc9\x4a\x47\xa4\x8c\x69\xdd\x66\x85\xaf\x0a\x85\xcd\xe1\x58\x44\xf2\x10\x3a\xdb\xb2\x98\xcd\xaf\x52\xeb\x4b\xac\x09\x80\xa8\x90\xc2\x59\xed\xab\xa5\x56\xf5\xe5\x67\xfc\x19\x00\x57\x29\xd9\xdb\x3f\x35\xa6\xb6\xcf\x0c\xe7\xe2\xc3\xb9\xe4\xc0\xa6\xa9\xb5\xf9\xec\xe3\x34\xb8\x95\x41\x0e\x18\x7a\x96\x0d\xe4\x57\xe6\xc2\xa1\x0a\x80\x3f\x38\x56\x1d\x1c\xc4\x3d\x23\xd5\x64\x3e\x93\xac\xad\x9e\x28\x4c\x1b\x5e\x00\x4c\x29\x3f\x95\x79\x30\x8b\x21\xfd\x43\xd2\x77\x6a\xa4\xa3\xbe\xa1\x75\xc0\xba\x0f\x63\x3a\xac\xc8\x17\x19\x46\x3b\x11\x1f\x59\x4d\x4b\x49\xad\x86\x2d\x08\x4c\x66\x13\xf5\x0d\x4d\x86\x71\x09\xa8\xbd\x67\xc8\x16\x29\x98\x60\xb0\xfe\x27\xf0\xf6\x2d\x5b\x56\xff\xe7\x27\xef\x28\xc0\x07\xeb\xdb\xfb\x6e\xff\x1e\xf8\xe8\x1c\xaf\x31\x5f\x2e\xd4\xe7\x60\x0c\x75\x31\x4b\x73\xd2\x8a\xae\xa4\xb7\x43\xa3\x56\x00\xe6\x01\x0b\x31\xc8\x19\xea\xe6\x8d\xfc\x15\x46\x64\x2f\x8e\x18\x51\x70\xab\x97\x36\x04\x4a\x3b\x4e\xcd\x71\x10\xb3\x04\x6c\x01\xb1\x5c\xc0\x0e\xd1\x4e\x90\x07\x95\xd5\xb9\xc2\x4c\x4f\x75\x6b\x67\x0f\x80\xbb\xb7\xdb\xdf\x13\x58\x58\xf1\x0f\x3a\x52\x36\xe8\xaf\x37\xd4\xb9\xd2\x75\x22\xd6\xa8\x08\x3a\x6e\x2d\x6c\x1e\x1a\xda\x94\x27\x68\x02\x88\x47\x22\xa3\xe9\xb1\x65\x9d\x1d\x66\x18\x18\xfa\x98\xb3\xfe\x67\x11\x14\x22\xf5\x13\x83\x46\x89\x6e\xf6\x49\xfc\x8e\xa6\x2f\x8d\x22\x1a\xaf\x8d\x18\x01\x83\xdf\x84\xb9\x33\x7a\x81\x40\xd2\x3a\xe5\x26\x85\x5e\x14\xa1\x4d\x81\x19\x41\x28\x52\x8c\x14\x52\x49\xea\x63\x23\x12\xa1\x07\xeb\xdb\x00\xe2\x54\x49\xf2\x47\x40\xe5\x9e\xb2\x83\xfb\xfe\xc5\x2d\xaf\xe0\x62\xd3\xaa\xa7\x6f\x49\xa6\x7b\x45\x6e\xc3\xae\xf2\xf6\xd1\x25\x7d\x29\xb0\xc5\x7c\x81\xb1\x02\xd8\x26\xe3\x3b\x54\x32\x39\x75\xf4\x3b\x31\xe8\xcf\x7b\x42\x23\x36\xdc\xd9\x1b\x81\x9b\x0a\x1b\xfb\x07\x36\x95\xee\xbe\xea\xc0\x5d\xcb\xaa\x79\x70\x5b\x13\xfb\x36\x86\xe2\x17\x50\x17\x04\x72\xa1\x9e\x5c\x3c\xd6\x3d\xec\xef\x8e\x18\xd5\xa9\x15\xa5\xa6\x0d\xed\x11\xea\xc3\x8f\xda\x83\x53\x19\x19\xda\xfd\xcf\xb1\xa5\x8f\xc5\x64\x12\x48\xdb\xcd\x16\x2a\x15\x63\x21\xf4\xa7\xdd\xf5\xbf\xc1\xd6\xf3\xa7\x96\x82\x17\x8c\x23\xb1\xfa\x57\xeb\xbf\xe7\x67\xb7\xfa\x09\xce\x9e\x26\xb2\xbd\x0b\x45\x50\x0e\x95\x6a\xf9\xae\x88\x59\xc1\x68\x57\x8b\xb6\x77\xcc\x36\xcd\x8b\x46\x11\x8a\xe6\xa2\x59\xbf\x8f\xe4\x71\x76\x29\xda\x0e\x01\x4a\x02\x91\xa4\x87\x36\x1f\xa3\xdd\x0e\xf6\xbd\x79\xf2\xe9\xb3\x6b\x33\x63\xd5\xae\xe2\x9a\xa5\x2b\x56\x96\xd3\xe5\x91\xee\xb5\xd5\x72\xb6\xd8\xdd\x9d\x2b\xf7\x24\x06\x17\x0c\x64\xfa\x86\x2b\xea\xd4\x74\x77\x79\x93\xdb\xbe\x34\x3d\xba\x76\xe5\x70\x7a\xb9\xd3\xb5\xb1\x9c\x5d\x92\x86\xcb\x46\x43\xd1\x52\x21\x92\x2c\xfb\xea\xdf\x1c\xe8\xce\x2c\xb6\x58\xa6\x53\xdd\x35\x1e\xd7\x7e\x8c\xf5\xff\x19\xa8\x83\x52\x2a\xd4\x8d\xd6\xd5\x56\xd3\x28\xa3\x26\x3a\x9a\x55\x58\x81\x95\x6c\x93\x46\x05\x0a\x0c\x58\x01\x87\x94\x74\x58\x2b\x9a\xc3\x9a\x08\x4a\x73\xc6\x75\x32\xe1\xf5\x22\x94\x48\x25\x53\xde\xb8\x37\x16\x0a\xd0\x21\x11\x8e\x46\xd4\xd2\x90\x10\xdb\x56\x7a\xd2\x06\x3b\xf7\x0f\xd7\xe2\x57\x8a\x18\x77\x08\x9d\x5b\xdb\x7d\x60\xe7\x29\x7d\x5d\x00\xb6\x54\x60\x6c\x91\xe8\xea\x8b\x16\x56\x76\x67\x57\xe4\xd2\x55\x87\xac\xaf\xaf\x38\x38\x92\x3d\x93\x8e\x0b\x25\xc6\x67\x8c\x9e\xb3\xf8\xa9\x67\x70\x35\x07\xe0\xb2\x5d\xba\xdd\xee\xc8\xae\xae\x54\x37\x96\xea\x4f\x38\x3b\x0e\xec\x9b\x1e\xee\x9d\x78\x5e\x94\x93\x3f\x8c\x30\xe7\x3b\xff\xcd\x58\x42\x77\x13\xee\xb2\x81\x70\x99\x6a\xad\x56\xa5\xd7\xfc\xb1\x84\xa4\x7a\xa3\x38\x60\xf8\x3d\x60\xb6\x22\xe7\xe7\x1a\x7f\x2f\x9d\x6b\x3c\xa7\xf1\x3a\x5c\x0b\x97\x92\x2a\x2d\xc8\x43\xe9\xb1\xcb\x79\x1c\x4f\xcc\x1e\xe3\x89\x39\x34\x6d\xb5\xd2\x96\x5a\x3d\x56\xb7\xd6\xa2\x35\x73\x9e\xa8\x9c\x87\x27\x56\x9a\x2c\x51\x24\xb3\x6c\x74\xe1\xe4\xe8\xe8\xe4\x82\x91\x70\x52\xbc\xc4\x1a\x8b\x24\x64\x17\x5b\x83\x70\xe9\xa9\xeb\x37\xef\xda\xb5\x79\xc3\x9e\xca\xf4\x70\xd2\xf3\x60\xa2\xfe\xf3\x45\x63\x7e\xeb\x07\x3a\x19\x2f\x3b\x8f\x8c\x91\x2e\x38\x1d\x29\xd0\xca\x27\x5b\xec\x90\x0b\xc2\xa4\x72\x14\x67\x5c\x31\xc1\xf4\x4f\xf9\xe6\x33\xd8\xd8\x7a\x46\xed\x13\x00\xd0\xea\xd6\x43\x04\x8b\xe9\x76\x5b\x8b\x83\x92\xc6\x3a\x38\x07\xfd\xcd\x56\xe3\x65\x29\x35\x94\x43\x9d\x8b\xea\x97\xf2\xb5\xe2\x41\x92\xe7\x06\x38\x0c\x84\xa0\x91\xdf\x42\x13\x5f\x02\xc3\x17\x7d\x7f\x5a\xe3\x4f\x70\x1b\x9c\x4f\xde\x93\x3a\xeb\xaf\xd3\xf7\xc2\x53\xf5\xd7\xa5\xf7\xeb\x11\xc0\xbb\xe1\x42\x10\xd9\x59\x63\x19\x7d\x8f\x9f\x6a\xc8\x24\x7c\x9f\x4f\xf0\x7d\x09\x5c\xc8\x28\x5f\x9c\xd2\x70\x1f\x60\x79\x3c\xe6\x72\xaa\x94\xf3\xd3\xf0\xb8\x34\xd6\x73\xd2\x69\x36\x4b\x38\x41\x70\xde\xb4\x5c\x6c\xa7\xe0\x12\xef\xab\x50\x85\x3e\xe9\x08\x7b\x93\x80\xd7\x46\xa6\xfb\x12\xa3\xd6\x0c\x80\x39\xe8\x08\x26\x42\xbe\x5c\x47\xcc\xf0\xdd\x7b\x15\x1d\xd1\x9d\x32\xcc\x69\x37\x8c\xf7\x0c\x4c\x47\xfc\x41\x00\xb7\x3d\x43\x36\xb3\x3a\x0c\x9f\x29\xaf\x35\xdd\x21\x00\x27\xdb\x48\x86\xce\x25\x6d\xbf\x90\x8c\x15\x07\xea\x40\x9d\x28\x85\x56\xd6\x96\xe9\x01\x83\xcf\x80\x65\xd8\x06\x72\x21\xc6\x1c\x83\x21\x19\x16\xb0\x4c\x38\x24\x82\x80\xe4\x0a\x41\x2e\xd1\xf0\x6c\xd3\xf7\x23\x07\xc5\xef\x27\xb0\xa4\xfc\x5d\xd1\x70\x87\x9b\x94\x68\x8f\x84\xc3\x2a\xa5\xa3\xb5\xdb\xdd\xb6\xd5\x49\xc6\x3c\x81\xa4\x52\xe4\x01\x74\x15\x4d\x08\xb9\x45\x4c\xcf\xf8\xa2\x4a\xdf\x82\x65\x23\x81\xac\xc3\x0f\xde\x70\xb0\x20\x8f\x84\x27\xc9\xba\xcd\xa2\xc6\xb9\x40\xac\x33\x10\x82\xae\x60\x7d\xbc\xaf\x77\xe1\xb6\x55\xdb\x4f\xb3\xd8\x53\xe0\xaf\x66\x5d\x19\x99\x5c\x26\x53\x28\x84\x7f\x45\xc2\x91\xa4\x43\xd4\x65\x07\x10\xa0\x9b\x10\xc0\xe9\x70\xe1\x7c\xb4\x3a\x4e\x69\x75\x82\xd3\x6a\xd2\x42\x76\x16\xcb\xa6\x10\x43\x2f\xac\xb8\xed\xe5\xb5\xe9\x91\x4b\xbe\x50\xee\xfc\xca\x2d\xb4\x6f\x2f\x22\xe7\x7f\xce\x22\xf8\xb1\xa3\x20\xca\x50\x1a\x1d\x0f\x1a\x65\xb3\x68\x74\xb6\x6d\xca\xe7\x60\x3a\x14\x42\x28\x94\x09\xa5\x79\x54\x92\x84\x42\xc9\xa7\xfc\xf1\x14\xba\x7d\xf6\x3b\xec\xed\x04\x7a\x64\x81\xb3\x93\x6c\x72\x0f\xfb\xdd\xc1\xc8\x58\x4f\xc0\xd6\xe1\x70\x7a\x3a\x7c\x2e\x7f\x34\xd2\xe1\x0b\x06\x64\x7d\xe9\xe1\x98\x21\xe3\x4a\x54\x2b\x31\x57\xd6\x68\xc9\x46\xd3\x7d\x7f\xf6\x38\x88\x5f\x2b\x9b\xd5\x65\x38\xe2\x73\x7b\x43\x1a\x6d\xd0\xe3\x0c\x32\xba\x7c\x13\xe9\xdf\x43\xa4\xfd\x4e\x14\x45\x5d\xa8\x9b\xf2\xe5\x28\xc8\x65\x46\x4a\x97\x13\x4a\x81\x6f\x77\x91\x0e\x6e\x1b\xa1\xec\xac\x76\x76\xf6\x10\x0d\x93\xfd\x30\xaa\xd3\x08\x45\xc9\x20\x75\x1c\x73\xe5\x5e\x6a\xd1\xe0\x66\xf7\xda\x25\x90\xe8\x53\x51\xda\xbd\x1e\x18\x5e\x30\x58\x4d\x7a\xc1\xdc\x69\x0f\x45\x65\xbe\x54\x66\x60\xb8\x7b\x94\x1c\x95\x09\xfa\x5d\xb6\xb0\xee\x4a\x25\x56\x18\x28\x52\x2f\x9d\x59\xbb\xe7\xed\xb1\x02\x80\xd9\xd8\x3b\x10\x98\x28\x95\x17\xdd\xac\x89\x76\xc5\x02\xc1\xa0\xdd\x7a\xb3\xc0\x37\xa2\xd9\x9c\x94\x68\x1c\xe6\x3e\x5a\x25\xda\x82\xe1\xb7\x6f\x72\xce\xbf\x44\xe6\xfc\x9e\xe6\x9c\x7f\xad\x39\xe7\x5f\x3b\xf9\x9c\xe7\xef\xa5\x39\x8f\xf9\xfe\x86\x34\x8f\x30\xfc\x91\xfe\x96\xc6\x1e\xe6\x3a\x3d\x69\x1c\x61\xae\x5b\x95\xfa\x05\xc3\x5f\x38\x3c\xe8\xef\x70\x2d\xbe\x18\x61\xac\xe2\xf0\xa0\xa3\xd0\x85\xcf\x25\xbf\xd5\x1c\x1e\xf2\xfb\x06\xf6\x5e\xc3\xdb\x4f\xd2\xdf\xc6\x7e\x6b\x79\x7b\xc9\xfb\x77\xb3\xf4\x3a\xde\x3e\xf2\xfe\x12\xf6\x5b\xcf\xdb\x47\x7e\x5f\xc8\xd2\x1b\x78\xfd\x24\xfd\xe9\xec\xbd\x91\xb7\x0f\xfd\x03\xce\x62\xef\x4d\xfc\x3d\x49\x7f\x88\xfe\xe6\x3e\xc5\xa4\xf6\x71\x9e\xb2\xb1\xa6\x92\x78\x0a\x5f\x96\x3a\x8f\xb1\x96\xb1\x63\xac\x65\xbc\xb9\x67\x00\x4c\x6a\xc6\xbb\xdb\x5f\xcc\xd4\xec\xf3\xb1\x1d\xd5\x7f\xc0\x76\xf0\xc5\x27\x66\x3b\x08\x24\x5c\x2a\x50\x55\xe2\x3b\xbc\xd5\x5a\xb6\x7e\xc6\xcd\x95\xb3\xc4\x72\xd8\xcf\x36\xee\x42\xda\x54\x69\xe3\x2e\x47\x25\xee\x02\x52\xbf\xc4\xd1\x54\x4d\x17\x77\xd8\x98\x4e\x5f\xa0\x91\xd5\x79\x0d\xb6\x96\x76\x62\x8c\xed\xa4\xd0\x45\xf0\x00\x45\x8a\x05\x61\xba\x8c\x83\xdd\x6d\x4f\x67\x9e\x48\x64\x62\x26\x99\x8a\x9a\xd5\x05\x4a\xd2\x02\x6e\xbe\xf5\x1b\xf9\xcf\x76\xca\x6f\xa8\xff\x6a\xe9\x8a\xb8\xcf\xed\xeb\x91\x99\xf9\xfa\x2d\xe5\x5c\x5e\xe8\x1d\x8a\xf4\x14\x35\x6e\xdd\x07\xc8\x0a\x0e\x06\x6a\xe5\xe1\xa9\x8a\xa3\xc3\x6b\x55\x85\x80\xaf\xdf\xe2\x99\x5a\xc1\x5f\xcd\xc7\x55\x16\x8d\x4c\xc1\x78\x98\x34\x9e\xa8\xf4\x96\x43\xbb\x6a\x26\x7f\x27\x16\x64\x71\xc0\x42\x4a\xa5\x64\x6b\x38\x49\xfd\xc0\x97\x70\x63\xdc\xb3\x0e\x69\xfe\x24\x23\x15\x52\x77\x7b\x11\x0f\xb1\xbe\x7b\xde\xf7\x34\x22\x44\x28\x57\x8c\x44\x14\x2a\x77\x5b\xd0\x97\x4a\xfb\x6a\x8f\x7f\x6d\x5f\xee\x31\x59\xae\x4c\x97\x7b\xa7\xc5\xe2\x63\xf1\x53\xaa\x09\xb0\x65\x62\x91\x4c\x36\xa6\xb4\x8b\xba\x78\xa8\x56\x89\xc5\x8b\x2b\x7b\x88\xb7\xc2\x87\x5a\x66\x2f\xf0\xd9\x6c\x30\x50\xf1\x8f\x77\x43\xc0\x1b\xf0\x74\xfa\x71\x05\x3a\x3c\x85\x6c\x6a\xa0\x98\xea\x4c\xea\xb7\xb4\xcc\x5b\x10\xc2\xd2\xdc\xa1\x6b\xbd\xd5\x35\xb5\xb4\xd6\x6b\x82\x6c\x9f\xb5\xe4\xeb\x9f\x52\x34\xb5\x4a\x73\x5e\xc8\x36\xf2\x17\xcc\x7e\xa4\x7d\x39\x48\xfa\x33\x42\x6c\xd0\x2a\x05\x66\x8e\x16\x2b\x94\xa0\xe3\xb3\x5f\xcd\x0f\x0d\x7d\xf2\x92\xa3\xa7\x14\x7f\xff\xbb\xf2\xcc\xf5\xef\x66\x7d\x20\xcd\x59\x2f\x93\x24\x76\xd7\x0c\x1e\xc0\xf2\x70\xc8\x6e\xa3\x72\x84\xd4\x1c\xdf\x71\xe2\x44\xff\xec\x0e\xe8\x68\x05\xd4\x92\x12\xce\xc2\xbf\x9e\x89\xd8\x1d\x44\xe0\x88\x92\xd9\x36\x4b\xe0\x98\x4f\xda\x98\x2b\x6c\x58\xe6\xc8\x1a\xbb\x9a\xcb\xc4\xa3\x2d\x51\xc3\xd3\x26\x69\xdc\xa6\x68\xae\x0f\x25\xfa\xe3\x20\x75\x07\x50\x06\x5d\x5a\x33\x53\x39\xc3\x00\x5c\xcc\x48\x30\x31\x83\x03\x18\x3b\xa1\xb4\x31\xc6\xa5\x0d\x09\xd2\x48\x2b\x25\x02\x84\x65\x84\xbe\x48\x39\xa4\x74\x9c\xd0\x04\x83\x08\x05\x33\xc1\x74\x3c\xea\xf5\xb4\x04\x13\x15\x61\x63\x27\x14\x4c\xec\x6c\x6d\xf9\xa6\x44\x13\x7a\xba\xd9\xac\x04\x26\x9c\x04\x83\xd0\x15\x84\x7f\x1d\x27\x9d\x08\x18\x33\x33\xaa\xb9\xf2\x09\xa7\xc1\x4a\xd4\xf3\x38\x17\x4f\x38\x02\x8c\x2d\x29\xa5\x9f\xce\xb1\x01\x4c\x20\xd5\x93\xe4\x08\x03\xda\xc9\x1f\xcc\x3c\x4e\x05\x17\x02\x81\x79\x8e\xe0\x02\x87\x9a\x92\x0b\x42\x82\x44\xd3\xb9\xec\xb2\xb6\xa6\x92\x64\x17\x5e\x8f\xa3\x25\xc2\x8c\xb5\x89\x30\xe3\xdc\xf0\xb7\xa9\x42\x6c\x7f\x4e\x1d\x87\xcd\x95\x6e\x08\x65\x2c\xff\x57\xa5\x9b\x7f\xbc\x69\xe9\x46\x26\xf1\x28\x7a\xae\x28\x49\x20\xbc\xad\x66\x8d\x80\x5c\x66\x00\x2c\x4f\xc6\x25\xd9\x86\x00\x9b\xa4\x47\x8d\x24\xd1\x86\x51\x2e\x46\xc3\x86\x66\x4d\x9c\xe6\xf4\x3a\x4e\x14\x1a\x9b\x33\xbd\x8e\x29\x32\xe6\x99\x5e\x33\xb4\xf7\x42\x01\x3e\xcc\x42\x11\x32\xc3\xda\xa5\xa5\xb7\x24\x2c\x59\xde\x50\x56\xc2\x17\x37\x65\x25\xcb\x1b\x88\x4a\x80\xa6\x49\x3c\xc4\xc7\xf1\x1d\x48\x20\xfb\x46\x98\xfa\x9c\x77\xc4\xc0\x71\x7e\x37\x54\xb3\x49\x37\x7e\xd0\xc3\xc6\x89\xbc\xf1\x24\xfc\x1e\x7f\x08\x29\x90\x9a\xe0\x71\xd9\x63\x8a\x1d\x63\xb5\x71\x1a\xb0\x59\x06\xc8\xa5\xc4\x32\xac\xe1\x5e\x8a\x90\x02\x80\x94\xb9\x93\x64\xc1\x32\xa6\xcd\x23\xea\xb2\xd5\x4d\x4f\x08\x32\xf9\x62\x84\x92\x89\x08\x11\xfd\xad\x66\xc6\x36\xa9\x56\xf5\x31\x05\x1a\xb3\x94\x18\xeb\x14\x48\xc5\x42\xb9\xd2\x1c\x17\x94\x83\xc5\x24\x76\xc6\xb8\x19\x21\x38\x9b\x17\xeb\xf6\x05\x1d\xb4\x75\xf7\x27\x87\xa2\xee\x80\x27\xe3\x22\xd5\x47\x62\x6b\x13\x55\x5d\x38\x9e\x72\xdd\x84\x75\xb2\x97\xe1\x1d\x7f\xb0\xba\x7a\xeb\xdf\xae\x6f\xa1\x10\x1c\xdd\xd0\xd3\xed\xf3\x1a\x5d\xde\x20\xd6\x0a\xae\xd1\x4a\xc4\xee\xf3\x77\xf8\x3b\xc4\x02\xd7\x4b\xc8\x1b\x9f\x80\x9f\x35\x61\xb3\x21\x27\xda\xc4\xa0\x5b\xd9\x84\xce\x09\x32\x41\x0f\x58\x46\xc8\x05\x96\x11\x00\x95\x2d\x00\x65\x0a\x61\xb5\x08\x32\x39\x60\x85\x0c\x93\xdf\x82\x62\xa3\x8a\xc6\x4f\x5f\xac\xd1\x20\xe4\xb0\x6b\x6c\x1a\x1b\x8d\xbb\x6d\x34\xe8\xb4\x0c\x56\xf5\x3c\xb0\xce\xa2\xf9\xc7\x20\xeb\xb9\xf1\xbd\x9d\xf9\xfc\x35\x9b\xe6\x80\xd1\xbf\xc4\xfb\xfc\x17\x22\x4b\xce\x38\x9b\xb7\x5b\xc3\xda\xfd\x61\x36\xae\xab\xa8\x1f\x5d\xc8\xda\x7d\x7a\xa6\xc3\x63\x94\x61\x21\x6c\xc6\x80\x0d\x98\x36\xb6\x1f\x94\x62\x1e\x14\xca\x14\xa8\x15\x8a\x45\x24\x27\x25\x88\xbb\xa5\x7d\x0e\xa4\x05\xb9\x88\x08\x09\x15\x95\x6a\x71\xb5\x06\x94\x04\x0c\xb5\x52\xb1\x93\x1e\x61\xdf\xa8\x03\xb5\xa8\x5e\xdc\xdd\x8d\x50\x1f\x71\xb2\xd3\x5d\xed\xa9\x90\xce\x29\xe4\xb2\xa4\xd6\x44\x28\x6c\x0b\x47\xf5\xa2\x8b\x81\x65\xe6\x31\xe0\xdb\x59\x74\x45\xe2\x14\xb3\xe7\xbd\xe5\x38\xd0\xab\x99\x28\x56\x08\xee\x88\x37\x1d\x88\x1a\x95\x0a\x41\xe7\xeb\x0c\xba\xd3\x5e\x6f\xb2\x96\xea\x77\x59\xdd\x81\x05\x39\x81\xe2\x65\x40\xc2\x4b\x31\x89\xcd\x82\xdb\x6b\x71\x3a\x21\x8e\xc1\x69\x32\xfb\xa2\xbe\x7c\x3e\x6c\x0f\x8a\xb2\x98\x2b\x56\x3e\x1e\x61\x6c\x3d\xf0\x47\xb8\x16\xce\x03\x11\xbb\x11\xaa\xff\x8b\xcb\xeb\x75\x16\x6a\xf8\xcd\xbd\x97\xe4\x6f\xf2\x1e\xe1\x36\x79\xd7\x81\x96\x72\x85\x84\x55\x0e\x82\x0c\x40\x80\xed\x92\x7a\x8e\x92\x66\x4f\x1b\xad\x24\x6f\xd7\x4a\x24\x53\x80\xa5\x84\x62\xce\xa3\x02\x2a\x9f\x40\x05\xf4\x69\x22\xd6\x0e\x13\xf1\x36\x92\x48\x86\xc3\x64\x2a\xfd\x9d\x4a\xb4\x54\xb2\xad\x2c\x1a\x1b\x9e\x9e\x1e\x1e\x5b\x84\x68\x5d\x6d\xfa\x1d\x07\x8d\x43\x79\x3c\xad\xb6\xbd\x25\xcd\xd3\xdc\x6a\xe5\xc7\x55\xdb\xbe\xde\xc2\x9e\x39\xf8\xf2\xcd\x59\x1f\xd0\x9d\xe4\x1b\xa7\x8e\x74\x52\x61\x51\x04\x41\x31\x1b\x63\x84\x83\xb7\x5c\xd4\xca\x5b\x9a\x1e\xaf\xe4\x1f\xfa\xf8\xf4\x14\xc3\x61\x0a\xa1\x8c\x40\xd8\x9e\x8e\xa2\x5a\xda\xd8\xa2\xa8\x26\x1b\x5b\x6f\xe0\x47\xba\xfc\x26\xfc\x48\xcf\x45\x84\xce\x66\xd7\xeb\xed\x36\xc3\xf1\xfd\xf0\x76\xab\x41\x6f\xb5\xea\xf5\xb6\x66\xbc\x98\xf3\xe1\xab\xd8\x49\xf7\xe9\x6a\x0e\xe6\x85\x55\xa5\x94\x53\x3e\xab\x45\x93\x24\xc1\x99\x76\x20\x0d\xa8\xc4\xc8\x3f\x47\xac\x20\x56\x1c\x22\x11\x2e\xae\x2e\x5f\xf2\x88\xfb\xfd\xc6\x25\xe6\x7b\xdd\x0f\x1f\xaa\xbc\xed\xfc\xd4\xbb\x4b\xc3\xd3\x8f\x3d\xb6\x60\xa0\x78\x5b\xd7\x7d\x08\x50\x6d\x2e\xdd\x96\x43\x05\xe0\xbc\xfa\x17\xfb\xa0\x37\x89\x3f\xd8\x79\xf4\x14\xc4\xea\xfe\x18\x98\x21\xc9\xc6\x82\x8f\x8e\x44\x00\xb4\x96\x6d\x0c\x31\x15\xd9\x52\x84\x18\x02\x84\x39\x5e\x68\xbe\xdf\x74\xbc\x71\xcc\xe3\x06\x2b\xeb\x61\xf4\x7d\x58\x0d\x17\x9c\xbc\xac\x76\x64\xfe\x2c\x5f\x08\x86\xf2\xf9\xd0\xf7\x73\xc1\x50\x2e\x1f\x0c\xe6\xf8\x18\xfd\x18\xd1\x53\x26\xe1\xfe\x63\x63\x54\x06\x80\x11\xf0\x45\x81\x9c\xb9\xe3\x9c\x6f\x8c\xb6\xb7\x12\xb7\x7d\x7f\x21\x1c\x72\x3a\xc3\x61\xa7\x2b\x48\x3f\x49\xcb\x8b\x41\x87\x33\x18\x74\x3a\x82\xf5\xcf\x06\x9c\xec\x1b\x87\xe1\x43\x8d\x75\x90\x47\xbb\x91\x09\x19\x6a\x5a\x13\xf0\x0e\xe8\xa2\x1d\xe0\x28\x73\x83\x7b\xa9\x54\xf1\x43\x1d\x11\xb5\x4e\xaf\x0f\xb8\x3d\x89\x7d\x57\xbb\xfb\x2d\x2a\xad\x56\x67\xd6\xb9\x82\xdd\xe1\x25\x07\xe8\x98\x16\x09\x0c\x3f\x40\x7b\x28\x0c\xdc\x26\x49\x86\x31\xc5\x87\x52\x2e\x0a\x0a\xf9\x5b\xf1\x5c\x9e\x3f\xc1\xf7\x78\x80\x80\x13\x0a\xba\x6e\x6e\x82\x75\x4b\x90\xff\xd6\x04\x9c\x8e\x10\x85\xa9\x05\x5b\x1b\x8c\x18\x7d\x9c\xb4\xab\x0b\xdf\x85\x44\x64\x41\x91\x5a\x10\x1d\x73\x4a\x84\xb1\x14\xc1\x4d\xa9\x54\x5a\x94\xe6\x88\x39\x48\xed\xa3\x2c\xb4\x46\x9b\x10\xb2\xb6\xd5\xfd\x29\x8f\xb3\xe0\xc9\x87\x38\x5e\xf1\x8a\x50\x6c\x55\xfd\xbc\xe7\x83\xec\x97\x23\xd4\xac\xe7\x51\x52\xcf\x1a\xb6\xe6\xcf\xd6\xd2\x32\x00\x84\x04\x40\x94\xd0\x90\x8a\x56\xcb\x81\x1b\x2e\x08\x78\x29\xeb\x49\x8b\xd6\x62\xd4\x4b\x3d\xd9\x2e\x5b\x3b\x2c\x56\xfa\xcd\x02\x22\x29\xdc\x49\xaa\x74\x7b\x0a\x4e\x8f\x0b\xaf\x09\x39\x9c\xf4\x49\xf0\xf9\xfa\x79\xab\x62\x21\xb8\x81\x8f\xe9\x2f\x37\x0e\xc1\x12\x74\x0d\x32\xa0\x60\x9b\x49\x3f\x5d\x28\xaf\x6d\x9e\x45\x43\xc2\x52\xbb\xc9\x6a\xa4\x3b\x9d\x0e\xce\x87\x4a\xad\x63\x82\x5f\xf6\x27\x13\x81\xe8\x40\x28\x34\x10\x9d\xea\x0e\x04\xb2\xa5\xf0\x60\x24\x3c\x10\xa1\xf0\xb8\x90\x1a\x7e\x8a\x4f\x47\x1e\xd4\x89\x3e\xca\x0d\x35\xe2\x7a\x0d\x16\xb4\x98\xae\xc5\x11\x3b\x6e\x6a\x9d\x32\xa8\x31\x95\x68\x54\x22\xa6\xde\x9d\x8c\x3a\x4c\xe9\xa9\xe4\xf4\x8e\xfe\x46\x70\xe9\x49\xb2\xd1\xd5\x61\xba\x99\x14\x69\x04\xa4\x59\xad\x87\x37\x4e\x4f\xc8\x97\x0f\x21\xea\x3b\x8a\x34\x8f\xba\x8f\x72\x30\xa7\xce\xd2\x1f\xb5\x9c\xaf\x84\x44\xb1\x12\xaa\x14\xd8\x55\x11\xd9\x25\x86\xd8\x15\x23\x0f\x41\x6f\x3f\x68\xeb\xb3\x6d\x95\x6d\xb7\xee\x17\x0e\x5a\x07\x6c\x07\xac\x83\xd6\xf5\x8a\xf5\xb6\xed\xf2\xed\xf6\x1b\x0e\xd8\x0e\xaa\x9f\xfc\x18\x7c\x34\xfd\x70\xe6\x61\xfc\xf0\xfd\x70\x5f\xf7\x83\xe9\x27\x5e\x7e\x08\x21\x60\xe3\x29\x46\xe6\x8c\x06\xb9\x6a\xf6\xe6\x8c\x67\xa3\xbb\x6d\xca\xb7\x8f\xe6\x1f\xb0\xa1\x18\x74\x68\x28\xe5\xa0\x17\xc2\x6c\x1f\x93\xfa\xe5\x69\x6a\x49\xad\x66\x0a\xec\x22\x84\xa9\xac\x28\x1c\x6a\xed\x55\x33\x63\x17\xc9\x56\x2a\x14\xa2\x4b\x89\x88\x2b\x15\x57\xd0\x2d\xeb\x22\x13\x0e\x25\x9d\xc7\x2c\x8d\x87\xc8\x8e\x18\xb6\x37\xe2\xd5\x70\x28\x18\x1c\xcc\x65\x86\xd5\x16\x11\x04\x55\x26\xb4\x6e\x62\xd9\xd6\x78\x34\xd0\x39\xa2\x69\x36\xef\xd5\x5c\x7f\x30\xd8\x93\x4e\x57\x3c\x26\x9f\x8c\x29\x3e\xa2\xc5\xe2\xaa\x05\xd5\xb1\x70\x20\x1f\x4b\x3c\x27\x35\x1e\x21\xd6\xfe\xcb\x48\xfb\x13\x04\xde\x0c\xf5\x44\xe7\xf7\x61\xcc\xbc\x62\x91\x57\x32\x7c\x48\x0e\x40\x29\x18\xc1\x11\x16\x04\xbc\x96\xce\xb3\x2d\x48\xc0\xc2\x32\x84\x32\xe9\x30\x91\xb8\xe7\x1f\xf6\xbc\xdd\x5c\xbc\x27\x90\x51\x2d\x86\x43\xd1\x7c\x5b\x61\x46\x9d\x20\x6f\x36\x36\x74\x42\x70\x70\xa2\xd5\xd2\xdf\xbd\x21\x40\x5c\x8e\xb9\x02\x6e\x83\xfd\xb4\x1f\x70\xe6\x71\x84\x30\xce\x4c\xf1\x39\xf5\x1d\xf2\x3c\x48\x9e\xab\x68\x1f\x4b\x6a\x2e\x3b\x6a\x3a\x98\x62\x36\x08\x40\xda\x2b\x86\x28\x85\x84\xa0\x5c\xae\xc8\x2a\xe4\x72\x7c\xc7\xaa\xa5\xdd\xd9\x6c\x7a\xd9\x6a\x84\x39\x6d\x65\x34\xdd\x4b\x23\xe4\x79\x01\x4f\x22\x0c\xf8\xd2\x36\x56\x21\x34\x59\x85\xcb\xc1\x30\x22\x9b\x43\xd2\x85\xe3\x68\x70\x8b\xb0\x2f\x6c\xa3\xc5\x6d\xc4\x7d\xe4\x38\xb2\x4c\x79\xe3\xbc\x6b\x9a\x3e\xa8\xf6\x27\xbd\xf8\x3e\xbe\xd7\x14\x6a\x1c\x81\xef\x34\x7e\x47\xd2\x44\x99\xe4\xec\x39\x6e\xfb\x88\x89\x2d\x82\x49\x68\x4a\xee\x0e\x1a\xfe\x13\x1e\x5d\x36\x30\x40\xf1\x28\xf1\x55\x8c\x03\x4d\x1e\xff\x24\xe1\xf1\xab\xfe\x7f\x78\xfc\x93\xb3\x78\x3c\xa9\xe3\x2c\xd2\x27\xbb\x78\x5f\x7d\x80\xf5\xd5\x18\xef\xab\x6f\xc0\x1d\x30\x44\x9e\x6b\x91\xa9\xa6\xd7\x72\x1e\x46\x7a\x2a\x42\xab\x60\xb6\xc0\xc5\xe6\xa4\xf8\x86\x41\x9d\xb2\x39\x1c\x21\x78\x30\x33\x16\x0e\x97\x3d\xae\xe0\x14\x99\x65\xa8\xd4\xd8\x0a\xb7\xe3\x0b\x51\x80\xce\x44\x27\xdf\xf7\x68\x8e\xdf\xb6\x18\xcc\x32\x19\xf7\x57\xe3\x92\xd3\x31\x10\x40\xfe\x44\x20\x1c\x60\x66\x04\xb2\x68\xb4\x52\x91\x4e\x3a\xc5\x8a\x05\x22\xc8\x07\x6d\x0e\xab\x68\xb7\x89\x56\x3b\xfd\x05\xb1\x4d\x69\x41\x96\xca\x58\x2d\xdd\x19\xfc\xc4\xd0\x8a\x8d\x78\xed\x6a\xa1\x77\xac\x10\xbe\xd7\x37\x3d\xbe\x63\x28\xd7\x53\xe8\x59\xf0\x91\xea\xde\x89\x03\xd1\x8e\x8b\x56\x8d\x96\x7a\x38\x5c\x65\xd2\xae\x3b\x5a\xed\x52\x82\x4c\x00\xe6\xf9\x16\xd1\xa5\x34\x40\xab\x5d\x08\xcd\x69\x17\x69\x56\x22\x40\xdb\xe5\x68\xb6\xa7\x12\x2d\x55\x8a\xac\x75\xcd\xf6\x50\x59\xce\x61\x57\x28\x08\x5d\x1c\xeb\x15\x56\xaf\xc5\x1b\x57\x0c\x3d\x81\x33\xdd\x16\x6b\x26\x25\x13\xd2\x9b\xa6\xef\xed\x29\x8d\xae\xba\xa8\x23\x7a\x60\x62\x6f\xf5\x23\x0b\x48\xeb\x72\x43\x3b\xc6\xa7\x7d\x08\xd0\xe1\xc6\xfd\xf0\x7e\x7c\x0f\x12\xd1\x04\x17\x46\x2d\x54\x93\x24\x60\x81\x9a\x95\x31\xc5\xac\x1d\x9a\xfe\xa5\x00\x23\xd8\xc9\x08\xc0\x6a\x66\x71\xc3\x92\x2d\x26\x07\x73\x66\x05\x92\x75\x98\x02\xcc\xff\xdc\x61\xf0\xbd\xf8\xd2\x0c\xfe\x7b\xff\xd1\x43\xd5\x59\xf5\x0c\xf2\x7a\xcc\xfc\xc0\xcf\xd6\x96\xf1\x8e\x9d\x4a\xba\xce\xa6\xa1\xcc\x4e\xf6\x72\x75\xb3\x09\x80\x16\xcf\x3c\x41\x8a\x67\xfc\x4e\x1e\x60\xbe\xc4\x02\xf0\xfe\xfa\x2b\x2f\xbd\x08\xbe\x99\x2a\xbe\xa4\x1f\x35\xf7\x57\x57\xc0\x3d\x64\xdc\x18\xc8\xbc\x30\x88\x64\x5e\xc8\xf8\xb6\x78\xc5\xd1\x0c\x50\x09\xd5\x0b\x46\x47\xce\x5d\x66\xd9\x76\xd6\x39\x3b\x2d\x57\x5e\x7a\xdf\xba\x75\xef\xbf\x28\x3a\x58\xfa\xcc\xc1\xd3\x9e\x2b\x35\xcb\x38\x9d\x94\xf1\xee\xf6\x32\x9a\x62\x53\xc5\x87\xd9\x4a\xfd\xf4\x9d\xe7\x9c\xb5\xcd\xb2\xec\xdc\x91\xd1\x0b\x56\x9c\x5e\x7a\xee\xb4\x83\x9f\x29\x0d\x46\x2f\x7a\xff\xba\x75\xf7\x5d\x1a\xa5\xf9\xd5\xe0\x82\x5f\xc3\x06\x02\x87\xef\x8d\xe7\x9c\x85\xcc\x37\x35\xbc\x0c\xae\xd1\x51\x9a\x6f\x92\xe4\x7b\x94\xe5\x73\xd6\x6c\x6d\xf9\x10\xcd\x66\x32\x62\x62\x66\x4f\xed\x57\x26\x61\x4f\xfd\x76\xd8\x30\xca\xda\x6a\x25\x79\x5e\x7e\xb3\x75\x59\xe1\xeb\xac\xae\x46\x43\x6a\x23\xc6\xfd\x48\xaa\xfb\xcd\x96\x43\x5a\xd0\x2c\x47\xca\x87\x71\xed\x3f\x2f\x07\x61\x34\x8a\x06\xe0\x53\x44\xf8\x51\x20\x1d\xb1\x89\x53\x8b\x02\xc2\x59\x42\x4b\x4a\x94\x8a\x38\xf8\xc7\x2b\xf7\xdc\x1e\x3b\xf7\xe2\xeb\xee\xbe\x8d\xdc\x07\x1e\x74\xdc\x9c\x78\xc7\xb5\x71\xfe\x41\xf2\x2f\x20\xf9\x3f\x2f\xe5\xd7\x88\x32\x9e\x9f\xd2\x22\x9b\x83\x7f\x9c\x16\xbf\xf8\xdc\xd8\x6d\x4e\x7a\xbf\x7d\x20\x7e\xed\x3b\x12\x37\x3b\x1e\xe4\x1f\x08\xd0\x8d\x24\xff\x5a\x96\xbf\x19\x97\x30\xc2\x2b\x85\x6e\x52\xab\x54\x1f\x42\x68\x4e\x5a\x19\x02\xa9\x9e\xa7\xdb\x8b\x46\x18\x0d\x10\x5e\x71\x07\x93\xc3\x43\xc8\x52\x33\x86\x4c\x98\xa0\x07\x08\xa1\xec\xf4\x76\xe1\x79\xa5\xf1\xca\x71\x4f\x06\xbc\x61\x8d\x56\x67\x08\xb8\x3b\xe2\x7b\x3f\xec\x0d\x53\xfe\xc0\xbe\x33\x59\x5d\xaf\x21\x02\x27\x65\x0a\x2b\xf6\xfa\x24\x26\xc1\x7f\x53\x9c\x2e\x85\x83\xf0\x10\x3e\x44\xeb\xa7\x52\xa4\xdb\x61\x27\xe4\x5a\x81\x16\xd0\xad\x0c\xd2\x0a\xb3\x19\x21\x73\xc8\x1c\x24\xef\xc9\xac\xb2\x32\x26\xd5\x9a\x26\x76\x2e\xa6\x1f\xf7\xe4\xec\xf8\xde\xa0\x27\xaa\xd5\xe8\x0d\x41\xf7\xc7\xdb\xbe\xc3\x41\x5a\x69\xa7\x73\xd0\xa2\x34\xa8\xb4\x56\x9d\x33\x38\xf7\x37\xc2\x68\x05\x69\xcf\x23\xbc\x3d\xd4\x1f\x4e\xab\x3d\x93\xbc\x3d\x1d\x68\xfa\xb8\x16\x51\x0c\xc4\xe6\xe0\x64\xce\x93\x27\xda\x5a\xf1\xeb\xb6\xef\xf8\xd0\x49\x5b\x44\xfb\xf2\x09\xd2\xa6\x51\xd6\x26\x7b\xcd\x22\x97\x91\x81\xc4\x7a\x08\x21\xda\x06\x3c\x2f\x56\x5e\x4a\xec\x0d\x79\xa2\xbc\x27\x24\xb8\x39\xf2\x49\xa9\x74\xfd\x45\xca\xcc\xd3\x32\x29\xef\xe2\xeb\x2f\x0a\x5d\x17\xe3\x5d\xf3\xad\xc0\x68\x0f\x73\xae\x7f\x9d\xd4\xad\x12\xaf\x6f\x5f\xcf\x19\x6b\x3a\x93\x80\x4f\xb6\xa0\xd3\xa8\x0d\xa4\xb8\x40\x88\x2d\xe8\x94\x5a\xa5\xce\x6a\x74\x05\xd3\x51\x52\x9a\x0c\x25\x1a\x4f\xc3\xf7\xf1\x07\x90\x17\x85\x51\x12\xa5\xd1\x82\x9a\x25\x99\x88\x07\xfc\xde\x0e\xbb\xcd\x6c\x50\x09\x58\x8f\x98\x6e\x3c\xc0\x74\xf0\x4c\x76\xa7\x56\xd9\x2d\x4f\x54\x46\x84\x11\x3e\xcc\x9f\x73\x97\x32\x8f\x3b\x4d\x36\x6a\xea\x15\x66\x24\x56\x24\x06\xc0\xd1\x68\x8c\x88\x76\x44\x25\x6f\x27\xe4\x36\x58\xcc\x91\xdf\x26\x62\xa6\x68\x22\x38\x0c\xe7\xf2\xdc\xdc\x01\x96\xf4\x98\x8b\x1d\x8b\x4b\xd5\x0d\x07\xe1\xac\xb5\xd5\xde\xb5\xf6\x7e\xb0\x17\xfd\x9f\x9d\xee\xaf\x2d\x80\x20\x56\x88\x82\x51\x81\xab\xd1\xce\x60\x36\x2f\x0c\xd6\xf4\xf7\x5c\x7f\xfb\xed\xba\x7c\x56\x3e\x5c\xec\x80\x74\x52\x7e\xf4\x5f\xef\xbb\xd6\x8c\x41\x7f\xd9\xdb\x1e\x54\x08\xa2\x56\x09\xa8\x19\x97\xe8\x49\xf8\x0a\x3e\x88\x7c\x28\xca\x74\x86\x7b\x6a\xe6\x64\x30\xe0\x71\xd8\x2d\x18\x54\x32\xac\xa2\xdb\x54\x04\x34\x2d\x53\xb7\x60\x41\x26\x60\x19\x3f\x72\x7a\x50\x0e\x32\x84\xc8\xb5\x87\x1a\x17\x0a\x54\xc1\xdd\xf6\x1e\x2e\x9d\xf3\x9a\x9f\x6e\xf0\xa1\x0e\x93\xc3\x1c\xa2\x62\x7b\xb8\x79\xb6\xb4\x15\x7d\xa1\x52\x29\x88\x76\x7e\x9e\x9d\x5a\xce\x12\xe0\x2d\x7a\x6c\x0b\xcf\x8a\x87\x65\x52\xea\x8b\xc6\x89\x55\x3d\xdb\x07\x8d\x39\x43\x72\xc2\x9e\x06\x47\xda\x9b\x56\xe8\xef\xcd\x26\x32\x64\xb9\x06\xfa\x66\xcc\xa0\xad\x24\x36\x16\xec\x3d\xcb\x71\xde\x8a\x91\xbd\x35\x7c\xfa\x59\x62\x32\x90\x02\x5f\xa7\xbc\xfe\x21\x38\x7f\x8d\x0d\x96\x0a\xa9\xc5\xd9\xb7\xb5\xa2\x33\xc9\xa5\xfe\xed\x42\x79\xd4\x83\x06\xd1\x30\xba\xa4\xe6\xf5\x7b\x3d\x82\x28\x0c\x0e\xf4\x97\x32\x89\x78\x28\x28\x60\x8d\x42\x34\xea\x34\x4a\x19\x52\x08\xcd\xbe\x0e\x62\x68\x42\x2a\xb3\xd3\x43\xb7\x6c\xd7\xb2\x43\xda\x6b\x6a\x46\xbb\x27\xaf\x05\xd9\x61\x9e\x74\xfe\x44\x33\xd4\x48\x29\xdb\x4d\x8f\x8e\x5b\x4d\x31\xab\x39\xa2\x12\xdd\x7c\x6c\xb4\x30\xc4\xd1\x14\xa3\xa6\x6a\x05\x8a\x27\x07\xbb\xd1\x81\x22\x21\x8b\x8c\x14\x72\x27\x3f\xcd\xd2\x78\x19\x1a\xb3\x15\x3b\x57\xf5\xfb\x3a\xcc\x11\x9b\x5e\xef\x8a\x74\xa6\xb2\x30\xf1\xb6\x41\x95\x2e\xa2\x77\x2a\x8c\x11\x6b\xd9\xdd\x0f\x66\xcb\xc5\x56\xcb\x23\x2e\xfb\xd9\x8b\x67\xd6\x18\x2e\x31\xe8\x2f\x7a\xa1\x39\x90\xf2\x89\x50\xb0\x50\x50\x8c\x2e\x91\xab\x37\x9e\x11\xbf\x74\xc3\x19\xd7\x0f\x76\x17\x14\x42\xff\xb4\x7f\xb0\x03\xc2\x96\x7a\x48\xf1\xcd\x4d\x21\x78\x16\x5f\x75\xce\x2f\xd5\xf0\x73\xa5\x50\x8f\xc0\x65\xd2\xc0\xc2\x68\x0c\x7d\x10\x8e\x60\x03\x8b\xa7\xb4\xb0\x36\xa1\xd7\x2a\x15\x32\x8c\x2c\x06\x8a\x0d\xec\xb6\x5b\x05\xea\x29\x4b\x27\x12\xda\xe1\xb1\x61\x98\x6c\xe9\x22\x65\x5c\x41\xd7\xf2\x97\x25\xc0\xd2\x8e\x0e\x1a\x36\x29\x96\x4a\x50\x21\xa9\x42\xdd\x65\x51\xbe\x71\xcc\x63\x16\x15\x98\x19\x9a\xda\xb5\x73\x63\x3d\x1d\xde\x6a\xb8\x27\x91\xcf\x26\x7a\xc2\x3d\x3e\x6f\x25\x5c\x89\x15\xf2\xc9\xca\x67\xcb\xe5\xee\x4c\xb9\x9c\xf9\x52\x35\x9e\x2b\xc4\xaa\xa1\x1e\x6f\x07\x59\x14\x27\xf3\xf9\x04\xf9\xf0\xfa\x7a\x96\xf7\x26\xbb\x7a\x7b\xbb\x92\xbd\x4d\x3b\xcb\x6f\xc3\xd7\xf1\x35\xcc\x9f\x4b\x19\x2d\xad\x39\xca\xa5\xee\xa4\xbf\xd3\xed\x72\x18\x54\x4a\x3a\xeb\x21\x06\x98\x1d\x75\xf1\xb2\x99\x4f\xbe\x5f\xca\x17\xab\xcd\x53\x8f\x66\x84\x98\xbf\x49\xea\x1d\xf9\x12\xfa\x06\xe8\x5e\x7d\xbc\xd3\x28\x10\xb1\xa4\x12\xe5\xca\x71\x47\x89\x4e\x00\x3a\xf9\x99\x6c\x4e\x7a\x91\x4e\x81\x68\xcc\xc6\x15\x13\xd4\x3b\x30\xa5\xa4\x4d\x5b\x4c\xdb\x6f\xf6\x8d\x75\x09\x18\xe7\xa6\x97\x4d\xaf\x1d\xaf\x81\x02\x36\xf6\x74\xc9\x64\x42\x70\xe0\x94\xc5\x89\xe9\x7c\x6f\x57\xf7\x29\xa7\x56\xba\x2b\x7a\x71\x61\x3f\x36\xe1\x92\xf2\xa7\x9e\x85\xe5\xca\x32\x75\x3f\x60\xac\x91\x47\xd5\xa3\x65\x6c\x14\xe2\x9a\xcf\x7a\x27\xfa\xa3\x93\x79\xc5\x10\x1e\x97\xa7\x3a\xab\xe1\xfa\xaf\x7b\xc3\xa1\x2e\xf3\x97\x10\x83\x7b\x18\x21\x78\x1a\x5f\xc4\xe2\x0a\x15\xc0\x43\xa3\x61\xa9\x94\xd0\x04\x33\xab\x15\x71\x2b\x62\x90\x0e\x54\x18\x40\xad\x82\xdd\x88\xa8\x26\xda\x63\x07\xa9\xd5\x9a\x8d\x7a\xd0\x90\xfd\x8e\x69\x0f\x9f\x2c\x15\x44\x12\xab\x00\x1f\x9a\x9d\x1f\x61\xb5\x0a\x9f\x24\x7b\x88\x65\x57\xc8\x15\x87\xdf\xb0\x8c\xf9\xb3\xd7\x4a\x27\xca\x49\x20\x55\xaa\x04\xe5\x6e\xa9\x84\xf6\x7c\xf4\x24\x87\x3f\x9b\x0d\x87\x11\xca\x16\xb2\x85\x7c\x8e\x06\x3e\x4a\xc6\x09\x4e\x42\x3c\xf8\x91\x89\x06\x9e\x04\xdb\xc9\xa3\x1f\xe1\x39\x67\x21\xe0\xe9\x41\x10\xe4\x55\xb9\x60\xae\x04\x63\xe3\x5d\xa9\xc9\x58\xa4\x62\xb8\xf3\xce\x41\x85\xb0\x27\x93\x27\x55\x95\xf3\x97\xc1\x30\xae\x96\xeb\xef\xb3\xb8\x74\x3a\x97\xc5\x17\x4a\xad\x1b\x1c\x5a\x13\x0f\x7a\xb7\x94\x27\xa8\x03\x6a\x77\x7d\xc3\xd2\xda\xf0\x12\xe2\x05\x6c\xe5\xad\xe5\x32\x02\x14\x20\x3a\x94\x6f\xe1\x33\x51\x82\x58\x21\xac\x30\x30\x2b\x2d\x41\x1e\x05\x35\x8a\x81\x46\x2d\xa3\x06\xd4\x22\xb0\xd5\x01\x75\xb8\x40\x45\x6d\xb4\xb3\x15\x86\xa7\x83\x21\x6b\xb3\x96\x00\xed\xd5\xd0\x75\x53\x02\xc5\x23\xa1\x80\xdf\x62\x32\x47\x83\xc4\x08\xc1\xac\x13\xbd\x34\xdc\x23\x75\x13\x50\x21\xb7\x59\xc7\xc6\xa8\x06\x89\x00\x48\xee\xad\xf0\x47\xbf\xba\x58\xdf\xab\x8b\x63\x59\x45\x57\xb0\x5f\xb2\x6c\x45\xc9\xa5\x56\xe9\xa2\xa9\xbe\x53\x4c\x7f\xb7\xff\x1a\x7f\xc3\xf1\x98\x7e\xc3\x68\x7f\xb7\xb7\x77\x85\xe6\xec\x03\xbd\xe1\x54\xef\xfe\x53\xcb\x63\x72\x93\xcd\xbe\x74\x49\xaf\xae\x23\xd6\xbb\x62\x6d\x22\x5f\x40\x80\xcc\x8d\xe5\xf0\x32\x7e\x1f\xc1\xf5\xdb\x6b\x16\x0b\xc8\x84\x0e\x2b\xc6\x32\xee\xb4\x43\x44\xb2\x45\x5c\x05\xe7\xa7\x9b\x6c\x00\x08\xc3\xce\x96\x2f\x15\x3b\xf7\xf1\xa3\x04\x51\x0c\x88\xa4\xeb\x7d\x74\x99\x88\xc9\x32\xb1\x2d\x69\x5b\x02\xb6\xe7\x4a\xe5\x3c\xd2\xa1\x21\x93\x85\x90\x5d\x4f\xcb\xdd\x6a\xb9\x42\x4f\x41\x55\x38\xb8\x41\x06\x2e\xf7\x8c\x20\x28\x42\x85\x40\x42\xd0\x99\x8d\x1d\x11\xb9\xfd\xdc\x0b\x45\xe5\xb2\xb1\xb1\x54\x40\x09\xb2\x84\x33\x9e\x54\x28\xce\xbf\xd8\x69\xbe\xf8\xd1\x50\x0d\x2c\xda\xf1\x11\xe8\xaa\x6e\xd8\xb1\xad\x34\xa2\x76\x78\x6b\x6b\x57\x54\xeb\xdf\xa1\x13\x0c\x90\x8d\xdc\x1e\xc7\xa7\x21\x13\xca\xd5\x32\xe4\x37\x73\x51\xc4\xb6\x30\x98\xf9\x05\x49\xa3\xdc\x48\x46\xb3\x95\x9e\x4b\xb2\xc9\xa7\x4d\xcd\x3f\x23\xf5\xdf\x00\x01\xb6\x3d\x26\x39\x28\x32\x05\xe0\xf1\xfa\x4b\xbf\xfa\xd5\xf5\xd7\x4f\x54\xab\xb0\x1b\x17\x9e\x1b\xd8\x5d\xbd\xe9\xa6\x2a\x7c\x88\xeb\xa7\x42\x84\xb7\x7d\x9d\xf0\xb6\x4e\x14\x45\xa7\xd4\x2c\x21\xa7\xc5\x6c\xd0\x12\x52\x1c\xf4\xb8\xe4\x48\x8e\x04\xdc\xe4\x65\x4e\x2e\x9f\xc8\x28\xc6\x84\x9d\xed\xf2\x0b\x79\x45\x90\x7f\x78\xf6\x7b\xce\xb7\x9e\x34\xf9\x5c\x74\xf5\x4a\xbd\x95\x71\x29\xa6\x14\x2d\x4b\x62\x0c\x1d\x25\x31\x13\xf9\x49\x9a\x29\xe3\xb2\x0c\x7c\x7d\xe5\xba\xea\xfa\x53\xa1\x6f\x26\x57\xad\x9c\xe2\xec\x51\xa8\x9d\xb1\x60\x7f\x66\xe8\xe2\xfa\xdd\xe3\xbd\x3d\x93\x95\x01\xd9\xc0\xb0\xe9\xbe\x8b\xf6\x5f\x3b\xa2\xcc\x66\x14\x95\xae\xaa\xd2\x18\x0c\xc9\x8f\xd6\xe5\x81\x5b\x06\xd6\x5c\x61\x81\x7f\xea\xde\x71\x3a\x5f\x4f\xe8\x89\x5d\xdc\x6f\xc8\x98\x77\x52\x0d\xb3\x11\xe8\xea\x1a\x23\x8c\x9b\x83\x9d\xc0\xb5\x8b\xb2\x65\xeb\x54\xf3\x04\xba\xc9\x14\xa3\x87\x03\x99\x23\xe7\x80\x28\x88\xb3\x62\x3d\x00\xd1\x05\xd0\xbe\x85\xdf\x9c\xf2\x42\x6e\x2a\x9b\x5d\xa5\x10\x40\xe6\xf2\xb8\x5f\xf8\xe4\xa7\x65\xd0\x5f\xae\xd7\x61\xb2\xf0\x57\x3a\xf3\xfe\x0c\x61\xf9\x5f\x0b\x4d\xff\x85\x7f\x84\x1f\xe0\x83\xa4\xca\x5d\xac\x3d\x2e\x72\x7b\x86\xfc\xf6\xd2\x48\x36\x4d\x2d\xac\x99\x69\x7b\x44\x85\xdc\x48\x8d\xbb\x64\x20\xb0\x59\x78\xa8\x65\xf2\x8f\x36\x49\x47\xc2\xbd\xc8\xdb\xe1\x62\x1a\xf7\x08\x11\x96\x43\x26\xb1\x54\x29\xd1\x8b\xbb\x85\xb7\x89\xec\xa2\xa1\x47\x9e\x79\xd7\x79\xf4\xe8\xcd\xf2\x15\xaa\x67\xc8\xdf\x96\xfd\xbf\x38\xfd\x4c\x70\x78\x07\xbc\x3d\xe4\xba\x4f\xb4\x29\xf6\x8d\x0c\x8c\xf4\x90\xeb\xcf\x53\x9b\x90\x74\x36\xf7\x8b\x64\x5d\xee\xe6\xab\x07\x22\xbf\x30\x27\x53\x00\x5c\x79\xd0\x0a\x8d\x61\x8b\xd9\x2c\x44\x4b\x20\x05\xc4\x88\x91\x3b\x8f\x2f\x4f\xd8\x0f\x97\x4c\x61\xff\xcc\x68\xb6\x32\x5a\xcc\x88\xd3\xab\x57\x8c\xe2\x81\xd3\xf7\xd4\xef\x86\x1d\x0b\x57\xa9\xea\x33\x70\xbf\x7a\xe5\x44\xfd\x69\x8d\x59\x80\x5d\xdb\xcf\x50\xaf\x5c\xc9\xf9\x88\x1e\x21\xf8\x15\xc1\x89\x83\xd4\xee\x65\x67\xac\x40\xae\xf0\xd0\x79\x48\x42\x90\x89\x82\x5c\x23\xc3\x02\x02\x42\x89\xb9\x66\x5b\xe0\x3b\x90\xde\x0e\x8a\x3e\x69\xd0\x6b\xe9\xa0\x27\xbd\x25\x90\x8b\xfb\x42\xb7\x90\x8b\xc7\xe8\x08\x14\x62\x96\x52\x08\x7e\x35\x94\xbb\x66\x7f\x7f\xa5\x32\x70\xfb\xaa\x71\x85\x30\x54\xbf\x7d\xe5\xca\xdf\xf5\x82\xa5\xfe\x3b\x7e\x15\x20\x51\x7f\x01\x12\x2c\x38\xe2\x07\xeb\x4f\xd2\x01\x24\xa2\x32\xb1\xdd\xfe\x0d\x69\x9b\x1a\x99\xd1\x1a\xb4\x01\x6d\x26\x34\xf1\x54\xa8\x72\x1e\xe7\x8e\x01\x12\xa2\xa0\x44\xd3\x20\x2a\xb7\xaf\x5d\x5c\xeb\x91\x6b\x44\x37\x80\x46\xbe\xc8\xc3\x89\x4f\x7b\x0a\xfd\x9c\x14\xad\x97\xba\x79\x5e\xce\xcc\xf0\x1a\x72\x06\x10\x10\x52\x0a\x68\xb7\x4e\x85\x95\x7a\x35\x16\x35\x4a\x91\xd2\x31\xe6\x4e\xdd\xca\x83\xef\x51\xaa\xcc\x75\x5a\x5e\xf9\x74\xb3\xe2\xb2\x94\x11\xe9\x45\xa5\x7e\x2d\xcd\xae\x86\x93\xe7\xae\x55\x58\x46\x1d\xcd\xa8\x23\x59\x75\xfa\xdd\x2a\x78\x33\x39\x09\x1f\x0c\x6a\x35\x2b\x96\x2d\x5a\x30\xd8\x5f\x2e\x76\x67\xd2\x29\xea\xac\xde\xeb\x71\x39\x4c\x06\x8d\x59\x6b\x0e\x98\x02\x46\x7a\xbc\x42\x11\xa2\x1d\x44\xd7\x53\x22\x77\x2c\x63\x6b\xb9\x9a\x91\xfc\x0a\x55\x0a\x44\x3d\xc0\x3a\x4e\x6c\x79\x98\xa9\x54\x4a\x8c\xdc\x96\xca\x84\x5a\xcc\xf6\x69\x45\x17\x8f\xc4\xa0\xaa\xc0\x64\x59\x91\x47\x89\xa0\xbe\xee\x0c\x13\xa3\x8e\xea\xd4\xf8\xc2\x1b\xd7\xa5\xba\x8c\xb0\x26\xda\x93\xe9\x0a\x74\x04\xe3\x11\x10\x2b\x62\x7e\x10\x14\xf2\x7e\x65\x59\x04\xbd\xc9\x60\xb1\x1a\x8c\x1e\x9b\xd3\x05\x4e\x6f\x3a\x91\x8b\x05\xb2\x16\x1f\x98\x7c\xd6\xa8\xd3\x17\xa7\x61\x92\x4c\x4a\x7c\x95\x5c\xc0\x0a\xbd\x20\x60\xb8\x79\x5d\xfd\x0b\xf8\xe0\xea\xda\xbe\x53\x13\xe5\x2f\xff\x3c\xec\x8d\x65\x6d\x96\x5c\xc9\xa2\x59\x9f\xcd\x68\x45\xe2\xba\x46\x9b\x5d\xa5\xb3\x77\x3a\xb5\x11\xb0\x99\x74\x46\xbd\xde\xa1\x36\x84\x7d\x81\xa8\xdd\x15\x07\x97\xc9\xa9\x35\x6a\x2c\x82\x8c\x19\x92\xaf\x05\x4a\x8b\xc8\x4d\xce\xd7\x57\x3a\x84\xe0\x4f\x64\x9c\x75\xa0\x20\x3b\xe7\xb6\xb5\xb6\x29\x02\x72\x04\xd4\xb8\x1f\x16\x12\xa9\x43\xd4\x2a\x30\x92\x8b\x68\xa7\x51\x87\xe5\x7a\x19\x06\x83\x1c\x76\x9a\xc0\x80\xb0\x60\xc0\x3b\x91\x46\xd3\xe4\x05\xfc\x9c\x5e\x32\x19\x0a\x25\x33\xc9\x74\xaa\x2b\x94\x08\x25\xe2\xb1\xa0\x9f\x46\x0d\x09\xb0\xa9\x12\xb4\x1b\xcd\x6c\xb2\x10\xbc\x33\xaf\x48\xb4\x4b\x38\x9d\xa3\x17\xc5\x2c\x99\x31\x04\xb5\x21\x07\x35\x8a\x0d\xd9\xec\x15\xc2\x48\xe0\x4f\x5d\x00\xe4\x18\xe1\xfa\xa9\xcb\x16\x64\xc8\xdf\x97\x97\x6c\x32\xe5\xeb\x37\xfe\x56\x5f\x49\x1c\xfa\x37\xe0\xd7\xbf\xed\xfd\xfd\x85\xf0\x1d\x85\x4e\x2f\x26\x0a\x7d\x64\x3a\xbd\x33\x11\x4f\x9c\x39\x58\x76\x78\x0e\x2b\x74\xa2\x3e\x3e\x93\xcd\x32\x3e\x63\x41\x08\xbe\x43\xe0\xb4\xa3\x4e\x54\xa9\x15\x55\xa0\xa0\x47\x75\x14\xf2\x9d\x54\xd8\x02\xae\x02\x55\xab\xc5\x8d\x48\x14\x5b\xe7\x9d\x3a\xbd\x2e\x47\x98\x37\xdd\xc4\x42\x49\x56\x24\xae\x46\x2f\x53\x53\xf7\x2f\xf9\xcd\x0a\x5d\x77\xcf\x3d\x0b\xf2\xe4\xef\xcb\xdb\xb7\x6d\xd9\x72\xd9\x85\x23\x83\xb5\xa1\x43\x3f\x19\xbc\xfb\xee\xc1\x9e\x41\xf0\x6d\x5b\xb2\x78\xf3\x60\xb5\x90\x1b\xe2\x74\xaf\x40\x6e\xdf\x20\xed\x71\x13\x7d\x93\x4b\x0d\x59\x8a\x16\x07\x43\x8a\x68\x09\x09\xb4\xcc\x4a\xa0\x14\xa0\xc8\x28\x05\xe0\x1b\xd7\xe5\x75\x4b\x16\x2b\xc7\x72\x37\x2c\xb9\xfa\xca\x2b\xf7\x58\xea\xff\x06\xb9\x72\xb0\x7c\x15\xdc\x89\xf3\xbe\xfe\x81\xcc\x94\x96\x78\x25\xb0\x0c\x2e\x1a\x88\x65\x46\x10\x48\xb4\xbf\x88\xa6\x6a\x0b\x8c\x20\x60\x13\x80\x90\x04\x51\x51\xec\xc2\x4a\x95\x6c\x11\xb3\x5b\x46\x0a\x2a\x66\x29\x10\xb1\xc1\x54\x49\xea\x73\x6b\xbb\x6f\xa6\x42\x2e\x14\x2e\x52\x37\xef\x26\x9b\x9a\x42\xcf\x7c\xfd\x92\x96\x49\x67\xd6\x9a\x47\x92\x68\xb3\x69\x97\xb2\xb9\xd1\x92\xb4\xb8\x4c\x22\xc6\x06\xbb\x0d\x23\x45\xb1\x7b\x79\x76\xaa\x92\xb2\x25\x13\x89\xbc\xf2\x92\x33\x75\x61\x85\xc6\x91\xf6\xf9\x02\xa6\x48\xd0\x5d\x2c\x9a\xfa\x92\x59\x6b\x6a\x32\xe7\x5b\x38\x11\x1d\xa8\xfa\xfd\xa5\x95\xe9\x42\xc5\xe5\x1c\xa9\xda\xbb\xaa\xa5\xd4\x54\x1f\x99\xed\x6a\x65\x47\x31\x69\xd1\xe9\xa2\x74\xcc\xce\xa1\xdb\x7e\x0a\xa5\x9b\x20\x04\x16\x21\x25\x11\xa5\x95\xf2\x43\x5a\x83\x46\x25\xca\xe5\x7a\x9d\x0c\xcb\x18\x01\x57\xb7\x13\x70\x84\xfc\x9d\x94\x88\x93\xfc\xb3\x37\x23\x8d\x74\x74\x12\x11\x8a\xff\x23\x90\x0a\x84\x96\x57\x0a\x0e\x72\x17\x42\x85\x98\x58\x09\x39\x2a\x84\x9a\x2f\x4e\x38\xc7\x7a\xc7\xdd\x89\xc9\xe1\x2e\xd2\xf2\x7e\xeb\x40\x3a\x5c\x4a\x3e\x1b\xb3\x6c\xb6\xfa\xbf\xaf\xee\x84\x89\x78\xfd\x55\xf0\xd1\x2b\x4e\x09\xfb\xeb\xaf\xd3\xfb\xf2\xe7\x57\xad\x59\x43\xfb\x3d\xcc\xc6\xe1\x69\xc8\x49\x77\xe3\xec\x16\x24\x20\x15\x2c\x52\x02\x12\xd9\x18\xdc\xa5\x00\x4c\xad\x71\xb0\xb0\x93\x46\x36\x25\xdd\xc1\x4e\x61\x3a\x1d\x36\xab\xd4\x4a\x3a\x12\x61\xb6\x90\xc5\x2e\x81\xba\x54\x81\xf0\xe5\xe4\x6f\xec\x5a\xf2\xf7\xec\x92\x1b\x2f\x5b\x04\x31\x5b\x75\xa8\x67\xdb\xb6\x9e\xa1\xaa\x0d\x16\xd6\x5f\x07\x19\xdb\xf3\xb9\x12\xbe\x8a\x23\x48\x4f\xc6\x9e\x02\x41\xf6\x24\xfb\x3b\x57\xce\xb5\xe1\x78\x17\xd1\x86\xed\x80\xd3\x91\xb6\xa9\x2b\x95\xec\x26\xd8\x7c\xf8\xfa\x60\x2d\xe9\xc9\x77\x0e\xa5\x3f\xd4\xdf\x95\xec\xf7\x74\x77\x24\x07\xe8\x78\x6f\xec\x82\x47\x04\xd5\xec\xbd\xad\x9d\x79\x58\x90\x7f\x8f\x55\xb8\xde\xd6\xf4\x4d\x23\x83\x4f\xc2\x17\x90\x95\xa4\x31\xaa\x48\x1a\xb3\x42\x24\xba\x71\xbe\x13\xc2\x46\x1d\xfd\xf6\x99\x3d\x0a\xd8\x57\xbf\x55\xf5\xd9\xf7\x3d\xba\xef\xfc\xea\xee\xb1\xc7\xde\x07\x77\xaf\x59\x1e\x7f\x7f\xfc\x1d\xbb\xc7\x76\x57\xe3\x34\xb6\x2a\xb8\xe1\xcb\x40\x77\x33\x0f\xb2\x72\xd3\x68\x0f\x7c\x19\x6b\x90\x86\x9f\x68\x03\x39\x5e\x2d\x00\xc8\x00\xc9\xb9\xe1\xa9\x7c\xa3\x82\x3c\x93\x2f\xe6\xbe\x5d\x18\x92\xe9\xb6\x4c\x85\x93\x25\x86\xe6\x17\x1f\x7b\x6c\xe8\xe1\x87\xf7\x3c\x33\xf0\xf0\xc3\x03\xcf\xb0\x32\x79\x1d\x27\xd1\x89\xab\xb8\x4e\x3c\x0d\x77\x82\x9b\x6e\xc0\xd1\x54\x37\xc2\x47\xe0\xef\x48\xd3\xc2\x7d\x24\x26\x32\xec\x53\xd4\xc3\x85\x17\x5d\x98\x3a\x74\xa8\xfb\x82\x43\xd9\x4b\x2e\xba\xf1\xb2\x77\xa6\x0e\x5f\x96\x39\x7c\x38\x75\xf9\xe5\x5c\x5e\x99\x44\xa7\xc3\xa3\x98\x36\x92\xea\x2d\x6a\x35\x52\xa0\xa2\x65\x7d\x22\xf2\x51\xc3\x4c\x17\xd7\xb2\x3d\x30\x76\x46\x7e\xa9\xd1\x48\x6d\x18\x8c\x1e\x23\xd1\x69\x68\x0d\x5a\x83\x5e\x27\x19\x72\xb4\x6f\x60\xd2\x85\x9f\xa2\xed\xf7\xef\xdc\x1e\x93\xd9\xe3\xb6\x3c\x05\x7b\x3e\xdb\xd1\x61\x36\x79\xdc\x66\xb8\xcf\x6d\xb6\x78\x3a\xcc\x66\xf7\x75\xa3\xa3\xf7\xbb\xcd\x56\x37\xfd\xce\xe2\xfc\xa2\x9f\x12\x5c\x3c\x41\x2d\x4b\xd0\x12\x1e\xb1\xc6\x8a\xb0\x9c\xed\x36\xc9\xa0\xb9\xdd\xe4\x00\x16\x5d\x10\xc9\x60\xee\x7e\x93\xbc\xb9\xdf\x64\x96\xc9\xc8\x6b\x51\x46\x04\x52\x86\x3d\x45\x73\x47\x41\xce\x31\x58\xdf\x49\xb0\x78\xee\xc0\xc0\xdd\x03\x03\xac\x4f\x2b\xa4\x4f\xf7\x20\x0b\x1d\x2b\x0a\x46\x3f\x99\x63\xb8\x58\xcb\x03\x1f\xc1\x67\x01\xbe\xfc\xbe\x9e\x1f\xfd\x7b\x73\xd7\xf2\x5d\x57\x55\x3e\xf3\xbf\xbb\xba\xd6\x1d\x82\x73\xb7\x0c\x7c\xc8\x79\xdd\xc0\x96\x81\x8f\xba\xee\xe0\x63\xae\x9b\xcc\xc7\x4a\x9b\xde\xbf\x14\x89\x94\xe4\xb6\x38\x6c\x24\xda\xb8\x07\xba\xa7\x9f\x7b\x6c\xf2\xa5\x2c\xa7\xd9\x21\x92\xf6\xf9\x66\x5a\x81\xa6\x25\x29\x23\x36\xb9\x1c\x9e\xaf\xaf\x81\xfb\x49\xf2\x4d\xdd\xff\xcc\xbe\x34\xf9\xd8\x73\x08\x93\xb4\x26\x32\xc7\x0c\xcc\x3e\x53\xf9\xb8\x8a\xee\x5d\x48\x65\x0b\x84\xb2\x87\x60\x3d\xc9\x70\xbf\x86\x42\xb5\x6f\xe4\xae\x8b\x26\x1f\xc9\xd6\x5f\x62\xbc\x61\x6e\x5e\x25\xdf\x37\x61\x75\xe9\x30\x5d\xa0\x7f\xb5\x3e\x43\xab\x23\x25\xec\x20\x99\xbf\x94\x7d\x64\xf2\xa2\xbb\xee\x1a\xa2\x99\x69\x5f\x98\x88\xbe\x74\x88\x8d\x91\xdd\x5c\x96\xeb\x6c\x1f\xec\x64\x54\x28\xb0\x9c\x3a\x9c\x15\x37\xaa\x40\x14\x6d\x74\x1d\x1a\x9e\x37\x85\x28\x2a\x56\xb7\xd2\x29\x44\xd2\x41\x76\x3a\x94\xa4\x41\xc4\x27\x8a\x7a\xce\x44\xa1\x03\x89\x4f\x96\xff\x83\x3b\xef\x7c\x96\xce\x97\x67\xaf\xa6\x6d\xa3\xfb\x4a\xe8\x13\xf0\x3c\x7c\x1b\x45\xf1\x79\xe8\x14\x50\x90\xfb\x11\x64\x7a\x0c\xc1\x11\x1a\xba\xf2\x31\x20\xe7\x98\x8e\x90\x2f\xa8\xb9\xe7\xa4\x26\x69\xe9\x19\xe2\x7e\xb6\xcf\x9d\xb3\xdb\x30\x06\x95\x92\x6a\xe1\x98\xf9\x05\x5e\x8b\x9a\x66\x3b\x2d\xeb\x20\x2c\x2c\x65\xce\x93\x9c\xe1\x48\x98\x2e\x55\xe8\xee\x37\x6a\xa9\x02\x62\x5c\xef\x71\x2c\xe2\x2e\x8c\xc6\x1c\x76\x8f\xd1\x19\x36\xe6\x4c\x83\x29\x62\xe9\x64\xf5\x99\xec\x5e\x6b\xc6\x9d\x8f\x80\x9a\xf8\x6f\xd0\xda\xdc\xf6\x70\x8a\x80\xaa\x25\xb1\xd1\x93\x01\xda\xa6\xf5\xe8\x6e\xb8\x07\xd6\x23\x39\x9a\xe0\x5e\xb5\x1d\x02\x3b\x54\x7f\x70\xee\xae\x26\x39\x42\x41\xdf\xe0\x4b\xe7\xbe\x20\x0e\x7d\x99\x77\x1a\x39\x75\x30\x64\x27\xc7\x27\x98\x83\xa1\x4d\xf5\xa3\x30\x7a\xf7\x45\x43\x9f\x42\x02\x52\xa3\x8f\xc0\x6f\xe1\x51\xe6\x63\x3c\x8d\xd4\x4f\x26\x42\x5e\x87\x06\x84\x6c\x57\xf8\x98\xa8\x7a\x2c\xca\x8f\x2d\xdc\x66\x68\x5d\x66\x80\xf2\xb3\x0e\xf0\xdb\xf1\xd2\xc2\xee\xc2\x68\x69\x7c\xa2\x18\x21\xeb\xbd\xde\x48\x69\xac\xfe\xe3\x98\xcb\x1b\x0a\x79\x3d\x71\xd3\x0f\x42\x1d\xee\xb8\xc5\x92\x70\x7a\x43\xff\x58\xb4\x74\xe9\x42\xe2\x59\x35\x92\x8c\xc7\x26\x7b\x63\xfe\x18\x71\xff\x1f\x0a\xf9\x62\xc1\x70\x38\x18\xeb\x64\x74\x47\x87\xf6\x10\x7e\x1b\x45\x0e\xe4\x47\x09\xd2\xa6\x30\x59\x9c\xdb\xd5\xa4\x4d\x20\x1d\xcb\x27\xf3\x4e\xd2\x2d\x55\x1c\x32\x47\x85\xbb\xed\x09\xc7\x44\x7e\x54\x9f\xcc\xa4\xfe\x9d\xfd\x89\x69\x5f\xcd\x5d\xcb\xf4\xed\xec\x4b\x2c\xf6\x8d\xb8\xea\xfb\x3b\xff\xd0\xdd\x9f\x9e\x4a\xc1\xa2\xc0\x1f\x32\x03\xe9\xe9\x34\x3c\xdf\x3f\xd5\xb7\xab\xcf\xe7\xe9\xeb\xe8\xeb\x9f\x22\xe9\xbc\x9e\x81\x8e\x7f\xa6\x5e\x8c\xa5\xa6\x53\xe5\xd4\xcb\x71\x92\x84\xc6\x73\x6c\x18\xe1\xe3\xf8\x1f\xc8\x4e\xe6\xa1\x8a\xce\x43\x7b\x8c\x9f\xad\x31\x51\xcf\x25\x36\x86\x12\x1a\xc9\x6d\x6f\xc0\x1b\x88\xa7\x6f\x0b\x76\x76\x74\xa6\x12\x4b\xc7\xff\xa0\x1b\xd4\xf5\x0f\xe1\xb3\x3e\xa1\x1d\xd0\x54\xa7\x8e\x5e\x3b\x33\x43\xf7\x5a\x11\x82\xd7\xa8\xfe\x81\x94\xa5\xa7\x74\x84\x47\xb2\x23\x7c\xb5\xdd\x84\x92\x92\x96\x12\x57\xe0\x1e\x99\x59\x5c\xfd\xf8\x19\x03\x53\x17\x9c\xb6\xff\xfc\x85\x43\x67\x3e\x31\x3a\xbe\x76\x29\xde\x72\xe5\xc5\x1b\x6f\x0d\x8c\x8f\xc6\x6f\xd9\x76\xcf\x07\x3f\xf0\xde\xed\xb7\x44\x47\xc7\x83\xd7\x6f\x3d\x4c\x09\x37\x26\x58\x03\xf8\x2e\x59\xe3\xca\x91\x92\xcc\x67\x51\x81\xe9\x7c\x06\x8b\x2d\x50\xb2\x58\x04\x01\xa2\x8f\xd4\x17\x3f\xfa\xec\x0d\x37\x13\xb7\x34\xb7\xa6\x33\x78\xef\xd1\x0f\xe0\xe0\xd1\x17\x99\x5d\xc1\x08\x7c\x9a\xae\x01\x49\xdb\xe4\x14\x4e\x4b\x80\x34\xc5\xc4\x84\xe6\x32\x5c\x25\x87\xc9\x3f\x2f\xc4\x07\xe5\x3a\xd9\xbf\x7f\x85\xff\x74\x54\x8f\x98\x7c\xd1\x58\x4d\x68\xc7\x5d\xc7\xf8\x0b\xd8\x02\x16\x21\x40\xeb\x12\x4a\x84\x72\xdc\xfe\xad\x17\xe0\x60\xfd\x94\xcf\x7f\x1e\xdf\xd5\x57\x7f\x4f\xfd\xd6\xbe\xe5\xb0\x12\xd6\x2c\x66\x79\xb5\xc4\xaf\xe5\xaf\x09\x2e\x94\xad\xbc\x0e\x2a\x04\x11\xa9\x42\xf8\xd6\xbe\x99\x43\x9b\xbf\xd4\xfb\x0b\x11\x42\x95\xfa\x51\xbc\xf2\x28\x93\x65\x9c\x8d\x19\xf8\x3d\x7c\x05\x85\xa9\x8e\x23\xe0\xb1\x09\x18\xd9\x55\x58\xc0\x98\xfb\x4c\x6b\x33\xdb\x9b\x42\xcc\xee\x81\xc8\x92\x41\xa7\x55\x41\x1d\xcf\x56\x5a\x3e\xa3\x08\x56\x4d\xe4\x0b\x1d\xc0\x15\x07\x1b\x4b\x7c\x44\x59\xc5\x90\x53\xa5\x19\x29\xa6\x73\x45\x62\xcd\xe6\x55\x28\x3c\x91\x82\x3f\x23\x33\x8d\xc6\xa2\x83\x7a\x18\x3c\x7c\xc9\x11\x4f\xa3\xfe\xc7\xe9\x21\x99\x6c\xf1\x18\xa8\xea\x0f\x8f\x3b\xf4\xda\x42\x25\x15\x88\xc4\xc3\xc1\xdb\xa6\x9b\xba\xa5\xbf\x12\xbc\xdf\x4a\xfa\x36\x43\xf0\x1e\xf6\xdb\x28\xde\x89\xb2\x50\x0a\x69\x51\x94\x88\x03\x1d\xb7\x2d\xd7\x30\x95\xa8\x14\xde\xc2\x5e\xb8\x76\x93\x0a\x67\x36\x8d\x6d\xaa\x80\x4c\xb3\x60\xa1\x77\xc8\x21\xf6\x8c\xec\x3d\xa8\x93\xe5\xf6\xaf\x5e\xba\x4c\xe1\xa8\x25\x73\x63\x01\x2c\xf6\x77\x6b\xb6\xae\x74\xea\x87\x0e\x8e\xaf\xd1\x99\xdc\x33\x5b\xf5\xfa\x3e\xe7\xa1\xb3\xfd\x89\xf5\xef\xdb\x55\xb9\x63\x57\x21\xd3\xbb\x3e\xaf\xb6\xf6\x0f\x21\x39\xea\x20\xe7\x39\x5f\x25\x94\x4f\xcf\xa2\x20\xa4\x51\x15\x86\xb8\x23\xaf\x94\x16\xe4\x6a\x00\x85\x9c\x2a\xab\x91\x52\xa5\x50\xee\x41\x3c\x24\x18\xb5\x2e\xd6\x03\x0d\x21\xab\x13\x35\x42\xbb\x87\xaf\xec\xf1\x79\xb8\x10\x4f\x33\xa2\xf9\xf3\x39\xfe\x83\xba\x5c\xff\x51\x5d\xb5\xe2\x49\xb3\x68\x80\x24\x65\x19\xc5\xcd\x3a\xe0\xc1\xd3\xa9\x66\x3b\x99\xc9\x74\x76\x5a\x2c\xd4\x9d\x4f\xa6\x9a\xe9\x29\xe6\x3b\xd3\x9d\xa9\x58\x84\x06\x78\x20\x9b\x1b\x66\x03\x39\xa1\xc0\x5c\xfb\x18\xc4\x76\xd7\x3e\xdc\x14\x0b\x0a\x60\x6e\x53\x69\xbf\x81\xe7\x9f\x03\x45\x9f\x8f\xfd\xaf\x65\x37\xc1\x4c\xb5\xfe\xc1\x81\x0e\x9f\xcd\xea\xf5\xd9\xbe\xc2\x9e\x66\x86\x7d\x05\x9f\xc3\xeb\x75\x92\x93\x17\x76\x38\x2b\xd8\x1f\x09\xf7\x85\x42\xfd\xe1\xea\xc2\xfa\x35\xf8\xe0\xd1\x1b\xe1\xa1\x68\x3c\x1e\x8d\xc6\x63\xb1\xfa\x74\xb0\x2f\x4c\x5e\x4e\x97\xc3\x7d\xc1\x7c\x3c\x1a\x4a\xc7\x62\xf1\x38\xd3\xe3\x58\xc9\x18\x7c\x19\x9f\xc3\x6c\x6d\xe9\x5e\x53\x01\x0d\xa1\x29\xf4\x18\x39\x03\xe7\x66\x1e\x44\x64\xe0\x04\x25\x0b\x11\xd2\x49\xd5\x2e\x0a\x90\xa9\x81\xac\xd1\x94\xbb\x8f\x59\x96\x6b\x01\x80\xe0\x95\x45\xed\x32\x69\x8c\x82\x4a\x25\xdf\x6c\x10\xf5\x42\x53\x71\xd2\x4d\xde\x90\x24\x32\x01\x76\xcf\x97\xfb\xb8\x1c\x33\xb5\x7c\xad\x56\x2c\xc6\xe3\x1d\x1d\x94\x9f\xd7\xa6\x6a\x8b\x26\xc6\x8a\x43\xc5\xc1\xde\x9e\x78\x21\x9e\xcf\xa4\xe8\x7e\x56\xd0\xdf\x66\xf5\x6b\x9e\x23\x2c\xce\xf6\x9e\x04\x36\x74\x02\x2b\xe0\x68\x5b\x3a\x7f\xdb\xf7\xb5\x03\x03\x5d\x5d\x03\x7d\x69\xb3\xdb\x63\xa1\xd7\x01\xe2\x8c\xf0\xec\xe6\xb3\xaf\x36\x3f\xff\xa7\xf9\x0e\x94\xae\xe6\x97\xcb\x26\x8a\x85\x89\xc9\x42\x69\xac\x48\x59\x13\xbd\x5e\xe8\x01\x59\x4f\xdd\xd0\x7a\xce\x3f\x8b\xe3\xd2\x7b\x7a\x85\xc8\x1f\xe5\x61\x09\xb4\x9d\xd0\x45\x39\x32\xa0\x4e\xd4\x45\x78\x58\xc4\x6f\x37\x2a\x90\x40\xd7\x2e\xc7\xc7\x00\x6b\x11\x00\x32\xff\x63\x6d\xb2\xcd\x67\x36\xf7\xf4\x6c\xae\x56\xb7\xf4\xf4\x6c\xa9\x66\x16\x67\xc8\xff\x34\xbd\xa7\xcd\x67\x9c\x91\x38\xed\xb4\xed\xe4\x29\x7d\xc7\xee\xf1\xcc\x92\x34\x7f\x99\x5e\x92\xe9\x3c\x37\x7e\xf0\x60\xfc\x5c\x2e\x03\xc1\x77\x88\x0c\x39\x04\x22\xa1\x01\x08\x7d\x19\x29\xa9\xfc\xf3\x65\x2a\xf6\x00\xea\x43\x22\x3c\x8d\xc7\x91\x4a\x92\x45\xd9\xe1\x5c\xb2\x22\x33\xfd\xf8\xcc\xae\x67\xec\x8f\xe4\xf7\x8a\xf0\xe9\x1b\x42\xbf\xfd\x7d\xe0\xfa\xfa\x48\x53\xcf\x8c\x4e\x85\x9f\xe1\x2c\xcb\xa3\x92\xd1\x3c\x20\xc6\x22\x0e\x21\x56\x01\x3d\xac\x78\x4a\x01\x5d\xc6\x4c\x9f\xbb\x7e\xe4\x0e\x58\x6e\xad\x84\x8c\xf5\x17\xb5\x47\xea\x8f\x92\x7c\x46\x92\xef\x47\xf0\x4b\x96\x4f\x21\x30\xde\x42\xcd\x5d\x48\x7d\x01\x23\x2c\x6a\xd6\xf1\x24\x2c\x68\xd6\xdb\xac\x2b\x06\x3f\x83\xdf\xf3\x3c\x40\xf3\x54\x20\x56\xb1\x88\x91\x8a\x43\x9f\x85\x65\xee\xbe\x8c\xb1\xfe\x7d\xc5\x53\xf0\xa9\x6c\xfd\xd1\x23\xda\xfa\x8b\xc6\x50\xc5\x8a\x18\x5c\x5e\x02\xd7\x7a\xa4\x92\xf8\x58\xc1\x44\xc4\xf3\x10\xa9\xf0\xc7\xa3\x7b\xf3\x8f\xd8\x9f\xe9\x3a\x13\xaf\xaf\x8f\x5c\x1f\xf8\xfd\x6f\x43\x4d\x5b\x69\x0d\xa9\xeb\xc7\xbc\x2e\x0e\x17\x40\xc5\x21\x8f\x59\x1c\x22\xc0\x8f\xeb\x1f\x79\x4a\x51\xff\x3e\x05\x0c\x96\xc1\xa7\xea\x8f\x52\xc8\x20\xa8\x3d\x02\xcb\x59\x5e\x07\x81\xed\xe5\x26\x6c\xa2\x8c\xf3\x40\x87\xc8\x6c\x7f\xe0\xe5\xfa\x93\xbc\x1a\x58\xd0\xaa\xba\x59\xdf\x5e\xf8\x31\xce\x1d\x83\x2d\x22\x82\x43\x14\x2a\x72\x91\xb4\x23\x5b\x3f\x42\x81\x83\x2e\xc5\x53\xef\xc9\xc2\xf2\x23\x5a\x08\xb6\x60\x53\x93\x7c\xbf\x86\xd7\x90\xa1\xb5\x26\x00\x86\xc4\x50\xb3\xbe\x42\x0c\x7a\x8f\x7c\x34\xb0\xf1\x2f\x1b\x03\x1f\x3d\x12\xda\xf4\xb7\x4d\xf0\x1a\xc3\x67\xb3\x6a\x5e\x77\x1f\xea\x21\xf8\x71\xb2\x32\x54\xbc\x6e\x8a\x9b\x52\xb3\xf3\x6d\x15\x78\x7a\x56\xa6\xd7\x66\x95\xc8\xd7\x0c\x41\x84\xe0\x7b\xf8\x02\xa4\x45\x16\xc2\xeb\xcc\x06\x8d\x02\x53\xb8\x43\x8e\x18\xd7\xb1\x51\x35\x46\x4c\x24\x4a\x2b\xf8\x9e\x01\x84\x75\x2f\xed\xfd\xba\xa7\x77\xc3\x07\x3f\xb8\xf1\x7a\xb8\x23\x25\x08\xf5\x9b\x61\x7f\xea\x7f\x7e\xf1\xf4\xd3\xf5\x9b\x10\x26\x30\x8d\x12\x98\xfe\xda\x2c\xcb\xa0\x13\x10\xe6\x70\x85\x4a\x22\x97\xf5\x2d\x95\x18\xd5\x3f\x80\xf8\x3f\xff\x43\x72\x7f\xfb\xdb\x89\xa7\x9f\xfe\xce\x77\xe0\xaf\x5f\xf7\x7c\x8d\x17\x5e\x3f\x4c\x8b\x3e\xbe\x5d\x46\x9d\x52\xa0\x65\x15\x48\x59\x31\xc2\xff\xc9\xbf\x8a\x58\x61\x2d\x84\x2f\x18\xbe\xe6\xf9\xfa\xde\x97\xd6\x09\x5f\xfb\xe0\x86\xeb\x37\x82\x21\x45\xca\x86\xfd\xf5\x9b\x85\x9f\x3f\x0d\x07\xe6\x69\x97\x45\xaf\x92\xd3\x75\x51\xc8\xe6\x20\x8d\x89\xb1\x83\x59\x72\x07\x53\x86\xc0\xaf\xbf\x2d\x08\x34\xff\xb7\xeb\x8b\x49\xc3\x3e\xbc\x4e\x00\x56\xf8\x41\x5a\x32\x1b\x5f\x52\xbb\x68\x7f\x2b\x05\x36\x46\x5a\x88\x82\xef\x9d\x4a\xd1\x43\x10\xc3\xd1\x42\xd3\x4b\x75\xb3\x31\x45\xd3\x57\x28\x59\x60\x38\x51\x7f\x9b\x56\xf5\x61\x9a\xe7\x6b\xa7\xce\x2d\x9b\x8f\x25\x09\x5a\xf8\x1e\x03\xf2\xd4\xff\x69\x02\x77\x5c\xd9\x0a\x0c\x0c\xa6\x26\x40\x04\x12\x06\xc7\x87\x4f\xa5\xf9\x10\xb4\xd1\x31\x26\xa3\x1d\x4f\xbf\xda\x89\xd4\x2c\x92\xc4\xc6\x49\x3b\x1d\x24\x38\xa4\x34\x10\x9f\x94\x06\x9e\x88\xee\x9d\x98\xe2\xa1\xe6\x9e\x53\x09\x7e\x02\x7f\x3f\xa6\xf3\xb1\x90\xd1\xfc\xaf\x57\x5e\x79\x05\xfe\x4e\x6e\xac\x3d\x6d\x69\x44\x2a\x1b\xcb\x31\xc2\x3c\x5d\xc1\x51\x61\x69\xab\x0f\x3c\xf0\x00\x4b\x4f\x3e\x49\xfa\x6e\xe6\xbf\xec\x9d\x48\x8e\x54\x74\x6d\xcc\x65\xe9\x82\x60\x29\x58\x08\xc9\x8b\x89\x7f\x7e\xee\xb9\xc0\x73\xcf\x19\xaf\xbb\xee\x5d\x50\x83\xe1\xfa\xa7\xeb\x9f\x59\xf7\xda\xaf\x7f\x8d\xe4\x73\xe8\x7f\x05\x8d\xd2\x73\x34\x7d\x3d\xd9\x54\x98\x31\x02\xf9\x49\x91\x10\x3d\x01\x93\xa3\x6e\x6b\x9a\x42\xe4\x09\x11\xb5\xcc\x62\xd1\x6a\xc9\x75\xa8\xf9\x19\xeb\xb1\x5a\x13\x69\x57\x97\xde\x9d\x19\x3c\x31\x12\x3f\x46\x13\xb7\x5f\x9d\x9d\xee\xce\x42\x87\x23\x50\x39\x11\x3c\xbd\xff\x0d\x78\x62\x15\xae\xc3\x25\x59\xde\x2c\x3c\x87\x33\x6e\x7d\x97\x2b\x13\xb7\x5a\x7b\x7c\x6f\x01\x9e\x77\x56\x02\x8e\x8e\x02\x01\x0a\xc9\x50\x9c\xc0\xf3\x15\x06\x0f\x97\x92\x74\x35\x75\x57\xdc\xdf\x61\x25\xb0\xc8\x8e\x87\xa5\x5d\xb2\xc8\xb7\x7d\x57\x94\x2b\x31\xbe\x1e\x25\xc7\xff\xda\x1a\xdf\xd3\xac\xf3\xdd\x6d\x1d\x10\xcf\xcc\xd3\x01\x3f\x39\xae\x8d\x12\xce\x81\xed\x95\xfc\x12\xcf\xa0\x20\x19\xcf\x6e\x25\x34\xd7\xe4\x64\x2b\x99\xbc\x2e\x4b\xc7\x9f\xcb\xd4\x45\x61\x81\x3c\xf5\x61\x1b\xfc\x72\x30\x3d\x96\xd0\x4e\x4f\x0a\xf5\x9b\x84\xe9\x69\x4d\xd7\x44\x7a\xb0\x33\x15\x09\x25\x3b\xe2\x42\x29\x1f\x49\xf9\xbe\x34\x1c\x5a\xb4\x50\x66\x36\x0b\x0b\x97\x44\x87\x8b\xa9\x91\xb4\xaf\x23\xd1\x1b\x2d\x4d\x24\x7c\xe9\xd1\x14\x9d\x43\x72\x72\xfb\x3f\xbc\x10\x25\x48\x9d\x01\x1d\x64\x25\xd7\x71\xbc\xe2\x50\x7b\xc5\x85\x42\x1b\x96\xc4\x02\xdc\xd4\x17\x0c\xf6\x05\xbb\x43\x82\xdb\x2d\x3c\x4b\x6f\xa1\x4c\x69\x2a\xd8\x17\x92\x1b\xc9\x8d\xbc\x31\x8a\x70\x0d\xf9\x08\xf5\x05\x7d\x8b\x17\x08\xa4\x11\x0b\xa6\xbd\x53\x25\xf2\xc4\x4b\xf3\xd1\xff\x5e\xbe\x2e\x64\x6b\xe4\xa5\xc8\x4b\xda\xa0\x46\xb4\x0d\xdc\xf2\x47\x8f\x6d\x21\x32\xa4\x62\x19\xdc\x1c\x3e\x05\xf2\x01\xf7\x8d\xf8\xd2\xe1\x70\x92\x38\x38\x4b\x86\xc3\x69\xdf\x48\x96\xd8\x17\x2c\xe8\xc6\x4b\x27\x4b\xa5\x55\x83\xc9\x44\xdf\x92\x6c\x76\x49\x5f\x22\x39\xb8\xaa\x54\x9a\x24\x3b\x76\x83\x9c\x56\x18\x10\x86\x9f\x13\xdc\x8a\x2d\xd9\x87\xcc\x68\x9b\x10\x12\x4a\x7f\xf0\xbd\xf0\x82\xef\x15\x3c\x53\x7f\xcd\x57\x7f\x09\x02\x3e\x92\x56\xd5\x20\xde\xed\xf1\xd9\x5c\x36\x60\xb4\x5b\x81\x45\x6a\x74\x65\xae\xc0\x0f\x12\x0f\xec\xb8\x7d\x60\xfd\xc2\x7d\x01\x3c\xf3\x89\xfa\xe7\x4f\x7f\xb0\xb8\x6f\x17\xa0\x47\x59\x1d\xf4\xf6\x27\xea\x0f\x83\xc2\x21\xe5\xa3\x19\xcb\x15\xf8\x53\xe5\xd9\x33\x4f\xc9\xed\x09\x4c\x5d\x95\xc4\x2b\x9e\xad\xbf\xb0\x3f\xfb\x67\xc7\xc3\x1b\xfe\x75\x4b\xa3\x21\xe9\x6c\x31\x3e\xad\x59\x8e\x16\xfe\x82\xcf\x62\xba\x47\x3d\x1d\x07\x48\x64\xe6\x04\x94\x06\x30\xab\x29\x07\x51\x31\xc1\xbb\x66\xca\x4b\xc6\x17\x95\x96\x6e\x1d\x5f\x7f\x5e\xd2\xbd\xca\xe9\x5d\xb4\xb5\x1b\x1e\xdf\x75\xd3\xbe\xb1\x03\x97\x6d\xbb\xb9\x7a\xc5\xd5\x87\xae\x74\x9d\xb7\x7e\xc9\xa6\x2a\x2b\x53\xd6\x38\x8a\x8d\xf8\x46\x5e\xa6\xec\x44\x65\xbe\x77\xa6\xb2\x78\xf5\x74\xcf\xf0\xd2\x4d\x7b\xd6\x24\x7d\xa7\xd9\x7d\x4b\x4f\x59\x08\x8f\xec\xb9\xea\x8c\xe9\xcd\x17\xac\xb9\x60\xe5\x4d\x7b\xde\xf1\xde\xf8\x19\xdb\xd6\x2c\x5d\xca\x68\x6b\x27\x59\x7b\x7c\x9b\xc0\x1b\x47\x05\xf4\x7f\x5c\x17\x68\x77\x82\x28\x74\x81\x52\xd4\x03\x28\x23\x20\x87\x28\x28\xe4\x74\x4f\x78\xee\x0b\xcc\x5f\x34\xb7\x83\x93\x6a\x50\x62\xa0\x7b\x2c\xbb\xa5\xc5\x9b\x64\x51\xc8\xe2\xb5\x28\x36\xab\x78\x94\x19\x0f\x77\x4b\x90\x43\x24\x87\x12\xf0\x21\x92\x13\x61\xb9\x12\x9f\x3c\x63\xad\xbb\x95\x87\x72\x03\xa5\x20\xee\x9e\x27\x6f\x7b\x0e\xb2\x50\x74\x24\x12\x08\x25\x0a\x09\xb2\x6c\x21\x60\xc6\xa2\xa1\x70\xd0\x1a\x25\x9b\xd9\xed\xa1\x7d\x99\x03\x5b\x29\x02\x62\x51\x22\x81\x8c\xc8\xb5\x3b\xb6\x7d\xf5\xdc\x45\x13\x07\xfa\x0b\x33\xe5\xfe\x58\x6f\x75\x7a\xd7\x66\xab\x7a\xe1\x40\x6e\x45\xb6\x7f\x6b\xa5\x37\xe7\x19\x9e\x1a\x4f\x38\xd5\xc3\xe3\x89\x98\x66\xc1\x4d\x7b\x4f\xbd\x61\xb2\xb0\x6b\xc1\xaf\xa2\x99\xa9\xea\xf0\x72\xe8\x5d\xdc\x73\xc6\xaa\xf5\x17\x0e\x06\x72\x89\x5f\x2d\xd9\x84\x73\x29\xea\xdd\x5a\x40\x61\x12\x17\xf2\x15\x32\x4e\x82\x28\x8d\xca\xd4\xee\x22\xdb\x9d\x26\xfb\xa1\x1e\xa7\x43\xad\x16\x70\x00\xa8\x75\x4b\x10\xd0\xe4\x31\xc7\x91\xb2\x36\x7f\xae\x64\xaf\xb3\x9c\x29\x25\x62\x5d\x41\xb9\x68\x6f\x0b\xb7\x57\x99\x15\x42\x94\x8e\x5d\x6a\xcb\x2c\x3a\x44\x2c\x11\x42\x87\xb9\xd2\xf4\x4a\x01\xa7\x76\x9e\x92\x4d\x2d\x88\x8f\xf5\x4e\x46\x86\x22\xb1\xc1\xe0\x40\xf7\x69\x4e\x0b\x96\x0d\x47\x62\x13\x7d\xef\x4d\x24\x3c\xde\xf8\x40\x72\xe0\xa2\x58\xa6\x10\x9e\x8e\x67\x12\xe3\x89\xc1\x7d\x81\x35\xe3\x91\xfe\x20\xf9\x9f\xdb\x66\xbb\xdb\xdb\xe5\x55\xc8\xd2\xc3\x85\xf5\x29\xb8\x69\x2c\x18\x48\x27\xfc\xd9\xe9\xfa\x67\xc7\x1f\x71\x2e\x8b\x27\xaa\xfc\xcc\xd2\xb3\x44\x17\x77\x07\xd2\xa3\x10\x8d\xd2\x28\x50\xfd\xec\x21\x19\x50\x7b\x42\x41\x60\xce\x3e\xec\x02\xb5\x10\x8a\x70\xb7\xff\x9c\x54\x14\xb8\x0e\xeb\xcf\x04\xb9\xef\x78\xe7\x85\xbd\xd3\x7d\x30\x82\xf3\xcb\xbb\x17\xac\x3f\x1a\xc2\x1f\x3c\xba\x81\x8e\xdd\xe1\xc6\x57\xe1\xfd\xf8\xbd\xa8\x82\x26\xd0\x9a\x9a\x76\x20\xd7\xe9\xb3\x09\x58\x44\x18\x9a\x7e\x2f\x2c\x08\x11\x6c\x21\x61\x7b\x0b\x7b\x76\xcc\xce\xb3\x00\xc9\x0c\xb8\xfd\x58\x25\x4b\xb6\x94\x3b\x88\xa9\xa0\x72\x84\xea\x3e\xe5\xec\xe8\x9f\x14\xa7\x5e\xb2\xb7\x96\x90\x2b\x19\x91\xd1\x91\xe2\x70\x30\x5c\x37\xfd\x4f\xd2\xc4\x0c\xbd\xf4\xb9\xe3\x13\xeb\x7d\x45\x51\x50\x9b\xfc\xd9\xee\x9e\xdc\xd8\xe2\x5a\x3a\x32\xda\x35\x95\xce\xad\x74\x56\x65\x58\x56\x0e\x47\x8a\x9e\x62\x8f\x73\x43\x22\x50\x4b\x2d\xc9\x9e\xda\xdd\xd3\x65\x75\xe5\x2a\x56\x8f\xac\x9c\x4a\x0c\x60\x83\xd9\xe8\x4d\x07\x43\x49\xe2\x2f\x21\x1d\xa8\xe8\xe2\x0b\xa3\xc3\xb1\xee\x64\x5e\x26\x7a\x92\x69\xbf\x27\xe8\xb6\xa9\x52\x13\xbe\x92\xae\x6b\xac\x6b\x22\x06\x99\x94\x3f\x61\x31\x88\x41\x7b\xa0\xcb\xa8\x50\x62\x66\xbf\x62\x21\xf4\xcc\xcc\x64\x80\xc1\x96\x07\x88\x3e\x62\x1e\x2a\x53\xc8\x61\xd1\xb1\xc3\xe5\x48\xae\x20\x67\xcb\x91\x42\x0d\xa2\xa0\x10\x77\xab\xb8\x0d\xab\x8a\xd9\xb0\x2a\xd9\x21\x73\xbf\x9f\x46\xc7\xf6\x0f\xfa\x07\x7a\xca\xf9\x6c\x3c\x6a\x24\xd1\x28\x9c\x76\x16\x27\x5b\xd3\x3c\x47\x36\x8b\x05\x8b\xc7\x89\x13\x19\x2c\x21\x2e\x83\x63\x1c\x71\x92\xae\xfd\x53\x07\x87\x87\x4f\x1b\x1e\x3e\x38\xb2\x60\x72\x47\x65\x7d\xb9\xb4\xbe\x5c\x5e\x5f\x22\xf7\xf0\x68\x2c\x3e\x1c\x2c\x4d\xa8\x8b\x32\x50\x59\x8c\xce\x40\x6f\x25\xe6\x8c\x6b\x52\xb6\x91\xb0\x65\xfc\xac\xf1\xf1\x77\xd0\xff\x7b\xa7\x16\x75\xf5\x6e\xab\x56\xb7\xf5\x56\xb7\xf5\xf5\x6e\x25\x87\xe1\x26\x13\x89\xb1\x58\xbe\xcb\xa2\x30\x1b\x74\xea\xee\x4c\x6a\xc8\x69\xf3\xfa\xc3\xdc\x9e\xa1\x1d\x27\x69\x54\x44\xfb\x19\x56\xb6\x75\x81\x4c\x4c\x81\x5c\x96\x06\x41\x2e\x2c\x42\x72\x99\x20\x93\xb3\xe3\x9b\x58\x81\x30\xd3\x4e\x89\x0a\xe5\x6e\x0d\x35\xdf\x93\x8b\xb2\x9d\x6a\x8e\x22\x35\x43\x91\x8a\xa1\xa8\x3b\x43\x51\x54\xc8\x65\x8a\xdd\xc5\x68\x38\x14\x0c\xf8\xdb\x90\xa4\x7d\x73\x48\xaa\x10\xb9\xbe\x69\x36\x40\xf7\x59\x89\x1d\x4a\x28\x64\x3b\x11\x72\xd4\x23\x99\x4d\x83\xbd\x3e\x42\x16\xa7\x27\x17\xe5\x4e\x8c\x8f\xcf\x9d\x0f\xe1\x4c\xcc\xdd\xa5\xbc\x50\xfc\x50\x2a\xb5\x0e\x61\x64\x86\x1a\xfc\x0f\x3e\x9f\x6a\x9f\xe8\xde\x93\x52\x60\x32\x35\x35\xb4\xa0\xd5\x9b\x0a\x0e\xe6\x63\x9f\x70\x3e\xdb\x9e\x35\x3f\x3b\xe5\xec\xd0\x82\xa9\x40\xb2\xdf\x58\x21\xb9\x2c\xf1\x38\x31\xa1\xe8\xbb\xf1\xc6\xfa\x17\x5e\x78\x1b\xec\x7b\x81\xcb\xe8\xe7\xc2\xe9\x70\x05\xbe\x88\xc9\x66\x3d\x0c\x9f\xe9\x37\xf0\x0d\x41\xd1\xc4\xf7\x1b\xa5\x38\xeb\x14\x35\xed\x6a\xba\xb9\x8a\xb8\x9f\x0c\x86\x23\x83\x61\x62\x21\x4f\xfe\xfb\xbb\x48\x14\xe4\x54\x57\x27\x9c\x4e\x4e\x37\x47\xd8\x0b\xf2\xa5\xd2\x1d\xf0\xe7\x72\x7e\x7f\x96\xd2\x9c\x1f\x35\x4e\x85\x0e\x7c\x2d\x12\x50\x96\xab\xcd\xb4\xec\x94\x38\x06\x00\x17\x73\x77\xc6\x8d\xd9\xe9\x43\xc4\xdd\x9d\x49\x27\x94\x1c\x04\xfe\x1f\x8d\xde\x26\x84\xfe\xfd\x33\x06\x5b\x5b\x59\x22\x9a\xe2\xa5\x39\xf8\x9e\x25\x2b\xb3\xb5\x41\xc9\xc3\xa4\x1e\xbf\xab\x69\x87\xe9\x13\x6c\x5c\x92\x8a\x04\x56\xd9\xe8\x28\xc4\x60\x67\xfd\xce\xfa\x08\xdd\xf2\xa3\xed\xcf\x91\x3a\x3f\x47\xea\xd4\xa0\x69\x5e\xa3\x93\xd9\x33\xca\x98\x07\x7c\x81\x79\xc0\xe7\x07\x05\xa9\x71\x23\xf9\x86\xe4\x97\xce\x4d\xc0\x1c\x5e\xcd\xd9\xaf\x76\x98\x24\x55\x95\xe9\x47\x0f\x3c\x30\x7a\xdf\x7d\xb7\xe1\x70\xff\xe7\x3f\xdf\x7f\xf4\xa7\x1c\x56\x07\x89\x69\xfc\x08\x7e\x10\x75\xa0\x10\x19\x17\x01\xaf\x59\x8e\xb3\xd4\x8e\xbf\xc0\xcf\xee\x96\x14\x62\xa9\x5c\x91\x0e\x9d\x30\xe5\x69\x28\x68\xb3\x95\x4b\x30\x1c\x11\xc6\x64\x0b\x57\x4f\x4d\xf5\x85\x7a\xa2\x53\x0b\x26\x16\x7c\x33\x9e\xc8\x0d\x0f\x5f\x52\xec\xad\xac\x5e\xb7\x71\xc5\xa8\x4d\xd9\x6f\x30\xbc\xb4\x6c\xe5\xb2\xc5\x4f\xae\x98\x19\xec\xc1\xfd\x9b\xbe\x55\xbb\x16\xb5\xc3\x6a\x41\x3b\x38\xac\x41\x11\x40\x09\x48\x05\x88\x9a\x75\xd9\x09\xa4\x2d\x14\x3b\xa6\xa8\xcd\x83\x4b\x45\x0f\x25\x90\x6f\x48\x75\xe9\x89\x93\x62\x8a\x76\x84\x48\xb1\x96\x59\x86\x11\xcc\xb0\xb9\x0d\x0f\xe4\xb2\x11\x5c\x30\x6c\x10\x7c\x7c\xaf\x1f\xac\x14\x23\xf5\xdf\xf6\x7f\x8f\xd3\x8b\x2c\xb3\xc7\x38\x8f\x20\xd2\xc9\xbc\x9c\xfe\xad\xa6\xb6\x00\x28\x9c\x20\xa8\x5a\xf1\x40\xe3\x48\xa9\x05\x95\xa0\x54\xed\x24\xf4\x41\x44\x1b\xe5\x18\x23\x64\x25\x8d\x05\x60\xa1\x23\x68\xf8\xec\x76\xad\x7c\x4a\x4a\x2f\x07\xaa\xee\xa6\xb9\xb8\xf4\x32\x7f\x1e\xc7\x5b\xaa\xe3\xad\x15\x4f\x95\xe9\xc1\x48\xc4\xe5\x42\x28\x12\x8f\xc4\x63\x51\x57\xd8\x15\xea\xf4\x52\x7e\x66\x36\xe9\xb4\x4c\xbd\xab\xa3\xc7\xbb\xdb\x03\x4f\x50\xab\x4e\x53\x48\xa0\xe6\x72\x16\x73\xdb\x1a\x4b\x24\x48\x85\x99\x6c\x21\x14\xee\xce\x87\x1f\x1f\x15\xb3\x3b\x07\x87\xd6\x7d\xd0\x4d\xcc\x02\x5c\x2e\xf3\xea\x07\xe1\x91\x9e\x54\xa6\xaf\xdc\x9d\xed\xf9\x9c\x60\x54\x1e\xfd\x30\x7c\xb4\xbe\x04\x1f\xac\x1f\x84\x33\x82\x1d\x1d\x7e\x7f\x47\x47\xf0\xf7\xc9\x24\x5b\xf3\x93\x7d\xa6\x97\xe0\x67\xc8\xc1\x74\x10\x3e\x37\xd3\x41\xb4\x0b\x6c\x01\xdb\xb1\x7d\x5a\x0b\xb3\x23\xb2\x05\x1e\xdf\x5d\x1a\xd9\x53\x1d\xde\xdd\x57\xbf\xc7\x46\x04\x94\x25\x7e\x5f\x78\x66\xea\x62\xeb\x68\x72\x51\x11\x35\xc4\x91\x83\xc3\xcb\xf6\x97\xfa\x0e\x2c\xbc\xc3\xde\x99\x2b\x98\xaa\x83\x70\x9d\xb5\x33\x7f\x0e\x5f\x5b\x6c\x84\xb5\x70\x33\x3e\x88\x14\xc8\xcd\xa8\x97\x41\x21\x97\x81\x8c\x9f\xb8\x32\x43\xcb\xc3\x51\xcc\x42\x95\x6d\xd7\xe7\xef\xb0\x5f\x51\xda\x0a\x6b\x9d\x80\x1a\x2e\xbe\x6e\xc8\x36\x3e\x05\xcf\xa1\x09\xb6\x36\x01\xb6\x2f\x46\x10\x11\x20\x57\xb6\x1b\x4a\xd9\x91\x2b\xd7\xaf\xbf\x72\x96\x6e\xc5\xc5\xe6\x57\xc8\xc7\xe0\x92\xd6\x6b\x04\xbe\x82\x55\xf2\x5c\x1e\x25\x04\xd8\xc6\xbc\x09\x85\x1c\x22\xe4\xf9\xfa\x73\x73\x4f\x9c\x2c\x95\xf9\x4a\xfb\xc3\x61\xf5\xd6\xfc\x7d\x2e\x2c\x97\x96\xa7\x64\x09\xcd\x97\xd3\x89\x6a\x76\x8b\xaa\xf3\x01\x27\xad\xb3\x83\xea\x43\xe0\x9b\x48\xc6\xf4\x21\x2a\x85\x0c\x48\x9d\x60\x0a\x98\x2a\x22\xb9\xc5\xe0\x87\xf5\xb1\x17\x9f\x80\xb7\x3d\x01\x0b\xea\x4f\xc1\x82\x2b\xee\xad\xff\x03\x94\xfb\x49\xea\x60\xe3\x87\x92\x5e\xce\x81\x3c\x74\xbd\xed\x72\xda\xac\x54\x71\x28\x6b\x29\x0e\x49\xfb\x24\xc5\xa1\xad\x20\x10\x80\x63\xb6\x42\x69\xae\x02\xf1\xc9\x97\x9e\xe4\x4a\xc4\xbb\xef\x66\x2a\xc4\x7b\xea\x2f\xdf\x79\xe7\x19\x53\x53\xdc\x66\x65\x00\x2d\x81\x27\xb1\x9a\xd1\x57\x0b\xd1\xbb\x1b\xd5\xf4\x9c\x8d\x40\xf5\xcd\xa6\x40\x85\x5c\x8e\xa6\xed\x8b\x18\x13\x63\x03\x70\x5e\xfd\xf2\x38\x24\xeb\x3f\xb8\x60\x85\x6b\x45\xeb\x3f\x56\xd7\xc7\xe2\x04\x84\xb6\x47\xbc\x6c\x4c\xca\xfe\x06\x56\x33\xd8\x6d\xa4\x6c\xb3\x56\x94\x4b\x65\xc7\x1c\xe4\x26\x16\xda\x4a\xbf\x9c\xe2\xe0\xd2\xbb\x40\x59\xff\xc7\xfe\x2f\xcd\x29\x9f\xe0\x68\x6e\x05\x02\xb2\x93\xf2\x5f\x9d\xaf\x7c\x70\x88\x01\x13\x54\x62\x01\xaa\xc6\x96\xec\x77\x60\xd3\xa5\x97\x12\x24\x67\xf7\xef\xaf\xff\xe3\x23\xb3\xca\x7f\xf1\x45\x78\xb6\xfe\xb6\x27\x9e\xa8\xdf\x32\xa7\x0e\xcc\x06\xe8\x5f\xe0\x2b\x48\x44\x9a\x63\xbc\x3a\xc0\xf6\x5d\x0b\x36\x8a\x72\x58\xfb\x83\x77\xde\xf5\x83\x4b\x1e\x7b\x0c\xee\xe9\xaa\x6f\xeb\x22\xf7\x2e\x84\x14\xa4\x3d\x4a\xf8\x11\x7c\x0d\x75\xa3\x5e\x34\x8e\x16\xa2\x55\x68\x33\x3d\x99\x38\xb3\x7a\xf1\xa2\x05\x13\xb5\xbe\x42\x56\x87\x14\x2d\xc3\xac\x42\x49\x32\x1b\xb0\xd9\x0a\xc7\x26\x7a\xc8\xc6\xbf\x30\xe2\x1f\xa2\x5f\x2c\xc7\x78\x72\x40\xd2\xab\xd8\x44\x52\x80\xa2\x4c\x92\xb4\x6f\xe5\x84\xe8\xd7\xe9\x4a\x85\xb8\x6a\x88\x92\x7b\xb4\xbc\x82\x08\x3f\xb3\x7f\x16\x7d\x76\x7b\x67\xa7\x1d\xd4\xf4\x6e\xf7\x9d\xb7\x62\xc5\x84\xaf\xc8\x9e\xf8\xc9\x8d\xbc\x55\xd2\x84\xcb\xdb\xf3\xcd\xfa\x09\xf9\x22\xcf\xc9\xcb\x29\x9e\xbb\xfc\x55\x96\x8d\x3f\x24\xef\x58\x0c\x9b\x00\x7c\x9d\xc5\xec\xa2\xb2\x8e\x45\x27\x43\x4c\xbf\x6c\x0a\xb4\x34\xa8\x8e\x12\x1d\xc7\xa2\xa3\x04\xb5\x6b\xeb\x7f\x32\x5f\x73\x8d\xf5\xe2\x1d\xfd\x5f\x5b\x32\x0d\x5f\xa9\x7f\xf4\x94\x3b\xdd\xd7\xad\xdb\x53\xff\x54\x69\xf9\x9a\x3c\x02\xf4\x25\x8c\x21\x8c\x9f\xe0\x67\xb5\xe7\x9a\x6e\x34\xcf\x6a\x87\x2a\x85\x3f\xa7\xec\x93\x18\x7f\x9c\xfb\xa5\xb8\x86\xe4\xd9\x4b\xf2\x04\xf1\x97\x26\x10\xc2\xf8\x4b\xcf\xf1\xe7\x9b\xe1\x2c\xb8\x15\x5f\x07\x0a\xfc\x2c\xf6\xf1\x5d\x1e\x5f\x2b\x06\xdb\x23\x8d\x8f\x43\x1e\xed\x69\xb3\x85\x23\xa5\xfe\x28\x35\x38\x39\x4d\x0b\xc5\xf5\x0b\x70\xb1\x41\xac\x01\xd8\x9e\x61\x9e\x51\xac\x18\x06\xe6\x8b\x02\xd3\x46\x6d\x96\x0b\x32\x81\xba\xa3\x38\xde\xcf\x10\xa3\x64\x27\xda\x8e\xab\xbf\xc7\x47\xb6\x37\x7d\x3e\xeb\xdd\x5e\x2f\xfb\xc4\x45\x9f\xd5\xda\x49\x9f\x79\x6d\x36\xf6\x8e\x0d\xc7\xfa\x0b\x38\xd1\x18\xc4\x37\x20\x0d\xf7\x31\xf1\xc6\x3e\x93\xe6\xd6\x58\xff\x6b\x33\x2e\x11\x4e\xb4\x62\x0f\xd1\x32\xef\x83\xd7\x1b\x39\xfc\xd0\x31\x98\x05\x32\x67\xfe\xba\xb3\xb2\x0a\x5e\xbf\x22\x7e\x2b\xaf\xf7\x7f\x49\x9a\x83\x73\xd3\xfc\x2d\xba\xaa\x02\xaf\xbb\x6f\x8d\x23\x86\x9b\xab\xb1\xba\xf1\x2c\x7e\xa6\xa9\x2f\x56\xc8\xb8\x2d\x05\x49\xe7\x20\x57\xfd\xd9\xfc\xba\xa1\x32\xb9\xb0\xda\x77\x73\xf2\x0a\x72\xb1\x72\x7f\x04\x2f\x35\xfa\xf0\xad\x48\xd7\xda\x7f\xc2\x54\xbe\x21\xd3\xb6\x5c\xa2\xed\xfe\xb1\x4d\x5e\x48\xea\x15\x0e\x33\xbe\xd5\x6a\x54\xe9\x2e\x30\xa6\xac\x3e\xbf\xc5\xc6\xdb\x74\x10\x1a\x8d\xbb\xf1\x23\x6f\xcc\x3b\x80\x6f\xd4\xd4\x6f\x8b\x5f\xe3\xbc\x26\x4e\xb2\x04\xaf\xbd\x36\x78\x80\xe5\x3f\x13\xe3\xc6\xfb\xf1\x91\xb6\xfc\x02\x26\xf9\x17\xd0\xfc\x56\x29\xbf\x83\x6d\x87\xd5\xef\x3a\x78\x90\x96\x80\x8f\x1c\x38\x40\x4a\x60\xf9\x2f\xc6\xee\xc6\x5d\xf8\x5d\x48\xd7\xea\x0b\x9d\xa8\x10\x60\x01\x1b\x9d\x6b\x9b\x1e\x92\x00\x2d\x8d\x04\xe9\xe8\xa4\x65\x09\xcd\x38\x19\x9c\x83\xee\x70\x2c\xcc\x64\x16\x3a\x8a\xc6\x94\xcf\x97\x32\xe2\x77\xa4\xb2\x59\x62\xeb\x14\x08\x84\x28\x2e\x1f\xc3\xf2\xc6\x1e\xfc\x38\x1d\x67\x27\x97\xeb\xdf\xcc\x68\x6b\x97\xe9\xeb\xaf\x55\x2a\xe1\x68\xb9\x12\x31\xbb\xc8\x19\x1c\xa7\xd3\x84\xe5\xc5\x70\xb8\x58\x88\x84\x8b\x5d\x1e\x8b\xc5\x43\x2f\x02\xdf\xdb\xf1\x60\x83\xc6\x6c\x73\xa1\x12\x6b\x41\xd2\x05\x02\xd6\x69\x31\x0d\x8e\x87\x04\x40\xc2\xda\xb6\xc8\xc6\x14\x58\x01\x2d\x25\xf6\x5d\x41\x99\xe8\x6c\x03\x77\x3e\x23\xaf\x71\x3b\x19\x80\x01\xbb\xc5\xa1\x37\xbb\xb5\x39\x43\x21\x62\xf3\xd9\x4c\x6e\x83\xd1\xa6\xc7\x57\x25\x03\x06\xc3\x2c\x0b\x2f\x86\xeb\x2b\x70\xa9\x71\x19\xde\xff\xe6\x7d\xab\x00\xa1\xcf\xf5\x2b\xea\xb8\xd4\xd3\x43\xf3\x7f\x08\x6b\x1a\xd3\xf8\x33\x04\x96\x14\xcb\x1f\xb0\x30\x1f\x25\x66\x51\x2e\xa0\xc9\xb9\x3d\x16\xee\x6e\xf5\x18\x6e\x7a\x01\x2a\x15\x25\x27\x92\x1c\x10\xdb\xc7\xf5\xa4\x75\x05\xaf\x56\x63\xd6\x05\xd5\x11\x73\xc2\x13\xec\xc6\xdb\x6d\x3a\xe2\x8f\x4a\xec\xb0\xba\x34\x7a\x51\x67\xf0\x47\xed\xd6\x78\x90\xd1\x9c\xfa\x3e\x32\x7f\xde\x4d\x63\xd0\xe1\xe7\xbf\x40\x69\xd1\xf3\x3b\x51\x73\x0c\x19\xc9\x18\xba\x14\xe9\x50\x8c\xb5\xab\x43\xa7\x25\xa3\xf8\xf8\x26\x45\x02\x52\x93\xda\xd1\x29\x3e\xc0\x06\x4f\xb1\x39\x94\x70\x81\x0c\x1f\x27\x1b\x48\xa4\xec\x8f\xe2\x68\x63\x48\x28\x21\x13\x72\xb0\xb2\xb5\x26\xe0\x83\xbb\xab\x35\xb8\x85\xf6\x73\xf6\xec\xa4\xc5\x67\xdb\x7c\xe2\x08\x8e\xb9\x9e\x70\xa0\x71\x3b\xdc\xde\xf8\x1b\xf6\x22\x25\x9a\xa0\x65\x72\x29\xdc\xce\x5a\x7b\x80\xc2\xc5\x48\x11\xde\x82\x30\xc2\xcb\x3c\x8c\x52\xc3\xe1\xb9\xcf\x89\x27\x59\xb3\x51\x68\x5a\x01\x0a\x84\x97\x15\xa8\x10\xb6\xc7\xa4\xab\xe9\xe3\x71\xf8\x4a\x30\x5f\xac\xb8\x29\x6e\x9e\x6b\x6c\x69\x74\x35\xbe\xf4\xe6\x69\x9d\xac\x9d\xd6\xbd\x4a\x58\x13\xa5\x9c\xef\xf5\xd9\x28\xc9\xb3\xf9\x58\x3f\x5c\xd0\x38\xa5\x71\x73\xe3\xd3\x34\x3a\x2a\x42\x47\xa3\xdc\x27\xe3\xd1\x68\xcb\xb6\xb1\xfe\x6c\xfd\x77\x8d\xbe\xc6\x2b\x04\x67\x4e\x5a\xe7\xdc\xf3\xff\xb4\x16\xf9\x71\x3e\x00\xea\xcf\xba\x82\x2a\xad\x4e\xeb\x73\xb8\xc2\x5b\xfe\xe1\x28\x9b\x94\x1a\x8d\xd6\xa8\xb5\x77\x76\xf9\x27\xb6\xf3\x72\x1f\x27\xa5\x52\xaa\x61\x41\x83\xac\xdc\x82\x4e\x8a\x25\x2e\x20\x61\x91\x34\x91\xe6\xaa\xc6\x58\x16\xb3\x29\x14\x6a\x59\x4d\xca\xda\xc3\x2f\x8b\x76\x0e\xeb\xff\x64\x5c\x91\x3c\x8b\xaf\x9c\x52\xd0\x80\xed\x5f\x68\xc6\x50\xee\x66\xb1\xd7\x69\xfd\x1f\x68\x78\x1b\x15\x02\x95\xae\xb5\x37\x29\xd2\xf6\x4b\x6e\x5a\x7e\x57\x18\x18\xea\xf5\x44\x53\x9d\x9d\xff\x23\x66\x22\xf1\x65\x41\x13\x89\x78\x6a\xf7\xc9\xf8\x18\x6d\x2c\x6f\xdc\xd5\xf8\xc2\x9b\xa7\x73\xe2\x1b\xd0\xb9\x95\x12\x99\xe3\xf3\xba\xb1\xb3\x71\x59\xe3\xee\x37\x3f\xaf\xe5\x7c\x5e\xdf\xd9\xd3\xc3\xf2\x5f\x06\xbe\xc6\xcd\xb0\xf6\xad\xd0\x85\x80\x8d\xe4\xd2\x83\xaf\x5a\x25\xf9\x57\x82\xab\xf1\x0c\x6c\x78\x8b\xf9\x57\x36\x1d\xa9\xe0\xfa\xf9\xf8\x48\xe3\x26\xc1\xc6\x7d\x0e\xbe\x79\x59\xe0\x84\x72\xc0\x1d\x84\xf7\xd3\x51\x7b\x8f\xd7\xcb\x3e\xf1\x91\x79\xe5\x80\x86\x1c\x3f\xd6\x78\x9b\xe0\x38\xb9\xdf\x44\xdc\x56\xfa\x4f\x3b\xf9\x5c\xc0\x8f\x91\x4f\x1f\xbd\x18\x0e\xbf\x84\x6f\x69\x5c\x29\x38\x66\xf3\xf6\xeb\x1f\x8a\xdf\x81\x6f\x19\x98\x98\xe1\xe3\xf7\x69\x92\xe6\xdc\xf6\x34\x40\x79\xfa\xb7\x1c\xb7\xc7\xf1\x2d\xc9\x99\x89\xa6\x6c\x74\x59\xe3\x25\x21\x79\x02\xfe\x7f\x97\xff\x70\xb7\xdf\x7f\x38\x83\x2f\x4b\x5c\x50\xdb\x49\x2e\x56\xee\x1e\x7c\x7d\xe3\x83\xa4\x5c\x05\x32\x1f\xcf\xbf\x5b\xbc\xfb\xaa\xc0\xf5\xf6\xdb\x7c\x97\xe0\xeb\xa3\xdb\xb6\x87\x27\x58\xbe\x0b\x48\xbe\x1b\x68\x3e\xea\x5b\x8c\x39\xc5\x92\x1f\x73\x25\xa2\x40\x0a\xea\x4a\x04\xf8\x02\xa3\xfe\xf7\xf3\x03\xbb\x4f\xeb\x38\x8c\xaf\xdf\x16\x9d\x1c\x0b\xec\x60\xf9\xcf\xc1\xf7\x36\x6e\x11\x2c\x48\x87\x7c\x6f\x3c\x9e\x67\xf3\xec\xb7\xcd\xe6\xd9\xaf\xcf\xe2\xd9\x0f\xe3\x7d\x8d\x6d\x82\x67\xae\x8f\x54\x19\x00\x70\xff\x93\xf6\x79\x7d\xa4\x9e\x90\x4b\xbf\x42\x38\x74\x84\x6c\x81\x98\x09\x87\x36\x3b\x5d\x26\xbc\x8f\x72\xe9\x62\x24\x5c\xe8\x72\x5b\xac\x6e\xb7\xd5\xe2\x66\xb0\xbc\x8d\x8c\xc1\x27\x04\x2f\x72\xa1\xf4\x5b\xe2\xd1\x27\xe6\xcf\x8b\x4e\xcc\x9f\x5f\x9f\x97\x3f\x37\xde\x43\x64\x85\xa3\xf8\x79\xa4\x42\x45\xce\x13\x4c\x9c\x27\x48\x41\x8a\x30\xf5\x66\xc2\xd8\x41\xdb\x23\xc2\x09\x2c\x8c\x13\x10\x2e\xd0\x64\x03\xbb\x2c\x26\x79\xaf\x36\x1a\xc3\x07\x0d\x91\x64\xb5\x8f\xca\x21\x87\x70\x77\xe3\x5e\x7c\x37\xc1\xea\x54\x4d\xef\x00\xc0\x5a\xb6\x3d\xa3\x01\x34\xc9\x42\xf9\x48\x01\x0f\xf8\xc1\x63\xb6\x7f\x65\xe5\x3e\x14\x04\x0a\xf0\x76\xe9\x35\xf5\xa1\x10\x0e\x87\x03\x0c\xfa\x76\xe0\xdb\x60\xdf\x1a\xce\xda\x02\x26\xa3\x59\x6d\xb0\x88\x39\xc7\xa4\xad\xd3\xac\x35\xa9\x74\x66\x35\x3e\x7b\xd2\x42\x9c\x78\x78\x3b\xf5\x5b\xcc\x46\x8d\xc6\xe2\x40\xcc\x8f\x21\x7c\x11\x22\xf8\xde\x37\xe2\x17\xc7\xbb\xa0\xf9\xb8\xc3\xab\x11\xb5\x1a\x8f\xcd\xee\x5f\x0d\x2f\x5a\xf3\x06\x51\xab\xd0\xe8\x35\x56\x4f\xb4\x63\x64\x35\x62\xe5\x7e\x14\xae\x80\x1c\x3e\x7f\x56\xb9\x73\x98\x37\x1c\xc7\xbc\x21\xd7\xc6\x87\xf0\x96\xb9\x8c\xe8\x84\x6b\x22\x04\xe8\x7c\x5c\x82\x73\xdf\x8a\x7c\x65\x21\x74\xf8\x7c\x26\x5e\x71\x3c\x60\x35\x4c\xe1\x67\x66\xd3\x07\x70\xed\x1c\x5a\x87\xd5\x57\x24\x6f\xa6\x75\x4b\x6b\x38\x52\x2b\xcb\xf3\x1c\x7c\x8e\xc0\x78\x07\xd2\xa1\x2c\xab\x33\xd2\x74\x78\x26\x79\x3c\x6c\x3f\x3b\xa0\x43\x3a\xab\xc9\x2a\x9d\x1d\xe0\xce\x0e\xf9\x44\xb1\x81\x30\x54\x4b\x81\x23\x18\x70\xc0\xe7\x06\xd3\xe9\xa1\x4c\xc4\xe9\x0c\x67\x59\x1d\x9f\x27\x75\x64\x59\x1d\x49\x5a\xc7\x89\x7d\x7b\x5a\xb9\x6f\x4f\x0e\x1b\x5f\x5f\x57\xf8\x9c\x7c\xce\x11\x08\x3a\x20\x55\x1b\x4a\xc3\x9f\xc2\x4e\x67\x24\x33\x94\x4e\x0f\xd2\xb2\x9f\xc2\x19\x38\x05\x7f\x86\x64\x77\xb1\xb2\xf5\x6c\x78\xd3\x3e\xe2\x0b\x52\x56\x14\xe1\xd2\xff\x9b\x5a\x3f\x89\x33\x37\xb1\xf6\xfc\xa0\x31\x02\x9d\xc8\x47\xf2\xf8\x69\x9e\x13\xad\x65\x19\xa7\x23\x8a\x06\xe8\x5c\xb8\xf0\x53\x3f\x45\x80\x7e\x06\x9f\x24\xb8\xbb\xe3\xcd\xe4\x03\x9a\x2f\x3c\x39\x09\x9f\xfc\x29\xef\xdb\x1f\x62\x35\x6c\xc7\x4f\xb7\xd9\xfa\x50\x7a\x4c\xd6\x6e\x58\xdd\x5c\xbb\x01\xda\x89\xb5\x70\x1d\xbe\x1c\x69\x50\x9a\x95\x1f\x54\x8a\x6c\x2d\x74\x32\x91\xcb\xdc\x66\x92\x72\xdf\x70\xd8\xda\x17\xac\x55\x3a\x3a\x16\x27\xb0\x76\x71\xba\x33\x36\x91\x8c\x74\xf1\xf2\x2f\xc7\x0a\x32\xbe\x9e\xa0\x71\x29\x69\xf9\x27\xa4\xd9\x0c\x67\x9c\x6e\xff\xe5\x40\x66\xc9\xf2\xe8\x41\xac\xb8\x2e\xb4\x7b\x57\xa8\xe9\x0b\xec\xc1\x46\x1f\x5c\xfc\xdf\x90\xa3\xec\xf3\xc8\x51\x2f\x64\x5c\xd1\xdc\x89\xc5\x28\x5a\xff\x05\x58\x80\xc3\xf8\x91\xb9\x6b\xc6\xc9\xd9\x6b\xc6\x18\x33\x0f\x84\xb5\xeb\x36\x87\xcf\xb5\x9f\x83\x15\xbb\xf7\xfa\xae\xbd\x8e\xe9\x94\x4e\xc3\x45\xb8\xe2\x3f\xd7\x1f\x98\x4f\x20\x37\xbc\xeb\xe4\xea\x03\x40\x5f\xc3\x09\xc8\xbf\x15\xdd\x41\x7b\x6d\xbf\x9a\x47\x75\x80\x00\xbd\x07\x5e\x87\x08\x7e\x68\xf6\xbc\x47\x8d\x36\xe5\x01\x46\x87\xb0\x1a\x8e\xcc\xd5\x0b\x58\x9a\x72\xc1\x91\xb9\x6a\x81\xff\x0a\x3d\x02\x6b\x63\x19\x3c\x40\xbe\x19\xd0\x29\x2c\xbf\x5a\x4b\x32\xe9\x00\x03\x5e\xd4\x3c\x1f\xc4\x0a\x3c\x80\x00\x63\xc6\xf4\xf1\x16\x81\xbc\xa5\x8b\x16\x3b\xad\xff\xf0\x71\x2f\xf8\xf9\x20\x03\xd2\x87\xad\x32\xd1\x2e\xad\x37\x2a\x85\xe6\x69\x74\x9b\x22\x04\x83\xc9\x65\x23\xab\x8c\x5a\xaf\xd7\x1b\x3d\x12\xf4\xe4\x87\xa7\x3c\x5a\xa3\x23\xe3\xa0\x7e\x4c\xb1\x1c\x36\xfc\xff\xae\xe7\x4f\x64\xe8\xfd\xd3\x93\x2f\xe7\x29\xfd\xdd\x89\x07\xe1\x51\xfc\x30\x8b\xbe\xfe\x17\x84\xb8\xbe\x0b\xdf\x02\xfb\x05\xc7\x6c\xda\xf0\x9c\x24\xd6\xf1\xb8\x4f\xf8\x56\xb8\x56\x50\x81\x88\xbf\x0b\x7f\x40\xe7\x73\x8d\xd9\xf9\xdc\x2e\x7a\x17\xbe\x1e\xce\x3b\x89\x1c\xd6\x9c\xcf\xaf\x1c\x27\x86\x21\x8c\xde\x87\x8f\xc0\x53\x6f\x5d\x7e\x3e\xd1\x3c\xf8\xf4\xc9\xc5\x67\x40\xff\xc4\x8f\xc1\x96\x93\xca\xce\x73\x24\x33\x70\xce\x23\x3c\x03\x7a\x86\xe0\xef\x82\xb9\x72\x31\xec\x94\x84\x67\x3e\xe7\x2f\x83\xef\x0a\xc9\xf9\xc7\xff\x0d\xf3\x88\xc5\x08\x93\x72\xf7\xc1\xe9\x6f\x5d\x96\x3c\xd1\x08\xf9\xe3\x9b\x12\x25\x19\x1f\xf8\x0c\x3c\x2a\xa4\xdf\xb2\x2c\x09\x27\x94\x25\xe1\xd1\x13\x0a\x93\x82\xe6\x78\x61\xb2\xd1\xe0\x7a\x35\xd2\x86\x20\xfe\x2e\x42\x20\xe2\x6f\x20\x25\x3a\x4c\x46\x1d\xc0\x91\xc3\x74\xd4\xcd\x97\xe6\xf9\x13\xa6\x19\x6a\xa6\x51\xe0\x17\x4c\x17\xf1\x14\x17\x91\x14\xe4\xfd\x85\xe4\xfd\x3d\x82\x55\x7a\xff\x87\xc6\xd9\xfc\xfd\xd9\xfc\xfd\x59\xd0\x68\xfc\x43\x88\x92\xf7\xdf\x56\xf0\x76\x5c\x85\x2e\xe0\x29\x2e\x68\xd5\x71\x36\x49\xf3\x31\xa1\x8f\xa6\x51\xf2\x76\x5c\x82\x6e\xe4\x69\x6e\x6c\xa5\x79\x3b\x49\xf3\x7d\x21\x4c\xd3\x60\x9a\xe6\x05\x52\xce\x0e\x9e\x66\x47\x2b\xcd\x39\x24\xcd\x7d\x42\x92\xa6\x51\xd1\x34\x7f\x40\x08\x5d\xc7\xd3\x5c\xd7\x4c\x43\xd7\x4a\x28\x26\xbc\x93\xa4\xf9\x35\x83\xf9\x35\xf4\x11\xb4\x97\xa7\xd9\x2b\xa5\x61\xeb\xa9\xf7\x49\x69\x7e\x89\x10\xba\x9a\xa7\xb9\xba\x3d\xcd\x77\x84\xf7\x4b\x69\x5e\x40\x97\xa3\x57\x79\x9a\x57\x67\x97\x73\x5b\x2b\x0d\xc1\xcd\xdf\xd0\xbf\x78\x9a\x66\x00\x0b\x59\x9b\x5d\x20\xf5\x6d\x58\xa6\xfb\x54\xdd\x5d\xc9\x68\xd0\x20\x50\xbb\x50\xe0\x36\x82\x16\x73\x9b\x8b\x6d\x87\x63\x96\x73\xed\x42\x29\x46\x43\x6e\x39\xda\xcf\x04\x81\x9e\xda\x14\xbe\xd2\xe9\x74\x38\x1c\x7d\xc4\x05\x9b\xbb\x03\xf4\xdd\x3e\x6f\xd4\x66\x73\x39\x13\x5b\x31\x96\xcb\x77\xd8\xed\x7a\x83\xd5\x6a\x38\x66\x77\x08\xce\xfa\x8d\x11\xab\x2d\xec\x4d\x57\xfc\x01\x3b\x38\xdd\xbe\x80\xd3\x6f\x75\xc5\xfc\x2e\xd7\xd3\x82\x5c\x56\xff\x84\x4d\x6f\xb0\xd9\x0c\x7a\x1b\xfa\x7f\x84\xd1\xe9\xfe\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x86\x49\xf5\x1c\x5f\x0f\x3c\xf5\x00\x03\x03\xe8\x00\x00\x00\x00\xd3\xed\x77\x0f\x00\x00\x00\x00\xd4\x46\x04\xc4\xfe\x53\xfe\xed\x02\x2e\x03\xc3\x00\x00\x00\x07\x00\x02\x00\x00\x00\x00\x00\x00\x78\xda\x63\x60\x64\x60\x60\x8e\xfe\xef\xc4\xc0\xc0\xf8\xe5\x5f\xf0\xff\x63\x4c\x7a\x40\x11\x14\xc0\x2c\x0f\x00\x92\x2b\x05\xff\x78\xda\x62\xfc\x02\x68\x9e\x1e\x60\xec\x88\x02\x30\x0a\x4f\xdd\xae\x6d\xdb\x36\x6b\xdb\xb6\x6d\xdb\x56\x50\x44\xb5\x6d\x45\xb5\x6d\x84\x8d\xed\xba\x3d\x69\xfe\x4d\x76\xf7\xb1\xee\x24\x5f\xce\xd8\xd7\xf8\x3e\xd1\x32\x6e\x7f\x81\xab\xa4\x21\xde\x8a\x1c\x84\x94\xaf\x14\xfe\x41\x4d\xd0\x1a\x91\x88\xb2\x20\xc3\x8c\x76\x95\x94\xaa\xb6\xb4\x45\xba\x24\x94\x53\xdf\x0e\x5e\x88\x97\x0c\x3b\x04\xfe\x27\xea\xa0\x50\x82\x91\x67\x43\xd6\x0f\xc8\x45\x20\x42\xad\xc8\xb0\x43\xb2\x05\x19\x36\xf8\xc3\xdd\x8c\x78\x04\xd9\xc9\xd2\xfd\xa4\xff\x05\x0e\x52\x84\x40\xe4\x59\x91\x8c\x38\xf8\x23\x59\xfe\xe4\x7d\xe6\x96\x6b\x0f\x94\x22\xc6\x8a\x66\x30\x40\xbf\x3e\xd7\xf2\x40\x33\x9a\xab\xf6\x48\x2b\xa7\x7e\x39\x6d\x2a\x69\xa4\x7a\xa2\x8d\xc4\x48\x53\x0b\x1a\xa2\xa9\xc4\xfe\x65\x71\x12\x5b\x49\x4d\x14\x49\x02\xa6\x48\x7f\xb5\xb9\xea\x8f\x91\x9a\x8f\x44\xfe\x0f\xc8\x45\x63\x14\xab\xe6\x94\xa2\xae\x0d\x71\x16\xd4\xad\xa4\xd4\xcc\x71\x6e\x66\xa4\xc2\xc7\x4e\x19\x66\xc4\xa2\x14\xe1\xd2\x52\x9a\x22\x03\xf9\xe8\x8a\x02\x34\x42\x1a\xea\xa2\x01\xda\xa0\x08\x0d\x91\x2f\x53\x31\x1d\x63\x30\x16\x3d\x30\x03\xd3\xb0\x1e\x73\xd4\x7f\xb5\x5f\x4b\xd4\x10\x47\x4c\xb5\x21\x1e\x75\x71\x1c\xfb\xd5\x9d\xa8\x85\x13\x72\x1d\xfe\x9a\xaf\x2b\x4b\xf0\x10\x3b\x51\x17\x91\x38\x8e\x78\x4c\xc2\x5d\x64\x23\x07\x0b\x64\x3a\xc6\xc3\x01\x4d\xe0\x05\xcd\x03\x68\x80\xa6\x58\x27\xc5\x68\x83\xf6\x38\x85\x9d\x62\x58\x10\x8f\x3c\xb5\x21\x8a\x50\x0f\xe1\xf0\x80\x37\x22\xe1\x82\x58\xf8\x23\x43\xcb\x39\x70\x86\x27\x32\x11\x0b\x17\x44\x21\x1e\xab\xb5\x3e\x0e\xc9\xe5\x74\x42\x13\x24\x4b\x1c\x22\xcb\x49\x46\x13\xe9\x01\x07\x38\xa3\x19\xbc\x10\x86\x1c\x44\xc1\x09\x7e\x88\x44\x10\xbc\x10\x8f\x26\x28\x84\x0b\xdc\xd4\x42\x38\xc2\x57\x75\x40\x21\x22\xe0\x60\xa5\xf1\xe2\x2f\xa9\x88\x97\x38\x38\xa3\x06\x9c\xe0\x8a\x3a\x30\xd0\x49\xad\x8e\x50\x44\xa1\x2e\xea\xc1\x45\x3c\x30\x0d\x2f\x24\x1d\xbe\x6a\x1a\xfc\xd1\x0b\x69\x88\x47\x10\x22\x50\x8c\xaa\xf0\x81\x01\x6f\x84\xe3\x1a\x56\xa0\x0f\x0e\x1a\xc6\x97\x99\x78\x86\x5d\x78\x8b\xe5\x78\x81\x31\x98\x88\xb9\x38\x8a\x71\x58\x82\x9d\x18\xa1\xf5\xc7\x0c\xe3\xeb\x46\x7a\x19\x33\x71\x01\x27\xb1\x1d\x73\xb1\x04\x8b\xd0\x01\x33\xd8\xb7\x06\xbd\x86\xb3\x98\x89\xa1\xea\x54\xec\xc7\x48\xf6\xd9\x4c\x67\x6b\x6c\x1c\xc3\x0a\xcc\xd0\xf2\x35\x5c\xc6\x25\x9c\xc1\x53\xbc\xc1\x73\x0c\xc2\x62\xec\xc5\x4c\x8c\xc5\x2d\x6c\xc6\x6c\x70\x8e\x2a\x5e\x74\x27\x06\xe1\x20\xc6\x62\x30\xb6\xe2\x3d\xce\x63\xac\x3a\x48\xcf\x50\x66\x16\x26\x61\x0a\xc6\x61\x2a\x86\x96\xc7\x31\xae\xdf\x00\x89\xc0\xca\x68\x00\x78\xda\x44\xc1\x01\x44\x63\x7d\x00\x00\xf0\xb7\xd5\xde\xde\x5b\xdb\xdb\xda\xb6\xb7\xf7\xf6\x56\xed\xd6\xeb\xf6\xdf\xda\xde\xd6\x7a\xad\xb7\xf7\xfa\xd6\x5a\x7b\xef\x6a\x4d\xce\x24\xc9\x67\x92\x84\x99\xcc\x27\xc9\x49\x92\x24\x27\x93\x64\x4e\x4e\x92\x93\x33\x27\xc9\x27\x27\x27\x49\x92\x4f\xe6\x4c\x92\x24\x21\x99\x93\x84\x24\x99\xf3\x01\xee\xf7\x83\xfe\x70\x43\x1c\x14\x85\x06\xa1\x14\x34\x03\xe5\xa0\x6f\xd0\x77\xa8\x00\x5d\x43\x4f\x32\x95\xcc\x26\xe3\x65\x92\x2c\x21\x4b\xca\x32\xb2\x59\xd9\x8a\xac\x28\xb7\xcb\xa7\xe4\x0b\xf2\x13\xf9\x59\x85\xad\x62\xb2\x62\xae\x62\xa9\x32\x5c\xf9\x5b\xa1\x52\xe0\x8a\x98\x22\xab\x58\x55\x6c\x28\xb6\x14\x7b\x8a\x92\xe2\x09\x86\x60\x35\x6c\x85\x01\x1c\x82\x7b\xe1\x11\xf8\x03\xbc\x04\xe7\xe1\x5d\xf8\x10\x2e\xc0\x97\xf0\x3d\xfc\x5b\xa9\x53\xba\x94\x51\xe5\x7b\xe5\xdf\xca\xbc\xb2\x88\x30\x08\x8f\x48\x48\x02\x49\x22\x29\x64\x0a\xd9\x42\x6e\x90\x7b\xe4\x05\xad\x44\x19\x94\x47\x25\x34\x81\xa6\xd1\x49\x34\x8b\xae\xa2\x9b\xe8\x0e\x7a\x84\x9e\xa3\x25\x15\xad\x62\x55\xb3\xaa\xac\xea\x40\x75\x5a\x65\xad\x0a\x55\xad\x55\xe5\xab\xca\x6a\x44\x6d\x54\xdb\xd4\x1f\xd5\x39\xf5\xa9\xba\xac\x41\x34\xac\x26\xac\x89\x6b\x06\x34\x23\x9a\x8c\xe6\x44\x73\xa6\xb9\xc1\xac\x18\xc0\x58\x2c\x8c\xf5\x63\xa3\xd8\x14\xb6\x8c\x7d\xc5\x0e\xb1\x02\x76\x8b\xbd\x6a\x61\xad\x5e\x4b\x6b\xe7\xb4\x4b\xda\xcf\xda\xaf\xda\x3d\xed\xb1\xb6\xa8\xbd\xd5\x3e\xeb\x74\x3a\x97\x6e\x5c\x57\xd4\x5d\xeb\x7e\x55\x43\xd5\x78\xb5\x4f\x0f\xe9\x03\xfa\x29\x7d\xc9\x00\x0c\xac\x21\x6c\x88\x1b\x46\x0d\xff\x18\xe6\x0c\x4b\x46\xd8\xa8\x37\x02\x23\x6b\x8c\x99\x08\x13\x6d\xf2\x99\xda\x4c\x5d\xa6\x01\xd3\x4f\xd3\x85\xe9\x15\x77\xe1\x01\x3c\x82\xf7\xe2\x83\xf8\x28\xbe\x8a\x6f\xe2\x3b\xf8\x3e\x7e\x81\xdf\xe2\x8f\x78\xd9\x8c\x99\x47\xcc\x19\xf3\x94\x79\xc1\xbc\x6a\xde\x34\xef\x98\x8f\xcc\xe7\xe6\x47\x22\x44\xc4\x88\x7e\x22\x4d\x2c\x11\x47\xc4\x4f\xe2\x8a\x28\x11\x4f\xa4\x8d\x94\xc8\x04\x99\x24\x53\xe4\x04\x39\x4b\x66\xc9\x75\x72\x87\xdc\x27\x1f\xc9\xb2\x05\xb1\x18\x2d\x36\x8b\x64\xb9\xb5\x3c\x5a\xca\x94\x9e\xb2\x53\x1c\xd5\x4b\x8d\x50\x19\x6a\x9e\xca\x51\xdf\xa8\x43\xea\x82\x2a\x51\x4f\x56\xc8\xaa\xb6\x12\x56\xda\xea\xab\xb1\xd7\x30\x35\xd1\x9a\xf7\x35\xaf\xb5\x70\xed\x4a\x6d\xa9\xae\xad\xae\xab\xae\xcf\x26\xb7\xed\xd9\x8e\x6d\xc5\x37\x91\x37\x1b\x76\xb7\x7d\xcb\xbe\x67\x3f\xae\xd7\xd7\xd7\xd6\xbb\xea\x03\x34\x46\x5b\x68\x1f\x1d\xa6\xfb\xe9\x34\x3d\x4b\x2f\xd3\x6b\x74\x9e\xde\xa5\x0f\xe9\x02\x7d\x49\xdf\xd1\xe5\x06\x7d\x43\x6d\xc3\x4c\xc3\x62\xc3\x45\x43\xe9\x6d\xcc\x61\x77\x30\x0e\xde\x21\x39\x06\x1c\x23\x8e\x8c\x23\xef\x78\x01\x95\x00\x07\x76\xc0\x80\x3e\x30\x04\xd2\x60\x12\x2c\x80\x15\x90\x07\xbb\xe0\x18\x14\xc1\x35\xf8\x05\x9e\x9d\x31\x67\xbf\x73\xd8\xb9\xed\xfc\xe1\x7c\x70\xbe\xba\x80\xab\xd7\x35\xe4\x4a\xbb\x26\x5d\xbb\xae\xc3\xc6\xbe\xc6\xa1\xc6\x9c\xdb\xe8\xb6\xb9\x67\xdd\x59\xf7\xaa\x7b\xd3\xbd\xe3\xde\xf7\x74\x79\xfa\x3c\x43\x9e\x2f\x9e\x6d\xcf\x0f\xcf\x89\xe7\xda\xf3\xc0\x54\x32\x16\xc6\xc7\x84\x99\x38\x33\xcc\x4c\x32\x73\xcc\x12\xf3\xd9\xab\xf2\xe2\x5e\xbb\x97\xf1\xf2\x5e\xc9\x9b\xf0\x26\xbd\x29\xef\x8c\x37\xe7\xbd\xf3\x49\xbe\x84\x2f\xe9\x1b\xf7\x7d\xf4\xad\x37\xb1\x4d\x2b\x4d\xb7\xfe\x88\xff\x5f\xff\x81\xff\xd4\x7f\xe1\x2f\xf9\x9f\x9a\x93\xcd\xa9\xe6\x09\x16\x66\xc7\xd9\x19\x36\xc7\x6e\xb0\xdf\x5b\x66\x5a\x16\x5b\x3e\xb5\x7c\x69\xd9\x6e\x39\x08\x04\x02\xd3\x81\x62\xe0\xb6\x75\xb1\xf5\x53\x6b\xbe\x75\xb7\xf5\x90\x63\x38\x9e\x93\xb8\x04\x37\xcc\x8d\x71\x1f\xb8\x79\x6e\x99\x5b\xe3\xb6\xb8\x7d\xae\xc0\x5d\x73\x0f\xdc\x6b\x10\x0e\xea\x83\x20\x78\x12\x3c\x0b\xde\x04\x9f\x79\x1b\xdf\xc7\x0f\xf1\x69\x7e\x92\x9f\xe3\xb7\x84\x4a\x41\x27\x58\x05\x20\xb0\x42\x58\x88\x0b\x03\xc2\x88\x90\x11\x36\x84\x2d\x61\x4f\x38\x16\x8a\x6d\xa1\xb6\x54\x5b\xf9\xaf\xa9\x10\x17\x5a\x0d\x6d\x84\xfe\x6b\x27\xda\xf9\xf6\xc5\xf6\xe7\xb0\x2b\x9c\xed\x90\x77\x80\x8e\x6c\x04\x8e\x44\x22\x7d\x91\xf5\xc8\x55\xe4\x3e\x52\xee\x54\x77\xa6\x3a\x2f\x3a\x5f\xa3\x83\xd1\xe3\x68\x21\x7a\x1e\xbd\x8b\x3e\x8b\xb0\x88\x89\xb8\x58\x2b\xbe\x15\x19\x31\x20\x86\x44\x49\xec\x15\xfb\xc5\xa4\x38\x2a\x8e\x89\x13\xe2\xb4\x78\x25\xa9\xa4\x31\xe9\xfe\x9d\xef\xdd\x5a\x17\xe8\x1a\xe8\x3a\xe9\x1e\xec\x4e\x77\x5f\xc6\x02\xb1\x42\xec\x3a\xf6\x10\x7b\xee\x89\xf6\x4c\xf7\xcc\xf7\x64\xe3\xea\x78\x20\x1e\x8b\x67\xe2\xb9\x78\x31\xfe\xf2\x7f\x53\xf0\x14\xe0\x36\x00\x00\x00\x74\xb6\x6d\xdb\xb6\xcd\x78\xbd\xba\x71\x8a\x5c\x1a\x7b\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xfe\xee\xbd\x9e\x1d\x7a\x9a\x3d\x37\xf5\xbc\x07\xf0\xc0\x18\xe0\x0d\x88\x80\x61\xb0\x1f\x78\x08\xbc\x02\x7e\x01\xff\x40\x55\xa0\x06\x10\x06\xcd\x83\x5e\xc0\xa5\x60\x08\x96\xe1\x09\xf0\x1a\xf8\x18\xfc\x08\x7e\x85\x64\x41\xf2\x20\x35\x90\x1e\x08\x8f\x0c\x40\xa6\x20\x57\xd0\x52\x68\x14\x9d\x80\xee\x40\xef\xfd\x1f\x03\xb0\x73\xbd\xec\x5e\xb7\x3c\xde\x94\x3a\x29\xd3\xbc\x59\xbc\xad\xbc\x2b\xbc\x2f\xbc\x1f\x7c\xad\x7c\xae\xef\x84\xff\x4f\x40\x0e\xec\x08\x3c\x0b\x7a\x83\x5b\x82\xdf\x42\x45\x42\xf5\x42\x1d\x42\xd1\x90\x18\x9a\x11\xda\x12\xba\x14\x2e\x13\xf6\x87\xfb\x84\x27\x85\x57\x84\x8f\x85\x9f\x44\x1a\x44\xba\x45\x26\x45\x8e\xe0\x79\x70\x2f\xde\x07\x5f\x87\x9f\xc2\x9f\x10\x19\x88\x12\x04\x4f\x6c\x21\xd7\x50\x32\xb5\x85\x3a\x44\xdd\xa1\x7e\xd0\x05\xe8\x3a\x74\x37\x3a\x4a\x0f\xa0\xe7\xd0\xfb\xe8\x07\x4c\x16\xa6\x16\x83\x31\x36\x33\x81\x59\xc6\xec\x63\xae\x30\x5f\xd8\x1a\x6c\x2b\xd6\xc3\xca\xec\x0d\xae\x1d\x37\x89\x3b\x13\xcd\x13\x6d\x17\xf5\x46\xd5\xe8\xb8\xe8\xb4\xe8\x81\xe8\xab\xd8\xaa\x78\xa9\x78\xb7\xf8\xad\x04\x9b\x78\xc6\xe7\xe1\xbd\x7c\x1f\x7e\x1b\xff\x29\xb5\x56\x6a\x1f\xa1\x89\x20\x0a\xe3\x84\x45\xc2\x1d\xe1\x47\xb2\x56\x32\x98\x1c\x95\xdc\x93\xbc\x21\x86\xc5\x41\xe2\x1c\x71\x85\xb8\x4d\x3c\x26\x65\x91\xea\x49\x1d\x24\x8f\x24\x4a\x63\xa4\x35\xd2\x19\xe9\x97\xdc\x4a\x1e\x24\x2f\x92\x1f\xc9\xef\x94\x52\x4a\x17\xa5\x8f\xb2\x4c\xd9\xa6\x3c\x52\x2b\xa8\xed\x54\x51\x1d\xa7\xae\x53\xcf\x69\x39\xb4\x7a\x5a\x07\xcd\xa3\x89\xda\x28\x6d\x95\x76\x45\xcf\xa7\x63\xfa\x00\xfd\x9c\xfe\xcb\xa8\x62\x34\x30\x30\x83\x35\x04\x63\x9e\x71\xc6\x78\x66\x66\x30\xcb\x99\x0d\x4c\xda\x1c\x66\x1e\x33\x9f\x59\x95\x2c\xc8\x22\x2d\xd7\x9a\x65\x3d\xb2\x2b\xd9\x75\xec\x76\x36\x64\x8b\xf6\x0a\xfb\x9c\xfd\xc0\xfe\xe5\x34\x71\x6c\x67\x94\x33\xc7\x59\xe3\xec\x71\xce\x39\x0f\x9c\x4f\x6e\x26\xb7\x88\x5b\xcd\x6d\xe1\x02\xee\xf2\x7f\xbc\xf4\x3a\x1b\x00\x00\x00\x00\x01\x00\x00\x03\x1f\x00\x6a\x00\x07\x00\x5c\x00\x07\x00\x02\x00\x24\x00\x35\x00\x8b\x00\x00\x00\x87\x0d\x16\x00\x03\x00\x01\x78\xda\x2c\xc8\x01\x06\xc2\x60\x18\xc7\xe1\xdf\xfb\x7d\x6b\x6d\xb6\x8d\x8c\x62\x48\x60\x84\x0d\x04\x29\xe9\x1c\x49\x77\x08\x04\x04\x5d\xa0\x0e\x10\xa2\x3b\x0c\x9d\x65\x40\xd7\x88\x3e\xfc\x81\xc7\x03\xd4\xb6\xc3\x00\x80\x0e\x64\x23\xa3\x93\x1d\x63\xd6\xb2\x67\xce\x5e\x8e\xa8\x38\xc9\x23\x0a\xce\x72\x4c\xc5\x55\x4e\x82\x1f\x72\x1a\xfc\x96\x33\x5a\x7a\x39\x0f\xfe\xca\x05\x77\x9b\xc8\x25\xb5\xbd\xf0\x58\x94\x02\x47\xfb\xc8\xc6\xd4\xcd\x64\x47\xe9\x5a\xd9\xb3\x75\x1b\x39\xa2\x71\x17\x79\x44\xed\x9e\x72\x1c\xbe\x97\x93\xe0\x41\x4e\x69\xbc\x97\x33\x0e\x7e\x29\xe7\xc1\x37\xb9\xb0\x85\x1f\xe4\x92\x55\xf4\xfb\xd7\x4a\x2d\xaa\x8d\xc3\x40\x70\x3f\x65\x3f\x20\x55\x9c\x00\x05\x12\x5e\x21\x34\x10\x30\xa4\x75\x42\xa1\xbc\x55\x47\xb5\x55\x54\xcb\x48\x72\x4c\xa0\x1f\x7f\xc3\x36\xe1\x4c\xce\x07\xbd\x83\x22\x24\xed\x63\x66\x77\x3d\xf2\xda\xb7\xe7\x60\xab\x3a\xf1\x3c\xcb\xee\xf9\x50\x1b\xde\x36\xa5\x6f\xa2\x77\x3a\x69\x7e\x0c\xfe\xdd\x94\x89\x57\x5d\xaa\x7d\x88\x83\x54\x61\xaa\xce\xe9\x30\x57\x59\x96\x2d\xd7\x2f\xc5\xc3\x72\x90\xbc\xbb\x64\x87\xa5\x2e\xa1\x67\x13\xa2\xf5\x0d\x0b\x71\x84\x52\xe8\xb6\xe6\xdc\x9c\xac\x69\x26\xbc\x3e\x07\xa3\xdd\x48\xa8\x4e\xa9\x5d\x4c\xa7\x7d\xdf\x2b\x27\x09\x55\xfa\x0f\xfe\xe4\x41\xbc\x14\xa0\xf2\xa1\xfa\x27\xf0\xa1\xb6\x91\x37\xbe\x49\xbc\xf7\x6f\xa9\xd7\xc1\x30\x02\xce\x96\xa6\x89\xe6\xc8\x5d\x73\x34\x81\x13\x64\xda\x6f\x73\xde\xb5\xa6\xf9\x02\xe7\x5f\x80\x09\x5f\xbf\x6f\xa6\x66\x0a\x72\xfe\xe6\x32\x4c\x7d\xd2\xd6\xe9\x57\x67\xb8\xb7\xa9\x66\xcd\x9b\xd5\x13\xeb\xb4\xb8\x8e\x12\xcb\x60\xdb\x14\x55\xb4\x32\xcb\x74\xb7\xc9\xff\x9e\xa1\x35\x79\x6a\xe9\x4c\x81\x2c\x55\x54\x53\x22\xa6\x39\x65\x58\xf7\xb0\x0e\x88\x18\xdc\x5b\x6a\xa8\x24\x8f\x33\xe2\x74\xa4\x81\xd3\x88\x3f\x52\x80\xff\x0e\x4c\x29\xcc\x15\x75\xb8\x6b\xc4\x02\x90\xe3\xac\x02\xe8\x8a\x3a\xf1\x03\x7a\x29\xca\x64\x2d\x31\xcb\x0b\xb2\x0f\xb0\xc6\x99\x77\x37\xdc\x71\x14\xdf\xa0\x9e\xc9\xc8\x34\x56\x90\x3c\xe8\xf8\xbd\x2e\x05\xce\x96\x6a\x30\x73\xc4\x4f\xa8\x63\xc0\x98\xc0\x5f\x8b\x6e\x06\x79\xf7\x3d\x94\xe8\x9b\x80\x5b\xd0\x14\xab\x97\xa5\xc8\x0d\x18\x4a\x26\xfa\x00\xef\x13\x7b\x1c\x5f\x0e\x2a\x2a\xd1\xba\xfa\xc1\xca\x07\x60\x2d\x45\x70\x36\xa2\x55\x82\xb5\x87\xf5\x46\x09\x1c\x2d\x78\xbe\x20\x1c\xee\x52\xba\x45\x9c\x47\x44\x3a\xd8\x47\x79\x01\x96\x3f\xc3\x08\x7b\x4b\x39\xee\x1d\xb5\x82\x1d\x56\xce\x87\x15\x44\xbf\xdb\xf7\x9b\x91\x92\xcd\x32\xd9\x58\xdf\xeb\x34\x9a\x4e\xd8\x56\xde\xf1\x95\x9c\x64\x7a\xb2\x32\x07\xb2\xd2\x77\x45\x4f\x62\x27\x5a\xfc\xa1\x4a\x44\xcd\x40\x16\x7e\x82\xad\xb0\xed\x40\x97\x29\xed\xc0\xcf\xff\x87\xf3\x0b\x54\xf6\x4e\x55\x00\x78\xda\x5c\xd0\x03\x56\x44\x01\x00\x86\xd1\xff\x9b\x97\x6d\xbb\xb1\x67\xb2\x6d\x4e\x76\x8d\xb7\xd3\x46\x6a\x1b\x59\xa7\x1d\x74\xd8\x0a\xb2\x8f\xbb\x4b\xb8\x32\xe9\xcf\x57\x48\x41\x49\xe8\x1f\xa3\x5e\xc2\x24\x93\x0c\x99\x75\x86\x41\x0a\xa9\xa4\x91\x4e\x86\x4e\xc8\x24\x8b\x6c\x72\xc8\x25\x4f\x11\xf2\x75\x44\x01\x85\x14\x51\xac\x28\x25\x3a\xd6\x21\xa5\xb2\xc8\xaa\x0f\x7d\x29\x46\x19\xe5\x54\xc8\xa6\x47\x2a\xa9\xa2\x9a\x1a\xd9\x15\xa7\x96\x3a\xea\x75\x4a\x03\x8d\x34\xd1\x8c\x19\x8b\xce\xb1\x62\xd3\x05\x76\x1c\x38\x71\xe1\xc6\x83\x17\x9f\x1c\x72\xea\x19\x3f\x01\x82\xb4\xd0\x2a\x17\x6d\xb4\xd3\x41\xa7\xdc\xba\xa4\x4b\x57\x74\xeb\x9a\x1e\xbd\xd2\xab\x1b\xfa\xe8\x67\x80\x41\x86\xe4\x61\x58\x5e\x46\x18\x95\x8f\x31\xc6\x99\x60\x92\x29\xa6\x99\x61\x56\x0f\xf2\x33\xa7\x00\xf3\x2c\xb0\x48\x88\x25\x96\x59\x51\xe2\x67\xe3\x96\x55\xdd\xb1\xc6\x3a\x1b\x6c\xb2\xc5\xb6\x92\xec\xb0\xcb\x9e\xee\xd9\xe7\x80\x30\x11\xa2\xc4\x88\x93\x20\xf9\x4d\x10\x3c\x20\x89\x01\x00\x40\x10\xdc\x79\x53\xac\x62\xec\xfc\xe2\x6c\xc4\x3a\xdb\xb6\x6d\xdb\xb6\x6d\xdb\x66\x37\x06\x18\x62\x84\xb1\xfc\x30\x51\x0e\xa6\x98\x61\xae\x5c\xdd\xd1\x96\xee\xea\x1e\x16\x58\x62\x85\x35\x36\xd8\x62\xa7\xfb\xd8\xf3\x55\x8b\x7c\xd3\x05\xdf\xf9\xc1\x4f\x7e\xf1\x9b\x3f\xfc\xd5\x03\xfe\xf1\x1f\x07\x1c\x71\xc2\x59\x0f\x35\x88\x8b\x86\x70\x95\x01\x6e\x1a\xc6\x1d\x0f\x3c\xf1\xc2\x1b\x1f\x7c\xf1\xc3\x9f\x00\x02\x09\x22\x98\x10\x42\xf5\x48\x8f\x09\x23\x9c\x08\x22\xf5\x44\x4f\xb5\x41\x94\x2a\x88\x26\x86\x58\xe2\x88\x27\x41\xcf\x48\xd4\x32\x49\x24\x93\xa2\xe7\x32\x24\x95\x34\xd2\xc9\x20\x93\x2c\x19\x93\x4d\x0e\xb9\xe4\x91\x4f\x81\x4c\x28\x94\x11\x45\x14\x53\x42\xa9\xcc\x28\x93\xa9\x62\x29\xd7\x0b\x2a\xf4\x52\xd7\x48\xe6\x54\x52\x45\xb5\x5e\x69\x9d\x1a\xa0\x96\x3a\xbd\x96\x05\xf5\x34\xd0\x28\x2b\x9a\x68\xa6\x85\x56\xda\x68\x97\x35\x1d\x74\xca\x92\x2e\xba\xe9\xa1\x97\x3e\xfa\x19\x60\x90\x21\x86\xf5\x46\x6f\x75\xc6\x08\xa3\x8c\x31\xce\x84\xde\x31\xc9\x14\xd3\xcc\xe8\xbd\x46\x64\xc3\xac\xec\x98\x93\x3d\xf3\x2c\xb0\x28\x5b\x96\x58\x66\x85\x55\xd6\xf4\x81\x75\x36\xf4\x91\x4d\xb6\xf4\x89\x6d\x76\xd8\x65\x8f\x7d\x0e\x38\xd4\x82\x3e\x73\xa4\x2f\x1c\x73\xc2\x29\x67\x9c\x73\xc1\x2d\x41\xf0\x80\x18\x06\x00\x00\x30\x30\xcd\x6c\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xaa\x56\x6b\xf6\x17\x66\xdb\xba\xfb\xcd\x74\x3a\x32\x23\xe0\x0f\xb3\x02\xfe\x06\xfc\x13\x03\xd4\x44\xcc\x36\xb1\x49\x4c\xca\x4c\x93\x99\xdc\x14\xa6\x34\x95\xa9\x4d\x63\x5a\xd3\x99\xde\x0c\x66\x34\x13\x07\xcc\xcc\x1c\xb3\x98\xd5\x6c\x5c\xa0\x13\x6f\xe9\x4c\x17\xb3\x9b\xc3\x9c\xe6\x32\xb7\x79\xcc\x6b\x3e\xf3\xd3\xd5\x02\x16\xe4\x89\x85\xf8\x6d\x61\x8b\x58\xd4\x62\x16\x67\x05\xdd\x2c\x61\x49\x4b\x59\xda\x32\x96\xb5\x1c\xdd\x99\x6b\x79\xe6\x5b\x81\x05\x56\x64\x9e\x95\xac\x6c\x15\xab\x5a\xcd\xea\xd6\xb0\xa6\xb5\xac\x6d\x1d\xeb\x5a\xcf\xfa\x36\xa0\x07\x3d\x6d\x68\x23\x1b\xdb\x84\x5e\xf4\xe6\xb5\x4d\x09\xb5\x99\xcd\x6d\x61\x4b\x5b\xd9\x9a\x3e\xb6\xe1\x99\x6d\x6d\x67\x7b\x3b\x10\x45\x34\x7b\xd8\x6b\x47\x3b\x91\x89\xcc\x64\x21\x2b\xd9\xc8\x4e\x0e\x72\x92\x8b\xdc\x76\xb6\x8b\x5d\xed\x66\x77\x7b\xd8\xd3\x5e\xf6\xb6\x8f\x7d\xed\x67\x7f\x07\x38\xd0\x41\x0e\x76\x88\x43\x1d\xe6\x70\x47\x38\xd2\x51\x8e\x76\x8c\x63\x1d\xe7\x78\x27\x38\xd1\x49\x4e\x76\x8a\x53\x9d\xe6\x74\x67\x38\xd3\x59\xce\x76\x0e\x61\x7c\xe6\x0b\x5f\x9d\xeb\x3c\xe7\xbb\xc0\x85\x2e\x72\xb1\x4b\x48\x43\x3d\x62\x59\x46\x1e\xd2\x71\x8a\xc4\x1c\x72\x29\x49\xc9\x40\x41\x0e\x92\x84\x94\xe4\x25\x23\x0d\x5d\xe6\x72\x57\xb8\x92\xbe\xf4\xa7\x2e\xf5\x49\x45\x6a\x2e\x71\xd1\x55\xae\x26\xbd\x6b\x5c\xcb\x09\x4e\x12\x41\x24\xf1\x5c\xe6\x0a\x81\x04\x11\xe7\x3a\xd7\xbb\xc1\x8d\x6e\x72\xb3\x5b\x58\xec\x56\xc2\x49\xe6\x36\xb7\x73\x94\x6f\xee\x70\xa7\xbb\xdc\xed\x1e\xf7\xba\xcf\xfd\x1e\xf0\x20\x4b\x3c\xc4\x56\x0f\x7b\xc4\xa3\x1e\xf3\x38\x69\x79\xc7\x7b\x82\xc9\xcf\x3a\x0a\x90\x8f\x2d\x6c\x66\x13\xc7\x18\xc0\x61\x36\x7a\x82\xdd\x9e\xf4\x14\x3b\xd9\xc1\x11\x4f\xb3\x9d\xe4\x24\x78\xc6\xb3\x9e\xf3\xbc\x17\xbc\xe8\x25\x2f\x7b\xc5\x40\x83\x0c\x36\xc4\x50\xaf\x1a\x66\xb8\x11\x46\x1a\x65\xb4\x31\xc6\x1a\x47\x88\xf1\x26\x78\xcd\xeb\xde\xf0\xa6\xb7\xbc\xed\x1d\xef\x7a\xcf\xfb\x14\x22\x05\xcb\x59\xca\x2a\x56\xb2\x9a\x45\xf4\xe3\x39\x0b\x7d\x40\x8c\x0f\x7d\x44\x83\xff\x04\xc1\x83\x0d\x04\x01\x00\x00\xb0\xa4\xfb\x8f\xf7\xb6\x79\xb6\x73\xad\x85\xa5\x95\xb5\x8d\xad\x9d\xbd\x83\xa3\x93\xb3\x8b\xab\x9b\xbb\x87\xa7\x97\xb7\x8f\xaf\x9f\xbf\x40\x28\x12\x4b\xa4\x32\xb9\x42\xa9\x52\x6b\xb4\x3a\xbd\xc1\x68\x32\x13\x04\x17\x04\x08\x04\x00\x10\xc0\x0e\x86\xbb\xbb\x75\x21\x1f\xe4\xc3\x25\xc9\xff\x56\x88\x8a\x2a\x6a\xea\x1a\x9a\x5a\xda\x3a\xba\x7a\xfa\x06\x86\x46\xc6\x26\xb9\xe4\x99\x7f\x3e\xb9\xe5\x9a\x57\xce\xf9\xe6\x91\x5f\xde\xb9\x9b\x9a\x99\x5b\x58\x5a\x59\xdb\xd8\xda\xd9\x3b\x38\x3a\x95\x6d\x96\x47\x7b\xe3\x38\x12\x86\x0f\x92\xc1\x51\xda\xbc\x7b\xdf\xbc\x27\x3f\xcc\x24\x8e\x24\x45\x76\xce\xb9\x6f\x34\x09\x4b\x5c\x53\x84\x87\xc1\x9a\xee\x5f\x3f\x26\xea\x93\x84\x96\xc7\xe9\x45\x15\x0a\xa8\x2a\x10\xfa\xe8\xc9\xdf\x27\xff\x98\xfc\x73\xf2\xaf\xc9\xbf\x27\xff\x99\xfc\x77\xf2\xbf\xe9\xcb\x0f\xcf\x9f\xb3\xe8\xaa\x15\x77\xc2\x18\x9a\xca\x4a\xa3\x94\x18\xfb\xa0\x09\xda\xa0\x8b\xb8\x03\x13\xd0\x07\x43\x30\x1a\x69\xda\xa6\x09\xfb\x40\x1b\x7e\xdb\x88\x76\x79\xd1\xca\xc6\x88\xe4\x46\x36\xe2\x66\x1e\xb5\x55\xb3\xc9\x8b\xa1\x17\x46\x94\x12\xd5\x12\x33\x5c\x24\x45\xd5\x16\xc3\xee\xba\x16\xbf\xcc\x93\x52\xf6\x79\x51\x88\xa6\x67\xeb\x22\xbf\xdf\xe0\x1e\xad\xcc\x7b\x04\x27\x60\xca\x52\xd5\x16\x4b\x55\x10\x79\x2d\xcc\xc6\xe8\x32\xf1\x41\x13\xb4\x41\x17\x55\xba\xf3\xf4\x98\x0e\x4b\x43\xf0\xd0\xa0\x6f\xa4\xd4\x08\x52\xf8\xa0\x6b\xa4\xd4\x18\xe2\x13\x15\x6f\xc5\x21\x7b\xa4\x2a\x5a\x3c\x3a\x35\xb5\x7c\x54\xc8\xdd\x2e\xa7\x3c\xf3\x47\x67\x29\x6d\x73\xfa\xf8\x2a\x6f\x61\x44\x8b\xc7\xa7\x85\xf0\xb9\xec\x89\x6a\x16\x25\x85\x70\x1f\xba\x8c\xc0\x10\xf3\x91\xf1\x04\x25\x3f\xa1\x12\xd9\x93\xbe\xaa\x4b\xb1\x78\xaa\x95\xf4\x4c\x2b\x89\x4a\xe7\x21\x31\x09\xd8\x73\xf5\x7c\xd8\x73\xd5\xc9\xf2\xb9\x16\x3a\x7d\x5e\x4a\xd4\xed\xf8\x88\x0f\x61\xa3\x0e\xd7\x66\x2f\x69\xfd\x4b\x5a\xff\x52\x5b\x8f\x10\x17\xf4\x27\x69\xb3\xc1\x38\x64\xaf\xb4\x9b\xba\x36\xc1\x10\xb4\x41\x17\xf4\xd1\x6d\x42\xb4\x23\xa2\x83\x75\x49\x02\xa6\x17\xaf\xb6\xb2\x6d\xb0\x0a\x35\xa6\x36\xec\x04\x4c\xe1\x37\x57\xaf\xb6\x43\xb3\xc9\xdb\x61\x57\xe7\x43\x8f\x1c\xa9\xf1\x4a\xbf\x04\x9e\x0d\x9a\x74\x02\x69\xb4\x78\xd5\xd5\x79\xb7\xd5\xae\xb5\x9b\x80\x29\xea\x4b\xd8\x5b\x3a\x95\xb7\x74\x2a\x6f\xcf\x1f\x80\x6d\x99\xd8\x36\x82\x6d\xc3\x4e\xd9\x3b\x6d\x67\xdf\x05\xfd\xc5\x3b\xed\x81\xbe\x7b\x70\xca\xbe\x09\xda\x60\x48\xe4\x54\x92\x15\x66\xd3\xf7\xf7\x17\x8f\xbd\x57\x05\x91\xcf\xb7\x91\x3b\xc2\x9a\x04\x4c\xd9\x07\xed\x0e\x5a\xa8\x21\x3d\xd0\xbf\xf8\x70\x3a\xe5\x14\x89\x32\x13\x76\x04\x26\x60\xba\xfa\xf0\xf0\x94\x2d\xdf\xf8\xa0\x9f\x72\x10\x19\x1f\xe8\xfe\x5e\x7c\x18\xb5\x83\x7d\x50\xb7\x18\x93\x21\xfb\xa4\xce\x64\xf1\xe9\x74\x06\xb3\x4f\x65\x25\x5a\xd1\x55\x1d\xfb\xb4\x69\xf3\x3b\xb1\xf8\x72\xfe\x59\x0a\x53\x94\xe6\xb2\x2f\x2a\x04\xa6\x8f\xdb\x63\xc3\x0e\xd9\x57\xb5\xfd\xfc\xeb\xd9\xa7\x95\xdb\x93\x0f\x97\x15\xcb\x75\x51\xcd\x88\x71\x00\x5a\xa0\x03\x7a\x88\x3b\x70\x0d\x06\x20\x07\x63\x5c\x37\x0b\xf6\x81\x0e\xfc\x8e\x91\xe3\x80\x72\x88\x6a\x7e\x12\xd5\x5c\x10\x55\x28\x0f\xa1\x93\x7c\x51\x68\xe2\x5a\x9c\xc4\xb5\xd4\x74\xd3\x5c\x83\x19\x13\xa4\xaa\x42\x57\x55\xcc\xc6\x68\x33\x09\x40\x0b\x74\x40\x0f\x65\x7a\x73\x71\xae\xaa\x1c\x3c\x74\x18\x18\xe2\x07\x55\x0d\x40\xcf\x10\xd4\x19\xda\x88\xb0\x6e\x4d\xb6\xc7\xa1\xb2\x9c\x6d\x48\x65\x37\xda\x27\x60\xa3\xab\xec\xe6\x5c\x65\xad\xe9\xf6\xa4\xb2\xf1\x62\xfb\x40\x65\x3d\x56\xe9\x2a\xcb\xe1\xce\x66\xd5\x65\x2d\x8b\xfa\xfd\xdb\x67\xe8\x33\x06\x39\x22\x63\xa3\x42\x33\x15\xf4\xb6\xa2\x9b\x4a\x57\x2a\x58\xfc\x5f\x2b\xf2\x46\x2b\x72\x79\xb3\x69\x85\x68\xea\xbc\x29\xab\x82\xd5\x24\x0f\x35\xc9\x43\xad\x8b\x6e\x7d\x12\xdd\x00\xa2\xcb\x61\xa3\x18\xd7\x61\x0d\xad\x6f\x68\x7d\xf3\x50\x74\x3d\x30\x98\x88\xa3\xe8\x72\x26\x75\xd1\xb5\x40\x0e\x3a\xa0\x07\x06\x68\x79\x4d\xb4\x63\xf4\x88\x75\xc9\x1a\xcc\x2e\xa4\x26\xba\xa8\x31\x75\x60\xaf\xc1\x0c\x7e\x6b\x25\x7f\x43\x74\x33\x43\xfe\x20\xba\x0e\x68\x41\x74\xe3\x85\x7c\x20\xba\x6b\x30\x43\x7d\x6b\xd6\xd2\xa9\xb4\x74\x2a\xed\x43\xd1\xb5\xb0\x2d\x5a\x09\x40\xeb\x90\x0e\x5b\xb9\x6b\xd6\xe9\x22\xec\x81\xc1\xa2\xd3\x9e\x6e\xf7\x50\x84\x2d\xd0\x01\xf9\xb4\x1f\x45\xb7\xd7\x45\xd7\x41\xce\x18\x1a\x13\x20\x76\x0d\x66\x6c\xd0\xc5\x17\xb9\xd3\x03\x83\x8b\x41\x13\x5f\x4e\xcc\x2c\xd8\x31\xb8\x06\xb3\xd5\xf0\x1b\xe2\x7b\x9f\xf2\x07\xf1\xbd\x5f\x05\xf1\x1d\x94\xf8\x0e\xba\xf8\x72\xb6\x27\xf1\xdd\x6b\xe2\xbb\x3f\x8a\xef\x9e\xc4\xf7\xdb\x03\xf1\xcd\x50\x9a\xc7\xbe\xe9\xe2\x1b\xe0\x16\x39\xb0\x39\xfb\x4e\xe2\xfb\xfd\x5c\x7c\x9d\xc9\x70\x59\x19\xed\x65\x27\xda\xea\x7a\xf4\xd9\x66\x90\x29\x5a\xb6\x3b\xfb\x2e\x5a\x79\xd9\x75\xa6\x4d\xa3\xf1\x0f\xc5\x84\x26\x68\x81\x36\xe8\x80\x2e\xe8\x81\x3e\x18\x80\x21\xc8\x69\xe7\xb2\x91\xbb\x9f\x64\x23\x68\xd0\xef\xc9\x33\xef\xb7\xad\x20\xdf\xec\x5a\x0e\x2d\x46\xd5\x1d\xe2\xba\xea\x17\x8a\xeb\xc4\x9d\x68\x68\x28\xaa\xcd\xb6\xa7\xc0\xa6\xc2\x86\x94\xa3\x19\x76\xed\x98\x83\x06\xfd\x9e\x3c\xc8\x31\x0e\x29\x07\x8d\xaa\x3b\xc4\x8d\x39\xc6\x01\x72\x8c\x43\xe4\x18\x87\x94\x63\x1c\xe1\xec\xcc\x91\xa6\x19\x73\xd0\x06\x1d\xcc\xbb\xa0\x07\xfa\x60\x00\x86\x20\x5f\xe2\x9c\xd5\xf1\xff\xee\x78\x48\x64\x1e\xfb\x51\xe6\x12\xa9\x61\x60\xdd\x18\xa3\xad\x83\x89\x75\xca\x3c\xae\x3b\x3e\xd7\xd4\xb2\xff\x76\x2b\xda\x4a\x96\xe3\x15\x11\xad\x28\x95\x52\x27\xd1\xfb\x55\xde\xdd\xdb\x55\x77\x33\x26\xb1\x56\x3f\x0f\xb2\x17\xe5\x55\x3d\x5a\xce\x1f\x94\xd5\x55\xcd\xa6\x16\xca\x31\xbf\xae\x36\x43\x2b\xca\xbc\xdb\x52\x3b\x96\x07\xe2\x78\xa2\x35\xd9\x66\x00\x46\x60\x88\x79\x13\x36\x07\x63\x62\xec\xad\x0a\x59\xcb\x66\x27\x1b\xd1\xe7\xed\xb7\x69\x29\x9b\xcd\x34\x1d\x5a\x89\x79\x9b\x18\xad\xa7\x75\xd5\xe6\x70\x46\x60\x82\x49\x9f\xdd\x8a\x4e\xf4\x39\xdc\x16\xb8\x06\x39\xc2\xf8\xf2\xd0\xf3\x2e\xef\xa9\x11\xdb\xe2\xc4\xf1\xbd\x5a\x8b\x9d\x68\x7a\x3c\xaf\xf8\x27\xb1\xbb\xed\xbf\x75\x82\x1c\x96\xed\x53\xa0\xe9\xe3\x02\x78\xf3\xf1\xc3\x5b\x8b\xdb\x7a\xe8\x7e\x9f\x77\x45\x45\xaf\x34\x75\x9c\x46\xde\xb6\x72\x3f\xdc\xd2\x52\x1e\xcc\x95\xdd\x8e\xb7\x0c\xae\x70\xa6\x5c\xa5\xdc\x37\xf0\x70\xf2\xd4\xe2\xba\x87\xc7\x27\xcf\x95\xec\xb7\x33\x6c\x58\x22\x3a\x0d\xc0\x10\xe4\xa0\xaf\x18\x9b\x20\xf7\x60\x07\x20\xd9\x5e\x92\x31\xaa\x1e\xa6\x0f\x52\x98\xef\xf0\x59\xb7\xab\x6a\x71\x9d\x17\x62\x59\x35\x77\x47\xe3\xa2\xbb\xcd\x4b\x31\x2d\xea\xe1\xea\x62\x2b\xf2\xb6\x37\xca\x2a\xdf\xc9\xa6\x54\xeb\x02\xcb\x01\x5d\xd0\x03\x03\x10\xe5\x5a\x0e\xe8\xcf\x44\xd7\x57\xbb\xbc\x17\xb4\x83\x6b\xd3\x8c\x13\xc6\x17\x5b\x39\x74\x02\x6d\x45\xe4\xb5\x03\x30\x06\x7d\xd0\x23\x5a\x21\x31\x49\xd9\xae\x6a\xc6\xb7\x5a\x27\x0a\xd9\x94\x7f\x50\xcf\x87\xc4\x96\x1e\xd0\xf8\x0c\x9d\xc0\x23\x9a\x21\x18\xcc\x94\xe8\x16\x72\x77\x35\x1b\xf5\x55\x8d\x30\x17\xaf\xd4\xfb\x68\xf4\x5c\xe6\x75\x0f\xaf\x0d\x26\xa0\x0f\x46\xb3\xf1\x36\xe8\xeb\xdd\xd5\x56\xca\x9b\xfc\x4a\xde\xe9\xde\x8c\x68\x59\xa0\x0d\xc6\xcb\x52\xf6\x57\xa2\x96\xfb\x53\xb0\xed\x82\x3e\x18\x80\x21\x98\x12\x1d\x0b\xf4\x40\x7f\x89\x36\x2f\x8b\xbc\x13\x30\x02\x65\xfc\xee\xd8\x30\x99\xc7\xae\xf5\xd0\x58\x37\x6c\xdd\x48\x74\xc3\xd7\x8d\x88\xf6\x3b\x9e\x82\x36\x67\xd1\x7e\x8b\xd3\x5c\x45\xaf\xb7\x38\x01\x63\x30\x22\x26\x1c\x3c\xf8\x39\x98\x81\x29\xe6\x23\x30\x01\xc3\xb9\xea\x47\xa5\x9b\x8f\xff\x1f\x60\xa8\x9e\xa4\x1a\xae\x0e\xaf\x64\x2a\xf7\xf8\x36\xa5\x38\x75\x38\x6a\xf8\x47\xed\x1f\x02\x9a\x9c\x8d\x2f\x7d\x0a\x53\x8d\x50\x4f\x38\x89\xfb\x5f\x4b\x1b\x9b\xda\x98\x6b\x63\x07\x63\x1b\xf1\x18\x23\x9e\xc6\x5c\x1b\x3b\x7f\xd0\xe2\x55\x42\xcd\x61\x9e\x3b\xf8\xb9\xc3\x51\x8e\xf1\x6c\xb2\x2c\x5b\xff\x0a\x0b\x80\x6c\x87\x00\x78\xda\x63\xf0\xde\xc1\x70\x22\x28\x62\x23\x23\x63\x5f\xe4\x06\xc6\x9d\x1c\x0c\x1c\x0c\xc9\x05\x1b\x19\xd8\x9d\xb6\xb8\x1b\xcb\x33\x33\x68\x81\x98\xdb\xad\x38\xd4\x38\x84\x38\x98\x39\x20\x3c\x17\x36\x03\x36\x19\x36\x30\x8f\xcb\x69\x0f\xdf\x01\xce\x03\x2c\x07\x18\x98\x19\x38\x81\x7c\x5e\xa7\x3d\x0c\x0e\x20\x08\xe1\x33\x33\xb8\x6c\x54\x61\xec\x08\x8c\xd8\xe0\xd0\x11\xb1\x91\x39\xc5\x65\xa3\x1a\x88\xb7\x8b\xa3\x81\x81\x91\xc5\xa1\x23\x39\x24\x02\xa4\x24\x12\x08\xb6\xdb\x70\x68\x70\x88\x70\x30\xf3\x68\xed\x60\xfc\xdf\xba\x81\xa5\x77\x23\x13\x83\xcb\x66\xd6\x14\x36\x06\x17\x17\x00\x1b\x03\x2a\x12\x00\x00"
 var assets_13 = "\x77\x4f\x46\x32\x00\x01\x00\x00\x00\x00\x8f\xd8\x00\x11\x00\x00\x00\x01\x7a\xa8\x00\x00\x8f\x75\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x74\x1b\xc5\x7a\x1c\x9f\x6a\x06\x60\x00\x96\x20\x08\x81\x18\x09\x9a\x16\x11\x08\x0a\x83\xf2\x50\x83\xb1\x78\x0b\x8c\x40\x00\x01\x36\x02\x24\x03\x98\x7c\x04\x20\x05\x8c\x6f\x07\xb2\x5c\x0c\x81\x23\x5b\xe9\x52\x71\x04\xdd\xb6\x1d\x53\x8b\xf4\x66\x15\x5d\xd0\xdc\xf9\xef\xe5\x00\x6e\xee\x10\xa1\x53\x6f\x86\xed\x53\x5d\x85\x40\x15\xca\xb6\x5d\x8d\xdc\x0e\xac\xfe\x4c\xff\xfd\x65\xff\xff\xff\xe7\x27\x15\x19\x33\xed\x30\x69\xc7\x00\x00\x05\xd1\xfb\xeb\x7f\xd0\x93\x14\x49\x08\x35\x13\x81\xbe\xaf\x6c\xd9\x98\x03\x87\x3a\x4e\x73\x1b\x1b\x85\x91\x56\x0d\x81\x18\x89\x05\xc1\x25\xda\xb4\x3a\x13\x31\x78\x1b\xe4\x91\x9e\x3a\x1c\x5c\xd6\x85\xbc\x5a\x82\x37\xd9\xd0\x2e\xe7\x00\x71\x77\xd6\x5a\x3b\x50\xd9\xf9\x18\xf6\x9c\x4d\xc5\x9e\x4f\x5b\x85\x10\x66\x7c\xbd\x1a\x77\x1a\xca\x0d\xc9\xbf\x96\x7e\xb7\x01\x2b\x26\xc4\x3d\x11\x5c\x11\x7d\xe7\xa7\x8a\xb3\x27\x7a\xaa\xff\x61\x20\x91\xef\x8a\xb3\xc7\x07\xaf\xb4\x35\x12\x81\x9b\x09\xbc\x32\x6f\xf3\x8c\x1c\xab\x86\x13\xd5\x04\x0e\x7c\xc2\x0c\xe0\x26\x5b\xe9\x89\xe5\x41\x6f\x62\xa1\xec\xaf\x5a\xdb\x46\x9c\xcf\x1d\xb4\x47\xd5\x2a\x9b\x96\x96\xbe\x10\x90\xdc\x0d\x2a\x7f\x98\xf0\xfe\xde\x1b\xe4\xd1\x8f\x8b\x49\x2c\x7c\xb2\x68\x67\x71\x14\x91\x1d\xed\x3b\x0f\x9a\xab\xf4\x39\x14\x53\xa5\xfb\xba\x80\xb5\x83\xb7\x59\xac\x2e\xf8\xe1\xff\xbb\x6f\xf2\x42\x56\x01\xe5\xd8\x9c\xd2\xc8\x4a\x72\xde\x5c\x5f\xa8\x07\xbb\xff\xaf\xba\xce\x3c\x7e\x00\xb3\x54\x04\x33\xda\x48\x70\xb6\x11\x20\xcf\xfd\x79\x7e\x6d\xfd\x39\xe7\xde\xa9\xc7\x14\xe1\xd0\x43\x8a\x88\x7f\xf8\x8a\xd8\xe3\x2e\x16\x1b\x8d\x1b\xd8\x8d\x91\x83\x95\xb8\x68\x83\x63\x62\x60\x17\xb8\x85\x0d\x98\x80\x31\x1f\x57\x3e\x51\xc2\xd0\xc3\xc0\xcc\x83\x1e\xb8\x8f\x36\x33\x6f\x77\x2b\x0c\x24\xc3\xc8\x4a\x03\x0d\xf1\x3a\xb1\xf4\x14\xbd\xaf\x3f\x80\xdb\x1c\xda\xbc\x37\x60\x98\xc5\xcb\x0e\x1e\xed\x7d\x36\x4f\x84\x17\x46\x63\x34\x46\xa2\x8d\x3d\x44\xb0\x1a\xf9\x8d\xca\x97\x51\xaf\x1e\x80\xb9\xb9\x11\x63\x43\x89\x31\xc6\x46\x8d\x55\x36\x0c\xc6\xa0\x47\xe4\xa8\x52\x4a\x0c\x14\xc5\x88\xbc\xde\xce\xdf\xea\xf3\xdf\x8e\x87\x3e\x52\x78\xfa\xff\x43\xf2\xce\x7b\x5b\x68\x05\x17\x68\x53\xb0\xb4\xe3\xa5\x89\xc5\x2c\xc2\xa5\x89\x44\x24\x1e\x8a\xff\xf9\xf9\xdc\xca\xf7\xae\xef\x5d\x93\x8c\x0b\x36\xe2\x12\xf8\xbb\xdb\xdb\x65\x7b\x6d\xaf\x04\x86\x9a\x8e\xc9\x1a\xf8\x40\x05\x0b\x1a\xb1\xaf\xfd\x76\x0f\xb1\x68\xb6\x6c\x23\x11\x3a\x43\x23\x35\xd2\x77\x45\x43\x0b\xd7\xe8\xf0\x3c\xfd\xd1\xfe\x9e\xb6\x9d\x02\x0a\xa4\xc8\x0b\xa3\x26\x92\xc4\xb3\xc6\x37\xdb\x35\x2a\xd3\xfa\xcb\xf3\xf9\x74\x66\x7f\xa4\x11\x58\xc4\x6b\x81\x65\x91\x65\x84\xe5\x09\x30\x14\xd5\xbd\x34\xcd\xa5\xab\x72\xdc\x9e\xf5\x99\x6a\x5f\x55\xdd\x3d\x33\xbb\x30\x34\x70\x04\xcd\xe1\x28\xf9\x33\xce\x01\xda\x33\x96\x8f\x11\xb4\x72\xcb\xbd\xc8\x04\x11\xbe\x91\xc2\xe0\x42\x41\xb9\xed\x78\xd6\x9b\x59\x58\x31\xf5\x0b\xa5\x2b\x0e\xfe\xff\x39\x95\x21\x16\x90\x4d\xa1\x2b\x1f\x29\x9a\x13\xe2\x50\x00\x47\x37\x60\xf7\x6b\xf7\x3b\x6c\x53\xd1\xd7\x1e\x2f\xbb\xdf\x44\x34\x40\x3d\x6b\xaa\x76\x83\x14\xd2\x05\xb1\xfa\x73\x44\x6e\x3b\x3e\xd7\xb1\x24\x06\x75\xa1\x36\x75\x3f\xe9\x35\x01\x0c\xa8\xff\x3a\xdb\x65\xea\xeb\x32\x0a\x01\xea\x34\x9f\x80\x13\x4b\x76\xc3\xa8\x3e\x6d\x3c\x4c\x1a\xa6\xff\x7f\x39\xfb\x27\xa9\xe8\x34\x68\x88\x11\xe1\x02\x91\x9a\x3c\x31\x76\xef\xcb\x62\xb7\x9a\x33\xa7\x1d\x78\x4c\x7f\xe5\xdb\x6f\xc9\xe6\x25\xd2\x61\x10\x8b\xf6\xba\x10\xaa\x68\xea\x4c\xec\x99\xed\x53\xeb\x98\xd1\x0c\xf7\xe2\x2b\x11\x3b\xd9\x18\x6b\xa7\x02\x51\xf8\x56\xd4\x29\x6a\xba\x6a\xd8\x38\x38\x71\xee\x42\x36\x01\x84\x72\xbf\x4a\xd5\x9a\x06\xc4\x0b\xdd\xc0\x8f\x14\x37\xc4\xa8\xb3\x24\xe7\x30\x33\x0d\x12\xb6\x29\x89\xb6\xe1\x33\x1e\xaf\xcb\xc0\x17\xa8\xfd\x89\x74\x8a\xb2\x42\xdc\xec\x90\x02\x40\x5a\xce\x3a\xb9\x8a\x55\xab\x91\xb9\x85\x9c\x6f\x26\xbb\x03\xc7\x6c\x5d\x4c\x61\x73\x5b\x2e\x10\x1a\x7e\x53\x2e\xc6\x99\xa6\x33\x03\x2a\x3e\x2d\x31\x19\xfd\x6d\xfb\xeb\xb0\x60\x89\x43\x23\x86\x72\xb0\x9b\xd2\xc5\x04\x65\x70\x7b\x96\x50\x82\x98\x95\x4b\x95\xde\xa5\xa1\x56\xcf\xe5\xe6\xe4\x72\x0b\x0d\xd8\xfa\x01\x0a\x4a\x97\x9c\x64\x78\x42\x60\x3a\x1c\x1c\x40\xbc\xe6\x68\x95\x84\x32\x83\x14\x59\xb1\x7b\x13\xad\x01\x82\xc5\xb5\x8a\x33\x0b\xa4\xce\xe8\x40\x43\xb2\x29\x18\x7f\xf2\xc1\xff\x6f\xfa\xa9\xdd\xe7\x69\xfe\x3d\x96\xdd\xe5\x7f\xb6\x40\x37\xb9\x55\x40\xdc\xb7\x40\xf4\x35\x23\xad\x3c\x1e\x3d\x97\xae\xd5\x91\x4b\xad\xbb\x5d\x5f\x4e\xfd\x28\xad\xc1\xc0\x4a\x7b\x81\x39\x01\x28\x00\xe6\x84\x82\x10\xb6\x80\x06\xb0\x10\x1f\x7f\x23\xad\x1d\x7c\x98\x04\xbe\x98\x53\xfa\x25\x08\x43\xa8\x84\x12\xd3\x33\x3c\xff\xdf\xbd\x38\x9b\x97\xdb\x91\x51\xc6\xeb\x98\xce\xd4\xca\x92\x74\xe6\x76\xfe\xc7\x4c\x60\x0b\xd7\x0a\xc3\x5a\xc6\xe0\x74\x15\x07\xa9\xf1\x06\xeb\x11\x42\x60\x24\xf0\x7c\x4e\x51\x6c\xfd\x48\x2f\x5b\x28\x1d\xb0\x57\x54\x02\xc3\x16\x2a\xc0\x25\x24\x91\xe4\x53\x96\xf3\xb3\x75\x57\x98\xb2\x16\x16\x10\x2b\xd3\xea\x76\x00\x2a\xcf\xd3\xdf\x9b\xba\x67\xfe\xbc\xa4\xa3\xff\xae\x90\xd2\x2f\x41\x84\xeb\xdb\x94\x0a\x12\xa1\xa0\x06\x44\x83\xbb\xf1\x9f\xbf\x77\x7a\xce\xb9\xf7\xfd\x15\x7d\xf4\x38\x8f\x6c\x8c\x65\xf1\x1c\xc8\x11\x05\x9a\x35\xff\xa6\x5a\xb6\xff\x0f\x00\x11\xa4\x36\x10\xcb\x0b\xd4\x45\xf1\x9c\xa4\x4b\xa9\x26\x95\x36\x54\x0e\xb9\x74\xe9\xa2\x9a\xf9\x81\x83\xf9\x83\x01\x06\x33\x04\x03\x00\x52\x20\x40\x2a\x00\x5c\x09\x04\xb8\x32\x49\xc9\x3a\x0c\x07\xd0\x0e\x82\x64\x2a\x6c\x0a\x19\x04\x95\x29\xe9\xb4\x90\x36\x6a\xb5\x17\x72\x70\x4a\xba\x73\x0c\x55\x4a\x9d\xab\xb5\x2b\x97\x7e\x57\x56\x4e\xa1\x0c\x9d\x5d\xf5\xae\xcb\x5c\xe5\xca\x7e\xdf\xd4\xc4\xbe\xd1\x9e\xae\x74\x39\xcf\x30\x28\x00\x05\x10\x6f\x5a\xdd\xf7\x0e\x1d\x62\xf1\x6a\xe6\xfd\x2b\x9a\x28\xbd\xa0\x52\x01\xb3\xa2\x80\x1d\x3b\xbd\xe2\xc0\xf3\xb5\x54\xa9\xca\x3e\xd7\xce\xe0\x9d\x04\x48\x43\x41\xec\xbc\xfb\x57\x67\x95\xef\x76\x59\xb7\x93\x52\x4a\x43\xae\xcc\x66\x1a\x23\x85\x35\x14\xc0\x12\x9c\x65\x4d\x33\x81\x30\xac\xb8\xd0\x5d\x2b\x48\x90\xc7\xeb\xde\xe7\x3b\xdf\x0a\xe7\x49\xb0\xe9\x91\x40\x43\x30\x21\x98\xc1\x18\x23\x8c\x10\xc2\x35\xc1\x64\xaf\xe9\x1d\xfa\xd5\x0e\x63\xaa\xa5\xd1\x9c\xd7\xec\x66\x5d\x7a\x3c\x7c\x45\x8e\x20\xa0\x92\xb3\x73\xef\xcf\x7d\x7f\xe0\xc1\x2d\xa5\xec\x8a\x10\x91\xa2\x52\x42\x49\x9f\x49\x82\xee\xe7\x83\x6a\x2b\x2c\xd5\x42\x7e\x79\x1e\x3e\x8e\xe1\x18\x44\x44\x44\x64\x58\x44\xe4\x88\xdf\x2f\x57\xbd\x22\x3d\x93\xb6\x8c\x18\x6d\x02\x51\x02\xa1\x4d\x4e\x7b\xb8\xfc\x22\xfb\x37\x23\x00\x23\x01\x60\x05\x84\x14\x9e\x37\x60\xde\x3a\x01\xe5\xbd\x33\xa0\x43\xbb\x0c\xfa\xb4\x4d\x58\xb9\x95\x1c\x0c\x42\xf0\x69\xbd\x90\xcf\xeb\x83\xf9\xa2\x09\xc8\xf7\x4d\xc2\xb4\x34\x0b\xfa\xb1\x05\xd0\x92\xd6\x21\x6b\xdb\x80\x69\xcd\x0a\xd5\x5a\xb2\xfb\x63\xc3\x5c\xcb\x37\x48\x49\x2d\x49\x2b\x2b\xbb\x12\x23\x67\x65\xcf\xc6\x0a\xa8\x93\xd2\xb7\x6f\xec\x90\x56\x02\x56\x3e\x8b\x54\x60\x25\x5d\xa5\xd0\x5a\x5a\xa4\xd5\xda\xd9\x8c\xb6\x0e\x2f\xfb\xd9\xe3\xcd\xdc\x3a\x9f\x52\x8e\xff\x2b\x73\xb9\xc4\xe0\x68\x12\x86\x4d\x86\x51\x93\x63\xdc\x14\xd8\x6b\x4a\xd8\x6f\xca\x38\x68\x2a\x38\x3c\x5f\x61\x02\xef\xd7\x54\xd0\x09\x29\xae\x8c\x2e\x44\xb4\x24\x2c\x88\x12\x35\xab\x20\xd5\xad\x0e\x23\x16\xac\xd7\xaf\x04\x1c\x41\x12\xa0\x50\xc9\xc8\x84\x40\x2f\x46\x4a\x16\xdb\xe0\xbf\xc3\x91\xc4\xef\x93\xa2\x46\x47\x2f\x4c\xa4\x9e\xb8\x8f\xa4\x2b\xcf\x35\x1e\x69\xdc\x19\x2b\x3b\x8a\x6b\xa8\xdc\x59\xf7\x38\xfe\x99\xcf\x00\xe7\x6c\x7b\xac\x53\x1c\x25\x2f\x75\x83\x89\x04\x13\x0d\xa6\xef\xb6\x66\x0c\x2c\xe7\xc0\xc4\xd2\xb7\x14\x47\xf1\x3c\x3a\xa5\x89\x3c\x3d\x77\x6f\x02\x2c\x57\x80\x8c\x25\x9a\x4c\x33\x69\xbe\x5c\xa4\x4a\x49\xf7\x30\x8b\x28\x91\xd6\xd0\x26\x4a\x5e\x7f\xb7\x5c\x94\xb3\xdc\xb2\x28\xc6\x81\xcc\xf8\x96\x23\x99\x17\xd5\xd8\x23\xfb\xb5\x0a\x6c\xe0\xc4\xf8\xb5\x3b\x02\x05\xc4\xcf\x9f\x17\x1f\xd9\xe0\xa2\x1c\xcd\xec\x7b\x64\xfc\x98\x82\xd5\xa8\x1a\xb5\xcf\x26\xc7\xe1\x53\xc1\xee\x0f\x00\xe9\xc9\x01\x62\x54\xfc\xf4\xbe\xb9\xc7\x55\x86\x8f\xe9\x12\x9c\x6b\x91\x56\xa2\x94\xa0\x84\x1a\x2a\x6a\xcb\xf8\xe1\x7c\x8b\xde\x84\xaf\x34\x22\xcd\x61\x1f\x46\x2c\xbc\x11\x09\x29\xdd\x40\x21\x3e\x92\x61\x3f\xe0\x35\x53\xb9\x1d\xcf\xad\xc7\x0b\xb0\xa8\xb9\xeb\xe9\x42\x21\x5f\x5e\x50\x62\x06\x16\xfb\xab\x1c\x33\xde\xf6\x60\x13\x3b\x40\x91\x1a\xad\x84\x56\x8c\xe2\x14\x25\xcf\x0d\x91\x1b\x30\xfc\xb7\xce\xda\xf2\x50\xaa\x24\x28\xa1\xba\x35\xcc\x40\x28\xce\xa4\x8e\x3d\xb5\xbb\xe7\xa4\x91\x31\x59\x12\xd1\x1c\x05\xdb\x83\xce\xf3\xd5\x9c\xb9\x2d\xbb\x37\xfb\xc1\xb5\x13\x88\x49\xbe\xae\x1a\x00\x48\x03\xc0\xa3\x6a\x24\x7a\xc4\x03\x72\xa4\x17\xe4\x4a\xde\xeb\x07\xc8\x07\xb9\x08\x88\x4d\x91\x08\x0a\x83\x25\x2b\xa6\xfc\xa0\x66\x7b\xf4\xfe\xb9\xdf\x7c\xa5\x57\x7d\xf5\x47\x0b\xbf\x73\xfa\xb3\xc5\xe6\x9c\xcb\xf0\x39\x88\x66\xd5\x02\x1d\x7e\x04\x0f\xe1\x75\x15\x69\x24\x88\xa6\x2f\x31\x36\x36\x79\x4b\xe0\x5b\xe2\x88\xb7\xa3\x2b\x31\x13\x99\x6e\x12\xa2\xf2\x09\x0d\xfb\x88\x38\x07\xdd\x37\xa5\x1f\x04\x09\x43\x63\x0b\x9b\x63\x35\x09\x43\x86\xd6\x1e\x6c\x14\x92\x6e\x4a\x2e\x41\x74\x1a\x30\xa9\x2a\x5c\x72\x4f\x2b\x11\x9b\xc8\x4a\x96\x6d\x47\xaa\x66\x3a\x7e\x4e\x2d\x9a\x26\xf9\x75\x19\x58\x98\x48\x91\x1e\x51\x60\x0d\xe5\xd4\xd0\xe8\xec\x45\x38\xac\x74\x8c\x17\x54\x76\x76\x11\x02\x0b\x84\x27\xe4\x51\x15\x42\xab\x2d\xc1\x70\x62\xae\xd5\x73\xcf\xcb\x90\x6d\xee\xb6\x55\xce\xe9\x17\xbf\xd4\xc9\x81\xc9\x22\x40\x31\x15\xd4\xd1\x7c\xd2\xc9\x95\x96\x67\x2f\xbd\x0c\x31\x13\xe7\xe9\xdc\x16\x0a\x14\xcf\x26\xe5\x02\x62\x85\x9c\x7e\x2b\xf2\x94\xd7\x48\x6a\x32\x2e\xfd\x13\x35\x0a\x02\xdc\xad\xf1\x50\x9f\xa2\x91\x38\xc3\xfd\x48\x17\x31\xa8\xf6\x85\x80\x9f\xb7\xd4\x85\xd4\x56\x25\x0c\xd4\xb6\x8e\xb2\xbb\x7d\xe9\xcb\x03\x9b\x73\x7a\x82\x4d\xf8\x1c\x21\xd5\x48\x10\x4d\x5f\x62\x5c\xac\xf7\xcd\x50\xa8\x95\xcc\x13\xf8\xd6\xc6\xd5\xde\x1e\x2d\xde\x07\x09\x3f\x8b\x3f\xdc\x68\x6f\xf1\xa4\xd2\xe8\x5e\xcb\x7b\x54\x8b\x56\xa6\xd9\xb4\xff\x13\xea\xf1\x93\x9f\xde\x2e\xa0\xf5\x0e\xe0\xc5\x45\x8c\x44\xc1\x6c\x27\x30\x48\xa3\x28\xea\xed\x1e\xb1\xe8\xb6\xc6\xa9\x58\x42\xb4\xc4\x7a\x40\x82\xf9\xe2\xf6\xee\x1d\xc7\x32\xa8\x7f\x0d\x76\x70\xdf\x4c\x84\x45\x27\xe7\xbd\xf8\x22\x3f\xb6\x70\x89\x39\x7f\xf0\x97\xfb\xe2\xc1\xa2\x36\xc6\x19\xd1\x75\x05\x3b\x8c\xf7\x83\xe4\x73\x32\xe3\xe5\x81\xcc\x6e\x5b\x53\x5d\x06\x56\x99\x0d\x51\xc1\xf8\xef\x82\x2b\x5c\xf3\x87\xca\x9c\x32\x6d\x94\x2c\xfc\x92\x4f\xaa\x0b\xd3\x68\x26\x91\x34\xb2\x0d\x32\xae\x6b\x79\x70\x3f\x3c\xa8\xec\x0e\xae\x40\x2d\x4d\x05\x0a\x78\x1c\x38\x70\x5d\xa8\x5a\xe7\xcd\xd6\x25\x20\x90\xb6\xa1\x47\x12\xbf\x26\x02\xc7\x8e\x7d\x7e\x9d\x77\x88\x07\xff\x52\x6a\xaa\x5f\x00\xd1\x69\x06\xf5\xd8\x9c\x73\xe8\x4b\x4c\x4d\x2a\x10\x5a\x74\xf8\x69\x70\x1d\xef\x08\x27\x92\x68\xdb\xb7\x12\x13\x43\x6c\xf7\x6d\x5c\x6f\xb0\x0e\x27\x95\x28\x5c\x92\xe5\xc6\x9f\x77\x74\xc1\xdc\x8d\x07\x8f\x84\xaa\x34\x6c\xa6\xa9\x3b\x26\x74\xd5\xff\xba\xa9\xc9\xf0\x08\xdc\xfa\x9a\xd8\x85\x35\x23\x82\x23\xa4\xcb\xb9\x36\x36\xd5\x2b\x30\xb7\xe6\xd8\x06\x68\x16\x78\xc2\x73\xf2\xb1\x68\x91\x02\x66\xca\xa9\xa1\xd1\xd9\xcb\x00\x56\x3a\xc6\xf2\x94\xdf\xe1\x40\x1c\x1e\x27\x8f\x43\xd5\xed\x67\x04\xa0\x8d\x21\xe6\xd8\x0e\x87\x3b\xbb\x07\x1f\x9d\x9d\x73\x0e\xd9\xa6\xe6\xf2\x16\x10\x7d\xbb\xb1\xab\xa0\x40\xbb\xe5\xde\x4f\x5e\xd1\x2e\x4d\x5b\x59\x5d\xd6\xba\x04\xd1\x74\x4f\x9d\x32\x01\x7f\x22\x45\xc6\x71\x73\x5b\x9d\xb3\x7a\x02\x3f\x0d\x69\x75\x88\xfc\xb2\x49\x94\x52\x8d\xc7\x7b\xd6\x0e\xec\x94\x14\x65\x04\x71\x22\x8f\xd0\xdc\x42\xf2\x9b\x06\x33\x5a\x76\x65\x5a\x73\xc0\x97\x96\xb6\xab\x20\x03\x3a\x96\xb6\x33\x38\x79\xeb\xed\xed\x2a\xf3\xee\xd8\x7a\xf0\x2f\xa5\xce\xb9\x5c\x3e\x5f\x2b\xac\x02\xd0\xa2\xc3\xcf\x05\xfb\x35\x6b\xbb\x16\xef\x08\x97\xc8\x7b\xbc\x3d\x56\xbc\x5e\x3a\xc8\xb7\xc5\x93\x40\xb4\xf6\x2d\xa8\xfc\xf8\x81\xda\xa8\xfa\x08\xaa\xb5\xd4\x83\xf1\x31\x81\xe1\xc3\x7c\x05\x41\xec\x8e\xeb\x3d\xb8\x27\x69\x90\x66\x08\x7a\x61\x05\x9e\xd6\x5e\x4f\x0d\x7f\x2b\x82\xdb\x63\xe4\x28\x16\x55\x7c\xa0\x3c\xd6\x87\xce\x9a\xd7\x7f\x12\xbf\xbc\x4e\x67\x89\xb8\x25\x3b\x1d\x7d\x7a\x0e\x7d\x85\x4f\x96\xae\xf2\x57\x55\xeb\xd3\xcb\xf3\xd9\x69\x36\x21\x3f\x8d\x76\x87\x6f\x57\xad\xab\x6e\xe7\x97\x88\x7e\xe2\x09\xaf\xdc\x17\xbf\x48\x13\x6c\xb3\xa1\xae\x2c\xc8\x10\xe6\xbb\xad\xab\x9d\xab\x54\x59\x2d\xc7\xdb\x33\xa9\x5e\x02\x37\x4c\x46\x50\x28\xab\x30\x2d\xe5\x93\x93\xae\x2d\xff\xa8\xcc\x51\x8b\x3d\xb0\x49\xac\xbe\xa8\x6a\xe6\x89\xfb\xe2\xe1\xd8\x29\x59\x1c\x4e\xa0\x3b\xab\x17\x89\xeb\xdc\xe5\x9e\x3c\x69\x5f\x11\x1f\xf8\xc4\x5f\x69\xf6\x7b\xbf\x78\xdb\x1b\xcc\x77\x7e\xdc\x5c\x42\x46\xc0\xe7\xaf\x85\x8e\xbd\xf6\xc1\xf3\x61\x7f\x2e\xf6\xc7\x91\xfc\xe9\x58\xc6\x7e\x76\xe8\xcf\xbc\x4a\xf0\x25\x81\xb9\xbf\x8c\x57\x9d\x8d\x41\xf4\x6d\x50\xb6\xb5\xda\xcc\xf6\xde\x6e\x62\x43\x5f\x65\xa7\x13\x22\xea\x09\x69\xf0\x94\x14\x2d\x5d\x99\x61\x23\x47\x9d\x83\x5f\x05\xd8\x3a\x84\x08\x28\xe8\x0b\xec\x70\xc2\x8f\x51\x51\xc9\x9f\xd9\x95\xe0\x4b\x26\xe6\x99\x8b\xbd\xac\xa5\x5b\x6e\x46\x49\x1f\x22\xf6\xee\x96\xa1\xd0\xae\x71\x10\x03\xaf\xf2\x37\x42\x05\xdd\x13\xba\x22\xf5\x60\x1a\x24\x85\x4a\x5f\x68\xf3\x1e\x21\xea\x1a\xa0\xe0\xca\x82\x5c\xa6\x9a\x54\x00\xe8\x6e\x52\x28\x17\x24\x70\x3f\xe3\x04\x45\xe7\x97\x25\xad\xb3\x94\xb4\x14\x3d\xc7\xf5\x15\xb5\xa8\x45\x2d\x37\x76\x8e\xef\x9b\xef\x9f\xe6\x6f\x48\xfb\xbc\xb6\xc4\xa6\x3e\x49\x6d\x21\x48\x3a\x65\xcb\xd7\xc6\x2c\xaa\x6b\xd5\x66\xc6\x8c\xe6\xf1\x68\x5c\x4b\x7b\xac\xa7\x57\x0b\xb4\x79\x61\x40\x27\xba\x29\x73\x62\x2c\x25\xc8\x52\xda\xdb\x93\xb2\x12\xb9\x2a\xc7\x54\xe3\xb3\x03\x7f\xe2\x89\x16\x91\x94\x20\x25\x9d\xb1\x5f\x62\x66\x9e\x6d\x63\xb9\x76\x97\x07\x1d\xb9\x96\x42\x46\x1f\x72\xce\x37\xd1\xe8\xce\x12\x69\xcf\x07\x1e\xb5\x10\xb4\x0f\x71\x2b\xa9\x52\xda\xbb\x5c\x95\x32\xfb\x0c\x7b\xc9\x1e\xa0\xb8\x68\x3a\xed\xc1\xc1\x79\xa0\xdf\xa4\x9e\xb1\xce\x05\xa9\xdc\xa8\xfa\x6e\xd4\xd2\xdd\x5c\x6d\x9e\x6a\xc8\xea\x32\x2e\x7c\x3c\x64\x1c\x37\xe8\x48\x6e\x81\x4e\x8d\x0d\x8f\x0e\x37\x93\xa4\xb1\xd1\xc5\xe0\x73\x33\xeb\x28\xa4\xa5\xf3\x52\xdb\x68\xd4\xed\xd7\x93\x1e\x02\x55\xc4\x75\xc0\x84\x76\xdd\x20\xb3\xef\x5c\xa2\xc5\xcb\x6c\x38\xb0\xcf\x9f\x44\x03\x8a\x0b\xdf\xed\x65\xf4\x5e\x0d\xb5\x8b\xe3\xa2\xc6\x89\x5c\xd3\xa9\x11\x98\x2f\xd2\xcf\x10\xc8\xe3\xa2\xa7\xb7\x26\x4a\xa6\x5a\x37\xf6\x68\x0c\xb9\x48\xaf\x56\x1b\x77\x9d\xc2\x73\xb8\xb7\xa6\x48\x2c\xdc\x3b\x0a\xbd\x90\xc2\x43\x78\xd5\x6e\xbf\x0b\xd3\x9a\xa0\xf0\x06\x56\x80\xdb\x37\xd2\x68\x58\x86\x4c\xad\x62\x21\x8b\x22\x8b\xa8\xcf\x4f\x8c\x69\x35\x43\x9e\x6b\x72\x47\xd7\x8c\xda\x58\x34\xcb\x2e\x92\x70\x3f\xdc\xb0\xbb\x18\x6a\x6a\x62\x8e\xbc\x30\xa2\xe5\x97\x39\x7e\xd9\x24\xdf\xea\xb5\xa9\x75\xc6\x54\x1b\xb3\xc3\x70\x97\x64\x21\xff\x06\xa6\xd9\x08\x07\xf0\x0a\x8b\x5f\x6f\x16\x15\x34\xb2\x8e\xe3\xb1\xa2\xe1\x3b\x28\x81\xc3\x13\x49\x54\x3d\xc2\x98\xe2\xfe\xf3\x94\x96\x66\xa7\x8d\x04\x5b\x4b\x55\x0f\xf0\xba\xab\x98\x47\x39\x31\x6d\x64\xba\xa6\x86\x45\xad\x4d\xb5\x5e\xf3\x6a\x23\x04\x6a\x90\x9f\x9a\xe6\x3c\x27\x44\xe3\x44\xad\x91\x77\x5d\x6f\xd7\xd1\x37\x39\x3d\x59\x7f\x55\x58\xe6\x5d\x37\x01\xbc\x94\x63\x99\x94\x16\xf8\x11\x89\x52\xcb\xa6\xc2\x62\x2b\x8d\xab\x65\xfe\x39\x7c\xac\x0e\x2c\x35\xe6\x3c\x29\x1c\xd4\x76\x82\xc9\x5d\xa4\xd3\x05\x1d\xc4\x79\x75\xbe\x20\x84\xd5\x1c\x27\x04\x49\xd4\x5c\xc0\xd7\x36\xb7\x01\x36\xfa\x82\xfb\x94\x7f\x5d\x49\x18\x29\xc0\x22\x80\xa5\x00\x2b\x00\x56\x04\xac\x3e\xff\x1e\x52\xfe\x23\x4a\x6f\x9f\x19\xec\xeb\xaf\xc4\x52\x48\xa2\x2f\x89\xc3\x93\xb3\x21\x1d\x99\x89\x83\x83\x1b\xfe\xaa\xc9\x68\x66\x0c\x35\x5d\x98\xbc\x23\x1f\x9d\x7c\x31\xcb\x77\xf2\x8b\xfc\xe5\xba\x98\xa6\x0d\xa7\x07\x7f\xeb\xb6\x43\x5f\xeb\x7e\x3c\x5a\x6a\x93\x15\x64\xc5\xed\xab\xcf\xce\xe7\xb0\xfc\x00\x96\x75\xb5\x77\x71\xf2\x4e\x7a\x32\x5f\xce\x8d\xf7\xff\x5d\x03\xdc\x72\x4f\x96\x27\x9e\xcb\x67\x51\xa4\x5c\x8d\x46\xb0\x23\x60\x3f\x2a\xd1\x2d\xf8\xc8\x47\x35\x84\x68\xc7\x30\xe6\xb1\xd5\xac\xd3\x2c\x03\x16\x5b\x61\xdd\x6b\xc1\x23\x39\x8d\x9a\xbd\xcb\x8b\xee\x0d\xa5\xe3\x51\x68\xb7\xa0\x21\xd5\xf6\x94\x8c\x8d\x13\x8d\x4c\x94\x9c\xe8\xba\xe5\xae\x74\x34\xa1\x02\xff\x86\x8c\x16\x96\xdb\x3a\x17\x91\x45\xa9\x84\x1c\xda\xab\xd9\xa5\xee\x83\xfd\xbb\x38\x82\x1e\x6a\x62\x48\x99\x67\x10\xf7\x25\x19\xf3\x15\xa1\x69\xcd\x8c\xa6\x0f\xd9\x67\x25\x73\xeb\x03\x68\x38\x99\x22\x3a\xe7\xab\x02\x36\x3c\x12\xea\x83\x95\x31\xd1\xf4\xcb\xb9\x7f\x13\xff\xa4\xdb\xab\xce\x77\xe7\xf5\x64\x88\xdc\xe6\x9e\x3c\xb4\x79\xc2\x73\x5e\xf1\x96\x0f\xf2\x59\xea\x9b\xfc\x94\xf3\xc7\xf9\x7f\x4c\x83\x46\x9b\x58\x4d\xe7\x7d\xfe\xf3\x59\xeb\x2d\x04\xcb\x5c\xed\x5d\x9c\xdc\x96\x3b\xf9\xaf\x86\x0b\xed\x8f\x94\xec\x5c\xff\xa5\xa6\x3a\xe4\xb8\x33\x2e\xb9\x21\xc3\xdf\x1e\xc9\x1d\x8f\x45\x35\x0b\x9c\xd8\xf0\x5c\x1c\x6a\x28\xe9\x62\xa8\x8e\x8c\x75\x2c\x93\xa2\xe8\x55\xca\xd2\x4e\x84\x73\xbd\x3e\x05\x82\xb5\x02\x7d\x8f\x13\x3e\xfa\x05\xf6\x6e\x86\x10\x7e\x46\x41\x4f\x16\x1f\xbf\x3c\x25\x82\xca\x54\xca\x84\x76\x32\x00\x0c\xa2\xb0\xdb\x1a\xce\xef\xaf\xd9\x21\x49\x46\x41\x81\x43\xa3\x0b\xcf\x50\x69\x0a\x65\x95\x95\x57\x45\x15\xe5\x57\x55\x5d\x05\xe9\x27\x24\xa4\x54\xa3\x95\xee\x58\x2d\x4d\xa4\x0c\xa5\xa2\x94\x27\x9c\x13\x89\x1a\xa6\x31\xb7\xa8\xc6\x21\x46\xc8\xd8\xf9\x4a\x29\xa7\x9c\xd4\xe5\x96\x9b\xa6\xbc\x4a\xd3\x56\xd6\x40\x47\x9b\x52\x60\x0f\x1a\x2e\x38\x72\x23\x45\xe5\xd8\x82\xe2\x7a\xd1\x92\x32\x72\x6a\x7d\xa3\xf9\x34\xdd\x74\x95\x22\x43\x90\x96\x16\xaf\x2e\xc0\x21\x24\xb7\x57\x08\xf7\x56\x28\xb9\xa5\x42\xcd\xfd\x14\x78\x1f\xf9\x4c\xdf\xa5\x28\xae\xcf\xa7\xea\x8f\xf6\x97\xe7\xe0\xbb\x12\xb4\x0e\x7e\x8b\x5e\x07\xff\xdd\x75\x2d\x7a\x08\xf3\x79\x93\x6d\x80\xd0\x3e\x88\x84\xa1\xe2\x31\xe0\xd6\xcb\x04\xe5\x9d\x76\x6b\xcc\xb7\xc1\xaf\x1e\x50\xc8\xa4\xf4\x9c\xfc\x4a\xd5\x69\xd7\x2f\xe4\x36\x8c\x65\x5b\xb7\xad\xb6\xb3\x7a\x95\x89\xce\x30\x0b\xbc\x36\x90\xbf\xaf\x1d\x64\xa0\x90\x31\x70\xc9\x15\x54\xaf\xc3\x80\xd0\x59\x1e\x5e\xcb\xfb\xae\x83\x46\xc5\x25\x67\xe4\x16\x50\x26\xa4\xd3\xa0\xb0\xf2\xc1\x31\x2a\x9e\x30\x68\x78\x14\x4c\x3c\xf2\x94\x6b\xd0\x65\x8a\xf0\xc7\xc1\x71\x6a\x5e\xb0\xe8\xf8\x94\xcc\xbc\xf2\x55\x68\xd4\x6d\x08\xe3\x21\x49\xb6\xfb\xee\x0d\x87\x41\x40\xc5\x22\x53\x81\x4a\x4d\x7a\x4c\x15\x81\x74\x29\xad\x14\xf8\x7b\x22\xbb\x57\xb3\xca\x52\xa8\x4a\xb3\x49\xa6\x89\xc4\xc1\xc9\xb8\x4a\x45\xc0\x22\xa2\x61\xe3\x53\xa4\x5a\x8b\xc9\xa6\x8b\x7a\x1c\x9c\x9c\x9b\x34\x44\x6c\x62\x5a\x76\xd9\x8a\xd5\x68\xd5\x6b\x86\xe8\xc7\x6b\x46\xc1\x5d\x3a\x12\x0e\x09\x1d\x87\x1c\x25\x6a\xb5\xe9\x33\x2c\xc6\x09\xbb\x1b\xb1\x19\x3f\x5d\xc3\xab\x86\x2e\x44\xb4\x24\x2c\x88\x12\x35\x43\x70\x23\x2b\x9f\x1d\xfa\x8c\x98\xb2\x60\xd1\xba\x2f\xf5\x6b\x03\x80\x72\x6c\x5f\x40\xcc\x76\x78\x8a\x34\x69\x33\x66\xed\x70\xb7\x67\x42\xb6\xe0\xa2\xeb\xee\x7a\x84\x8a\xc6\x1d\x5d\xd0\x93\xf1\x7f\xe9\x0c\x88\x95\x26\x5f\x89\x3a\x2d\xfa\x4c\x78\xdb\xd7\xa5\xa4\x0d\xf8\x51\xff\x02\x45\xa0\xed\x84\x23\xdb\x95\x1d\xff\xb6\x87\x9a\xf6\xd1\x67\xca\xda\x41\xc7\x39\xb8\xd8\xaf\xcb\x9e\x09\x78\xd7\x13\x2f\xb8\xf3\x13\x26\x56\x8a\xec\x51\x78\xe7\x33\xb0\x4c\x0d\xbe\x16\x5d\x06\x8c\x99\xf1\xb6\x2f\x87\x83\xc6\x11\xfd\xc5\x1f\xf5\x5f\xc4\xc2\x80\xc5\x4a\x94\x02\x3d\x13\x17\xae\x18\x1d\x97\x98\x92\x9e\x95\x5b\xb6\xfc\xb9\x74\xe1\x89\x55\x09\x69\xd5\xad\xdf\x34\x23\xe6\x5b\xfa\x5e\xfd\x9f\x03\x0d\xca\x86\xc8\x08\xb5\xdb\x41\xc7\x9d\x75\xd1\x75\x77\xa7\x7b\x53\x82\x27\x5e\xf9\xe0\x93\xbf\xea\x4b\x64\x11\xa2\xc5\x8f\xa4\x5b\x9a\x91\xa5\xc1\x22\x63\xe2\x93\x52\x33\xb2\xff\x9a\xf7\xd6\xdb\x37\x41\x87\x21\xf6\x45\x1d\x3c\xb9\x09\xdf\x04\xed\xdb\xff\x2d\x5c\xb9\x71\x5f\xae\xa0\x04\x09\x17\x72\x32\x52\x0a\x82\xb5\xb6\xd1\xfc\x5f\xc4\x43\x07\x7e\xb3\x82\x17\xda\xca\xbb\xad\x7c\xda\xca\xb7\xad\x54\x6d\xa5\x6e\x0b\xcf\x1b\xfd\x19\x0d\xf6\xed\x2b\x86\x7a\xb9\xf9\x6b\x56\xd8\x20\xd9\x5e\x87\xa4\xbb\xe0\x86\x7b\x1e\xc8\x95\xaf\x50\xb9\x7a\xb0\x23\xa1\x80\x6e\x91\x8e\x72\x08\x20\x40\xaa\x2f\x8c\xbd\x03\x48\x1f\x6c\x28\x65\x43\x25\xea\x9f\x8c\x92\xf0\x95\x2a\x4f\x8c\xc9\xfc\x43\x29\x01\x39\x63\xc7\xdf\x78\x84\x07\x65\x59\x25\xfc\x89\x7b\xc8\xc0\x73\xe4\x22\x9b\x9f\x98\xe5\x8f\x9c\x38\xf2\x22\xbf\x38\x49\xda\xcb\xfb\x95\xef\xe8\x89\xcf\x30\x10\xb1\xfc\x7d\xa3\x18\xbf\xed\x0a\x4e\xad\x3b\x3c\x33\xb9\xf3\x08\x54\xb7\x2a\x7f\xda\x96\x43\x58\xd7\x36\x2b\x10\x7c\x0e\x8f\x1a\x6a\xa4\xed\x64\xcd\x9d\x61\x46\xcd\x8a\x87\x86\xf5\x27\x0c\x25\x91\x7e\xc4\x11\xd0\xd2\xf1\x5b\x18\x32\x67\xb8\xc5\x95\x3b\x29\x57\xde\xf0\xd6\x82\xa2\x0d\xb2\x81\x36\xac\x0d\x33\x92\x21\x47\x8d\xc7\xca\x90\xab\x6c\xd2\x5d\xe5\x93\x23\xb9\xc6\x16\x8e\xf5\x4c\x5e\x44\x2f\x30\x5e\x8e\x03\x59\x26\xa6\xfa\x1a\xce\x25\x77\x92\x9a\xdc\xa7\xe6\x46\x34\x08\x92\xfc\x4d\x8e\x0e\xb6\x48\x5b\xb8\x23\xf8\x2d\xa3\x84\x2a\x0c\x17\x15\x24\x90\x0b\x29\x49\x45\x6a\xd2\x90\xd6\x5d\x82\x92\xc1\x02\x98\x0b\xb5\xa3\x83\xa5\x16\xff\x0f\x68\x0d\x81\x1e\xa0\x3c\x5f\xdd\x49\x20\x5d\xcf\xf6\xa4\xd6\xac\x96\x4e\x78\xa5\xa6\x31\x84\x56\x7e\x70\xfe\xc6\x8f\x70\xef\xc0\x32\x39\x2a\xa4\x00\x5e\xf3\xae\xf7\x7d\x68\xb1\xa5\x96\x5b\x69\xb5\xb5\xd6\xdb\x98\x24\x13\x98\x0b\x53\x32\x15\x53\x33\x0d\xd3\xd6\xae\x92\x2e\x99\x92\x2d\x39\xc8\x74\x24\xa9\x4c\x46\x59\xe5\x94\x87\x3c\x0e\xb3\xbb\xc1\xb6\x43\xa6\xc6\x03\x5b\x81\x24\x15\xc1\xe2\x95\x52\x1a\x74\x03\x32\xc8\x4d\x44\x65\xd2\xa9\xae\xc5\x27\x3d\xe1\x83\xde\x3d\xcc\x7c\xb3\x45\x9b\xad\xda\x6d\xb3\x5d\x87\xce\xba\xab\xab\xbe\x06\x42\x92\xb5\xd6\xac\x47\x64\x75\xc5\x1a\x42\x45\xbc\x74\xcb\xa9\x57\xfc\x55\x45\x19\xac\x02\x69\xaa\x92\x44\x11\x9b\xac\x74\x09\x9e\xea\xd0\x04\xf8\x07\x3c\x9f\xda\x42\x88\x2d\xc7\xec\x35\x6d\x25\x42\xdb\xec\xb5\x6d\x23\x86\xad\xb3\x5b\x53\x13\x47\xfb\xec\x75\x69\x48\x82\x6d\xb3\xd7\xa7\x55\x0c\xdb\x67\x6f\xc8\x55\x71\x74\x54\x6c\xcc\x4d\x09\x74\x42\xfa\x3a\xba\x15\xfc\xc4\xc3\xd2\x94\xbf\x53\xbd\x5e\x69\x41\xcc\x3a\x77\x44\xd8\x64\x8b\xb9\xd8\xd7\xb7\x75\x1c\xd5\xfe\xa7\x00\xc5\x98\xe3\x10\xf4\x3b\x49\xfc\xec\x7a\xdf\x74\xd3\x87\xbd\x85\xf9\x64\x25\x30\x8c\xff\x1d\xc5\x47\xb3\x60\x7b\x4e\x5c\x0a\x19\x07\x75\xc9\x98\x1c\xdc\x16\x57\x52\x78\x20\x08\x4e\x25\xc8\x07\xce\x50\x86\x60\x96\xb4\x01\xd4\x10\x73\xc5\xa5\x7c\x4a\x0e\x0f\x08\xf3\x45\x83\x2d\x83\x60\xe2\x40\xd0\x20\x10\x64\x32\x84\x73\xc1\xed\x24\x27\x07\x04\x63\x84\xc0\x7c\x70\x11\x40\xe8\x48\x73\x9f\x1a\x10\x6a\xb8\xae\xba\xee\x82\x06\x91\xe4\x31\x4c\x93\xc3\x8e\x9d\xc8\x55\x7b\xc8\x94\x0a\x75\x38\x6f\xa9\x24\x1f\x5c\x29\xbb\x44\x52\xa4\x32\x03\x74\xed\xf8\x34\x43\x83\xfb\xe2\x51\x38\x26\x07\x6e\x10\x3c\x6e\x85\x0f\x94\x35\xbe\x55\x10\x11\x23\x4e\x12\x92\x92\x8c\xe4\x14\xa0\x12\xaa\xaa\x36\x22\xd2\x57\x8b\x9d\x75\xd5\x5d\x4f\xbd\xf5\x37\xd0\x60\x43\x0d\x37\xd9\x9f\x1c\x39\x87\x8c\x18\x63\x5c\x4f\x82\xea\x82\x0b\xef\x0e\x3d\xa2\x89\x31\xb3\xce\x39\xef\x82\x6f\x7c\xeb\x3b\xdf\xfb\xc1\x45\xb1\xe4\xce\x42\x84\xaa\x62\xbe\x2c\x36\xbe\x5c\xa7\x41\x4d\x22\x87\xdb\x85\xdb\x91\x00\x9e\xee\x45\x80\x8d\xce\x83\xb3\x2b\x51\x01\xe9\x1b\xfa\x86\x20\x30\x57\x22\x45\xc1\x01\xaf\xf5\x31\x6e\x08\xfc\x09\x7a\x8e\xea\x88\x23\x0d\xd4\x77\xea\xce\x05\xe0\x05\x00\xe4\xe9\x28\xf0\x3d\x0c\x78\xd1\x9d\x48\x46\x23\x0e\x7e\xef\x92\xc6\x96\x18\xa2\x05\x58\x29\x24\xe7\xd3\x4f\xd4\x32\xcc\xbd\x6a\x79\xa1\x69\x16\xb9\x4f\x53\xcd\x54\xd0\x31\xbc\xd6\x51\xd5\xd6\xe0\x7b\x78\x2a\xee\xf7\x65\xcd\xb5\x46\x93\x03\x27\x40\xa0\x4e\x0c\x86\x88\xf5\x9d\x38\xe3\x4c\x30\x59\x82\x79\x4c\x0e\x39\x22\xcd\x69\x37\x64\x7a\x2d\xcf\x3b\xef\x95\x8c\xb1\x8a\x9a\x58\xa7\x4e\x09\x43\x55\xc4\x50\x8e\x1a\xec\x90\xfd\xd1\x8e\x51\x6f\xfc\x63\x03\x97\x5e\xf3\x2c\xb0\xc2\xe1\x84\x26\x2c\xf1\x61\x05\x49\x93\x02\x5b\x6d\x73\x20\x43\xcb\x35\xd2\x15\x60\x02\x1f\x21\x22\x2d\xb3\xde\xdf\xaa\xb5\x43\x03\xd8\x91\x21\xa2\x0b\xea\xa2\x1a\xe9\x28\xc5\x32\x07\x55\xab\xb5\xdc\x4a\x5b\xad\xb6\xd1\xa1\xb0\x53\x1c\x4e\xb8\x56\x5a\xe6\xd9\x2a\xda\xad\xb2\xdc\x64\x5a\x62\xb1\xa5\xfe\x19\xd2\x7c\xfc\xa5\x8c\x7b\x9d\x75\xd4\x5e\x55\x43\xfa\x4b\xd7\x07\xf0\x68\xef\x3b\x05\xa4\xc1\x35\xee\x51\x43\xb7\xa6\x78\xb9\xae\xfa\xb5\x4b\x1f\x41\x7b\x3a\xf0\xb3\xcf\xc1\x04\x69\xa1\xb9\x87\xb3\x64\xcd\x56\x53\x6b\xd3\x49\xe1\x40\x5e\xca\x90\xd1\x1f\x9d\xa8\x5a\x25\x8f\x67\x6a\x3a\x4a\xa2\x48\xe8\xc3\x68\xa8\x5f\xfc\x66\x84\x44\x2b\x00\xa7\xb9\xe7\x4f\x59\xb1\x28\x80\x0d\x32\x09\x39\x46\x4c\x46\x22\xe3\x4c\x4a\x4e\xf8\x90\x12\x20\x83\x08\x30\x80\x1c\x60\x14\xff\xb5\x22\x9c\x1c\xb6\xd7\xd1\x44\x27\x31\x99\xe1\xa5\x3a\xed\x7e\x70\x25\x19\xf7\xaa\xa7\x91\x27\xca\xad\xa7\xa5\x26\x59\x67\xa3\x5d\x4d\x4f\x84\x29\x99\x86\xb9\x95\x4c\xa9\x96\x66\xed\x2b\x9d\x32\xac\x97\x97\x20\xf2\x63\xe4\xa7\xa0\xd2\x51\x18\x14\x01\x45\x43\xb1\x50\x22\x94\x0c\x65\x41\x26\xf2\x50\x8d\x06\xf4\x60\x2a\xb6\x61\x17\x52\x70\x08\xad\x64\xc5\xb1\x68\x38\x3a\x05\x8d\x42\x63\xd1\x44\x34\x1b\xcd\x43\x4b\xd1\x7a\x36\xb2\x8b\x3d\xec\xe3\x30\xd3\x78\x9c\x27\x79\x9a\xe7\x79\x85\xd7\x79\x87\x99\xab\x84\x81\x61\x92\x30\x58\x0c\x1e\x43\xc4\x30\x31\xcb\x30\xaf\x30\xef\xb0\x61\x58\x10\x16\x8c\x8d\xc3\x26\x60\xe1\x58\x04\x36\x05\x8b\xc2\xd2\xb0\x66\x6c\x31\xb6\x1c\xbb\x08\x17\x86\x43\xe2\x48\x38\x1a\x6e\x16\xee\x3a\x1e\x47\xb8\x40\xb8\x42\xb8\x41\x94\x11\x8b\x89\x41\x62\x05\x09\x4e\x42\xd2\xa2\x68\x8b\x98\x09\xff\xe2\xb8\x23\x3a\xca\x38\x00\x8c\x22\x50\x57\x47\xa2\x9f\xa3\x5a\xa8\x9c\x11\x6e\xaa\xd2\xea\x7b\x97\xfd\xe5\xd8\x46\x30\x51\xb5\x0d\xb6\x34\x36\x80\xf5\x57\xd0\xb5\x41\xa5\xd4\xa1\xc1\x6b\x06\x2c\x3f\x52\x7e\x22\x2a\x0d\x85\x46\xe1\x51\x54\x14\x13\x25\x44\x49\x51\x5a\xd8\x11\x40\x21\xea\xd0\x89\x7e\x24\x63\xe7\x93\xeb\x08\x65\xcf\x9a\x80\x46\xa2\xd3\xd0\xe8\x06\x2e\x5a\x62\x6b\x85\xfd\xae\x63\x4c\x77\x9d\xe5\xe5\x2f\x79\x9b\x19\x70\x2e\x2c\xb3\xf5\xd9\xc6\xd5\x24\x3b\xca\x6a\x08\xae\xf3\x1d\x9f\xd8\xfa\x3c\x6b\xf9\x09\x46\x42\xd0\x22\x6d\xb2\xd8\x5e\x53\x4e\x5f\xff\x5e\x18\xa3\x7c\xf1\xbf\x3b\x9f\xf2\xf8\xb8\xe9\xff\x77\x81\x4f\xfe\x17\xca\x7e\x08\x7f\xb6\xad\xfc\x56\xfb\x8b\x5f\xfc\xe4\x7b\x7b\x03\x9a\x9e\x8f\xbe\xf9\x9f\x4d\x2d\x9b\x3d\x4f\xf8\xb4\x2c\xbc\xff\xa2\x6f\x9d\xff\x79\x66\x33\xf8\xd3\xfc\x52\xad\xfd\x10\x3a\x6b\xa8\x93\xb7\x3f\x76\xb4\x93\x2d\xaa\x1a\x97\xcc\x1e\xf0\x64\xaf\xe0\xa6\x6b\x55\x33\xb8\x16\x79\x77\xb4\x2c\x33\x4e\x40\xbb\x03\x7c\xf8\x7f\x36\x73\x38\xfa\x00\x3f\x8f\x5e\xa1\x6d\xc6\xfc\xc6\x00\xde\xfb\x63\x99\x6a\x19\x6c\x19\x68\x19\xf0\x7e\xcf\x8d\x05\xde\xbc\x18\x12\x41\x27\xc7\x6f\xdc\x60\x70\x1a\x9c\x9b\x44\xd8\x3b\x84\xbb\xaf\x6e\xa1\x35\xae\x75\xed\xeb\xdc\xd1\xe2\x73\x42\xef\x6f\xff\x04\xeb\xd9\xd8\xe6\x00\xaf\xc2\x7a\x67\x53\x5b\xd8\xe2\x96\xb7\xba\xf5\x6d\xae\x7e\x8d\x6b\x3e\xe1\xdc\xad\xc1\x34\x84\x46\xbb\x31\x6c\xac\x4f\xb4\x00\xac\xe0\xd3\x9d\xd0\xfc\x05\x34\xff\x9d\x02\xd0\x9b\x1f\xf6\xae\xef\x4e\x36\xa5\x9f\x72\x0d\xe5\xde\xaf\x08\xf1\x3b\xf0\x0f\x59\x4d\x07\xdf\x2f\xc4\x46\x64\x23\xf0\xbc\xf7\xe3\x73\xfa\xc9\x4c\xac\x8f\xe5\x8e\x14\xa9\x9f\x3f\xbb\xaa\x0e\xca\x2b\xd4\x0e\x32\x99\x5d\x3d\x69\xdb\x5b\xa2\x74\xad\x6a\x9a\x40\x4a\x6a\x3f\x10\xae\x4a\xfe\x27\x01\x2d\x11\x83\x8b\x11\xe7\x1e\x3a\x90\xa0\xc4\x6f\xc2\x90\xfc\xb5\x64\xd4\xc7\x47\xe9\x63\x44\xbc\x4d\x84\x5b\x66\x73\xcc\x9d\x75\x9f\xf5\x43\x25\x05\x47\xde\x03\xf9\x9b\xc5\xe1\x64\xd2\x0e\xd5\x1a\x90\x51\x94\xd8\x43\x35\x5d\x93\x92\x26\x97\x06\x63\x4a\x57\x7c\xe5\x52\xa5\x76\x0f\xe9\x19\xd3\xd4\x20\x63\xaf\xfe\x8e\xa8\xf6\x42\xea\x84\x34\x9e\x86\x4f\x49\x0e\x00\x98\x20\xe9\x58\x4c\xc2\x8a\x4d\x70\xd1\xe9\x1c\x14\xb5\x04\xaa\x48\x58\x21\x48\xc3\xd2\x46\xc5\x0e\xc9\x12\x8f\x29\x0e\x5b\x02\xd6\xf8\xdc\x49\x39\x13\x2b\xce\xa6\x30\x8b\xac\x54\x8a\xb2\xca\x4d\xa1\x34\xa7\xb2\xdc\x79\x54\x95\xa9\x26\x9f\xea\xb2\xd4\x96\xad\xa1\x80\x96\x0a\xb5\x55\xac\xb5\x22\x9d\x05\x2d\xc8\xd3\xe4\xaa\xcc\xcb\xdd\xaa\x82\xb0\x0a\x97\x5d\x84\xac\x18\x90\x12\x70\x4b\x51\x54\xb2\xc2\x98\xaa\xcb\x51\x55\xb6\xca\xb2\x0c\x68\xd4\xa3\x56\x63\x85\xfa\x35\xe8\x52\xad\x4f\xbd\x51\xaf\x3d\xd4\xe1\x51\x5e\x19\xf4\xc6\xb0\xd7\x86\xcc\x8a\xe6\xbc\x52\xc4\x98\x2a\xd5\xe7\x67\x8e\x6b\x71\x3e\x64\x0a\x8b\xbe\xc8\xa4\xe6\x0b\xf4\x37\x4f\x5f\xf4\x0e\x4d\x19\x51\x76\x1a\x05\x99\x0d\x45\x31\x3f\x0f\x9b\x8a\x94\x57\x8c\x26\x45\xba\xd5\xe0\x95\xaa\xa6\x5c\x01\x3d\xb5\xb2\x40\x81\x3d\xf3\x5c\x1f\xeb\x7d\xab\xa1\xfa\xea\xb2\xd5\x32\xa0\xae\xb6\x1a\x6b\xad\x29\xfb\xb4\x99\xc6\xa4\x4c\xce\x78\x52\xd7\xd4\xdf\x70\x23\x7f\x9b\xd1\x55\x67\xa3\x0d\xd5\xdb\x00\x7b\x82\x6f\x62\xba\x04\x43\x2f\x91\x26\x0a\x75\x64\x9c\x92\xd9\x13\xf2\x24\xe3\x4a\xc2\x9f\x4e\x5d\x39\x42\xe5\x6a\x2a\x5f\x7b\x25\x3a\x2a\xd5\x55\x99\xe5\xf9\x4b\x2e\x40\x5c\xde\x62\xf3\xb2\xb9\x28\xf9\xc5\x2a\x2e\x4d\x49\xe9\xd8\x25\xe2\x94\xa4\xb4\x0c\xb5\xe5\xab\xab\xc0\x20\xbe\x1b\xea\x8c\x6b\x31\xe6\x95\x1f\x34\x7b\xac\xcb\x5b\x23\x12\xfd\x4b\xe7\x7f\x2a\x8d\x34\x9a\x69\xfd\xc9\xd5\x5f\xd4\x9a\xc8\xd4\x04\xc2\x4a\x50\xc7\x45\x3d\xb9\xda\xc8\xc2\x51\x44\xa0\x8b\x8e\x57\x2a\x41\xe9\x84\xa1\x88\xca\xc0\x2f\x4d\x7e\x26\x81\x0c\x66\xf4\xdc\xcc\x5e\xea\xab\xc6\x40\x4f\x0c\x46\x36\x25\x47\xfd\x3d\x36\x92\x93\x39\xb9\x18\xcd\xd5\xdc\xdc\xcc\xce\xd9\x39\x3c\xcd\x4a\xb4\x2a\xd3\xae\x42\x87\x4a\x9d\xaa\xb4\x29\x37\xaf\xc7\x13\xdd\x9e\xe9\xf5\x42\x7f\x1b\x71\x70\xf1\x70\xf2\x52\x26\x3f\xb2\x6a\x35\xb1\xc9\x21\xd5\x94\xe5\x29\xe4\xaf\xde\x32\x05\x82\xf2\x95\x2b\x52\xac\xcc\x5c\x21\xc2\x30\x44\x8b\x11\x25\x54\xb8\x08\xb1\xe2\xc4\xe3\x9b\xac\x1f\x1d\x45\x8d\x29\x89\x58\xa5\x6f\x9c\x73\xc6\x5a\xa9\x92\xa7\x6d\x94\x44\x9d\xa5\x45\x75\x92\x9e\x80\xfc\x5e\xa5\x31\x80\xdd\x61\xa5\x1d\xe8\x0d\xab\x7e\xf8\xdf\x43\xb4\x43\x57\xf7\xd0\xac\x15\x2f\x68\x45\x39\x8e\x78\x3c\x05\xfd\x7a\xbf\x09\xc8\x64\xd5\xd2\xf1\x78\x6f\x5a\xb8\xdb\xda\xe4\x87\x28\x33\x01\xf1\xc2\xb6\x0e\xd8\xfe\x07\xe3\x6c\x53\x7c\xfa\x64\xb9\x59\xb3\x7d\xf6\x33\xc4\x17\x96\x9c\x85\xff\x72\x90\x7d\x1b\xcb\x48\x69\x4d\xbc\x21\xae\x49\x33\x80\xb8\xe6\x4d\x41\x02\xe6\x79\x56\x90\x03\x26\xe0\xff\x86\x34\x7d\x1a\xda\xa8\xb0\x1d\xb7\x14\x6b\xab\xc9\x9b\x3b\xfa\x6c\xb5\x68\x15\x5f\x83\x8f\x0f\xba\x4e\xe6\xd4\xce\x1d\x06\x0e\xcf\x0e\x09\xb1\x37\x4c\xd8\x22\x7d\xc7\xa7\xb8\x05\x5b\x20\x81\xff\x93\x30\x43\xfc\x19\x0e\xa0\x48\xfe\xc6\xf2\x72\xa6\xbc\x72\x3b\xb0\x53\xbd\xeb\x23\x8a\xc4\xe7\x24\x21\x1e\xdb\xa9\x2e\x5b\x90\x07\x5a\x90\x5a\x98\x80\x2a\x30\xf0\x30\x29\xa8\xa1\xa8\x46\x69\xed\x48\x72\x41\x0a\xd1\xa2\x31\x49\xc2\xc3\xa0\x14\x09\xeb\x51\x8d\xa3\x1f\x9d\xe4\xb3\xed\x25\x7f\xd5\x0f\x0f\x8a\x88\xf9\xff\xaf\x79\x4f\x8b\x56\x4d\x9a\x0e\xad\x3f\x50\xe1\xc8\x59\x71\xda\xe9\x2e\x76\x6c\x8d\xaa\xb4\xa0\x4d\x0b\x72\x55\x01\x94\x98\x0d\xe0\xb4\x04\x44\xb7\x84\x11\x01\xa1\x97\x04\x72\x8f\x19\x49\x5f\x76\x14\x85\x6b\x41\x09\x68\xe7\x60\x95\xe3\x7f\x34\x7c\x7d\x16\x5c\x90\x79\x07\x74\xdf\x2c\xb6\x25\x63\x90\x06\xe1\x5a\x6b\x1e\x6b\xbd\x83\x8e\xb1\x1f\x3c\x0a\x83\x2e\xe2\x12\xdb\x12\xa9\x79\xb4\xb1\x2c\x98\xe8\x4a\x43\xac\x4f\x95\xf9\xe2\x63\x1a\x1a\x42\xde\x24\x34\xf4\x4b\x51\x71\xfd\xac\x0a\x20\x4c\x33\xa0\xa1\xce\x94\x1f\xd4\x7e\xa3\xb6\x5a\x89\x76\x8f\xb5\x1c\xa6\x23\x71\x55\xcd\xef\x19\xbd\x05\x0d\x58\x37\x20\xa8\x71\x0b\x57\xd8\xe2\x6a\x1c\xf5\x77\xf0\x29\xd4\xd3\x0b\x9a\x00\x2d\x75\x0c\xc3\x3f\x1a\xbe\xc5\xb2\x94\xcc\xa4\x47\xfc\xec\x36\x1e\x81\x94\xb3\xb0\x7d\x62\x13\xfd\x6e\xdc\x5d\x7e\xfd\x8f\xfc\x70\xdb\xb7\xbe\x43\xbb\x7d\xbc\xf1\x2b\x6d\x6d\xee\xac\x0e\x12\xcc\x3a\x75\x9b\xf3\x28\x92\x62\x68\xba\xeb\x4c\x9c\x33\x93\xb4\xef\x50\x1f\x46\x32\x13\x90\xb7\x86\x25\xc5\xa9\xa4\xbf\xa1\xf8\x13\x3c\xbb\xae\x5a\xde\xb4\x5e\x3c\xaa\x0e\x70\x82\xc7\xfb\x64\x47\x8d\x3d\x75\x10\x22\x63\x98\x29\x1b\xe3\x4d\x99\x80\x8d\x34\x24\x24\x2e\x06\x12\xe3\xee\xdd\xc0\xc5\x5c\x0a\xd7\x27\x96\xa6\x1a\xb4\x54\xd5\x50\x51\xf2\xd9\xc0\x93\x94\xdf\xf6\x70\x1e\x86\xab\x26\x7e\x4e\x4e\x7b\xcc\xdf\xb3\xf5\xb2\x2b\xe8\x21\xc1\xcd\x0a\xfa\x48\xe4\x0c\x02\x8e\x03\x21\xc7\x83\x88\xa3\xe0\x88\x13\x40\x97\x13\x41\xcc\x49\x20\xe1\x64\x90\x72\x9d\x9e\x21\x44\xe7\x15\x29\xe6\xed\x13\x2b\x28\x49\xde\x07\x07\x6f\xac\xa3\x8a\x9a\xc0\xfe\xdb\x7c\x5c\xd3\xd8\xff\x0a\xfe\xe8\x0c\x73\xec\xbb\x6c\x7a\x32\x39\xd1\x27\x0a\xa2\x24\x2a\xa2\x26\x1a\x62\x40\xb4\x84\x6f\x62\x13\xa0\xb2\x51\x30\x1d\xec\x69\x67\xd4\xf2\x2d\xd7\x66\x74\x13\xc7\x26\x6e\xaa\xce\x90\x88\x38\xa7\xd7\xa6\x69\x0e\xa0\x37\xef\xc1\xa8\x84\x15\x89\x86\xd9\xd7\x32\x25\x39\x58\xd0\xf5\xe4\x64\xc6\xf9\x35\x09\x71\xda\xda\xa7\xae\x73\xbe\xa5\x56\xcc\xab\x62\x2b\xe0\x95\xa8\xd2\x3a\x67\x23\x6a\x0e\x21\x12\xe7\x6c\x5a\x16\x45\xf0\x0b\xa7\x51\x77\xef\x64\x25\xad\xa2\xdf\xb1\x1b\x65\x03\x54\xe8\x5b\xdb\x49\x5c\xd3\xbc\xb9\x8b\xb6\x28\x6f\x56\x05\x77\x70\x3c\xf8\x39\xe6\x0e\xdf\x23\x71\x21\xb8\x87\x52\x05\x89\x72\x48\x54\xdc\x8c\x3f\x7b\xe9\x25\xc0\x8e\x54\x54\x1d\xae\x66\xd8\x45\x32\xbd\xf6\x18\x07\x1f\x40\x04\xb4\xc0\x4f\x6d\xc4\x30\xe5\xd9\x61\x36\x8d\x33\x05\xaa\x78\x78\x9e\xc3\x71\x1b\x4a\x36\x2a\xe9\x0d\x21\xf1\x1b\xfb\x1c\x30\x86\xb3\x43\xfb\x93\xdb\x2f\xe6\x6a\x8e\xa7\xc5\xae\x33\x41\x34\x21\x13\xb1\x44\x54\xa5\xab\x37\x48\xe9\x8b\xcc\x07\x32\x32\x65\x4c\xc0\xd1\x4f\x84\x3c\xc3\xa4\x76\x73\x8b\xa4\x4e\xe6\x83\xd8\x98\xe8\xf7\x2a\x08\x40\x9a\xa4\x0b\x2b\x4a\x28\xb3\x23\x67\x02\x37\xb0\x51\x8f\x8c\x09\x90\x24\xca\xbc\x01\xba\x04\x0b\x7e\x4c\x88\x20\x69\x92\x46\xb6\x16\x38\xcd\x93\x30\x99\xd1\x8c\xdb\xb5\xde\x13\x1d\x48\x88\x04\x84\x04\x3a\xa5\x5a\x09\xd7\x9c\xea\x94\xeb\xdc\x1d\x20\x8e\xa8\xe7\x7c\x67\xaa\x03\xbc\xc2\x69\x77\x38\x9e\xdb\x12\x95\x68\x75\x13\x37\xa7\x02\x05\xf5\xfb\x45\xbb\xe4\x6d\x4e\xec\xc4\x70\xa6\x36\x4d\x7b\x89\xa9\x5b\x5b\xcf\x85\xf0\xd2\x4c\xcc\x8f\xff\x63\x76\xb7\xd5\xb4\xf1\x0b\x08\x4a\x0d\xf2\x7e\xfb\x16\xed\x81\x3a\x72\x16\x95\x2b\xd3\x2c\x7a\x59\xa2\xe5\xe5\x97\x3c\x52\x07\xc5\x19\x59\xd6\xc8\x57\xfa\x4a\xce\x83\x73\x1b\x7d\x35\xe7\xb1\xc7\x7c\x5f\xb9\xd6\x26\xc6\x03\xdc\x20\x52\x96\x9c\x09\x70\x4d\x47\xe0\x96\xb6\x44\xac\x37\x22\xa8\xa0\x95\x15\x86\x49\xd7\xf3\x24\x37\xad\x3e\x59\x11\x6c\x7e\x5f\xf4\x2e\x8b\x24\x40\xe1\x69\xea\x7c\x56\xb2\xa2\xb4\x73\x2c\xb5\x13\x0f\x37\x36\x38\x64\x3a\xe8\xde\xbc\x54\x3e\x4b\x2d\x79\x53\x8a\x76\x87\x79\x6b\xe0\x0f\xee\xd9\x00\x3f\xee\x5c\x8d\x25\x9b\x6b\x31\xb7\xb7\x77\xf2\x04\xf2\x35\xe5\x56\x76\xee\xa6\xbb\xfb\x05\xf7\xbe\x21\x68\x05\x25\xba\x58\x16\x2d\xcd\xee\xe7\xf6\x3b\xe5\xbd\xe3\xe6\x41\x3e\x8c\x1a\x00\xbd\xa2\x12\x0a\x3a\x23\x40\x23\x14\x6c\x0e\xc0\x40\x27\x14\x7c\x46\x80\x41\xa8\xdb\x02\x47\x00\x00\x0d\xb1\x34\x14\xaa\x02\x01\xd6\x86\xea\x35\x28\x02\xaa\x55\xea\x84\xea\xa5\x37\x34\x47\x9d\xc6\xda\x02\x53\x46\x80\xb3\x50\x58\x72\x00\x46\xae\x42\x21\x66\x04\xb8\x09\xd5\xdf\x45\xa2\xd6\x0e\x0e\xb4\x93\x5a\xbb\x38\xd0\xee\xda\x42\x8f\xd2\x2b\xd4\x27\x97\x84\x3d\x4c\x8d\xd7\xff\xdf\x4d\x29\x8c\x67\xca\x5e\x02\x5b\x0a\xaf\x3f\xf3\xd2\xb1\x83\x43\xa9\x87\x13\xc4\xdc\x20\xf0\xd6\xaa\x1f\x43\xfa\xde\x8f\x81\xf7\xeb\x87\xa0\x7e\x64\xd4\x3c\x22\x0e\x82\x24\x48\x37\x6d\x63\x23\x33\x37\x32\x77\x23\x0b\x37\xb2\x6c\x02\x54\x41\x50\x07\xe9\x36\x10\xf7\x67\xeb\xfd\xf9\xf0\xfe\x7c\x7a\x7f\xbe\x9a\x00\xef\x20\xf8\x04\x79\xfb\xbe\x72\x75\x2c\x42\x33\xd5\xce\xb5\x7e\x1f\xdd\xbb\xc1\xab\x6e\x1e\x4d\xc1\x87\x33\x00\xc1\xff\xc2\xab\xf6\x79\xcd\xe1\x9f\xfe\x55\x01\xaf\x02\x16\xf8\x0b\xb0\xcc\x2d\x00\xa6\x3e\x56\x30\xea\x40\x60\x9b\x3b\xc0\x53\x29\xec\x35\x74\xdc\xd5\xa9\xed\xd5\x4d\xc7\x55\x68\xb0\x14\xbf\x21\x64\x68\x1c\x09\xe6\xac\x78\xb9\x82\x30\xce\x49\x05\xb7\xe8\xdd\xde\x08\x42\xd4\x9c\xe6\xf0\x54\x40\xb1\x58\xce\xb6\xbd\xac\x9a\x36\x50\xeb\x3a\xd6\x92\x77\x7a\x18\x5d\x16\xd0\x85\x69\x23\x59\xa5\x62\x9d\x18\xb3\x5c\x4e\x26\xf0\x24\x8d\x9d\x2a\xda\xa2\xc3\x60\x10\xe5\xae\x58\x07\x99\x9e\x67\x6e\x13\x28\x73\x02\x8a\xfc\x18\x27\xc7\x8c\x29\xe6\x92\x36\x11\xe4\xd8\x54\xee\x34\x3f\xac\x07\x91\x9c\x11\xda\x71\x62\x63\x32\x5f\x14\xde\x73\x3b\x66\xe4\xd6\xc8\x30\x98\xe8\x89\xc1\xc2\x87\x5e\x14\x91\x55\x21\xb1\x42\x11\x17\x29\x7c\x78\x86\xc6\xd5\x98\x6d\x20\x48\x26\xd8\xb0\x39\xc0\x86\xb6\xdc\x6a\x26\xea\x1d\xcf\x90\xc1\x2b\x0a\x70\x5d\xb1\x09\x0b\x2e\x22\x7b\x99\x86\x58\x08\x4b\x90\x8d\x96\xd9\xea\x20\x43\x62\x43\x49\x02\x6a\x69\x9c\x20\x91\x20\x73\xf8\x70\xb6\xf2\x46\x43\x1c\x36\x87\x89\x57\xee\x46\x3b\x62\xcf\xc6\x74\x66\x6c\xc8\xac\x9b\xa5\x54\x76\x51\x96\x55\x53\x86\x20\x9e\x86\x98\xdc\x32\x5b\x1f\x1d\x39\xcd\x60\x3a\x1a\xf8\xe1\x15\xc5\xe9\xb6\x98\x5b\x29\x01\x1e\x4c\x74\x25\x71\x27\x6c\xc3\xf4\x3d\x97\xd1\xa2\x5d\x59\x1b\x7c\x5f\x03\xe9\xf9\x86\x94\x17\x72\x60\x35\x83\x73\x13\xa3\x6f\xdd\x9c\xb3\xbf\x10\x88\xf9\x7e\x3a\xb0\x03\x57\xc7\xad\x03\x4d\xf4\x79\x5c\x85\xd3\x70\xa4\x7e\x8c\x24\x37\x80\x6b\x80\x77\xaf\x75\xae\xe7\x55\xe6\x3e\x07\xcd\xc7\x3a\x4c\x83\x2e\x82\x2b\x96\x65\x27\x70\xea\x05\x6d\x13\x02\x03\xfb\xf6\x60\xd8\xae\x79\xce\xeb\x79\xf4\x7e\x5d\xdb\x8e\x23\xbb\xd8\x41\x4e\x05\x6b\x90\x23\x58\x8b\x37\xf0\x2e\x76\xbe\xbd\xff\xb8\x1c\xc6\x86\x2b\x41\x5e\x56\x33\x61\x65\xc6\x14\x51\x88\x0c\x7a\xa7\x27\xa7\x16\xd1\x1c\x18\x6c\x00\xd5\xaf\xc8\x0d\xc2\x90\x74\x4e\x77\x80\xd9\x9a\xe4\x27\x72\xc9\x16\x74\x99\xf5\x27\xb0\x60\x33\x34\x0b\x0a\xe1\x3c\x12\x8a\x6d\x45\x34\x67\xc2\xc3\xb5\x56\x70\x60\x2d\x0b\xc1\xaa\x75\xfd\x1b\xec\xa9\x7b\xc1\x56\x02\x4a\xda\xdd\x75\xae\x56\x9c\x6c\x3a\xad\x7d\x55\x6f\xaa\x98\x2d\x07\x9c\xab\x88\x59\xe7\xa9\xe8\x3e\x71\xba\xdf\x6e\xeb\xb2\xb9\x36\x1b\x13\xdd\x7f\x83\x60\x43\xd4\x4e\x7e\xed\x01\x46\x9a\xa5\x24\x0c\x04\x5d\x04\xe8\x15\xfd\xd0\xbd\xda\x95\x8c\xe4\xec\xfd\xb4\x2d\x26\xd2\x1f\x6c\x97\x02\x16\x22\x08\xf8\xd4\x5a\x19\xfa\x6a\x4d\x9a\x93\x77\xa8\x4d\xaa\x60\x41\x08\x68\x8f\xb2\xed\xee\x9d\x6e\xba\x10\xfb\xfe\x24\xee\x45\x5f\x9e\x0b\xce\xba\x55\x6b\x56\x97\x84\xcc\x6a\xb8\xa1\x3d\x13\x31\x3b\x75\xab\x60\x6d\x52\x94\x68\xd6\x7e\x9d\xc5\xe1\x3a\x46\x04\xe9\xd1\x28\x2a\x7d\x00\x59\x35\x93\x2b\xac\x72\xeb\xd9\xf6\x39\x23\x27\x60\x50\x98\xdb\x16\x50\x28\xef\xd6\x5e\x2b\xa4\xba\xd0\x8b\xc0\x38\x28\xb7\x21\x4c\x5e\x41\x69\x94\x58\xa2\x35\x5d\xa4\x27\x12\x08\xae\xfa\x5e\xba\x50\xc2\xd0\x9e\x2f\x92\x90\x58\xf9\xb7\xfa\x5b\xba\x79\xbe\x3a\xc7\x55\x08\x52\x13\xea\xe3\x2b\xf8\x5e\x77\x83\xe1\x35\x45\x97\xd0\xa4\xff\x25\x3f\xe1\x7f\x20\x0c\xa5\x92\x2d\x50\xd0\xb7\x9d\x02\x4a\x26\x13\x4b\x14\x9b\x10\x18\x13\x97\x57\xc9\x84\x6e\x8c\xa1\x45\x7e\x51\x20\x01\x96\x4c\xda\x9e\x25\x63\xa1\xfa\xc8\xb2\xfc\xb6\xcd\xab\x7a\x74\x7e\xf4\x35\xfe\x54\xa2\xc8\x80\xc0\x34\xf9\x01\xc6\x72\xb6\xb9\xa2\xe4\x97\xdb\x19\x32\x36\x5e\xfa\x35\x0e\x56\x28\x9a\xfb\xf4\xb0\x43\x5c\x55\xe4\x77\xf3\xe1\xbb\x86\x7c\xf9\x09\x3f\xc4\x45\xad\x1f\xb6\x98\x42\x62\xf8\x90\xf3\x9d\x35\x6b\x88\xe1\x22\x90\x29\x53\x97\x7a\x88\x42\xb2\x7d\x24\x52\x0f\x64\x6c\x61\x89\x6f\xbe\xb2\x54\xa3\xb7\x35\xd8\x02\x95\xa8\xad\x93\x39\x76\x0c\x62\xe8\x57\x72\x0e\x12\xe1\x84\x0d\x4f\x6c\xe5\x9a\x18\x31\xd7\xe2\xec\x89\x25\x82\x5c\xc1\x76\x00\x42\xfd\x25\x5d\x74\xfb\x01\x32\xcf\xdf\xb0\xd0\x44\x03\xfd\x95\x2f\x53\x13\x27\x20\x13\xf9\x9d\xd6\x20\xd1\xe3\x56\xab\x70\x6a\xdf\x48\x0d\x83\x22\xdf\xac\x7c\xae\x3f\xcc\xb1\x8d\xf9\xb6\x9e\x05\x94\x7c\xae\xaa\xc9\x59\x77\x39\x33\xa7\xd7\xb3\x47\xa7\x20\x7d\x51\xfd\x8b\x22\x43\x5d\x2f\x8b\x52\x1c\x50\x6b\x87\xcb\xe9\x7b\x3f\x41\x36\xe4\x88\xad\x74\xbf\xca\x16\x56\x89\x82\x0b\x28\x12\x98\xfa\x66\x55\x42\x72\x85\xfe\xf6\xa8\x29\x35\xc8\x1b\x7c\xc1\x70\x78\x00\xc3\x1b\x58\x7e\x07\xaa\x5d\x38\x9e\xa9\x2d\xb8\x5a\x78\x49\x5d\xf8\xf0\xac\xfe\xaa\xaf\xc7\x56\x2f\x6b\x04\x86\xca\xf3\x61\x91\x49\x31\x3c\xa7\x4a\x06\xf7\x36\x08\x55\xe3\x7b\xa4\x0c\x15\x5c\x6a\x42\x53\xa2\x36\x4a\xb4\x5e\x49\xd2\x06\x09\x83\xe4\xc0\xa0\x68\x6e\x56\xa5\xef\xe5\xd8\x16\x01\x29\xf3\xcc\x52\xf3\x10\x53\xea\x54\x37\x23\xd1\xba\xd6\x00\x44\x52\xf1\x49\x1e\x6e\x0e\xfe\x8c\x14\x3c\xf6\xde\xab\xec\x98\x48\x69\x55\x11\x50\xc4\x22\x58\xcd\x64\x14\x45\x9f\x32\x69\xf4\x1f\xc9\x16\xa6\x93\xca\xdf\xcb\x67\xca\x3d\xb1\x97\xc6\xbb\x7e\x9f\x73\x9c\xf9\x9f\x7a\x11\x68\xf0\xac\x30\x44\x67\x93\x1d\x26\xa7\x70\x89\xe2\x83\x64\x68\x77\x9d\x60\x6d\x44\x89\x2a\xa0\x5f\x12\xe1\x5a\x6f\x92\x22\x90\x63\xb1\x2f\xb3\xd9\xe2\x09\x0b\x2f\x0c\x28\xd5\x54\x4b\x56\x52\x77\xc6\x99\x25\xd6\x3a\x37\x16\xbb\xf3\x6c\x14\xb0\xb8\x61\x8d\x02\xec\x14\x03\x10\xbf\xc7\xe1\x8e\xf4\xdf\x97\xf6\x6a\xdd\xbb\xf7\x8b\xd3\xb3\x23\x16\x45\x15\x08\xee\xcc\x5d\x0e\x35\xce\x36\xf7\x04\x28\xbf\x2e\x98\x6e\x84\xdc\x41\x39\x3b\x69\x0c\xb3\xdc\x59\x5d\x40\x9a\x42\x71\x83\xda\x83\xcc\xd6\xfd\x65\xcf\x47\xb5\xc6\x11\x38\xac\xc9\x10\x36\xd0\x54\x7a\x48\x52\xc6\xae\xf5\x26\x5f\x77\x9a\x81\x4e\x22\xc1\x6f\x38\xb5\xd0\x05\xe4\xf8\x92\xf0\x25\x19\x18\x9f\xfa\xe5\x0b\x19\x14\xc7\xae\x19\x14\xff\xfd\x33\x92\xaf\xc6\xed\x1c\xf2\x9b\x33\xc8\xcc\x77\xc3\x69\x6a\xad\xad\xe9\xab\xe9\x27\xda\x54\x81\x45\x87\xdc\x22\x29\x26\xa7\x80\x5a\x12\x14\x8b\xbe\x47\x38\x62\x95\x67\x81\x2d\xd6\xd9\x71\xb0\x81\x84\xd5\x9a\x67\x69\x98\x20\x10\x70\x9f\xb1\xc9\x3e\x5a\x39\x3b\xd8\x76\x97\xc7\x6d\xf1\x9b\xb9\xbe\xfb\x79\x02\x02\x8d\x63\x87\xb8\xa4\x28\x81\xa1\x20\x58\x16\x53\xf1\xd5\xd0\xb1\x65\x31\xe1\x88\x53\x38\xdb\xce\x72\xac\x37\x0d\x96\x39\x06\x64\x64\xa9\xec\x69\x3d\x45\x91\x35\xdc\x57\x24\x2f\x4c\xa3\xf6\x26\x36\x26\x05\x52\x98\xda\xdd\x9f\x0d\x70\x38\x4a\x2c\xc2\x77\xd6\xf5\x4b\x62\x9f\xb1\xd6\xbc\x37\x3c\x79\x3e\x23\xa8\xd9\xc3\x19\xd3\xb2\x23\x79\x1a\x5f\xd1\xd2\x1d\x20\x81\xb1\xb1\x5b\xd2\x5a\xd6\xe6\x02\xc9\x35\xe1\xcb\x16\x7e\x44\x66\x49\xeb\x88\x19\x6e\xcf\x09\x42\x32\x56\xbf\xc3\xa1\x8e\x88\x28\x53\x9c\xd6\x56\xca\x4f\xf8\xc4\x1e\x1f\x5e\xe4\x8f\x65\x87\xed\x77\x59\x31\xb7\xe8\x48\x41\xbd\xcd\x8c\x59\x55\x9d\xa0\x09\xab\x08\x43\x45\x9a\xba\x02\x25\xf9\xcd\xab\xdb\x51\x29\xd6\x87\x8c\x7b\x7c\x2c\xf0\x34\xaa\x88\x81\xd3\x12\xb5\x1b\x50\x89\xba\x49\x1e\x69\xb4\x52\x73\x4b\xac\xb7\x26\xe4\x56\x95\x57\x9f\xd4\xdc\x8b\x76\xc6\x39\x4e\x76\x2c\x18\x42\xef\xf8\xae\xe8\x29\xcd\x88\x59\x1b\x68\x4c\xdc\x72\x2d\x33\xed\xe2\xa8\x88\xf4\xb3\xed\x6e\x6d\x14\xd3\xe3\x86\xb8\xef\x2e\x42\xbb\xce\xa1\x41\xc8\xd6\x5a\x6c\x8b\x53\x57\xda\x9c\xec\x38\x71\x5c\xf8\xd9\x91\x88\x17\x67\x0c\x3e\xe2\x1e\xfa\xd0\x72\x7f\x56\xf3\x15\x1a\x68\x50\x34\x38\x4c\xac\x4e\xf5\x09\x25\xe7\x3c\x2c\xe2\xee\x1c\x38\x12\x5e\x1e\x78\x6e\x59\xfa\xe2\x60\x81\xdf\x7e\x3d\x09\x48\x28\x30\x2c\xad\x72\x7a\xfd\xdf\x8b\xf0\x81\x5b\x18\x32\xd6\x92\x65\xe2\x10\x20\xa7\x0d\x71\x3a\x06\x36\xde\x5a\x33\x9a\xd4\x04\xa2\x92\xa9\x51\x22\x65\x7d\x62\xa9\x79\x8f\x26\x49\x1f\x56\x7b\xbc\x08\xc1\x86\xa7\x48\x90\xe3\xea\xba\x13\x64\xe0\xdb\xc7\xf3\x72\x51\x1b\x06\x12\x7d\xc2\xbd\xaf\xb0\x0d\x12\x10\x7f\xd0\x94\xf1\xba\xcd\xfb\x09\x0c\x93\x1c\xc7\xc1\x18\x95\x18\x05\x29\xf6\x70\xba\x13\xb2\x62\x20\x81\xdf\x56\xc7\x42\x0a\x12\x2e\x89\xed\x05\x8e\xe3\x4d\xdb\xcb\x1c\x9b\x9d\x5d\x06\xf7\xdd\xb1\x47\x78\xe5\x2e\x05\x86\x89\x82\x92\xd2\x25\xae\x84\xfe\x8c\x74\x20\xac\xbd\x26\x0a\x32\x46\x86\x77\x50\xaf\xf7\x95\x73\xab\x69\x01\x14\xc2\x3b\x3b\x35\x1a\x76\xbc\x6b\x61\x18\xa9\xf9\x77\x6a\x29\x5f\xfc\xd2\xae\x98\x4a\x37\x2f\x90\x6c\xfb\xe6\xa4\x7b\x70\x68\x69\xab\x29\xd4\x8a\x6b\x4e\x3f\x4f\x1c\x1d\x1b\x67\xdb\x16\x4f\x46\xb9\x2d\xa4\x66\x98\xe3\xe5\xd6\x5b\xe4\x46\xaf\xc8\x0d\xbe\xb0\x0f\xd9\xef\x76\x4e\x86\x4c\xd4\x25\xb1\x7f\xd1\x2a\xee\x38\xb7\xf0\x6f\x90\xe2\x24\x65\x53\x19\xad\x2d\x54\x1e\x9a\xbd\xd4\x84\xb5\x96\xd2\x8e\x79\x62\x20\x22\x22\xea\x73\x26\x59\x54\x5c\x1d\x0b\x28\xf9\x6c\xa8\xc9\xd9\xe6\x4e\x51\x35\xc1\xd8\xc1\xfc\xf0\x97\xa3\x9f\xde\x1f\x96\xb9\x0c\x50\x21\x88\x73\xfc\xb7\x26\x89\xb4\xf0\x9b\x86\x7c\xae\xcb\x9d\x9c\xdd\x41\x91\x1a\x76\x55\x19\x23\xc1\xd8\x54\x3d\x93\x43\x26\xea\xe3\x7c\x6e\x3c\x05\xe5\x71\x25\xdf\x12\x0e\x80\xac\xae\x7b\x93\x46\xc9\x5f\xa0\x77\x04\xce\x8a\xc6\x66\xd3\x70\x90\x23\x13\xa1\x2b\x5c\x7b\xe9\x4d\xe7\x66\xf5\xf3\x3d\x2f\xe3\x31\xa3\xf9\x83\xcf\x7a\xa1\xca\x6c\x9b\xb9\xac\x52\x4f\xd9\xc3\xfd\x1d\xcc\xda\x2f\xe6\x7c\xae\xdb\x2f\x49\x12\x28\x77\x80\xaf\x1c\x17\x1c\x75\xec\xd5\xe5\x18\x07\xf4\x2d\x98\x98\xe1\x43\xe1\x89\x56\x6f\x32\xfd\xc6\x0e\x7e\x1e\x51\x5e\x4a\xad\xb8\x42\x11\xe3\x54\x40\x46\xbc\xf5\x4d\xf8\xba\x18\x80\xc7\x44\x9a\xe2\x90\x01\x5e\x22\x1d\x2c\x52\x81\xeb\x3c\xc6\x6b\x85\x7a\x05\x1f\xe0\x65\xdc\x41\x62\x3d\xd6\xd7\x4a\xe5\xf7\x76\xb0\xd0\xad\xcc\x2c\x4b\x5f\xe6\x73\x95\x8f\x7a\x66\x9c\x20\x51\xef\xd8\x18\xd0\x9c\x7a\x03\x9c\x4d\x02\xb2\x6b\x55\xff\x2a\x32\xb1\xbf\x9d\xa7\x52\xdd\x76\xfd\xf1\x54\x5c\x21\xe2\x67\x07\xfe\xfa\x2a\x98\x0a\xaf\xe3\x17\xd3\xb8\xa1\xea\x0a\x7f\x04\x86\x72\xb6\x59\x01\xa5\xbd\x6e\x7e\xa9\xdc\xd0\xf4\x81\x6b\xcc\x61\x76\x86\x9a\x1e\x58\x18\x52\x70\x32\x4c\xcc\x0d\x42\x77\x39\x9d\x81\xa4\x1d\xa3\xbb\x75\x3c\xd8\xe7\xe9\x61\x96\x60\x8b\xba\xe5\x9b\x25\x00\xb2\x59\x15\xf6\x57\x93\x2c\x2b\x83\x6f\x81\xbd\x91\x7c\x9c\xf3\x52\x2f\x95\x6e\xe5\x0c\xea\x72\x77\xd5\xe8\xd1\x9b\x9d\xb4\x3b\x29\x51\x46\xbe\xc2\xd4\xc4\x5c\x1f\x60\xb0\x16\x6c\xec\xbe\x98\x60\x56\x08\xca\xd9\xe6\xa5\x3f\xf3\x08\xa8\x92\xa1\x18\x68\x98\x3b\xfe\x02\x9d\xf0\x0e\xf7\x42\x8c\xdb\x7d\xd0\x76\xf7\x1a\xd3\x9b\xdc\xb8\xc4\x6d\xb0\xc0\x8b\xdc\xd7\x82\xa2\x8b\xcc\xc6\xa0\xd6\x96\xe5\xd3\xcf\x8c\xb0\xb3\x83\xec\x60\x8b\x6b\x0b\x4b\x5e\xa5\x7b\x72\xb6\x52\x28\xa2\xb5\x8e\xfa\xda\xf4\xac\x27\xf2\x02\x19\xa2\xad\x2e\xcf\x4a\xf1\x35\x00\x4a\x28\xfc\xce\x6e\x02\x55\xf6\x55\x8f\x06\xee\xbd\x89\x98\x6d\xfb\x47\x7c\xc7\xe0\xf8\x1d\x0f\xec\x51\x4c\xdb\x1d\x1f\x22\xfa\xde\x21\xdd\xc9\x53\xc7\x1e\x35\x3a\xbb\x05\x5b\x28\xfa\xb2\x81\x66\xe1\x78\xc1\x91\xa8\xeb\x89\x32\x0f\x36\xcc\xb9\x9a\xdf\xb5\x36\xb4\x0d\x4f\x45\x9b\x78\x84\x6e\x2d\x86\x35\xcb\x7e\x56\xa6\x7c\xa1\xf6\x1a\xd5\xdd\xc3\xed\xf9\x18\xa4\x25\xd1\xe8\xc1\xc1\x6f\xd8\x5f\xfa\x06\x39\xd1\xe4\xb7\x4e\xb2\x89\xc7\xe1\xa1\x8f\xb2\xb6\x17\x6f\x3d\xca\x1c\xca\x1e\x56\xf2\x09\x89\x36\xd3\xed\x61\xac\xee\x77\x51\x91\xa8\x28\xd4\x80\x38\x78\x8f\x61\x4f\xc4\x6e\x7b\x0f\xc7\xd3\x42\x7a\x7f\x00\x8d\x03\x52\x7a\xca\x89\xc9\x5b\x8e\xb7\x16\xfb\xc9\x0c\xc8\xf5\xff\x19\xe8\x19\x6a\x4c\x4a\xd3\x78\xce\xe1\xbe\xa3\x9e\x68\x8b\x0c\x33\xa5\x4e\x75\xce\xce\xe9\x9e\xb0\xa0\x6e\x67\x9a\xea\xc9\x4a\x43\xf6\xe4\x6c\x1b\xc5\x8f\x2b\xe2\x95\x83\xee\x4b\xe9\xe0\x6f\xc4\x2f\x28\x72\x86\xdb\xf3\xc3\x2a\x00\x91\xce\x5e\xa8\xd4\x2c\x1a\xd2\xdb\x17\xda\xda\xcf\x12\xce\x6a\x50\xa3\xb4\x32\x20\x30\x05\xe3\x53\x6b\x02\x2d\x9a\xae\x49\x36\x6f\x0d\xa6\x81\x42\x81\x7c\xda\x83\x05\x58\xaf\x99\xca\x1d\xa4\xa9\x16\xff\x7b\x17\x54\x25\x39\x47\x62\xf5\xd9\x65\x47\x6f\xba\x3e\xe0\xec\xf6\x4b\x2d\x4a\x5a\x7a\xf1\x65\xe6\xd0\xa0\x3c\x0d\x0b\x84\x33\x76\x3f\xf5\xc1\x5a\x7b\x25\x24\xf7\x6b\x0b\x11\x9e\x40\x82\x0a\xe7\x63\x26\xfe\x0f\xce\x1a\x24\xb4\xad\xa7\x70\x01\xd3\x5a\xb7\xe0\xe6\x67\x4b\x49\x5b\x4d\x92\x09\xa9\x89\x99\x71\x48\x79\x29\x6b\x56\x9a\x24\x7f\x2e\xd5\x62\xdd\xb9\x69\xd1\xdf\x1f\x28\x58\xed\xf5\x1a\x6c\xb5\x5e\x85\x92\xac\xf7\xbe\x57\x57\x83\x19\x0c\x03\x4a\xf5\xbb\x3f\xc7\xab\xbf\xa6\x94\x12\x87\xb7\x5e\x0a\x1f\x38\x86\xc6\xe0\x56\x9e\x7a\x95\xa3\xbf\x56\x55\x85\x80\xa2\x7b\x74\xd5\x10\xd9\x0e\xc4\xfa\x2b\x04\xa9\x22\x95\x19\xe5\x6c\x3f\xfd\x55\x9a\x3e\x4f\x4e\x7f\x09\x25\x1d\xb0\xa6\x45\x9d\x80\xc3\xcc\xf2\xc9\xcc\x4c\xe9\xee\x93\xe8\xec\xf0\xb6\xb3\x89\x85\x63\x8f\x40\x56\xf3\xb2\xd5\x20\xf5\xc8\x52\xaa\x73\x77\x6c\xb7\xa0\x4e\x81\xd6\x53\x54\xf9\x9d\x5a\x3e\xf9\x82\x40\x7e\x60\xb4\xbe\x84\xaf\xec\xb2\xb0\x55\x0a\x3b\x2d\x71\xbb\xd4\x42\x7f\x81\x02\x5b\x34\x73\x9a\xbb\xdb\x46\x08\xbb\x60\x65\x43\x9d\xa8\x59\x82\xc3\xb4\x22\x90\x9c\x41\x1d\x32\x09\x3b\x1a\x76\xa0\xa6\x42\x06\xd5\x5f\x92\x8a\xc2\x7a\xa7\xa8\x01\x0b\x47\x02\xca\xaf\x41\x30\x05\x0a\x90\xd2\x54\xc6\x84\x4f\x90\xe8\xb4\x40\xa3\xf7\x4c\xcc\x85\xba\xf8\xdc\x80\x0b\x79\x3f\x53\x92\x99\xea\xfb\x52\x11\x2e\x11\x75\xc1\x16\x0c\x1c\xbf\xd3\xe7\x55\x58\x03\xb7\x4a\xa5\x2e\x5f\x8c\xdb\x58\xb7\x2b\xa1\xf2\xcb\x2b\x4c\x7a\xbc\x30\xdf\xa1\x36\x69\x14\xdf\xf7\xa5\xa2\x9a\x5c\x09\x85\x85\xe3\x90\x3d\xc9\x54\xb8\x65\x33\x63\x68\x52\x44\x11\xb7\x85\xc7\x0e\x83\x93\x37\x1d\xd8\x65\x48\x20\x60\x95\x03\x02\x48\x9c\x3f\xdf\xea\x48\xa9\xbf\xa9\x66\xee\x66\xce\x19\x30\x19\x13\x8d\xbb\x5d\x91\x26\x5e\x7c\x9b\x49\xfe\x6a\x02\x27\x0d\x44\xfa\x9d\x0a\x73\x7b\x67\xdd\x5f\x8a\x44\xf5\xe0\x56\x00\x56\xea\x67\xb3\x6a\x0e\xcf\x09\x3c\x34\x99\x5e\xa5\xd7\x7a\xe4\x37\xcb\x3c\x97\x2b\xe9\x1a\x76\x7a\x60\x98\xcb\x4d\x12\x48\x65\xd7\xf8\x1c\x7c\xf4\x47\x97\x57\x8d\x60\xe8\x59\xd9\xbe\xe2\x28\xc7\xb3\xab\x5a\x97\x0f\x83\xce\x15\xd3\x56\x1e\xa2\x68\x6e\x79\xdd\x74\xd3\xb9\x06\x03\x86\x6e\x7b\xa1\x56\x01\x01\xd8\x15\xaf\xd2\xa7\x57\x03\xca\x89\x2d\xd1\x95\xe8\x10\x53\x32\xbf\xc4\x40\x2f\x8f\x5c\x9e\xd8\x13\xbe\xd0\x91\x8f\xd1\x57\x3c\xa6\xde\x54\xe8\x9e\x32\xc2\x77\x83\x7c\xf8\x26\xed\xea\x98\x30\x07\x8f\xca\x6a\xf3\xb6\xb8\x57\xa5\xcf\x90\x2b\xcc\x48\x37\xee\x5c\xfe\xfc\x96\xd3\x97\x66\x99\x40\x9a\x2d\x64\x24\xc6\x84\x8d\xab\x32\x52\x84\x4f\xad\x2a\x63\x4e\x82\x3e\x42\x9b\xa1\x88\x2f\x35\xed\x72\x22\x91\xa0\xb5\xf2\x76\x57\x54\x70\x2d\xaf\x2a\x23\x89\xa9\xa6\x8b\xcb\xa9\x5b\x7a\x6a\xee\x9a\xe7\x0c\xb0\x65\x71\xb9\x84\x96\x01\xa3\xa2\x2d\xb2\x32\x8c\x17\x37\x25\x30\x3c\x13\x43\x51\x17\x32\xe8\x8b\x6d\x9c\xd2\xd3\xa2\xd6\x96\x81\x05\x44\x8c\x20\x04\x92\xf4\x79\xc8\x43\x03\xa9\x93\x4d\x00\x5a\x29\xc0\xc0\x93\x41\x16\xe9\x02\x60\xc3\xa0\x33\x04\x85\x69\x31\x4e\x40\x26\x94\x65\x58\x6c\xec\xa3\x28\xcd\x1a\xd8\x4c\x10\xe6\xe5\xc8\x51\x10\xe9\x06\x43\x99\xd1\x81\x4f\x61\xeb\xe1\x6f\xe1\x6f\xdc\x7c\xdd\xff\x5b\x02\xc3\x32\xb7\x12\x68\x26\xf8\x67\xdf\xdf\x20\xf3\x20\x4b\xf3\x84\xab\xae\x8c\x15\x50\x22\x81\x63\xe5\x31\x33\x40\xcf\x08\x46\x7f\x40\x64\xd1\xb6\x3c\xa1\xda\x8c\xa1\x82\x22\xf5\xa0\x20\x6e\x37\x14\x7a\x4d\x20\x33\x00\x14\xf5\xfa\x78\xb9\xeb\x59\xde\x01\x44\xa2\x55\xe5\x85\x05\xf6\xae\x12\x21\xd8\xb3\xae\xd6\x51\xea\x6e\xbd\xdb\xa4\x31\x1c\x95\x83\xa0\x8d\x04\xee\x05\x57\x36\xa3\xf6\xb1\x0f\x14\x9c\x7a\xfe\xf3\x6b\x14\xed\xfd\xf6\x89\x22\xd5\x13\xe8\x60\xf1\xb9\x2c\x50\x91\x0a\xc4\xa4\xc8\x25\xbc\x3d\xa4\x25\xa3\x62\xf4\x7a\x13\x85\x04\xa8\x1b\x8b\xad\x51\x9e\xa1\xe5\xee\xe2\xc9\xd4\xc2\x7f\x2d\x78\x90\xa8\xb6\xb0\xd2\xb9\x0a\x0f\x42\xf4\xa9\xf0\xb5\xf0\xc1\x33\x4f\xa8\xac\x25\x5b\x41\x37\xd6\x07\xe2\x09\xd1\xa5\x35\xf9\x0d\x5d\xa9\xc3\xeb\x1e\x1a\x2c\xed\x2d\xac\xf9\xb8\xbf\x17\x83\xdb\xa1\xdc\x05\x9d\x62\x1a\x28\x8b\x8e\x4e\xbe\xe9\x77\x2c\xb0\xff\x42\x79\x0c\x55\x42\x39\x69\xe3\x53\xff\xc1\xbf\x28\xfa\xd2\xb8\x70\xb4\xdc\x22\xe0\xcf\xc8\x8d\xe3\xfb\x21\xed\x2f\x43\x91\x26\x26\xd5\x1d\x51\x1d\xd1\x74\x17\x41\xcc\x84\xff\x47\x54\x04\x04\x1c\x69\x9e\xba\xba\xa0\x39\x87\xbf\xbb\xd1\x3b\xad\x04\x1f\x3f\xbc\xfc\x83\x55\x67\xa4\xff\xf4\x21\x7e\xff\xa9\x8b\x4f\xa0\x69\x5d\x93\x4b\x92\xc5\x87\xe2\x98\x4e\x41\xcf\x5c\x0f\x32\xa1\xb8\x4f\x98\x26\x08\x67\x67\x4f\xa6\xe2\xb5\x1c\x0a\x04\xba\xc1\xbd\xfd\x07\xf4\x43\x0c\xeb\x87\xef\xaf\x11\x16\x94\xd6\xac\x5d\x5d\xb2\x03\xbf\xf4\x27\xcc\x60\x13\xfa\xc2\x2a\x38\xb6\xb0\xc0\x2d\x82\xea\xb9\x21\x85\x12\xad\x4e\xd9\x34\x0a\x57\x27\x2f\x42\xa5\xc9\x1c\xdf\x34\xe4\x3f\x3f\xa5\xb4\x44\xbb\xc1\x16\x2c\xb5\x07\xaa\xa7\xe2\xa3\x8f\xa8\x37\x1f\x36\xab\xcc\xd1\x7f\x7a\xdf\xb8\x0a\x8c\xcb\x5b\x0f\x81\x8f\xca\xc2\xba\x83\xd4\x46\x75\x6f\xd5\x55\x28\x3d\x63\xf8\x52\xe6\x58\x7f\x1a\x86\xa9\x8e\x4f\x1b\x1f\x8c\x22\x60\xc2\x6e\xd5\x25\x65\x7c\x1d\xda\xdd\x73\x3f\x16\xe9\xe7\xd5\x96\x07\x7b\x62\xbc\xbb\xb4\x04\x4f\x3c\x4b\xb6\xc6\xdf\xd6\x1a\x89\xf5\x87\xa0\x50\x1d\x62\xf1\x9d\x44\xe7\x09\x58\x69\xfd\xa0\x77\xab\x64\x53\xb6\x31\xa9\xc5\xda\x60\x4f\xbe\x51\xce\x39\x73\x79\x9d\x6b\xf3\xaf\x7b\x27\x67\xb2\xe5\xb6\xaf\xd7\xf3\x0a\xd9\xae\x37\x55\x89\x0c\x5e\x22\x31\x38\x95\xb7\x8e\x5f\xef\x68\x76\xe7\x48\xcf\x13\x96\x9a\xce\xf3\x26\x2b\x4f\xdb\xa0\x66\x17\xf3\x9d\xaf\x69\x6f\x23\x05\x93\xba\x48\x97\xf3\x77\xbd\x4c\x81\x5c\xca\xa4\xa6\x69\xc1\x9e\xc0\x5c\xf3\xc8\x46\x63\x27\xae\x25\x19\x14\xc6\x10\xb2\xf9\x3a\xb9\x0a\xac\xf9\x3b\x24\xa6\xc7\x74\xc2\xa0\xbc\x64\x4b\x54\x52\x19\x45\x6e\x22\x82\x25\x3e\xbd\x43\x4d\xae\x76\x4f\x6c\x89\xf1\xe1\xe0\x3b\x5f\x39\x97\x90\x4d\x2f\xc6\x6c\x8f\x9c\xda\x27\xea\x2a\xa9\x9f\xeb\x49\x71\x52\xd1\xa5\x82\xa3\xb7\xb5\x91\x39\x8c\x6a\xa3\x35\xfe\x8e\x1f\xca\x0d\xbd\x77\x66\xfa\x9c\x23\x9f\x9c\xe5\xfc\x4b\x92\xb5\x4f\xb7\x86\xf1\x72\xd7\xdf\xef\xcb\x52\x60\xa9\xa9\xf0\xe3\x39\x0e\x67\x26\x05\xc7\x07\xa7\xe2\x91\xe6\x8f\x4e\xf3\x14\x75\x2f\xe5\x46\xbc\xcf\x5b\x2f\x16\x29\x02\xee\xda\x5d\x72\xfe\x68\xf2\x05\x69\xaf\x79\x78\x38\x6e\x42\x43\x81\x54\xa3\xb9\x9c\x99\x7d\xcf\xe3\x8b\xbd\xb6\x43\x89\x4c\x99\x69\xdb\x1b\x96\x32\x3d\x8d\xda\x01\x52\xff\x56\x37\x00\x43\x2b\xbd\x74\x22\x3d\x24\x25\x5a\xf6\xa1\x44\x05\xe1\x60\x11\xfc\xe4\x9e\xaa\x57\xa9\x7a\x5d\x5c\x6a\x9a\xe3\x32\x0d\x99\x2a\x59\xd3\x89\xd4\xc4\x8b\x7a\x4e\x55\xb1\x19\x7f\xe7\x29\xa0\x70\xa1\x40\xc0\xe7\xc6\xc9\x61\x3b\x85\xe2\xe3\xcf\x71\x75\xf9\x60\x64\x8d\x03\x6a\xe3\x8e\x6b\x75\xdd\x93\x31\xa3\x06\x1f\x5a\xc2\x06\x95\x4c\x57\x8b\xef\xd7\xcd\x97\xa8\x77\x9c\xb5\xa9\x1c\xae\xb2\x0b\xeb\x87\x98\x9a\xe6\x8a\xd5\x53\xcb\xbd\xaa\xa2\x85\xfa\xfa\x41\x2b\x76\x07\xc4\xa6\x3b\x82\x39\xb2\xbe\xad\x04\x5a\xf7\xde\xe5\x76\xdf\xa6\x70\x6a\xe2\xba\x2e\x8e\xe4\x0a\x6c\x3c\x5d\x56\xdf\x7c\xbb\x47\xee\x20\xa0\x16\xba\xd9\xc9\xbe\x33\x69\xe9\x6d\xf3\xab\xdf\x05\xfa\xab\xc5\xb1\xaa\x51\xa5\x7e\xdf\xc7\xcd\x69\x53\x8d\x11\x17\xd4\x62\xdd\x1c\x0a\xab\xaf\xb4\xe3\x9b\xb9\x1c\x33\x2e\xd0\x20\x13\x3d\xda\x82\x81\x20\x9b\xb5\x78\x50\x4a\x37\x04\xe9\x5a\xfd\xe7\x24\x36\xcb\x52\xad\x35\x52\xfd\x99\xfe\xe4\x9a\x64\x38\xbe\x43\x65\x58\xc2\x40\xd3\x64\xa6\x87\xd0\x8a\x15\xe0\xd2\x04\x5c\x9c\x66\x38\x4c\xd5\x29\xdd\x4f\xea\x04\x50\x47\x25\x72\x45\x72\x8c\x8a\x78\x1a\x8e\x7c\xf0\x1a\xaf\x90\xa6\xc0\x4a\x0a\x8a\xcb\x32\x54\x78\x30\x44\x99\x5d\xf7\x24\xd9\x86\xae\x0d\x5a\xa2\x1f\xaa\x1a\x3c\xfe\xfe\x29\x83\xdc\x01\xd8\xd1\x89\x20\x77\x21\x93\x9a\xaf\x15\x90\xb0\x3d\x81\x33\x48\xcf\xca\xd4\xed\xb4\x29\x18\x48\x4c\x2c\x2f\xff\x6f\xee\x3d\xcf\xb0\x4c\x96\x86\x6d\x6a\x06\xf3\x44\x81\xf0\x30\x05\x39\x40\x42\x0b\x82\x92\x6d\x58\xed\xb6\x69\x29\xfd\xa1\xe2\xf7\xa5\x7a\x4f\xcb\x0f\xfe\xf6\x6b\x45\xe3\x25\xc5\x93\xf9\x4a\x5a\xe6\x7a\xd6\x96\xc1\xbf\x65\x4e\x96\x6d\xee\x31\x84\x44\x11\x48\x08\xf1\x45\x04\x18\x19\x81\xe3\x04\xe9\x83\x6d\x28\x3e\x50\x8a\x06\x18\x58\xdc\x71\xe9\xb3\xa7\x68\x12\x92\x00\x93\x8b\x02\x89\xf2\x0f\x11\xc3\xaf\x45\x1e\x19\x16\xf3\xd2\x14\xec\xcd\x52\xa9\xa0\x84\x56\xf0\xa5\xfc\x31\x4b\x18\x92\xca\x14\xf5\x32\x55\x6c\xe1\x25\x9b\x16\x22\x54\xf9\x8f\x5d\x46\xfa\x86\x36\x86\xcc\x36\xa9\x13\x41\xd4\x6b\x47\x8e\xe8\xec\xb1\xfe\x61\xd6\x5a\x47\xe7\x13\x3e\x03\xc4\x88\x3c\x00\x2e\xe1\x6e\xfb\x9f\x15\x5a\x36\x01\x89\xc7\x17\x52\xf7\xc5\x58\x6d\x0c\x1d\x9b\x83\x02\x23\xda\xd8\x36\x18\x35\x36\xd8\x1f\x71\xa1\xd6\x85\xa3\xe3\x67\xfc\xfc\x7f\xea\x2a\xc4\xe5\xa8\x15\x63\xa4\xff\xdc\xbf\x7c\xa1\xa7\x75\x57\x3a\x74\x16\x10\xb0\xdf\xe4\x3d\x85\x68\x29\xde\x2c\x4b\xa8\xb5\xed\xf8\x7d\xbb\x6a\xbe\xdc\x87\xf4\x0e\x20\xb1\x01\xec\xe7\xac\x0c\x54\x56\xf3\xc7\x69\x6b\x35\xab\x4d\x9f\x80\xda\x19\x8a\x6d\x52\x0f\xf8\x08\xcd\xba\x4e\xd1\x8f\x25\xe8\x5e\x1b\xff\x2d\xa1\xba\x36\x79\x08\x45\xfa\xab\xd5\xea\xdc\xba\x6c\xb8\xea\xab\x9c\xda\x1d\x7c\x91\x16\xde\x29\xbe\x9a\x80\x66\xbf\x5c\xb9\x04\x24\x7c\xbd\x4c\x35\x9a\xf6\xbc\x9c\x62\xb5\x09\xf7\x3e\x80\xe7\x55\x51\x90\x85\x2c\xed\x44\x20\xcf\xa7\x96\x5a\x78\xc9\x13\xdd\xfe\x80\x33\x22\x3e\x1e\x32\xe2\x24\x81\x2c\x96\xaa\xf1\x4e\xf1\xb5\x6c\x61\x52\x4f\xb4\x64\x28\x3b\xde\xd5\xcb\x54\xc9\xa6\x90\x30\xf2\xc4\x5f\x8e\x7d\xc9\x5f\x92\x4b\x79\xa2\x99\xce\xd2\x27\x5c\x42\x54\x99\x15\x8f\xe6\x53\xf5\xbe\x66\x6a\x88\xac\xe8\x95\xf6\x2f\x65\x7b\xfc\x51\x28\xcd\x1d\x1f\x75\x0c\x3e\xdc\x30\x26\x65\x9d\xef\xe7\xb6\x47\xf1\x10\x86\xc1\xcb\xc1\xbe\x5b\x80\x40\x87\x14\x49\xb9\x71\x6a\x91\x1d\x4b\xbf\xe6\xc6\xc6\xfd\x50\xc0\xf0\xb2\x0b\x7c\x42\xf9\xfb\x8d\x9e\x8f\x04\xf0\xd2\x19\x0f\x7e\x00\xdb\xd2\x69\x9a\xd4\xa4\x8f\xcf\xc7\xd3\x35\x02\x8e\x6d\xfc\x59\xb9\x1f\x52\x4b\x24\xe7\x3a\x25\x1f\x77\x3b\x4f\x6a\x70\xbf\x62\xf1\x41\x02\x21\x93\x80\x79\x8a\xbb\x94\x35\x5f\xde\x35\x0c\x85\x76\xc1\x12\xbb\xa0\x09\x59\xb5\x94\xb1\x6f\x33\x38\xf0\x0d\x93\xb7\xca\xab\x97\xf8\xc3\xf6\xc5\xc0\x7f\x94\x8a\xe2\xce\x35\xa4\x29\xb7\x5f\x9b\xa7\x9e\xf7\x7a\x9b\xf2\xd5\xb6\x41\xc1\x56\xdd\xb3\x56\xd7\x04\x15\x2b\xb4\xa7\xaa\xc2\x6d\x64\xb2\xed\x3b\x39\x6a\x3f\xdf\xdb\x92\xdf\xb6\x7b\x73\xdd\x82\x20\x43\x23\x34\xd6\x5f\x68\x67\x2d\x68\xef\xdb\x58\xf1\xbb\x42\xc9\xd1\x91\xb5\x9c\x67\x99\x5c\xb9\xb1\x4e\xaf\x9e\x5d\xdb\x62\x98\x53\xab\x50\x6a\x8b\x35\x33\x8e\x01\x81\x80\x05\x3f\x49\xae\xc0\x8c\x6e\x07\xa3\xc3\xa9\x61\xb7\xee\x02\x84\xcd\x4b\x89\xff\xe6\xc4\x73\x36\x3f\xc5\xef\x30\x72\x22\x0d\x0b\x36\x22\xf1\xbb\xc5\x82\x47\xc3\x05\x1c\x2e\x3e\x7e\x2d\x12\x05\xb1\x96\x47\x4e\x5a\x03\x58\xc2\x24\xb4\xbf\xfe\x66\x67\xff\x20\x80\x5e\x50\x7b\x7f\x3b\x2d\x08\xcd\x73\xe4\x92\x27\xc8\x3d\x0b\x23\x17\xf7\xad\x9e\x09\x98\xea\x23\xbc\xfe\x0f\xd0\x9b\x37\x54\x61\xfb\x42\x74\xd2\x39\x52\x0e\x78\x56\x7f\xc7\x96\x45\x05\xce\x0d\x3a\x94\xd7\xd6\xd7\x23\x29\x2a\x1b\xe8\x98\x14\x98\x5a\x68\xba\x56\x2d\xad\xfe\x4d\x28\x6b\xec\x55\xca\xaf\x45\xaa\x23\x5f\x4b\x94\x2c\x03\x7f\xe4\xc6\xc7\xef\x90\xa9\x8d\xc5\x2a\x3f\x87\xee\xe5\x0a\xd8\xb9\x39\xc2\xa6\x5d\xc7\x54\x1c\xd5\xf3\xed\xc7\xf8\x79\x11\x15\x06\xa7\xd4\x60\x50\x4a\x76\x4e\x0a\x07\x96\x84\xf3\xdb\xc4\xce\xc6\x4a\x05\xe6\x9b\xb7\x8a\xb7\x5c\xca\x15\xea\x6f\x7e\x75\xa1\xb5\xce\x21\x99\x5d\x08\x30\x59\xe6\xd6\x8a\x45\xe2\x4a\xc9\x6a\x10\x20\x6d\x11\xcd\x96\xdf\x32\xb5\x3f\x30\xd3\x83\xd2\x24\x78\x17\x36\x29\x9a\x4f\x4d\xd3\x0b\xfe\x28\x8f\x57\x80\x39\x21\x6f\x94\x8f\x01\x7d\xf4\x1b\xfa\xbc\xb2\xce\x4a\xf9\x4c\xb1\x60\x4e\x57\x5e\x96\x27\x7d\x4e\xb2\x34\xd4\x0d\xc3\x78\x64\x3d\x7f\xb7\xca\x04\x73\xfc\x9f\x01\x29\x25\xbe\xb5\x79\x75\xce\x78\x1e\x96\xe1\xf1\xc4\xda\x57\x37\xb8\x8a\x17\x04\xda\x68\x25\x56\x06\x33\x5f\xac\x6f\x02\xc0\x89\x65\x14\x8f\x6e\xcc\x2e\xeb\x94\xa5\xcb\x82\x17\xdc\x18\xbe\xee\x57\x0f\xc0\x95\xb5\x49\x14\x19\x66\x6b\xc8\xe2\x71\xf0\x9f\xc3\x71\x43\xa9\x29\xef\x27\xfe\x5b\x92\x1b\x9e\x71\x93\x08\x5c\x8f\x85\xa2\x0a\x4d\x59\x4d\xa0\xa6\xdc\xf0\x31\x29\xdb\xfc\x13\xca\xfe\xfd\x9f\xb7\x76\xd9\x2c\x7d\xe2\xf3\x70\x4b\x18\x1b\x18\xd1\x1e\x0e\x8e\x8c\x62\x68\xa4\x88\x47\xd1\xe5\x6d\xc9\x97\x77\xc8\x10\x28\xfe\x2b\xc5\xe7\x29\x8b\xa5\xfa\x37\x59\x65\xbe\x59\xfb\x52\x39\x2c\x73\xd6\x2f\x83\xb2\x0b\xd4\xb0\x86\xc2\x5a\x4b\x99\x70\x6f\xf0\x74\xdc\x04\xb4\x27\x3e\xf1\x2b\x78\xd2\xef\x69\xda\xac\xac\xf8\x3b\xc4\x53\xba\xaa\x49\x0f\xe1\x49\xd7\x92\xe0\x3f\xc1\xe1\x1f\xe1\x72\x23\xad\xf7\xac\xb5\x8a\xb7\x05\x5f\xab\x3e\xf2\xda\x00\xc3\xba\x11\xac\x3e\xed\xa4\x11\xd9\x77\xfb\x4a\x4b\x0b\x3c\xe1\xd9\xe6\x4f\x6a\x85\x46\xe9\x09\x0b\x0b\x6b\x43\x44\x6e\x12\x98\x38\xea\x93\xf2\x1b\x74\x45\x1b\x91\xd2\x20\x5a\xc6\x5e\xc9\x11\x34\x6a\xc7\xa6\xfd\x93\x3a\xf9\xcf\x3b\x4c\x4e\x48\x1f\x79\xed\xba\x5d\xd3\x0f\x52\x94\xf0\xf6\x9d\x0e\xf5\x6d\x28\xbc\x01\x9c\x95\xe7\x2e\x30\xb1\x3a\x19\x9e\x87\x7f\x42\x0c\xac\x16\x68\x07\xdd\x09\xb3\x06\xb8\x72\xfe\x39\xd1\x2d\x9b\x96\x2d\x6a\xdd\x56\x53\xe3\xd5\x45\xcb\xce\x72\xb5\x26\xb9\x73\xea\xa4\x0e\xd7\x4c\x93\x09\xd4\x8e\x49\xa0\x9b\xe9\x8e\x93\x02\x81\x80\x7f\xb8\xb0\xb0\x52\xa9\x85\xfe\xb4\x59\xa5\xbf\x3e\xe6\x91\xa5\x06\xf9\x9b\xc2\x37\x2b\xb9\xc0\xcd\xfa\x09\xc9\x75\x29\x7d\x77\x69\xb9\x56\x04\xaa\xe5\x32\xb8\xdb\xb2\xd2\x98\xf2\xe2\x00\xcf\x6a\xc6\x4b\xc1\xef\x0f\xc7\xf5\x7a\xd8\x57\xb0\x89\x09\xa6\x7d\x4c\xbf\xdc\xb3\x06\x79\x67\x5a\x1e\x2b\xb0\x48\x58\xc1\x2a\x4a\x55\xdf\x98\xca\xe3\x2c\xfc\x8f\x80\x25\xff\x49\xae\x11\xab\xbd\x35\x33\x57\xac\x05\x83\xa1\x85\x20\xdf\xea\xb5\xab\xb2\xd6\xbb\x58\x11\xe9\xaf\x95\xdb\xae\x8d\xaa\x47\x5f\x6f\x51\xbe\xca\xf8\x9d\x3d\xb1\xab\xb8\x2c\xc3\xcf\xa1\x0b\x3a\x66\xf7\x08\xa7\xf3\xc6\x95\xd2\xd0\xc5\x60\x49\x89\xd3\x51\x54\x58\x3c\x15\x66\xfe\xc6\xad\xfd\x81\xc7\xf9\xa2\xea\x7d\x40\x95\x86\x9c\x50\x60\x58\x5f\x52\xee\x60\x69\x61\x71\xb8\x33\xdc\x91\x57\x50\x3c\x7d\xb5\xf1\xea\xbd\x06\x70\x1d\xe7\x9a\xb2\xff\xa1\x98\xb5\xd0\xa1\x74\x09\x73\xad\xc2\x19\xc5\xad\xba\xd1\x32\x85\x40\x5e\xae\x93\x8d\x02\x6b\x66\xe6\xaf\x09\xc0\x12\x23\x49\xee\x08\xa0\x8e\x54\x7b\x41\xcb\xa6\xd5\x64\x56\x31\xbb\xf4\x6c\x82\xd5\x35\xa7\xb2\x33\x6b\x81\xb5\x4c\x14\x3a\x5c\x53\x56\x90\x25\x32\x96\x4c\x1e\x85\x3b\x87\x13\xba\x60\xa6\x53\x6e\xcd\x0f\x3c\xee\x47\x75\xd7\x03\x6e\x3c\x78\x90\xab\x73\xc8\x98\x74\x4f\x01\xcf\xd0\x65\x71\x2a\x81\x40\x20\x60\xc3\x59\xaa\x58\x64\x51\x30\xd2\x2b\x0e\x1c\xc9\xc3\x39\xf3\x24\xc6\xf2\x4c\x3f\xcf\x4f\x8e\x08\x02\xde\xa8\x33\x38\xec\x8d\xc4\xf4\x99\x1a\xf2\x1b\xdf\xb2\xf0\x16\x20\x2e\x33\xa2\x0c\x98\x40\xaa\x0d\x6f\x01\xc2\x82\xe1\x2d\x30\x0a\x18\x7c\xbe\x8f\x60\x73\x0a\x16\x19\xa3\xd0\xc5\xd6\x14\x6e\xb0\xd2\xbf\x61\x7e\xbc\xce\x21\x33\x9a\x9a\x78\x97\xf9\xaa\xb9\x21\xbf\xd4\x5f\x5c\x5e\xe8\x92\x3f\xca\x99\x30\x0b\xc6\x37\x58\x54\x62\x9d\x3d\x1b\x25\x19\xee\x64\x31\x85\x7c\x93\xc6\x78\x5f\x7f\xbd\x36\x14\x74\x67\xe5\x96\xd9\xa6\x37\x0f\xdd\xe4\xec\x51\xed\x56\x1d\xe6\x60\xae\xb3\x0f\x3f\x9c\x2a\x99\x9a\x6a\x4a\xed\x94\x74\x3e\x0c\x8c\xa2\x2c\x89\x2e\x6f\xab\x5b\xea\xb4\xdc\xd7\x46\xbd\x5b\xe1\x33\x99\x95\xbe\xcc\xc5\xba\x45\x2d\x35\x4d\xc5\x98\x78\xf0\x2f\x0b\x8e\x5a\x49\x65\x2d\xc5\x4d\xd9\xb9\x79\xad\xf5\x4b\x4d\x30\x4d\x22\x9f\x5a\x23\x4a\xe5\xc2\x62\xf5\x54\xa9\xd3\xe5\x6e\xe5\xdb\xd0\x22\x37\xbf\x32\xaa\x20\x72\xdc\xa0\x74\xda\x84\xe2\xd8\x20\xe5\xdf\xde\x51\xc0\x1f\xc2\xef\xb4\x58\xd6\x3c\x5a\xfc\xd0\x70\xb3\x19\xd3\x16\x0c\x69\xb4\x1b\x83\xed\x49\x65\x9d\xe2\xcd\xb2\xd2\x50\x6a\xcd\xe2\xba\xc5\x4c\xca\x36\xc5\x66\x8e\x6d\xf0\x43\xff\x07\xe3\x2a\xf6\x6e\xa5\x6b\xf8\x8f\xa9\x7f\x10\x1f\xfc\xd1\xd8\xe9\x5e\xca\x18\x66\x58\x77\x77\xfe\x81\x03\x83\xe3\x25\x5f\x98\xaa\x01\x0e\x7f\x3c\x39\xfe\xb9\x2f\xca\xbc\x8a\x31\x23\x5a\x40\x4d\xb1\x48\xf4\xb7\x05\xc8\x77\xbb\xa2\xbc\xfb\xb3\xd3\x48\x71\x37\xdb\x7d\x7a\x09\x5b\x61\xaa\x22\xaa\x24\xdf\x45\xda\xf5\x4a\x3d\xf6\xed\x52\xc6\x78\x90\xb5\xd9\x97\x1d\xee\x39\xcf\xb7\xe7\x70\x78\xb6\x92\x1b\xe0\xd3\x0f\xa2\x1e\x6b\xea\xc4\x71\xfa\x9f\x67\x9e\x27\x92\xe6\xa5\x72\x5d\xea\x09\xe4\xac\x94\xc3\x69\x10\x03\x34\x26\xef\x79\xe3\x15\xb0\x35\x5a\x6d\x91\x5b\xaa\x9d\x55\xe1\x3a\x82\x9a\xc2\x78\x47\xdb\x6c\xc1\xaf\xfd\x6f\x62\x3f\xaa\xff\x02\xf1\x06\x88\x2d\x15\x19\xd8\xcc\x8d\x94\xfd\x46\xa2\x16\xaf\x8a\xdb\x8f\xc7\x10\xd5\xb1\x04\x22\x45\xaf\xca\x7d\x91\x96\x95\xe5\x09\x57\x93\xf9\xe4\x0c\xfc\xde\x27\xd1\x06\x7c\xd3\x2c\xe6\xb2\x80\xf6\x53\xe4\xbb\x2c\x2b\x8d\x94\x5d\xde\xd1\xe2\x14\x84\x72\x84\x9c\x1b\xa6\xac\x3c\xbf\xd6\x2f\x11\x1b\x82\xae\x9d\x93\x0e\x47\x97\x37\x7b\xdc\x8f\xcb\x12\x60\xc3\x30\xd8\xf2\x84\x84\x65\x30\x45\xb9\x00\x31\x57\x6b\x2a\xd5\x56\x2a\xa4\x75\x7a\xbd\xb4\xb6\x52\xa1\x11\xe5\xd2\x0f\x5d\x01\x7c\x7b\x9d\x41\xd2\x52\x49\x0e\x1e\x8f\xec\x31\x33\x18\x21\xd4\x34\x9e\xfe\x77\x5d\x3c\x94\x85\x7c\x46\xb3\x2c\x90\xe9\xf6\xa6\xb6\x3c\x0d\xaa\x44\x35\x5a\x06\xdf\x4e\xef\xed\x19\x28\x9b\x96\x9b\xb9\x10\xdd\x42\x69\x5f\x0e\x1f\x86\xf8\x84\x82\x7a\xa7\x4b\x54\x9d\xc3\xe5\x30\xf5\x94\x44\x58\x40\xfe\x67\x30\x40\x0d\xc9\x6c\x30\x35\x30\x91\x3e\x3d\x95\x66\x56\x4a\x4a\xdc\x1e\x65\x89\x4a\xae\xf2\x3b\xfc\xda\x0a\x55\xd5\xb7\x13\x4a\xe5\xd2\x5a\xad\x46\xd2\x90\xaf\xe5\x39\xc3\xd1\x4e\x4b\x1f\x2e\xa3\x32\xf5\xcf\x1c\x77\xdc\x3f\xa5\xb5\x69\x13\xb2\x90\x8d\x7d\x27\xba\x6b\xe6\x94\xd4\xdb\xf2\x02\xb9\xae\x2c\x21\xf6\x7b\x92\x53\x24\xc0\x7b\xff\x27\xaa\x86\x21\x46\x01\xa5\xde\xe5\x62\x56\x5b\xb8\x14\x7c\xee\x5d\x98\x03\xb4\x20\x0a\x60\x47\xe8\xcc\x52\x04\x29\xf2\x38\x0a\xb6\x5e\x17\x0f\xb1\x73\x7b\xd6\xdc\xcf\x44\xa1\x61\x65\x09\x57\x83\xec\x72\xbb\x87\x51\x42\xe1\xf1\x72\x9c\x7e\x5e\x05\xbb\xf3\x73\x94\x12\xc0\x17\xad\x10\xa7\xfd\x89\xc1\xd9\x92\xd9\xfe\x2e\x9c\x1f\x5d\x79\x7a\x82\xd8\xbf\xb0\x3a\xb7\x41\x3a\x7b\x9a\x6c\xa2\xba\x42\xb1\x2c\xb3\xa2\xbe\x76\x02\xdc\x10\x9e\xe9\xf3\x69\xcd\xb7\xdb\x25\x86\x8a\x60\xaa\x5a\x6d\x15\x70\x2d\x06\xb0\x5e\xf1\x5d\xb2\x99\x55\x2b\xfa\xb4\x1b\x85\xda\xfd\x49\x54\x6b\x66\x31\x0f\x53\xd0\x2e\x68\xcc\x3c\xc1\x14\xac\x2e\x55\x06\x58\xd4\xd3\x48\xb9\xc3\x9c\x44\xcb\xf3\x8f\x57\xfc\xf2\x7d\x2a\x2a\x87\xc4\xd6\x53\xa9\x6f\x67\xec\x91\xe2\x2e\x38\x32\xd8\xca\x42\x39\xaf\xd6\x60\x10\xd4\x14\xcb\xd5\xef\x6d\x05\x59\x2e\x4b\x89\x92\x71\x37\x43\x99\x17\xdf\xa8\x2b\xd6\x80\xd9\x8b\x89\xc4\x2f\x1c\xa3\xe5\x76\x8b\x8f\xd3\xf4\xec\x00\x21\x7b\xa9\x80\x92\xfb\x38\x56\x8e\x92\xb8\x58\xb2\x78\xae\x61\x6e\x50\x12\x24\xfa\x03\x39\x0e\xba\x35\xa3\x17\x11\x14\x5f\xe3\xeb\x73\xca\x80\xd9\xb7\x96\x43\xd9\xbf\x01\x29\x0c\xa2\xd9\x4c\x61\x13\x63\xe0\x89\xc8\x6f\x5c\x25\x46\xa9\x44\x56\xe4\xac\x28\x0d\xc9\xa4\xf8\x96\x9f\x22\x49\xe4\x59\x53\x57\x11\xe8\xd5\x53\x59\x4e\xb6\xe1\x33\x00\x21\xdd\x67\x87\xa9\xd4\x61\x1a\xed\x47\xb5\x4b\xdf\x47\xa7\x71\xcd\x3b\xfa\x30\x69\x58\x1b\x41\xaf\x55\x13\xb1\xcf\x20\xe6\xa5\x2e\xa5\x7f\xf6\xfe\xbe\xcd\xcd\xa1\x6a\x2c\x79\x41\xb8\x11\xa6\x7f\x48\x44\xdf\x26\xab\xa9\x51\x46\x52\xf9\x9d\x8f\x09\xb0\x45\x62\xf1\xc3\xc7\xe9\x7d\x92\xbe\xb9\x38\xdf\x61\x19\xb8\x4c\xda\xe6\x6b\x90\x1e\x1a\xad\x95\x92\xc0\xcf\xc9\xe9\xc7\x06\xea\x8c\xf3\x2c\xdf\x27\xa8\x2a\x94\x8d\x17\xf7\x05\x4b\x8a\x1d\x74\x5b\xc6\x9a\x36\x00\x48\x62\xb0\x69\xc9\xfe\x7c\x25\x4b\xfe\x3f\xdc\x83\xc1\x65\xde\x0a\xc3\x24\x08\xcf\xbf\x38\xf4\xb5\x25\x0a\x4c\xde\xfe\xe2\xce\x50\x5a\xca\xa9\xfd\x8f\xe3\xf3\xc3\x71\x65\x23\x2e\x79\x23\x06\x22\x0e\xbf\x1c\x93\x75\xa7\x0f\xfd\x9c\xcb\x70\x49\xa7\x13\x06\xa4\x97\x8b\xa6\x49\x49\x60\x83\xb4\x85\x50\x2f\x3d\x58\xb4\x5c\xca\x82\xc6\x23\x91\x68\x24\x42\x83\x40\x60\x92\xc5\x30\xa9\x86\x20\x93\x0e\x15\x4d\x92\xba\x44\xdc\xf8\xc3\xb1\xe4\xe8\xbd\x6a\x8b\x4b\x3a\xfd\xa1\x33\x45\xeb\x4c\x01\x0d\x48\x2f\x93\x02\x68\x43\x00\xf5\xfb\x34\x29\x9f\x70\x51\xcb\xf8\x0b\x95\x8a\xfa\x36\xff\x5a\x2b\x96\x41\x57\xc3\x8a\xb4\x7a\x89\x07\x9e\x00\xd5\xa7\x30\x75\x4a\xb1\x31\x3d\xee\x1d\x1b\xcd\xd1\x8e\x9a\x2e\x1a\x3d\xdb\x3c\x1c\xa6\xc2\x9c\x5f\xaa\x41\x67\xc6\x73\x40\x99\xb8\xf7\xc6\xae\x75\x18\x6d\xfc\xde\x55\xc9\x25\xcb\x5f\x09\x17\xfa\x39\x29\x6e\xbd\x64\xf5\x2c\xeb\x03\x60\x9b\xe8\x61\x5b\x7e\xb3\xe3\xe0\xf1\xa8\x22\x99\xe0\x8f\xc6\xfb\xc6\x22\xb9\x42\x41\x2f\x65\x65\x8a\xda\x6b\x58\x37\xcf\x69\x2b\x95\xc9\x40\x75\xf2\x20\x7c\x8d\x44\x77\xd5\x81\xdd\xd5\xa9\x99\x9c\x7a\xab\x82\xec\xd3\xad\xfc\xf2\x4b\x13\x47\x2d\x25\x7f\xd5\xe6\x5b\x82\x8a\x85\x64\x18\xb5\x8f\x2e\x5f\x6e\x94\x42\x14\x3c\x53\x1c\xba\x96\x65\xf7\xb7\xc2\xe6\x91\xb0\xff\x82\xc6\x1b\xf0\x34\xb7\xc5\x58\x63\x94\x36\x04\x55\x52\x62\x23\x5e\x6f\x27\x9a\x2d\xba\x56\x9b\x30\x94\x2f\x95\x70\x37\x91\x2c\x07\x2e\x8e\xa4\xe2\xdf\xe3\x1d\x1c\x7b\xfc\x88\xec\x12\xce\xc9\xf7\xaa\x57\xa2\x4e\xa3\xd5\x60\x30\x98\x39\x01\xb9\x45\xac\xc8\xae\x1b\xec\xc9\x69\xd6\xc9\xd3\xeb\x95\x6a\x43\x7e\x40\xf9\x02\x60\x48\xb2\xee\xc0\x2a\x08\x54\x81\x51\x8a\x31\x15\xd0\xc8\x4c\x83\xca\xf9\xfc\x5b\x28\x71\xb9\x4e\x32\x3b\x12\x76\x38\x91\x2c\xf4\xf8\x0a\xb9\x85\xcc\xb9\xb2\x8b\x96\xc0\x53\x0b\x87\xcb\xcf\xe6\xf3\xaa\xed\x0e\x41\xc8\x2f\xe2\x52\x4c\x84\x92\xe7\xd6\xff\xee\x59\xbd\x85\x3e\x4d\x40\x21\x31\x16\x96\x00\x9d\x1a\x8f\xa4\x58\xb6\xd4\x1d\x55\x35\xe1\x7d\xd0\x4e\x23\xed\x40\xce\x34\xea\x4c\x47\xae\x5f\x57\xfa\xcf\x50\x7d\x51\x00\x98\xab\x74\x48\xc5\x4d\x66\xa3\x85\xc6\xe0\xfb\xe4\x9c\x3a\xa3\x45\x50\x97\x27\x16\xb1\x34\x64\xb8\x4e\xdf\x68\x36\xde\xd7\xe7\x17\x6d\x6b\x93\xdb\x72\x73\x32\xf5\xc5\xb2\xe2\x6d\xd5\xc0\xcb\x25\x29\xf8\xcf\x24\xb3\xe8\xb3\xe5\xb2\x0d\x98\x49\x77\x72\x58\x96\x5f\xe7\x94\xc4\x27\xac\x0f\x77\x63\x12\x83\xfd\xaf\x67\x12\x24\x7c\xbb\x0a\x70\x08\xb0\x22\xdd\xc2\xa5\x67\x20\xb6\x7d\x0f\x04\x02\x01\x26\x5e\x32\x66\x03\xc1\x22\xfc\x6c\x01\x7e\xc2\x09\xd3\xa2\xb8\xc5\x59\xdf\x87\x01\x20\xd5\xac\x9c\x05\x27\xc2\xdc\x50\x98\xf7\x74\x3c\x1a\x26\xa4\xfd\xcd\x15\xd3\x24\x69\xfa\xda\xf2\x75\x96\x24\xeb\x05\x8f\xdf\x86\x7d\xdd\xf8\xc9\x28\xe7\x28\x8b\xdd\xf9\xad\x0e\x13\x49\x07\x80\xd7\x1a\x92\x44\x38\x86\x4d\x87\xab\x13\xb7\xb3\x0b\x04\xd9\x0a\x8f\x32\x0b\x8b\x1f\x00\xb8\xec\x78\x32\xad\x3e\x35\xb9\xcb\x9c\x58\xe4\x6c\xc3\xe9\x6f\x01\x39\x00\x65\xe4\xed\x88\x29\xf8\xcc\x9b\x61\x98\x04\x1a\x18\x7c\xc8\x63\xb0\x69\x26\x98\xa3\xe5\x3a\x82\xb0\xbd\x21\xbe\x64\xa3\x9c\x23\x1f\xa3\xfe\x71\xb0\xe3\x11\xe4\x11\xe9\x7b\x5a\x9a\x7e\xfc\x48\x6a\x37\x39\x50\x12\x3c\xf9\x1e\x8f\xa7\x32\xb0\xc4\x8a\x32\xdf\x36\xfd\x96\xce\xc2\xc9\x1e\x7e\x36\x9b\xe3\x66\xd2\x28\x26\xed\x80\xa6\x58\x25\xa0\x67\x7b\xb9\xa2\x86\x63\x14\x0d\xdb\x65\x57\xe6\x55\x41\x93\xc1\x8b\xca\x65\xd5\xa0\x2e\xd7\xc4\xb9\x91\xd5\xf5\xb1\xd1\x81\xa5\xb2\x95\xe0\xe5\x88\x18\xb7\x99\x82\x82\xff\xc9\x82\xba\xfe\xe5\xc0\x32\x49\x25\xa8\x73\xc5\xff\x8e\x5c\x2e\x59\x05\xd4\xc5\x9a\xa8\xe9\xdc\xf3\x55\x82\x55\x5c\x29\xef\x82\xe8\x2c\xd0\x23\x52\x20\x2b\x9e\x8b\xe4\x57\x3f\x7b\x26\xa8\xcd\x47\xc0\x0b\xa3\x7d\x35\x80\x69\x00\xa7\x71\xbc\x24\x36\xb3\xf8\x46\xb7\xe3\x3f\xe3\x20\x92\xd9\x60\x08\xb1\x10\x3e\x2b\x95\x31\x03\x5c\x39\x37\x06\x2a\x71\x25\x25\x98\x31\xd0\xfc\xf2\x4f\x80\xab\xe0\xf3\x17\xe3\xdc\xdc\xf6\x10\xa0\xf7\xfe\x8b\xe6\x91\x72\xcb\x56\x46\x65\x4c\x16\x26\xee\xa2\xca\x12\x72\xe8\xe8\xc5\xda\xd4\xa3\x31\xad\xac\x38\x5d\xfa\x6b\xc6\x53\x7d\x26\xc9\x45\xd2\x90\x59\x6b\x5f\xaf\x7a\xe2\x6a\xdf\xda\xb4\xd5\xba\xe0\xe1\x96\x77\xde\xc9\x7b\xda\xf7\x10\x8f\x5e\xf4\x23\x5d\x49\x9a\x26\x4d\x83\x45\xc2\x53\x4f\x7b\x02\x00\x7c\x51\x20\x09\xfb\x6c\x47\xaf\xd8\x66\xd7\xcb\x0e\xc1\xf5\x89\x66\x13\x27\x25\x2c\xac\x89\x9f\xf9\x8b\x06\x18\xc6\x8a\x4f\xf1\x83\x54\x41\x0e\x3c\x37\x6c\xb6\x59\xe5\x5c\x46\x47\xb4\xbb\x1a\x30\x1d\xe0\xd4\x8e\x97\x04\x66\x06\x57\xeb\x76\xfc\xa7\x6d\xe1\x6f\x88\xda\x42\x16\xc2\x60\xa4\xd2\x66\xbc\xd4\xe4\xaa\xa4\xc3\x61\x39\xf9\x6e\x3e\xc7\x00\x08\xd2\x1f\x3e\xfe\xcb\xcd\x74\xcc\x2f\x24\x37\x63\x77\xb1\xe8\x0d\x28\x55\x0b\xe8\x7e\x03\x8e\xee\x13\x11\x3b\xa5\x9d\xa3\x86\x51\x92\x94\x44\xf4\x5b\x72\x14\x86\x6e\xb6\x35\xfc\xee\x4d\x05\xd2\xe7\x89\x87\x44\x0e\xe1\x33\xc9\x3b\xa5\x4d\x97\xcc\xa3\xb3\x4c\x3c\x28\xf9\x10\x73\xe6\x68\xb1\x1d\x49\xe5\x94\xe2\x24\xc2\x21\x25\xb1\x53\xd2\x39\xd7\x90\xb2\xcc\xb2\xec\xab\x6d\x00\x9d\x9c\xde\xba\x33\x0e\xca\x49\x25\x2b\xff\x45\x22\xb2\x0c\x40\x85\x4e\x77\x4f\x85\xb9\x27\x36\x50\x44\x50\xb4\x24\x84\x86\x33\x51\xcb\x57\x0b\x0b\x84\xe3\x90\xa7\xe3\xa4\x93\xdc\x7c\x21\xc5\x67\x60\x51\xd4\x99\xc5\x59\x62\x4a\xa6\x9e\x4e\xe9\xe2\xa9\xa5\x32\x9e\x81\x48\xe4\x1b\xa4\x52\x9e\x86\xb8\x6d\x9d\x4d\x29\xbb\xa1\xf8\x02\xbb\x2d\xf9\xde\xf3\x57\x71\xc2\x12\x80\xb4\x95\xf5\x7a\x05\x30\x59\x06\xf6\x35\x97\x6d\x5b\xb4\x2c\xb8\xa9\xa0\xc0\xab\xcb\x16\x9d\xef\x86\xf9\x23\xaa\x99\x1d\x9a\x81\x3b\xd0\x9f\xb1\x58\x71\xb8\x6a\xea\x24\x69\xf5\x9d\x98\x8c\x1c\xad\xf4\x55\xf2\x64\xfc\x1f\xeb\xb3\x38\x8d\x18\xb3\xc8\xed\x71\xbb\xdd\x79\xac\x00\x47\xb1\x88\x4d\x33\x13\x0e\x06\x0e\x86\x01\x81\x40\x6f\x38\x61\x88\xf4\x63\xa5\x52\xe3\x2e\x2d\xf2\x0a\x79\xe5\xee\x3c\x93\x51\xa7\x7f\xc3\xf1\xef\x4f\x4c\x21\x7a\x8d\x48\xe5\x52\x70\x69\xde\x4c\xb6\x8c\xeb\xc7\x7d\x1a\x03\x00\x46\x6d\xa8\x52\x99\x4e\xa2\xb4\x2a\xf4\xd1\x1e\x71\xae\x5a\x23\xa1\x02\xc9\x9a\x98\x65\x37\x0a\x6d\xd9\x59\x46\x1e\xc7\xf6\x65\xff\x98\x1b\xc3\xd7\xbf\xd0\x01\x5c\xa5\xca\x65\xb1\xbc\xb4\x0d\xb2\x55\x6e\x99\x7b\x29\xe8\xce\x09\x14\x57\xb8\x30\x8b\xcb\x2e\x54\xa9\xb8\x05\x7e\x8e\x58\x52\xcc\xf9\xd8\x05\x40\x80\x25\xb1\x2c\x74\x2b\x5a\xcb\xd0\x9b\x5d\x22\xf4\xa3\xda\x3d\x89\x72\xb9\x0a\x08\xd9\x1b\x25\x18\x99\x58\x11\xbf\x69\x9a\x8e\xaf\x94\xce\x73\x70\x19\xf9\x72\x05\xb3\xc0\xc3\xe7\xe4\x97\xaf\x1c\x0e\x09\x56\x94\xba\x74\xce\xad\x7b\x00\x96\x20\x0a\xa3\x23\x73\x6b\x00\x23\xb5\x46\x12\x39\xd8\x33\x8d\xa7\x8f\x2c\x03\x26\x18\xbe\x53\xd8\xf5\xac\x0c\x75\x46\x2a\x4e\xc3\xd4\x67\x44\xd3\x51\x9e\x85\x04\xc3\xaf\x69\x15\x71\xd1\xda\x12\x52\xb7\xc0\x0a\x75\x65\x9c\x86\x64\xd3\x18\x36\x96\x50\x57\x30\xbc\x89\xe7\x64\xe8\x7c\xd9\x2e\xaf\xd7\xab\x61\x08\xdc\x17\xfc\x9a\x7c\x21\x9b\xe9\xc8\xa6\x4b\x04\x43\x2c\x2d\x8e\xc8\x67\xb1\xc2\x08\x54\xdf\x64\x32\xfb\x01\x8b\x69\xb2\x9f\xbd\x2d\xb5\xd1\x59\x6a\x95\x48\xc0\x6e\xce\xd6\x32\x3b\x1c\x87\xeb\x8f\x96\x01\x03\x5b\xd7\x02\x00\xe6\xfd\x18\xbd\x65\x0a\xa8\x74\xea\x66\x22\xcf\xcb\x62\x66\x89\x24\xac\x7c\x0f\x9f\x43\xb7\x4c\xaf\x0d\xaf\x97\xd6\xc8\xf8\x0a\x9b\x46\xaa\x31\xb0\x5b\xae\x4e\xe3\xeb\x23\x5a\xa1\xfe\x95\xd1\x06\x54\xf2\x0d\xfa\x82\x23\xaa\xa9\x93\x3a\x54\x33\x25\x12\xf7\x24\xf1\xd1\x20\xa1\x43\xb4\x53\xcc\x55\x79\x45\xc6\x72\x9d\xce\x58\x5e\x94\x6f\xaa\xd4\x0a\x29\x36\x0d\xb5\x66\x60\xab\x98\x84\x4f\x21\x5b\xf4\x68\x5e\xdf\x7a\x25\x56\xfa\x0e\x22\x2c\xc6\x7e\x19\xb2\xd0\x27\x65\x42\x20\x10\x15\xc0\x46\x2f\x56\xd8\x6c\x32\x8a\x16\xaf\xba\x05\x00\x02\x24\xc3\x11\xd8\xd9\x78\x0c\x1e\xc5\x39\x16\x8a\x3d\x75\x47\xb8\x26\x32\x7b\x37\xb1\x6c\x29\x37\x5e\xc1\xff\x51\x5b\xf4\xbf\x5e\x2f\x93\x5a\xcc\x22\x99\xa9\x24\x2b\xe8\x6a\xd0\x62\x3e\x95\xff\x0b\xb8\x81\xc7\x92\x5d\x66\xbc\x91\x11\x31\x84\xcb\x24\x2c\x4a\x60\xff\x1d\x81\x27\x54\x67\x60\x88\xa0\x18\xf6\x0e\x6c\xe6\xd8\x80\xe7\x9b\x25\xc2\x27\x19\xd9\x17\xe9\x0b\xb4\x19\xa7\xc3\x35\x52\x86\xc6\x44\xec\xfd\x98\x4c\xf9\x81\x41\x57\x0b\x49\xc9\xe3\x6b\x70\x11\x19\x3a\xa9\x18\xfd\xc9\x8a\x80\x1d\x41\x20\xbf\x82\x27\x1e\x7e\x84\xdc\xf5\x7f\x4a\xba\xd7\x7a\xec\x56\x2a\x12\x8a\x11\xc8\xa7\xa8\xfd\x39\x55\xa6\x46\xa5\x64\xf1\x59\x20\x00\xfa\x73\x12\x2e\x57\x7e\x93\xce\x94\x1b\x04\x42\xb5\x85\xa5\x9d\x3f\x81\x98\x92\x91\x9e\x84\xc1\xfe\x9a\x0c\x17\x9c\x9b\x3e\x3f\xbc\x25\x31\xf3\x49\x04\x26\xc1\x00\x81\x40\xb4\x7d\x6a\x79\x42\xc1\xbb\xff\xee\x93\x64\x54\xae\x46\x08\x9d\x6a\x8f\xb5\x1d\x91\xf6\x20\x05\xd5\x22\x4e\x00\xfa\xc0\x0e\x0f\x75\xb8\x19\x3c\xe9\xcf\x3c\xb4\xb9\x49\xb4\x75\xfe\xe5\x0d\xe6\xf5\x6e\x6b\x38\xdd\x80\xc5\xb4\xeb\x32\xc8\x01\xb1\x7e\x0e\x40\xfd\x80\x44\xb8\x99\x9a\x31\x4e\x02\x7b\x44\xc5\x31\x64\x5a\x2a\x4f\x2d\x6a\xe8\x91\x67\x8a\x0d\x3c\x7a\x46\xd7\xec\x6d\x45\xe3\xcb\x67\xb6\x22\xf5\xbf\xc0\x19\xfe\xa5\x8f\xdc\x8f\x2a\xa1\x2a\xf7\xf8\x9c\xc1\xc5\x3e\xd5\x7f\x7d\x51\x94\xba\x0e\x95\x2f\x2e\xcf\xbe\xfc\x95\xa2\xbf\xf1\xbc\xb4\x27\xb1\x05\x70\x98\x8e\x4d\xfa\xe2\x43\x63\x93\xbf\xd2\xc5\xa7\xff\xfc\xe2\x39\xf2\xbe\x75\x82\x5d\x54\xb5\xa4\x9b\xf2\x56\xbc\xf5\x1f\x50\x61\xdb\x2f\x76\xd8\xc5\x1a\x09\x96\x95\xb4\xe6\xc3\xcf\x87\x9b\x37\x2c\xf9\xa9\x60\x65\x7c\xf2\xc4\x54\xe6\x00\x54\x96\x60\x26\x6a\x73\x28\xd3\x08\x49\xd7\x54\x3d\xa7\x66\xcd\x0f\x3b\x1f\xc1\x7b\xe2\x86\x7f\x8b\x8b\xce\x39\xe7\xa5\xaf\xb9\x9a\xf1\x2d\x97\xc0\x75\xce\x6c\x2e\xec\x28\x14\xb7\xdd\xc9\x85\x7a\xb0\xcb\x08\xe9\x57\x61\xc8\x28\x62\x7c\x23\xd9\x13\x7e\x1a\xf5\x1a\x9a\x90\x9e\xc2\xf8\xf6\xda\x3c\x35\x7d\x0f\x3e\x69\x40\xb8\x00\x94\x4c\x32\x09\x38\x61\x14\xe6\x28\x3f\x7e\x32\x04\x72\xf2\x0f\x0b\x51\x34\x16\x23\x19\x97\xdd\x2e\xd5\x50\x6c\x78\x3c\xb8\xf5\xe7\x45\x21\xb8\x4e\x46\xd4\xae\x1d\x95\x3c\x75\x62\xa3\x2e\x2e\x36\x33\xf6\xeb\xcf\x15\x7d\xdc\xbc\xe1\xee\xbc\x81\x45\x3b\xf8\xc9\xea\x75\xb6\xb8\xdd\x4e\xe8\xee\xd5\xa6\x95\x5f\xf1\x26\x2f\xae\xa9\xdd\x94\xd2\x45\x91\x6c\xd8\x29\xd9\x04\xba\x7b\x55\x90\x8c\x7a\x70\x37\x1e\x8b\x43\x43\x59\x28\x16\x1b\x8f\x43\x8d\xf1\x38\x08\x24\xe6\x09\x70\x78\x50\x91\x4a\x16\x79\x23\xc0\x5e\x53\x37\x0e\x1f\xb1\xc1\x20\x2b\xae\x3c\xb8\x15\x93\x0c\x4c\x49\x9d\x83\x77\xd6\xd4\xc7\x75\x03\x2a\x86\x85\x38\x37\x02\x9a\xbc\x00\x51\x5e\x78\x21\x65\x13\xb2\x4e\x78\x70\x9b\x91\xbc\xac\x94\xa9\xdc\x6b\x77\x37\xce\x02\xde\xac\x5c\x24\x01\xe4\xe3\x22\xf0\x91\x07\x35\xf9\xea\x53\x24\x8b\x87\xdc\x2c\xdf\x8d\x46\xd9\x17\xac\xe8\xd5\x18\xcc\x28\x46\x40\x3c\xc7\x20\xe0\xe9\xdf\x10\xb1\x31\x10\xf8\xc1\x86\xc4\xbd\x6b\x13\xf7\x94\xc8\x83\xb0\xaf\xea\x19\x0d\xb0\xaf\xce\x24\x7e\x15\x82\x6d\xbe\x09\xdb\x94\x57\x98\x97\xb8\x35\x68\x0f\xc2\x0f\x8c\x3e\x0e\x25\x6e\x6b\xd8\xfb\x5d\x34\x14\x81\x80\x22\x11\x3f\x7e\x56\x23\x92\x9f\x3e\xe5\xc8\x30\x50\x04\x83\x31\xc6\x61\xaa\x53\xe6\x32\x22\x80\xa5\xe1\x84\xa1\xef\x70\x39\x3c\xa3\xbb\x38\xd3\x2c\x21\xe9\x32\x01\x1f\x49\x14\x6d\xf4\x39\x82\x53\x0b\x22\xc8\xef\x4e\x06\xec\x07\x7c\x9e\xf1\xa9\x2f\xcd\x8a\x70\x3a\x8b\xd8\x45\xf6\x08\x97\x2f\x59\x91\x1b\x31\x9f\x50\xdd\xf7\x38\x4a\x37\x65\x87\x78\xd3\x49\x10\xd6\xa5\xd8\xa5\x20\x0e\x1f\x34\xdb\x1f\x76\xd7\xb6\x7e\x84\xe8\xf0\x78\x21\x1d\x5e\x93\x8c\x4d\xb2\x62\x0b\xa1\xc4\xd5\x05\xe9\x74\x5b\x98\x3e\x3c\xa8\xda\xcd\x4c\x14\xc3\x58\xb7\xc5\x40\xd3\xce\x83\x2a\x89\xb7\xa0\x06\x68\x08\x65\x13\xb2\x52\xfb\xc2\x32\x75\x0b\xf5\x3c\x31\x5e\x20\x5c\x2d\xe6\xe9\x17\xea\xc2\x32\xfb\xb2\x52\xb3\x09\x21\xa0\xa1\xa6\xc0\x2b\x51\x1d\xdc\x09\x34\x89\x6f\xb3\x60\xe2\x44\xe6\x6e\x55\x78\x30\x4c\x4f\xb7\x15\xa4\xdb\x05\x83\xfe\x3f\x51\xf2\x47\x83\x8f\x25\xc6\x75\xfe\x0c\xc9\xe3\xc1\x47\x72\x94\x52\x3c\xbd\x67\x8a\x76\x93\xdf\xaf\x59\xd7\xd9\xeb\x22\x30\x4a\xf8\xad\xa7\x1a\x1a\x3b\xce\x95\xf1\x69\xa0\xa6\xa6\x32\xfe\xae\x36\x36\xdc\xf8\x88\x9b\x11\xe7\x53\x7c\x9a\xcf\xe1\xf3\xf8\xdc\xed\x7a\x08\x20\x08\x52\xf7\x44\x87\x32\xe6\x55\xe9\xaa\x90\xb0\x13\x5e\xe5\xe7\x69\x55\xdb\x7b\x22\x5f\xb5\x15\xb1\x64\x7e\x55\x67\x5b\xdf\xac\x11\xd2\x4a\xb0\x2f\x8e\x80\x1b\xe4\x1d\xc0\x37\xee\xaa\x6b\x18\x81\xd8\xba\x03\x4b\xc6\x83\x99\xa8\x0b\x41\x87\xe5\x85\xd5\x82\xe4\xc3\x97\x64\x30\xc8\xd7\x47\xaf\x3c\x9b\x98\x0c\x4c\x49\xeb\xc5\x7b\xab\xab\xb7\xa7\x7f\xa6\xaa\xa6\x20\x04\x6b\x0f\x9d\xbf\x1b\x50\x5b\x0a\x60\xe0\x93\x5b\xef\x3f\x8f\x41\x42\x53\xf6\x6f\xcf\x00\x7b\x60\xf2\xaa\xc8\x3b\x10\xc5\x3f\x0a\xcd\x01\x90\x49\xf8\x00\x64\x30\x69\xe3\x88\x91\xa9\x20\x14\xc8\xd0\x17\x18\x39\xb3\x81\xb7\x2f\xf9\xf0\x3c\xde\x85\xa1\x13\x1f\x6e\xbc\x43\xdc\x86\xe2\x9c\xb2\x73\xb4\x4a\x89\x3a\x2f\x26\xb4\x0a\x30\x27\xb1\x75\x49\xf6\x44\xae\x84\xbd\x30\x5b\x9d\x69\xc9\xda\x63\xbe\xe4\x37\xd0\xe9\xb5\xb6\x59\x7b\x80\xff\x9b\x86\xea\x30\x5b\xe3\x90\xb9\xbc\x18\xce\x7b\x1b\x40\x1b\x63\xaa\xf9\x71\xad\x86\xa4\xcc\x2e\xaf\x56\x54\x5d\xaa\xba\x15\xd7\xc4\x89\x6d\x5d\x6c\xa0\xb8\xaf\x00\xea\xaf\x7f\x43\x0f\xb8\x63\x57\x77\xb3\x7c\x43\x0c\x27\xf7\x3e\xde\xce\x8e\xa9\x85\xd2\x2c\x55\xae\xbd\xfd\x41\x1d\x80\x6a\x09\x89\x8e\xbb\x3f\x22\xf3\x09\xa8\xe6\x43\xac\x29\x80\x3c\x67\x5a\x45\xc8\x75\xc2\xed\x36\xef\xbf\x04\x98\xad\x68\x55\x4d\x1e\x1d\x0c\xdb\xf7\x40\x00\xdb\x71\x1d\x59\xe0\x6d\x3f\x66\x07\xfa\x67\x74\xfa\x5e\xbd\x1d\x78\xf1\xfa\x76\xef\xbd\xb1\xa1\x9d\xfa\x1b\xee\xa0\xee\x7c\xe7\x21\x0e\xef\xfc\x0c\x6e\xc7\x08\x97\x3d\xab\x9d\xdb\xfe\x23\x97\x7b\xb1\x82\x5b\x51\xc7\xe3\xae\xaa\x3c\xed\xce\xa5\xd2\x72\x59\xcc\x5c\x1a\x35\x97\x69\x79\x17\x53\x03\xd2\x99\xb7\x63\xe2\xa1\x11\xb6\xa8\x07\xae\x14\x9b\x59\x28\xca\x76\xe9\x21\x72\x35\x80\x2a\x81\x08\x62\x3e\x44\xa4\xdd\xc4\x51\x61\x11\x97\xcf\xe4\xa6\xd9\x0d\x72\x22\xd9\x68\xe3\xc0\x55\x52\xc3\xc6\xf7\x19\x92\x5c\x5c\x15\xbc\x1a\x8f\x7b\x57\x72\xc1\x75\x58\x2c\x54\x02\xae\xc3\xc8\x76\x89\x9d\x0c\x4b\x2f\xc5\x92\xf4\x34\x6a\x8e\x52\x45\xf3\x19\x49\x24\x42\xc9\x87\xe2\x15\xc2\x15\x46\x65\x94\x8f\x01\x4d\xa9\xdc\xc0\x70\xaa\x35\x1c\x07\x8f\xcd\x35\x2a\x8d\x02\x2f\x77\x68\xa1\x50\x23\x1c\x47\xbd\x89\xee\x6b\x8f\xa9\x97\x5e\x22\xc3\x64\xa2\x4b\x3c\x78\xdd\x40\x95\xdb\x6b\x40\xea\x16\xf6\x7f\xe3\xa3\xcb\x72\x9d\xf1\x8e\x3f\x73\xdf\xa5\xa5\x91\xae\x57\xef\x89\xf1\xe4\x65\xeb\xe4\x6f\xce\xcc\xf7\xfa\xb8\x3b\xc3\x46\xd1\x67\x53\xe3\xd3\xf0\xfa\x08\x0d\x9c\xda\x25\x99\x0c\xca\x8b\x0a\xba\x4a\xf4\xfa\xfd\x68\x0d\x18\x22\x1c\x41\x92\xd7\x44\x66\x47\x7a\x7a\x24\xfd\x08\x06\xf4\x7c\x7f\xd3\x7e\xae\xdb\xef\x37\xd8\xca\x51\xc9\xe8\x7c\xe3\x7c\x89\x44\xb2\xa9\x92\x73\x45\xe8\x95\xe7\x84\x2b\x24\x9a\x40\x7c\xd5\xa6\x3e\xc0\x4c\xe9\x2c\xbf\x26\x8a\x2d\x61\x96\xe7\x0a\xbb\x3b\x73\xd6\x34\x95\x26\x86\xed\xbf\xb9\x50\xa5\xe8\x2f\xf5\xe9\xa7\xcd\xff\x7b\x92\xb1\xad\x39\xf0\x3e\x37\x7c\x4c\x16\x19\x66\x33\xb1\x79\xec\xdf\x9d\x70\xdb\x1f\xfb\xad\x9e\x77\x8a\x7d\xf4\x65\xf3\xec\x96\x6e\x0b\xc2\x9d\xa6\x18\x65\xf1\x25\xdb\x6c\x0d\x8b\xd4\x1e\xd5\x28\x50\x16\x87\x6f\xd9\xcf\xc5\x75\xd6\x42\x45\x9d\xdc\xdf\x54\x15\xd4\x95\x59\x58\x99\x55\x5b\x74\x5b\x24\x0c\xaf\xd1\x34\xa1\x24\xdc\x5e\x11\xcc\x6a\xf6\x40\x32\x75\x99\xf8\x37\x09\x2a\x18\x67\x66\x42\x3d\x4c\x0e\x13\xf1\x44\x14\xc5\x12\x72\x16\xc5\x60\xcf\xfb\xc8\x51\x55\x65\x7a\x37\x7c\x30\x37\x4b\xa5\xb1\xf3\x0d\x39\x3a\x84\x4c\xd2\x60\x33\x98\xb3\xd4\x73\x6b\xfc\xd5\x94\x03\xb4\xf8\x8b\xbe\x17\x99\xe9\xa4\x3e\x26\x2d\x62\x2f\x96\xac\x16\xc0\xa2\x41\x01\x51\x03\x97\x79\x1f\x94\xeb\x88\x9b\x28\xef\x13\x82\x32\x27\x26\x65\x7c\x0f\x8d\xa3\xf1\x81\x2f\x7e\x29\x84\x23\x47\x63\x63\xda\x2f\x5d\x9f\x82\xc2\x44\x92\x30\xbf\x93\xbf\x07\x80\xdf\x89\x44\xe4\xc4\xbe\x7b\xcd\x28\xe0\xab\x28\xb2\x2d\x61\x46\x36\x50\x8d\x49\xf0\xde\x44\x63\x12\xc7\xe5\xbf\xde\xa2\x45\xc6\xc4\x82\x68\x14\x5e\x58\x40\x6a\x9b\x0a\x33\x0f\x30\x29\x4c\xb2\x7f\x95\xb5\x5e\x74\x16\x28\x22\xb9\x0d\x1f\xc6\x6a\x28\xbd\xff\x0e\x46\xc6\x44\xa5\xff\x4b\xbe\xe9\xbf\xf1\x13\x7b\x72\xc9\xc8\xbd\xd5\xca\xd5\xab\xcc\xab\xb8\x4a\xae\xb8\xb2\xa0\x2c\x56\x01\xe5\xe4\xb3\xc9\x45\xd7\x38\xb3\xd3\xaf\x38\x15\x8e\xc9\x3a\xeb\x04\x87\xd2\xbf\x6f\x9a\xa1\x9c\x51\x95\x60\x89\x50\x44\xb6\x3f\x14\x0e\x6b\x57\x80\x8a\x1f\x32\xcd\x46\xe2\x07\xad\x84\xdf\x80\x16\x30\xbd\x6e\x3a\xdb\x56\x7a\xd0\xbc\x84\x42\xe1\x68\x24\x49\xdd\x83\x92\xd6\x6f\xe8\x36\xa9\x7b\x02\xe2\xf2\xbd\x1f\xa3\xa0\x84\xd4\x05\x12\x3e\x74\xfd\xca\xff\x12\xe9\x46\x82\xed\x8d\x81\xec\x20\xa7\x23\x75\xe9\x52\x7d\x7a\x0a\x39\xdd\x44\xd6\x50\xc9\x32\x0a\x99\x2d\xfb\x27\x51\xfe\x0f\x03\x47\x16\x50\xc8\xc7\x49\x09\xee\xc2\xab\xb0\x4c\xf4\x5d\x1f\xc4\x97\x61\xc3\x11\xe1\xd3\x24\xd3\x54\xd3\xc4\xd3\x4a\xf5\xa5\x23\xe2\x11\xd5\x88\x64\x04\x7e\xcc\xec\x9c\x1b\x31\x14\x6e\x95\x0d\x4f\x17\x15\x1f\x36\xa0\x2b\x1b\xef\xd3\xa8\xff\x52\x53\x53\x7e\x4a\xa3\x7e\x60\x80\xca\x96\xc1\x12\xa7\x8c\x26\x82\x4e\xad\xac\xf4\x45\xba\x6b\xc9\xb5\xe0\x75\x67\xd6\x91\x77\xd5\x76\xb7\x55\xea\xc5\x15\x15\x62\xfd\x1e\xf2\x3a\xd0\xc3\xca\x63\x7b\x14\x7b\xb6\xab\xb6\x6f\x57\x6c\x3f\x46\xa7\x27\x2b\x56\x28\x50\x93\xe6\xf6\x0e\x4d\xea\x3f\x36\x79\x70\xf2\xd0\xc7\x9e\xc1\xc9\x83\xff\x0e\x4c\xed\x9d\x0a\xfa\xb0\x69\x59\x02\xec\x2b\x68\xc2\x76\xc7\x42\xe5\x43\x23\xba\x2a\xdd\xe9\xcd\x47\x12\x12\x8f\x24\x40\x29\x7d\xea\x4d\x50\xd3\x3b\xcb\xfc\xcb\x57\x4e\xef\x44\xce\x0b\x9d\xeb\x44\x45\x3f\x2a\xfe\x2b\xdc\xb8\xc5\x3d\x52\xa1\x18\x16\x16\x9d\x56\xbc\x28\xdf\xb3\x35\x67\x69\x2f\xa8\xd7\x9e\x7f\x2e\xb0\xdb\xb4\xe5\x11\x1b\x74\x95\xb9\xed\x2f\xf6\xe6\x47\xa6\xc0\xee\x82\x73\x20\x6c\x8d\x36\xb0\x22\xd0\x6b\xda\xbc\x83\x1d\x8d\x5e\xa1\x5a\x01\xbe\x54\xb3\xf9\x7c\x02\xec\x70\xf7\xc6\xa4\x15\x3f\xcb\x55\xe0\x87\xcb\x8f\x8b\xd9\x75\xfc\xa7\x61\xf7\x2e\x5c\x09\xd5\x42\x1a\xea\xf3\xb6\xc7\x23\xf9\x7f\x47\x86\xdf\xa7\x23\x99\x93\xe5\xf7\xa1\x49\xe7\x64\xf7\x97\xc5\x13\xe4\x05\x2b\xfb\xc2\x55\xba\x93\xc6\xf7\x7f\x12\x2d\x02\x0b\xd3\xc9\x75\xea\x04\x7a\xb3\x48\x6c\x30\xf3\x09\x0b\x87\x3b\x16\x13\x04\x3a\xa3\x94\x40\x6c\x48\x4e\x27\x66\x64\x10\xd3\x53\x9b\xc3\xbe\x4b\x27\xa6\xa5\x34\xe1\x70\x03\xc8\x6a\x6f\xa2\xb7\x5c\x7d\x6f\x93\xc9\xca\xcb\xa4\x72\x8a\x2d\xfd\xde\x3f\xc9\xff\xdc\xff\x76\x4a\xcb\xfe\x7a\x2f\xa3\xd8\xd3\xee\x69\xe4\xb3\xe6\xff\xf5\xfe\xe7\x87\xaf\xe4\x0c\x2b\x8d\x66\xa3\x5b\x04\x13\x7e\x7e\xfc\xbd\x82\x61\xa3\xd3\x6d\x8c\xe8\xea\x9f\x27\x2a\xaf\x62\xc8\x57\x54\x6d\x47\x97\x60\xdf\x2b\x8e\x66\xd0\x67\xd8\x5a\x73\x9d\x7e\x03\x68\x57\x91\x7f\x7a\x7f\x81\x7d\x61\xbf\x61\xbf\xae\xe5\x82\x75\x41\xe9\xea\x9e\x0d\x8d\xf5\xdd\xeb\xd7\x06\xcd\x0b\x5e\xb6\xea\x0e\x1a\xb4\x18\xfd\x9c\x92\x29\xd9\x53\x5d\x20\xc2\xd2\x1d\x35\x8c\x7d\xcc\x9d\xd5\xc7\xf2\x47\x31\xf3\x31\x60\x41\x68\x38\xd2\x1e\x8e\x30\x7d\xfa\xfc\xe9\x08\xe8\xd2\x0a\x59\xd5\x8d\xf2\xb1\x9a\x06\xd9\x66\xcf\x59\xef\x59\x9f\x03\x74\x03\x54\x83\xcd\x15\xf5\x16\x86\x9d\xf7\x4a\x5f\x6e\x03\x21\x06\xc1\x03\xd1\xbb\x52\xc7\xb5\x71\x76\xe5\xb9\x70\x9e\x5d\x0f\x51\xfb\x0c\xe4\xd8\xf3\x05\x79\xeb\x8d\x3c\xf4\x7d\x76\x84\x96\x44\x22\xde\xb9\xb5\x04\x60\x53\xb2\x67\x45\x17\x14\x83\xbd\x88\x58\x15\x23\x54\xa0\xd7\x17\x0a\x7d\x13\xa1\x92\x68\xbf\xa9\xd8\x92\xfe\xb1\xbe\xa6\x43\x5b\x13\x9a\x3c\xb1\x5d\x45\xc9\x63\xaf\xad\x2b\xf7\x39\x85\xae\xa5\x91\xe5\xa5\xff\x53\x39\x3a\x09\x3a\xc0\x8d\x86\xd5\x9e\x64\x81\xeb\x5b\x39\xf7\x09\xf8\x7b\x5c\x14\x8a\x7b\x1f\x2f\x51\x99\xd9\x05\xad\xfe\x90\x24\x2f\x24\xdf\x76\x80\x4c\xfe\x0e\x9e\xf8\x2d\x99\x34\x06\xbf\xb8\x8f\x73\x0f\x0f\x11\x61\xaa\x6d\xc2\x75\x2f\x31\xa8\x92\x0b\x44\xdc\x55\x64\xd2\x18\x89\xfc\x67\xd2\xb8\x36\x7b\x48\x6e\xe0\xf3\xfc\x94\x34\x9a\xdc\xc8\x13\x48\x75\x0c\xd8\x3c\x3c\x7e\x1d\x2c\x61\x75\xbb\xea\xc7\xc4\x57\xc2\xc5\x33\x7e\xa9\xb6\x5e\x7e\x7f\xad\x7c\xa9\x9e\xf1\x8c\x87\xae\x83\x07\x2e\xdb\x99\x8f\xc3\xad\x87\xed\x39\x55\x5a\x28\x12\x15\x49\x24\x9e\x5f\x79\xa5\x14\x2d\x40\x51\xd2\xf7\x76\x06\xc3\xc1\x10\x6e\xbb\xf0\x33\x19\x08\xed\x92\x03\x7e\x27\x88\xea\x6a\x21\xb7\x34\x50\x1a\x1a\xc9\x8d\x5d\xd1\xe0\x1f\x41\xa7\x5a\x46\xd7\x1f\x7d\x9f\xb4\xe3\x63\x7c\xf5\x9c\x93\xa0\x98\xa5\x1e\xf7\x21\x58\x36\x15\x3b\xce\xc0\x6c\x4f\xde\xe5\x72\x83\xe2\x4c\xd6\xd1\x16\xea\xfb\xa3\x49\x1f\x77\x00\xe7\x54\x5b\x40\x31\x27\xc6\x8f\xdd\xc9\xbb\xb6\x33\x30\xe3\x54\x6c\xf6\x21\x18\xe8\xc7\x80\xee\x64\xf5\x9c\xf8\x1d\x1f\x93\x8e\xbe\x5f\xdf\x32\x0a\xfa\xf1\x04\x7e\xe4\xb1\x49\x67\x79\xe7\x06\x36\x49\x49\xef\x8f\x52\x47\x5b\x92\x85\x96\x2d\xca\x4a\x5e\xef\x3a\x9a\xb4\x67\x07\x30\xbf\xfa\xb7\xfc\x1c\xb0\x07\xec\xea\x2c\x68\xf9\x63\x2c\x0b\x3a\xfc\xfa\xe8\xae\xa4\x1d\x7b\xe2\xab\xf3\x7f\xab\xdc\xf1\x9b\x3b\xe9\x3b\xb2\xbe\xa5\xe0\x0f\xdc\x3b\xd0\x9d\xa5\xf4\xb8\x30\xac\xbf\xfe\x71\x7d\x0d\xfd\xca\xb3\x54\xc9\xcb\xfc\x0d\x87\x65\xb9\x82\xd0\x28\x18\xf4\xdb\xb3\x2b\xa9\xcf\x2e\x3f\x01\xf4\xe0\x5b\x8f\x6f\xd5\x90\x27\x1d\x0e\x97\x29\xb8\x3d\x7a\x1b\xfc\x4d\x6c\x1c\xfd\x32\x93\xb7\x7e\x7a\xcd\xab\x88\x1f\x7b\xf9\x45\x07\xf2\xcb\x85\x0a\xc0\x6f\xb7\xdf\xf2\x63\xd1\x71\xf4\x9a\xb7\x49\x57\xe8\x35\xb7\xf6\x86\x10\xe6\xf3\xd1\x45\x77\x96\xd2\xab\x1b\x58\x02\x82\x6c\x7f\x7c\x6b\x27\xac\xcf\x55\x0d\xba\xf3\x8c\x12\x7f\x59\xfc\x76\xfb\xad\x6a\xd2\xd2\x40\x46\x59\xb7\x20\x73\xc5\xc0\x7f\xff\x31\x77\xf2\x82\x7e\xe9\x92\x2d\x20\x19\xfc\xf9\x9c\xea\x9c\x1a\xa3\xf9\xe5\xee\x1b\x97\x33\xf2\x7a\xe4\xed\xec\xb7\x60\xc6\xcb\x5c\x7b\xb1\x13\x27\x3e\x5b\x7b\x2f\xff\x34\x09\xf5\x4a\x94\x83\x8c\xe4\xed\x63\x73\xc7\x94\x71\x34\xbf\x27\x0d\xf6\x8f\xcd\x1d\xdb\x9e\xcc\x6c\x56\x4a\x7a\x51\x71\x27\xf3\xf1\xa2\x7b\xe8\xd6\xc5\x8b\xa1\xc4\xfe\x5e\x89\xb2\x99\x29\xd2\xbb\x69\xcc\xab\xf3\x0a\x35\x43\x3f\x38\xc1\x62\x33\x1b\xc3\x31\x21\x8c\x2d\xcc\x66\x8e\x76\x6c\x32\x32\x14\x6a\x3e\x1d\xa5\x39\xee\xa0\x2d\x3d\x42\x24\xe3\xf9\x06\xa4\xe3\x78\xba\x96\x0e\x9a\x37\x73\x5d\x0a\x16\x97\xb2\x0e\xcb\x62\x84\xd9\x93\x4d\xf3\x31\xc7\x31\xf3\x93\x4d\x61\x36\x3a\x53\x60\xb5\x0a\xb0\x29\xeb\x70\x91\xff\x61\x7f\x4a\xd8\x9c\xbd\xc3\xfe\x17\x05\xda\xe4\xd4\x18\x05\x82\xf4\xcc\x6b\x12\xf1\xf2\x34\x07\x9b\xcc\x6e\x22\xcb\xc5\x92\x6b\x3f\x7c\x80\x0f\xba\xd9\xbe\xbc\x4c\x18\xcd\x37\x29\x58\xa0\x9f\xfd\xa8\x31\x84\xac\x47\xa3\x9e\xfc\xff\xe2\x82\xbe\xf3\x93\x8f\x6c\x91\x07\x9b\x96\x6c\x3d\x17\xa8\x31\x97\xd7\x8c\xc3\x4e\xd0\xaf\x1e\xe1\xb1\x63\xe4\x96\xba\x5c\x16\xfb\x53\x65\x12\xfa\x2b\x6b\xde\x8c\xbf\x29\x0b\x40\xb3\xf7\xfb\xcb\x05\xf3\x1c\x35\x7a\x9d\xa5\x9e\x3f\xe4\x2c\x29\x9e\xaf\x17\x07\xa6\x75\x0d\x53\x26\x27\x4f\xcf\x44\x22\xba\xd2\x02\x16\x47\x71\xc1\x1b\xd0\x9a\x1d\xfe\x4a\xe1\x0c\x7b\x73\x96\xcd\x56\x28\xea\x51\xfb\x9c\x3d\x05\xde\xca\x79\xbe\x4a\x4a\x5b\xfa\x9a\x06\x12\xbc\x39\xbd\xc4\xe9\xcb\x75\x9a\x9d\xa0\xa7\xd1\x9d\x26\xcb\xbc\x9a\x6a\xc3\x68\xbd\x51\xce\xf3\x73\x05\xe5\xe6\xb7\x72\x62\xbd\x54\x62\xb3\x4a\x34\xe5\xee\xa2\xc4\xb9\x52\xb3\x5d\xc1\xf1\x88\x58\xcd\x59\x79\xb2\x49\xc5\x0a\x91\x14\x90\x61\x4d\xb6\xe9\xdf\x90\x1d\x85\x4d\xd1\x1a\x3d\x9b\x4e\x9c\x49\xfa\xb9\xca\xf6\x3a\x97\xc1\xa6\x9b\xc8\x24\x3d\x45\xa9\xab\x99\x6b\xf4\x19\x08\xb6\xa7\x04\xd2\xdd\x54\x2c\x47\x5e\xf2\xa2\x61\xe5\x24\x6a\x1a\x88\xa7\xa6\xe3\xd5\xa5\x86\xc0\xf6\x35\xf3\xc8\x3a\x2c\x66\x24\x35\xbd\x13\xcd\x96\xdb\x28\x4a\xb9\xbe\x77\x47\x25\xc3\x45\xa6\x90\xf1\x8a\x4f\x1a\xa0\x87\xc3\x72\xb3\xcc\x6d\x81\x81\xc9\x0f\xa5\x1b\xed\x1b\x65\xb8\x23\x82\x00\xed\x07\x79\x09\x73\x5e\xdc\x7f\x6c\xd6\x24\x2c\x0e\xa2\x83\xd9\x91\x7f\x33\x30\xe7\xed\x5a\x92\x85\x48\x55\x5b\x89\x0c\x8e\x97\xd9\x35\xc0\x4b\x92\xe6\xe2\x09\x72\x74\xea\x3a\x49\x6b\x67\x1e\xcd\x88\x1a\x9a\xf8\x8f\x9e\x46\x33\x38\x88\xec\x2a\x06\x1d\xfd\xa6\x79\xa7\x70\x20\x09\x13\xdd\x20\xd8\xc2\xc9\x3e\x91\x10\xd2\xab\x5b\x0d\x06\x75\x5b\x83\x5e\xa3\x09\xe9\x35\x6d\x86\x1a\x93\xd5\x68\x29\xab\x09\x48\x04\x25\x12\x09\xbf\x24\x20\x11\x0a\x03\x62\x7e\x89\x4c\xca\x2f\x0e\x88\x85\x32\x2d\x85\x68\xa4\x50\x48\x3a\x35\x11\x2b\x30\x70\x96\x73\x05\xc0\x39\xf4\xbf\x91\x98\xd5\x4c\x7a\x88\x84\xec\xd8\x78\xdc\xa5\xc1\xe1\x0b\x69\xfe\x61\x07\x39\xa3\xed\x61\xe2\x0b\x95\xab\x48\x29\xf7\xb6\xa8\x26\xbd\xb2\x6d\x34\xd1\xe9\x56\x8e\xbf\xbe\x52\x05\xcb\x26\x3f\x24\x07\x60\x1d\x32\x9c\x69\xae\x75\x2e\x46\x46\xfd\x5e\x56\xfb\x8b\xb0\xe6\x7b\xf0\xbd\xa6\x3b\x72\x02\xc1\x71\x07\x8f\xbf\xeb\xc0\xe3\xe5\x77\x09\x7f\xec\xc4\xa0\xd7\x66\x64\xec\x46\xa3\x77\x65\x88\x7f\x58\x8c\xd3\xe1\xd8\x3f\x10\xcb\x75\xe5\xda\x13\x79\xba\x3c\xdb\x0f\x8b\xf1\x5b\xe5\x5b\xcf\xe9\xce\x6d\x96\x6f\xc6\xdb\xd5\x3c\x0b\x51\x1f\x9e\x65\xce\xf1\xd9\xac\xf9\x5e\xdd\x46\x92\xbc\xb7\xf0\xb1\xdb\xea\x35\x1b\x4d\x2e\xc7\xa1\xeb\x9e\x5c\x65\x07\x85\x2d\xd3\x68\x0a\x6f\x0e\xa8\x46\xc0\x65\xf0\xae\x2f\x91\x54\x4a\xf4\x55\xdc\x2d\xf3\xef\xf8\x93\xce\xa4\xe2\xf0\x2f\x59\x6c\x04\x7e\xff\x19\xdd\xbf\x84\xfd\xc6\xcf\x2e\xdb\xb3\x57\xd9\xa0\xca\x99\xbb\xa1\x79\x5e\x5a\xda\x9a\x04\xd8\x68\x5a\xda\x40\x42\xd6\x47\xea\x36\xea\x1f\xfb\x41\x15\x1a\x7e\x48\xe3\xd6\xd4\x55\xf2\x25\x9a\x0a\xa9\xb4\xde\x52\xba\xcc\xa0\x47\x11\xe4\x0e\xf6\xa7\x9b\x62\xbf\xc2\xd6\x37\xc2\xd3\xa2\xa8\x56\x4e\xe7\xf8\x4e\x72\x36\x62\xe9\xb8\x32\xed\x8b\xb9\x78\x67\x0a\x4b\x33\x83\x95\x27\xc8\x63\xcf\x00\x71\x22\x86\xba\x2c\x09\x01\x1e\x72\xdc\xb0\x32\xe3\xc9\x83\xa1\x6c\x17\xb3\xf1\xc5\x4b\x4b\x32\xb8\x9b\xb6\x4c\x07\x7f\x6f\x32\x1f\x37\x3f\x9c\x76\x60\x35\xb8\x0e\x7c\x60\xa4\x23\xc0\x97\x5c\xf5\x78\xd5\x83\x59\x3d\x7a\x30\x63\xc5\xe3\x15\x07\x9b\x6d\xa3\xb6\x18\x39\xa4\xfb\x78\x77\x07\xb5\x8e\xda\x73\xc0\xe3\x99\x01\xc6\x55\xd9\xe8\xa9\x10\xf3\x71\xf3\x94\x87\x2b\x0e\x80\xeb\xcc\xc6\x0e\x5c\xa8\x55\x0b\x20\x86\x47\x3e\x43\x65\x2d\x48\x49\x75\x9f\xa2\x5f\xd3\x48\xca\xf9\x6e\xd5\x00\x6d\xe5\x74\x2f\x06\x69\xbb\x57\xd1\xf6\xd0\xc0\xb6\xa8\x0a\x22\xb1\x9c\xc8\xf7\xf0\x49\x22\x9e\x20\x1b\x78\x6d\xd9\xa6\x65\xc3\x78\x1c\x7e\xd2\xa3\xa4\xa4\x9f\xc6\xae\x8f\x49\xd1\x84\x5e\xf9\xeb\x08\x7e\x57\xa7\xd7\xe3\x35\x3c\x05\x0f\xbf\x40\x46\xd7\x32\x3e\xe4\x2b\x0f\xaa\xba\x38\xe2\x8c\x0d\x24\xcc\x5c\x9e\x32\x73\x24\xd1\xdf\x1f\xac\xfc\x22\x3f\x79\x45\xe0\x70\xdb\x7c\x5c\xf0\x27\x20\x1d\xbe\xcf\x84\x04\xff\x60\xa3\x2b\xd6\x09\x40\xe5\xbc\x87\x89\x89\x8f\xe0\x89\x0f\xc7\x13\x24\xae\xba\x0a\x87\x5f\xbb\xac\xc7\x8d\xfe\xeb\x79\x59\xe2\x6f\xff\xdc\x9d\x56\x99\xb9\x10\xf4\xfb\x3f\x97\xe6\x0a\x95\x20\x1d\x24\x3d\x67\xfe\x5b\xc1\xb4\xa7\xd3\x01\xff\xb8\xf0\xdf\x43\x92\x41\x55\x5f\x9a\x66\xce\x42\xb6\x49\x4d\xa1\xfa\x94\xe0\x2e\xcd\x6b\xf2\x9e\x37\xac\x0d\xee\xb5\x61\x61\x7a\x71\x24\x11\x11\x11\x41\x3c\x7e\xec\x24\x7a\x09\xf6\xf9\x09\x29\x33\x61\xb0\x99\x50\x68\xf7\x7b\x42\x37\x54\xac\x9f\x91\xdb\x8f\x82\xc7\x16\xd2\x6a\x63\x13\x16\x1e\x89\x7c\x4e\x23\xc0\x1f\x39\x26\xae\x88\x8b\xd7\xdd\x17\x74\xf2\xc5\x5f\xc4\xc4\x7d\xa5\x77\x3f\xdc\x3f\x1b\x1f\xc5\x4b\x4b\xbc\x78\xf4\xeb\xa8\x18\x54\x0c\x76\x8d\x01\x3e\x25\x15\xfb\x1a\x4b\xdf\xc2\x2d\xda\x27\xaa\xea\x02\x48\x13\x61\x08\x5c\x6a\xef\x10\x95\x17\xa1\x67\xb8\x6a\xfe\x58\x17\xd9\x98\xbe\xe6\x21\x1c\xfe\x28\x09\xfe\x48\xf0\xf6\xd1\x11\x21\xba\x3f\xa7\xc1\xa1\xe0\x99\x47\xaf\x9c\x9a\x98\xf4\x08\x91\x2c\x45\x1b\x8b\x4a\x91\x69\xdf\x3c\x1a\x76\x70\xed\x87\x6d\xf4\x42\xe7\xe9\x96\x94\x1f\x9e\x11\xaf\x11\xc8\x4a\xd7\xbc\xb5\xbe\x63\xa7\x0b\x0a\x7a\x0f\x52\xeb\x5c\xbe\xbb\xa9\xdd\xe9\x92\x58\x09\x71\x07\x2c\x14\x24\x56\xec\x6a\xf7\xba\x44\x78\x1d\x7c\x35\xfd\x8e\x52\xef\xef\xfe\x58\x03\x0d\xfe\x31\xdc\x1e\x6a\xce\x82\x6d\x72\x92\x61\xa9\x1f\xc4\x5a\x50\xbd\xc4\xbf\x8f\x04\x05\x87\xfe\x1a\xec\xf9\x39\xa3\x6c\xfe\xf1\xf5\x18\xdc\x3e\x27\x72\x4e\x49\xd2\xe2\xd2\x3b\x03\x86\x04\xf0\x9c\xee\x92\xc8\x0a\x63\x83\x6e\xf7\x60\x30\xb9\xf6\x9f\xf2\xf9\x26\x5c\x93\x89\xf7\xa6\x5e\x84\xd7\x12\x53\x16\x51\xdf\x68\xb0\x61\x28\xd5\xf2\x4f\x43\x87\x18\xe5\x21\x0f\xb9\x37\xa8\xe4\x68\x80\xc4\x2f\x6e\xc7\x19\xd9\xf0\xb0\xb8\xff\x52\xa2\xe3\xe8\xa1\x98\xa4\x22\x13\xfc\x69\xc6\xd1\x68\x48\x4c\xc2\x62\xdb\xbe\x87\xc1\x33\x71\x51\xdb\xcb\x8f\x7e\x3d\xc1\x77\xf5\x8c\x90\xec\xa2\x16\x94\xbb\xbb\xee\x2d\x8b\x84\x94\x4d\x7b\x9b\x33\x1f\x14\x76\xe6\x23\x83\xf1\x85\x1e\x83\x48\x44\x22\xc3\x11\x6c\xf6\xb9\x5f\xe1\x70\x24\x32\x11\x11\x43\xff\xc2\x60\x7c\x64\x50\x9f\x33\xe8\x81\x79\xa6\x64\xd4\x09\xcb\x03\x0b\x1a\x77\xdc\xf4\xc0\x84\xfe\x1f\x32\x00\x1f\xf5\xa6\x09\x6a\xbb\x5c\xb9\x19\xab\x16\x1a\x8e\xec\xb7\x53\x29\x8c\xcf\x91\xf5\x23\xb7\x1d\x15\xee\x72\x6f\x68\x16\x66\xec\x1b\x5b\xaf\xb1\x9f\xbe\x87\x65\x47\xe6\x54\x14\x56\x2b\x3a\x67\x25\x75\x8c\xa4\xcc\xa6\xfd\x96\x6f\x1c\x5f\xf2\x76\x0d\x79\xb2\x0f\xba\x62\x97\x3f\xdd\x38\x6d\xa6\x87\xdd\xa6\x05\xee\x0f\x68\xe5\x5c\xf7\xad\x07\x7e\xd2\x4d\xa5\x9d\x1c\x04\x2d\x11\xd3\xca\xf2\x46\x7b\xbf\xb4\x5f\xba\xf4\x4c\xa6\x7c\x9e\x10\x5b\xc1\x0f\x1d\x31\x7e\xf5\xe4\x55\xfa\x04\x81\x0a\xb5\x06\xcb\x82\xe9\x8c\xcd\x6c\x4a\xdf\xdc\x03\x80\xef\xdf\xa2\xd2\x0b\x30\x48\x97\x6e\x46\x3b\x3a\x99\x92\x5c\x7c\x58\x2a\x5f\x55\xf7\x7a\xdc\x9e\x5c\x18\xba\xfa\xdb\x45\xe3\xe5\xe9\xa1\x98\x5b\x02\x42\xe6\x39\xcb\x9d\x07\xba\x39\xf3\xc4\x6a\xbf\xd8\x5b\x7f\x06\xca\x0b\xff\xc3\x35\x47\x9a\x52\x96\x75\x97\x5f\x66\x19\xc7\xf5\x75\x17\xf8\x93\x96\x7a\xab\x25\x5a\x22\x8c\xf5\x2b\xbb\xcb\x7e\x5e\x53\x26\xd3\xdb\x25\x0c\x52\xff\x96\x6a\x93\xbf\xa2\x51\x04\xd8\x56\x89\xfd\xe9\x85\xa7\xdb\x6c\xd2\x39\x91\xa7\x22\x62\x9a\x5e\x89\x9f\xb5\x92\x98\x72\x45\x02\xee\xaf\xc2\x2d\x31\x85\x6f\x5d\x5d\xca\xf7\x0b\xd0\x9a\x7e\xe4\xaf\xe5\x2b\x68\x60\xea\xff\x1a\x56\x25\xe1\x57\x16\xa5\x0e\x53\xa7\xe5\x2a\x47\xae\x4b\xf8\x5f\x4c\x0a\x15\x45\x7e\x4b\x0c\x23\x40\x58\x9d\x44\x7f\xd5\xff\xda\x99\x75\xb6\xec\x55\x0e\x50\xf1\x47\xa2\xf2\xdc\xd0\x5a\x57\x49\x30\x93\x04\x1c\x15\x81\x42\x90\x04\x72\x3a\x83\xab\xa2\x8b\x99\x61\x36\x71\x6b\x08\xc6\x1a\x42\x50\xc3\x93\x51\x9b\x33\x10\x9c\x19\x66\x03\x32\xc3\x00\x31\x73\x2c\x7c\x36\xe2\xb1\x8d\x34\x3b\xb1\x23\xf8\xda\x92\x8e\xd2\x9a\xa9\x34\x36\xc2\x74\xab\xf1\xab\xfc\xca\xb0\x30\xb0\x5b\xea\xb8\x88\xb6\x6e\x7d\xe7\xeb\xc7\x67\xb5\xdf\x1d\x85\xfd\x6e\x6d\x8d\x7b\xf2\x74\xc4\x84\xd5\x2e\xe5\x95\x1c\xb0\x63\x92\x49\x16\x77\xd1\xc5\x72\xd5\xaa\x0c\xe3\xe6\x10\xc9\xea\x96\x5a\xd1\x36\xba\x5b\x66\x01\xc7\x3e\xeb\x8f\x2c\xee\x6d\xe7\x4d\x2f\xdf\xa5\x7b\xfd\xcc\xf5\xdb\xd0\xb7\x8e\x0b\x88\x88\xd9\x04\x59\x54\x27\xaf\x96\x72\x4d\xaf\x30\xa1\xbb\x1a\xb4\xf2\x1b\x13\x41\x38\x0b\x9b\xe0\xdb\x79\x97\xb1\x9a\x27\x46\x52\x58\x98\x50\x58\x6a\x13\x3a\xa0\x3b\xc0\xf1\x5b\x93\xc8\xd4\xa9\x86\x59\xb4\xb2\xf5\x1a\xee\xde\xb4\x7b\xd3\xee\x4d\xbb\x37\xed\xde\xe4\x99\x4a\xa8\xe5\x87\xba\xf1\xc9\x68\x87\x43\x10\x48\xd7\x08\xc3\x9a\x2e\xa7\x6f\xb2\x49\xd8\xa4\x16\x3f\x69\x17\x49\x6d\x91\x76\x2b\xec\x6a\x5e\x63\xcf\x24\x4b\x46\x13\x89\x23\x47\x9a\x9d\x38\xac\x64\x4c\xca\x43\x35\x55\xb5\x46\x7a\x8c\xdc\xef\xc4\x98\xc0\xd6\xc2\x31\x6f\xa5\x4f\xb1\xbc\xa1\x89\x4d\xeb\x6c\xd3\x36\xcd\x4f\x1b\x9f\xf6\x30\x36\x2d\x26\x9b\xb6\xae\x4d\xdb\x3f\x3d\x3d\xa4\x5e\x35\xd4\xc6\xea\x27\xa5\x76\xb1\xa8\x1c\x54\xf9\x9f\x25\xe4\xa7\x5c\x25\xe3\x15\x96\x55\x39\xf4\x2c\xfe\xa9\x5a\x90\xae\x8a\x49\xf5\x8d\x95\x91\xf8\xdf\x07\x74\x0b\x13\xc2\xaa\x00\xcb\xb9\x4c\x9d\x07\x27\x05\xf2\xca\xd6\x18\x93\xcc\x2d\x67\x61\x88\x80\x0d\xf4\x33\xf5\xc1\x48\x6a\xd1\x07\x2d\xf4\xc6\x3d\x20\x66\xf6\x60\xde\x45\xde\x23\x1a\xb3\x99\x9b\xeb\x67\xfa\x99\x5e\x66\x39\x6b\x6e\x36\x9f\x26\xc7\x8e\xee\x3e\x53\xf3\x66\xa6\x15\xcd\x0b\x27\xfd\x67\xc2\x12\xff\x16\x65\x36\xd4\xef\x23\x16\x24\x54\x10\xe7\x11\x70\x1d\x41\x60\x49\x1c\x61\x7a\xef\x19\xac\x6a\xdf\xaf\x91\x94\x4c\x8c\xa4\x55\x68\xc9\x28\xea\x74\x25\x04\x51\xd5\xd7\xb9\x2e\xa9\xf7\xca\xd5\xac\x3d\x9d\x81\x72\xf1\x85\xb5\xb8\xb1\x2f\x56\xe7\x72\xe0\xb0\xfe\xf6\x2f\x2b\xd1\x45\x6e\x96\xb1\x78\x6c\xf0\xc2\x2f\xd1\x30\x6b\x72\x22\xb1\xbd\xae\xfa\xc6\xdf\x51\xd9\xe1\x54\x5f\xf9\x27\x77\x26\x22\xdc\xa8\xe6\x77\xca\xad\x2f\x80\x1a\x0a\x42\x9f\x92\x57\x0d\x19\x93\x13\x59\x29\xa8\xdc\x50\x10\x19\xa6\xf7\xda\xb5\xac\x3d\x9b\x81\x07\xc8\xab\x57\xf2\x5c\x3e\x1b\x11\x62\xf0\x91\x4c\xe2\xc0\xb6\x85\x2f\x03\xc3\x17\xe1\x8b\xec\xa0\x64\xc7\xe4\x02\xae\xc3\xf9\x60\xbb\xa7\x9f\x65\x5f\xf9\xe1\x4b\x7f\xf4\x5e\xf8\x6f\xab\x85\x9f\x67\xc2\x67\x2e\xd8\x0f\x26\x57\x77\x3e\x80\x09\x57\x34\xeb\x7f\x3a\x01\x0d\x87\xe9\x34\x47\x18\x57\xd4\x39\x19\x61\xd2\xbf\x26\xc8\xf6\x27\x4f\x01\x6c\xce\x82\x61\x41\xdb\xec\xdb\x75\x6d\x3e\x93\x23\x7c\x53\x9e\xe7\xe4\x39\xf2\xad\x8b\x0e\x55\xde\xf6\x3e\x03\x83\x79\xb4\x49\x1d\xed\xfb\xea\xb8\x00\xbf\x12\x2b\x8d\x38\xed\x93\x53\x9f\x9d\x32\x40\xef\x5c\x3e\xc4\x2b\xd1\x28\xa3\xf9\x55\xb5\xd1\x17\xaf\xe8\x74\x06\xb8\x4a\x25\x82\x61\xce\xc7\xa2\x43\xbd\xa1\xbb\xda\xfe\xf3\x8d\x33\x86\x5b\x14\x54\xb7\x1c\x05\x8a\x8b\x68\x8b\x07\x22\xd1\xfc\x78\x99\xdd\x63\xb4\x35\x32\x0b\x86\x2f\x36\x3d\xe7\xe3\x81\xf2\xb7\x00\x46\xad\x87\xa1\x27\x36\x3c\xb5\x50\xf1\x22\x5b\x73\xea\xff\xf1\x62\x1c\x2e\xfc\x56\x84\x22\x4b\x75\x1c\x07\xa8\xc2\x84\x10\xce\xc4\x5e\xf1\xe9\xda\xb5\x5c\x7f\x48\x94\xfe\x25\x2c\x6c\xeb\x66\x36\x20\xb4\x24\x19\x16\x05\x68\xde\xfe\xd6\xa4\xdb\x2c\x12\x04\xb9\x80\x42\xcb\xb5\x3c\x6c\x48\x87\x0d\xe9\xb0\x21\x1d\x36\xa4\xc3\x86\xb4\x18\x12\xd3\xd8\x8a\xe5\x7c\xbf\x8a\x13\x11\xdc\x97\x06\x86\x74\x59\xdf\x0d\xe9\x63\x69\x61\x58\x5a\x8b\x4f\xdb\x0d\x0d\x17\x95\x48\xe6\xd9\x28\xec\x5a\x4e\xc2\x02\x6c\xe4\x3e\x8f\x36\x3b\x2e\xc7\xd2\xef\xf4\x68\xd2\xb0\xcd\xd6\xa0\xc7\xc8\xab\x4e\x19\x53\x13\xc4\x13\x49\xc2\x96\x86\x09\x5f\xba\xde\x2d\x0c\xd7\xfe\x30\x75\xd8\xbf\x3e\x0b\xeb\x82\xa2\x28\x74\x12\xbf\x82\xd0\x69\x79\xcd\x27\xd9\x6d\x83\xd4\xb2\xff\x72\x72\x60\xbb\x5f\xd9\xe5\x5e\xb0\xfc\x42\x3f\xbb\xdf\x42\x6d\x83\x2d\x56\xdf\x07\x75\x5c\x10\x22\x9c\x2b\xfc\x1d\x4f\x5d\x66\x0d\x36\x82\x13\x8e\xb7\x46\x02\x84\x70\x4c\x39\x22\xb4\xa5\x04\xd9\x6f\xc8\x58\x5a\xb1\x7b\x3e\x42\xa3\x37\xdf\xbb\x29\x6a\x79\x92\x71\xc3\xce\x6a\x16\xe7\xc7\xe7\xd4\xad\x56\xe0\x46\x61\x35\xdf\x22\x9b\xee\x23\x3e\xe2\x21\xe5\x82\xce\xcc\x5f\x2c\x00\x3b\xbd\x7b\xc4\x0a\x02\xae\x05\x91\xc2\xc4\xbe\x42\x23\x2e\x6d\x6b\xa1\x55\xd7\x46\xdf\x5d\x5b\xfa\x4d\x61\xb6\x9f\x42\x4b\x4e\x36\xa7\x43\x58\x90\x98\xcd\xef\xa7\x79\x4b\xd9\x81\x89\xed\xc0\x71\x75\x06\x6e\x58\xf4\x8b\xdd\x5a\x36\xa3\x02\x5f\x5e\x68\x94\xc6\xac\x2b\xd4\xb4\x9e\x01\x72\xb9\xe8\xb1\xec\x94\xb1\x6b\x73\xaa\x2b\x3d\x0e\x94\xaa\xda\xaf\x57\x97\xb7\x97\xc3\xca\xba\x1f\xd4\xa3\xc1\x37\x66\x15\x10\xe0\x02\x25\x54\x50\x43\x03\x2d\x5c\x3d\x1d\xeb\x36\x4c\xf8\x1c\xe8\xec\x9f\x29\x40\x81\xa4\x2f\xe9\x5b\xe7\xcf\xbe\xdb\x85\xce\x46\x49\xd0\x1f\x79\xa9\xb3\xf6\x1e\x23\xc8\x18\x39\x99\xe6\x2c\xb9\xd0\xb8\x32\x3c\x20\xef\x52\xc4\x6f\x04\x9e\x42\x26\x34\x4e\x69\xbd\x7c\xef\xc3\xa7\x80\xfd\x94\xa1\xbc\xa6\x74\x3f\xdb\x9b\x73\x65\xb3\xde\x0a\xfc\x1f\x56\xa1\x7f\x7a\x7d\x31\x2d\x46\xaf\xdc\xc4\x54\xfb\x68\xb3\x28\x17\x39\x6b\xd7\xcf\xb9\xd2\x4f\x05\x17\x57\xbe\x3d\xeb\xd1\xb1\xaf\xff\xac\xbe\x7b\xb3\x44\x25\xe7\xe9\x5e\x63\x07\x4e\xa6\xc0\x77\x26\x3a\x17\xe6\x5a\x01\xce\x0a\x77\xf8\x4a\x39\x17\x4c\x97\x29\xd3\xa4\xd3\x17\x15\xb0\x41\xd1\x3f\xcf\x7a\xfc\x11\xbb\x4c\x14\xb7\x5c\x75\x13\x5d\xf7\xf9\xe0\xcc\xbf\xd3\x52\xc4\x63\xfb\x8f\xcf\xe3\xbf\xdf\x0e\xb1\xe5\xd5\xd6\x2b\x7a\xc1\xda\xf7\xd4\x7f\x79\x85\x49\x5c\x1f\x5c\x6b\xe2\xaf\xac\x39\x37\xfe\x21\x69\xfe\x7f\xb6\x2b\x67\x38\xd0\xe9\xfa\xbf\xb8\xca\x2e\xf8\x22\xb1\x06\x84\x13\x23\x06\x30\x17\xf0\x90\x75\x60\x3c\xb8\xe8\x51\xbb\xd2\x82\x7c\x36\x38\x03\x12\x01\x2f\x2c\x96\x6b\x41\x65\x5d\x6c\xcb\x29\xa7\x97\x4f\x2d\x60\x64\x82\x85\x04\xb6\x27\xc1\x32\x2d\xd7\x18\x90\x40\x00\xe4\xc9\xa1\x31\x3f\xd6\xbc\xdf\xce\x95\xbc\x3c\xb7\x67\x8d\xd9\xa4\x4f\x5f\x46\xb1\x02\x8d\x8c\x15\xfb\x81\xdc\x5d\x70\x01\xa2\x93\x26\xd7\x00\x97\x2a\x1f\x8f\x00\xec\x64\x9e\xca\x1b\x63\xc1\x09\xb0\x3d\xc0\x26\x70\x4b\x23\xa9\xa3\x40\x2a\x23\x8c\xe6\x62\x51\xf1\xa4\xfc\xcb\xee\x2f\x39\x42\x2b\xd9\x83\xf2\x7a\xb0\x7b\x74\xa3\x68\xde\xdd\x10\x51\x2d\x9c\x89\x82\x0b\x6a\x04\x56\x42\x3f\xd1\x05\xa1\x76\x42\xc8\xcf\x61\xcf\x58\x0a\x39\xb5\x6e\xe9\xbb\x15\xa4\x9d\x84\x5e\xf4\xe3\xaf\xbf\xb0\x4e\xd3\xad\x77\xe5\x83\x89\x38\x1a\x47\x7b\xc1\x40\x90\x3b\x2d\x0d\xac\x56\x4a\xd1\x79\x7a\x91\x61\x3b\x3f\x91\xa5\xce\x4c\x4f\xc5\x0a\x5a\xa8\x2d\xb6\x01\x9a\x80\x1e\xde\xd5\x72\xf8\xf6\xee\x2d\x51\xae\x83\xac\xde\x4d\xf2\xed\x51\x42\x26\xb3\x84\x04\xd8\x97\x61\x6b\x12\xdf\xd6\xe9\x3d\x07\x0c\x12\xc3\x69\x33\x72\xe3\xd9\xca\x08\x19\xd0\xfa\xc5\xc5\x29\x41\x0e\x25\xe0\x7d\x52\x2e\xbd\x41\x5e\x1a\x8c\x7b\xf4\xfe\x28\x56\xd8\xb7\x2f\x44\x90\x71\x6b\x74\xe5\xe1\x60\xca\xc2\x59\xaf\xd1\x3d\xba\x5d\x4e\x53\x4a\x48\x34\x9b\x8a\x62\x4e\x00\xc8\x8b\x35\xb9\x4d\xdc\xa8\x41\x8b\x26\x48\x21\x32\x4c\x14\xf9\x83\xc1\x00\x02\x0c\x7b\xc2\x0a\x1a\x45\x29\x79\x24\x0c\x69\xdd\x3c\x11\x1c\x2c\xc7\x6a\xe2\xc4\xfe\xb5\x65\x04\x01\x02\x17\x32\x5d\x9c\x2f\xc1\x15\xec\x6c\x5f\xda\x89\xb5\xe4\x57\xfe\x36\x05\x2c\xfa\xe9\xcb\x28\x25\x90\x12\x48\x6a\x54\x18\x55\x3f\x59\x63\x29\xa7\x93\x67\x8a\xa8\xc2\x98\x5c\x7b\xeb\xb0\x11\xb0\xaa\x60\xa6\xca\xc9\x4b\xa7\x5e\x0b\x8e\x34\x6b\x7a\x34\xd5\x32\xc2\x88\x84\x49\xa3\x0c\x7a\xcc\xcf\xf0\x03\x99\xcb\x7b\x20\xde\x63\x23\x98\x9a\x16\xea\xbd\xf3\xb1\xc0\x03\xc7\x46\x3e\x9d\x77\x26\xa6\x0d\xb2\x6e\xd5\xa8\x10\x30\xc0\x93\x9c\x7a\xeb\x2a\x52\x0a\xfb\x12\xa8\x57\x0f\x30\x39\x72\xa5\xba\xa1\x52\x04\x24\x15\xa6\x22\x31\x17\xf3\x96\x1f\x67\x33\xa2\x99\x33\xb6\x13\xd6\xda\xfd\x02\x00\xaf\xc2\x99\xb6\xdd\xd3\x8b\xfc\x76\x32\x5f\xb5\xb0\x5b\x66\x6f\x0c\x20\x37\x95\x8d\x75\x98\xcc\x44\x73\x66\x4d\x28\x54\x83\x51\xe5\xf0\x35\x23\x44\xb5\x2f\x28\xcb\x96\x17\x96\x56\xd4\x82\xcf\x7e\x54\xc8\xab\x99\x4a\xd7\x96\x7a\x74\xce\x1e\xc7\xb9\xc5\x35\xd6\x65\xf2\x03\xc8\xe5\x65\x33\xeb\xe9\xd2\x03\xcf\xc9\x09\x14\x4a\x86\x09\xb3\x5f\xf6\x9d\x43\x7c\x09\x72\xa3\x08\x82\xe7\x1e\x1d\x7b\x22\x32\xa8\xd1\xa9\xd6\x12\xa7\x7b\x6f\x93\x4d\xa7\x3e\xe5\x10\x66\x30\x24\x47\xed\xaa\xe8\x14\x5b\x93\x43\x98\xa0\x5a\x46\x68\xad\x30\x0f\x0e\x85\xa6\x91\x83\x35\x45\xbe\x25\x9e\x81\x44\x29\x9e\x0d\xb5\xf0\x63\x72\xef\x9d\xc4\x7c\x25\xbc\x03\x76\xc1\xec\xc4\xd7\x84\xe3\xd7\x2a\xb2\xb8\xc6\x27\x35\x76\x3c\x73\x78\x2c\x3e\xfa\x84\x43\x7c\x6d\x05\x32\xb5\xc8\x99\xe4\x27\xe3\xe6\x3e\x95\x5b\x22\xec\x2f\x4d\xc0\x83\x8a\xe0\xb8\x47\xc7\xb5\x14\xc9\x20\xb9\x37\xb6\x2f\xaf\x12\x10\x5b\x93\xd5\x79\x9a\x59\x19\x25\x13\x10\x62\x23\xaf\x18\xd3\xbb\x3b\x22\x60\x94\x71\x3c\x62\x0a\x3a\x4b\x7b\xca\x27\x9c\xa6\xd2\xe4\x08\xa9\x23\x45\x80\x6f\x53\x5b\xf2\xbb\xd6\xb6\xb2\x9f\x2a\xe4\x69\xa5\x37\xdd\xf2\x0a\xfb\x4b\xea\x1a\xda\x1a\x3f\x86\xbc\xc0\xd9\xc9\xf4\xae\xe8\x5a\x3a\x06\x38\x60\x56\x22\x1b\xa5\xbd\x06\x7c\x90\x2d\xfb\x16\xe7\x8f\x84\x08\x3c\x11\xe9\x96\x7d\xb6\xef\xb8\x8a\x58\xaf\xb0\x29\x3f\x7b\x9f\x33\x07\xe4\xdc\xf8\x92\xd0\x8b\x94\xb8\x31\x02\x66\x6f\x3c\x58\xd2\x8f\xad\xf1\x63\x59\xa5\x4b\x9e\xd5\xc0\x43\xcd\x03\x68\x92\xd6\x64\xb8\x54\x5a\x5e\x2b\xed\xf4\xe0\xbc\xf8\xca\x7e\x2d\xb4\x02\x70\xc5\x8e\x97\x68\xc1\xad\x7a\xb3\x8c\x03\xbb\xe8\xc2\x9c\x2c\x6d\x97\x94\x20\x98\x02\x47\xc0\xbd\x92\x09\x69\x4d\x82\x4b\xa5\xe5\x82\x3b\xe1\xbc\xf8\x4a\x36\x5c\x28\x01\xb8\x62\xc5\x25\x3d\xdb\x1a\xd7\x24\xf6\xa6\x33\x8b\x76\xe2\x8d\x49\x08\xb9\x65\x5c\x0d\x18\x82\x27\x2d\x04\x92\x46\x0a\xf1\xce\xb2\x57\xf9\x22\x43\xee\xf6\x3a\xf5\x2c\x3f\x0a\x86\x48\xa7\xcd\x0b\x4a\x72\x27\xdd\xf6\xe3\xcc\xed\x37\x60\x56\xfc\x62\x47\x8a\xf2\x9d\xa4\x11\xc2\xe9\x1d\x64\x4c\xda\x5c\x64\x78\xf7\x38\xff\x1e\x8b\x43\x3a\xf7\x36\xa5\x3d\x1a\x4b\xd2\x43\x60\xe8\x67\xdb\xa4\x51\x86\x2b\x9b\x84\x59\x94\xb0\x92\x74\x5e\x27\xe9\x5e\xb7\xbd\x2f\x97\x55\x97\x4f\xdc\x0f\xd3\xf9\x19\x17\x6d\xd5\xb0\x2e\x7c\x35\x5d\x9d\x59\x67\x37\xe5\x31\xd5\x2e\xaa\x94\x3e\x42\x77\x9f\x51\x3a\xad\xcf\xeb\xc9\xbd\xec\x65\x76\x0f\x30\x96\x7d\xcd\x26\xd4\xbb\xb7\x98\x65\x25\x64\x1b\x0c\x20\x86\x4e\x84\xc8\x19\x1b\x74\x0a\x32\xf2\x41\x99\x68\x5e\xdf\x25\xff\x9d\xc3\x2b\xe7\x70\xbc\x52\x0b\xd3\xd9\x42\x51\xc9\x5e\x10\xa5\x74\x24\x6f\xf3\x20\xee\x87\x6f\x34\x10\x6c\x0e\x76\x68\x9b\xa8\xc5\xf5\x4c\xfa\xc4\x19\xce\x5e\x6b\x2b\x97\xa8\xb1\x8e\xaa\x9f\xf5\xf1\xd8\x92\xb3\x41\x08\x57\xf4\x24\x80\x24\x5c\xe1\x3e\x6f\x3c\x62\x99\x4d\xa5\x63\x47\xf0\x4c\x27\xf6\xc9\x9b\x9a\xcf\x6f\xc2\x20\x68\xda\xe0\xd8\xf8\x5b\xe5\x0c\x1c\xac\xc8\x3a\x0a\x92\x97\xd4\x15\x48\x6c\x01\xbc\x7b\x70\x8f\x6d\xb5\x8a\x98\xe9\x0d\x5c\x87\x60\x64\x62\xef\xe0\xc4\x56\xb8\xab\x9e\x48\x08\xb1\x89\xf0\xea\xef\x30\xdb\xa7\x4b\xb8\x73\xcb\xe1\x4f\x8a\x63\x88\xa3\xdf\xb5\x54\x89\x12\x2c\x7a\xe6\x78\xa8\xe7\x9a\x57\x3f\xab\x0d\x50\x32\x62\x36\xb1\x41\x62\x0b\x79\xda\x28\x66\x68\x01\x52\xdc\x07\xe8\x21\x6b\x9d\x9d\x68\x51\x7d\xb4\x7f\xdc\x0d\x8c\x98\x53\x64\xca\xf6\x81\xf6\x08\x7a\xf3\xe1\x17\x7e\xfc\x9a\x0d\xc0\x19\x4d\xff\x10\xe1\x2d\xee\xa7\x4d\x22\x25\x6b\x31\x3b\x6b\x20\x29\x82\xbe\xdb\xb8\x50\xda\xdb\x93\xc6\x5d\xde\xc1\x6a\xb8\x1b\x48\x80\xe0\xf1\xe1\x35\x29\xb7\xbf\x61\x66\x07\x2c\xb1\x68\x2d\x01\xd8\xcd\x75\x5c\x7d\x76\xfa\xfd\x5b\xd7\xce\x7a\x4e\x31\xa0\x6c\x0a\x34\x11\x17\xf5\xd1\xe7\x06\xdf\x08\xbd\x37\x3e\x75\x7f\x57\xfd\x7f\xdb\x5b\x28\x55\x91\x49\x41\x71\x0b\xa6\x96\xd2\x9c\x4f\xa3\x48\x95\x7a\x3c\xe8\xe7\x61\xba\xde\xeb\x89\x19\xdf\x0c\x6b\x11\xcc\x84\x13\x1d\x11\xda\x22\x4d\x9b\x8c\xbb\x6d\x31\x9b\xc9\xbd\x34\xbf\xab\x44\xc6\x3c\xfa\xc9\xb9\x6b\x08\x5e\x58\x38\x9b\x58\x1e\xe7\x63\xb8\x5b\x20\x92\xc5\xa0\x62\x60\x69\xa1\x99\x96\x03\x08\xcb\x0d\x40\x04\x02\x01\x08\xce\xcc\xb5\xe1\x0c\x44\x31\xf3\xfb\x83\xf2\x5b\x2b\x80\xad\x08\x57\xd3\x30\xde\x83\xf0\xa0\xf7\x98\x49\xd5\x83\x08\xe7\x4c\x7d\xe0\x10\x1a\x37\x53\x33\x17\x69\xba\x22\xbc\x09\xfa\x4c\x49\x71\x54\x07\x15\x9b\xca\x7c\xc8\x4e\x4a\x1d\x1d\x3d\x74\x0a\xcd\xd5\x44\x02\x6e\xe1\x9d\xfb\x17\x42\x96\xd6\x73\x8d\x73\x5a\x03\x8a\x0c\x23\x2c\x6b\x13\xb0\xf0\xd5\x44\x23\x58\xc7\x9d\x2b\x5b\x0c\x32\x6e\x4b\x30\x03\xad\xd6\x78\x55\xcc\x37\x08\xa6\xcb\xc7\xfd\x9a\xef\x3d\x5c\xd4\x7e\x1e\xa4\x34\x17\x86\x02\x28\xdd\x44\xa9\x40\xce\x6b\xf2\x28\xdf\x44\xd3\x30\xac\x5d\x05\xf7\x3d\x12\x8c\xaa\x03\x35\x0e\x6d\x58\x60\x68\x2e\x74\x9b\x93\xee\x5c\x46\xb1\x51\xa5\x0d\x40\xa6\xfc\xe9\x3e\x7c\x9b\x39\xa3\xbb\xf2\x89\x39\x68\x20\x9b\x09\x92\xe5\xe1\xa5\x8a\xad\x07\x84\xc6\xcd\xfd\x1d\x15\x15\x5f\x8d\x8c\x4c\xb0\x39\x11\xdb\x00\xf0\xad\x68\x80\xec\x79\xde\xa4\x8c\xc1\x44\xcb\xea\x03\x00\xcd\xfc\xdc\xd4\x7d\xa4\x99\xd8\x2b\x1f\x8f\xb4\xa8\xaf\x58\xcb\xc4\xe6\xc3\xaf\xea\xf1\x6b\xd6\x07\x75\x6c\x95\x1d\xea\x21\x2d\xb5\x3e\x34\x62\x69\xb6\xb1\x0c\xfc\x79\x86\x96\x1e\x2f\x76\x2e\xb6\x33\xd3\x57\xd5\xa9\xff\x29\xac\x23\x1c\x07\x86\xca\xff\xff\x3a\x3c\x7e\xfd\x4f\x44\x81\x8d\x9d\x01\x65\x2f\xd8\x3c\xb3\x09\x80\xe4\x1e\x24\xbb\xef\x3d\x31\x63\x3e\xf6\xa7\x21\xa7\x65\x69\xfe\x2f\xf1\x45\xdf\xe9\x95\x9e\x85\x41\x18\xce\xa6\x37\x03\x1d\x74\x57\x29\xb8\x87\x94\xf2\x96\x9c\x17\xc2\xa6\xf8\x7d\xdc\x67\xf6\xfb\x4b\x73\x69\x2b\xa5\x9c\xbb\xbd\xf3\x11\xfc\x56\xb4\x06\x74\x53\x16\x9c\x51\xf2\xb6\x30\x40\x7d\x23\x6a\x56\x7d\x69\x45\xb3\x36\x11\x85\x60\xa3\xaa\xba\x84\xa7\xfa\xb1\x9a\x66\x56\xb9\x8a\x41\x30\xed\x66\x36\xa9\x2b\xc1\x44\x00\xb4\xc1\x79\x58\x70\xef\x45\x63\x95\x92\x98\x40\xe8\x3c\x59\x6c\xed\x53\x2c\x83\x66\x85\x63\x42\x75\x56\x93\xaa\x08\x5a\xf3\xe1\xd7\xee\xf1\x6b\x16\x0a\xae\xfe\x23\x7a\x37\x44\x38\xc5\x8b\x6c\x62\x25\x51\x76\xb2\xde\x01\x11\x22\xa3\x5b\xd7\xa0\x73\x08\x84\x41\x87\xca\xbe\xb0\x15\xc8\x33\x76\x4f\x80\xb6\x48\xcf\x01\x4b\x0c\xcd\x9a\x9d\xec\x07\x14\x9f\xe3\x3b\x1f\x4e\xf3\x1c\xe7\x07\xa0\x54\x67\x9c\xe2\xa6\xe6\xc3\xaf\xfc\xf1\x6b\x16\x83\xd3\xbc\x81\x3b\x80\x04\x88\x56\x27\x67\x56\xf3\x65\x53\x4f\x51\x77\xdc\xb3\x3e\xb5\x16\x55\x27\x0c\x80\x6c\x66\x19\xc6\xb6\x15\x33\xbf\xbf\x36\xdf\x17\x43\x58\x37\xd0\x03\x29\x8b\x3d\x18\x98\xad\x09\xb6\x77\x69\x07\xe4\xb5\x93\x8c\xf9\xb2\x9b\xb2\x05\x96\xba\x71\x42\x6c\x8a\x23\x3b\x5c\x32\x8c\xd6\x2f\x78\x99\x2a\xa3\xa0\xeb\x0c\x6e\x48\xde\xdf\xa0\xac\x33\x11\x80\xea\x07\x7e\xf8\x20\xfe\xff\xb5\xf8\xe5\x2f\x73\x24\x08\xc7\xc4\xb4\x24\x7c\x4a\x0f\x6f\x2c\x6a\x57\x73\x2c\xb8\xa0\x39\x41\xd5\xe6\x6f\x98\x54\x2a\xc1\xc3\xc3\xbc\xcb\xc8\x42\xfc\x67\x77\xaa\x57\x44\x6a\xab\x55\x4a\x33\xb3\xe9\x7a\xa7\x70\x3d\x94\x92\x95\x0c\x0c\xd8\xb4\xdc\x29\x64\x6d\xdc\xaa\x2f\xe3\xc8\xbf\x3d\x35\x55\x95\x9d\x24\xb6\xc6\x6a\xdf\xb2\x04\x39\x99\x9c\xcf\xec\xf6\x7e\x4a\x16\x85\x12\x21\x2c\x64\xa3\x72\x14\xc0\x0f\x9b\x41\x6a\xd6\xf3\xae\x49\x06\x02\x0c\x94\xb1\x8b\x35\x3d\x00\x56\x5f\xae\xc7\xc3\xbc\xba\xa5\x5b\x31\xb5\x02\x8b\x6a\xb7\x22\x88\x29\xf1\x50\x03\x11\xce\xb8\x8a\x38\xa6\x1d\xb9\xc3\x21\x41\x64\xd8\xc6\x49\x62\x9a\xb2\x14\x2a\x3a\x3c\xd3\x51\x7d\x15\x8e\xf4\xe8\x07\x53\xa9\xc7\x77\x1a\x03\xdc\x4d\x4b\x24\x0c\x7d\x52\x6a\x15\x69\x29\xb6\xb1\xa4\x56\x62\x60\x21\xf5\x18\xa9\x4a\x7e\x3b\x73\xa3\x04\x21\xaa\x13\x53\x97\x28\x44\xd0\xf6\xb3\x59\x83\x7b\x6e\x80\xe4\x46\x14\x8e\x81\x08\x39\xe8\x4c\xb5\xe2\x62\x3a\x1b\x37\xcf\xfe\x59\x48\x5c\x0b\x94\xd5\x3c\xfb\x33\xe3\x9b\x69\x53\x2f\x32\x68\x6c\x30\x53\x8b\x74\x3a\x7d\xc4\x1f\x8d\x3b\x3b\xc6\x06\x86\x5b\x04\x0c\x3c\x75\x28\x7d\xc0\x96\x8b\x34\x99\x78\x72\xca\x46\xa4\x86\xe9\xe4\xb4\xa6\x3e\xa5\x70\x46\x9c\x39\xb8\x67\x35\x03\x03\xac\xe9\x76\x4e\x95\xc9\x81\x6c\x47\x29\xf7\xda\x11\x80\x7e\xa6\x4e\x8c\x50\xc9\xae\x80\xb3\x31\xad\x90\xfe\x08\x58\xc8\x3e\x43\xf7\x38\xa4\x2f\xe3\xa1\x9b\xb1\xf6\xcc\xdb\x10\x10\x22\x4d\x91\xa2\x92\x8c\x0d\xe3\xc8\x80\xd3\xdb\xb9\x8c\xa6\x27\x09\x45\x3f\x07\xc0\x6a\x3d\x34\xdd\x68\x03\x37\x51\x32\x99\xa1\x47\x1b\x01\xd4\x62\x3a\xd5\x45\x80\x51\xa7\xd5\xbe\x3c\xab\x53\x0c\xcf\x75\xca\x84\x4e\x23\x64\x64\x96\xcf\x9e\x9f\xc9\x87\xe7\xb6\xcc\x3c\x95\xc3\x01\x03\x6e\x26\x4a\x20\x48\xf1\x17\x00\x57\xb8\x50\x9a\x06\x53\xfd\x8c\x08\x5a\x2f\x28\x4c\x6b\x50\x67\x66\x1b\x2f\x2d\x03\x9a\x6b\x82\xe8\x51\xb4\x74\x35\xea\x38\x3d\x91\x5a\x4f\x05\x50\xa1\xea\x57\x89\xe4\x64\xa2\x69\x5b\xe1\xfe\xf3\xf7\x19\x8f\xf1\xad\x12\x80\x88\x74\x58\xc6\x9a\x8f\x97\xb0\x43\xbe\x14\x61\xa0\xed\x26\xa4\x28\x0a\x0e\x3f\x18\xae\xa5\x54\xe4\xf4\x55\x88\x4a\xe5\x64\xa6\x16\x3a\x1c\xa8\xe6\xa5\x9c\x51\xd4\x78\x36\xc9\x28\x87\x83\x49\xa2\xb6\xd3\x91\x00\xb0\xa6\x00\x62\xb2\x06\xd3\x8a\x33\x0c\xfb\x56\xdd\x16\x80\xf6\xf1\x0f\x09\xbe\x31\x3a\x4b\x71\x7c\xf4\x3e\xa1\x53\xcd\x14\x00\x6e\x58\x7e\xc3\xc9\xa5\xa8\x99\xb7\x37\x7f\x43\x56\x4d\x26\xa5\x80\x2a\xfb\x43\x04\xe2\x62\x15\x0a\x32\x3b\x86\xa4\x3d\x44\x87\x53\xae\xec\x72\xa7\x85\x23\x69\xbd\xdf\xcd\x76\xd6\x2d\x33\x5d\x29\x74\x84\xa8\xb4\x9a\x2d\xa2\x01\x90\x70\xad\xe1\xec\x58\xe9\x58\xec\x48\x74\xec\x70\x4c\x84\xbf\x3f\x02\x1f\x15\x4d\xe8\x71\x42\xfa\x2b\x39\xac\x92\x08\xcb\x24\xa2\x99\x90\x76\xba\x00\x57\x19\xf4\x0f\x8e\x52\x79\xe9\x63\x3d\x6c\x5d\xab\xa6\xc4\xac\x4d\x84\x86\xe7\x07\xc8\x03\xc8\x73\x88\x2a\xd9\x92\xe4\x62\xab\x64\xbb\x1c\x62\xb0\xfd\xb2\xb3\xe5\x7e\xe4\x3c\x23\x2c\x42\xf6\x4d\x20\x1b\x3c\x31\xb8\x31\xef\x8f\xbd\x71\x46\xc3\x70\x1d\x2e\x86\x19\xb0\x9a\x03\xac\x74\x66\x80\x82\xd5\x64\xae\xb4\x4b\x4f\x05\xb5\x1f\x08\x5e\xdf\x85\xe1\x2f\xcc\x01\xc6\x2a\xb2\xd7\x1e\x87\xdf\x49\xa3\x97\x50\xd3\x03\xde\x67\x26\x28\x18\x62\xab\x66\xc4\x09\x9d\xeb\xf0\x6c\x14\x1b\x83\xd6\xf0\x0b\x58\x15\x8a\x9c\xe7\xfa\x35\xe5\x2c\x9f\x1d\xda\x16\x61\xb6\x3b\xdc\xcf\xa7\x76\xdf\xee\xb7\x3d\x6a\x50\x97\x40\x0d\x68\x1e\xe9\xa4\xbe\xa2\x92\x6f\x62\xbc\x58\x0a\xc2\x58\x1a\x54\x0b\x35\x4d\xe2\x96\xf1\x34\x51\x6a\x20\xe4\xf7\x21\xa8\xd3\x22\x24\x60\x24\x22\x52\xa3\xe4\xc2\x28\x57\x2a\x48\xd7\x95\xbc\x20\x46\x08\xf2\xb8\x2a\xb2\x38\x3b\x63\x40\xd8\xfc\x93\xf1\x27\xa0\x85\x61\x1e\x7d\x7a\x6c\x93\xec\x51\x3b\x46\xab\x6b\x8d\xca\x44\x97\x45\x9a\x0c\xfd\x6d\xf8\x49\x8e\x23\x32\xee\xe1\xcc\x71\xf5\x2c\x09\xe2\xc6\x41\x72\xb0\x06\x1d\x22\x14\x0c\x38\x9e\xd7\xd1\x82\x5c\x2d\xd8\xd5\x5c\x57\x4d\x73\xcc\x1a\x67\x1b\x92\x93\x7d\xeb\xa6\x11\x0a\x56\xf0\xb0\xc5\xc1\xef\x0d\x22\x0b\x10\x7a\x5b\xfd\x01\xee\xd0\xfd\x5a\x2a\x8e\xd8\x22\x05\x38\x0c\x80\x22\x63\x88\xe8\x3a\x62\x9d\x20\xc6\x7a\x16\x94\x33\x29\x26\x4f\x06\x85\xd3\x35\x8a\xd1\x8d\x98\x7b\xd2\xcf\x3a\xe3\xdc\x6e\xf7\x3e\x67\x04\x6f\x0e\xc3\x41\x8d\x0d\x12\x15\xe3\x6d\x16\xc6\x7e\xdc\xb0\xe8\x58\x03\xb6\xec\xb5\xe3\x21\x6c\x9c\x07\xa6\x50\x4b\x63\x3d\xd9\xb5\x3a\x3c\xa2\xb1\x43\x4a\x35\x29\xaf\xac\x15\x0f\x18\x06\x58\x2b\x08\x76\xdb\x2e\xe0\x57\xd1\xae\xbb\xcb\x61\x97\xb5\x99\x43\x47\x88\x02\xe6\x96\xd7\xf7\xcb\x16\x1d\x56\x45\x35\xfb\xd2\x48\x08\x27\x2c\x70\x45\x4a\xf3\x86\x52\xf4\x4a\x42\x5e\x80\xed\x43\x83\x33\x67\x6c\x04\x25\x21\x89\x11\xb1\xf7\xb1\xb3\xeb\x4f\xd5\xa9\xfa\x8a\x47\x73\xb0\x82\x10\xe6\x2c\xc7\xa1\xed\x33\xe8\x4f\x1c\x12\x92\x6c\x12\x01\x2a\xd8\x29\x5c\x54\x35\xbe\x26\x9f\x34\x16\x79\x2a\x91\x07\xb7\xe0\xf6\x78\x10\xdd\x40\x84\xa6\x25\x3d\x5f\x23\xd2\x39\x1e\xde\x54\x6f\x72\x38\xe1\xac\x07\x88\x39\x72\x7a\x18\x50\xc9\x02\xf6\x9d\x1a\xb2\x5a\x1d\x55\x55\x5a\x4a\x59\x25\x42\x20\x2f\xca\xf9\xac\x39\x52\xfb\xb9\xb2\x4b\xcf\x31\xe9\xc9\x1c\xbb\x7c\x53\xd9\x8e\xec\x06\x05\x3c\xfc\xf1\x81\x59\xcb\x4d\xce\x77\x0f\x84\xdd\xd9\xf0\x43\xc6\xf7\xaf\xd7\xf3\x6e\x3b\x74\x16\xea\x92\x53\x1c\xb5\x11\x86\x37\x27\x9a\x8d\xc1\xf4\xdc\xdc\xbc\x5b\x7f\xad\x4f\x4c\x89\x6e\xd7\x08\xb7\xe6\xfa\x8b\x04\x37\xd1\xf9\x45\x80\x35\x3d\x7a\x2c\x06\x67\xea\x32\x4f\xb5\xdd\x37\x9e\x6b\x66\x28\x27\xa4\xc3\x3e\xef\x88\xce\x3c\xbe\xd5\x40\xd9\x84\x14\x8b\xdd\xbe\x77\x78\x2e\x0e\xe7\x75\xfc\x5a\x45\x21\xc0\xd8\x20\x46\x2c\x01\xc0\x1b\x95\x39\x9e\x0a\xb4\x87\x8b\x86\xf1\x28\x5d\xc0\xbe\x48\xbf\x26\xea\x4c\xb9\x28\xcf\x6d\x0c\xb4\x01\x78\xd0\xbd\x21\x08\x38\x71\xc6\x8b\x2a\x99\xbf\xb8\xe0\x52\xde\xa1\x55\x73\x08\x51\x34\xee\x7a\xa4\x20\x83\xca\xec\x23\x00\x6d\x55\x5d\xd2\xa5\x6e\x01\x33\xca\xab\xe5\x73\xce\x03\x0f\xbe\x96\xf8\xba\x10\x4a\xa5\x92\xcb\x78\xb3\x29\x6c\x95\x8d\x11\xa1\x2f\x6c\x62\x18\x37\x40\x22\x69\x9f\xe6\xc7\xbf\x37\x2e\xcf\x8f\xdf\x8f\xdf\x1c\x1c\x8d\x75\xf5\x4a\x27\x28\x41\xad\xd8\x9c\xe6\x2b\x11\x32\xe8\x89\xc2\x26\x94\xc3\x60\x46\x90\xd8\x96\xac\xc5\x50\x5e\xe2\x90\x06\x8d\xa6\xe4\x8a\x99\x56\xdc\xae\xcc\x9d\x68\x69\x0b\x03\x8e\x42\xa0\x43\x00\x72\xf4\x80\xd0\x04\xb0\xa5\x4e\x55\x61\x2e\x22\x50\x01\x11\x75\x2d\x1a\xbf\x51\xba\x05\x70\xf1\x00\xe3\x76\x6b\xa7\x05\x50\x35\x7e\x9d\xa0\xb6\x7a\xf7\x70\x46\xe8\x22\x70\x31\x55\xbf\xbd\xfd\xff\xd1\x06\xcc\x37\x39\x37\x68\x2d\x4b\x74\x03\x3c\x26\xe7\x4e\xdd\x96\x14\xf3\x16\x1c\x60\x52\x70\x14\x22\x17\xae\xdb\xc2\x54\xc1\xc7\xe0\x27\x10\x99\xaf\x69\x3d\x45\x00\x14\xe3\xa3\xc7\x30\xaa\x66\xe1\x68\xc4\x3d\x9c\xe7\xb9\xd8\x1e\x9f\xc6\x2d\x84\xc2\x84\x48\x7d\x1e\x7b\xda\x4a\x0d\x80\x32\x0b\xf7\xbc\xc9\xcb\x16\xf0\xc1\x93\x97\x61\x28\x98\x2b\xc7\x58\x01\xa2\x8b\x29\x6b\x91\x9c\x5c\x56\x43\x29\xd5\x96\xaa\xd2\x35\xd7\xd0\xac\x71\x8b\x75\x3b\xa8\xeb\xf3\xb0\x8d\xed\x9b\xa4\x78\xd8\x7e\x72\xde\xc6\x9d\xf5\x83\xb8\x65\x9f\x86\x60\x18\x77\xd6\x80\xae\x54\x1b\xe1\x46\xf3\xcb\xe9\x2a\x9e\xc2\xad\x68\x2b\x4b\xb5\xb8\xed\x01\xfa\x08\x2c\x7f\x39\xc9\x92\x3c\xdd\x17\x1f\xd0\x25\x4d\x08\x08\x1b\x8f\xbe\x79\xa3\xc9\x9d\x76\x84\x02\x54\x0b\x79\x1a\x72\x95\x95\x28\x09\xb3\xc3\x22\x49\x5c\xa7\xfa\x71\x19\x42\xcc\xc0\x8d\xf4\xb2\xa7\xa0\x4c\x10\xd9\xb9\xeb\xa2\xcd\xd8\x53\xef\xa0\x5b\x2e\x30\xf1\x1d\x7a\x34\x25\xc9\x06\x1e\x35\x2e\xfd\x67\x3c\x30\xe4\xba\x8c\x19\x6e\x45\x42\x64\xe0\x5a\xd0\x97\x5c\xd7\x2b\x8b\xc7\xb5\xc8\xef\xd1\xdf\x17\xc0\x39\x6d\x4d\x20\x87\x9d\xd3\xd8\x60\x8a\xb2\x76\x92\x4d\x6e\x34\xb0\xb2\x64\xe3\xcf\x28\xb3\x65\x25\xe1\xc6\x66\x3e\x0a\x9e\x43\xcf\xb9\xb4\xbe\x55\x5b\x29\x2e\xa4\x40\x26\xc6\xb7\xcc\xc8\x03\x94\x63\x37\xdc\x1d\x7e\x2e\x48\xd2\xe5\x91\x1d\x0c\xd1\x9e\x0e\x51\x01\xa8\x0e\x51\xab\x32\x1e\xf8\x2a\x65\xf5\x8d\x40\xa7\x7c\xb7\x89\x3b\x33\x96\xcb\x87\x67\xb1\x9e\x12\x27\xbc\x61\xbc\x33\xbb\xa7\xdb\x62\xf5\xb8\x87\x43\xb7\xc3\x73\xad\x24\xdc\xac\xca\x91\x69\x0a\x29\x6f\xad\x05\x61\xb3\x23\x58\x2b\xc3\x46\x65\x32\xf7\x75\xbf\x42\x5b\x39\xd3\xbb\x3b\xba\x8e\xab\xe4\x6c\xe3\x4e\xbd\xa5\x22\xf1\xe6\x32\x74\x5b\x42\xb8\x03\xc0\xc3\x24\x95\x8a\x0c\xf3\x6c\xdd\xb5\x38\xb5\xe2\xa0\xe6\x65\xef\xdd\x55\xab\xf3\xbe\x19\x8c\x8f\x0c\xf9\x76\x36\xd0\x3f\x3b\x9d\x65\x25\x14\x05\x42\xb9\x81\xeb\x71\x5f\xe8\x42\xd7\x25\x8a\xd1\x2d\x99\x36\xfa\x4d\xcb\xfa\xca\xdc\xcc\xb7\x3f\x01\x75\xbb\xc6\xfa\x24\xc9\x19\xb2\x69\xb6\xf4\x32\x5b\x6e\x54\xb9\xb1\x1a\xe9\xdd\x3d\xf6\xa3\x4a\xa6\x2c\x73\x80\x41\xcc\x6f\xdd\x09\x75\xe1\xdd\x3c\x07\xee\xbd\xe8\x6a\xe2\xba\x05\x0e\x89\x74\xf8\x14\x8e\x45\x6b\xf7\xc4\x7e\x36\x3c\x67\x65\x31\x46\xf0\x22\x8a\x15\x86\x30\xa7\xb7\xa8\x0d\x34\x9d\xae\xdf\x8c\x90\x17\xca\xf6\x5d\x30\x18\xf0\x00\xf1\xcd\x1e\x12\x78\x7c\xd6\x01\x20\x79\x15\x2b\x4a\x90\x04\x59\x40\x23\xcf\x4a\xfc\x0b\x36\x07\xd7\x9a\x7c\x3d\xdf\xbf\x75\x97\x0d\x49\xdd\xb9\x13\xa9\xe0\xb1\xc4\x95\xc4\x84\x09\xf4\xd3\x36\x5e\x6f\x9b\x89\xc0\x60\xfd\xd5\xd3\x8d\xb3\xb0\xc4\x0f\x11\xc3\x00\x7f\x65\x53\x16\x80\x8a\x6e\xed\x55\xe3\x0a\x1d\x48\x27\x2d\x2b\x98\xb5\x92\x4c\xb2\xa7\x20\x06\x5a\xed\x52\x4e\x1a\x07\x90\xec\xdb\xcd\x65\x28\xf2\x23\x2f\xf7\xa1\x90\xe6\x96\xab\x27\x0c\x9d\x71\xa4\x72\xbb\x92\xa2\xdb\x37\x2f\xa6\x3b\x0d\x66\xd0\xe0\x70\x5e\x07\x0b\x70\xb5\x40\x57\x73\x5e\xd5\xbf\x5c\xc3\x75\x7b\xf2\xc6\x15\x48\x04\x49\x87\x19\x65\xb7\x0a\x0e\x84\xd8\xa3\xc5\x9d\x00\x05\xcd\x88\x47\xa2\xc0\x2c\x15\x89\x64\xda\xec\x14\x48\x41\x06\xf6\x8b\x8e\x31\x2a\x99\x69\x2c\x4c\x31\xe9\x05\x41\x36\x6b\x63\x99\x36\xe2\x96\xa6\x94\x97\xc1\xfe\x06\x1e\xda\x09\x11\xb6\xf6\x47\x72\x5a\x06\x45\x36\x5b\x6b\x63\xbe\x49\xa8\x90\x95\xd8\x45\xcc\xc4\xb5\xf0\xea\x88\xaa\x8a\xdc\xf6\x08\xb1\x48\x6e\xd6\x9a\x25\xbc\x04\x80\x21\x7b\xf2\xe9\x3c\x90\xbd\x51\xc2\xcc\xef\xc2\x02\xa8\x2f\x8f\xe6\x5e\x56\x98\xf5\xa0\xad\x2f\x2c\xc6\xed\xea\x39\x4b\xca\xa6\x5d\x22\x0f\x49\x05\xc8\xf1\xba\x8d\x35\x64\x62\xa7\x98\x67\x67\xee\x56\xd3\xae\x7b\xee\x4e\x9c\x6e\x16\xb5\x77\xe9\xdf\x35\xcb\x9e\x33\x02\x70\x7d\xdd\xe5\x66\x37\x2c\x26\xd7\x57\x25\x81\x28\xe1\x6a\xe1\x8e\xd2\x88\xd2\xee\x3b\xd3\xf8\xcc\x73\x65\xb7\x41\xbb\xbf\xb1\xac\x36\x85\x1e\x03\x26\x62\xe4\xa4\x16\x5b\xd6\x26\x34\xb1\xb9\xc7\xd3\xb0\xae\x5c\xb1\xfe\x1e\x45\x1a\xef\x05\x21\x26\x15\x96\x64\x26\x59\xdc\xde\x53\x99\x46\xa6\x03\x41\x94\x50\x3a\x7c\x84\x34\xf1\xd4\x80\x0f\xf2\x8b\xa1\x59\x9e\x6c\xb2\xe3\x10\xb0\x3a\x9b\x22\x18\xdf\x76\xb5\x51\x02\x88\xea\xe2\x21\x39\x43\xf8\x79\x0a\xea\x83\xf1\x6b\xaf\x8d\x90\x37\xb4\x8e\xb0\xb5\x53\x1b\x6d\x6d\x2c\x00\x7f\x5e\xc2\xa1\x93\x4c\x09\xba\x0c\xc7\xb7\xae\x26\x03\x31\x05\x1c\x0f\x04\x8a\xe1\xc8\x75\xdc\x38\x2b\xf0\x7e\xae\x8b\xdf\xfd\xb4\x82\xac\x08\x75\x4b\x79\x8b\xda\x82\x96\x5f\x79\xb7\xa3\x84\x34\xe3\x66\xe3\x98\x46\x29\x4c\x98\x3f\x34\x3d\xb6\x40\x8c\xdb\xa2\x9b\x3f\xa0\x50\x9e\x34\xfc\x9f\x5c\x0b\x39\x37\xb2\xe1\xce\xe2\xe9\x3e\x49\xf6\x97\x21\x7a\x3e\x1d\x0f\x28\xa6\xe2\xcc\x68\xd7\x46\xa7\xd0\x49\x0a\x73\x25\x0d\x48\x53\x63\x3f\xec\xd9\xe3\xeb\xe4\x1e\x9b\x97\x93\xc1\xa2\x09\xb4\xb1\x26\xc4\x1d\x1d\x67\x23\x86\x3b\xb9\xac\x03\x26\x2b\x8d\x68\x12\xd6\x07\x07\xa3\xac\xbf\x34\x4b\x47\x23\x9e\x37\x7c\xef\xce\x69\x34\x25\x84\x59\x20\x78\x29\x78\xf6\x60\xa6\x81\xc0\x25\x3d\xa6\x58\x62\xc9\x93\x8e\x20\x0d\x58\x72\x79\x08\x59\xa5\xef\x13\x96\x19\xba\xf5\x41\x41\xc4\x58\xe9\x25\xf9\xd3\xfe\x85\x50\xf2\x8c\x58\x42\x16\xde\x04\x78\x1c\xf7\x4f\x55\x04\x4f\x77\xfe\x12\x7c\xf2\x64\xbe\xab\xca\x78\xdf\xc1\xe6\xe4\x54\x28\x88\xf9\x4c\x89\x09\xb5\x3c\xd2\xb4\xd0\x52\x22\x14\xb5\xb6\x06\x64\x23\xeb\x22\x43\x01\x7c\x17\xf4\x75\x5d\x47\xb5\x26\x72\x0f\xb2\xe6\xe5\xb4\x87\xcd\xcf\xc0\xa7\x8d\xc6\x52\x2c\xe3\x0c\xea\x89\x0c\x69\x08\x1c\xfc\x05\x16\xe7\xd1\x09\x88\xb3\xbc\x54\x45\xf4\xed\x3f\x33\x86\x0c\x8c\x6e\xf9\x9a\xd5\x67\x36\x56\xd6\x1b\xd5\xef\xbb\x29\xc1\x63\x13\x1b\x45\x62\x2b\x90\xd3\x26\x62\x06\xfd\xf8\xd7\x4b\x83\x24\xb6\xbe\xa7\x9b\x69\xcf\x59\x29\x7b\xe1\xb3\x25\x33\x04\x3f\xa4\x0e\x40\x01\xc1\x6c\xc8\xb0\x8e\x39\x1b\x4f\x5b\x9b\x60\xdb\x72\x7a\xd4\xe0\xd8\x4e\xed\xf7\xfd\x3a\x34\xbf\x0b\x6d\x11\xca\x28\x3c\xab\x51\x34\xe9\xee\x42\xd6\xb8\x60\x2a\xed\x7e\x45\x4d\x6b\xc5\x64\x68\xae\x63\x17\x34\x69\xe7\x57\x31\xc2\x85\x8e\x4b\xaa\xd9\xea\xda\x80\x95\x45\x0b\x25\xb3\x6a\xa6\x38\x2d\x02\xc6\xcb\x6f\x55\xac\x46\xa2\x3c\xd4\x84\xda\x16\xe6\xde\x59\xa3\x2a\x94\x36\xb7\xbd\x0b\x47\xf9\xab\x16\x21\x5f\x2c\x20\x25\xc6\xdd\xc6\x45\x62\xc0\x06\xf2\x54\x14\xe8\xe5\x6b\x84\x7a\x63\xaf\xbb\x01\x25\x88\x2f\x04\x51\x66\x09\x4b\xcb\xa3\x35\x12\xb3\xc9\xe8\xc6\x26\xa3\x75\x89\xd5\xd8\xd2\x69\x87\xb4\x76\x7c\x81\x44\x87\x73\x3e\x6a\x52\x9a\xa5\x3b\xd5\x25\xe0\xcb\xc6\x81\xe6\x5f\x2c\xe9\x63\x07\xfb\x95\xf0\x4c\x2a\x4a\x57\x85\x42\x12\x95\xb8\xcb\x87\xe7\x48\xa7\xd7\x26\xe2\x9d\x3e\x1b\x2a\x85\x30\x9c\x0c\x3b\xd5\xa9\x16\x9a\xdd\x6c\xac\x9c\x5d\xae\x31\xbf\x22\x02\x08\xc2\x1a\xd5\xef\x69\x6c\x9a\x6a\xf6\x06\x58\xd6\x6d\xbd\x17\xf3\x62\x9e\xad\x6f\xe9\x0a\x52\xf9\x8c\xe8\x7c\x09\x35\xb9\xa5\x2c\xf9\x2e\xc7\x53\x2e\x7f\x1f\x41\xb6\xab\x31\x1b\x20\xc4\x45\x1c\x04\x7f\x94\xf4\x5a\xa9\x50\xc9\x1d\x82\x8c\xdf\x9e\x6c\xcf\x9d\x6d\x6e\xde\x20\xff\x16\xe1\x4f\xb3\x80\x71\xe7\x91\x26\x8b\x1c\x06\x35\x77\x7c\x4b\x58\x6d\x10\x33\xbd\x99\x25\xd4\x3e\x36\x66\x79\xa1\xa7\x6b\xa6\xdb\x6d\x17\xdd\xcb\xc5\x65\x72\xdb\x25\x0c\x91\x2c\x86\x84\xf2\x86\x65\x97\x6c\x47\xbd\xc9\xf1\xbb\x27\x9f\xd4\x5b\x67\x8a\x0c\x85\x08\x6a\x63\x16\x79\xce\xdd\xb1\xdf\xe5\xf2\x6b\xb6\x1a\x8f\x9b\x2f\xc0\x9b\xce\xfb\x65\x5f\x39\x83\xfd\x82\x9a\xae\xe1\x34\xa1\xf6\x6a\xc3\xed\xa7\xbd\xe6\x46\xe5\xd8\xc2\x5e\x12\xbc\xb7\xc0\x1b\xd7\xd1\xaa\x28\x0b\x9e\xe6\x2f\x9d\x2b\xb4\x68\xc0\x96\xe5\xbe\x4e\x47\x93\x79\xcb\x12\xf5\x77\xb3\x6f\x94\x1b\x6a\x89\xcc\x31\xba\x9e\xfa\x95\xc0\x4d\x87\x42\xcb\x72\x9c\x6c\x67\xf0\x53\x4a\x07\xf4\x67\x66\x8f\x83\x4a\x78\xfd\xb9\x10\x52\xcb\x40\xc0\x78\x6f\x53\xaf\xcf\xcc\xc1\x37\xd4\xf3\x97\x9d\xd7\x89\xb9\x8c\x1b\x5a\x66\xc2\x3e\xfb\xbf\xfd\xac\x25\xe3\x3a\xaa\xde\xce\xab\xd0\xfc\x0e\xb4\x57\x7c\x32\x26\x91\x3d\x93\x2b\xf9\x9b\x19\x0d\xb6\xbe\x75\x0b\xea\x37\xf8\x8f\x78\xbf\x16\xeb\xd3\xc8\xd2\x93\xd9\x2f\xe7\x9b\xb5\xd3\xf2\x29\x4c\x8c\x6e\x3b\x3f\x98\xcd\xe0\x27\x94\xe3\x8c\xb1\xf2\x70\xdf\x22\xa3\xc3\x30\xd3\xc1\xaa\x35\x37\xd1\x1b\x97\x58\x50\xb7\x58\x68\xe1\x6c\x88\x17\xf8\x64\x97\xbd\xc9\x82\x2e\x31\x53\xe6\x3a\x82\x35\x4d\x90\x22\xb1\x99\x71\x66\x56\x15\x73\x26\x41\xdb\x1e\x23\xdb\x5d\x52\x49\x69\x83\xa9\x50\xf3\x0d\x29\xcf\x5b\x13\x25\x41\xc8\x59\x8b\xc4\x84\x44\x1c\x24\x5b\x2d\x34\x42\x31\xd3\xfb\xce\xa9\x7c\xdc\x80\x92\x9f\x11\x2d\xd3\x6b\x8d\x1e\xee\x13\xf0\xe9\xe6\x8c\x19\x1d\x01\x8e\xe8\xfe\xd0\xfc\x9f\xe9\x04\xab\xa6\x43\xf2\x79\xc3\x71\xd5\xfc\xba\xe7\x5b\x78\x80\x0a\x5b\x43\x12\xb2\x83\x8d\x39\xe1\x60\xd5\x7a\x35\xdc\x3b\xe2\xa5\x27\x6a\x10\x38\xf8\xf2\x2b\x4c\x35\x98\x7e\xf2\x3f\x0f\x68\x17\x29\x88\x0c\xa4\xc6\x30\x43\xf0\x98\x05\xec\x4d\x13\xb4\x95\xfd\x16\x9a\x51\x5a\x33\xdb\xff\x0f\x01\xbe\x51\xdf\xcd\x93\x3b\x76\x43\x91\xa2\x13\x4b\x99\xae\x81\x15\x69\x72\x88\xa4\x81\x1d\x06\xb9\xe0\xed\x8f\xf0\x0b\xc8\x7f\x72\x8f\x1f\xc1\x68\x68\x4d\x91\x71\x3a\xca\x98\x9d\xf1\x43\x06\xdf\x14\xe5\x78\x36\xf1\x11\x71\x1b\x53\x5e\x98\xe4\x76\x9a\x3c\xb1\x27\xbb\x48\x78\x50\xc9\x75\xad\xc6\xd2\xb6\x90\x2c\xfd\xd8\x22\x10\x06\xab\x47\x7c\x2c\xce\x55\x71\xa4\x71\xc1\x2d\xc2\x1b\xee\xb7\x59\x92\xe3\x31\xed\xc6\x10\x42\x7b\xa2\x81\x9f\x90\xf9\x2d\x7a\x79\x85\x91\x52\x83\xc3\xe4\xd6\x4b\x61\x55\x43\x63\x64\x44\x4f\x2e\x75\xbe\x68\x94\x8a\xa9\x2e\xa9\x03\xd0\xe1\xf6\xc0\x73\xfd\xe3\xeb\x39\xc2\xe9\xc9\xf1\xdb\xf1\xdb\xd7\x2f\x8f\xfb\xed\x7a\x3e\xa1\x11\x86\xa5\x11\xc6\x6d\xb4\x2c\x19\xcd\x71\xb5\x4e\xbb\xf8\x41\x04\xd0\x36\x3c\x01\xe6\xa4\xf1\xb1\x78\x46\x03\xcc\x1a\xd3\x87\x90\xf0\x5d\x0e\x92\x2e\xe3\xcf\xd2\x8c\x63\x6e\xc4\x0f\x06\x14\x98\x99\x11\x11\x6f\xed\xcb\xe9\x49\xb0\x54\x6a\xcf\x3d\x19\xa7\xb9\x57\x0b\x48\x5f\xea\xf3\x20\x92\x1a\x6b\xc9\x55\xd0\x0d\x24\x68\x67\x6d\xc9\x1e\x79\x2a\xb9\xeb\x1b\x21\x87\xce\xf0\x1d\x56\x25\xdc\xc4\x21\xd6\x00\x3f\xf6\x24\x4a\x68\x4c\xf7\xb1\x58\xe6\x2e\x6d\x6d\xe8\x67\x90\x13\xca\x5c\x52\xc0\x60\x08\x25\x05\xb2\x4d\x8f\x3a\x8d\x4d\x1b\x2f\xac\x15\xca\xe5\x9e\xc7\xfd\x84\x52\xdb\xbb\xc9\xb2\xce\x96\xc2\xbf\xa3\xa3\x80\x6d\x0b\x97\x62\x17\x77\xee\xd3\x26\xee\x7a\xa3\x5a\x0b\x1d\x3d\xa5\x20\xc0\x13\x1d\x24\x64\x84\x2f\x14\xf3\x27\x64\xfa\xb9\x3e\x5f\x34\x49\xb7\xbc\xc9\x84\x91\x04\x0a\xe0\x12\x51\x4c\x83\xfd\xbc\xb7\xf9\x22\x70\x62\x70\x8d\x68\x8d\x29\x1b\x4a\x20\x03\x40\x8e\x4f\x9b\x19\x34\xd1\x82\xc5\xca\xc6\x16\xb4\x74\x0e\x04\xd1\x4d\x89\x7e\x9f\x15\xf0\xd9\x26\x92\x42\x65\x9a\xed\x4e\x87\x69\x18\x5c\xcc\x21\x45\x6c\x02\x64\xc8\x82\x17\x44\x31\x2e\x65\xb9\xd8\x41\x7c\x40\x6e\x7d\x25\xe8\x24\xdc\x9a\x86\xeb\xd0\xca\xa3\xfe\xee\xab\x21\x18\x64\x3b\x39\x1f\x6b\x89\xa2\x9e\xac\xdd\x2f\x99\x63\xcc\xa6\xbb\xce\xe3\xaf\xf3\x90\x97\x2f\xa0\xff\xac\x71\x08\xae\x9d\x42\x80\x12\x3d\x32\xd3\x25\x92\x3d\xc0\x64\xbf\x80\xd9\xd4\xe8\x98\x37\xac\x2f\x10\xf8\x81\x79\x67\xd3\x23\xc6\xc6\x27\xd9\xa4\x01\xd7\x88\x7a\x36\xa8\x1c\x41\x3f\x0a\xcf\xa1\xca\x9c\x01\x0b\xc7\x8a\xa3\x06\x3a\x29\x70\x20\x67\x9e\x2c\x87\x77\xcc\x1b\x89\x3f\x63\x01\xee\x3f\x3c\x6f\x5b\x45\x92\x1b\x3d\x88\x4c\x4f\xdd\x36\x77\x57\x0d\x58\x62\x8c\x63\x20\x1f\x36\xa6\xd1\x26\xd2\x56\x32\xdc\xe8\xbe\xe7\xd0\x4e\x6e\x70\x5c\x80\x20\xf9\x0e\x85\x78\x7c\x23\x85\x05\xfa\xe7\xd9\x43\xc8\x40\x40\xec\x6a\x09\x22\xb0\x6b\x44\xee\x42\xde\x66\x39\xcf\x8f\xce\x34\x6f\x56\x5a\x80\xc7\x81\xe6\xbc\x0f\x75\x32\x36\x67\x0c\x4a\xa4\x7b\xc9\xdd\x1a\xe5\x62\xeb\x71\x82\x1f\xfd\xdf\x05\xae\xd8\x5b\xd2\xd9\x67\xfa\x9a\x7d\x13\xf2\x89\xe6\x3e\x23\xba\x0a\x2b\x2b\xd4\x3c\xc7\x89\x17\x26\x3f\x43\x36\xe8\x8d\xd5\x15\xb3\x63\xcf\x4e\xa1\xe1\xd4\x94\xde\x2a\x49\xd8\xd6\x33\x80\x1f\xd7\xe9\x36\x1c\xab\x13\x77\x65\x3c\x0f\x4f\xd8\x04\x64\xc1\x89\xd6\x0e\x82\x53\x52\x8d\x6c\x80\x7b\x9a\x9c\xd6\xb7\xaf\x4f\x3f\xc1\xfd\xf3\x34\x57\x70\x61\xb3\x55\x0d\x24\x70\x9c\x6c\x30\x26\x4c\x4c\x6e\xcf\xa3\x17\x49\x56\x35\xb5\x3b\xea\xbb\xa6\x56\x45\x2c\x68\x54\xa6\x31\x94\x10\xd8\xe8\x16\x26\x2f\x76\x22\x53\x4b\x52\x9a\x05\x0e\x7a\x9a\x44\xfe\x4c\xf8\x3f\x89\x2d\x0c\xc6\x9d\x3b\x23\xdd\xe4\x32\x66\x21\x2a\x57\x45\xb8\xa2\x07\xd7\xab\xa4\xe0\xcc\x4d\x0e\xc2\x4e\xf5\xb7\xe2\x00\x71\xfc\x89\xd9\x54\x5e\x65\xa4\x6d\x88\xcf\x22\x82\x6d\x49\x99\x08\x4a\x0a\xc1\x41\xfa\x34\x57\xf9\x48\xf6\xd9\xbf\x9f\xf7\x43\xdf\x81\x1e\x71\x90\xf2\xfe\x64\x16\x47\xb2\x9d\xee\x2e\x38\x14\x23\xb9\xd3\x73\x56\x7b\x2d\xa7\xca\x1e\x97\x4a\xc5\x53\xd8\xfc\x69\xb6\x2c\x32\x30\xd7\xed\xb4\xc5\x1c\x4a\x7f\x66\x8f\xb4\x9c\xda\x45\x85\x9b\x46\x2a\xc9\x85\x89\x99\x3b\xae\xe3\x39\x1f\xb9\xf9\x6a\x87\xed\x32\x3f\x24\x2a\x4c\x3d\xc2\xcf\x6c\xc2\x88\xb4\x8b\x64\x79\x57\xa8\xdd\xf5\x73\x7a\xe5\x70\x77\x3f\x0e\x75\x95\xa5\x32\xaa\xd2\xec\x71\xde\x86\x68\x33\x59\x35\x7d\x3d\x07\x4d\x07\x39\xbf\xb2\x03\x10\x11\xda\x21\xfd\x3f\x91\x61\x22\xa4\xb5\x15\x1e\x69\xee\x91\x56\x57\x15\xa8\x16\x2d\x34\x69\x21\x55\xa5\x41\x25\xb2\xae\xd8\x33\xf3\x66\x2e\xa9\xda\x4a\x5d\x0f\xb6\x73\x06\x83\x59\x3c\x09\xc3\xbf\x07\xf3\x7c\x2a\x6a\x97\x57\x85\xe4\xfc\xc8\x06\x17\x5a\x8c\xf4\x34\x7f\x36\xc1\x26\xb1\x6d\xae\x97\xc1\x50\x2a\x2b\xfd\xbd\xe1\x06\x81\x59\x33\xf3\xc5\xc9\x18\x75\xb1\x1e\xb4\xcb\xd9\x23\x2c\x32\x74\xa8\x04\xe2\x15\xd6\x48\x22\x9d\xe8\x4f\x4d\xf3\x73\x6a\x8b\x5b\x0c\xdc\x26\xb3\xf6\x26\x62\x99\x33\xcc\x36\x32\xac\x88\x9a\xe8\x8e\xe0\xc4\xbb\x55\xdb\x76\x70\xb6\x3d\x80\x21\xda\x1a\x9a\x9a\x11\xea\xb4\xe7\x15\x7b\x13\x46\x9b\x71\xdd\xc0\xed\xf4\x23\xa4\xdb\x71\x5c\x19\x0e\x1d\xcb\x25\x4c\xc6\x5b\x6f\xe7\x00\xc2\xc1\x08\xa9\xcd\xe4\x7c\x6e\x25\xcb\x98\x2d\x54\x46\x36\xc3\x5a\x2c\x39\x57\x44\x89\xc2\x66\x0e\x08\xb3\x8b\x03\x88\xcd\x40\xe0\x48\xc3\xea\x14\x39\xe6\xe5\xf9\x00\x79\x4b\x29\x53\x7c\x72\xa1\xb2\x7a\xd0\xf1\x62\xe7\xee\x43\x99\x30\x1a\xad\xe4\xba\xb6\x49\x27\x2d\x60\x9a\x1c\x5a\x84\x48\x03\xdf\x81\xf5\xad\x9b\xde\x20\x72\xb8\x98\x46\x26\x42\xda\x7f\x24\x4b\x09\xc1\xda\x83\x53\x2b\x60\xed\x50\xb4\x15\x01\xd8\xd5\x68\xeb\x9f\x36\xc2\xb1\x88\x52\x4b\x1e\x76\x3a\x82\x72\x22\xce\x65\xca\xc9\xa2\x39\xc5\xbe\x59\x0c\x05\x35\xbf\x6f\x77\x9c\x14\xbc\x0f\x7c\x5c\x5a\x28\x4d\x52\xd9\x07\x31\x3e\x6d\x95\x64\x7d\x0d\xa4\x80\x68\xf5\x35\xea\xdb\x0d\x04\x83\xef\xaa\xee\x06\x91\x4e\x00\xd4\x54\x9f\x2d\x06\x2e\x03\x03\x57\x53\x59\x3c\xe2\x45\xbb\x4c\x76\x41\x6c\x20\xb8\x26\x63\xe5\x80\xba\x83\xa0\xd9\x2d\x12\x3f\xcc\x6c\x79\xfc\xe7\x01\x3b\x08\x33\x1f\x83\x41\x34\xd6\x9f\xc6\xd4\x39\x8d\x5b\xd7\xcc\xed\xd0\xe6\x6f\x0a\xaf\x6e\x69\xc2\x00\xd9\xd0\x0f\xaf\x32\xae\x95\x07\x25\xd3\x06\x39\x4a\x94\x2c\x6f\x44\x83\x8d\x08\x5c\x70\x46\x4b\xf5\xed\x36\xaa\x67\x9c\xd9\xe5\xa6\x27\xb1\x66\xa4\xb3\x7d\xc8\xe9\xd2\x48\x5f\xd5\xd1\xb8\x2f\x44\x48\x11\xdb\x59\xa1\xcd\xdf\xbe\xde\xbd\x7d\x71\xf6\xb8\x1d\x0f\xfb\xdd\xd2\x9e\x3e\x95\xa7\xf2\xb8\xe4\xe8\x89\x34\xb8\x4b\x5e\xef\x1f\x6b\x12\x60\x13\x09\xde\xab\x5e\x79\x98\x84\xc8\xe8\x96\x3a\x63\xec\xe2\x5e\xb0\x5b\x91\x13\x64\x7b\xea\x18\x0c\xa8\x14\xe3\xae\x65\x9f\x8d\x00\x86\xc3\xb0\xdf\x6d\xa1\x1f\xa2\x5d\xab\x6b\xff\x2d\xb9\xe9\x93\xa8\x8e\x31\xa5\xdd\x5d\x6e\x3a\x48\x85\x81\x14\x9d\x00\xa3\x4a\x11\xa8\xeb\xe3\x5e\x08\x62\x79\x82\xeb\x34\x95\xa6\xe9\x4c\xb8\x0a\x7e\xac\x14\x11\xfb\x86\xb9\x6d\x11\xd3\x01\xb0\x97\x50\x7a\xa7\x86\x59\x79\x23\x30\x88\x44\x74\x24\x54\x25\x55\x12\x52\xde\xd4\x1d\x38\xf8\xfd\x12\xcc\x8d\x70\xab\x89\xe5\x2e\x34\xde\xee\x92\xd8\xb7\x86\x92\x22\x95\xd8\xf5\x7e\x50\xe5\x88\xf1\xc8\xe5\xf4\xd5\xa2\x46\x41\xde\xa3\xb1\xd3\x41\x21\x14\x58\x0e\x4f\x7e\x08\x18\xe2\x51\xb1\xc9\x20\xef\x4d\xda\x55\x44\xc1\x63\x9b\xd1\xe2\xd6\xd0\xb4\x01\x33\x56\x8f\x61\x99\x18\xe1\x7d\x52\x8c\x04\x81\x5e\x6d\xa2\x36\x77\x74\x3f\xd4\xbb\x9d\x17\xa1\xcf\x89\x40\x17\xf0\xc9\xc6\xa7\xa3\xf8\x31\xca\x5a\x66\x01\x45\x0a\x5f\xe6\xcf\x01\xd1\xe8\xf1\xe4\x5a\x61\x13\x9c\xbb\x94\x5b\x9c\xff\x81\xfc\xbc\xb4\xd6\x0b\xb0\x62\x9c\xc5\x7c\x7d\x1a\xee\x8d\x47\xc6\x0c\x3d\xad\x20\x04\x2e\x24\x43\x20\x24\xe6\x42\xd0\xdd\x20\x65\xec\xd0\xc9\x1c\xea\x00\xcd\x9e\x53\xec\x4e\xad\xd1\xb8\x2c\x1e\x30\x27\x63\x4f\x9f\x5d\xb2\x72\xb9\x6c\x74\xea\xe6\xf5\xf6\x5d\x2b\x3c\x61\x45\xee\x4e\xcc\x55\x0b\xc4\x66\xb3\x6d\xf1\x2a\x75\x51\xce\xe9\x82\x24\x25\xe5\x1f\x9d\x05\x7b\x45\xf6\x47\x6c\x36\xb8\x8c\xbf\x77\xf8\x77\xb8\x09\xde\x04\x9f\x8b\x97\xb0\xea\x3e\xe9\x32\x10\x21\x59\x15\x19\xa4\x7d\x74\xeb\x12\x23\xad\xd5\x90\x0b\xc9\x7e\x76\x28\xce\x88\x2a\x6b\xb0\x42\x4f\xfc\x53\x6e\x67\xc8\x43\x00\xf7\xb7\x84\x1b\x34\x16\x24\x6e\x50\xcf\xa2\x49\x9c\xa9\x1d\x20\x20\x0e\x4e\x71\x1a\xfe\xf9\x2a\x95\x5c\xc1\xde\xd8\x67\xbb\x16\x79\x1a\x2f\xf5\x0f\xdf\x4a\x75\xd3\x74\x94\x4d\xd2\x3f\xf4\xdf\x16\xf3\xba\x0b\x3f\xed\xe7\x62\x44\xcc\x3b\x34\xc9\x5e\x9a\xa9\x7b\xea\x0d\x0a\x7f\x62\x5b\x59\x9d\x9c\xcc\xde\xf7\xbc\xe6\xa2\x9d\xe1\x66\xf4\x44\x43\xd4\x64\x0a\x55\x62\x28\x4a\x95\xca\x9f\xf8\xd8\x21\x20\x40\x36\x8e\x47\x39\xcf\xb0\x03\xb3\x79\x16\x59\x90\x93\x09\x87\x36\x2a\x81\x68\x5d\xcb\x6a\x16\x7c\x7a\xd2\xd8\x26\xbb\x5f\x85\x87\x37\x02\x99\x9e\x42\x8a\xe2\x6a\x47\xb3\x43\x55\x31\x16\x9d\x80\xd9\xf1\xe1\xeb\xed\x52\xed\xab\x5d\x6b\x17\x33\x45\x52\x19\x5d\x07\xbd\x60\x9b\x97\xb3\x57\xcc\x9b\x9b\xb5\xd3\x26\xe1\x3c\x22\xbd\xb8\x60\x35\x64\x29\xc2\x6b\x1e\xdb\x23\xe2\x29\xe9\x1a\xa4\xb5\x63\x49\x5e\x92\xad\x9e\x65\x55\xaa\x7f\x61\xc7\xc6\xd6\x9d\x73\x5d\x1a\xc2\x19\x19\xfb\x9c\x26\xc4\x95\x9c\xad\x18\xdb\x6c\xba\xae\x28\xa8\x7b\xb3\xec\x03\xbb\x7b\xf3\xfa\xe6\xf2\xf6\xfb\xfb\x57\xff\x7d\x72\xda\x41\x26\x32\x01\x87\x95\x3f\x6f\x79\x8c\xa7\xcd\xde\xa0\x7d\xf8\x88\x4e\x42\xcd\xfb\xeb\x74\x63\x1c\xad\x3a\xe9\x18\xd6\xed\x12\x72\xd0\x73\x30\x67\x92\x7b\x52\x3f\x32\x3c\x29\xeb\x69\x33\x46\xa3\x22\xf4\xe1\x2c\xa7\xc3\xd0\x7f\x36\x31\x04\x68\x27\x10\x81\x2e\x30\xac\x61\x08\xeb\x74\x97\xd2\xbd\xf6\xe7\xe7\x69\x67\xea\xca\xe2\x67\xcb\xdf\x13\x28\xf5\x59\x17\x1c\x4f\x9b\xbb\xba\xb5\x5c\x77\xd2\x9d\x01\xe2\x1e\x71\x66\x0b\xfa\xb4\xbc\x42\x27\xb3\x4b\x75\x41\x14\xc8\x5e\x26\xf3\x98\x2c\x37\x09\xde\xa2\x07\xdf\x1f\x73\xa5\x5d\x54\xe0\xa7\x8c\x14\x14\x91\x77\xf8\xfa\x61\x24\x1a\x23\x9e\xc5\x71\xcd\x6d\x85\x23\xa6\x08\x32\x3b\x72\x09\x52\x54\xa8\x5d\x91\x0a\xc9\xf9\x54\x3b\x9b\x93\xdf\x01\x0c\xb9\x58\xee\xef\x0d\x37\x48\xb8\x57\x19\x0e\x7b\x84\xe1\xba\xbf\x1c\x76\xa8\xc5\xa0\x01\xa3\xb9\x5b\xe9\x36\x87\xe8\x64\x71\xdc\xd7\xa5\xca\x13\xb9\x1c\xa6\x73\x9a\xa9\xb9\x47\x4e\x49\x6f\x79\xaa\xbd\x9a\x9d\xb0\x15\xef\xdb\xad\x9e\x98\xd3\x20\xdf\x27\x5e\x06\xed\x04\xe9\xff\x60\xc6\x2d\xe4\xfc\xe4\x54\x8f\xf5\xd3\x58\x55\xfb\xf7\xa3\x2a\xc5\x88\xd9\xc8\x94\x07\x18\x01\xa2\x07\xf6\xb8\x78\x7d\x2e\x02\x3f\x81\xcc\x13\x82\xa5\x09\x31\x81\xff\x47\xef\xb1\xab\xa1\xc8\xba\x09\x4e\x82\xe1\xdf\x5b\x3b\x3d\xd9\x0e\xe3\x1e\x6c\x90\xc5\x2e\x44\x92\x9b\x21\xd5\xcc\x96\x72\x5f\xf4\x31\x5b\xd1\x32\xf1\x3e\xde\xe4\x18\x23\xe4\x17\xf5\xaf\xaf\x8f\xcb\xa9\x73\xb8\xc2\x65\x12\x7b\xa7\xe6\x45\xf8\xb7\xda\xaf\x63\xe2\xf9\x15\xc2\x5f\xc5\xc2\x1a\xc2\xcd\xc3\x76\xe5\xc0\x84\x58\x06\xc8\x9b\xeb\x1b\x19\x1e\xd9\xbb\x1a\xd8\xaa\xad\xd4\xbd\x9e\xa5\xae\x97\x87\xdb\xf1\xe6\xfe\x21\x84\xb5\xbe\x75\xa4\xd0\xff\x45\x19\x1b\xb8\xc4\x52\xa7\x60\x2e\x2a\x86\xf0\x5f\x9c\xd7\x61\xc8\x8c\x92\xd0\x3a\x20\xb0\x53\xd9\x96\x44\xc7\x45\x2b\x3c\x08\x45\xa4\x82\x90\x2a\x21\x97\x46\x39\xa8\x40\x26\x7e\x92\x66\x7c\x1c\x04\x66\x82\x5a\xdc\x8e\x1c\xe9\x12\x32\x5d\x72\x61\x0b\x04\x42\x42\x66\x85\xb0\x9f\x2e\xe2\xd9\x5a\x6a\xd1\x31\xaa\x29\xd1\xe7\x29\xa5\x0c\x81\x14\x57\xa1\x90\xc0\x42\x54\x84\xdc\x2a\xcd\x9b\x8c\xa5\xfc\x48\x66\xd2\x96\xde\x98\x17\xf6\x33\x89\xea\x59\x36\x49\x20\x70\x4e\x05\x17\x32\x19\xb7\x5a\x5d\x19\x41\x3a\xc2\x2a\xff\xc8\x83\x69\x21\x0f\x59\x59\xa9\xba\x46\xce\xd8\xaa\x10\xe0\xdd\x53\x20\x4f\xbd\x5c\x8f\x22\xda\x7c\x13\x52\x4d\xe1\x1a\x28\x07\xbd\x36\xbf\x59\x77\xb2\xf8\x35\xb8\x6d\xc4\x93\xaa\x29\x6a\x9b\xa6\x08\x75\x6b\xbb\xd6\xa5\x26\x85\x4a\x29\xa2\xe2\x3f\x1f\x81\x93\xc5\xcf\x7d\x77\x4a\x65\x43\x4c\x13\x4e\x07\x85\xe0\x1a\xd3\x91\xfe\x2f\x60\xb3\x0d\x97\x6c\xfa\x24\x9f\x9c\x71\x65\xb5\x14\x7c\x26\x07\x3e\xe2\x33\x2b\xdd\x05\xdb\xbf\xb6\x41\x53\xb4\x0e\xe3\x1d\x79\xe3\xff\xaf\x07\x77\x6f\xdf\x64\xa7\xeb\xd3\x5b\x4b\xc1\x86\x51\xf7\x42\xbe\x84\x43\x80\x39\x2c\x6a\xa0\x3e\x13\x27\x0d\x0d\x38\x40\xaa\xda\x44\x1c\x55\xe9\x76\x6e\x82\xc6\x8b\x93\x35\x6b\x74\x9f\x1e\x1a\x08\x45\x61\xd2\x61\x5c\x51\xca\xba\x3d\x68\x62\xad\x89\xdb\xb4\xdc\xa6\xc7\xba\x92\x71\x73\x40\xdc\x25\xd7\xba\x7e\x7e\x58\x88\x04\x59\x8f\xe9\xec\x0f\xe6\x10\x8e\x8e\x02\xd3\x68\x28\x50\x0d\x46\xd8\xa5\x3f\x28\x2a\x07\xf0\xe9\x0f\x6a\xa3\xc7\x3a\x3a\xcf\x11\x60\x16\x09\xf4\xbe\x8b\x1c\x5f\xcf\xb5\x14\x86\x75\xdd\x8f\xfd\x60\x7e\x03\x12\x7d\xa5\xf0\x2c\xc7\x7a\xd8\xc7\x4e\x91\x9a\x4d\xeb\x2a\x32\xba\xe4\x20\x5f\xe2\x2f\xc0\xa9\x77\x56\xe2\x1e\x20\xe8\x17\xa3\x86\x0d\xf2\x69\x52\xcc\xff\x61\xce\xbf\x0e\xca\xbd\x63\x45\xbb\x59\x9d\x23\x0c\x65\x1d\x0b\xf2\x14\xb7\xd1\xb1\xd6\xfd\x70\xa1\xd1\xa6\x34\xe1\x37\x98\x61\x2c\x72\x6c\x00\xf5\x9d\x28\x24\x39\xf6\x77\xee\x5d\xf0\x98\x22\x58\x53\x13\x25\x87\x46\x34\x9d\x1c\x26\x53\x42\xf7\x81\x6d\xc2\x12\xd0\x73\x83\x88\x94\x75\xb3\x64\xba\x1d\x65\x51\x74\xa1\x16\x9f\x07\x6b\x8e\xea\x09\x75\x39\xec\x56\xdf\x21\x99\x83\xae\xeb\x09\x28\xfe\x29\x40\x03\x73\x71\x9a\xb1\x00\x1a\x4a\x3a\xb0\x91\xa5\x3f\xa2\xb7\x86\x6f\xbe\x28\x9f\x4d\x83\x97\x71\xc0\x03\x3c\x1e\xaf\x93\xa8\xdd\xa6\x67\x4d\xd9\xd9\xd1\x9a\x98\x54\xb3\xc9\xbd\x33\x49\x58\x13\x2a\xb5\x7d\xc5\xd6\x17\xb8\xd4\x32\x9f\x45\xc7\x68\x5a\x38\x2d\x36\x4b\x47\xa0\x63\x2b\x12\x29\xc6\xb4\xd3\xf1\x83\x01\x8b\x2b\xcd\x19\x74\xee\x69\xc6\x5a\x87\x0a\x59\x0c\x33\xa7\x49\x8b\x32\x66\x45\x4f\x27\x72\x68\x64\xde\x2d\xd7\x8f\x5d\x11\x23\x4e\x9d\x35\x35\xc5\xb7\xa4\x24\x12\x23\x8a\x6a\xf0\x02\xbc\x49\xe2\x2f\x28\x4d\xa5\xa0\x1e\x84\x14\x09\x1f\x3f\x8a\xcb\x07\x40\x58\xbb\x12\x07\x48\x35\x3c\xa7\x16\x98\x18\x21\x7c\xc4\x03\xc0\x42\x19\x1e\x91\x5d\xe7\x3c\x66\xe6\x1d\xb1\xc1\x1e\x7b\x6c\x82\x1e\x85\x09\x4f\x30\xbc\x3b\xc6\x31\x38\x04\x47\xe2\x3c\xec\x86\xb3\xb0\x3b\x4e\xc7\x27\xf4\xcf\xbf\xb6\x7a\xc2\x5a\x9c\x4a\xc4\xc6\x09\x03\x5f\x66\x71\x00\x02\x08\x7c\xf4\xf0\xfa\xed\x86\x46\xfe\xfb\x88\xb1\x72\x00\x5e\xfd\xb7\x58\x03\x00\x78\xed\x25\xff\xe6\xff\x99\xa3\x69\x5f\xbd\x02\x18\x80\x00\x20\x40\xed\x74\xa4\x81\xfc\xf6\x7f\x68\x47\xda\x07\xf2\x30\xbf\xe6\xe4\x0b\x2a\x88\xee\x47\xba\x76\xca\x1f\xd0\xb4\x07\x0b\xc7\x1e\x4b\x21\x5c\x42\xe1\x18\xab\x2a\x7b\x30\x71\xc2\x8d\xc1\x0f\x71\xfb\x3c\x54\x53\x3d\xdc\x85\xd3\xa6\x4e\xd5\x4a\x2c\x94\xa9\xdc\xff\x20\xf5\x01\x52\x64\xd2\xa7\x88\xe7\xe2\x13\x49\x1d\x9b\x31\x95\x3b\xc8\xb8\x6b\x8d\xe4\xa7\xa0\x40\x99\x9c\x76\x5b\x2f\xab\xce\x41\x89\x26\x97\x3a\x56\x8c\x81\x2c\xb9\x61\xfc\x61\x8f\xb5\x68\x97\x47\xf5\x5c\x22\xb1\x5c\x01\xb0\x37\xfe\xff\x0a\x50\x86\x58\x71\x67\xb4\xd9\x6d\x6d\x9f\x2b\x06\x34\xb0\x23\x14\xe2\x18\x73\xad\x8f\xeb\x44\x42\x43\xbe\x21\x07\x14\x39\x50\x4b\x47\x4a\x39\xd6\x5d\x72\xea\x00\xf7\x5a\x12\x26\x62\xa4\x18\x63\xa4\x9a\xa2\x1e\x24\xdf\x24\x79\x4a\x55\xf3\x54\x3c\x61\xcd\x91\x34\xc4\x0d\xc9\x26\x18\x14\x7b\xcc\x25\x12\x7e\x52\x06\x6c\x6a\x3a\x2e\xf2\x5a\xdf\x23\x9d\xba\x35\x68\x94\xa7\x47\x97\xf9\xfa\xd6\x75\xbe\x84\x3d\x91\x6c\xc2\x94\x4e\x14\x6a\xfb\xb2\x7d\xb7\x49\x54\xf6\xc3\xc5\x24\xc7\xa8\x61\x7a\x6e\xcb\xa8\x83\xab\x43\xd1\xe6\x3a\x01\x61\xa6\x90\x6e\xcd\xa2\x99\xaa\xc4\x78\x0a\x3a\xe6\x32\x8f\x82\x8b\xd7\xc1\xb2\x69\x0c\x00\x25\xe2\x9d\x9e\x9c\x06\x46\x02\x38\x9c\x58\x24\xc9\xe1\x3e\x13\x8a\x01\x0b\xaf\x24\x36\x78\xad\xcc\xc9\x3d\x46\xfe\x98\xe5\xab\x4d\xcf\xc8\x35\x6e\xab\x19\xad\xc9\x13\x2d\x86\x45\x22\x34\x21\xbc\x89\x41\xd6\xd4\x69\xaf\x16\x4c\x64\x62\xc5\x27\xf6\xd9\x84\x20\xf6\x44\xa7\xb0\xcf\x8c\xc6\xe9\xab\x4a\x92\xb7\x9a\xc5\xc8\x62\x44\x9a\x28\x0e\x04\x20\x3e\x16\x21\x03\x9e\x9a\x26\xb1\x24\x41\x57\xf9\x11\x27\x08\x60\x4b\x56\x50\xb3\xbf\x8a\x61\x29\x80\x45\x74\x40\x07\x41\x1e\x4a\x32\xe6\xfa\x3c\xe8\x12\x49\x81\x74\xc5\xb6\x78\x6b\x8e\x05\x56\xb9\x6c\xae\x8d\x4e\x64\x46\x6e\x71\x65\xbb\x97\x9a\x2c\x0d\x19\xae\x3b\x96\x55\x52\xeb\x0d\x3d\x37\xc9\x48\x3c\xcc\x6d\x64\xea\x6e\x89\xb3\xe7\x33\xcb\xcc\x59\x71\x2d\x5c\x91\x14\xd3\xb3\x87\xb2\xfc\xe3\x96\x7c\xff\xf3\xc6\x24\x1b\x9c\x96\x28\xc1\x53\xe6\xab\x15\xf5\xdb\x7a\xe4\x84\x2c\xe7\xcb\x26\x4b\xd3\x9a\xbb\xe9\xc5\x5d\x1c\xf9\x3f\xdd\x2c\xb7\xd0\xe2\xcc\xf5\xbb\x34\x15\x07\xe7\xfa\x4b\x20\x50\x73\x98\x4e\xc6\x48\x32\x88\x50\x3d\x24\x01\x4e\xb9\xc1\xc1\x40\x80\xe9\xb1\xfc\xfd\x8a\xa5\xa8\x17\x46\x3f\xb8\x10\xe9\x8b\x8c\x3e\x7e\xa1\x17\xe3\x37\xb8\x1b\xed\xf7\xa1\x62\xae\xe9\xcf\x61\x85\x19\x04\x76\xd0\x10\x8e\xfb\x23\x30\xb8\x49\x23\x51\xec\x8f\x62\x7b\x76\x9d\x1f\x8d\x4f\x32\x1d\x3e\xd2\x28\x00\xd8\x39\xc7\xe0\x23\xba\x81\x7d\x42\xad\xc1\x67\x18\xc7\xcc\xe7\x84\x8d\x93\x2f\xc1\x67\x52\x8d\x94\x51\x69\xe4\xc0\xb4\x50\x4c\x5f\x61\xdf\x85\xe1\x4b\x21\x25\xa6\x9c\x56\x25\xb0\xa4\x57\xd4\x44\x5f\x08\xfb\xf7\x21\xb8\xaf\x0a\x7c\x5f\xef\xb0\xa6\xed\xcf\x38\xed\xab\x72\x18\x23\x84\x43\x17\xe1\xd8\xef\xb9\x3a\xc2\x92\xbf\xfd\x36\xb0\x6b\x8a\x69\x80\xe2\x5b\xc2\x17\x2f\x3d\x17\x01\x11\xb2\x3c\x9d\xaa\x3f\xf5\x1b\x4b\xe8\x59\x40\x39\xd7\xcf\xc7\xcf\x41\x9d\xf1\xaf\xd6\x9f\x88\xb0\xcf\x29\x96\xd7\xac\x5c\xa9\x98\x93\x7b\xe1\x12\x3a\x56\x57\x52\x28\xdd\x86\x4e\x51\x31\x24\xab\x58\x1e\xef\x0a\xb8\x78\x17\xda\xb6\x1e\x9c\x6f\x76\x97\x33\x14\x7f\xf8\xd8\x28\xf4\x51\x5d\xae\xed\xba\xb9\x1c\x2c\xe4\x14\x65\xab\x83\xd6\x20\x14\xb8\x8b\x14\xe7\x03\xd1\xab\x19\x51\x60\x5f\xf7\x86\xe2\xe5\x3d\x5e\xb1\x24\x95\x97\xfd\xd5\x2b\x2a\x97\x67\x26\x20\x98\x2c\x11\x70\xeb\x7a\x3d\xf7\x08\x54\x12\xe2\xd7\x66\x38\x74\x6e\xa0\x30\x95\x50\x62\x70\x32\x85\x9a\x1b\x5d\x23\x9c\x3e\xa5\x44\xbc\x1b\x8b\x29\x18\x40\x85\x29\x09\x33\x53\xfc\x7c\x63\xe0\xd6\xa5\xeb\xf5\xdc\x1b\x55\xb3\xf0\x5a\x12\xde\xaa\x4a\x2e\xa6\x81\x08\x36\x06\x59\x0d\x77\x62\x85\xa8\x00\x66\x43\x61\x60\x4a\xe1\xf7\x7a\x2c\x80\xe2\xc6\x04\xce\x71\x0e\xa8\x3d\x33\x9c\x9e\x03\x29\xd0\xbd\x35\xd1\x92\xbe\xba\x5a\xe5\xe4\xa5\x97\xa4\xd8\x5e\xba\xf9\x60\x6c\x60\x09\xe6\xa2\xae\x66\x2f\xfb\x3c\x42\x4d\x55\x7d\xfe\xa9\x10\x4e\x36\xc9\xea\x8c\x4d\x99\xe6\x0e\x45\x26\x4a\xca\x50\x34\x72\xb7\xba\x91\x09\x67\x63\xcc\x26\x8e\x64\x50\xc0\xd1\x20\x99\xf2\x64\xe8\x9e\x31\x9e\xac\x97\x6a\x3d\xad\xa6\xf3\x3e\xcf\x8e\x6e\xd4\x16\x0e\x66\x7f\x96\xb1\x93\x14\x8d\xd9\x8a\x33\xcc\x7e\xc9\xac\x99\x4c\xca\x83\x5a\x94\x65\x46\x14\xe3\x2b\x47\x5e\x3b\x92\x85\xf4\x2b\x91\x7a\x22\xb2\x97\xf6\x3d\x7a\xb2\xdc\xcd\xff\x0a\x93\x44\xe5\xfa\xda\x8a\xcd\x7c\xcc\x6c\x91\x5b\xc7\x35\xc7\x17\xe8\x1f\x8c\x84\x1f\x00\x70\x42\x98\x50\x97\x08\xab\xa9\x6b\x68\x6a\xe5\x21\x49\x1d\x5d\x3d\x7d\x83\x8c\x2f\x2e\xe7\xdb\x19\xb4\x84\x60\x82\x25\xda\x29\x32\x2c\x02\xa2\xff\x89\x59\x95\x14\x22\x24\x92\xb7\xa4\x4a\x29\xb5\x34\x64\xe5\xa5\x87\x2a\xc3\x45\x8a\x61\xc2\x86\x0b\x1f\xc1\x65\xea\x91\x5c\xa1\x19\x25\x6a\xb4\xe8\x31\x62\xc6\x42\x41\xf5\x57\x5d\xe3\xc4\x8d\x17\x1f\x2d\x41\xc2\x44\x89\xd1\x5d\x65\xe2\x1a\x53\xd7\x99\xf9\x27\xa3\x1b\x2c\x52\xa6\x4a\x9d\x06\x23\x2d\x66\xba\xf4\x58\x19\x32\x66\xca\x9c\x25\x6b\xb6\xec\x9e\x85\x9d\x03\x27\x67\xae\xdc\x79\xf2\x96\x59\x96\x0a\x5c\x37\x9d\x73\xcb\xf9\x72\xf2\x97\x5b\xa0\x3c\x95\xe5\x57\x50\xa1\x3b\x6e\x54\x5c\x49\xa5\x05\x2b\xab\xbc\x8a\x2a\xab\xaa\xba\x9a\x6a\xcd\xeb\x89\x5d\x91\x0b\xd5\x60\x37\x3d\x1f\xc3\x27\xa8\xb1\xa6\x9a\x6b\xa9\xb5\xb6\xda\x09\xeb\xa8\xd3\x0b\x2e\xfe\x6d\x59\x3d\x4d\x6a\x72\xbd\xf5\xd5\xcf\xa8\x81\xe8\xf9\xe6\x97\x7f\x01\x8c\xdd\x15\x68\x58\x90\x07\x0d\x1b\x11\x52\x68\x61\x85\xc7\x28\xa2\xc8\xa2\x8a\x2e\xa6\xd8\xe2\x8a\x2f\xa1\x44\x26\xa4\x2d\x2b\xa9\xe4\x52\x98\x91\x7b\x2f\x55\x6d\x69\xa5\x97\x51\x66\xac\xb2\x58\xb4\xd1\x7b\x39\xe5\x96\x87\xa8\xba\xad\x15\x84\xc4\xae\x30\x8e\xc7\xed\x8c\x1b\xaf\xe2\x4a\x2a\xf5\xa4\xfd\x1e\x75\xa0\x8a\x2a\xab\xe2\xd8\x11\x64\x6b\xab\x71\xa0\x63\x0e\xfa\x12\x40\x43\xc7\xab\xaf\xc1\x21\xef\x34\x86\xf2\xa8\x89\x59\x63\x67\x7b\xd5\x6b\xcd\x7d\x5b\x4b\xad\xb5\xd5\x5e\x87\x96\xae\xd4\xa5\xa9\x6b\xf5\xd4\x5b\x5f\xfd\x0d\x34\xd8\x50\xc3\x8d\xb0\xb0\xfa\xbb\x82\xc6\x1a\x6f\xa2\x49\xb6\x1e\x34\xdd\x4c\xb3\xec\xbe\x4f\x6b\x4f\xb4\xf7\x54\x07\x6c\x81\x76\x6d\xf1\xcf\x13\x2d\x71\xf4\xae\x15\xce\x3e\xb4\xc6\xd5\x4f\x6d\xb4\xa9\x4e\xbd\x06\x8d\xf9\x7f\xdc\x75\x3e\xbf\x3b\x8d\xaa\xe2\x93\xe2\x9c\xb7\xae\xf9\x9f\xdb\x7c\x81\x19\xd1\x26\x31\xd6\x2d\x8b\x4d\x21\x68\xec\x2f\xac\x75\x30\x4d\x06\x97\x04\xd1\x9e\x56\xf5\x53\x32\x1f\x3e\x8c\x56\xdc\x18\x9f\x80\x32\xa0\xcb\x86\x81\xed\x25\x5c\xff\x65\x82\x80\x88\x84\xdc\x70\x8c\x82\x8a\x86\x8e\x81\x89\x95\xbf\x5e\xec\xfa\xe9\x34\x40\xb7\x3e\x3c\x7c\x02\x42\x22\x62\x12\x52\x32\x72\x0a\x4a\x2a\x6a\x9a\x72\x0b\xd0\xd2\xd1\x33\x94\x57\x7e\x1f\x18\x3b\xea\x10\x32\x87\xff\x4b\x6b\xaf\x80\xa3\xd7\x9c\x5c\xdc\x3c\x9d\xee\x74\x3b\xdb\xc5\x41\x66\x30\x89\xe0\x92\x20\x20\x25\x4b\x91\x2a\x2d\x59\x7c\xb2\xe5\xf0\xcb\x15\x90\x27\x5f\x81\x42\x45\x8a\x95\x28\x15\x54\xa6\x5c\x85\x4a\x55\xaa\xd5\xa8\x55\xa7\xde\xaf\xa3\xc1\x64\xb1\x39\x5c\x1e\x5f\x20\x14\x89\x25\x52\x99\xbc\xba\x1a\xfb\xda\x37\x2b\x94\x2a\x00\x10\x04\x86\xa4\x62\xe5\x5c\x81\xf9\x8b\xc3\x8e\xcb\x82\x26\xb5\x0b\xd6\xfa\x24\xd0\xe4\x25\xd0\x7a\xc3\x11\xc8\x46\x77\x33\x4b\xea\xb6\xd8\xaa\xb4\x2b\x28\x74\xda\x84\x63\x3b\xdd\x99\xee\xf5\x47\xf7\x2b\xeb\x7a\x37\xba\x59\x96\x08\x3c\x81\x48\x22\x53\x5a\x47\x2d\x33\x59\x52\xe8\xa5\xd7\x62\x1f\x93\xc5\xe6\x70\x79\x7c\x81\xb0\xf5\xa2\xf6\x8a\x25\x52\x99\x3c\xb5\x8d\x36\xab\x2a\xd0\x68\x41\x32\x2c\x6a\x77\xbb\x3a\xd9\xb8\xb2\xdb\xa9\xe8\x80\x52\x95\xb9\x7d\x9d\x50\x97\x9a\x5c\x0b\x8e\x46\xab\xd3\x1b\x8c\x26\x0b\x4b\x2b\xb3\xb5\x8d\xad\x9d\xbd\x83\xa3\x93\x26\x02\xaf\xbc\xae\xda\x5d\x8b\x56\x6d\xda\x75\xe0\xb2\x78\x38\x7d\xa9\x80\x08\x6a\x43\x49\x6d\x69\x6b\x6b\x1b\xd3\x62\x74\x8f\x04\x20\x2d\xf4\x6c\xe2\xea\x46\x8c\x1a\x33\x6e\xc2\xa4\x29\xd3\x66\xcc\x9a\x83\x75\x73\x21\xbc\xf1\x16\xbb\x86\xe0\xf6\xaf\x4e\xbd\x06\x8d\x9a\x34\x6b\x61\xd3\xca\xae\x0d\xca\xc5\x62\xa2\x80\xb9\xb8\x61\x12\x26\x65\x32\x26\x07\xb5\xf1\xe8\xc1\xcc\xee\x7d\xf3\xcd\x34\x16\x23\x4b\x76\xcd\x36\xd1\x5c\xd3\x8d\xcb\x24\x4e\x00\xbd\x68\x45\xba\x51\xad\x52\xa5\xd6\xd0\x80\x61\x39\xad\x6e\xd0\xc4\xbb\xed\x16\x88\x90\x70\xc4\xaf\xb2\x8b\x45\xa9\xaf\x9e\xc8\x5b\xae\xd9\x72\xd0\x30\x8c\x35\xd6\x89\x70\xf0\x4a\xc1\x53\x8d\x11\xe7\x5b\xa4\xc6\xba\x04\x02\x69\x5b\x13\xbf\x20\x3a\x92\xb1\xc1\x93\xfa\x48\x60\x09\x26\xbf\x0b\xd3\xc8\xbb\x09\x89\xfa\xcf\x18\x57\x35\xf4\x3f\xad\x10\x4b\xbf\x38\xaa\x4c\x8e\x09\xcb\xf0\xe0\x01\xd2\xda\x4c\xba\x2d\x96\x44\x06\x3c\x2a\xea\x48\xf2\x05\x09\x9b\x32\x94\x50\x90\xfe\x2c\x0d\x93\x84\xe1\x44\x68\x18\x6a\xd5\xf0\x7e\xbb\x24\xca\xf2\xe9\xd4\x8b\x5a\xd6\x9d\xd8\x91\xb0\x22\xcd\x5f\x2d\xda\x6a\x31\xb1\xca\xeb\x96\xa3\xd7\x54\x01\xa9\xda\x0c\x9d\x52\x16\x1d\x9c\x53\xad\xe6\x23\xf1\x6d\xc7\x54\x79\x2f\x18\xbf\xd2\x84\xef\x67\x46\xfa\x35\x9d\xca\x2f\xe7\x0d\x32\x96\x7a\x73\x34\x56\xb5\x1c\x24\x50\x6f\x5b\x72\xd5\x8c\xb9\xbd\x8c\x79\x7f\x47\x99\xb1\x6c\x18\x3f\x65\xcd\x89\xe6\xc4\xec\xbb\x19\xf2\xf1\x70\xa0\xfb\x26\x43\xd4\x89\xe1\x33\x55\xdb\x03\x14\xa5\xaa\x20\xa6\xab\x31\xef\xeb\xf0\xa4\x31\xe4\xb3\x9a\x7c\x34\xef\x37\x6b\x93\x6f\xed\x33\x21\xf2\xe9\x68\x00\x3b\x4a\xc3\xd4\x04\x1e\x06\x96\x3a\x8e\xf6\x06\xcd\x70\xdf\x96\xfd\x4c\xf4\x75\x02\x59\x21\x7b\x91\xc1\xd7\x6f\x7b\x15\x44\xa4\xc9\xba\x5d\xed\x9e\x99\xb8\xb3\x39\xee\xa7\xb6\xc1\xd3\x58\x7c\x92\xb8\xc1\x0e\x80\xa0\x2c\x4b\xb8\xa5\xd1\x83\x7e\x62\x03\x77\x78\x28\x0e\x69\x6d\x85\x16\x9b\xab\x5b\x7f\x40\xc9\x23\xa6\x14\x2b\xb9\xcd\x81\x30\x4f\xed\xf3\xf0\xe8\x82\xf2\x7b\xb0\x26\x47\xed\x5a\xde\x99\xb1\xb2\x6c\x54\x14\xb0\x89\x5e\x9a\xf3\x1f\x8a\xfd\x55\x21\xb8\x47\x95\x57\x59\xb6\x7a\xae\x5a\xed\xfa\x3a\xe4\xe8\x82\xea\xf1\x37\x43\x6d\x7b\xd1\x88\xf3\x5d\xf5\xb0\xae\x52\xc2\xd6\xe6\x5b\x1e\x37\xd1\x6f\x56\x72\xa9\x46\x77\x90\x1d\xd5\x5d\xef\x28\xc7\xee\x3e\xac\x5e\x37\x32\x54\x3d\x82\xfd\x86\x3c\xa0\xee\x8f\xa1\x53\xdd\xfd\x3f\x0f\x75\x56\xf2\x16\xbe\x4b\x70\x23\xef\xf1\x1c\x69\x0a\xcd\xc4\x7a\xbc\x2d\x68\x6e\xf6\xb3\xea\x1c\x39\xca\x83\xdc\x83\x56\xde\xb7\x1d\x5b\x67\xea\x3f\x92\x3f\xab\xca\x1f\xc0\x0b\x2a\x29\x2e\x3a\x26\x36\x36\x31\x2e\x3e\x21\x84\x8e\xb9\x2a\x07\x82\x11\x9c\x46\x2e\x6a\x9a\xde\xff\x11\xf3\xaa\x5f\x58\x23\x7a\xd9\xf0\x1a\x3a\x65\x7f\x03\xbf\xa2\xc6\x38\x93\x14\x17\x1d\x13\xbb\xa1\x5e\x38\x3e\x61\xbd\x1f\x1c\x5f\xd1\x83\x7b\x1b\xb4\x67\x31\x71\xd0\xbc\xc2\xae\x24\x98\x51\x33\x66\x56\x34\x6b\xe8\xcb\x6a\x5c\x57\xab\x4e\x41\x90\x8f\xf6\x59\x28\xac\x00\x0d\x9e\xff\x7f\x89\x86\x52\x7d\xad\xe4\xaa\xc2\x12\x15\xd7\xda\xb2\xf0\x87\xd3\xa8\x5f\x47\x06\x46\xff\x92\x2f\x07\xca\xd1\xff\x1f\x80\xf4\x29\x92\x97\x06\x23\x6f\xc8\x0b\x6e\x44\xe2\x24\xb5\x22\xe0\x18\xa7\x8a\x83\xae\xfb\x27\xaf\x4b\x70\xd1\xf1\x31\xb1\x71\x99\x14\x44\x5c\x9b\x42\x97\x10\x93\x98\xb4\x99\x7e\x77\xeb\xf7\xe1\x22\x50\xe3\x3a\xb9\x4c\xf6\xca\x84\xc0\x04\x55\x02\xc8\x54\xf0\x5a\x87\x98\xfd\x53\x9b\x10\x5e\x80\xd1\x5a\xbf\x86\x55\x42\x08\x39\xc9\xaf\x48\xc4\x22\xc4\xd4\x6c\xba\x4e\xfa\x6e\x43\x7c\xe1\x45\xb1\x6b\xdd\xef\x18\x1f\x9d\x90\xb8\x49\x7a\x89\xed\xd9\x77\x95\x36\x0b\x3c\x95\xe3\x58\xbc\x92\x30\x89\x4e\xc6\x27\x66\x52\x79\xc6\xae\xb3\x61\x86\x8b\xac\xf8\x30\xaf\x38\x5f\x90\xcf\x38\x3f\x02\x85\x89\xfd\x4e\x46\xb9\x81\x3e\x78\x65\x10\xb7\x73\x00\x6e\x2c\x2b\x45\x32\xb6\x3f\xb2\x3d\x04\x4a\x08\x46\x68\x28\x86\x13\xbd\x2c\xd4\x32\x92\xf4\x64\xef\x0a\x3e\x5a\x8e\x16\xd9\x3d\xe2\xb3\x74\x71\xe7\xc0\xab\xa5\xef\x84\x72\xd6\x59\x09\xcb\xd5\xb1\x88\x29\xc2\x41\xc1\x94\xc1\x8a\x53\x0a\x29\xde\xb2\x89\x64\x49\x46\x96\x2c\x09\xb9\x44\xc8\xf6\x85\x2c\x59\x72\x7d\x21\x49\x84\x2c\x09\xd9\xbe\xf7\x5b\x09\x9f\x6a\x58\x39\x84\xbd\x4f\x70\x1c\xc7\xf1\x81\xe7\xcd\xc7\xae\xe9\x77\x1e\x0d\x24\xb3\xa9\x2b\x8e\x44\xcd\x78\x9a\xc5\x6e\xbf\x44\x32\x9b\x92\xa3\x94\xd2\x7d\xfa\x64\x11\xff\x60\xc9\x54\xca\x46\xa7\xbb\x4a\x7f\x1c\x05\xcf\x0b\x0e\x63\x70\x7f\xa8\x4b\xc8\xe8\xce\xb0\xe6\x09\x23\xcd\xdc\xb4\xbc\x04\x9d\xa6\xeb\xf5\x2e\xd0\x32\xb7\x58\x7e\xaf\xb0\x25\x90\x4a\xdf\x34\x72\xb3\xb6\x6c\x7e\x7a\x41\xd7\xd8\x06\x92\xa6\xf2\xd2\x3b\x08\xfd\xae\xe0\x41\xff\x83\x11\xf7\x1b\x30\x3e\xfb\x39\x60\xef\xdc\x93\x3f\x04\xbf\xee\x13\xc8\xb2\xc7\x2b\xb4\x4b\x1d\x1b\xbe\x35\xf0\xf8\xc0\xc7\x18\xd2\x29\x34\x55\x0e\xf2\xe6\xae\x65\xc6\xd3\x0d\x97\x99\xd2\x5e\x2d\x3c\x3f\x9b\x7e\x5f\x65\x75\xc2\xc8\x39\x18\xf8\x78\xe2\xf4\x4c\x29\xa0\xed\xfc\xa0\x97\x70\x7d\x59\x7e\x37\x8f\x6e\x38\x7c\xc9\xed\xcc\x35\xe5\xa9\x03\xfb\x5e\x2e\xd3\x2b\x5d\x9e\x7e\x99\x77\xff\xf4\xf1\xef\xd3\x71\xb6\x20\x37\x7d\xc7\xa4\x85\xbb\x69\x76\xc6\x2c\x43\x9c\x7a\xc8\x4d\x99\x66\x58\x2e\x09\xb2\x6c\xb3\x85\x21\x75\xde\xb8\xc1\x27\x3a\x36\xaa\xb9\xef\xab\x45\x65\x7f\x65\x92\xe7\x10\x99\xa8\x65\xbf\xf3\xf9\x59\x88\x79\xf8\x29\x0c\x5f\x98\x71\x80\xc1\xb3\x0b\x59\xf6\xd4\x1d\x4a\x51\x97\x65\xe0\x24\x82\x86\xe2\xde\x54\xab\xe3\xc4\xa1\x9d\x11\x81\xba\x64\xb9\xb1\x3c\x96\xa8\x6f\xde\x3b\xbd\x4d\xe5\x3e\x10\x76\x41\x61\x46\x67\x28\xfa\x63\x21\xa6\x42\x7b\x08\xd7\x67\xf7\xbe\x6f\xe4\x42\x2b\x03\xbb\xff\xfa\xe2\x2a\x67\x31\x32\x3f\x5f\x70\xaf\xb6\xc6\x67\xf7\x70\x6d\xb5\x63\xbf\x3e\xab\xfb\xbd\xc8\xd2\x53\xe5\xf7\xff\xb8\x46\x39\xd1\x21\xb1\xfd\x7e\x3e\x12\xae\x64\xfd\xac\xdd\x2d\x29\x6b\x66\x40\x2f\xaf\xe9\x6d\x0a\x44\x12\x7e\x5d\x37\x43\x8e\xc0\x40\x2f\xbd\x07\x6e\x84\xe1\x84\x59\xb3\x42\x68\xb0\x9b\x8d\xb6\xad\x41\xfd\x4c\xd9\x77\xae\xd6\x4a\x92\x3a\x7c\x45\xed\x46\xcd\xc4\x38\x36\x62\x56\xee\x3b\x57\x0f\x5c\x5e\x3f\x4d\x15\x39\x75\xff\xd0\x80\x6b\x48\xca\xf4\xd9\x91\xad\x75\x9a\xc4\x36\x6b\x76\x74\x46\x16\x17\x57\x5c\x59\xdd\xe3\xb3\x81\x4c\xdc\x5e\x74\xb4\x7d\xb9\x1d\x8b\xa9\xe9\x9d\x54\x74\x5c\xc3\x38\xba\xfe\x78\x18\x90\x0d\x9d\xc3\x0d\x5f\x54\xac\x15\x42\x7a\xf2\xc2\xa6\xbf\x4e\xd3\x28\x46\x13\x29\x72\x94\xa2\x38\x9e\x9b\xb9\xd1\x97\x2b\xed\xf2\x42\x45\xac\x98\x40\x36\x81\x3b\x77\xda\xef\x2a\x8e\x2d\x7c\xf3\x1b\x8f\x5e\xc5\x5f\x26\xa8\xe4\x1d\x78\x84\xdb\x8a\x8a\x9f\x2e\x43\xb6\xe6\x9d\x5b\x7a\x19\xe1\x40\xc4\x64\xd5\xfb\x27\x72\xf5\x41\x70\x50\x58\xde\x7b\x96\xeb\x62\xec\x7e\xd9\xfe\x89\x85\x35\x9f\x18\xfc\x0b\xfc\x13\x3e\x0c\x85\x06\x2a\x1d\x31\x9f\x98\xca\x77\x81\xc1\x5f\x2a\x0c\xda\xe2\xe2\x25\x16\xf4\x5a\x80\xbb\x5c\x93\x74\x47\xf6\x06\x9b\x2e\xf8\xed\x6c\xfc\x66\xe9\x63\xbf\x37\x37\xcf\xe4\x9a\x83\x98\x41\x61\x65\xc4\x5d\xf2\x7f\xfd\x0d\x0f\xbf\x59\xc1\xd4\x6f\xc2\xdd\x33\xc0\xe0\xe7\x00"
@@ -41,3 +42,4 @@ var assets_15 = "\x77\x4f\x46\x46\x00\x01\x00\x00\x00\x00\x48\x88\x00\x12\x00\x0
 var assets_16 = "\x77\x4f\x46\x32\x00\x01\x00\x00\x00\x00\x38\xd8\x00\x12\x00\x00\x00\x00\x7f\xc8\x00\x00\x38\x75\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x4c\x1b\x9a\x4e\x1c\x81\x08\x06\x60\x00\x86\x38\x08\x42\x09\x83\x3c\x11\x0c\x0a\x81\xc4\x58\x81\xad\x35\x12\x81\x68\x01\x36\x02\x24\x03\x86\x76\x0b\x83\x3e\x00\x04\x20\x05\x82\x1a\x07\x20\x0c\x81\x22\x1b\x2c\x71\x55\x07\x7a\x8f\x03\x20\xf2\xdd\xcf\xa3\x11\x11\x63\x1c\x18\x13\xb0\xf3\x91\x81\xda\xc1\x38\x85\x64\xff\x7f\x4c\x7a\xc8\x8c\x1a\xe0\x3d\xa1\xe6\xee\x5a\x78\x56\x62\x04\x23\xa4\xa7\x60\x0b\xaa\x81\x3b\x2e\x01\x5c\xca\x08\x0d\xc5\x2d\xa1\xd0\x61\x91\xab\x1c\xa1\xde\x95\x1e\x90\xe9\xf6\xb4\x97\xf6\x09\xdf\x36\x83\x4d\xe3\xd2\x7a\x49\x85\x0c\xfa\x43\x63\x75\xaa\xce\xc7\x3e\x1a\x82\x21\x04\x4e\xca\x7f\x21\x8e\x74\x92\xa7\xa1\x22\x25\xef\x6d\xce\xc2\x1e\x6a\x05\xed\x4a\xbb\xa5\xf1\xd4\xe4\x45\xa3\x93\x78\xc8\x2e\xbe\xea\xde\x9e\xdd\x8f\x33\xe6\x20\x65\x22\x63\xd1\x29\x1d\x41\x88\x88\x79\x43\xb4\xcd\x7b\x40\x8c\x42\xc1\x68\xd0\xad\x99\x33\x12\xa3\x50\x41\x1b\x0c\x44\xac\x00\x51\x31\x22\x67\xce\x9c\x6e\x66\x6f\x73\x51\xd6\x9c\xb5\x36\x17\xe9\xa6\xbd\x70\x95\x87\x3a\xab\x19\x3b\x0e\x8c\xa4\xf8\x00\xbb\x7b\x40\xe8\xbe\xba\x4e\xb2\x83\xb4\xbb\x07\x1c\x98\x46\xfe\x67\x7b\xb7\x68\x9e\xba\x6f\x01\x6b\x26\x3b\x39\x22\x88\xf8\xfd\xda\xde\xfd\x33\xc7\xd4\x43\xf7\x12\x20\x53\xc3\xbc\xc1\x52\x85\xe7\xe3\xef\xfd\x77\xcd\xcc\x39\xf7\x7d\xfa\xc2\x29\x4d\xe3\xb5\x00\xa5\x8b\xc4\x02\x14\x96\x08\xc4\x02\x52\xfa\xb6\x6a\xb9\x06\xe1\x90\x54\xee\x05\xbd\x85\x06\xbd\x7b\x7c\x0c\x33\x1f\x93\xfa\x7a\x17\x5d\x1e\xa7\xfa\xdc\xa1\xed\xc7\x06\x07\x38\xf4\xeb\xcb\xaf\x6d\xf2\xb6\x13\x4c\xfe\x21\x96\xbd\x8a\x75\x42\x65\x25\x60\x3f\xbf\x54\xfd\xe3\xfd\xbf\xef\xaf\xd3\xdd\xbf\xb1\x24\x59\xb2\x23\x9d\x95\x39\x01\x0c\x2a\x4d\x01\x73\x01\xb3\x01\x73\x01\x4b\x01\x2c\x82\x85\x65\x41\x30\x05\x2b\x0b\x35\xb9\xb5\x47\x0f\x02\x4d\x24\x00\xfc\xdd\x7e\x6d\x8d\x0d\x69\x16\xaa\x56\x32\x47\xa9\xba\x1f\x92\x48\x24\x8e\x10\xb2\xcb\xe4\x05\x13\xfb\x77\xee\xbf\x24\x85\x14\x7f\xa7\xef\x9e\x7d\xfd\xcc\xb3\x4c\xc6\xad\xf1\x6b\xfc\x6a\xf6\xec\xd9\xe3\x1c\x9f\xbf\xd7\x59\xb6\xd2\x01\x40\xc5\x3c\xfd\x5d\xc2\x9d\x9b\x3a\x45\xa5\xff\xbe\xb4\xf6\x97\x64\x5e\x92\x6c\x2f\x78\x43\xb2\x97\x64\x1f\x2d\xcb\x0e\x79\x1d\x00\xa8\x90\x3b\xcb\xf2\x82\xbc\x07\x8c\x1d\xd5\x81\x3a\x5d\xd2\x71\x8d\x4d\x93\xb6\x8b\x53\x25\x65\x8a\xb6\x0d\xfc\xbf\xae\x7e\xfa\xe4\xb2\xca\x2b\x64\xe5\xc8\x06\x61\xb0\xb2\x7f\x58\x5f\x5e\x7e\x7c\x59\xe2\x9b\x91\xec\x12\x6d\x77\x3d\xa0\x30\x92\x29\x75\x65\xbb\x4b\x3a\xc3\x47\xc9\xa9\xbe\xa2\x74\xd1\xc1\x7f\x2e\xed\xae\x62\x77\x41\x7b\x68\x11\x1c\x92\xb8\xc4\xf1\x5c\xfc\x10\x22\x2b\x71\x3f\xda\x85\x9b\x91\xc4\xa7\x00\x4e\x31\x15\x95\x5d\x75\x29\x96\x76\x51\x1a\xd2\x74\xe8\x27\x6c\x2c\x91\x6c\x1c\x68\xb8\x1a\x8c\xa9\x71\xd0\xee\x98\xf1\xc6\x55\x63\x94\x27\x04\x91\x10\x42\x7d\x33\xda\xfa\x76\xec\x65\x6c\x3e\xb2\x8d\x44\x06\x0c\xd9\xd0\xff\x3f\x36\xe9\x71\xd9\x72\x51\x0b\xdd\x86\x09\x0a\xfd\x69\x5d\x56\x01\xcc\x60\x6a\x5e\xf1\xd9\xcf\x10\xc4\x9c\x27\x48\xc2\x6c\x28\x5c\xc6\x50\x48\xd6\x50\x48\xee\x50\x48\xc1\x2f\x21\xe4\x84\x62\x90\x26\xcd\x60\xba\x3c\x07\x81\x00\xd3\x38\x89\x86\xd8\x70\x63\x1b\x27\x20\x7d\xc0\x7d\x24\x1b\x1c\x38\xe4\x2e\x20\x04\xa8\x1d\x79\x1c\x1d\x06\x0c\x21\x00\xf2\x1f\x20\x90\x2f\x09\x2d\x09\xa3\xe0\x1f\xe0\x88\x83\x22\xc3\x80\x74\x54\x01\x28\xa1\x0f\x01\xa6\xc7\x48\xa8\x2a\x4f\x70\x3b\x09\x92\x7d\x29\x6d\x50\x4e\xcf\xcc\x60\x50\x4b\x0d\xd5\x4c\x19\x29\x1e\x48\x08\xc2\x64\x1b\x22\x5d\xfb\xca\xa2\xd0\xca\xbd\xc2\xaa\x3c\x10\xa7\xa4\x56\x21\x4c\x8f\xa9\xec\xaa\x6e\x69\xac\x00\x20\x33\x4a\x1a\x90\x21\x65\x16\xbd\x67\x46\xde\xab\x3c\x68\x64\x05\x52\xbf\xa2\x41\x0c\x20\xf1\x43\xdb\xef\xa0\x43\x0e\x3b\xe2\x38\x15\xea\x34\xe8\x20\xd0\x63\xc0\x10\x99\x15\x1b\x8e\x28\xa8\x5c\xd0\xd0\x79\xa8\x50\xa9\x5a\x8d\x2e\xdd\x7a\xf4\xea\xd3\x6f\xc0\xa0\x21\x67\x9d\x73\xde\x15\x57\x5d\x73\xdd\x0d\x13\xe6\xdc\x76\xc7\x5d\xf3\x16\x2c\x5a\xb2\x6c\xc5\xb3\x56\xe8\x0a\x1d\x9e\x80\xb8\x64\x1d\xc4\x3d\xe3\xd1\xa9\xbe\x03\x86\x46\x48\xc6\xff\x15\xc0\x03\x91\x49\x2e\x19\xe5\x9e\x87\x42\xc3\x96\x9c\x30\x19\x31\x93\x9b\x3e\x05\x69\x86\x20\x08\xc3\x84\x08\x97\xac\xf2\x81\x2e\x09\x21\x8c\x00\x3b\x29\xa4\xf1\x76\x0d\x5c\x2a\x45\x25\x56\x43\x2a\x53\x0b\xc1\x0a\x06\x8d\x1a\x77\x22\x2a\xa1\xf0\x3a\x49\xd2\x87\xf8\xdf\x52\xca\x20\x5a\x3e\x70\xcf\x4b\xd5\x83\xac\x1f\xe4\xcb\xa6\x34\x60\xac\xf2\x9e\x8d\x6d\x90\x0c\xc1\x22\x84\x2f\xab\xda\xaf\x85\x72\xdf\xc0\x4b\xab\x6c\x73\xc9\x23\xb4\x64\x36\xf6\xd3\xd0\x0c\x48\xce\x5d\x19\x69\x92\x9b\x71\x05\x19\x73\x2a\x0b\x64\x93\xcd\x91\xf2\xff\x43\x19\x88\x15\x41\x10\x71\x08\xc0\xd8\x01\x78\x86\x7e\x14\x21\x08\x0b\x82\x84\x20\xe0\x08\x4d\x5a\x8c\x40\x74\x32\x09\x81\x00\x43\xcf\x01\x06\xc4\x4d\x84\x53\x65\xa8\xe1\x3d\x10\x5d\xb7\x3f\xc6\x94\xa3\x42\x07\x86\x79\x5e\xb0\xcc\x0e\x12\x81\x8c\x35\x54\x9c\x6b\xb7\x6c\xa3\x67\x93\xc7\x28\xce\x8e\x2a\xb5\xa8\x45\xe9\x8e\xb1\x1e\x7a\xe9\xa3\x9f\x81\x72\x33\x2f\x4c\x72\x8b\x29\xa6\x99\x89\x59\xc4\x1c\xb8\xcd\x1d\xee\xb2\xc0\x22\x4b\x2c\xb3\x52\x1e\x65\xdb\x63\x9e\xf0\xb4\x3c\xcb\xa6\xe7\x6b\xb8\xe8\xe4\x25\x42\xde\xd2\xcb\x2e\x83\x3c\x37\x41\x02\xc1\xe1\x5e\xbc\x2b\xf1\xe0\xe5\x03\x48\xca\x79\x4f\x97\x32\xc2\x28\x63\x8c\xbf\xf2\xda\x51\xb6\x3a\xc3\xa6\xbc\x5a\x63\xc5\x1e\x8d\x3b\x2f\x95\x44\x20\xa1\xf0\xa3\x58\x66\x13\x09\x32\xd6\xe9\xec\x7c\xe3\xba\x02\xaa\x3b\x44\x35\xa8\x29\xb3\xd9\x34\xc7\x6d\xee\x70\x77\xca\xcf\x32\xbb\x48\x90\xb1\xc6\x99\x62\x66\x99\xe3\x36\x77\xb8\x3b\x45\xa2\x66\xd5\x02\x8b\x2c\xb1\xcc\xca\x14\x01\x95\x6c\xa2\x54\x51\x3b\xbb\xc6\x2e\xbb\xec\xb2\xcb\x6e\x63\x2a\xca\x32\xb5\x48\x90\xb1\x1e\xd4\x70\x38\x27\xc5\x35\x92\x8a\x63\x16\xcc\x71\x9b\x3b\xdc\x2d\x0b\xe1\x58\x64\x89\x65\x56\x6c\x7d\x1f\xb0\xce\x01\x02\xe8\x5e\x9a\xf0\x00\x59\x92\x80\x19\xf2\x25\xf7\xb2\x93\xf3\xf9\x90\x07\x61\x25\x7f\xf6\x40\xbe\xe6\xb1\xe9\x6f\x4f\x36\x01\x32\x0b\x64\x0d\xf9\x87\xe7\x2d\x90\x37\x00\x66\x23\x22\xf5\x5f\xbb\x0d\x90\x25\x20\xbd\x00\xd9\xc9\x76\xb6\x92\xab\x7f\x31\x6f\x44\x77\x7f\x97\xf5\xcb\xe6\xc8\x87\x0b\x27\xc9\xef\x6c\x32\x2a\x19\xc8\x5b\x28\x20\x9d\x50\x80\x59\x32\x4a\x38\xf9\x00\xc3\x90\xce\x9c\xcf\xf0\xf0\x31\xfa\xf1\xb4\x1c\x02\xc8\x5a\x1e\xe5\xb5\xba\x77\x2b\x27\x66\x83\x61\x88\xfe\xdd\x72\x32\x16\xb5\x6c\x02\xc3\x0f\xff\x5e\xfd\x57\xcf\xa7\xb4\x42\xdb\x7e\xb5\xcf\x84\x39\xfb\xda\xe5\x6e\x9e\x0d\x6c\x81\x56\xba\xae\xaf\x21\xf9\xd6\x9d\x5b\x98\x57\x12\x3f\xb3\xf7\x7e\x0b\xe4\x02\x40\x0e\x67\x91\xe8\x7e\x32\x79\xc1\xd3\xef\xe4\x75\x36\x08\xc9\xca\x77\x5c\x2d\xfb\x17\x2b\xab\xe9\xd4\x77\xc9\x1b\xa2\x69\xca\xa3\x6d\xc0\xac\x79\x1c\xee\xcb\x22\x08\x44\x4a\xf0\x57\x6c\x65\x2a\xc1\xf9\x98\xf1\x34\x01\xf8\xf1\x20\xcc\x94\x77\x9d\x62\x8e\x44\xd0\x23\x32\x09\xe4\xa5\x2d\x92\xb5\xd6\x25\xed\xd9\x66\x46\xbb\xb4\xa9\x3f\x7b\xf9\x85\xfb\x00\xe7\x93\xaf\x22\xc9\x9e\xd8\xa5\x63\x72\x86\xf3\xb4\xd9\xf3\x17\x32\x9f\x6c\x59\x9b\x21\xd9\x47\x57\xe5\x5d\xe8\x7c\xc7\x36\xbe\xdb\xf9\xe3\xd6\xd5\xf2\x32\xbd\x79\x46\x14\xe2\x2c\xb2\x1e\xea\xa1\x55\xcd\x2a\x3a\x7f\x64\x6b\xdb\x5d\xeb\x17\xe0\x9a\x13\x13\xda\xf5\x54\xf3\x34\x37\xf2\xe9\x8e\x5b\x40\xda\xb2\x6e\xf7\x4c\x17\x31\x9c\x48\x50\x46\x85\x86\x9c\x19\x98\x6e\x08\xca\x30\xa2\x7f\x81\xdb\xef\x30\x04\x3c\x0d\xbc\x74\x18\x12\x65\xce\x92\x14\x32\xb1\x2c\x9b\x5a\x8e\x23\x27\xf2\x5c\xac\x88\x5e\x2b\x61\xf0\xb4\x4f\xe1\x6f\x0e\xaa\x50\xeb\x90\x2e\x77\x29\x9b\xb7\xc2\xa9\x07\xb5\xd0\xc1\x68\x1a\x94\xb4\xa4\x25\xd3\x65\x1c\xa0\x86\x1c\x34\xc2\x21\xc3\x1d\xae\xb9\xe0\x1d\x03\xa3\x6c\x3e\xc7\xcd\x4d\xa5\xe6\xa1\x4a\x0d\x8c\xfa\x2d\x9c\x86\x9d\x0d\x23\xe9\x9a\x0f\xc1\xfc\xf4\x2c\x44\xdf\x02\x0c\x2c\xc8\xd0\xc2\xcc\x8d\x62\x61\x51\x96\x29\xe2\x96\xd0\x07\x18\x83\x02\x83\x0a\x8b\x71\x4a\x51\x17\x25\x81\x78\xc3\x92\x3c\x2c\xce\x33\xa5\xdc\x3d\x10\x35\x2a\x49\xab\x42\xca\xf4\x35\xbb\xb4\x5a\x58\x46\x57\xc0\xd1\xe8\x08\x43\x78\xc9\x65\xd8\x65\x30\x57\x2e\x5f\xc2\x8d\x18\xc7\x67\x82\xd6\x82\x6e\x9a\x81\x32\x5b\x8b\x98\x73\x17\xda\xfc\x5d\x8c\x05\x2b\xc4\xbc\x87\xb8\x34\xc4\x2b\xde\x06\x22\x89\xf1\x61\x47\xd9\x8f\xad\x20\xe2\x28\x12\xe1\xb7\x94\xf0\x52\x47\xd0\xa4\x46\xd0\x4e\x31\x5f\x89\x4f\x48\x71\x49\x40\x42\xc7\x79\x42\x9c\x42\x0e\x6b\x9a\x22\xfe\x11\xb7\x60\x9d\x9b\x33\x2c\xc2\xd5\x68\x6e\xc9\xef\x2b\xc1\x25\x2a\xf8\xe9\xa0\xa7\x23\x14\x51\x23\x14\xdf\x15\x51\xee\x24\x8c\xf7\xc4\x2b\x94\x78\x3b\x4a\x27\x1e\x62\x74\x1b\x20\xe8\xc3\xd9\x6f\x42\x08\x29\x1e\xc4\xb9\x3b\x7b\x4c\xdc\x77\x61\x1e\x79\x8a\x1f\x5b\xf8\x40\x6a\xc1\x23\x81\x86\x4d\xdf\xf1\xe0\x03\xed\xb8\x13\x05\x0b\x44\x07\x4f\xe7\xc1\x5d\xd8\x91\x87\x05\xf9\x00\xa1\xe8\x91\x38\xa0\x1a\x18\x01\x75\x24\xec\x65\x4b\x6d\x0a\x14\x3c\x20\xf8\xe4\xdd\x11\x9a\xc0\xd8\x72\x7b\x8b\xd2\xcd\x82\x83\xe2\xe6\x58\x81\xb7\x96\xbe\x8e\x0b\xe8\xae\xdb\xea\x40\x59\x98\x56\x8d\xde\x10\xd8\xcd\xb0\xd3\x53\xf2\x39\xbe\x38\xc0\x9d\x2c\xc0\x22\x00\x9b\x3c\xb5\xb7\x04\x58\x20\x7f\x0f\xf0\x0f\x38\xf8\x54\xd7\x00\x0b\xba\x19\xa0\xad\xbb\x87\x60\x61\xd7\xb5\x8f\x42\xfb\x02\x0e\x43\x62\xcc\x07\x94\x7d\x21\x0a\x11\xb0\xa6\xed\x0d\xc5\x01\x33\x4a\xd5\x34\x1c\x3a\x94\xa3\xa6\xdc\xb7\xee\x83\xff\xd0\x4e\x51\xeb\x54\x7d\x52\x9f\xf5\x18\x34\xd1\x45\x1f\x63\x24\x44\xa3\x6f\x27\xfa\xff\x1f\x80\x4a\x1f\xac\x3e\xcd\xf4\x06\x8c\x99\xf6\xc0\x86\x8f\xa4\x27\x65\x02\x1a\x0d\xb4\x97\x1c\x65\xc6\x7c\xcb\x5b\xc8\x4c\xe4\x57\xc6\x7b\x61\xbb\x9d\x55\x20\xf4\x10\xfe\x9f\xf9\x7b\xe1\xef\xf0\xab\x61\x0b\x53\x5d\x15\xf7\xbc\xfc\xf4\xa2\xb8\x3d\xb4\xf8\x1c\x2c\x0d\x58\x15\xb0\xa7\x69\xcc\x30\xef\xd5\x19\xba\x5c\x1e\xe2\x7d\xf3\x99\xb2\x8c\x18\x35\x66\xdc\x84\x6c\x39\x6e\x9a\x74\xcb\x94\x69\x33\x72\xe5\x0d\xfd\x56\x9f\xaf\xc0\x23\x8f\x3d\xf1\xd4\x33\x85\x9e\x0f\xec\x1d\xe5\xc4\xbf\xe4\x92\xf9\xc5\x63\x35\x84\x6b\x1e\x01\x7a\xc0\x49\x80\x06\xb8\x85\xac\xf9\x2e\xc0\xad\x80\x07\x00\x07\x00\x1e\x24\x9b\x76\x37\xe0\x21\xc0\xd3\x80\x63\x00\xcf\x00\x5e\x74\x22\x00\xbc\x04\x86\x35\x01\x2f\x03\x5e\x03\x3c\x0c\x78\x1d\x67\xfe\x1e\xc0\x1b\x80\x77\x01\x8f\x00\xde\x03\xbc\x0f\x38\x0d\xf0\x01\xe0\x13\xc0\x53\x80\x4f\x01\x9f\x01\xce\x00\x7c\x0e\xf8\x06\xf0\x3c\xe0\x5b\xb2\xe6\xb3\x00\xdf\x01\x7e\x04\xbc\x0a\xf8\x89\xec\xf9\xd9\x80\x9f\x01\x7f\x00\xde\x04\xfc\x09\xf8\x0b\x70\x0e\xe0\x6f\xc0\xff\xa6\xec\x03\xa6\x9c\x07\xc8\xe4\x4f\x80\xb2\xca\x0c\xce\x07\xca\xf6\x8b\xef\x00\xb8\x00\xa8\x2f\xcd\xe6\x22\xa0\x7e\x38\x9b\x8b\x81\xfa\xf1\x0c\x2e\x01\xfa\xd9\x17\x9c\x43\x80\x4b\x81\x7e\x9e\x05\xe7\x13\xe0\x72\xa0\x5f\x60\xf1\x85\x04\xb8\x0a\xe8\x17\x9d\xc1\xb5\x40\xbf\xce\xe2\xeb\x0a\x70\x1d\xd0\x6f\x38\x9b\x1b\x80\xfe\xc4\xd9\xdc\x08\xf4\x0f\xcc\xe6\x26\x60\xd1\xd9\xb6\xd3\xcd\x8e\xd8\xd2\xe6\x1f\x3b\xde\xa5\xaa\xcf\x2d\x8b\x92\x01\x73\x0c\xde\xb1\x9b\xb5\x1e\x76\x84\xc3\xdf\x08\x30\xad\x18\xaa\xa3\x69\xc8\x1c\x48\x49\x11\x83\x3a\xcc\x8a\x1c\xeb\x41\x1e\xdf\x21\x58\xde\x9a\xa5\xef\x5a\x22\x2e\xd9\xad\xc2\xdf\x39\x5e\x3a\x25\x2f\xf5\x3a\x88\x84\x56\x20\x5f\x27\xe2\x9f\x91\xb0\x95\xd0\x29\x44\xc2\x57\x14\x74\x0f\xc5\x9a\x0e\x0c\x2e\x43\xf2\x99\x1f\x28\x70\x75\x7c\xc6\xdc\x21\x41\x95\x3a\xe7\x5e\x1d\x82\x60\x07\xbd\x8e\x56\xde\x51\x03\x8d\xad\x75\x17\x79\x6e\x36\x8e\x44\xd4\xd1\x3d\xe7\x72\x37\x0a\x70\xb3\x76\xf7\x1b\x74\x36\xdd\x6f\xc4\xb5\xce\xaf\x2f\xfc\xb8\xa2\xab\x4a\x9b\x5a\x34\x4e\xd6\x0a\x01\x99\xdf\xac\x68\xda\xd2\xae\x31\x8b\x44\xda\x87\x12\xff\xf3\x75\xc3\x52\x8a\xdb\x85\x3c\xb5\xc8\xa3\x9c\x61\x5d\xf9\x72\x8b\x60\x37\x8f\x0b\x75\x06\x81\xe9\xee\x2d\xb7\x3e\x2f\xb8\x8a\xb1\x67\xac\x20\x74\xbe\xd4\x37\xe4\x7c\x38\x57\xf8\xb0\x2d\xd7\xf6\x50\x7c\x8b\x31\xcd\x08\x59\xa7\x63\x29\x92\x77\x9d\x5f\xd7\x7c\x4e\x87\xdb\xf9\x56\x2a\xe4\x41\xf1\xd3\xd8\xf5\x13\x58\xcb\x83\xb5\xfc\xde\xa9\x3a\x7d\x59\xa0\x5f\x15\xd1\xdb\x7a\xd7\x85\x85\xe1\x9b\xb7\xd6\xa2\x52\xcd\x35\x07\x3e\x2e\xd7\xa5\x0c\x0e\x96\x09\xae\x34\xbe\xf6\x04\x42\xd7\xf2\x80\x10\x5b\x53\xd0\xc3\x37\x93\x45\x81\x38\x8a\x9c\xfc\xef\x25\x76\x7b\xb1\x4f\xd0\x13\x80\x55\x04\x2e\x01\x7d\x16\x80\x9f\x03\x6c\x79\x01\xc7\xfe\x89\x11\x01\x36\xbb\xe9\xc8\xe3\xc2\x44\x27\x81\x90\x77\xf5\x73\x2f\xc9\xd9\x51\x2d\xd1\x95\x89\x1b\xe4\x69\xbe\x93\x33\xa7\xa3\x25\x11\xb3\x18\xd5\x3b\xad\x96\x0c\xad\x10\x1c\xbb\x92\xe5\x74\x58\x65\xed\x99\x72\x02\xa7\x1b\xd9\xdd\xdc\xea\x08\xe7\xa0\x1d\x33\xab\x1e\x8e\x13\x63\x1f\x0f\xa2\x3f\x47\xa6\x7d\x91\x43\xfb\x0b\x0c\x63\x03\x5c\x5c\xe1\x2c\x8c\xca\xb0\x94\xbc\xd5\x60\xa1\x70\xa2\x7b\x62\x60\xc9\x85\xc8\xd6\xd4\x77\x67\x00\xa2\xd5\x6f\xac\xa4\x5e\xca\xd1\x6a\xed\xc8\xd6\x42\x2c\xb8\xd7\xd1\x59\x55\x39\xaf\x6e\x64\x14\x85\x55\x5a\x82\x5c\x71\x27\x67\x13\xec\xe8\x75\x2d\xab\xc1\x44\x30\x85\x2e\x60\x4a\xce\x06\xed\x8d\x3b\x56\x57\x27\x2e\xea\x16\x9a\x1a\xd4\x6e\x19\x42\x06\xb8\x58\x63\x7a\xe7\x07\xcd\xbd\xab\x15\x18\x6d\xa1\x39\x40\x69\xb4\x13\x85\x6b\x55\xa0\xb6\x51\xca\xc9\xd3\xc9\x34\x6d\x0e\xd6\x56\x52\x06\x0d\x8d\x9d\xac\x54\x56\xf3\xd1\xf4\xe4\x38\x21\x60\x0f\x35\x64\xd1\x1c\x0c\x04\x17\x9d\xee\x67\x7f\xc6\x93\xf5\xe2\x46\xc3\x5f\xc7\x8d\xf2\x98\x16\x6a\x96\x30\xd8\x94\x62\x89\x94\x2b\xe4\x89\xee\xbc\xc1\x49\xaa\x50\x9d\x50\x85\xc3\x44\x45\xf4\x07\xaa\x47\x2d\x51\x27\x8e\x84\x6e\x0c\x46\x1a\xa2\x80\x2c\x03\x19\x09\xc5\x04\x36\x23\x6a\xb0\xa9\x06\x42\x51\x66\x65\x59\x24\xca\x91\x8a\x84\xa1\x89\x46\x68\x2c\x27\x8a\xb8\x72\xd2\x79\x75\x3f\x91\xa0\x33\xe5\x50\xc0\xa6\xae\x1b\x1b\x00\xb1\xae\xd5\xba\xba\x8a\x8a\x57\x1b\x46\x06\x92\x11\xaf\x7e\x82\xa3\xdb\x9b\x8b\x5a\x58\x08\xff\x56\x5d\xd8\xfa\xe3\xd5\x8e\xc2\x85\xd0\xa4\xd6\x81\x57\xcf\x19\xbd\xf4\xe7\x83\xa9\xb5\x67\x75\xb0\x0a\x75\x7c\xcd\x07\x61\xe6\xce\x19\xee\x85\xdd\x9a\xa8\xf4\x4d\xa7\xaa\x6b\x95\x80\x95\x23\x45\xb9\x94\x92\xab\x41\xe8\x50\x13\x9d\xd2\xc9\x32\xfa\x61\xc3\x60\xdb\x26\x18\x62\xc1\xaf\x5a\x8a\x7d\xcd\x7d\x2d\x6c\xb9\x6a\xaf\x41\xaf\x76\x1c\x5c\x43\xb8\x5d\x96\x3a\x78\x54\xec\xdc\x56\xe3\x95\xba\x46\x49\x4e\xb5\x5c\x0f\x7c\x96\x88\xe5\x75\x7b\x82\xaf\xc8\x55\x57\x38\xda\xab\x3a\x18\x25\x88\x05\x50\x59\x48\x59\x24\x9d\x99\xf2\xee\x6f\xb7\xb7\x43\xb9\x12\x95\x76\x50\x8a\xea\x65\xa0\x32\x4e\xe9\x53\x2a\x77\x33\x50\x4a\x3a\xa8\xcd\x9f\x8b\x51\xb8\x11\x36\x62\x7a\x18\xb2\x6b\x2e\x3c\x93\x5c\xc6\x9d\x9c\xdb\x53\x37\x5f\x08\xac\x63\xa6\x3b\x14\xd2\xd1\xba\x97\x27\xca\xa9\x9c\x22\x96\x3f\xd5\x39\x03\xaf\xde\xec\x68\x7c\xe5\x46\x9b\xc2\xc7\x30\x84\xee\xc6\x61\x67\x81\xec\xf0\x36\xdc\x9e\xdb\xd7\x99\x1e\x71\xe7\x30\x73\xc7\xd1\x41\x8a\xdb\x62\xd2\xb2\xf2\x36\xed\x8a\x03\x83\x48\x1f\x94\x58\x38\xb5\x7d\x30\x62\xb9\x84\xab\x10\x5c\x01\x07\x1b\xa9\x0a\x75\x2e\xfc\x64\xfc\x38\xab\xcf\x7e\xd5\x43\x2a\xf3\x07\xd1\xe6\xeb\x7c\xfd\x42\xb8\x81\xff\x79\x6e\xf6\xdb\x5d\xff\x7a\xf5\x55\xb8\x5c\x91\xac\xe6\xf2\x8c\x6a\x09\x56\x5b\x40\x4a\x7a\xdd\x89\x17\xc6\xc8\xf4\x04\x3c\xcb\x10\xa7\x1f\xb9\xa9\x90\x28\x91\x7c\x8d\x5c\xea\xac\x07\xa0\x64\x28\xd5\x7a\x00\xf6\xec\x55\xbd\xc8\xbf\x41\x74\x35\x97\x1d\x1f\xb6\x31\xe8\xcb\x39\x3e\x97\x73\xf2\xca\xa7\x46\xf2\x9e\x0f\x32\xb9\xf1\xd6\xb3\x1e\xa2\x44\x9f\xa5\x17\x52\xa5\x72\xbe\xa7\xd4\x99\x91\x90\xd7\x87\xd5\xb0\xf2\x54\x3b\xbf\x22\xc3\xb1\x3a\x35\x97\xfd\xf4\x65\xc5\x73\x09\x54\x6e\x7b\x46\x8b\xe6\x8f\x20\xcb\x45\x5e\x26\xa9\xa0\xb8\x3c\xd6\xbc\xc8\x96\x78\x9c\xad\xf5\x00\xb2\x91\x8b\xd7\x4f\xa4\xf5\xca\xde\x40\x92\x40\x46\x7f\xb3\xda\xe8\xde\x0f\x49\x3c\x53\x1a\xb2\xaa\xba\x65\x0d\xe4\x02\x45\x50\xa3\x03\xd7\x83\xa5\xae\xb5\x0b\x87\xb1\x4e\x58\x91\xf4\xc0\x54\x16\x65\x49\xe2\x4e\xce\xdb\x8f\x8c\x7f\x41\x41\x3e\x63\x16\x49\xa7\x70\x92\xd5\x4c\x2e\x14\x9f\xe0\xba\xc1\x73\x16\x3c\x56\x93\x35\x39\x1a\xc8\x8d\x75\xc5\x1b\x6a\x96\x93\xde\x42\xc9\xec\xd9\x81\x58\x8c\x02\xa6\xb9\x00\x15\x09\x73\x33\xea\xa2\x60\xe2\x0a\x6d\x88\x35\x9a\x63\x29\x9a\x69\xa4\xfc\x8f\x0b\xc4\xf0\x4a\x91\x86\xc2\xa1\xba\x72\x32\x80\x23\x89\x3e\x84\x14\x37\xf6\xd9\xcc\x2d\x3d\x46\xab\x16\x6a\x82\xe0\x54\xd9\xdd\x70\x46\x12\xae\x06\xcb\x42\x95\x3a\x06\xf9\x27\xe4\x34\x47\x03\x62\x8b\x72\x91\x80\x57\x34\xd8\xa2\xd7\xf7\x75\x49\xdc\x80\xe8\x7e\xfc\x43\x8c\x42\x46\x32\x94\xb3\x4f\x5d\x9a\x94\x16\x31\x69\x85\x4b\x11\x05\x42\x51\x7b\xdc\xa0\xd1\xb8\x4c\x84\x00\xca\x67\xe3\x0f\x55\xb1\x9e\x7a\x83\x70\x24\xa0\x13\x39\xbb\xa9\xaa\x88\x57\xc9\xad\x16\x10\xb8\x7c\x60\xea\x6a\x25\x4b\x6a\x44\x34\x52\x2c\x9c\xb4\x03\x8a\x0d\xfe\x98\x4c\xa2\xa5\x9a\xc3\x08\x63\xec\xd0\x79\xdf\x60\x4d\xa2\x8b\x37\x79\xe3\x38\x79\x19\xd9\x9b\x67\x5f\x4e\x96\x02\x9e\xc2\x26\xf7\x26\xb4\x61\x53\xbe\xc6\xd8\x8f\x33\x14\x75\x2d\x85\xc8\xc4\x56\x71\x90\x76\x32\xd3\xe5\xaa\x43\x04\x42\xe3\x40\xe3\x27\xfe\x29\x43\x1c\x7e\xb9\xd2\xc9\x48\x82\xeb\x4c\xad\xf1\x06\x76\xc3\x9c\x68\xd5\x3d\xdb\x34\x47\x00\xe5\xec\x50\x24\x65\x48\x69\xb3\xed\x11\x0a\xf7\xa8\x9a\x32\x7b\x2a\x67\x93\x09\x7a\xaa\x28\x4f\xb6\xed\x08\x9d\xca\xa8\xd5\x20\x26\x36\xdb\x62\x5a\xef\x57\xab\x40\x90\xf0\xb0\x72\xc2\x4f\x24\xab\xc7\x42\x7b\xca\xba\x0d\x37\x24\x6e\x87\xe3\xd7\xe3\x18\x25\x30\x41\xbc\x78\x4c\x6a\xf3\xe9\x74\x16\x81\xcc\x0c\x89\x85\xa9\xdb\x41\x5c\x46\xd1\x28\x19\xd9\x40\x26\x20\x06\x67\x3b\x2c\x1e\xea\x7a\x21\x10\x1e\x83\x42\xda\xf2\xa2\xaf\x9e\x58\xc0\xe7\xf0\x83\xfd\x1a\xef\x31\xc1\x36\x7b\x5a\x02\x08\x4e\x8d\x6d\xe0\xfa\x33\xc5\x2a\x1a\x10\x8a\x00\x86\x72\xa3\x80\x27\x92\xd1\x41\xde\x7d\x22\xc4\x46\x1b\x12\x1a\x1d\x65\xb5\xd1\x0b\x9b\x1f\x68\x02\x86\x46\x29\x6f\xa8\x89\x00\x09\x0a\x1a\x25\x09\x15\xee\xa5\x75\x55\x75\xb4\x88\x82\xf4\x14\xdb\x24\xee\xab\xc4\xe1\x8e\xbe\x06\x7d\xd7\x97\x4b\xb9\xf8\x88\x26\x35\xca\x0f\x5e\xcb\xb2\x23\x67\x8f\x31\x21\xc4\xa0\x21\xc6\xa3\xc4\xbe\xad\x2d\x63\x69\x53\x10\xd7\xad\x88\x2b\x54\xd8\x8f\xfc\x75\x62\xe3\xe5\x5f\x75\xd7\xb6\xde\x17\x16\x7c\xc2\xf1\x52\x60\xfa\x6e\xc6\x31\x69\xa1\x81\xee\xeb\x01\x8e\x85\x5e\xa2\xf7\x21\xa5\x04\x96\xc9\x2d\xe3\xf6\x76\xbc\xd6\x9c\xa5\x72\xc4\xa1\x82\x5a\xa5\xb1\xfa\x57\xb6\xbc\x1f\xe8\x43\x4c\x8a\x81\x54\xef\x1a\x10\x97\xd1\x17\x5a\xe8\x7e\x0a\xc5\x64\x99\xc1\xe9\x46\x1e\x57\xd0\x45\x78\x5a\xc6\xf1\xff\xcd\xce\xb8\x03\x50\xd4\x81\x62\x91\x44\xa3\x1d\xc5\x8b\x2f\x76\x8d\x4a\x0f\x0a\x19\xa5\x33\x46\x05\x84\xd4\x80\x38\xf7\x88\x11\x08\x4c\x00\xce\xe0\xf1\x3d\xf6\x8e\x88\xde\x42\xcd\xdb\x56\xc0\x02\xc7\x1f\x98\xc8\x14\x24\xa2\xd5\x09\xa7\xe4\x69\xec\x8b\x69\x92\xad\xb6\x0c\xeb\x4b\xd0\x52\x9a\x5a\xda\xf8\xc1\x0a\x0c\x61\xc0\x08\xb6\x5a\x0a\xc3\x31\xba\xc2\xbc\xad\x26\x2b\x8d\xcc\xe6\x55\x15\xc8\xaa\xbf\x50\xad\xfa\x78\x14\x0e\xc7\x66\x30\xd4\xa3\x81\x2f\xa8\x32\x9a\xc2\xa5\x1f\x33\x80\x50\xe8\x2c\x36\xb3\xb0\x61\xaa\xe0\xe1\xc8\x4c\xc6\x95\x21\x3b\x22\x6b\x04\x09\x09\xee\x1e\x3a\xef\x48\xbc\x12\x25\x4b\xaa\xc0\x8c\xce\x0a\x58\xf0\x6c\x68\x39\x98\xf6\xb1\x98\xaf\xdb\x98\xc7\x26\xe5\x5b\x4f\x2f\xca\xad\x72\x05\x89\x03\x16\x14\x74\xe8\x4f\xf4\x25\xfd\x7a\x33\x66\x44\x25\x12\x3e\xd7\x9a\xb1\xce\x8e\x49\x43\x58\xe2\x9d\xb1\x2b\x03\x12\x1a\xff\x18\xef\x8a\xbc\x03\x77\x9e\x68\x5b\x25\xe6\xee\x2c\x45\xb1\xb2\x15\xd8\x8f\xc4\xa4\x57\x49\xf0\xfa\x4a\x37\x32\x8d\x8f\x66\x1a\xb6\xba\xaa\x1c\x5a\x57\x28\xde\x96\x7a\x40\xd1\x85\x09\x37\xdd\xb0\xbf\x75\x96\x60\xa1\xcd\xc6\xe5\x11\x57\x22\x94\x61\xd2\x94\x19\xf3\x24\x73\x03\x89\xf1\x52\x1c\x0b\x16\x54\x0e\xed\xd1\x76\x7c\xa7\xf8\x40\x21\xf2\x6a\xf9\xb5\xe5\xe1\xb9\x3c\xcd\xbe\xb2\x2b\x2f\x1b\xce\x08\x45\xca\xc1\x6a\xe0\xb3\x69\xf5\x72\x03\x9d\xf6\x32\x42\xbb\x67\x37\x16\x14\xdc\xa8\xd2\xa8\xa8\x36\x87\x30\xa0\x7a\x2d\x0c\xd8\xb0\x6c\x84\xe4\xc4\xe6\x8f\x63\x08\xae\xa4\xd5\x5f\x0d\x42\xcb\xc4\x66\x53\x11\x06\xfc\x8b\xb1\xbe\xa9\xfb\x0a\xf8\x84\x9d\xf3\x3e\x2c\xd6\x27\xa7\x0c\xbe\xb9\x3b\x97\xbe\xfd\xde\x17\xdf\x79\xae\xf5\x38\xeb\x49\x01\x04\x84\x32\x18\x8b\x81\xc0\x7d\x82\xd9\x13\xbc\xd6\xd5\x2d\x6d\x72\x44\xc6\x3d\x7f\x94\xad\xff\xdd\x3f\xf0\x3e\x9d\xb8\xc3\x06\x78\x05\x47\x03\x91\x4c\xf3\xd8\x0f\xfc\x71\x6f\x9a\xe1\x90\x47\xbe\x3b\x87\x3b\x54\x8e\x7c\x84\x29\xed\x69\xf0\xb6\x95\x92\x69\xce\x66\xa6\xd9\x87\x4c\x32\xcb\xa6\xb9\xe8\xa1\xda\x63\x0f\xd6\xb5\xec\x9f\x23\x16\x1c\x88\x6d\xb3\x75\xfb\xa9\x71\xcb\x53\x97\x46\xaa\x37\x7d\x90\xaa\xcc\xe6\x00\x37\xa9\xf1\x62\x50\x13\xd4\xa5\xe2\xa7\x03\xbf\xfe\xa5\x57\x7d\xff\x44\xc5\x74\xf3\xf1\xdf\x45\x33\x71\x5a\xd5\x27\x8d\x42\xf7\x7e\xec\x78\xc6\x81\x13\x6c\x58\xec\xdb\xbd\xb2\x72\x4e\x64\xb9\xe9\xf1\x90\xe4\xf4\x97\x9b\xb8\x78\xdf\x58\x2a\xc5\x8d\x41\xb1\x71\x75\x7f\xef\x75\xca\x89\xc9\xd2\xce\x77\xef\x59\x58\xb8\x22\x3a\xdd\x38\x75\x47\xec\xd5\xb7\xbb\xb8\x38\x4e\x2a\x8b\x16\xc0\x08\xe7\xfa\x52\x9b\xdb\xdf\x51\x5c\xda\xdf\x56\x4c\xca\xeb\x6f\x2d\x2e\x19\x68\x2b\x1a\xe5\xd2\x27\x92\x38\x26\x64\x43\x2b\xfd\xda\x4f\x04\x6d\x33\x92\x89\x42\xd6\xf1\xbc\xc1\xb6\xa2\x92\x81\x96\x52\x52\x6e\x6f\x5b\x69\x59\x7f\x7b\xc1\x28\x5c\xdb\xd2\x56\x2b\x31\xcf\x46\xdf\x8c\xc4\xf1\x2a\x46\xd0\x97\x22\x6b\x7c\x74\x24\x39\x27\xcb\x22\x2f\x80\xd3\x5b\x1d\x6c\xec\x42\xae\xa5\xf6\x2c\x2f\x5e\x6c\x7d\x30\x5e\x3e\x30\x98\x1d\xee\xf0\x4b\x89\x10\x55\xf4\x4d\x36\x73\xf0\xf1\x1f\x92\x95\x73\x13\xf3\xe1\x23\xf8\xea\x16\x22\x98\xa1\x2b\x6f\x6d\x47\xb3\x0e\x72\x0d\x63\x43\xe8\x18\x6b\xd7\x08\x1b\xb5\x76\xbc\xa5\x3f\x99\x4c\x30\xb4\xb2\x8f\x14\xd1\x0c\x95\xad\xb8\x74\x70\x51\x22\xc9\x7e\x2d\xe1\xcc\x3b\xc3\x42\x03\xed\xfa\xf2\x90\xd6\x9b\xca\x0a\xc7\x9b\x6f\x55\x84\x6b\xd7\xe6\xe9\x6f\x6b\x24\x55\xc0\x9f\xa2\xbc\x75\x56\x33\x9a\x3f\x1b\x15\xea\x69\xd7\x16\xb3\x5a\x6f\xc0\x70\x9a\x47\x5b\xa6\xcb\x23\x84\xe7\x29\xe1\xa3\x66\x76\x3d\xec\x19\x4a\x8c\x3a\xa1\x4b\x23\x0c\x6b\x25\xa3\x19\x93\x68\x3f\xe5\x44\xc2\x45\x23\xda\x43\xde\x06\xce\x05\xa5\xf3\x52\x0d\x21\xd8\xa3\x7e\xc7\x99\x5c\xcc\x5a\x74\x2d\x8b\x8b\x95\x87\x96\xbe\xc2\xae\xb6\x53\x32\x36\x4d\x80\xe2\x5f\x7d\x01\xd3\x20\x2c\xf4\xb5\x7c\xd3\x2f\xf5\x2d\x75\x91\x94\x62\x73\x86\xb3\x85\x65\x4e\x67\x4e\x74\x77\x22\x59\x6e\x97\xe2\x6c\xf2\x0a\x2e\xf7\xd8\x0a\xda\x2c\x92\xae\xfc\xd3\x68\xef\xfd\x6f\x5d\xd8\x3e\x01\xd5\x3f\x7a\xcf\x7e\xeb\x3c\xff\x49\x61\xa5\x2e\xf8\xb6\x31\x03\xbd\x4f\xc3\x3a\x7c\xcf\x79\xf7\x85\xb0\x3c\xda\xa1\x1e\x0b\x1c\x8e\x4e\x63\xed\xd0\x57\x14\x74\xc9\xeb\x53\xcb\x5f\xbd\x99\x8c\x33\x96\x3e\x3e\x61\xee\xbe\x9c\xdc\x30\xc5\x9c\xd4\xa7\x1b\xc9\x15\xaf\x7f\xac\xdc\x62\x87\xbf\xcc\xab\xb4\x62\x44\xba\x49\x56\xd0\xea\xc2\x98\xa1\xee\xb4\x60\x1f\x06\x35\x9c\x4e\xc6\x45\x26\x0e\x3f\x0b\xc8\xd9\xe0\x7a\x36\xe1\x9f\xd0\xe9\x8b\x4f\x3c\x1a\x3f\x18\x3f\x21\xbd\xb5\xf5\x04\xb3\x76\x03\x47\x49\x0c\xa2\x07\x45\xb3\xe9\x4f\x3e\x9e\xca\xd3\x6b\xd9\xd7\xbe\xd5\xae\x87\xd3\x73\x16\xb8\xb0\xff\xf3\xe8\xbe\x03\xea\x45\xa6\x30\x2c\xb9\xf8\x36\xf5\x8d\xca\x9e\x99\x92\x4e\xa0\x56\xf4\x83\x1d\x68\xb3\x38\xf6\xc5\xf3\xc4\x9a\xec\xd7\xac\xcc\x71\x78\x92\x3c\x0f\x9d\xed\x4f\xf3\x65\x16\x65\x24\x27\x64\x71\x82\xbe\x5e\x61\x69\x6b\x8e\xbe\x97\xbd\x09\x7b\x7f\x4b\x62\x30\x2a\xe6\xec\xc8\x4b\xe1\x17\x25\x89\x6b\xaf\xd2\x4a\xd6\xa5\x8d\xf8\x83\xdf\x9d\x63\xd9\x4d\x3d\x03\x5b\x8f\x2c\x22\x48\xbd\xb4\xf2\x88\x96\x20\x8f\xc8\x93\x31\x71\x51\xe5\xee\x44\x31\x21\x25\x4d\x21\x55\x25\xe2\xd4\xe2\x36\x42\xcd\x24\xfb\x18\x8b\xd9\xca\x50\x61\xaa\x25\x9d\x4b\x98\xe0\x7e\x35\xc5\xfb\x7a\x3c\x21\xe9\x7c\xb0\x2a\x43\x25\xb8\x95\x75\xf5\xfb\xb3\xa5\xc5\x1f\x4f\x50\xea\xe6\x5e\xa1\x11\x1e\x8c\x50\x8e\xb7\x47\x48\x84\xb7\x27\x2b\x54\xee\xa9\xb3\x5b\x20\xc5\xd1\xcd\x8f\xe6\xea\xc6\x6c\xa3\x31\x53\x54\x22\x2d\x38\x61\x35\xe3\x1b\x3c\xaf\x4b\x93\xdf\xbf\xcc\x2e\x2a\x7b\x59\x9a\x24\x08\xfa\x43\xd8\xcc\x46\x6b\x41\x0b\xb0\xf7\x31\x1e\xd5\x3c\x62\xe1\x6f\x79\x32\xae\x22\xbc\xd9\xd3\x35\xbc\x21\x3e\x8e\x5d\xeb\x81\x44\xd2\x98\xba\x33\x92\x9a\x0f\x43\x77\xb1\xa0\xa4\xb8\xb1\xef\x76\xea\x6e\xea\x99\x14\xfb\xaf\xb3\x89\x73\xe2\x5a\x11\xa1\x3b\xb1\xdf\xc3\x24\xc4\xe9\x23\x45\x66\xed\xc3\x4d\x7a\xfe\x3a\xdd\xa7\xad\x68\x74\xc8\x5e\x11\x1a\xf9\xb4\x32\xbf\xb8\x75\xe7\x9a\xb3\x75\xb5\xa7\xbb\x83\x8d\x35\x89\xcf\x47\x5f\x56\xcd\x8c\xee\x52\x62\xd8\xa3\x6d\x27\xb9\xd8\x3d\x43\x29\x92\x7c\xff\x7f\xeb\x37\x5d\x92\xc9\x0a\x44\xbf\x13\xcd\x27\x1b\xbf\xa2\x67\x45\xda\x32\xc1\x74\x41\x74\xa9\xbf\xc9\xb6\x9a\x09\xaf\x9d\x16\x55\x9f\x91\xb4\xcc\xd5\x8f\x62\x10\x62\x35\xc7\x8f\xdd\xec\x0f\xe6\xdb\x14\xf6\xd1\xcb\xd4\xa3\x5e\xd8\x67\x22\xbf\xff\xd4\xf4\x3d\x15\x26\x4f\xd4\xe2\xc5\x06\x7e\x05\x7e\xf4\x9b\x0b\xf9\xef\xbf\xff\x66\x2e\x9f\x2f\x35\xca\x88\xe2\xcb\x32\xcb\xb7\x51\x75\x75\x96\x75\x38\xfc\x88\x7d\x38\x2a\xf5\x66\xa2\x5e\x06\x5e\xc8\xef\xa8\x11\x6e\x6d\x48\x5c\x51\x6c\x68\x1d\x7f\x79\xf6\x45\xac\x45\x26\xe0\x1a\xfa\xc7\x2b\x32\x37\x19\x12\xda\xe2\xb3\x3f\x5c\x26\xbd\x3b\x69\x9e\x7f\xe5\x3b\xc4\x9b\x50\x00\xb1\x62\xfd\xdd\x66\xee\xc1\x44\x17\x5e\xac\xc2\xcf\xfa\x45\xba\x24\xcf\x57\xd8\x97\xaf\xa1\xb6\x75\x04\x81\xa4\x71\x3c\xd9\xed\xbb\xb3\x46\xa2\x3d\x17\xff\xa8\x0b\x4a\x7c\xc6\xf3\x15\xe5\xa6\x16\x56\x57\x2e\x43\xaf\xc3\x76\x4b\x1e\xd7\x9d\xc8\x4f\xe5\xcd\x36\xaf\xf9\x40\x8d\x8d\x29\xf7\x75\x1b\xde\x36\xbb\xdd\xa1\x77\xbc\x7f\x04\xf0\x46\xdf\xb8\x53\x3e\x74\x65\x0d\x5a\xb2\x7d\x71\xe9\xed\x59\x5d\xc3\xd5\x38\xe3\xdc\xb6\xbd\x07\x52\xba\xf7\x3e\xb7\xb8\x1b\xdb\x19\xae\x52\x8d\xa9\x78\x7a\xd2\x87\x3f\xe7\xc7\x1b\x1b\x3b\x60\x2f\x3b\x78\x1f\x64\x0d\xdf\x90\x6d\xab\xd3\x85\x16\xfc\x9e\x9c\x34\xc4\x74\x5d\x85\x2b\x7d\x93\xde\xd9\x87\x4e\xce\x8f\x8a\x3f\xd5\xb8\x06\x56\x3d\xd6\xfa\xb7\xba\xba\x2d\x2d\x7f\x8b\x3c\x37\x08\xa6\xa8\x00\x3c\x87\xcc\x51\x8f\xc6\xdb\x91\xed\xf0\x36\xe3\x77\xc5\x72\xf8\xa5\xda\x85\x8f\x27\x12\x17\x5f\x46\x15\x6f\x40\xdb\x2b\xca\x21\x6e\x01\x3e\x2e\x01\x31\xa1\xc7\xc9\x96\xf5\x9d\x4d\xe2\x8d\xa8\xcc\x1a\x8e\x7c\x11\x81\xc8\xc5\x99\x1d\x0e\xb0\xe9\xff\x98\xb2\xd7\xcd\xd1\x3b\x84\x6d\x1f\x92\xf0\xc7\x4a\x10\xb1\x85\xb7\x59\x8e\x95\xc8\x0d\x48\x56\x1a\xdc\x93\xcc\xd6\x97\xeb\x2f\x59\xa7\xa1\x1b\x05\x0b\xf0\xfb\xb2\x46\x46\xd4\xb7\x6f\x5f\xc5\xd8\xd8\x51\x1b\xca\xea\xa5\x95\x1a\x33\xe9\xc1\x4b\x17\x64\xfa\xeb\x4d\xc1\x43\xd2\x4a\x9d\x85\xf4\x00\xdb\x4c\xef\xc4\xf2\x71\xb0\xd6\xfa\xae\xb6\xa6\x75\x1b\xac\x5a\xbe\x6c\xfd\x5a\x57\xd3\xbc\x0b\x56\x2d\xfa\x01\xa4\xfa\xf3\x8d\x4e\xdb\x8f\xb8\x9c\x67\xc5\xcb\xfb\xbe\xc1\x91\x9f\x04\xe2\x92\xc3\x83\x43\x52\xb2\xc2\x20\xb4\x2d\x65\xe2\xd2\x9d\x2c\x7c\x34\x7d\xef\xc6\x48\x97\xe6\x0f\x92\x6e\xad\xc1\xd3\x98\x0f\x62\xe7\x39\x4c\x0e\x26\xb1\x36\x5f\x95\x3e\x95\x49\x20\x50\x84\x4c\xb1\x70\x9e\x4f\x11\x49\x91\x01\x41\xd1\x31\x09\x7b\x3e\xda\x8e\xa9\xce\x71\x74\x6d\x7f\xba\xca\xf7\x9c\x37\xb1\xd9\x97\x7f\x04\xd5\x9f\xfe\xcc\xc9\xbd\x9b\x85\xf7\x4f\x09\x8b\x0c\x4b\x60\xd8\x58\x96\x78\x15\x1e\xfe\x6f\xb3\xf5\xa6\xe7\x86\xdc\xf2\xa7\x2e\x74\x62\xd9\x52\xf1\xb1\x3d\x6c\x48\x9c\x8b\x9f\x4d\xdb\x8d\x91\x1b\xf8\x1b\x97\x3f\xb7\x28\xdb\x72\x0d\x8d\x7e\x38\x22\x06\xff\x5f\x54\xb0\x9d\x39\xfc\x78\x69\x7e\x66\xc2\x5f\x38\xef\xac\x7e\x7d\x30\xa6\xcd\xd2\x4e\xe1\xc2\x90\xae\x42\xd5\x90\xad\x8e\x3d\xd4\x26\x40\x14\x38\x8a\xd1\x1c\x52\x16\x5a\xb8\xf7\x87\x28\xdf\xad\xe7\x51\x01\x27\x3e\x61\x04\xdf\x3f\xef\x43\xe7\xaf\xa2\xbe\xba\x75\x3e\x3c\xf7\xdc\x43\xe2\xaf\x6c\x1e\x8b\xd9\x26\x6e\xd4\x83\x59\x58\xe5\x52\x92\xdc\x9b\x26\x94\x2a\x2a\xda\x51\xb2\xea\xe8\xc7\x17\x7b\xce\x43\xc7\x10\x82\xf7\x3f\x13\xfb\x88\x8a\x39\xa3\x25\x00\xa9\x5e\xf2\x72\x08\x70\xef\x0d\x29\x6e\x6d\xc3\x83\x07\x90\xc7\x0e\xa1\xc1\x72\x43\xc9\xfd\xb2\x54\x90\xaa\x74\xbf\x2c\xa2\xa5\x7f\x66\xfd\xc8\xf2\xbc\x6b\xad\x1d\xd4\x05\xab\xa5\xca\x91\x52\x4a\x4e\x73\xef\xd0\x9a\x7a\xe2\xab\x5a\x05\xc1\xd1\x5d\x8d\x0f\x2e\x15\xf5\xfd\xbb\xf4\x11\xe4\x18\x5a\xdb\xb8\x51\xc9\x14\x73\x03\x9c\x67\x54\xe3\x02\x3d\x7b\x66\x7b\xe6\xe2\x12\x38\x75\xd8\x4e\x44\x74\xc6\x38\x44\xa7\xf1\x2a\xfb\xb1\x60\x15\xd0\xb2\x36\x8f\x30\x5b\x45\x23\x9e\x2b\x4e\x0f\x05\xe7\x0a\x44\x22\x00\x12\xe0\x0e\xfa\x45\xb7\x4e\xbd\x52\xe4\xc1\x22\xda\x82\xaa\x83\x0a\xd5\x39\xbd\x1a\x11\x27\x8a\x8e\x16\x60\x4c\xfc\x55\x16\x1d\xfc\x5c\x21\x92\x30\x08\x8d\xb9\x78\xc7\x3f\x79\x93\xff\x00\x02\xfd\x8b\xf7\xf0\xe6\xed\x2f\xeb\xd0\xa8\x30\x44\xf5\x0b\xa6\x04\x04\x9e\x6c\x97\xef\x0c\x28\xb7\x36\xd9\xf7\x77\xf5\xe8\xad\x86\xc0\x98\x43\x8a\x1a\x3b\xe7\x12\x8c\x0c\xc9\xed\x19\x5d\xc7\x63\xbf\xd5\x32\x9b\x03\x72\xb5\xe2\xef\x4d\x93\x93\xf3\xd0\xc7\x1b\xfd\xfe\x88\xd2\x67\xd9\xd6\x14\xb0\x5e\x58\xf9\xe2\x9f\x04\x14\x7b\x56\x90\x59\x94\xc6\xee\xf4\x88\x2f\x6e\x30\x76\x7f\x1e\xc8\x97\x15\xef\xc2\x8a\x12\x0a\x39\x29\x01\x79\xf9\x05\x47\x8d\x04\x36\x2f\x36\x33\x2e\x3e\x98\xb9\xae\xef\x82\x86\xbd\xd4\xd1\x68\x3d\xe5\xba\xb2\xb8\x12\xe8\x47\xdc\xf7\x73\xf7\xf8\xec\x39\xdf\x04\xfe\x00\xa4\x78\x49\xfa\xc3\xad\xd0\x33\x71\xaf\x3d\x75\xad\xd2\x06\x43\xe2\xeb\xa2\xd2\x12\x23\x08\x7e\x9c\xd6\x9b\x1f\x55\xfe\x60\x79\x2a\x62\x3a\x82\xb2\x75\x7c\xa7\x75\x7d\x33\x82\x63\xbb\xd3\x25\x2b\x26\x23\x0b\x06\xbc\x11\xb5\x17\x70\xfd\x1e\xf6\x34\x8f\x34\x1d\x1f\x08\xb7\x13\xd6\x87\xdc\xa4\x48\x50\x54\x93\x30\x71\xa1\x5a\x5b\xb9\x52\x4d\x4c\x69\xd4\x93\x63\x63\x0c\xa5\x31\x84\x98\xf3\x37\xa6\xce\x5b\x9b\xa1\xd6\x74\x0e\x66\x9d\x88\x13\x17\x16\x56\xbb\x9c\x45\x91\xbf\x9f\x0d\x0a\xb2\x5a\x3b\x3d\x55\xdb\x12\x43\x74\xcf\x9d\xe8\x8a\xb5\x31\x6f\xf2\x40\x4e\x54\x94\x93\x78\x4b\xe0\x89\x8f\x2c\x9a\x7c\x68\xdb\x16\xd1\xb6\x30\xd4\x7b\xe7\xde\x79\xc5\x73\x0c\x4b\x27\xf7\x4c\xd5\x24\xb0\x8e\x3b\xeb\x49\xa6\xba\x25\x69\x05\xfc\xc5\x11\xe2\x24\x2a\x09\x3c\xc9\x51\x65\x37\xfb\x3a\x67\xa1\x99\x6b\xb8\x61\x86\x3d\xc5\x23\x51\x3f\xe8\x37\x8e\x40\x35\x39\xd0\x9c\xf4\x7b\xb3\xa0\xee\x29\x86\x5f\x78\x0d\x22\xff\x14\xc3\x2f\x8a\x1f\x85\x61\xb9\xeb\xa2\x5a\xd8\xcc\x98\xa6\xb3\x35\x31\xed\x21\xac\xa8\x36\x33\xb3\x79\xf4\xaf\x55\xfc\x78\x9b\x7f\xc4\x3f\x1c\xe0\x7a\x22\xc0\xcb\x0c\x4e\xe9\x9e\x79\x7e\x10\x81\xe5\xaa\x61\xb5\x06\xe6\xab\x05\x5d\xd3\x60\xe5\xdf\x0f\x33\x39\xf0\x7f\x75\x69\x6a\x20\x30\x5b\x94\xf3\x4f\x26\xe7\x29\xfe\xf5\xe3\xb4\x8c\xaf\x1d\xc5\xfe\xac\x67\x37\xfb\xe5\x68\x31\x47\x35\x03\xb2\xfd\x43\x9b\x43\xf0\x9f\x8f\x76\x1e\x6b\x53\x0b\x9b\xc4\x11\x44\xb8\xd3\x5d\x2a\xeb\x54\x4e\x5b\x5c\x3e\xa2\x19\xac\x1f\x25\x91\xe1\xf0\xbd\xec\xfd\xd8\xf8\xbd\x6f\xe3\xf5\x38\x43\x7b\x07\x67\xb2\xbd\x89\xba\x0a\x9d\xb0\x72\x84\x2c\xfd\x96\x7a\xbf\xf2\xef\xc5\xd9\xfc\x79\xef\xb8\x2e\x5f\x51\xb8\x0d\xd3\xd1\x8d\xea\x64\xe8\x2e\x5b\x45\xe4\xbb\xb2\x78\x45\x56\x7f\xc1\x44\xc5\xe2\xa9\x93\x14\x5d\x85\x4b\xcd\xb2\x61\xb1\x81\x8f\xf2\x2d\x94\x95\x71\x63\xe0\xe2\x34\x34\x76\x0b\x77\xad\xbb\xae\x42\x2b\xe0\x9f\x22\x01\x45\x85\x7b\xcf\x3c\x52\x16\x5f\x24\x60\xc2\x4e\x94\x75\x4c\xc6\x0b\x8a\x87\x24\x44\x88\x84\x4f\x77\xba\x44\xf6\xd2\xf5\x94\x28\x1a\xbc\x35\xf8\xd0\x9b\xef\xdb\x05\x53\x4d\x75\x11\xe3\x83\x5a\x63\xd4\x51\xaa\xf6\x79\x91\xa8\x89\x68\x51\x61\xb5\xd3\x4d\x5a\x32\x1a\xab\xf1\xde\x0b\x2f\x8d\x8c\x45\x9f\x0e\x51\x1c\x54\x99\x38\x9a\xc4\xb0\x81\xb6\xd9\xd5\x4d\x91\xad\xcc\xa1\x3f\x8d\xef\xb7\xfd\x7a\x89\xe4\xa0\x20\x7f\xf6\xf4\xc2\xb9\xfb\x03\xf0\xa9\xc5\x2b\xe7\xdf\x9c\x57\xd3\x6e\xb2\x96\xb1\x2d\x84\x12\xe7\x57\xcf\xd1\xcf\xb9\xbf\x59\x44\x6c\x55\xab\xe3\xdd\x05\x07\x01\x72\x64\x10\x8d\xbb\x55\xa3\x09\xdf\x59\x5c\xbd\xe8\x7a\x9c\x4f\x1b\x0f\xe1\x9b\x35\x3a\xda\x4d\xe7\x54\xcf\xaf\x82\x4b\x2b\xf0\xa9\x81\xfb\xe7\x1e\x3e\xe6\xf6\xf0\xf2\xc5\xdb\x50\xa5\xae\x5e\xd9\xa8\xb0\x4a\xd5\x69\x6f\xb0\x20\x58\xfa\xd9\x2d\x43\x57\x87\x5f\x57\xdb\xa5\xe9\xb5\xd4\x99\xe9\x59\x31\x6d\x54\xbb\x53\xf7\x60\xe8\x8f\xea\xe8\x0b\xfb\xcd\xdc\x9d\x8b\x2c\xe4\x3e\xca\xe6\xab\x3a\x1e\x6c\x17\x1f\x0a\x2a\x1b\x9b\xaf\x93\xdd\x9e\xd9\xba\x2a\xb7\xa0\xb0\x2a\xfe\xe4\x21\xee\xba\xc2\xab\x99\xd7\x2d\xb2\x97\x9f\x43\x2d\x28\xc8\xd9\x2f\xd0\x31\x30\xa0\x12\x34\xe2\xba\x02\xcb\x28\xea\x46\x5c\x85\x49\x06\xa4\xe2\x3d\xa5\x3d\x86\xa5\x48\x33\xa2\xbe\x65\xb7\x0e\xb9\x3a\xf7\x6d\xeb\xfe\xe2\xde\xab\x31\x05\x67\x17\x37\x47\x53\x17\x1f\x6f\x9e\x55\xcd\xdd\x08\x33\x54\x48\x43\xea\xdc\xed\x33\x29\xe9\x59\x45\xa5\xb9\xf9\x7e\xf5\xb7\xee\xb0\x9b\x4a\x4f\xee\x14\xc5\x65\x75\x91\xe3\x13\x75\xd1\xa5\x15\x6f\x8a\x8a\x6a\x56\xc3\xdc\xb8\x4f\x7d\x61\x11\x29\x74\x22\x93\x62\x43\xe6\x46\xa2\xc3\xc3\x92\x59\xab\xc4\xc6\x85\xcc\xdc\x88\x0a\xe7\xc0\xf0\x0f\x4a\xf7\xa0\x12\x05\xe3\x41\x8e\x9d\xdd\x1e\x34\x8a\x32\x1a\x30\x12\xc7\x8b\x3b\x0c\x38\x44\x39\x0c\x22\xe2\x66\xad\xa2\xd2\x04\x65\x10\x32\x33\x88\x6f\x08\x49\xf2\xf8\xeb\x8b\xcd\xb4\xdc\x80\xab\x83\xa2\x53\x9f\x20\xf9\xa5\xdb\x58\xe0\x96\xe6\x99\xc6\x89\xb2\x32\x32\x49\x77\x64\xfb\x5f\x0e\xa5\x57\xb1\x86\x9b\x19\x79\x81\x57\x7a\x25\x1f\x7e\xf8\x2e\xfc\x7a\x16\xe7\x9a\xc8\x0a\x09\x8b\xb3\x25\x92\xc3\xc8\x11\x3e\x13\xa1\x1e\xb5\x0f\x23\xad\x0e\xfb\x99\x53\xac\x92\x0e\x3e\x37\x75\x54\xf6\x30\xa4\xdb\xc5\x1c\xcc\xe4\x5a\x72\xd6\xb2\x21\x19\x18\x5a\x5b\x05\x5b\x93\x0d\xf5\xec\x2c\xd6\xb5\x34\xed\xc8\x7a\x04\x07\x92\x96\x86\x8d\x55\xb2\xb5\x95\xa2\xf9\xbc\x53\xed\x40\xb3\xf1\xf5\xc5\xe1\x88\x81\x91\xc5\x86\xd6\xbe\xb6\xaa\xd3\xde\xee\x14\x1a\xc3\x3f\x17\x70\x92\x62\x02\x72\x19\x6e\x6e\x0e\xbe\xed\xd9\x1d\x55\xb2\x22\x3d\xad\x8d\xa8\xd6\x7a\x05\xa7\x3a\x05\x54\x87\xb1\x68\x77\x8d\x82\x23\xaa\x73\xee\xaa\x9b\xd5\x54\xba\x99\xfe\x43\x61\xee\xce\x0d\x3a\xe9\xd6\xe8\x38\x69\xfa\x9a\x5b\xb9\xfb\x58\x5d\xb0\x8d\x63\x72\x6c\x66\x22\x33\xac\x33\xbe\x3f\x50\x3d\x2d\xda\x84\xa4\xa6\xd2\x27\x18\x3c\x90\x9e\x9f\x57\x5f\x9a\xa3\xef\x23\xe5\xe0\x95\x71\x88\xba\xaf\x2b\x9f\x65\x0d\x9c\xf5\x54\x69\xa1\xfa\x22\xb1\x33\x88\xa4\x5e\x33\x69\xe7\x47\x1a\x9f\xdd\x11\x75\x7b\x13\xfe\x4d\x3c\xa1\x3b\xd6\x50\xa4\x6a\xfa\xf3\x53\x39\x1c\x83\x7b\x2a\x61\x6d\x48\xb3\x6a\x54\xfd\x78\x93\x8d\x89\xa9\xed\x99\x9c\x7c\x2b\x4f\x23\x0d\x0b\x0b\x02\x03\x14\x17\x6b\xad\xf1\x3d\xe9\x7c\x22\xc2\xfd\x76\x28\xb2\xb9\x39\x2c\xb4\xa1\x69\xbd\xe1\x74\x28\xbb\xf5\xb4\x30\x8f\x26\xd1\x42\x53\x83\x68\xcd\xb2\xb4\x0e\x26\x59\x1e\x3f\x42\x1d\x20\xed\x91\xd8\x7f\xd9\xf6\x7b\xf6\xae\x7f\x45\x6f\x21\xbc\x77\xbc\x8d\x86\xeb\xd8\x85\xf2\x80\xa3\x42\x95\x70\xf2\x52\xeb\x3f\x7e\x2f\xba\xe7\x4c\x5e\x65\x53\x7a\x9c\x85\x65\x54\x88\x4b\x45\xa4\x97\x9b\x08\x86\x43\x89\xa3\x29\x15\xa8\x1e\x19\x90\xbb\x1b\xd8\x95\x98\x55\xde\x13\x1b\x12\x97\xab\x5a\x75\xa8\x94\x79\x31\xb5\xb2\xa4\x37\x3b\xf2\x9e\xa2\x99\x8a\x86\x3e\x51\x89\x91\x00\x18\x65\xa2\x1d\x40\x0f\xa3\xb9\x53\x20\x3d\x49\x08\x4f\x75\x88\xf5\xab\xd2\x0e\x3e\x92\xa5\x11\xe4\x32\xa3\xd1\xf2\x03\x2d\xf1\x97\x8f\x4f\x17\x81\x43\x21\x8f\xc3\x3a\xcf\xdf\xe5\x25\xde\x7f\xeb\x47\x00\xd7\xd0\xd1\x13\x97\xcd\xdd\x9d\x4b\x18\xce\x85\x1e\x4e\x16\x24\xba\x8b\x24\x0d\xd9\x5d\x25\x7d\x32\xec\x62\x5a\x07\xb2\x83\xe3\x78\xc4\x54\xde\x0a\x21\x5f\x72\x5c\xf8\xf4\x9d\x7d\xd7\x0f\x35\xc4\xc4\xa3\x8d\xb9\xfd\x7d\x9a\x46\x26\xb4\x46\xc7\x3b\x48\x8d\xd7\x26\xce\x34\xdc\xb8\xd9\x3a\x80\xf0\x65\x84\x3a\xd8\xd1\x43\x80\xb7\x83\xcf\xfb\x9d\xb3\xb7\x63\xb0\x03\xd5\x51\xa7\x32\x73\x0c\x5a\x1b\x2a\x74\x2c\x65\xc9\xf8\x33\x75\xb6\x74\x37\xc8\x01\x07\x8d\xee\x2d\xcf\x2f\xed\xcc\x5d\x6f\xac\xad\xf5\x66\xc8\x1e\xf0\x81\x68\x4a\xaa\x7b\xe3\x7e\x71\x8a\x2e\x03\x19\x82\x36\x23\x1d\x36\x54\xd5\xfd\x43\x3f\x76\x5d\x84\x7c\xbf\xed\xee\x0a\x17\xb0\x6c\x21\x37\x6d\x09\xdd\x38\xe3\x68\x35\x1d\xd5\xea\xee\x3c\xae\xa5\xa2\x75\xb3\xf9\xf2\x2a\x9a\xc7\x35\x75\xa8\x94\x1e\x95\x61\x5b\xa6\xb9\x53\xd9\xf5\x92\xbd\xc9\x89\x60\xdd\x27\x3f\x9b\x7a\x71\xb0\xd0\x91\x9c\x74\x2d\x67\xf5\xdc\x2d\x2a\x21\x5d\xaf\x4f\x85\x33\xf2\x85\xa4\x1f\xd2\x0f\x3a\x20\xf1\x88\x9a\xfd\xc1\x97\x36\xcc\x95\x3d\x6a\x5a\x76\xc1\x2a\x55\x3a\x60\x88\x7f\xbd\x19\xbc\x0f\x4b\xbb\x93\x3b\x87\xf9\xfc\x01\xf1\x49\x01\xe7\xa1\xff\x89\x61\x3f\x0f\xbf\x8c\xc9\x88\x99\x4c\x8d\x0b\x87\x5c\xbb\x70\xb6\xcf\xbe\xbf\x5b\x1d\x5d\x9f\xea\x65\xb3\xfa\x6d\xf7\xeb\xdb\xb9\xda\x56\xe7\x54\x9e\x5d\x9b\x5c\x71\x3c\xa3\x10\xa7\xf9\xd6\x47\x3d\xc1\x9e\x4a\x32\x52\x26\xba\x38\x9a\x54\xc5\x55\x7b\x87\x0e\xdb\x5b\xd0\x28\xc4\x46\xc4\x22\xcd\xf6\x15\xe6\x7b\xe1\x49\x23\xe2\x1d\xdb\x94\xab\x4b\x67\x9b\xcf\x7c\xef\xfd\x04\xba\x74\x6d\x1c\x9c\x6d\x29\x9e\x16\x1a\x58\xd1\x2a\xc4\xf5\x07\x0b\x51\xa1\x29\xe1\xd3\xd0\xe2\x1e\x72\xf3\xcc\x69\xbe\xa8\xc2\x84\x18\xdb\x4c\x05\x17\xbc\xcd\x75\x92\xf6\xb2\x91\x06\x67\x51\x35\x0a\xef\x7b\xa3\xcd\x13\x84\x51\x0d\x74\x1c\x4c\x55\xd5\xda\xe8\xec\x48\x01\x76\xaa\xac\xa7\x4b\xc0\x63\x4d\x31\xa9\x7f\x5c\x20\xcf\xaa\x2f\x5b\xe4\x5d\x75\x45\xd9\x5a\x6a\xfa\xab\xfc\x8d\xaf\x7c\xf7\xf2\x43\xac\x55\x6a\xa2\x39\x23\x09\xb6\x11\x35\xde\x51\x96\x15\x07\xe8\x2f\xdf\x1d\x3f\x9b\xd5\xc3\xb6\x89\xe8\x7d\x62\x46\xa9\x88\x88\xac\xcc\xe0\xc4\x95\xf8\x06\x25\x0c\x37\xb8\x12\xd5\xf4\x10\xeb\x88\x56\x05\x22\x9d\x4a\xb2\x64\x50\xcc\xcd\xdc\x28\x56\xd6\xae\xd4\xed\xa2\x83\xf6\x08\xa9\x17\xe0\x24\x6b\x5e\xd2\x1c\x38\x3f\x5d\x69\xf1\x28\x59\x6b\xdb\xcb\x41\xca\xb2\xe2\xab\xbb\x70\xaf\x1c\xab\x9b\x79\xc8\x16\x8e\x36\x38\x1a\xd4\x1d\xa9\x89\xdc\xe7\xf8\x5f\xb8\x43\x8f\x9d\xfe\x40\xb3\x4f\x8f\x99\x75\xfd\x38\x7f\x15\x01\x77\x93\xa2\xd2\x63\x61\xc4\xd4\xcb\xfd\xdc\x58\xe4\xcd\x5f\x56\xb6\x66\xc0\xa8\x66\x70\xee\x7d\xff\x4e\x1c\x01\x27\x17\x63\x0c\x62\x3b\xd0\x09\x03\xe8\x93\xb5\x7c\xb0\x2e\x83\xfe\x6a\x14\xa6\x6a\x28\xa9\x03\x1d\xfd\xb9\xd9\xdd\x68\xc7\x28\xb7\x95\xec\x2d\xe7\x19\x24\xb0\x1b\x2e\x48\xab\x6e\xbd\x0c\xfa\x34\x87\x3a\xae\x95\x53\x0e\x34\x27\xfc\xda\x28\xac\xdb\x38\x51\x99\x53\xbd\x0c\x2d\x7f\x85\x76\x1b\x4a\x84\x39\x99\xc9\x41\xce\x39\x58\x47\xbc\xdd\x55\x92\xee\x63\xe3\xb8\xad\x13\x95\x05\x95\xf3\x98\x23\x70\x2c\x77\x3d\xa7\x85\xcd\x8a\x68\x3a\x77\x2a\xaa\x95\xc5\x8c\x68\x25\x0c\xb5\xd9\xfa\x44\x08\xb3\xc2\xa5\x7d\x18\x3e\x25\xda\xd1\x9b\x02\xeb\xa7\xca\x74\xa1\xae\xa2\x0e\xa2\xbb\x74\xb9\x63\x8e\xb9\x64\xc4\x7a\x30\x32\xa3\x69\xce\x69\x51\x5e\x3e\x29\x31\x91\x14\x7c\x83\x7f\x03\x5e\x79\xc0\x77\xe0\x88\x56\xc0\x97\x88\xac\x5a\xaf\x03\x4d\x41\xda\xcf\x64\x94\x20\xe5\x4e\xde\xf0\xcc\x7a\x2f\x79\xbb\x38\xdd\xa7\x52\x4a\x58\x65\x63\x91\x75\xc5\x3c\x3b\xe7\x3c\xd8\x89\xce\xb5\x6a\xcf\xe0\x45\x9c\x80\xd2\x5e\x31\x65\x2e\xac\xb4\x7b\xbd\x9e\xee\xf7\x10\x37\xab\x72\x6e\xaf\x6d\x0a\xac\xe2\xc2\x7b\xd0\x0e\x68\xbe\x77\xd1\x0f\x88\x25\x76\xb6\x39\xb0\x86\x47\xef\xc1\x14\xf0\xf6\x78\xf2\x9f\xc4\x78\xe7\x0a\x58\xf9\xca\xfe\x98\x83\xbc\xfc\x1f\x2b\xe4\xfe\x0d\xce\x4a\x09\x66\xa7\x27\x41\xfe\x47\x83\x32\x93\x59\xcc\xec\xa4\x10\x71\x5b\xfe\x0f\x25\x57\x25\x72\xd0\x0e\x5f\x45\xa1\xce\xd7\x67\x4d\xae\x7d\x28\xf9\x50\x8a\xea\x61\xbf\xb4\xb2\x5d\xbc\x68\xed\x55\x84\x3d\xe2\xe4\xc9\x8a\x7a\xa6\x93\xbc\xd2\x5e\x92\x6c\x36\xb7\x9a\x34\xf7\xef\xee\xda\xee\xe4\xdc\xd9\x5e\xd4\x6f\x7d\x71\x24\x33\x53\x5e\xe9\x93\x87\x82\xbb\x8c\x34\xd6\xb6\xd6\x3e\xd9\xbe\xd4\x48\xd1\xc9\x6b\xd7\x4b\xe4\x42\x1f\x71\x83\xd5\xa0\xdb\xdf\x9e\xbd\xce\x1b\x23\x1c\x7e\xef\x23\x1b\x82\x32\x9c\x3b\x8f\x5f\x5a\x5c\x0a\xcc\x7b\x4a\x97\x26\x4b\xc3\x1c\xeb\xcc\x6b\x35\xd9\x49\xee\x39\xf2\x8a\x92\x6b\x73\x82\xae\x6d\xcc\x2d\xd6\x50\x14\x00\xd7\x57\x45\x06\xea\x68\xf2\x78\x34\x2f\x1c\x03\x9f\x30\xd4\xd2\xd9\xb7\xe9\x74\x90\x39\xad\x93\xd6\x78\xb7\x91\xce\x9f\xeb\xff\xfe\x5e\xd8\xbd\x96\xc5\x25\x72\x53\xe6\xf0\xa5\xe1\xb8\xa1\x66\x1a\xb3\x8c\xa7\xe5\xe5\xe7\xae\x6c\x3f\x21\x9e\xd5\xaa\xcc\x7e\xea\x83\xe6\x55\xb1\x80\x00\x7a\xe2\x90\xf0\xce\xdd\x68\xf1\xb2\x4d\xe0\xc0\x95\x03\x79\x79\xf1\x22\xce\xea\x0a\xcc\x6d\x70\x80\x68\x00\x4e\x22\xe5\x3f\x7c\xcf\xc1\x84\x7a\xdf\x49\x21\x01\x6a\x2e\x01\x4b\xaa\xfb\x12\x98\x20\x97\xaf\x42\xbf\xfa\x3d\xb4\xbe\x4a\xd4\x4b\x69\xc9\x8b\x5a\x4b\x8d\x4e\x97\x02\x9e\xc2\x0d\xda\x7b\x80\x17\x3e\xed\x2b\x1e\x51\xd9\xdd\xda\x80\x11\xad\x83\xea\x5f\x63\x13\xda\xc7\x06\xc8\xd6\x46\xb9\xd6\xb3\xd0\xf8\x35\x9b\xcf\x2f\x97\x8d\xfd\x28\x81\xef\x83\x4b\xaa\x38\x13\xfa\x2a\xca\xcb\x64\xbf\xca\xf3\x50\x65\x31\x01\x13\x2e\x00\x83\x46\x02\x11\xa0\x2e\x69\x7f\xb9\x86\x58\xc2\x90\x56\xc1\x32\xa6\x26\x2d\x4b\xdc\x98\x56\x66\xaa\x68\x3c\x95\xe5\x11\x7f\x42\x69\x79\x95\x2a\xe1\xa0\x6d\x4a\x42\x4d\xe6\x3f\xba\x1f\xae\x21\x5a\x2e\xba\xcc\x32\xa6\x4e\x58\x96\xc8\x1e\xce\xd2\xd8\xfd\x98\x25\x31\xb9\x96\x31\x08\x96\x25\xd1\x18\xce\x8a\xfd\x6f\xc1\x50\xb3\x31\xba\x69\xa8\xbb\x16\x95\x8b\xab\x7b\xe7\x69\x16\xef\x6d\x64\xf1\x2e\xaa\x64\x89\xae\x1b\xba\xf6\x7b\x7f\x16\xb2\x78\x07\x90\xc5\xdb\x89\x2c\x6c\x02\x65\x03\x47\x31\x0c\x73\x0d\x2c\xd3\xeb\x86\x39\xca\xda\x6c\x68\x8c\xe7\xc6\xe8\xce\xb2\x9b\xff\x7d\xac\x87\xd2\x83\xa5\xe6\x79\x19\x96\x1e\x8e\x9a\x30\x72\x50\x27\x7e\x00\x0c\x6b\x6d\xa8\x00\x5c\x5f\xc9\x45\x9a\x62\xdd\x32\x4e\xf8\xb6\x1e\x9d\xbd\x60\x68\x4c\xe9\x02\xc3\x23\x9f\x62\x58\x16\x66\x80\x22\xc5\xae\xc8\x73\x83\xea\x4a\xb4\x3e\x4e\x2d\x3b\x50\x04\xcf\xfd\xd1\x9d\x65\x2f\xfe\x9b\xac\xb7\x30\xdc\x54\x7d\x35\x0a\x59\xca\x36\x40\xf0\xdc\x1e\xa5\x58\x2f\x0d\x0f\x89\x11\x49\x0c\x71\xab\x57\xc0\xbb\x48\x73\xd3\x77\x69\x84\x81\x03\x16\x94\x16\xf2\x49\x7f\xa4\xe4\x6b\x4d\x36\x06\xdd\xa1\x02\x3a\x9f\x7c\x73\x5b\x29\xd3\x34\xa7\x39\x4b\x9e\xb3\x53\x2f\x00\xe8\x8e\xee\x7a\x38\x91\xaf\xf7\x62\x37\xc4\x13\x18\xcf\xc0\x75\x4d\x6d\x3b\xb5\xb6\x31\x16\x1a\xc9\x6e\xfe\x93\x39\x4b\x72\xb8\x90\x8a\x48\xb3\x57\x57\xc7\x00\xac\x71\x08\xbd\xed\x58\xd7\x2f\xac\x7e\xd7\xe6\x7e\x2b\x1e\x0f\xe8\xf6\x9c\x68\x21\xae\x1e\xe7\xfb\xe7\x94\x72\xf9\xbf\x83\xf8\xdd\x4d\xca\x04\xda\xf6\x13\x5d\xb2\xaf\xe0\xff\xab\x40\x79\xb6\xb8\xb5\x0a\xa8\x37\x93\xcc\x20\xbc\x1f\xf6\x4d\xdc\x73\x12\x94\xa9\x74\x8b\xea\xde\xe6\x92\xdd\x4e\xd9\x54\x0b\x4c\x13\xad\xe4\x03\xd1\xc9\xf6\xa0\x05\x64\x19\x84\x01\xed\x47\x48\xbc\x06\x24\x12\x60\x19\xd7\x3f\x63\x11\xc7\xbb\x31\xfd\xbf\x03\xff\xd6\xec\x3e\x39\x77\x3f\x9a\xfc\x01\xe8\xc7\xee\xc5\x74\x01\xe5\xde\xbd\x27\x17\xa8\x17\x46\x32\x67\xe0\xb5\xb0\xee\xd8\xab\xf6\x2d\x4c\xa0\xcd\xac\x1b\x9a\x5e\x83\xd9\x18\x40\x13\x21\xd3\x30\xb8\x20\x0c\x77\x86\x30\x08\x1f\xb6\x00\x44\x80\x8a\xef\x3d\xc4\xfc\x2b\x57\x4d\x38\x96\xc8\x74\xf9\x74\x05\xd1\x2d\xa8\x3d\x59\x08\x14\x7b\x27\xb2\x38\x26\xa2\x72\x27\x3a\x69\x97\x62\xc5\xbb\x11\xe0\xd2\xb6\xaa\x30\xb4\x2f\x00\xd8\x58\x35\x43\x9a\xa0\x50\x47\xf5\xa7\x95\xe5\xfa\x71\xe6\xff\xb1\x2f\x06\x09\xc4\xf5\x96\x35\x35\x28\x0f\xa0\x94\x34\x3a\xda\x8f\x08\x41\xab\x90\x84\x3a\x6f\xce\x80\xd9\x70\xb5\x43\x9b\xb0\x30\x94\xc2\x02\x71\x29\xde\xc2\x2e\xd2\x29\x63\x27\x67\x7c\x32\x72\x58\x14\x37\x01\xc0\xec\x08\x78\x04\x4c\x8f\xa1\xf2\x2f\xba\x69\x0a\x53\x90\x80\x64\x1b\x09\x50\x0f\x21\x58\x53\xd2\x27\xbb\x94\xf3\xf9\x96\x27\x9c\xd4\x36\x02\x6e\xe1\x76\x56\xb2\x55\xdb\x75\x27\x81\x42\xc6\x66\xb5\xd0\x70\xe3\x8d\xf4\x10\xd8\xdd\x1e\xbc\x05\x57\xf2\x46\x61\xcb\x16\x9e\xab\x51\x53\xca\xdb\x5b\xa7\x16\x6f\x2d\x46\x9b\x83\x52\xb0\x7d\x2d\x4f\x15\x5e\x93\xb7\xf4\x3c\x3c\xc0\x03\xde\x91\x67\x68\xa1\x69\x0c\xcd\xf4\x50\x98\x0b\xfa\x61\x7b\xb2\x2e\x30\xf5\xb9\x56\x3d\x7c\x82\x2f\x42\xf3\xaa\xbf\xb4\xcb\xbc\x24\x8a\x8c\x53\x7b\x40\x95\x50\xb0\x96\xd7\xf7\x81\x12\xea\xe1\x8c\x69\xb9\x1a\x8f\x8c\x3e\xb0\x2f\x95\xec\x86\x3e\x02\x05\x2f\x5b\xac\xe0\xc2\x73\x5d\x23\x5e\xa0\xaa\x5b\xc5\xac\x6f\xa6\x20\x50\x5c\x85\xcc\x59\x04\x21\x0a\xc7\x68\x64\x08\x71\x18\x95\x00\x85\x45\x15\x45\x0e\x36\x34\xc0\x4d\x14\x44\xa3\x4c\x61\x52\x0f\x45\xc9\x19\x62\x9f\x21\x20\x14\x8b\xd6\xa0\x5d\xc3\xfc\x44\x01\x58\x4e\x1f\x2d\x03\xfb\x03\x44\x87\xc3\x31\xcc\x95\xf1\xfe\x7d\x52\x10\xc0\x23\x1a\xc4\x5d\x54\xb6\xf4\xd0\x0f\xb3\x4f\x15\x4d\x20\x6b\xc3\xdc\x38\xe9\xd8\xc3\x00\x4a\x41\x36\x49\xf6\x01\xe5\xdd\x88\xcb\x63\xe2\xc2\x28\x8f\x49\xbd\x4d\x82\xa4\x6b\xa6\xa5\x63\x8c\x1e\x46\x84\xea\xbb\x6a\x5d\x8c\xd6\xd8\x20\xa3\x47\xab\xa9\x42\x9f\xf0\xb0\x8f\xf4\x26\x1e\xf2\xfd\x66\x60\x7e\xff\xc2\x1f\x72\xb0\xc8\x3d\x97\x62\x78\xe7\x8e\xd3\x9e\x7e\x00\xbf\xb9\xde\xf6\x50\x64\x6f\xe8\xc8\x97\xc0\xeb\x0f\x43\xd5\x46\xdd\x19\xe7\x95\xf6\x77\xd0\xcb\x77\xa0\xf0\xca\x24\x56\x80\x7b\x93\xb8\x46\xd1\xa3\x92\x2e\xae\x4b\x3a\x26\x80\x69\x65\x59\xeb\x59\x16\x21\x90\x2c\xac\x26\x9e\x5c\x17\xe2\x04\x86\x0e\x3a\x5c\xa6\x34\xaa\x08\xdf\x7c\x18\x82\x0e\x1f\x2c\x16\x35\xdd\xe3\x60\xdd\x4d\x95\xf3\x39\xe7\xd5\xc1\x42\x94\xbc\xbb\xe0\x23\x53\x1c\x44\x72\x54\x94\x1f\x01\x7b\x59\x12\x6e\x20\xe3\x5c\x16\x31\x61\x84\x54\x03\x75\x83\x30\xc1\x4b\xc8\x36\xd8\x86\xd3\xf6\x63\x03\x3a\x1d\x8d\x63\x79\xe0\x26\xb1\xa1\x48\x9f\x38\xd7\xfd\x88\xfb\x9e\xf9\x7d\xb7\xbe\x5f\xd3\xe7\xf8\xaf\xf3\x87\x53\x33\xa5\xcd\xa4\xcb\x6b\x92\xd6\xdd\xc9\x68\xfd\x1e\x58\x50\xd6\x01\x8f\xb5\x49\xa9\x77\xe6\x1f\x93\x1a\x28\xb7\x80\x09\xa6\x59\xeb\xba\x4b\xe9\xb0\x6e\x36\x63\x4f\x82\xe5\x13\xca\x47\x1c\xd5\x60\x7a\x1e\x83\x94\x2a\x81\x66\x11\x91\xca\xbe\x81\x20\x54\x81\x3e\x51\x1a\x40\xc8\x01\x4e\xe2\xca\x38\x5e\x85\xa9\x8f\x2d\x3f\x27\x34\x10\xf1\x81\xe8\xcc\xd9\x23\xc8\x7a\x4a\xb1\xa5\x3a\x94\x38\x9b\x4f\x2e\x90\x65\xf5\x1d\x65\xac\x7e\x78\x7c\x07\x3e\xc4\xf7\x50\xea\xb2\xaf\x53\x7f\xd4\xf0\x7d\x5c\x2b\xbc\x3a\xa2\x0f\x63\x78\xb9\x46\xde\x7b\x2e\xe0\x87\x5f\x88\x06\x1d\x22\xe1\xdf\xe0\xd4\x73\xa2\xfb\xf7\x47\xf5\xf0\x6b\x94\xb4\x89\x47\x1d\xbd\x2c\xe4\x11\x9e\x52\x79\x00\x05\x9b\x55\x7f\x40\xa2\xab\xcd\xc6\x34\x68\x4c\xa2\x63\xb7\x28\x97\xa2\xe4\x53\xcb\x2e\xe7\x0a\x88\x23\xd4\xfc\x61\x68\x65\x7a\xcc\x9f\xc6\x3c\xe5\x3e\xfc\x4e\xf5\x8e\x9f\xa6\x86\x48\x43\x49\xf7\x43\xfa\xe3\x37\x8f\x5f\xa9\x72\x48\x22\x22\x4f\x29\xb6\x04\xdc\x85\x46\x6e\x58\x97\x19\xd8\xfa\x0d\x4c\xdc\x2b\xb4\x4f\x48\xc7\x5a\x0a\xee\x15\xc0\x45\x0d\x58\x2c\x86\xbc\x28\x98\x00\xe7\xcc\x50\xaa\xc9\x5d\x34\x08\x0c\x02\xf8\xea\x81\x43\xe9\x35\x74\xef\xaa\x00\x93\xe0\x8c\x04\x5e\xe7\x1a\x76\x6b\x96\xe6\xf8\xbf\x2b\x0d\x8e\x19\x43\x56\x4f\x43\xb4\xb5\x4b\xa0\x6d\x97\xda\xb9\x6b\x9c\x0b\xb3\xc1\x56\xce\x1f\xa6\x1e\x8b\x1f\x0b\x1c\x4c\xc4\x4b\xcc\xff\x35\x51\xb5\xf1\xaa\xa8\xab\x86\x84\xef\x80\xd4\x41\xc7\xb8\xa8\x32\x77\xf2\xb1\xc7\xf3\x28\xb5\x55\xce\x10\x5b\x44\xbd\x0b\xcb\x43\x48\x1c\xcc\x1d\xf5\xc4\x6a\x70\x37\x8a\xaa\x72\x9c\xb7\x6c\x06\x39\x12\x9d\x17\x8b\xaf\x88\x56\x75\x87\x9a\x4e\xb5\x43\x7c\x59\xc4\xd9\x0a\xbf\xe1\xa8\x46\x33\x31\xb0\xff\xe2\x6c\xf4\xf9\x27\x3c\x9b\xe9\x1a\x9b\xa4\x40\x96\x02\x23\x32\x45\x50\x59\x1d\x3c\x60\x37\xde\xa0\x23\x14\x2d\xa4\x05\x20\xf4\xb4\x55\xcc\x85\x41\x13\x03\x82\xdd\x62\x04\xcd\x19\xcd\x6a\x33\xe6\x30\x5e\x4c\x05\xca\x6f\x08\x15\xa5\x96\x05\x32\x3e\xf3\x21\x00\xa5\x93\x3f\x00\xc4\xa0\xb6\x01\x67\x02\x2a\x5b\x1c\xd1\x99\x59\x5c\x90\x79\x83\x45\xa4\x88\x85\xcc\xc0\x0c\xcb\xf1\x72\x33\xea\x6b\x48\x87\x2b\xa1\x01\x9c\x22\x53\x8a\xae\xcf\xeb\x9b\x79\x50\xd0\x49\xf2\xf4\xac\xfb\x4e\xd8\x17\x5f\xc4\x41\x91\xe8\x98\x83\x1c\xb2\x8e\x61\x6d\x47\x20\x0f\x3c\x4f\x50\x85\x73\x50\x8d\x73\x88\x12\x06\xf1\xa2\x45\x05\x68\x12\x79\xca\x3b\x5e\xa6\x52\xfc\x3d\x3c\x6a\x41\x03\xf3\x8a\x9a\x23\x66\xcb\xb0\xa1\x49\xbb\x3d\x94\xa7\x33\xaf\x3e\x50\x14\x05\x99\x08\x14\x39\x56\xa9\xe3\xd7\xa4\x4a\x7a\x1c\xb9\xc9\x11\xe5\x09\x68\xad\xf9\xeb\x6e\xaa\x3e\x17\x1c\x81\x06\x68\xfa\xf4\x44\x5d\x15\xd9\xfd\x15\xe0\x33\x27\xe3\x2c\xd2\x6d\x0e\x12\x6e\x5a\xfe\xdf\x3e\x4c\xc8\xe3\xc2\x60\xa3\xce\x3d\x6d\x92\xb8\x9d\x12\x47\x85\xc1\xa3\x30\x17\xa6\xd1\xbd\x92\xd0\xcd\xb5\xff\xd6\xb3\x1e\x0e\xc6\xd4\x7b\x0e\x78\xb4\x5b\x1f\x38\xda\xc2\xdc\x35\x1b\x9d\xa9\x15\x9f\x20\x79\x42\x75\x33\x85\x33\xab\x3c\x9a\xc7\x04\x32\x2c\xf5\x92\xd4\x0a\x20\x9d\xc3\x0c\x1b\xec\xab\x19\xcb\xd5\x89\x16\x3b\x4b\x60\x14\xba\x69\x7a\xa8\x73\x13\x19\x95\x75\xce\x34\x76\x71\x5c\x62\xe3\x77\x86\xac\x4d\xc6\x2e\x32\xd3\x27\xdc\x56\x2a\xb4\x6a\xcd\x59\x0e\x79\x38\xec\x20\xf9\xf5\xbc\x34\x0a\xe6\x21\x78\x79\x64\xde\xb9\x19\x73\x95\x65\x79\x51\xe3\xce\x52\xe4\x37\x6f\xbd\x3e\xe5\x23\x0b\xd2\x17\x94\x1a\xf5\x95\xae\xa2\xad\x74\xfc\x9e\xb3\x30\x0a\x9f\xc6\x1f\x3b\x6f\x95\x87\xc6\x5c\x86\xad\x80\x89\x37\xac\x46\xca\x35\x64\x72\x39\x4c\x68\xf0\xe6\x9e\x84\x25\xa6\x0b\x96\x8e\x71\x1c\x03\x1e\x7b\x00\xd7\x66\x58\x28\x84\x75\x3b\x49\x94\xfa\x49\xb9\x3a\x58\x7e\x2e\x5c\x01\xab\x20\x4b\x36\x54\x43\xb0\x11\x1b\xea\x45\xfa\x54\x59\xdd\x43\xe8\x2a\x6b\x7d\x32\x48\x23\x60\x80\x6e\x78\xe5\x0d\xad\x1f\x43\x02\xfd\x7b\x6a\xe4\x9c\x9d\x81\x8a\x02\xeb\x27\x50\xb3\x29\x97\x14\x03\xe2\x2a\x48\x41\x86\xb2\x44\x27\xce\x30\xeb\x5e\xca\xcb\x3b\x2d\x3a\x4d\xd3\x43\x2d\x2e\x69\x2d\x18\xc2\x33\xf5\xa8\x0f\x3a\xea\x96\xf5\x7d\x53\x5f\x42\x42\x61\x89\xe7\x16\x61\xbe\x4c\xac\xca\xa8\x48\xbb\x36\x4b\x3e\x16\x03\x11\x74\xdb\x26\xa2\x0d\xf9\xa5\xca\xac\x17\xf2\x07\x2c\xc3\xcb\x36\x47\x90\x8c\x35\x4b\x86\x83\x08\x08\xaf\x37\x5c\x62\xd8\x64\x8f\x73\x9f\xfc\x20\x9a\xb1\x6f\x52\x66\xf2\x60\xdb\x09\xc6\x36\x43\x73\x9a\x12\xb1\x89\xf2\x4a\xa9\xe6\x38\xe1\xa0\xd1\x3c\xf3\x12\x59\x2c\x6f\x4f\x2b\x79\xe3\xc4\xb3\x9d\x20\x69\x6f\x35\x53\xac\xb2\xd4\x78\x21\xba\xaa\x98\x07\x2a\x66\x7d\xb8\x00\x49\x21\xfd\xca\x7b\x43\xb3\xa4\xac\xe7\x70\xab\xa8\x01\x0b\x2d\x5a\x13\x2a\xb8\xcf\x87\x2a\x87\x60\xe2\x94\x7a\xd5\x26\x15\xae\xd0\xef\x2f\x69\x0f\xa9\x6d\xb9\x4a\x56\x2a\x75\x53\x4d\xb4\xcf\xa8\xaf\xd1\xc1\x04\x54\x72\x3e\x0d\xfa\x81\x3d\x7a\xac\x17\x99\x25\xc2\x4f\x32\x93\x52\xbc\x22\x36\x56\xb4\xfd\x30\x30\x84\x87\x0a\x45\x1c\xf5\xb4\x00\x04\xe9\x01\x67\x3a\x12\x62\xee\xca\x43\xf4\x1e\x41\x5d\x7b\xa2\x11\x9c\x01\x54\x86\xad\xda\xd6\xa8\x70\x67\x3c\xbf\x25\xad\x3c\x89\xb5\x39\x1c\xae\x5b\x94\x61\x6b\x61\xb1\x66\xb5\x8d\x5f\xc4\x38\x9e\x28\xef\x52\x35\xf1\xcf\xc2\x86\x5b\xe5\x1a\xd8\xf2\x06\xe4\xec\x42\x2a\xcb\xd2\x06\xbf\x47\x60\x09\xa2\xbc\x73\x83\x5b\x38\x65\x1b\x6f\x2d\xb3\xcb\x2d\x24\x69\x73\xf1\xc1\xc9\x81\x21\x99\x46\x1f\xa5\x27\xd2\x74\xeb\xed\x6c\xd6\x05\xae\x2f\x89\x94\x6f\x75\xc8\xe7\xe4\x73\xf0\xba\x13\x61\x1c\x9d\x92\x0d\x3d\x02\x0e\x50\xf2\xec\xb4\x15\x6c\x76\x05\xfb\xc1\x02\x93\x9d\x2b\xb6\xe7\x48\x1e\x4e\x66\x25\x96\xcb\x10\xf2\x1e\x67\x35\xa0\xc9\x3b\x16\x9b\x48\xe3\x10\x92\x79\xbb\xe4\x26\x4c\x61\x69\x52\xe2\x9a\xe3\xcc\xb6\x89\x23\x2c\xcd\x60\xa8\x47\x0b\x59\x16\x01\x86\xd9\x2a\xc9\x0e\x27\xeb\x19\x54\x13\x52\xbe\x04\xf5\x56\x27\xa7\x1b\xa5\xa3\x90\x45\x0e\x16\xf7\xe4\x59\xc0\x08\x08\xa1\x37\x15\x27\x90\xaa\x02\x1f\x2f\x4a\x27\x63\xa6\xa2\xae\x66\x1e\x7f\x2a\x9e\x94\xa2\xe4\xb7\xb7\xa9\xbe\x7c\xd0\xe2\x11\x25\x4c\x31\xed\x0b\xbc\x2e\x1a\x9f\xee\x94\x5f\x4b\x14\xb4\xd8\x0a\xc7\x1d\x85\xf5\xf4\xd5\xe9\xf6\x19\x98\xe0\x7b\x9f\x60\x3c\x87\x5b\x58\xaf\x62\x20\x27\xbc\x32\xda\xf3\x89\xed\x73\x68\x9a\x53\x8c\xb5\x6a\x5e\xd6\x1d\x11\xb8\x78\x4e\xe7\xaf\xdc\x9e\xaf\xc6\x7a\xf3\x77\xc7\x0b\x7c\xf6\x03\x24\xf5\x15\x07\x34\x7b\xa6\x55\xa9\xf4\x0d\x9f\xd4\xe7\x9b\x27\x25\x33\x53\xa9\x31\x5e\x59\x07\xb8\x2e\x5b\x27\xf8\x8a\xbd\x32\xb8\xc9\xab\xeb\x15\x08\x8b\x56\x86\x45\xbe\x86\x3e\x9d\xea\xbe\x9a\xdf\xa8\x09\xb5\x7f\x94\xa2\x91\x6a\x0a\x21\xc1\x2e\x04\x29\xff\xae\xed\x42\x5c\x0a\xd6\xe5\x73\x83\x97\xee\x3f\x36\xab\xce\x9c\xde\xc7\x62\x6f\x8c\xe3\x80\x15\xac\xe6\x85\xfd\x81\x42\x85\x89\xf2\x9f\x97\x38\x04\xaa\xb1\x3c\x47\xce\xf4\xc9\x97\x41\x93\x0a\xba\x4b\x76\x6c\xde\x5c\x0a\x97\x9e\x48\x20\x45\x24\xc6\x21\x61\xc6\x47\x38\xd2\x09\x88\x3c\x82\xc2\xb2\x09\x3d\xca\xdd\x5f\xd6\xc9\x48\xee\xcf\x44\x97\xac\xf6\xb3\xb0\x30\x45\xd9\xec\x4c\x2b\x2f\xd7\xa7\x1f\xc6\x4b\x3a\x76\xbd\xf5\xb8\xb6\x77\x18\xb8\x55\x3a\x53\xac\x7f\x81\x1f\xc7\xf8\xf8\x30\x25\x61\x62\xf9\x8a\x24\x5f\xae\x13\x63\xdb\x7c\x04\x44\xed\x5b\x57\x85\x82\x16\xfe\xd0\xcc\x73\x36\x5b\x30\x75\x55\xa5\x66\x73\xae\xd4\x40\x94\x44\x83\xb7\x5e\x85\xd4\x3c\xea\x74\xa0\x48\xc7\x23\x3f\x28\xc6\xf9\xba\x9b\xc1\xec\xdf\x39\xed\x2d\x92\x69\x69\x0f\x25\xb1\x22\x46\x35\x3c\x35\x13\xf7\x10\x7f\x93\xff\x30\x14\xff\x0f\xfe\x82\xbf\x72\xf0\xf7\x7f\x63\x87\xf2\xfe\x51\xe1\xef\x53\xfb\xe7\x99\x7f\x14\xec\x33\x7a\x0f\xef\x4b\x51\x3d\xef\x06\x23\xed\xf9\xcf\x4c\x3b\xff\xce\xd9\xef\x0c\x81\xa1\xa0\x13\x8b\xf2\x21\x6b\x3e\xd5\x6e\x7a\xa7\x34\xa4\x86\xfd\xbf\xf4\x7a\xc6\xe8\x24\x5b\x67\xe7\xec\x29\x77\x85\x28\x49\x27\xdc\x97\xee\x7b\xf7\xab\xfb\x3b\x7b\x9a\xed\x64\xbf\x85\xb7\xeb\x6b\xfb\xfe\x6c\x3e\xb6\x9f\x5b\x58\x7f\xf2\xc5\xdd\x4f\x86\x21\x34\xf4\x31\x86\x17\xb4\x86\x1d\x1b\x54\xbe\x02\x69\xeb\xbe\x94\x73\x0e\x7d\x12\x3b\xd9\xce\x10\xe9\xc0\x3e\x42\x45\x94\x61\x30\x50\xc7\xb8\x75\x3c\x7d\x29\x39\xcc\x91\x04\x55\x55\x1b\x89\xe1\x60\x10\xb5\xf5\x62\xef\xc5\xa5\xec\xb8\x8a\x61\xd5\xc1\x21\x5d\xbb\x49\xb5\x52\x2d\x08\x84\x50\x0d\x3f\xa7\x1c\x6a\x57\x98\x67\x89\x03\xb4\x91\x25\x16\xcb\xf3\x35\x9c\xf5\xd9\x66\xa6\x0d\xa0\x39\xac\xa1\x74\xa8\x84\x35\xcd\x93\x13\x59\xfc\x8b\xed\x31\xf1\x39\xb4\x16\x92\xd0\x4b\x62\x79\x6e\x91\x69\xf8\x4d\x8d\xcb\xdb\x3b\xe4\xfd\x9b\x95\xaf\x9c\xb2\x4c\x73\x94\xaa\x7c\xf9\x76\x45\x75\xfd\x73\x46\xc8\x0a\xcc\xb0\x4f\xf5\x1e\x50\xc0\xa4\xc8\xcb\x16\x0d\x60\xf2\x34\x7c\xbe\x93\x4e\xce\xec\x70\x8b\xe5\x1e\x03\x1e\x41\x2f\x42\xcd\xc3\xd4\x1d\x2f\x08\x03\x4a\x6e\x6a\x98\x0f\x81\xd0\x96\x14\x1f\x69\xe0\x53\xc7\xce\x14\x4f\x8b\xe1\x67\x20\x30\x02\x15\xf0\x2c\x71\xc5\x27\xe4\x78\x4d\x54\xda\x2e\xb1\x56\x8b\xff\xa6\x35\x45\x0f\x35\xc3\x85\x99\xb3\x15\x5f\x08\xac\xf4\x8b\x85\xbe\x7a\xef\xd2\x87\x30\x6f\xd5\x74\x7b\xa7\x6c\x14\xec\xbb\xf6\xd6\x1b\x85\x36\x13\x64\x25\x10\xb3\xf8\x20\x2c\x7d\xb4\xa9\xa9\xb4\x90\x2a\x3b\xdb\xb6\x5d\xec\x3d\x7c\x0f\xde\xbf\x5d\xe6\x5b\x5b\x27\xf9\x37\x3f\x8e\xb8\xc4\x39\x97\x92\xb6\xaf\x1f\xdd\xd4\x0c\x9a\xec\xfb\x23\x58\x78\xf1\x75\x1a\xbc\x56\xf9\xfc\x00\x04\x38\x08\x4e\xd2\x2c\x87\x74\x29\x9e\x0d\x6b\x50\xf2\x15\xe7\x6a\x65\xfa\xc1\x56\x64\xce\x48\x2a\x02\x37\x42\x99\x90\x10\x47\x6f\x1a\xbd\xab\xa6\x42\xf5\xa9\x16\xd8\x0d\x21\x95\x27\xe2\x2d\x22\xc9\x40\xcf\x5b\x40\x99\x18\x35\x6d\x77\x51\xb3\x56\xb9\xae\x99\x73\x6f\x87\xcd\xd8\x62\x86\xa3\x22\xec\x7e\x01\xd9\x5c\xb4\x8c\x0e\x77\x21\x75\x17\xc9\xd7\xad\x04\xd2\xb1\x6e\x2d\xbb\x56\xf3\x33\x58\xe7\xe5\x4a\xf3\x3a\x9e\xa3\x5b\x01\x84\xaf\xf1\xba\x97\x54\xf0\x7c\x38\x7e\xca\x55\x7b\x03\x25\xeb\x25\x02\x4a\xb5\x39\x4c\x0d\x4f\x9b\x2b\x5b\xce\xcb\x2f\xef\xc5\x0d\xe6\x5e\x4d\xdb\x5b\x96\xf3\x91\x21\x51\x0a\x4c\x11\x95\x57\x89\x5d\x70\x85\x8b\x08\x47\x2e\xfd\xe7\x5b\x9b\xf9\x74\x60\x66\x2e\xb6\x6d\xd9\x76\xb5\xd2\xae\xed\x93\x7c\xad\x6f\xa7\xcf\x39\x2c\x84\xe0\xb7\x8f\x8b\xb2\x04\x2c\x07\xb5\xc7\x8f\xc0\x38\x0c\xd6\x88\xa5\x47\x37\xee\x80\x48\x7a\x76\x6e\x7b\x47\x6a\x70\xf2\x39\xae\x92\x2a\xbb\x57\xbe\x66\x64\x98\x2a\xbf\x92\xd3\x39\x3d\xf9\x69\x48\x21\xf9\xeb\xab\x45\x65\x5f\xd1\x40\x04\x1e\x91\x14\x81\x10\x3f\x0a\x9b\x42\xb6\x49\x56\xa9\x2b\xc8\xba\xb4\xbb\x60\xa9\x26\xab\xd5\x98\x54\xee\xb9\x8b\x5a\xb2\xcc\x16\xed\x15\x11\xd0\x93\x51\xe0\xfe\x35\xa6\x13\x50\xc3\x68\x2c\x96\x4e\x82\xa2\xa0\xbe\xe0\xab\xf8\x55\xde\xad\xf6\x52\x26\x9d\x4b\x07\xcc\x26\x31\x61\x98\x3b\x82\x50\xa3\xd4\xc6\x6a\x47\x12\x4a\x3c\xc9\xed\x7c\xd0\x0b\x62\x6c\xa8\x7a\xb9\x05\x42\xf4\x20\x86\xba\x9b\x57\x03\x3b\x1e\x73\x13\xd4\xd1\x85\x13\x20\x60\xc5\x6e\x7f\x1b\x6a\x48\x70\x14\xf9\x3d\x6a\xab\x2f\x0b\x7e\x96\xbe\x3c\xe1\xad\x50\x87\x8b\xa6\xd2\x77\x37\x09\x55\x37\xd7\x83\x50\x45\x29\xa5\xba\xa4\xf8\x82\x72\xc4\x30\x8a\x31\x8f\xeb\xae\xa7\xe9\x41\x32\xe7\xec\x71\xa6\xab\x96\xe2\x12\x1a\xc7\xb6\x5d\xca\x81\x69\x54\x72\x20\x2b\x4d\x75\x75\x8f\x18\x84\x19\xe9\x0a\x3a\xc1\x10\xe9\x58\xb4\xbc\x32\x14\x0c\x79\xae\x8a\x08\x62\x4b\x47\x80\xc6\xa1\x94\xab\x27\xab\x79\xdb\xd3\x5e\xcd\x20\x6a\x5d\x91\x74\x94\xf3\xd6\x90\x9e\x17\x43\xb0\xce\xa2\xbf\xdb\x81\x75\xd5\xc5\x1f\xde\xaf\x51\x22\x8f\x1c\xf7\x8f\x89\xc9\x78\x0e\xaf\x57\x1e\xc6\x48\xc0\x6d\xca\xe6\x4e\xc7\xfd\x57\xab\x0b\x1b\xb5\x7e\x16\x76\xb8\x55\xee\xe0\x78\x2b\x3f\x81\x6b\xd3\x70\x7f\x14\xa8\x9a\x2a\x32\x21\x16\xe0\xe8\x06\xad\xfa\x56\x6d\x0a\x1d\xc3\x8b\x34\x88\x9f\xf0\xa5\x3a\xdd\x55\x26\x6a\x5d\x5b\x9b\x74\x94\x71\x7a\x1a\xd9\x54\x07\x5d\x5e\x8d\x8b\x06\x19\x1f\xd1\x77\x02\x84\xb3\x34\x2c\x0c\xe7\x49\x22\x2a\x2b\x84\xf6\x5b\xf8\x1a\xb6\x30\x52\x4d\xe8\x83\x55\x79\x59\xa6\xc3\xb2\x36\x5b\x89\x36\x7b\xba\x9a\x5d\xf5\x3a\x1d\xfd\xf9\xe3\x54\x31\xad\x84\x7d\xe3\xcc\x4f\x54\x5d\xdb\xf4\xd1\x19\x66\x26\x20\x97\x4e\x69\xf8\x9a\xde\x68\x2c\x5d\xf8\xa5\xd7\xd4\x7a\x91\x7a\x4e\x64\x02\x5e\x46\xdf\x00\x59\x9d\x12\x6d\xda\x62\xfa\x80\x58\xda\xf1\x1f\xe3\x98\x78\xa2\x0f\x74\x39\xec\xf0\xd5\xd8\xea\x0d\xd0\xd2\xd8\x35\x4a\x5d\x2a\x19\x3a\x12\x56\x2a\x4d\x48\x1c\xc0\x2e\xfc\xa0\x97\x3c\x25\xe9\xbe\xc2\xcb\x4d\xbb\x94\x4a\xe4\x90\xce\xab\x98\x81\x07\x57\xbc\x7d\x00\x22\x04\x57\xab\xbc\x20\xe4\xcc\xb7\x55\xb3\xcc\x59\x15\x26\x1a\x03\x56\x51\x99\x8f\x23\x77\x0c\x9b\x31\xab\xe6\xb6\x40\x28\xca\x0e\x32\x85\x02\x2b\xa3\x68\xa7\x88\x49\x9e\x63\x6d\x38\x1d\x17\xf5\xcb\xfd\xdd\xc5\x91\xa1\x0c\x00\xb4\x80\xca\x29\x98\x67\x8c\xe5\xe6\x22\x6b\x1c\x2d\x45\x0e\x85\x67\x32\x01\x90\x04\xa9\xb5\xe0\x29\xd6\x90\x9f\x12\xcd\x08\x69\x9e\x66\x50\xd2\x8d\x38\xf8\x69\x4e\x99\x06\x76\xfa\x44\xf3\x12\xd6\xff\x06\x64\xa6\x00\x0c\xbe\xf0\x93\x88\xb2\x7c\xe1\xeb\x1f\xd9\xfd\x4b\xe5\xef\x12\x6f\xde\x59\x14\x08\xef\x4f\xa9\xff\xf5\x05\x98\x1c\x39\x34\x00\x98\x7a\xde\x6f\x95\x7f\x9a\xbc\x79\xdf\x2b\xbe\xfb\x93\x51\x11\xff\x05\xbb\x0c\x21\xa6\x4e\x5d\x41\x72\x97\x33\x5e\x93\xc4\xbc\x06\x63\x6f\xf2\xda\xc6\x2c\xcb\x9a\x51\xbd\xcf\xdf\x91\x79\x87\x46\x4d\x64\x9a\x27\xdd\xf2\x85\xd2\x48\x5a\x58\x53\xa5\xf6\xdf\x7c\x96\x25\x5e\x94\xee\xa4\x2d\x40\x95\x68\x83\x66\xd2\xfe\x76\x03\xd1\x81\x76\x69\x53\x29\x2d\x45\xb7\x89\xd2\x6a\x95\xf2\x97\xa2\xf8\xf9\xed\xd8\xe4\x30\xeb\xed\x8b\xbb\x93\x91\xb9\xf5\xb9\x6c\x75\x99\x2b\x1d\x94\xef\x06\xec\x28\x9e\xbb\x53\x66\x3b\xab\x5a\xf1\x0e\x75\x18\x1e\xe5\xec\x3a\xc1\xe8\xbb\x3b\x48\xae\xac\x70\x38\xe1\x9d\x54\x55\x6b\x87\xf9\xfa\x2c\xb3\xe1\x44\xd1\x5b\x0f\xf7\xf8\x3f\xb8\xad\x13\x23\xde\x3e\x81\xb5\xc7\xc8\xad\x6b\x4e\xf2\x06\xd8\x8e\x08\x8e\xd2\xe0\x47\x27\x41\xb5\x9d\x46\xb3\x42\x5c\x19\xc2\x17\x9e\xe9\xca\x0c\x77\x84\x8a\xd4\x2f\xc8\xb5\x72\xe6\x2b\x51\x70\x2b\x08\x6d\x4c\x9a\x9b\x85\xf0\x5c\x4a\x3d\xde\xc9\x4d\x48\xc2\x5c\xb9\xa0\x42\xe5\xb8\x7b\x27\x89\x94\xa3\x53\x87\xf1\xc9\x9b\x2e\x7e\x20\xd8\xbd\x26\xdb\x44\x89\x35\x04\x80\x63\x41\xab\x6e\xa3\x52\x91\xa0\xff\x44\x28\x76\x2a\x58\x6d\x2a\x7d\xd5\xf1\x5b\xe3\xfc\x76\x21\xc7\xb5\x0e\x53\x11\x20\x7d\x73\x03\xc6\x5c\xe7\x71\x58\x70\x99\x62\x05\xf3\x1f\x1c\x5c\x4c\x93\xa8\x68\xba\x60\xa0\x72\x64\xa4\x2e\x1b\x6e\xfd\x9b\x76\xb8\x61\x2a\xb5\x53\xc0\x7a\xe7\xc4\xec\xb0\x6e\xa6\xa2\xde\xc7\x97\xa7\xe4\x4d\x97\x58\xb5\x4e\xcc\x1e\x11\x70\x48\x70\x3a\xe2\x78\x0e\x1b\x6e\x7e\xca\x01\x6b\xc6\x4c\x76\x16\x8f\xf5\x42\xce\x16\x00\x79\xc7\x38\x53\x3b\xd4\x4e\xab\x8c\x51\xcb\xce\x8f\x5f\xa1\x88\x56\x95\x7b\x1a\xe5\x7d\xd2\x46\x9d\xef\x92\xba\x47\xdc\x3c\x16\xb3\x85\x40\xed\x21\x23\xb4\x5e\x0a\xae\x65\x84\xf2\x87\xe9\x18\xe1\x8c\x39\xd2\xe8\x86\x69\xbf\x92\xd7\xa9\xd8\xcd\xe2\x30\xe5\xe4\x51\x57\xb3\xd5\x70\x5b\x91\x2a\x4c\xa9\x31\x6d\x83\x5c\xfd\x25\x26\xbf\x6c\xa7\x7f\xc9\xbf\x68\x76\x41\xe8\x52\xee\xed\xf8\x15\x17\xe8\x0a\x77\x18\xa2\xae\x81\xbb\x85\x96\x32\x78\x72\x95\xa1\x84\x0c\x32\x67\x5f\x97\x88\x0b\xe8\x0e\x5a\x60\x40\x9b\xdf\x21\x15\xf1\xed\x1f\x83\x31\xad\x9a\x2e\xb7\xdc\x84\x9c\x81\xb3\xae\xed\xe3\x02\xda\x3d\x06\x08\xdc\xaf\x13\x34\xfa\x54\x29\x71\x76\x0e\x6c\x23\x66\x02\x2a\xd8\x14\x80\x2c\x0a\x93\x5f\x91\x90\xa0\xc6\x3b\x0a\x08\x00\x17\x12\x0c\x0f\x58\x4c\x81\x13\x87\x59\x12\x31\x85\x0b\xde\x91\x29\x48\xe2\x5c\xf4\x84\x38\x61\xa3\x08\xe7\x1b\xd2\xd1\xb4\xe9\xd3\xd9\xfc\xcb\x4e\xec\xe5\x82\x2e\x02\x44\x8a\xc2\x24\x61\x97\x8a\x1a\x65\x2a\x33\x5a\x35\xac\x49\x85\x1e\x83\x62\x6b\x0d\xe5\xde\xc7\x48\x30\xd4\xd1\x38\x08\x8e\xcf\xba\xc7\x49\xca\x7c\x02\xb5\x0f\x3f\xc1\x02\x0a\x7a\xe1\x22\x05\x39\x8e\x8d\x19\x28\x01\xc2\x44\x45\x24\x8a\xe9\xd9\x20\x33\x63\xce\x0e\x15\xf6\x63\xa6\xb9\x2f\x85\x3d\x24\xac\x53\x56\x0c\x53\x07\xfe\xd4\x9e\x80\x4e\xe2\x99\x26\x9b\x90\xfd\x0f\x5f\x5a\x9e\x1a\x6c\x91\x3c\xff\x91\xa7\xb3\x4e\xf7\x32\x27\x2d\xcf\x04\x2f\xa6\xaf\xd9\xcf\xc4\x9e\xdf\xf6\x38\x13\xdf\x9b\x1a\xee\xd6\xc3\xe2\x71\x9f\x26\xa5\x67\x92\xb7\xb7\x66\x87\xdb\x01\x75\x7d\x9d\xce\xa0\x9f\xed\x9c\x46\x1a\x85\xa6\xc9\xd6\xa0\x1f\x8e\xaf\xe3\xb3\x9e\xbf\xfc\xeb\xe7\xd4\xf7\x61\x3f\x1e\xa8\xbf\xca\xff\xf8\xa9\x1c\xbc\x4b\xf8\x0f"
 var assets_17 = "\x77\x4f\x46\x46\x00\x01\x00\x00\x00\x00\x48\x58\x00\x12\x00\x00\x00\x00\x7f\x8c\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x47\x44\x45\x46\x00\x00\x01\x94\x00\x00\x00\x40\x00\x00\x00\x4c\x05\x30\x04\xf2\x47\x50\x4f\x53\x00\x00\x01\xd4\x00\x00\x05\xcb\x00\x00\x0d\x0e\xce\xf6\xe4\x49\x47\x53\x55\x42\x00\x00\x07\xa0\x00\x00\x00\x5c\x00\x00\x00\x88\x94\x26\x9e\x52\x4f\x53\x2f\x32\x00\x00\x07\xfc\x00\x00\x00\x56\x00\x00\x00\x60\xa0\xa7\xb1\xa6\x63\x6d\x61\x70\x00\x00\x08\x54\x00\x00\x01\xa5\x00\x00\x03\x38\xe2\x83\x21\x5a\x63\x76\x74\x20\x00\x00\x09\xfc\x00\x00\x00\x4c\x00\x00\x00\x4c\x24\x41\x06\xe5\x66\x70\x67\x6d\x00\x00\x0a\x48\x00\x00\x01\x3b\x00\x00\x01\xbc\x67\xf4\x5c\xab\x67\x61\x73\x70\x00\x00\x0b\x84\x00\x00\x00\x0c\x00\x00\x00\x0c\x00\x08\x00\x13\x67\x6c\x79\x66\x00\x00\x0b\x90\x00\x00\x36\x72\x00\x00\x62\x26\x08\x18\x2e\xfa\x68\x64\x6d\x78\x00\x00\x42\x04\x00\x00\x00\x64\x00\x00\x00\xe8\x04\x02\xf8\xe2\x68\x65\x61\x64\x00\x00\x42\x68\x00\x00\x00\x36\x00\x00\x00\x36\xf8\x46\xab\x0e\x68\x68\x65\x61\x00\x00\x42\xa0\x00\x00\x00\x1f\x00\x00\x00\x24\x0a\xba\x06\x7d\x68\x6d\x74\x78\x00\x00\x42\xc0\x00\x00\x02\x45\x00\x00\x03\x76\x81\x5a\x51\x9f\x6c\x6f\x63\x61\x00\x00\x45\x08\x00\x00\x01\xbe\x00\x00\x01\xbe\x3a\xfc\x21\xa8\x6d\x61\x78\x70\x00\x00\x46\xc8\x00\x00\x00\x20\x00\x00\x00\x20\x03\x0e\x02\xf9\x6e\x61\x6d\x65\x00\x00\x46\xe8\x00\x00\x00\x99\x00\x00\x01\x10\x10\x6f\x2c\xa9\x70\x6f\x73\x74\x00\x00\x47\x84\x00\x00\x00\x13\x00\x00\x00\x20\xff\x6d\x00\x64\x70\x72\x65\x70\x00\x00\x47\x98\x00\x00\x00\xbd\x00\x00\x00\xdb\x74\xa0\x8f\xec\x78\x01\x0d\xc1\xb1\x01\xc1\x50\x18\x06\xc0\xfb\x9e\x0a\xd6\xb0\x86\xce\x1a\xf6\xd0\x01\x40\xb2\x43\xd2\x65\xa9\xec\x92\xff\x4e\xb0\x03\x34\x1b\x7b\x07\x71\x74\x12\xe7\xba\x72\xad\x71\xaf\xf1\xaa\xf1\xad\xf1\xd7\x8b\xc1\xa8\x99\xcc\xb2\x00\x0f\x01\x0a\x23\x78\x01\x94\xd2\x03\x70\x23\x4c\x18\xc6\xf1\xff\xe6\xd2\x9e\x73\x69\xda\xcf\xb6\x6d\xdb\x3a\xdb\xb6\x6d\xdb\xb6\x6d\xdb\x36\xcb\xb3\x6d\xb3\xef\xf7\xcc\x5c\xa6\x97\xe3\xf4\x76\xe7\xb7\x78\x56\x6d\x12\x1c\x90\x86\xac\x54\xc5\xfb\xf3\xaf\x7f\x67\xe5\xc9\x22\xb5\x2a\x97\xe5\xd5\x12\x95\x8b\x95\xe1\xc3\xb2\x85\xaa\x96\xe7\x5b\xbc\x00\x66\x38\x08\x19\xbb\x90\xb1\x27\x64\x9c\x02\x6f\x99\x62\x95\xcb\xf3\xe4\xdd\x2d\xe0\x10\xbc\x78\x40\xad\xcf\xe3\x4b\xd1\xd9\x9b\x19\x47\x63\xcd\xe0\x6b\xd7\xd2\xb5\x24\xd1\xb5\x77\x7d\xdd\x54\x37\xda\x79\x5d\x1a\xe7\xd7\xe8\x51\xf7\xbc\xfb\xd1\x65\x75\xb3\x5d\x71\x77\xde\x95\x95\x9b\xb5\xb6\xbb\xaa\xda\x32\x58\xa7\xea\x5c\x52\xd5\x0d\xa3\x83\x75\x6a\x52\x9d\xad\x33\x77\x57\xdd\x81\xe3\x6b\xc2\x49\x4b\x24\xaf\xf0\x1a\xaf\xf3\x06\x6f\xf2\x1e\xef\xf3\x11\x1f\xf3\x39\x5f\xf2\x15\xdf\xf0\x2d\x7f\xf0\x27\x7f\x93\x89\xcc\x64\x21\x3b\xb9\xc9\x47\x17\xba\xd2\x9d\x1e\x8c\x64\x14\xa3\x19\xc3\x58\xc6\x31\x9e\x09\x4c\x64\x12\x93\x99\xc2\x2c\x66\x33\x87\xb9\xcc\x63\x31\xab\x59\xc3\x5a\xd6\xb1\x99\x2d\x44\x13\x43\x2c\x71\x6c\x67\x07\x8e\x48\x7b\x5e\x6d\x76\x3b\xa8\x36\x8f\x2d\x42\xad\x0d\xc5\x43\x4e\x7c\x34\xb2\x01\xa4\xc2\x6b\xf1\x5a\x9f\xa6\x7c\x05\xe5\x12\x67\x52\xd7\x5e\xd7\xca\x3f\x34\xb7\xf6\xb4\xb2\x41\x78\x49\x4f\x14\x3f\x10\xa1\x5d\x7b\x29\x44\x24\x4a\xc8\xa0\x24\x9d\x92\x63\x4a\x7c\xe8\x56\x3b\x40\x8a\xe0\xce\x00\xda\xa5\xd9\x9f\xda\x95\x93\x0f\xc9\xa3\xb3\xda\x41\x5a\x65\x2f\xd9\x12\x72\xda\x29\xed\xdf\x85\xf6\xdb\x41\x7e\xb0\xf3\x14\x52\xe2\xe1\x4f\x3b\xa9\xfc\x28\x8e\x46\x5a\xf5\x91\x46\xef\xe9\x46\x7c\xb6\x01\xdd\xaa\xf5\xa3\x7a\x31\x9e\x9c\xea\xf5\x02\xe5\x6c\x3c\x75\x6d\x9d\x76\x0f\xa4\xb9\x2d\xa2\x95\x2d\xa4\x97\x6d\xc1\xd1\xd5\x26\xaa\xfd\x53\xa7\x1d\x8f\xe3\x05\x52\xf2\x26\xef\xf3\x23\xd7\x5d\xc0\xbd\xec\xbe\xc5\x29\xf1\x92\x4e\xe9\x27\x7c\xca\x77\xda\xeb\xf8\xdc\xe2\x95\x7d\x49\x14\x5f\x11\xe0\x1b\x1e\x65\xb1\xbc\x8b\xdf\x1e\x25\x20\xaf\x58\x76\xde\x4d\xbc\xcc\xe7\xda\xf1\x8b\xed\xe4\x37\x3b\xc6\xef\xf2\x87\xfc\x25\x59\x24\x9b\xe4\xb2\xa3\xe4\xb6\xc3\xe4\x93\x76\x9a\x77\xd3\xd9\x9e\x32\x4a\xe7\x47\xcb\x18\x19\x2b\xe3\x64\xbc\x2c\xd1\x5d\x4b\x65\x99\x2c\x97\x15\xb2\x52\x56\xe9\xec\x6a\x59\x23\x6b\x65\x9d\x6c\x91\x68\x89\x91\x58\x89\x93\x78\xbd\x97\x20\x5b\x65\x9b\x6c\xd7\xbb\x3b\x24\x8c\xcf\x2d\x86\x2f\x6d\x3f\x5f\xd9\x71\xbe\xb1\x1d\x2c\x16\xaf\xd2\x89\x4a\x4f\x28\xd9\xc8\x62\x09\xe7\x5d\x3b\xc9\x17\x32\x5f\x16\xc8\x42\x59\x24\x11\x5a\xf3\x93\x56\x7e\xd3\x7d\xbf\xcb\x1f\xf2\x97\x64\x93\x76\xd2\x45\x6b\x5d\xa5\xbb\xf4\x90\x55\xca\x56\xcb\x1a\x59\x2b\xeb\xc4\x11\x85\x17\x08\xe3\x45\x32\x52\x9a\xba\xda\xed\x34\xfb\x8f\x9c\xe4\xa1\x80\xce\xa7\xd5\xfd\xc7\xf9\x5d\xfe\x90\xbf\x24\x9b\xb4\x93\x55\xb2\x5a\xd6\xc8\x5a\x59\x27\xe1\x64\x51\xbb\x45\xa2\x25\x46\x62\x25\x4e\xbc\xf8\xad\x29\x01\xe9\x26\x3d\x45\xff\x9b\xf2\x2f\x64\xbe\x2c\x90\x85\xb2\x48\xd2\xf0\x37\xcf\xf3\x9f\x64\x94\x2c\xd6\x90\x1c\xd6\x91\x2d\xea\xa3\x25\x46\x62\x25\x4e\x3c\x61\x19\xf1\x42\xd8\xf2\x94\xa9\x78\x9a\xc7\xc1\xd6\xdb\x05\x3b\x65\x2d\xed\xbc\xcd\xb1\x06\x76\x81\x74\x36\xca\x06\x93\xac\x62\x07\x00\xec\xb0\xad\x4a\xe6\xfe\x7d\xc1\x7e\x3f\x01\x22\x92\x7d\xff\x51\xd3\x2f\x44\x6d\x6f\x3b\x92\xac\xfb\x1f\xbc\xe7\x60\xb0\x3f\x01\x44\xd9\xa9\x3b\x56\x95\xde\x91\xcc\xb4\xfd\x00\xd6\x8f\x0c\xf8\x6d\x90\x2d\xc5\xaf\x7e\x12\xd8\xab\x28\xb5\x29\xf6\xb8\x75\xb1\xd3\xd6\xc1\xda\x9a\x1f\x2c\xde\xf6\x84\xfc\xed\x47\xee\x7e\xdd\x16\x86\xa6\x56\xc7\x16\xd8\xea\xc4\x89\x60\x95\x12\x4f\x58\x34\x58\x26\x8b\x20\x92\xfb\x95\x0c\x02\xd8\xb9\xd0\xd0\xaa\xdb\x9e\xc4\xb9\x76\xf0\x56\x6a\x17\xe4\x73\xee\x53\xec\xe8\x7d\x57\x2e\xdc\xbc\xc3\x36\x07\xbf\x29\x00\x6c\x9a\x3c\x27\xd1\xb2\xed\xd6\x3a\xd8\x1e\x3b\x04\x60\xc7\x92\x5a\xdf\x1d\xff\xaf\x76\x86\x24\xc7\x6d\x9f\x0d\x04\x5b\x40\x04\x11\x96\x60\x7b\x09\x10\xb0\xc1\xa4\xb7\x77\x50\x6a\x47\x6c\xad\xc5\xdb\x69\x9b\x61\xcb\xb5\x0f\x20\xf8\x0e\xe0\x0b\x7e\x3f\x58\x8c\x34\x43\xa9\x2c\x05\xb0\x5d\xc1\x95\x03\x76\x30\xe4\xef\x09\xee\x27\x3d\x04\xd3\x3b\x8a\x9d\x01\xb0\x79\x3c\x54\xb1\x0b\x0f\xb7\xd3\xf6\xdd\xfe\x1e\xdc\x77\x7e\x0e\xc0\xce\xf2\x50\xc5\x7a\x92\xcc\x12\xfc\x7f\x6b\x26\xcd\x4f\xdf\xb1\x7e\xec\xde\xbf\x3a\x9d\xbb\xa3\x58\x37\x39\x77\xd7\xff\x11\x10\xf0\xdb\xf1\xbb\xf6\x37\x0b\xf9\x3e\xb0\xf8\x3b\x56\x43\xf7\x3f\x38\x0d\xfe\xdd\xc9\x4f\xc1\xba\xd9\x12\x42\xe7\x2d\x83\xfd\x32\x82\xaf\xd8\x7a\x9b\x18\xb2\x9e\x9d\xe4\x15\x0f\x91\x84\xab\x42\x5a\x55\x87\x5f\xd5\x43\x80\xc0\xff\x4d\xd6\x03\xce\x5d\x7b\x00\x45\xf1\x75\x74\xed\x7b\xcf\x79\xaf\xb6\x6d\x7f\x51\x6d\xbb\x9d\x4c\xa3\xda\x18\x41\x6d\xdb\xb6\x39\x99\xee\xec\xa8\xf9\xc5\xf8\x63\x45\x9b\x88\x41\x0c\x23\x66\x24\x13\x29\x32\x95\x2e\x5a\xcc\x61\x1e\xdd\x58\x20\x3d\x59\x2c\xbd\x58\xc1\x6a\x7a\xb3\x56\xfa\xb3\x51\x06\xb0\x99\xad\x0c\x64\x87\x0c\xe1\x20\xc7\x18\xca\x49\xde\x31\x9a\xcf\xfc\x60\xb5\x97\xd0\x46\x42\x26\xf9\xd6\xee\xbe\xb5\x3b\x0d\xe9\x41\x4b\x7a\x30\x58\x02\x86\x48\xcc\x50\x89\x18\x26\x09\x23\x19\x45\xc8\x68\x29\x31\x46\xf2\x8c\x95\x02\xe3\x18\x4f\xc8\x04\x89\x98\x88\xdf\x2a\x39\xa6\x49\x89\xe9\x52\x66\x86\xd4\x98\x29\x15\x66\x49\x95\x2e\xa9\x33\x47\x1a\xcc\x95\x16\xf3\xa4\xe9\xbf\xb5\xfd\xb7\x8e\x17\x60\x87\x55\x92\xb2\x5a\x5a\xfe\xe7\x7f\xfe\x67\xc6\x66\xf9\x9f\x2d\x92\xb1\x55\xba\xf9\xcf\x2d\x6f\xc5\xee\x1c\x96\x1e\xde\x8c\xdd\x39\x26\x3d\x38\x29\x11\x17\x24\xe6\x22\x57\x48\xb8\xca\x4d\x42\x6e\x71\x8f\x88\xfb\x3c\xa2\xc4\x63\xa9\xf2\x84\x97\x34\x78\x25\x4d\x5e\xab\x5d\x9b\xcf\xd2\xe1\x8b\x0a\xa6\x2e\x98\x11\x32\xd1\x05\x63\x17\x8c\x5d\xb0\x46\x53\x5a\x56\x63\x90\x54\xdc\x31\x64\x84\xb8\x1d\xff\xb9\x94\xfa\x4b\xc8\x14\xe9\xb8\x57\xde\xbd\x22\xf7\x2a\xb9\x57\xce\xbd\xaa\xee\x55\xfe\xa7\x57\xca\x3c\x35\x6a\xb0\x48\x1a\x6e\xd4\x76\xa3\xc4\x8d\xda\xac\x91\x06\xeb\x24\x63\x83\x14\xdc\x2b\x70\xaf\xa6\x7b\x05\xee\x55\x64\x9b\x84\xec\x92\x90\xdd\xd2\x60\x3f\x07\x70\x41\x62\x17\xac\xb9\x60\xec\x82\x35\x4e\x48\x8b\x53\x9c\xa7\xe2\x8e\x6e\x47\xe8\x76\x91\xdb\x95\xdd\x2e\x55\xb9\x77\x34\x5c\xad\xed\x6a\x09\x3f\xf9\x43\xc1\xed\x82\xbf\x48\xf9\x26\xa9\x00\x78\x01\x4d\x89\xc5\x01\x42\x41\x10\x43\x5f\x06\x77\xe7\xbc\x05\x6c\x1f\xb8\x43\x01\x7b\xc1\xe1\x44\x41\xd0\x28\x6e\x7f\x34\xc9\x43\x40\x16\x4f\x1b\xed\xc3\xe9\x48\x9a\x2c\x9a\x2f\xa6\x8e\x2a\xdc\x6e\x08\x3e\x5f\xd8\x7e\xb3\x0a\x14\x5f\xb7\x09\x18\xbc\xf8\x8b\x91\xc4\x11\x07\x44\x1a\xe1\x3f\x3f\xce\x19\x63\x10\x21\xd5\x1f\xb9\x60\x8c\x11\xa2\x7b\x07\x98\x51\x0d\xa0\x78\x01\x35\xc5\x37\x01\xc2\x50\x00\x06\xe1\x2f\xbd\x88\x48\xd1\xc0\xc6\xc4\x96\x31\xa8\x78\x36\x98\xd8\x59\xe9\x16\xb0\x82\x03\x84\xd0\xdb\x5f\xee\x24\xe9\x32\x5a\xc9\xc8\x36\xd9\x84\xa8\xfd\x3a\x39\x0b\x31\x62\xff\x5c\xd4\x8f\xb9\x7e\x81\x1e\x86\x71\x1c\xcc\x78\xdc\x72\x77\x42\xb1\x89\x75\xa2\x23\xa4\xd3\xec\xa4\xd3\x89\x9f\x9a\x46\x0e\x93\x00\x00\x78\x01\x7d\xcb\x03\x8c\x6e\x57\x00\x85\xd1\xf5\xa3\xb6\xdd\x9e\xba\x0d\x6b\xc5\xc9\xc4\xac\xed\x18\xb5\x6d\xdb\xb6\x3b\xb6\xcd\x60\x6c\x7b\x26\xaa\xad\xdb\x9b\x9c\x22\x7c\x3b\x59\xd9\xd1\x87\x5c\xe4\x40\x39\x19\xf1\xb7\x27\x73\x85\x7c\xa6\x00\xa7\x08\xf2\xf6\xc6\x4e\xee\xf1\x9e\x42\x45\x5a\x74\x1b\xb0\xee\x6b\x49\xe6\xf8\xcc\xd9\xd9\xee\xec\x74\x76\x36\xec\x19\xf6\x0f\x07\x87\xc3\xc2\x19\xa1\x20\xdc\x14\x0a\x0f\xdb\x3d\x49\xc0\x4e\x82\xf7\xbc\xaf\x48\xb1\x56\x3d\x06\x6d\xf8\x26\xed\xce\x8a\x5d\xd8\x23\xec\x1b\x0e\x4c\xbb\xd3\xff\xef\x64\x92\x9f\x92\x55\x5b\x27\xed\xc9\x6f\x49\x1b\xc9\x66\x6a\x39\x75\x51\x72\xc6\x9f\xbd\x7f\x56\xfd\x59\xb9\x78\xcf\xe2\x9d\x8b\x77\x2c\xde\xba\x78\xe5\xe2\x05\x8b\xc7\x2e\x7c\x37\xff\xb4\x0c\x00\xe0\x18\x9c\x80\x4b\x6c\x79\x9f\xfb\xc2\x97\x0a\x15\x29\x76\xbf\xcf\xd4\xaa\x53\xaf\x41\xa3\x26\x0f\x78\x50\xb3\x16\xad\xda\xb4\x7b\xc8\xc3\x3a\x74\xea\xd2\xad\x47\xaf\x47\x3c\x6a\xd8\x88\x51\x63\xc6\x4d\x78\xcc\xe3\x26\x4d\x99\x36\x63\xd6\x13\xe6\xec\x08\x99\x49\xc0\x51\x00\xc8\x60\xa7\x48\x0e\x01\xf7\x20\x8f\xf7\x22\x9f\xe0\x7d\x14\xe2\x6a\x14\x45\x3e\x45\x31\x5a\x70\x2d\x5a\xd1\x85\xcf\xd1\x1d\xb9\x1f\x3d\x18\x40\x09\x06\x23\x9f\x61\x08\xe3\xa8\xc5\x04\x26\xf1\x00\xa6\x30\x87\x66\xcc\x63\x01\x0f\x61\x11\x6b\xe8\xc0\x7a\xe4\x11\x6c\xe0\x6b\xf4\xe1\x1b\xd1\xa3\xf8\x16\x3f\x61\x18\x3f\xe3\x17\x3c\x86\x5f\xf1\x27\x26\xf1\x97\xe8\x09\x24\xa2\x39\x32\xc7\x47\x9e\x24\x73\x56\xea\x6c\x3c\x45\xb6\x3b\xf2\x0c\xd9\xe9\xc8\xb3\x64\x67\x23\xcf\x11\xf6\x48\xed\x89\xe7\x09\xfb\xa6\xf6\xc7\x8b\x84\x03\x53\x07\xe3\x15\xc2\x61\x91\xd7\x09\xa7\xa7\xce\xc0\x1b\x84\x82\xc8\x5b\x84\x9b\x22\x6f\x13\x0a\x23\xef\x70\xd8\xee\x91\x77\xff\x06\xd7\x70\xaa\x44\x00\x00\x00\x00\x2a\x00\x9d\x00\x80\x00\x8a\x00\x78\x00\xd4\x00\x64\x00\x4e\x00\x5a\x00\x87\x00\x60\x00\x56\x00\x34\x02\x3c\x00\xbc\x00\xc4\x00\x00\x00\x14\xfe\x60\x00\x14\x02\x9b\x00\x20\x03\x21\x00\x0b\x04\x3a\x00\x14\x04\x8d\x00\x10\x05\xb0\x00\x14\x06\x18\x00\x15\x01\xa6\x00\x11\x06\xc0\x00\x0e\x00\x00\x00\x00\x78\x01\x5d\x8e\x05\x0e\x13\x41\x14\x86\x67\xa8\x5b\x42\x14\xa9\xcd\x54\x91\x99\x34\x2e\xc4\x2a\xbb\xc5\xa1\xde\x79\xb8\x5b\x8c\x03\xe0\x4e\xed\x2c\xff\x46\xb7\xd1\x1e\xa7\x87\xc0\x56\xf0\x91\xe7\xef\xcb\x0f\xa6\xce\x23\x35\x30\x0e\xe7\x1b\x72\xf9\xf7\xf7\x78\x57\x74\x52\x91\x9b\x37\x34\xb8\x12\xc2\x7e\x6c\x81\xdf\xd2\x38\xa0\xc0\x4f\x48\x8d\x88\x12\x7d\x44\xea\xfd\x91\xa9\x92\x58\x88\xc5\xd9\x7b\x0b\xd1\x17\x8f\x6e\xdf\x43\xb4\x1e\x78\xaf\x71\x7f\x41\x2d\x01\x36\x36\x8f\x3d\x3b\x31\x12\x6d\xca\xff\x0e\xef\x13\x9d\xd2\x88\xfa\x98\x68\x80\x59\x90\x07\x78\x12\x00\x02\x1f\x94\x5a\x5f\x35\x62\xea\xbc\x40\xa4\x31\x30\x43\x83\x57\x56\x1e\x6d\x8b\xf2\x52\x0a\x1b\xbb\x81\xc1\xce\xca\x4b\x22\x8d\xf8\x6f\x8d\x9e\x7f\xfe\xf8\x70\xa8\x36\xa1\x10\x3f\xa1\x91\x0c\x09\x63\x83\x76\x1e\x8c\x16\x8b\x30\xab\x4a\xbc\x5a\x2c\xf2\x8b\x2a\xfd\xce\x77\xff\xe6\x2e\x67\xff\x17\xda\x7f\x17\x10\xa9\xdb\x2e\x7f\x35\x08\x3a\xaf\xaa\x32\xef\x17\xaa\xb2\x2a\x3d\x85\x64\x69\xa4\xd4\xf9\xb1\xb1\x3d\x89\x92\x34\xd2\x0a\x35\x5b\x23\xa3\x50\xf7\x5c\x56\x39\x4d\xfe\x49\x2c\xc6\x66\xdb\x66\x51\x76\xd7\x4d\xb2\x4f\x13\xb3\x65\xb5\xc8\xfe\x29\xe5\x51\xf5\xe0\xe2\x93\x7b\x90\xfd\xae\x91\x87\xc8\x29\xb4\x3f\xb9\x82\x5d\x31\x4e\x9d\x59\xf9\x2d\xab\x47\xf6\x16\xe9\x1f\xbf\xc6\x89\x01\x00\x00\x01\x00\x02\x00\x08\x00\x02\xff\xff\x00\x0f\x78\x01\xac\x7c\x07\x40\x14\xc7\xf7\xff\x9b\xd9\x72\x1c\xf5\x0a\x70\xf4\x76\xca\xd9\x51\xaa\x9a\x58\x63\x37\xc5\x5e\x83\x5d\x22\x76\xec\x88\xbd\x20\x88\x8a\x15\xac\x58\x12\x1b\x9a\x78\x6e\xd4\x08\x26\x8a\x89\xbd\x62\x89\x51\x63\x8c\xa6\x7c\xd3\x4c\xfc\xa6\x1b\xe5\x86\xff\xcc\x2c\x78\xb7\xa7\xe6\xdf\x7e\x89\x6c\xbb\xdd\x9d\x37\x6f\x5e\xfd\xcc\x9b\x05\x09\x86\x01\x08\xb5\x24\x3b\x08\x20\x83\x1e\xbc\xc1\x0f\x32\x14\x6f\x83\xd1\x68\x6a\x62\xf7\x36\xd8\xa1\x9c\x6d\x65\xbe\xd5\xf3\xad\x4f\x39\xd8\xa1\x6d\x1f\x3b\x8e\x0b\xdd\x8f\xc3\x9a\xf7\xe5\x27\x40\x4f\xc0\xd0\xbc\xaf\x22\x62\xa0\x4f\x2a\x92\xba\xd3\xa9\x3b\x0f\xbe\xdb\xef\xe9\xfd\x87\xe2\xad\x5e\xf1\xe1\x3b\x3b\x36\xec\xf7\xf5\xfe\xa3\x61\xa3\xe8\x68\x63\xb4\x60\x44\xc8\x88\x84\x68\x94\x84\xa2\x85\x5a\x8e\x97\xf1\x89\x64\xf2\x0b\x29\x45\xde\xdf\x60\x81\x10\x84\x1d\x0e\xc9\xfe\xf8\x1d\x49\xe7\x98\x89\xa7\x3e\x31\xe2\x69\x8e\x01\x78\x40\x0e\x1e\x00\x18\xb6\x54\xfe\x89\xb2\x78\x0f\xbc\x21\xee\x39\xc4\x79\xd1\x13\x2f\x46\x9c\x2c\x79\xd6\xed\x13\x7d\x33\xf4\x8f\xbe\x0a\x92\x31\x25\xa0\x61\x23\x64\x15\x12\x84\xc4\xe4\xf8\x40\x7f\x39\x26\x16\xf5\xdf\xe9\xf3\xf1\xd9\x94\xd1\x8d\x1b\x8f\x4e\x41\x1b\xc4\x80\xc7\xef\xd6\x6f\xd6\xac\xdf\x4b\x2f\x01\x86\x1c\xd1\x84\xad\x32\x80\x08\x7a\x08\x06\xbb\x10\xa7\x60\x4f\x81\x77\x21\xce\xae\x2b\xb7\x0b\x06\xbb\x47\x39\x7d\x9b\x60\x35\x27\x48\x6c\x83\x02\x22\xc6\xa1\x25\xc8\x97\x6d\xa5\x69\x64\x3d\xfa\x25\x87\x6d\x00\xc3\x54\x00\xf1\x1a\xa5\x36\x14\x22\x21\x5f\xa5\xd6\x9b\x12\xe8\x5d\x4d\xad\x81\x9e\x18\xe8\x89\xb3\x1f\x86\xaa\x93\x10\x7a\x12\xc2\xfa\x11\xee\xcd\x88\x9f\x61\x0f\x8f\x0b\x52\x40\xa8\xee\x93\x5d\x2c\xb7\x87\xb3\xb1\x62\x5b\xaf\x72\xda\x6d\xc5\xc3\xf9\xa3\x97\xc1\xee\x5b\xce\xb6\xa6\x72\xbb\x87\xc1\xee\xcf\xef\x0a\x2c\xb7\x83\xc1\x1e\xc4\xaf\x44\x30\xfa\xa3\x29\xed\xd6\xa4\x68\x73\x34\xfd\x4b\x10\xd8\x5f\x42\x80\x95\xff\x59\x05\x7a\x15\x3b\xc8\xa3\x2e\xf9\x5d\x1e\x22\x7d\x5b\x42\x50\x78\xb7\xfc\x6e\xc8\xa3\xdb\xf2\x6e\x17\xbe\x6b\x7b\xff\x49\x97\xe5\x45\xc8\xa3\x2d\x79\x84\xd6\x93\x34\xb4\x3e\x17\x0d\xc9\x43\x5b\xc8\x20\xf6\x97\x47\x8a\x72\x49\x1a\xb6\xa2\x21\x00\x08\xc6\x56\x36\x14\x8d\xf2\x46\xa8\x0b\x69\x6a\xef\xf5\xb4\x5b\xfa\xea\x0e\xc7\xd0\x93\x18\xd6\x47\x1c\xa3\x67\xec\xd5\x1b\xec\xde\xe5\x6c\x6b\x60\x5b\xc5\x8c\x9e\x76\x08\x1b\x94\x60\xe7\x59\x8c\xc1\x1e\x59\xce\xb6\xb1\x6c\xab\xd4\x7e\xfa\x0b\xed\x54\x62\xac\x2d\x36\x36\x29\x31\x39\x25\x29\x21\x20\x30\x30\xc0\x9a\x18\x6b\x8d\x91\x03\xfc\x03\x45\x7a\xe2\x2f\xeb\x02\xac\x49\xf4\xe7\x04\xff\xc0\x84\xf8\x64\xa1\xcf\xdc\x75\x9f\x7e\x72\x6c\xe7\x9a\x5d\x47\x0e\x67\x4f\x98\x3a\x6b\x21\x6a\xb4\xa7\xeb\xf9\x0f\x56\x97\x5e\x2b\x59\x99\x3d\xbf\x00\x4d\x7d\x73\x56\x42\xab\x2b\x5b\xdf\xb9\xea\xff\xf9\x1d\xcb\x83\x8b\x4b\x77\x64\x8d\x4d\xcb\x1c\x3a\xb5\x68\xe4\xee\x4b\xe6\x63\xc7\x8c\xdf\x9d\xca\x2b\x98\x05\x12\xa4\x57\xfe\x24\xcd\x97\x4e\x82\x0f\x84\x40\x2c\x24\x42\x63\x98\xa6\xf6\x55\xa0\xdd\x13\xaa\xfb\x6a\xa5\x27\x56\xda\x57\x26\x3d\x9e\xe5\x76\xcf\x38\xc5\x28\x56\x91\xcd\xae\x29\x41\xce\x33\xab\xc1\x1e\x5e\x4e\xc7\x9b\x1e\x28\x75\x9c\x97\xc3\x0d\x4a\xa3\xa7\x67\x4a\x92\x95\x4b\x64\x52\x9c\x92\x22\x58\xd9\x51\x4a\x5c\xc3\x46\xe6\xc4\xe4\x84\xf8\xc0\x00\xda\x51\x6b\x4c\x6c\x92\x85\xf7\x32\x29\x29\x31\x96\x72\x00\x25\x26\x47\xf1\x5f\xa2\x5c\x7f\x60\xbc\x91\x6c\xc8\x92\xbe\x73\xfe\xc2\xed\x3b\xe7\xce\x2b\x5e\xdc\xa7\x53\xfb\xde\xbd\x56\xf7\xc2\x2f\xed\x44\xf2\xae\x9d\xa4\x82\x5d\xe9\xd0\xab\x77\xc7\xf6\x7d\x89\x2e\x1d\x9f\x4e\x17\xd7\xce\x2f\xde\x93\xd3\x7e\xc1\xce\x9d\x79\xba\xd7\x87\x0f\xe9\xd9\xf1\xf5\xb4\xb4\x6e\x15\x57\xe6\xef\xde\x95\xd7\x7e\xfe\xee\x9d\x79\xf2\x1b\xc3\x87\xf6\xec\xf4\xc6\xb0\xa1\x3d\x7e\x6f\x2d\x4e\x68\x0d\x02\x0c\xaf\x7c\x20\xfe\x2e\x9d\x80\x08\xb0\x41\x02\x2c\x7c\x8e\x1c\x84\xd1\x93\xb0\x6a\xc1\x0f\xa2\x27\x41\x4c\x28\x62\xc2\x98\x50\x28\x75\xf4\x61\x6c\x27\xc4\xd4\x61\x3b\x43\x9d\x18\xb6\x33\xaa\x17\xcd\xea\x2d\xc1\xea\x2e\xd0\x18\xcc\x18\x11\x66\x50\x22\xd1\x53\x3e\x45\x47\x1a\x55\xd1\x52\x1a\x3d\xbd\xc8\x19\x95\xc2\xc4\x84\xb1\x8b\x89\x85\x0e\x25\x53\xa1\xd0\x59\xac\x36\x99\xb2\xae\x86\x14\x9f\x82\x74\x4c\x6a\x04\x7f\x4b\x4a\x72\xb2\xca\xa8\xe1\x93\xb7\x0f\x6e\x7d\x62\x57\xc1\x89\xbe\xe3\x46\xa1\x36\x6d\x76\x66\x95\xdf\x1d\xdc\xe9\xf4\x5b\x9f\x12\x82\x3e\x5b\x35\x9d\x6c\x8a\xde\xb9\x2e\x66\xca\x94\x36\xf1\xc3\x5e\xed\x36\x08\x2d\x4a\xb7\x4f\x9e\xb2\xbc\xfd\x8e\x0f\xf7\x2d\xec\xb3\xa6\xdb\x1b\x64\xe6\xbc\xcd\x95\xbb\xfe\x99\xd8\xba\xed\x57\x9d\xc7\xa3\xdd\x41\x59\xf3\xa6\x2c\x13\xbe\x1d\xb4\xbc\x7b\xc3\xde\xcd\x5f\xe9\x3b\x0e\x10\xbc\x25\x46\x83\x83\xdb\x1c\x83\x6a\x71\xa4\x2a\x8b\x43\x89\x65\x36\xc6\x11\x30\x17\xad\x91\x56\x90\xe5\xe8\x4b\x40\xb0\x90\xd4\xc1\x6b\xe4\x51\x60\x04\x3d\xd8\x7d\xe3\xec\x22\x1f\x7c\x93\x29\xc5\x22\x63\x41\x67\x30\x07\x5a\x74\xb1\x36\xbc\x30\xf3\xbf\x73\x63\x57\x1e\xd1\xa3\xa5\x3d\x27\xc7\x2e\xcc\x7c\x80\xdf\xf8\x0a\x6d\x41\x3d\xda\x4d\x1f\x4f\x12\xc9\xd7\x3d\xc9\x2c\xf2\x4d\xf1\xa0\x8c\x4e\xef\xa1\x1e\x4c\x57\x63\xe9\x3b\x53\xdc\xde\x89\xfc\x31\xd6\xd9\x92\x4d\xe6\xa4\x44\x8c\x6d\x29\x81\x26\x13\x4e\x99\xfc\xeb\x02\xdb\xfa\x23\xb8\xcf\xa6\x21\xb6\x05\x0f\xa7\xe2\xb6\x5f\x92\xb7\xc8\x8e\x8e\x19\x53\xd0\xaf\x28\x2e\xea\x2a\x4a\x47\x11\x5d\x32\x3a\x92\x62\x32\x0c\x10\x84\xe1\xc1\x42\x4f\xc9\x0e\xbe\x10\xa5\x8e\xbb\x48\x47\x57\xe4\xe3\x5e\x1e\x4c\x1d\x49\x90\x5d\x4f\xf7\xfa\xb8\x20\xda\x54\xcd\x14\x89\x9a\xe7\x9a\x16\xc9\xac\x13\x04\x1b\xea\x44\xae\x36\x40\x0d\xf4\xeb\x3c\x51\xed\x06\xe4\xe2\xa9\x19\xa5\x07\x66\x0a\x9f\xf5\x2b\x1c\x8f\xfa\x90\xb7\xc7\xae\xed\x4f\x7e\x1d\x80\xa2\xc8\x77\xfd\x19\xe5\xaf\xc3\x4a\x31\x51\x3c\x04\x5e\x10\x02\xf4\x65\x76\xe0\xe6\x43\x46\x4e\xfd\xe2\xe6\x2e\x20\xda\x68\x35\x52\x63\x67\x4c\xc0\x45\xa8\x80\x8c\x2c\x25\x23\x51\x41\xa9\xe0\xb3\x8f\x24\xa2\x0b\xfb\xd0\x6e\x40\x10\x4e\xee\xa2\x44\xf8\x1c\x3c\xc0\xc2\xde\xa4\x88\x4f\x9d\x08\x7b\x29\x30\x2e\xdb\xb8\x69\xf1\x97\xb3\xd3\x07\x88\x07\x87\x92\xbb\x1d\xe6\xe7\xed\x5c\x31\xfc\x1c\xa3\xa3\x26\x8e\xc4\x3e\xf8\x10\x08\x60\x04\x3a\x72\x0a\x72\x35\x4c\xb4\x61\xec\x43\x82\xd0\xf7\x38\xb2\x10\x10\x2c\xa7\x7e\x6c\x0a\x5c\x01\x3d\x84\xaa\x9c\xd1\x51\xce\xe8\xb8\x31\x94\x9c\x4f\xa5\x30\x6f\x15\xc0\xdc\xd5\xf2\x26\x13\x5e\x7a\x69\x42\x93\xc1\x0d\x5b\xb6\x6c\xd8\xa0\x79\x73\x40\x60\xaa\x9c\x2f\x18\xb8\x27\x34\x03\x25\xcd\xd5\x15\xd2\x27\x2d\x56\x94\xb0\x7f\x33\x1e\x54\x34\x53\xae\x0f\x80\x61\x22\xd5\x42\x4f\xaa\x85\x3e\xb4\xbd\x26\x6a\x8b\x9e\xf4\x66\x4f\x7a\xb3\xd3\x58\x31\x1d\xf4\x34\x28\x46\xa4\xb1\x4c\xae\xbd\x30\x60\x6b\x0c\x16\x92\x0c\xa6\x84\x78\x93\xd9\xc6\xf5\x42\x67\xe4\xe6\x24\x45\xf4\xbc\xfb\xe0\x87\x7b\xe2\xdd\x9f\x7f\xba\x2b\x94\x2c\xc8\x5f\x36\x0f\xe7\xe6\xe5\x2e\x14\xf0\x18\x72\x84\x9c\x40\x49\x28\xe1\x6f\xd4\x0a\x35\x26\xd7\xc8\x29\x9f\x9f\x3e\xbb\x71\x97\xdc\x7c\x70\xff\xfa\x37\x80\xa0\x18\x00\xdf\x92\x3e\x00\xb9\x9a\x2e\x89\x92\x22\x3d\x1b\x6c\x80\xc4\x14\x42\x8c\x53\x04\xa7\x76\x63\x81\x5d\xa4\x31\x85\xd5\x28\x25\xd5\x4c\xc0\xb7\x4a\x49\x1e\x36\x85\x8b\x57\x72\x77\x9d\x66\x23\x92\x0a\x20\x26\xd0\x5e\x5b\xe0\x35\xd0\x3a\x5c\xcd\xbb\x2d\x2e\x9e\xa6\x5c\x11\x0c\x16\xd6\x92\xc1\xa0\xe8\x9d\xd7\x0d\xcc\x37\x29\x01\x16\x83\xda\x5e\x74\x12\xaa\xb6\x0b\xd4\xc7\xd4\xe0\x66\x04\xa1\x68\x31\xa1\xa2\x1d\x7a\x34\xbe\xe7\xe2\x89\x8b\xd7\x95\x20\xe1\xd6\xf9\x07\x34\xac\x99\x8e\x3f\x5f\x88\x1b\xce\xde\xdc\x73\xc2\xca\x4d\x4b\xce\x3e\xfa\x74\xff\x67\xe4\x33\xd2\x17\x10\x0c\xa8\x7c\x20\xfc\x43\xa9\x8b\xad\xf6\x18\x3e\x94\x20\x9f\x6a\xea\x82\xe9\x49\x30\xa3\x0e\x7c\xb8\x5d\x83\x38\xe5\x32\xa0\x54\x65\x33\xa0\x0c\x25\x8e\x1d\x0d\x04\x34\xc1\xee\xa3\x91\x74\x1f\x1e\xb9\x81\x41\x89\x75\x32\xc9\x1c\x0b\xec\xf9\x60\x83\x3d\xac\x9c\x6e\x95\x48\xd7\x01\x4d\xa0\xdd\x30\xf0\x5e\x3c\x35\x86\xcc\x53\x8a\x51\x35\x54\x2f\xc9\x3a\x69\xb3\xa2\xec\xbc\xf9\x05\x95\xd3\xf2\x4b\x1c\x9f\x5c\xfa\x31\x6b\xe4\xb4\xf9\x95\x40\xd2\x48\x65\x69\xc1\xac\xec\x65\x1b\x57\xe5\x09\xf1\x38\x7b\x02\x82\xdc\x8c\x77\xbf\xbd\xfd\xf1\x40\xa5\x5e\xac\x7d\xf6\x89\xff\xdc\x3d\x34\x71\xf1\x92\xf9\xb3\x73\x30\x60\x48\x02\x10\xbb\x50\x59\xf5\x04\x5f\xe8\xa8\xf5\x00\x4e\xb3\xc0\xfa\x8a\xf4\x22\xa3\x15\x51\x03\xe8\xec\x14\x8d\x72\x10\x8b\x72\x14\x0f\xd9\x8b\x07\x94\xfc\x26\x46\x7c\x80\x95\xab\x34\x4a\x40\xd1\x46\x9d\x90\x7d\xee\x5c\xa9\x23\x1d\x2f\x39\xe9\x98\x8b\x4e\x06\xa2\x1f\x0a\xc9\x7b\xa8\xdb\x18\xe1\xd7\x8a\x26\xf8\x5c\x2d\x40\xb0\x9e\xea\x40\x7d\x4a\x45\x38\x0c\x56\x69\x40\xb4\x59\x44\x69\x70\x32\x9f\x69\x00\x32\x28\xa2\x93\x7b\x3a\x1f\xc4\x28\xd2\xc5\x29\x21\xce\x8b\x92\x2e\x84\x5d\xa4\xdc\x36\x96\xb3\x11\xf0\x77\x12\xab\x63\xde\x9b\xda\x09\x33\xb5\x37\x02\x65\xa9\x29\xc0\x1f\x53\x6f\x6c\x4b\x08\xe4\xdc\x64\x22\xa3\xd3\x5d\xea\x84\x7f\x74\xbc\x5f\x6f\x54\xce\xe9\x1f\x7f\xbf\x7d\xfc\x0f\xe3\x3e\xe3\xf2\x29\x73\x57\x6d\x5e\x90\xd9\xb6\x11\xbe\x8d\x6f\xec\x21\x13\x5b\x90\x7f\xee\xdd\x27\x8e\x1b\x1f\xcd\x9c\x6d\xdf\xb0\x72\x7f\x52\x2d\xc0\xb0\x80\xd2\x1f\x26\xed\x07\x7f\x88\xa6\x36\xcf\x29\xc2\xee\x3d\x60\xe3\x8f\x5c\x7b\x00\x55\x3d\x08\x70\x15\x14\x25\xcc\x55\x0e\x02\xac\xb2\xa8\x7b\x4a\x2e\x24\x25\x19\xa0\xa6\x40\x95\x3b\xa0\x5a\x08\x84\x37\x62\x6e\x56\x82\xff\xc4\xd3\x47\xbf\xfe\xf3\xd2\x15\x52\x81\x7a\xa3\xee\x57\x07\x6e\x89\xdc\x96\x39\x33\x7f\x85\xb4\x7f\x93\xf8\xe8\xfe\x02\xf2\xc7\xf5\xfb\xe4\x37\xd4\xca\xd1\x1e\xad\x44\xbb\x25\xc7\xf8\x09\xbd\xda\x1c\xb8\x73\x78\x4d\x41\x09\x20\xaa\x81\x20\xd6\xa4\xdc\x97\x21\x5e\xab\xe7\xce\xa1\x60\xb4\x4b\x06\x57\x05\x87\x2a\x05\x47\xc8\xca\x2c\xa8\x58\xd3\xb1\xfd\x63\xdc\xb7\xe2\x81\x70\x53\xea\xf0\xf8\xb0\x14\xb4\x16\x40\x80\xf1\x94\x2f\xbe\x5c\xcb\xa3\xa1\x6e\xf5\xd8\x06\xd0\x17\x06\xd0\xb7\x3b\x85\x8d\xc9\x97\x4d\x1f\xc0\x78\x61\xa3\xba\x64\x43\xa9\xae\x43\x2a\x84\xd8\x98\x6c\xf9\xd9\xf8\xc8\xea\x5d\xd5\xc4\x1e\x60\x50\x62\x5c\x99\xa5\x2a\x89\x1a\x65\xb9\x44\x12\x02\x8b\xb4\x38\xbf\x10\x8f\x39\xe3\x93\x29\xdb\x1e\x4c\x1c\x32\x61\x61\x65\xf9\x55\xc7\xdc\x09\x83\xc7\x3f\x38\x5e\xf6\x73\xe1\x86\xc7\x85\xab\xe6\xcf\x5b\x4d\x7e\x1c\xb3\x68\xe1\xdd\x85\x8b\xc5\xc4\x31\xc5\x0d\x1b\x7d\x38\xf5\xa3\x7b\xf7\x3f\x9c\x72\xb4\x51\xc3\xe2\xd1\x87\x6f\xde\xac\xd8\x3a\x7d\xfd\xda\x47\xcb\xf2\xc5\x90\x45\x93\xc6\xe5\xe6\xde\x5d\xc2\x6c\xf8\xb0\xca\x4a\xe1\x11\xef\x67\x0d\xe8\x03\xce\x74\xa7\xba\x97\x66\x7a\x62\x66\xbd\x14\xcc\x4c\x4f\x58\x18\x01\x02\x3f\x32\x6b\x44\x54\x63\xd4\xed\x5e\x6e\x16\x81\x45\x40\xbc\x47\x4c\x1a\x8c\x01\x06\x90\xac\x49\xd4\x4a\xd4\xa4\x26\x3e\xa9\x3a\x9a\x16\x9a\x37\xdd\x3a\x68\xd6\x91\xb4\x71\x17\x72\x6e\xfe\x43\xec\xe4\xdd\x1a\xb6\x6f\xff\x22\xbf\xa4\x6e\xaa\x51\x94\x39\x7d\x75\x3e\x9e\xd3\xb6\xc7\x8c\xfb\x39\x2b\x7f\x9c\x41\x3e\x22\x3f\x24\x93\xde\x24\x53\xda\x28\x7e\xf7\x78\x42\x8f\x4e\x87\xbe\x39\xb2\x6e\xcd\x71\xa8\xac\x84\x6c\xea\x01\xc7\x88\x6d\x20\x16\x4c\x7f\x01\xd2\x51\x7f\xf6\xb7\x30\x11\x0c\x2e\x96\x30\x88\x39\x32\x7a\x67\x6d\xea\x95\x7b\xd2\x3b\x6d\xf4\x9e\x2f\x85\x89\x48\x06\x83\x37\x80\xc1\x25\xca\xe6\x77\x02\x82\x0e\xf0\x89\x30\x5d\xec\x04\x32\x04\xba\xc8\x18\xfd\x95\xda\x75\x1e\x4b\x21\x29\x00\x25\xa1\x00\xe4\x81\x27\x54\x5c\xa2\x66\x6b\x81\xe3\x04\xca\x5a\x89\xa6\x9f\x60\xfc\x5d\x8b\xf2\x85\xdb\xc2\x65\x10\x40\x07\x35\x81\x2a\x0c\x0d\x1c\x38\x13\x5d\xb5\x5b\x11\xdd\xbc\x3a\x0b\x29\x84\xdb\x15\x47\x85\xd6\xec\x4f\x68\xb0\xd5\x51\xb6\x05\x00\x41\x36\x9c\x10\xee\x88\x9d\x9d\xb4\x70\xe7\xcc\x68\x91\xca\xed\x12\xa3\x85\x53\x92\x24\x84\x3a\x46\x09\xbd\x2a\x8a\xf1\x62\x24\x1c\x25\xd9\x2b\xc9\xc2\x32\xc0\xd0\xb9\xf2\x4f\x61\x0a\x1d\xeb\x20\x88\x86\xae\xcf\xf1\x5d\x51\xf4\x24\x8a\x8d\x75\xa8\x33\xcf\x85\x50\x03\x13\x61\xd1\x00\x2f\xf4\x5f\xa0\xfa\x2f\xea\xbc\x52\x52\x9e\xfa\xaf\x64\xd5\xf2\xeb\x68\xc8\xeb\x4c\x8f\x87\xe3\xf8\xd7\xe6\xf7\x18\x9b\x9e\x36\xa3\x04\x7f\x7d\xe8\xa3\x6b\xdb\xc6\x74\x3c\x5e\x95\x2d\xaf\x9f\xba\xb8\xc7\xa2\x81\x63\xd2\xa7\x8e\xee\xbf\xed\xf4\xf9\xfd\xfb\x8a\x47\x77\x5d\x4b\x3e\xa9\x4a\x9f\x01\xc3\x48\xf2\x92\x7c\x43\x2a\x84\x24\x68\x0d\x23\xc0\x1e\xaf\xc6\x26\x1e\x94\x62\x0f\x9e\x01\x95\x2b\x7e\xf1\x1e\x8c\x44\xbf\x38\x45\xf2\xf0\x63\x47\x1e\x06\xa5\x09\x76\xea\x5b\xb9\x3d\xde\xa0\x84\x3a\x2f\xd0\x94\xb5\x4e\xb9\xbd\x4e\x9c\x62\x75\x5e\xf3\x33\x28\x2d\xb1\xab\xdc\x52\xbb\x65\xe3\xc2\x9b\x92\x6c\x62\x3d\xb2\x08\x32\x8b\x4b\x4c\x10\x1d\x23\x62\x9d\x6c\x12\xd9\x99\x85\x07\xf8\xd8\x6c\x32\x51\xa7\x2d\x9a\x90\xcc\x63\x97\x14\x33\xe3\x84\x7c\xce\xfb\xe6\xc1\x23\x49\xc9\x79\x9d\x96\xad\x34\xfb\x65\x1e\x4b\xef\x3a\xa7\x4b\xa2\x79\x55\xc6\x12\xd9\x4c\x4a\x88\x72\x96\x9c\x39\xe0\xe5\xbd\x1c\xd9\xae\xf4\x3b\xf8\x72\xcd\xe6\x17\xd3\x1f\x93\xb5\xef\x7b\x7b\xdf\x45\x33\x1e\xfe\x83\x86\xbd\xf7\xe4\x37\xdf\xae\x7d\x5e\x1e\x57\x03\x35\x68\xdc\x72\xf2\x3a\xf4\xd7\x6f\xe4\xfb\x5d\x3d\xbb\x7f\x7f\x71\x3b\x12\x56\xd7\x6d\xe1\xb8\xf1\xdd\xbd\x03\x68\x21\x5a\x7b\x9a\xe4\xfc\xf5\x88\xac\x3a\x5e\xcf\x3a\xd1\x16\x7f\x1f\xed\x44\xa1\xc8\x8c\x3e\x78\x78\x8f\xf4\x23\xcb\x97\xad\x4d\x1b\xa8\x47\x7f\x87\xff\xc2\xb8\x18\x06\x20\x85\x4b\x76\xd0\x81\x27\xb4\x73\x8f\x9e\xdd\xe1\x02\x99\x9e\xc8\x4c\x1c\xf4\x22\xe6\xf6\xcb\x55\x6a\x15\x4f\x7e\x51\x4d\xf9\x51\x02\x33\xa5\x82\x70\xd1\x51\x94\x7b\x0a\xd7\xdb\x85\xeb\x9f\x74\xbc\x86\x1e\x3e\x42\x33\xc8\x02\x0a\xba\x74\xc1\x21\x78\x37\x08\xb0\x1b\x40\xcc\xe1\x91\x7b\x20\x44\x42\x4f\x77\x7f\xa9\x89\x9e\x80\x7b\x48\x4b\x9c\xe2\xe7\x6c\xd2\xc3\x8f\x05\x51\xcc\x13\x19\x9c\x42\x88\xb4\xa6\x26\xc1\x18\xed\x8c\x38\x64\x2b\xa2\xa7\x34\x18\x89\xae\x19\xad\xfa\xc9\xe8\xdd\xe8\xce\xcf\x0f\x27\x0d\x9b\x92\x4b\xbe\x27\xa7\x51\xb3\xec\x0d\xe4\x2b\x52\x86\x62\x66\x15\x2e\xc9\x27\xdf\x48\xf6\x13\x65\x69\x9b\xea\x46\x97\xcc\x39\x71\x0f\xef\x76\xfc\x91\x37\x1d\xe9\xd6\xcf\x1a\x3d\x6d\x0c\xd3\xbd\xa9\xd4\x23\xdc\xa4\xda\x13\x06\x6d\xb5\x76\x52\x13\xeb\x32\x84\xc4\xaf\x9c\x19\x40\x93\xc6\x38\x06\xbb\x9c\xb1\xa0\x89\x09\x97\x68\x8d\x02\x63\x52\x22\x93\x1a\xb0\x58\x63\xa9\xb8\x60\x9a\x4d\x9b\x78\xe0\x7b\x33\x94\xdc\xff\x85\x10\x72\x76\x05\xf2\xdc\xf7\x3d\x0a\xb2\x1c\x0f\xde\x59\x70\xf8\xca\x29\x65\xcb\x9e\x30\x74\xe9\xbb\x27\x68\x02\x4a\x5e\x72\x1e\x25\x6e\x27\x8e\x6f\xdf\xdb\x48\xfe\xfb\x64\xe9\xcf\xe4\xfb\x15\x07\x00\x73\x2e\x9f\xa2\x5c\xf6\x82\x00\x68\xf2\x2f\x3c\x66\x9c\xf3\x76\x52\x45\xb9\xea\xe3\xc6\x47\xd1\x64\xa1\x9e\x5b\xd4\x09\x46\x6a\xb5\x69\x6a\x8f\x6d\xbb\xd1\x86\xa3\xa8\xc6\x66\xb4\x99\xdc\x3a\x71\xed\xdc\xdd\xbf\x7f\xb8\x21\xd9\x77\x91\x73\x67\x53\x2f\x91\x73\x3b\xb0\x64\x7a\x92\x87\xfc\x2b\x7b\x3e\x42\x66\x0c\x80\x38\x25\xed\x38\x25\xaf\x83\x53\xa2\x9e\x89\xcf\xbc\x44\x99\x0d\xab\x97\xc6\x28\x8a\x06\x4d\xb4\x66\x50\x3c\x34\x26\xd2\x18\x1d\x50\xf5\x27\xdc\x73\xe4\xe2\x2f\x2a\x86\x0a\xab\x1c\xf5\xf1\x54\xbc\xd5\x51\xb1\x49\xb2\x17\x91\x7a\x55\xed\xc7\xd1\xf6\xf5\xd0\xf2\x5f\x64\x5d\xd1\x63\x91\x8b\xb7\x7b\xfb\xb2\xb6\x45\x6b\x55\x7b\x17\x1c\x9b\xca\x84\x6c\x47\x53\x3c\x14\xcf\x77\xcc\x64\x6d\xf9\x02\x82\xe9\x54\x3a\xee\x50\xe9\x88\x84\x21\xff\x07\xd2\xf1\xe2\x7c\x48\x89\x50\xbd\x6b\x44\xdc\x01\xbf\x88\xc8\x08\x9c\x7a\xa0\x45\xc4\x1b\x74\xa7\x84\xbb\x50\x53\x53\x16\x99\xc1\xb1\xd1\x80\x8a\x8e\x92\xc5\x8a\xa3\x63\xb0\x50\x25\x39\xcc\x75\x88\x77\x3a\x91\xbf\xed\x0a\x79\x50\x88\x51\x02\x0a\xfc\x06\x05\x46\x96\x25\x93\xbb\xc7\x4f\xa3\x7b\xc7\x46\x6f\x4b\x22\xfb\xb0\xe1\xd8\xc8\xf4\x9d\x28\xf1\xfc\x6c\xd4\x11\x8d\xfc\xe1\x06\x8a\x26\xbf\x92\xca\xa9\x7f\x92\x2f\x1b\x36\x41\xed\x37\xaa\xbc\x93\x3c\xf8\xd8\xf5\xd4\x8e\x9d\x26\xcd\xd3\x08\x94\x66\x54\xed\xc0\xb2\x06\xc6\xd4\xcd\x7a\x34\x41\x89\xd3\xa3\x54\x97\x01\xe5\xd9\x15\x63\x69\x02\xdd\x26\x48\x1e\xc7\x1d\x31\x65\x65\xf8\xee\x71\x3a\x7c\x03\x25\xbb\x63\x19\x9e\xc0\xe4\xe7\x03\xba\x99\xca\xb3\xd1\xf0\x7f\x81\x8c\xf9\xbb\x12\xd0\xd4\xb2\x32\xc9\xce\x9e\x4a\xa2\x19\xd0\x05\x7a\xe8\x07\x0d\xb4\xf1\xac\xd3\x79\x33\xfa\x28\x71\x12\x55\x00\x4d\xde\x62\x54\xc3\xaf\x04\x35\xf9\x14\xbc\x8e\x3f\xb9\x72\xeb\xb7\xb2\xdc\x79\x53\x57\x21\xc9\xfe\xe4\x9f\x2b\x0f\xee\x9e\x99\xb9\xa4\x60\x11\x54\xf1\x47\xe2\xfc\x99\xa4\x8d\x3c\x9d\x89\xb7\x56\xd0\xdc\xb1\x64\xc0\x2c\x0a\x6d\x69\xec\x04\xfd\x60\x24\x4c\x87\xc5\xb0\x1e\x8a\xa1\x14\x3c\x52\x95\x26\x2c\x11\x93\x25\xcc\x7e\x37\x27\xcb\xed\xe4\x5e\x72\x9a\x3c\x45\xce\x96\x0b\xe4\x1d\xf2\x21\x59\x9f\x4a\x69\xd5\x71\xe6\xa1\x04\x84\xac\x38\x54\xa1\xcc\x5b\xf4\xbd\xe3\x13\x3c\xf2\x3f\x78\x7b\x09\xf1\xa7\x2c\x6c\x88\xcb\x1d\x33\x2b\xae\x55\x69\x41\x18\xa5\x54\x82\x5a\xff\xa6\x05\xe0\x2a\x5f\x4c\xd2\x13\xd0\x48\xac\x54\x2c\x3d\xbe\x89\xea\x53\x55\x8f\xe5\x6e\xdc\x7a\xf7\xd5\xf2\x55\x33\x34\x1a\x21\xd0\xf8\x68\x27\x52\xcd\x93\x32\x10\x39\xc0\xaf\xee\x3c\x41\xcd\xbe\xf4\x38\x81\xf6\xcb\x8c\xac\xc8\x4c\xf7\x68\x2b\xba\x83\xee\xfc\x53\x66\x22\x31\xab\x88\xd5\x5c\x46\xc7\xe0\x4d\xf1\x1d\xea\x4e\x52\xf0\xb0\x27\xc3\xc5\xb5\x8e\xcd\x8e\xb3\x8c\x36\xa7\xb4\xea\xe1\x55\x05\x79\x32\x05\xd2\x0e\x8a\x93\x10\xad\xf4\xb8\xa9\xa7\x82\x25\xe0\x54\xf1\x1d\x13\x2c\xc4\xd8\x8c\x54\x11\xb5\x1e\x3f\x8e\xbf\x3c\x26\x0e\x79\x52\x44\x09\x59\x27\xbe\x05\x18\xa6\x54\x3e\x90\xf4\x54\xeb\x8d\x10\x09\x4d\xb4\xf9\xb6\x96\x11\x3e\x06\xd7\x44\x49\x74\x4b\x94\xfc\x71\x95\x3e\x27\xaa\x11\x44\x80\x8d\x63\x22\x3a\xae\xd2\xa6\x14\x49\xbf\x9c\x3c\xb2\xef\x25\x7f\xad\xc2\x2b\x91\xd7\xde\x7d\xc8\x6b\xf9\xb1\xf2\x23\x87\xae\x08\xd7\x4a\x4a\x2f\x08\x78\xf7\x0d\x72\x7c\xd7\x6e\xd4\xe4\x52\xfa\x55\xd4\x7a\xcf\x6e\x72\xec\x53\x8c\x04\x14\x40\x7e\xfa\x6b\xd4\x13\x72\x1f\xf9\x39\xa0\xca\x2b\x94\xf1\x6c\xd9\x0c\xaf\x29\x9e\xfe\x3c\x65\xf1\x64\x01\x9c\x16\x58\xd6\x24\x4e\x8a\x97\x80\x9e\x35\xcd\x02\xf7\x6d\x4e\xea\x8d\x56\xe6\x2b\x68\x7e\x6f\xad\xf2\xb2\xb6\x68\x94\x5e\x86\x83\x1f\x22\x3f\xf2\xf7\xdf\x64\x37\xea\xbb\xfe\x9d\x77\xf2\xc9\x46\xdc\xd4\x41\xfd\xd2\x1f\x67\xaf\x7f\xbb\x69\x45\xee\xbc\x8d\x02\x60\x18\x53\xe9\x29\xc9\x94\x7f\x01\x10\x43\xa9\xf2\xb0\xd6\x50\xe3\x36\x7b\x70\x15\x55\x46\x4a\x88\x51\x33\x7e\x3c\x15\xf1\xe0\xf8\xab\x51\xe3\x55\x69\xb4\x17\xa5\xe5\xa9\x4e\xd2\xd5\x94\x5f\xc4\xd7\x28\xca\x56\x94\x9d\x89\xc4\xf9\xe4\x62\x87\x2e\x4f\x79\x6b\xa7\xbc\x2d\xbb\x78\xf4\xe0\x15\xe1\x0a\x9a\xc4\x78\x7b\x8d\x5c\xee\x75\x21\xf3\x0f\xd3\x53\xfe\x16\x93\xe3\x9f\x22\x84\x90\x85\xf1\xf7\x31\xb9\x57\xc5\xdf\x5d\x00\xe2\x59\xae\x1d\x16\x18\xac\x48\x41\x1c\x5e\x91\xd8\x0c\xc9\x8b\x95\x4e\x9b\x61\x2b\x06\x35\xcc\x32\x68\xc2\x2c\x2f\xae\x19\x4c\x62\x02\xdd\xdd\x11\x67\xba\xac\xa3\x69\x36\xaa\xe6\x3b\x3e\x46\x8a\x8f\xa3\xaf\xfe\x42\xfa\x55\xf3\x51\xaf\x4b\x8e\xb1\xc8\x96\xbf\x7b\xeb\x5a\x72\x1b\xbf\xe6\x78\x5f\xb2\xdf\xbb\x91\x73\x2e\xde\x51\xe0\x8d\x7f\x5c\x3d\x63\xd1\x72\xc4\x34\xa7\x0b\xf5\x5b\x13\x18\x5e\x44\xa9\x06\x5b\x2d\xda\x96\xc6\xdc\x6b\x27\x8e\xec\x32\x83\x4c\x98\x37\xf6\x75\x52\x18\x1b\xc2\xdd\x77\xac\x26\x1d\x0c\xe1\xd3\x29\x21\x6e\x39\x2e\x4b\x01\x19\xae\xe5\x3e\x87\xc2\xa4\xbf\xda\xec\x26\xc6\xe2\x5e\x7f\xdf\x47\xe6\x3b\x05\x3f\xcd\x3d\xbe\x6b\xdd\xb2\xcd\x85\x68\xd4\xc5\xa1\xe4\xc1\x77\x05\x84\x1a\xa3\x4f\xde\x5e\xbb\xad\x00\xe7\xb6\xbf\xbc\x76\xef\xfd\x49\x17\x32\x17\x14\xce\x1c\xd7\x37\x2b\x2d\xeb\x9d\x71\xfb\x3f\x9d\x78\x66\xf6\x82\x75\x33\x6e\x4c\x66\xbd\x6a\x04\x20\x16\xf2\x38\xb7\x81\xb6\x3b\x5a\xf6\xcb\x06\x8d\xcb\x2f\x57\x19\xcb\x3d\x68\x21\xa9\x77\x8c\xd4\x17\xd3\x24\xd3\xe3\x5f\x24\x53\x11\x20\x58\x42\x39\x55\x4c\xdf\x69\x82\x97\x15\xc9\xec\x4f\xfb\xac\x35\x83\x1a\x04\x4a\xe3\x6b\x14\x5f\x8d\xba\xc8\xa0\xd3\xc5\x80\x8d\x9a\x6f\x15\x07\x31\x8a\xc5\x88\x54\xde\x49\x78\x48\xbe\xc1\x47\xdf\xdb\xfa\xf6\xbb\x92\xbd\x22\xe6\x12\x79\x6c\xc0\x08\x7f\x25\xdc\xab\x88\x2d\xda\xf7\x5e\x91\xf0\x39\x00\x62\xf1\xbb\xe8\xe0\x78\x47\x63\x05\x74\x2c\xdf\xd1\x06\x78\x5a\xa3\xa7\x31\x6d\x80\x04\x6e\xd3\x6a\xf2\x60\x3d\x01\xd3\xd9\xb5\xab\x8e\x6f\x8a\x1d\xdf\x5e\xa9\x14\xf7\x3f\xee\xa2\xda\xd1\x66\x00\xf2\xcf\xbc\x8f\x7d\xb5\x66\x41\x63\x40\x9d\xaa\xa9\x89\x07\xaa\x05\xda\x8f\x9e\xf8\x71\x1b\x2f\x78\x32\x6b\x2a\xab\x3b\x1f\xbe\xa3\x0c\xb0\xa4\xa0\x04\xba\x31\x33\x3a\x6c\x3a\x46\x0c\xfa\x3a\xac\x36\x8a\xda\x86\x82\x6b\x45\x7e\x75\x9c\x6c\xde\x47\xae\x5b\x2c\xe4\xec\x3e\xb2\xa5\x0c\x9d\x2f\x7b\x57\x78\x54\xe1\x61\x3f\x21\x7c\xf3\xb8\x8b\x58\x73\xdc\xb8\x27\x9f\x53\xfa\x10\x34\x01\x10\x2f\x71\xff\x3b\x4a\xcb\x02\x27\x3d\xee\x46\x58\x0b\x89\x03\x12\x99\x7f\xd5\x67\x43\x01\xec\x80\x43\x20\x52\x97\x5b\x75\x29\x57\x5e\x27\xef\x96\x4b\x64\x7a\x49\x00\x99\xd1\xae\x97\x41\xa5\x9d\x3b\x5c\xfe\x2f\x01\x2f\x40\xa9\x5f\x91\x44\xf4\xd9\x37\x64\x3d\x59\xfb\x35\xba\x49\x12\xee\x0b\xf3\x70\x03\x47\x03\x47\x0c\x6e\xec\x38\x83\xef\xe2\xab\x8c\xa7\x7e\x00\xe2\x11\x4a\xa9\x07\x34\xd2\x52\xea\xa4\x47\x43\xa9\x93\x38\xb5\x3d\xe6\x08\x13\xf0\x70\x34\xe3\x36\xf1\x2c\x23\x9e\x77\xf0\xa7\xf8\xf3\x8a\x71\x8e\xfb\x38\x52\x58\xc1\xde\xdf\x0b\x40\x9c\xce\x7d\x5f\x1b\x78\x71\xf0\xe1\x6a\x53\x44\x60\x86\x86\xe1\x0e\x92\xf3\xa2\x5e\xd2\xd1\x8b\xaa\xef\x4f\x62\x18\x44\x00\x6a\x22\xb4\x7a\x72\x47\x88\xa8\x78\x28\xfc\xbd\x69\xd3\x72\x71\x5e\xd1\x52\xd6\xde\x4a\x72\x06\x7b\xc9\x73\x40\x07\x31\x40\x29\xb6\xeb\xdc\xe3\x66\xc1\x55\xda\xa9\xfb\x64\x51\x33\xf6\x3a\x76\x8c\x2c\x42\x99\xd2\xf7\xff\x4c\x5b\xab\x3b\xcc\xde\x53\x8b\xce\x35\x34\xae\x9e\x6b\xc0\x71\xae\xba\x44\x1f\x64\xd2\x51\xcb\x8e\x07\xd9\x25\xfb\x3f\x2c\x8a\xd1\x93\x33\x68\x3e\x6f\xb5\x26\xbf\x1b\xc5\xd9\xb1\x2b\xfd\x76\xa4\x0d\xd8\xcd\x0c\x43\xa1\xc1\x84\x1e\x4d\x27\xd9\xc7\x8f\xcb\x73\x1e\x75\x58\x2b\xe7\xb0\x76\x5b\xe2\x5b\x82\x3f\xd7\x21\x9b\x53\x87\x34\xa2\x5e\xae\x20\x1d\x53\x16\xd6\x88\xc4\x8c\x42\x55\x86\x5b\x7c\x74\x2f\xaa\x3b\x1b\xd5\xd9\x23\x1e\x21\x11\xf8\x33\x47\x6d\x40\x20\x56\xa6\x0b\x6b\x01\x40\x80\x50\x97\xd7\xb8\x07\x54\x12\xc3\x73\xd6\x56\x8c\x16\x56\x17\x16\x02\x93\x5e\xf1\x26\xba\x2d\x11\x10\xc0\x0a\x76\xc4\x92\x5c\x94\xfa\x74\xfa\x0a\x19\x54\x64\xe9\x80\x1f\x8e\xc4\x98\x05\x7b\xac\xf9\xdb\x9b\x09\xf9\x92\x3e\x16\x0b\xdc\x6f\x3e\x10\x7e\x14\x5f\x87\x08\xa8\x05\x99\x8a\xa5\x76\x1d\x9e\x2c\x1b\xec\x51\x55\xde\xc6\x42\x89\xb0\x04\xb9\xcb\xbf\x26\xc3\xc6\x16\x1e\x79\x79\xb1\x1d\xf3\xf0\xf4\x71\xd7\x5c\xdb\xe4\x65\xa9\xf2\x3b\x91\x1a\x58\xcf\xea\x1a\xc3\xc7\xda\x58\xbc\xcc\x6c\x7a\xc2\xd3\x79\x0b\x0e\xfc\x58\x8c\xfe\x96\x00\x8e\xee\x59\xa3\x18\xb4\x57\xcb\xe0\x39\xf7\xfd\x2d\x17\x11\xfa\xe1\xc0\xa4\x8c\xa1\xd9\xa5\x13\x4f\x4e\x39\x72\x5d\x8c\x25\x5e\xbd\x37\x5a\x57\x90\xbd\x93\xa2\xba\x65\x1f\xcc\xdb\x7d\xa4\xe7\xe0\x89\xc3\xda\x77\x2d\xec\x73\xe4\x1d\xe2\xbb\xa6\x8f\x61\x69\xbf\x0e\x77\x4f\xf7\x1e\x02\x98\xdb\xde\x28\x19\xc0\x17\x82\x61\x98\x62\x0a\x09\x65\xb4\x99\x0c\x14\xf6\x01\x6a\x95\x9c\x91\x65\x90\x9b\xd5\xd3\xe2\x18\x92\x87\xc0\x83\x4d\x6f\x3e\xb8\xde\xee\x29\xba\x45\x13\x3e\x30\xe4\x47\xc7\x62\xec\xe4\x28\x93\xd1\xc6\x21\x76\x23\x33\xd7\x62\xd4\x37\x65\x17\xc7\xeb\x8b\x4b\xc6\xa3\xc5\xf7\x4b\x56\xe6\x7e\xd0\xa5\xe7\x81\x85\xab\xb1\xf1\x11\xb9\xb6\x62\xa6\x0c\x8e\x4f\xf2\xc8\x0d\xe2\x90\x3e\xba\x54\x4c\xea\x15\x5f\x02\x04\x6f\xd2\xb1\x7a\x40\xc7\x2a\x1c\x3a\x2a\x86\x88\x48\xda\xb4\x0b\xf4\x16\xe4\x1e\xdb\x80\x4b\x44\xc3\xfa\xc7\x40\x36\x7f\xb6\xd5\x90\xc7\x58\x9b\xe0\x4b\x49\x64\xf8\x81\xca\x71\x6b\x2c\x1f\x00\xca\x6d\xdc\x7c\xe8\x6a\x8f\x7d\xd2\x94\x93\x63\xbf\x20\x4f\x26\xdd\x5a\x7d\xe8\x57\x8f\x7d\x1e\xf9\xe9\x4b\x37\xac\x9f\x3f\xad\x5f\xea\xae\x61\xc8\x86\x20\xb2\xe8\xaf\x9c\xdb\xef\xa5\x2f\x3a\x5f\x66\x3d\x72\x8e\x49\xd4\x40\x4a\xe5\x7f\x65\x00\x3f\x08\xa1\x1c\x0e\x0a\x0d\x63\x2c\x0a\x52\x39\x2c\xc7\x69\x50\x51\x4d\x20\xaf\xa5\x5e\x12\xbc\x39\x87\xd9\x8e\x73\xd8\xfc\xc2\x69\x3f\x73\x22\x8d\x6d\x2d\x94\xbf\x56\x26\x44\x58\x2d\x20\x48\x31\xb2\x4e\x0c\x7c\x70\xec\xe8\xb8\x92\x62\xfd\xb8\x53\x1f\xfe\x5c\xb2\x36\xdb\xde\xb5\xfb\xde\x9c\xb5\x38\xf6\x1f\x14\x37\x0f\x27\x3e\x86\x49\x39\x28\xf1\x91\xee\x70\x79\x11\xfa\x75\xdd\x55\x46\x7b\x2a\xa5\xfd\x77\xca\xe1\x00\xca\xe3\x74\xc5\x83\xf3\xd8\xee\xe1\x8c\xbd\x38\x79\x41\xee\x0a\x10\xe2\x01\xec\xbe\x90\xb8\x03\xc7\x42\x2e\x87\xe0\x54\x37\x30\xc4\xe0\x32\xd9\xa5\xde\xe9\xa1\x8d\xc1\x24\x36\xa3\x51\x8d\x68\x47\x07\x06\xaa\x48\x20\xa2\xf3\x1b\xd1\xb4\x1e\x04\xbf\x76\x87\x3c\xc8\xfa\x62\xee\xb5\x1f\x1d\x56\xf1\xfd\xc5\x43\x72\x12\x32\x72\xc8\xad\xf1\x6b\x4d\x38\xc2\x23\xc7\x1f\x45\xff\x16\xb3\xd5\x91\x4f\x7e\x24\x8e\xd7\xb6\x9c\xec\xd2\xba\xcf\x15\xe1\xdc\xdb\xab\x7c\x97\x6e\x00\x04\x2f\x03\xe0\x73\x72\x00\xed\xcd\x50\xc5\x2f\x90\x69\xa1\x4b\x2f\x22\x5c\x05\x3c\xc8\xd5\x15\xbb\xf7\x8f\xf1\x1b\x69\xc2\x63\x1f\x57\x63\x69\x37\x97\x53\xb9\x62\x38\x0f\x45\xe2\xf9\x54\x83\x45\xa7\x0a\x10\x9b\x00\xfb\x6e\xcf\x9e\xd2\xe2\x96\x2d\x3c\xe3\x92\xfa\x0d\xf9\xee\x3b\x61\x4f\xfe\xb8\xf7\x8e\x1a\x0b\xf4\xe9\x43\x26\xe4\x57\xf4\x04\x0c\x83\x48\x2f\xe1\x37\xca\xf1\x60\xa8\x01\xf3\x95\x98\x9a\xb1\x8c\x3f\x31\x2c\x38\x04\x0d\x79\x4e\xfd\x0b\x72\xcd\x7f\x4d\xea\x89\x6a\xa6\x54\xb9\xb1\xf0\x89\x12\x0b\x4b\xdd\xb4\xf0\x88\x12\xc0\x7f\x63\xe6\x29\x5c\x23\x4c\x31\xcf\x08\x53\x4a\x55\x02\x6f\x4b\x51\x6b\x51\x98\x5c\xa5\x38\xc5\x6a\xd0\x8f\xc7\x4f\x8d\xd3\xef\xfe\xe7\xea\xe4\x7b\x2f\x0d\x9a\xba\x77\x51\xe1\xb8\xb2\xa3\x3f\x95\x16\x2c\xda\xd7\xad\x67\xf1\x22\x2a\x5f\x0e\x54\x77\xc9\xb4\x27\xf7\xae\xfe\x36\xac\xf7\xb8\x55\x6b\x17\xa7\xce\x41\xf1\xbf\x7f\x70\x65\x33\xfa\x65\x03\xf7\xe3\x4b\x00\x84\x2f\x65\x00\x23\xd5\x63\x4f\x93\x99\x8f\x8b\x21\x4e\x83\xc2\x6b\xa2\x3e\x7e\xe2\x4b\x4f\x7c\x9d\xe9\x3e\xf3\x59\x9e\xae\x66\x26\x39\x21\x8a\x65\xba\x5c\x77\x79\x4a\x8f\xda\x66\x9d\x44\xbd\x85\x12\x94\x3e\xae\x5f\x4e\x6c\x49\x89\xf0\x41\x21\x99\xe9\x48\xc2\x17\x26\x8f\x1f\xf4\x7a\x05\xab\xe6\xc0\xc0\xdc\xf0\x08\xe9\x04\xaf\x57\x6b\xae\xc8\x3e\xbe\xec\xcd\x34\x34\x47\xae\x95\x75\xcf\xc8\x03\xae\x2e\x12\x92\x25\x0d\x0c\xe3\x52\xbe\xd6\xb3\xa4\xe4\x8c\x8a\xc7\x8b\x4d\x51\x64\xfd\xe6\xcd\xfb\xbd\xfc\x32\xe0\xca\x63\xa4\x33\xea\x4b\xdb\xf3\x86\x40\x66\xc3\x2c\x41\xea\x54\x00\x75\x58\xf0\x02\xd3\x4b\x07\xd8\x05\xb0\xa3\x86\x80\xc1\xef\x01\x71\x8a\x41\x72\x0d\x84\x0d\xd1\x31\xb6\x24\x35\x04\x16\x98\x04\xaa\x44\x74\x26\xdf\x36\x4b\x8c\x4a\x6c\xde\xca\x9c\x92\x94\x4c\x69\x49\x16\x9b\x3e\xe9\x48\xce\x98\x56\x7b\xbc\xd2\x5d\x3c\x82\x22\xeb\xb5\xe0\x64\x01\x62\x7c\x10\xbd\x65\xa0\x74\x4d\x76\x49\xb2\x98\x8a\x68\xb5\xfe\xc5\x18\x8c\x07\xc7\x58\x02\x9a\x82\x3b\x0a\x73\x0e\x3c\x69\x3c\x58\xf5\xf3\xb3\x10\xcc\x29\xd9\xd3\x09\xc2\xa4\xa8\x41\x61\xe9\xa4\x92\x92\xa1\xa8\xeb\x7d\xd2\x1f\xdd\xb8\x85\xfe\xcc\x24\x0b\x64\xa8\x18\x38\x15\x0d\x23\x2f\x3b\x16\x03\x82\x8d\x00\xa8\xa7\x0c\x6e\x68\x56\xc4\x73\xd1\x2c\x36\x12\x32\xb0\x3e\xe6\x01\xc8\xd3\xb8\xf7\x98\xaa\x88\xdc\xb2\x69\x35\xec\x05\x06\x4e\xa3\x6e\xd5\xfd\xf6\xa7\x27\xfe\xd5\x27\xa1\xf4\x24\x94\x47\xe9\x1e\x7c\x02\x5c\xe2\x3b\xbb\x87\x06\x5c\x0f\x62\xd1\x8f\x85\x19\x87\xe4\x2a\x49\x55\xcb\x33\xd8\x81\x35\x86\xed\x50\x53\x69\xea\xb9\xaf\xbb\x25\xbf\x3b\x05\x0d\x93\x4b\x46\xce\x7c\x2b\xc7\xab\xf4\xbb\x43\xaf\x94\x88\x4d\xa7\x2d\x79\xef\xf5\x41\x64\x91\xa3\x2e\x3e\x37\x69\x62\xd6\x08\x47\x3c\x3e\xf9\x60\x43\xc5\x8f\x62\xd3\x6a\x5d\xa2\xbd\x32\x42\x77\xc5\xcb\x64\xd6\xf6\x4a\xdb\x11\x37\x27\xee\x9e\x61\xb0\xf0\x9c\x59\x03\x2f\x57\xad\xb2\xb8\xab\x15\x7a\x49\x9e\x71\xc6\xa9\x56\x94\xba\x9c\x8d\xae\x6a\x45\x89\x02\x0c\xfd\x69\x94\x91\x48\xa9\xf2\x83\x50\x78\x45\xf1\x0e\x0b\xaf\xaa\x61\x35\xbb\xe6\xf0\xee\x0e\x50\x31\x6b\x82\xdf\x60\x8d\x55\x62\xde\x01\xa8\x4f\x66\x13\x44\x50\x5d\x23\xc7\x59\xd8\x7f\xe6\x97\xf9\x5f\x20\x63\xe6\xfd\x95\x77\xc8\xc3\xd2\x9d\x4b\x96\xee\xd8\xbd\x24\x77\x17\xb6\x6d\x26\x8b\xc9\x25\xe2\x53\xf4\x64\x09\x8a\xaf\xd0\x1f\xb8\xfd\xc5\x69\xe5\x8b\xdb\x2c\x02\x22\x83\xc4\x08\x4e\x5b\x08\x8c\x55\xcc\xaa\x7f\x36\x33\xfc\x46\xab\x83\xce\x2c\x54\xc3\x39\x93\x7b\x3a\x28\x79\x6b\x62\xa1\xe7\x7a\x6a\x4d\x2c\xc4\x44\x9d\x49\x82\x36\x18\x8a\xf8\xea\xf8\xc9\x8c\x92\xdd\xfa\x8c\xb3\x9f\x7c\x5d\xb2\x31\x67\x57\x8f\xee\x7b\x16\x6e\xc2\xc6\xbf\xc9\xd5\x99\x8e\xbf\xa5\xdb\xd3\x96\x90\xdb\xe4\xb1\xf8\xc1\xf5\x35\x8e\x27\xab\xaf\xf1\x38\x83\x0c\x12\x1e\x56\xf5\x63\x94\x36\xce\xf8\x77\xcf\xa1\x0a\xb9\xc9\x9d\xfb\x9a\x88\xe3\xf9\x9d\xd0\x7a\x08\xab\xf1\x99\x70\xe3\xc7\x93\x65\xe3\x3c\x68\x40\x57\x7a\xe2\x87\x92\x4d\x0b\xb7\xf7\xee\xb3\x2d\xbb\x08\xc7\x56\xa2\xda\x73\xc7\x3c\x8e\xc5\xe2\x34\xd4\xa8\xc2\xa3\xf4\xfa\x4a\x6c\xca\xff\x54\x95\x5d\x5c\x48\x7b\xe0\x03\xed\x14\xd1\x97\xcd\x34\xba\x00\xfe\x1a\x62\xdd\x3d\x02\x73\x6c\xd8\x25\xe3\xf2\xaa\xaa\x43\x60\xec\xac\x66\xaf\x05\x17\xd6\x69\x74\xa8\x55\xc9\x01\xa1\xff\xce\xe4\x30\x61\xb5\x6e\x83\x03\xc4\xa6\x33\x57\xf8\x02\xe2\x31\xda\x11\xda\x32\xc3\x6a\xf4\x2a\x56\xa3\x19\x6e\x4d\xe1\xa3\x22\x84\x55\x17\xc0\xfa\x50\x1c\x5e\xa3\xd6\x82\x06\x8d\x0c\x33\xd8\xa3\xcb\xe9\x56\xa9\xa1\x2d\x7a\xad\xf1\xb4\x9a\x51\x8b\xd6\xa8\x73\xf9\x6a\xed\x89\x80\x33\xc8\x77\xdb\xdf\xb8\xbf\xef\xf0\xb7\xa5\x73\x87\x0c\x9f\x30\x12\x05\xec\xed\xfe\x7d\xc9\xbc\x33\x19\x25\x52\xde\x84\xf4\xd9\x28\xb2\x73\xf7\x97\x7b\x4c\xea\xb2\xf0\xf0\xd1\xd5\xaf\x8e\xed\xd3\xfe\x95\x36\xcd\x7b\x65\xf6\x5e\xb1\xef\xcd\xed\x83\x52\xc7\xf4\x64\x79\x5d\xe5\x03\xdc\x4b\x6a\x09\x01\x30\x50\xf1\x0d\xb4\x68\x11\x8d\x20\xd7\x22\x84\xa0\x67\x6b\x6a\xd4\x02\x3a\x96\xfa\x09\x9a\x2a\x14\x0f\xd7\xae\xb2\x72\x1a\x13\xb3\x63\x46\x16\xd9\xf0\x61\x0f\xe0\x69\x0b\x8b\x7c\x8c\x68\xd1\xb9\x73\xc9\xad\xa2\x1a\x77\x6c\x3b\x63\xd6\xc9\x93\x52\x4b\xf2\x38\xdf\x31\xb8\x55\x2b\xef\x02\xff\x82\xc5\x78\x73\x3e\x92\x01\x41\x0e\xe5\xfb\x1d\x6a\x22\x0c\xd4\x5a\x51\x0c\x43\x8b\x91\x69\xc8\x72\x77\x37\xce\x40\x5f\x2d\xba\xc2\xcf\x89\x01\x6a\x32\x62\x38\x1a\x44\x29\x63\xf1\xb0\x50\x6b\xf4\x95\x77\x0f\xa2\x92\x33\xd7\xdb\x95\xd8\x47\xcd\x3e\x7b\x12\x97\x39\xda\xfe\x55\x24\x98\x9f\x9c\x02\x40\x10\x0d\x20\x94\x52\x6a\x9e\x8b\x01\x69\x44\xce\x3d\x1b\x52\x40\xd2\x60\x40\xe8\x57\xe4\xfd\x11\x99\xb1\x94\x4c\xfb\xe8\x89\xd0\xe2\xc9\x29\x66\x05\x11\xd4\x05\x90\xae\xd1\x43\x6f\x18\xa4\x48\x3e\xbe\xee\xef\xd7\xca\xb7\x56\xf2\x5f\x9c\x7a\x81\x97\xc0\x9d\x8c\xba\xf3\xf4\xaa\xa2\x83\x63\x40\x8c\x14\xb3\x39\x41\xec\x54\x5e\x42\x4e\x16\x90\x7f\x2a\xa1\x80\x9c\x3a\xfc\x69\xc5\x9a\x4a\xe1\xa5\x27\xa7\x84\xc4\x8a\x0b\x62\xd3\x8a\x1b\x42\x1d\x40\x50\x1b\x40\x38\x47\x69\xf3\x82\xee\x5a\xa2\x9c\xa8\x4f\xd0\xbf\xa3\x3e\x9e\x3c\xeb\x95\xd5\x9d\x3b\xba\x63\x66\x8e\x5c\x10\x18\xba\x83\xfe\xfe\xef\x4d\x52\x84\xc6\xdc\x78\xfc\xf8\x33\x34\x86\x14\xdd\xc0\xfb\x50\x9e\xe3\x07\xc7\x17\x68\x0d\x19\x85\xad\xd8\x02\x08\x02\x49\x67\xc1\x4e\xa9\xf1\xa3\x51\x11\x18\x8c\xee\x9c\x72\x89\x00\x35\xc3\x62\x62\x74\xf8\x4a\xbc\x65\x97\x94\x83\xe9\xa9\x4a\x03\xb6\xda\x6c\x49\x16\x56\x42\x40\xe9\xf8\xa5\xe2\x14\x79\x6d\xf8\x1d\x6b\xdb\xf8\x01\xe9\x31\xb5\xc9\xac\x73\xc8\x4f\xa8\xfb\x24\x92\xfc\x26\xf8\x14\x88\xaf\x0e\x1f\x2b\x36\x00\x04\x7d\x00\x84\xf7\x29\x25\x5a\x0c\x28\xe8\x7f\x00\x03\x6a\x8a\x33\x2b\xb6\xe3\x9e\x8e\x03\x42\x62\x61\x61\x8e\x10\xbc\x6e\x3e\xc7\x50\xc8\x4a\x5c\x24\x37\x83\x20\x68\xa4\x98\x83\x43\x78\xcf\x7d\xe2\xa8\x2e\xba\x14\x86\x45\xea\xd8\x2c\x96\xe0\xcc\xa2\x64\xfe\x72\x8e\x02\x27\x25\x5a\x93\xe2\x93\x92\x92\x59\xc6\x64\xa4\x55\x22\xb4\x8a\xdc\x64\xc1\xd3\xf6\xbf\x7f\xfd\x3a\xde\xf7\x7e\xec\x95\x9d\x3b\x85\x4b\x64\x65\xfc\xb7\x1f\x9e\xfe\x7d\xc5\x6f\xe5\x1f\xdc\x4f\x98\xf8\x0a\xf9\xcf\xb9\xaf\xfb\xf6\xfb\xf6\x12\xf9\xb9\x35\xa3\x60\x1f\xf9\x0d\xb5\x79\x61\xa5\x2a\x62\x31\x54\x9b\x35\x6b\xc8\x6f\xf2\x51\x76\xb7\x99\xd2\x3b\xa1\x8a\x5e\x49\xa5\x97\x41\x1e\x41\xb4\x62\xde\x8b\x47\xb3\x46\x4a\xaf\x91\xd2\x6b\x72\xd2\x2b\x99\x8c\x9c\x5e\x4b\x32\xad\x54\x4e\xa1\x34\x1b\x68\x5e\x11\x48\x67\xc4\x13\x02\x62\x98\x4b\xd7\x99\xcf\xeb\x0e\x1e\xbc\x12\xbb\x5f\x41\xd7\xaf\x1f\xdc\xf7\xa4\x15\xf2\xbc\xf3\x5d\x8f\x6e\x3f\x9c\x47\x21\xaf\x4c\x8c\xbf\x5f\x72\xf5\xe1\x8a\xdf\xcf\x7d\xf8\x55\x3c\x6b\x7f\x3e\x5a\x29\x3e\x14\x62\xc0\x02\xad\x15\x23\x9f\x1b\x00\x67\x20\x13\xc8\x31\x27\xbb\x1f\x4f\xf4\xfd\xe3\x5c\x6d\x99\x9f\xbb\x2d\xf3\x62\x22\xc2\x2c\x70\x03\xcc\x2c\xb2\x8e\xd9\x67\x4a\x14\x15\x96\x24\xf1\xe1\x91\xdc\x0e\x73\x76\x77\xaa\xf3\x7a\x8f\xad\x87\xf3\x5e\x5d\x62\x6f\x19\xfe\xea\x40\x41\x5f\x74\x2b\x69\x75\x8d\x51\x03\xf0\x96\x4b\x2d\xb7\x7a\xe2\x49\x03\x01\x43\x1e\x59\x8b\xd2\xc4\xd7\x78\xfe\x10\xef\xcc\x1f\x78\x3c\x8f\xe3\x34\x2a\xad\x59\xf2\x82\x99\xb6\x30\x68\xce\x6c\x35\xb3\x50\x9d\xe5\x0d\xc5\xbb\x9e\xae\x78\xc1\x7b\x9f\x3c\x90\x5e\xad\xca\x1a\x10\xa4\x57\x7a\xd1\x4a\xd4\x58\x88\x86\x6e\x0a\xc4\x58\x59\x9f\x9d\x51\x68\x90\x3b\x70\xac\x2b\x77\x85\x3e\x3c\x39\xf4\xe1\xcf\x6c\x37\xdb\x06\xb1\xad\x12\xea\x0e\x80\xc8\xea\xda\x0b\x4c\xc5\xc9\xc4\xd7\x66\x68\x50\x90\x4e\xc3\x28\x0a\x22\x9f\x5a\x5e\xf2\xfe\x99\x73\xfb\x4b\x0a\xca\x64\x15\x04\xd9\x30\x3f\xb3\xef\xac\xb3\x21\x3f\xfc\x18\x83\xc2\xee\x58\xaf\xa3\xf0\xe8\xaf\xbe\xb4\x5c\x2f\xa8\x86\x42\x10\xf4\x07\x10\x59\x76\x15\x0d\x33\x94\x30\x77\xba\x9f\x81\xf6\x23\xfd\x79\x5e\x17\x19\xa7\x0c\x8c\x44\x13\x94\xfc\x48\x94\xa1\x1c\x8b\x44\xa9\xce\xde\x68\xcb\x0f\x59\x4f\xe9\x52\x1f\x55\xdd\xed\x91\xac\x1c\xe0\xf9\x3d\x44\x16\x7f\x5d\xb4\x2e\x9a\x95\xc9\xa5\x24\xd9\xa8\xab\x12\x34\xbe\xd8\x4c\x75\xf3\xb8\x47\x73\xfc\x05\x7a\xf2\xe8\xb5\x5a\xf1\xd8\x63\xfb\x16\xfd\x9f\x67\x8e\xde\x3d\x36\x7b\x5c\xfa\x3c\x3d\x6a\x81\xc7\xde\x59\xdf\x7f\xd3\x26\xfd\xfc\x41\x1e\x5f\x6c\x42\xe2\xe9\x5f\xae\xef\x1f\x35\x63\xfd\x4c\x52\xb1\x09\x00\xd3\xb1\xf9\x56\xea\x2f\xfe\x0a\xa1\x50\x07\x5a\x28\xb8\x6e\x3d\xd5\x2f\xd9\x6d\xe5\xa0\xf5\x5f\x86\x72\x96\xe3\x62\x0d\x0a\x48\xb1\x28\x9b\x9b\xff\xb2\xe9\x6c\x29\xb1\x54\x4b\x6c\x29\x3c\x86\x49\xb1\xe8\x58\xed\x97\x45\x87\xfc\x03\x03\xe3\x79\x51\x33\x23\x5a\x16\xdf\xd8\x7c\xe5\xf2\xe6\xec\x79\x79\x23\xc6\xaf\x9a\xb7\xaa\xe8\x93\x13\x9b\xd7\x2c\x28\x1c\x9b\x96\xbf\xa0\x62\xd0\xc4\x13\x5f\x9d\xc8\xc8\x38\x39\x3e\xe3\xc4\xc4\xf1\x0b\xe6\xe5\x2c\x5a\xba\xf1\xdc\xa5\xb7\x0b\x73\x0a\xa6\x65\xae\xcd\x5d\xff\xf6\xf9\x13\x9b\x97\xe7\xe0\xac\xac\xeb\xd3\xb3\xae\x65\x4d\xbf\x96\x39\xed\x3a\xd3\xaa\x48\x00\x91\x4e\x96\x40\x60\xf5\x1c\x40\x20\x25\x3b\x50\x33\x89\x19\xe6\x1e\x2f\xfa\x99\x85\xba\x0a\x78\x07\xd2\x0e\x1f\xf0\x33\x47\x9a\x71\xaa\xdd\xcc\x84\x8d\x6e\x15\x13\x76\x16\x9c\x70\x7d\xd4\xb1\xad\xe2\xeb\xbc\xec\x59\xae\x62\xf2\xd4\x3e\x06\x3c\xad\xfd\x67\xff\xa3\x04\x9c\x86\x46\x7f\x4e\x06\xa0\xc6\x64\x0e\x9a\x43\xe6\x1c\x27\xd9\x0c\x79\x46\x4d\xc8\x80\x3b\x82\x2f\xde\xe6\x68\x38\x73\xfb\x0c\x72\x14\xb5\x9e\xb1\x7d\x26\x2e\x67\x63\xb0\x8a\x5a\xb0\xd7\xb8\x05\xd3\x41\x10\xb3\x61\x9a\x89\x2a\x45\x42\x42\x5d\xaa\x6b\x34\x20\x60\xa1\xe1\xaa\xd2\xd2\x52\x6a\xf2\x2d\x15\x3f\x08\x67\xf0\x5f\x80\xa1\x1f\x31\x8a\x99\x54\x4a\x13\xa9\xc5\x9f\xa3\x58\xdb\xb6\xa3\x1d\x62\xab\x7c\x92\x38\x22\xea\x5c\x1e\xc4\x5e\x15\xe8\x61\x65\xbf\x06\x1a\x94\x16\x2e\x65\xee\x81\x2d\xaa\x60\x32\x5f\x2a\x90\x1a\xc4\xa5\xa1\x95\x57\xd2\x35\x34\x28\x29\xce\x8b\xe1\x29\x0d\x79\x13\x4c\x48\xf8\x2a\x22\x4d\x86\xa0\x16\x78\xb3\x40\x26\x36\x29\xe5\x69\x8d\x77\x03\xcc\x2c\xa7\x3a\xe9\x27\x3a\xa3\xc8\x40\x31\x02\xd7\xa4\xd8\xb1\x7a\x6c\xe1\xf2\x21\x66\x96\xb6\xed\x40\x2a\xbe\x19\x7f\xb6\x5d\x5e\xe9\x81\x8d\x39\x3b\x96\x5d\x39\x54\x96\x7a\xa8\x75\x7b\xe4\x75\xf7\x7b\x24\x96\xec\xca\x5b\xb6\xb5\xf1\x22\x14\xb9\x3b\xa3\xa9\xe3\x7e\xbf\xce\x5d\x3a\x27\x2f\x44\x61\xf5\x5e\xef\x91\x87\xf6\x7d\xd4\xb3\x51\xce\xb0\x5d\xa7\x1b\x37\xc9\xb8\x88\xe7\x15\x4e\x1e\xd4\x37\xbd\x79\xc3\x71\x1b\xc6\x95\xf6\xa1\x97\x77\x9c\xf9\xea\xe2\xcc\x0d\x13\x87\xb4\xed\xd2\xaa\x4b\x87\xc1\x73\xf7\x04\x85\x9a\x87\xb7\x6d\xd7\xa5\x75\x37\xa3\xff\xf0\xb6\x7d\xc6\xb0\x71\x48\x13\xff\x8b\x1f\x4a\x27\xc1\x03\x8c\x10\xce\x2a\x34\x5d\x56\x4b\xb0\xd9\x4e\x9d\x41\xd5\x02\x3a\x18\xd5\x40\x49\xcd\xea\x83\xb4\xaa\xea\xc5\xf7\xd4\xbd\xd4\x9f\x16\x2c\x52\xeb\x57\xb7\xca\x08\x82\x00\xfd\xe9\x4a\xae\xff\x48\x27\xb8\xa6\x35\x81\x35\x8a\xad\xe9\x4b\xbc\x58\x98\xc9\x1c\xdb\x26\x57\x69\x5c\x03\x3a\x58\x0d\xaa\xa5\x35\x99\x9e\x24\xf3\x18\x3e\xb9\x81\x5a\x74\x7b\xc0\x4f\x88\x14\x28\x46\xe9\xd9\x20\x99\x4f\xdc\xc7\x1d\x00\x4f\x83\x27\xbd\xe0\xeb\xc9\x23\x2a\x23\xd6\x24\x3e\x4f\xcf\x94\x50\x3e\xeb\x66\x4f\xa6\xb3\xe3\xce\x65\x5f\x0d\xa8\x06\x8b\xae\xc3\xc7\xe3\x61\x6d\xb0\x5f\x55\xa4\x5d\xd3\xdf\x44\xeb\xf6\x4d\xb4\xf8\xab\x86\x95\x95\x3c\xd2\x99\xf4\x28\x3a\x91\x5e\x55\xb1\x20\x0e\x7c\xb7\x68\xd3\x47\xc7\x36\x6c\xd9\xbb\x72\x60\xff\x01\xa3\x47\x0f\x78\x33\xd5\x81\xb6\x20\x33\x6a\x89\x4c\x5b\x8a\xc8\xcf\x5b\xb7\x90\x07\x9b\x27\x1e\x41\x9d\xd1\x1c\xd4\xe9\xc8\x01\x72\xf0\xd4\x49\x72\xf0\x10\xee\xb9\x6e\xeb\xb5\x43\x63\xed\xd7\xde\x59\x33\xb4\xe7\xe2\xac\x8c\x69\x8b\x7b\x0c\x5f\xb0\x97\x7c\xbe\x63\x07\xaa\xb9\xb7\x18\xc5\xec\xdc\x4e\xee\x14\x9f\x43\xfd\x4e\x9f\x26\x3b\xce\x9d\x24\xbb\xae\x5c\x46\x7d\xb8\xc6\xe0\xf7\x05\x3f\xce\xcb\x9a\x30\x5a\xf1\x8d\xb5\xb1\x9e\xf9\xb2\xda\x78\x6d\x79\xb6\x22\xc6\x06\xa8\xeb\x3a\xd8\x5c\x0e\x16\xd9\x89\xe2\xc5\x77\xcc\xeb\x07\x50\x95\x76\xba\x79\xa3\x17\xbf\xce\x4a\x32\x04\xd7\x89\x8e\x28\x57\x06\x55\x4f\x74\x24\xb3\x89\x0e\xc6\x1d\x9b\xca\x2b\x3a\xcd\xa1\xce\x72\x30\x59\xc6\x23\xbd\xe5\x57\xe7\x4c\x9d\xb7\x73\xef\xe8\x19\x6d\xdf\xd8\xba\x37\x77\xe1\xfa\x10\xf2\x4e\xdd\x3e\x61\xe3\xbb\xf7\xc5\xc7\x63\x62\x7b\xcd\x78\x6b\xdc\xb4\xc4\x45\xc9\x09\xde\x6f\xcd\xcb\xcf\x26\x27\x06\x77\xcd\xaa\x15\xba\x0c\xb5\x48\x18\x50\x59\x09\x69\x50\x28\x0c\x13\xde\x87\x58\x58\xff\x98\x80\x0e\xd6\xa3\x36\x95\x04\x10\xcc\x46\x53\x85\xa3\x42\x14\x48\x10\x02\xac\x43\xa8\x9c\x1a\x36\x4d\x75\x1c\xa3\x8f\xdb\x21\xe1\x68\xa9\x23\x4b\x68\x81\xa6\x22\x8f\xad\x20\x42\x3f\x2a\x79\xdf\x52\x6e\xf9\x42\x04\x24\x42\x33\xd8\xad\x24\x37\xe7\x2a\x9f\xcc\xc2\x08\xb6\x35\xf3\xad\x55\x53\x01\xe2\x0e\x91\x99\x35\x2b\x0a\x43\x9f\x9e\x29\x51\x3c\x86\xa7\x35\xc3\x4a\x8c\x5a\xb1\x16\x43\x65\x32\xc6\x10\x43\x65\x32\x29\x2a\xa6\x6a\x1d\xe1\xb1\x24\x94\x7a\x00\x92\x0c\x49\xf4\x6a\xa4\x53\x28\x6b\x45\x26\xf1\x67\x0d\xf6\xb8\x72\x7b\x5c\x1c\x5b\x70\xd9\x0c\xbb\xe6\xea\x1a\x21\x4b\xf1\x67\x65\xb6\x4e\x19\x94\x98\xd9\xe5\xc5\xc5\x2c\x1a\xb3\x04\x58\x63\x13\x6d\xb1\x36\x5b\x82\xba\x78\xc6\xda\xcf\x5d\xdc\x26\x52\x81\xdc\xb2\x15\xf9\x6f\xda\x44\x1e\x70\x81\x44\x65\x4b\x91\xff\xba\xdd\x73\xa6\x23\xe3\x0a\x5f\xc1\x30\xd1\xbe\xb1\x43\x9f\xd7\x87\x2d\xc6\xb7\xdc\x64\xee\x34\x93\xc9\xdd\x28\x66\xef\x1e\x14\xcd\x64\xf2\x4f\xf2\x9e\xd0\x75\xfe\xcc\xac\x56\xf1\xeb\x9b\xf5\x8a\x35\xd4\x28\x31\x0e\x12\xe7\xe0\xd6\xc9\x1d\x9b\x01\x20\x98\x26\x45\x0b\xad\xb9\x3d\x37\xf2\x39\x3d\x2c\x68\x6b\xba\x85\xd6\x8e\x64\x7c\x4e\x8a\xce\x07\x0c\xf3\x84\x32\x3c\x83\x8e\x8d\x17\x04\x42\x9c\x1b\xfb\xbd\xcb\xa9\xb7\x52\xf4\x1a\x85\x36\x69\xb8\xc3\x45\x8f\xeb\xac\xa5\x1a\x4e\xf2\x0f\x9c\xb7\x66\xe4\x88\x55\xab\x46\xa4\x17\x54\x26\x77\xea\x94\x9c\xd2\xb9\xb3\x58\x36\x62\xe3\x86\xf4\x91\x05\x05\x81\xed\x9b\x34\xed\xfc\xc6\xe8\x4e\x80\x61\x30\x80\xf0\xa7\xf8\x3b\x6d\xd7\x0f\xda\xb1\xd5\x68\xda\x8c\x5a\x6f\x78\xde\x3a\x37\xbb\x8f\xa6\xde\x43\xf2\x95\x99\xf3\xf4\x92\x42\x25\x9c\xaa\x5d\x03\xc7\xba\x89\x73\xd1\x68\xb2\x7a\x27\x99\x8d\xe6\xee\x44\xad\x2a\x3e\x12\x5e\x11\x7a\x15\x92\x21\xa8\xa8\x10\x6d\x7a\xe2\xb3\x96\x71\xaa\x35\xde\x80\xf7\x48\x47\x68\xdf\x7b\x28\x1e\x1c\x4d\x06\x67\xaa\xa4\x29\xd0\xf2\x67\xde\x0b\x6b\x16\x52\xf9\x06\xaa\x5a\xaf\x78\x38\xaf\xfb\xf2\x95\x3c\xfe\x81\x2c\x90\xe5\xcc\x46\xce\x75\x54\x6c\x89\xaa\xbf\x1f\x8e\xc6\x7b\x1c\xbb\x51\xbd\x31\x2d\x5f\xee\xdc\x7e\xd3\x4e\xe4\xb1\x7e\x54\x0f\x3b\xca\xc7\x1b\x46\xa3\x90\xb4\xb6\x8d\x9a\xbd\xda\x64\xc2\xea\xd9\x63\x47\x8c\x7a\x63\x05\x20\x68\x8e\xf3\xf1\x7a\xa9\x14\x62\x21\x57\x89\xb2\xd5\xd2\xd2\xa7\x59\x4a\xc5\xe8\x83\x60\xd6\x2c\xa5\xf7\xd0\x38\x98\x0d\xf9\x20\xa4\x2a\x2d\x00\x65\x1c\xf2\x83\x48\x88\x03\x41\x5d\x52\xc5\x08\xd6\x39\x15\xc0\xd3\x37\xb8\xaa\x92\x39\xd6\xa9\x51\xfe\x7c\x4d\x95\x12\xae\xbe\x2f\x98\x9a\x20\xd7\x52\x74\xd5\xa5\x6a\x57\x55\x55\x49\xc1\xd3\x55\x55\x36\x2b\xd2\xf7\xe8\xd4\xa1\x45\xbb\x26\x9d\x37\xbd\x3d\x23\x77\x63\xbb\xd6\x6b\x8a\x73\x16\xec\x28\x7a\xe3\x95\x76\x1d\x37\xf6\x11\x87\x37\x6b\x58\xbf\x69\x42\xed\x21\x59\x99\x23\x52\xfa\x07\xd7\xce\x1f\x39\x73\xd6\xa8\xfa\x2f\xbf\x9c\x90\xc1\xab\x75\xb3\xc4\x9b\x38\xac\x7a\x4e\x1a\xd3\x39\x69\x8c\x52\x55\x80\xe5\x00\xeb\x0a\x0d\x94\x30\x73\x5a\xc1\x76\x81\x2f\xad\x64\x99\xfb\xcb\xf7\xc8\x1f\x6b\x24\x42\x6e\xb3\xe7\xd7\x53\x64\xef\x17\x9a\x83\x9a\xa0\x8b\xe2\xe3\xac\x8f\xd1\xc2\xb8\x9a\xbc\x94\x03\x23\x26\xf7\x32\x28\xd1\xa5\xf8\x49\xe7\xa3\x8e\xa8\x31\x90\xa3\x75\x7c\xb2\x50\xc5\x22\x51\x77\xf4\xd6\xa4\xd3\xcd\x4b\x77\xea\x53\x8b\x57\x75\xa5\x80\xae\x63\xd1\xdb\x1b\xd7\x0a\x51\x4f\x4e\x4d\x5c\xd4\x91\xd4\x95\x6e\x03\x82\x57\x00\x84\x96\xbc\xe6\xad\xae\x82\xbd\xbc\x19\x45\x2f\x2e\x04\x54\x10\x78\xa8\x45\x7f\x46\x6a\x74\x58\xc8\x12\x6d\xc4\xd9\x3d\xfe\x43\xfe\x46\x9e\xff\x41\x3e\xd8\x83\xdc\xf8\xb4\xf2\x71\x17\xd6\xd3\x55\x78\x14\xca\x14\x3a\x82\x1e\x02\x15\xa1\xaa\xda\x10\xc7\xed\xf7\xa0\x74\xdf\x0c\xbd\xc3\x54\xf4\xe9\x12\xcb\x55\xea\x12\x4b\x7c\x8b\xad\xb1\x8c\x6b\x41\x05\x03\x26\x91\xd7\x50\x31\xb0\xb9\xec\x56\x8a\xe4\x67\xd0\xf2\xc9\xe0\x06\xda\x1e\x30\xcb\x56\x99\x1a\x4f\xc4\x21\x8a\xfd\xba\xaa\x26\xec\x48\x45\x0b\xa2\x55\x11\xb0\xa9\xe1\x56\x0a\x0a\xf7\x5e\xb7\x25\x5f\xf7\x46\xef\x96\x43\xa2\x12\x43\x57\x0e\xce\x18\x95\x18\x57\xaf\x8e\x3e\x9b\xd5\x27\xe3\x6d\xe8\x21\x5f\x11\xd9\x52\x41\x0c\x35\x7a\xe1\xc2\x48\x7f\xed\xc2\x48\xec\xbe\x30\x92\x79\x1b\x1d\x5d\x18\x89\x1e\x6e\xba\x89\x86\x9a\xf0\x36\xdc\xb7\xc9\x9c\xc9\x00\x98\xb6\xa1\x08\x36\x3e\x4f\x14\x42\x5b\x11\x54\x6c\x57\x60\xe1\x93\xd6\xc4\x29\x9e\xa1\x82\x1a\xc5\x28\x06\x41\x33\x03\x2f\xb8\x9b\xb9\xe7\xad\x2e\xd7\x4d\xff\x70\xfd\x86\x0f\x8f\x90\x0b\x47\xdf\x1e\xdc\xa3\xfb\xc0\xc1\xdd\xbb\x0e\xc2\xe2\xd0\xa2\x4f\x8e\xef\xe8\xb4\xf9\xe3\x8f\xb7\xcb\xc3\x26\x4c\x1c\xfe\xfa\xd0\x09\x63\x07\x03\xf7\xab\x6b\x85\x69\xc2\x41\x88\x85\x0d\x3e\x00\x3a\xd8\x80\x46\x02\xbb\xde\x13\x40\x5a\x21\xbd\x0b\x36\xd8\x56\xf9\x39\x5e\x4b\xf7\x1b\x51\x10\x78\x20\x1d\x6c\xc7\x37\x00\xc0\xa0\x65\x0f\x5f\x33\x44\x07\x5f\x3a\xcb\x9f\xd9\x08\x0f\xc0\x83\x3f\x7b\x03\xaf\xa5\xcf\xbc\x2d\x44\xf0\x67\x34\x35\x60\xfc\x99\x71\x00\xd2\xcf\xd2\x11\xfe\xfe\x42\xfe\xcc\x76\x81\x41\x02\x3a\x78\x07\x1a\xe1\x0d\xd5\xcf\x44\xd3\x67\xa2\xf9\x33\x80\xa1\x0d\x99\x2d\x4c\xa3\xf9\x79\x10\xc4\x40\x6f\x45\xaf\x56\x45\xea\xd9\xaa\x01\x36\xe3\xe8\xbc\x9f\xe5\xe7\x60\x88\xe6\x48\xd6\xff\x6e\x7d\x8e\x3d\x9a\x7a\x6f\xd7\xd9\x37\x5f\x41\xa7\x53\xb9\x9a\x20\xab\xc1\x0d\x5b\xbd\xc3\xf3\xfa\x24\x26\xbe\xf8\x55\x54\x7b\xd0\x61\x2f\x3c\x69\xcc\xb0\x99\x25\xf8\xfe\x07\x27\x6e\x6c\x19\xd3\xba\x6a\xb5\x0e\xde\x35\x72\xf6\x94\xe3\x43\x6b\x8e\x99\x98\xd1\x7f\xeb\x85\xb3\xef\xbf\xbb\x2f\xe3\xf5\x95\xa8\x19\x8d\x7a\xeb\xd7\xe3\x33\x86\xbf\x01\xe8\x7a\xf3\x1a\x6c\x13\x4c\xd5\x56\xe0\xbd\xb8\x64\x5c\x31\xca\xa0\x62\x31\x2f\x2c\xfd\x57\xbc\xa0\x6a\xad\x80\x66\xb6\x5e\xe3\x9f\x4c\x32\x54\xad\xa7\x15\xa2\x05\x2b\x73\x4f\x66\xfe\x87\xa2\x05\x5d\xef\x8a\xa5\x7e\x8e\x0b\x17\xbf\x12\xc6\x0b\x1f\x38\x5e\xf3\xc7\xaf\x3b\x0e\x07\xe2\xe3\x8f\xf7\xa1\x33\x91\x68\x30\xd9\x2c\xd9\xd7\x92\xda\x85\x8e\x9f\xd1\x34\xfc\x05\x20\xe8\x0b\x97\x84\x2f\xc4\xa1\xe0\x05\x8d\xf9\xfa\x79\xbd\xb7\x50\xb5\x50\xc0\x53\xcf\xa3\x6e\x51\xd0\xb3\x1d\xf2\x14\xab\x24\x5d\x2a\x57\x74\xa2\x27\xbf\x49\xc5\x6a\xcc\x08\xa5\x20\x64\x41\x48\x87\x50\x5f\xd4\x89\x1c\x9e\x8a\x3a\xa2\x8e\x53\xc9\x61\xd4\x69\x2a\x39\x48\x0e\xd2\xb3\x2e\xe8\x8d\x2c\xb2\x9f\x6f\x88\x3d\x0b\x75\x25\xfb\x00\x04\x5a\x59\xfc\xb6\x14\x2e\x3d\x00\x0b\x44\x41\x6d\x48\x53\xc4\x3a\x75\xb9\x46\xb2\x68\x97\x6d\x63\xcb\xb5\x0b\xb8\xb4\x9c\x0c\x31\x70\x7c\xd3\xca\x77\x2c\x67\x0b\xe5\x25\x24\xe1\x9a\x02\xcd\x1a\xec\x45\x4a\xac\x7b\x21\x32\x2b\x7b\x49\x89\xe5\xeb\x52\xf8\x8c\x89\x2e\xd0\x2c\xf9\x5b\xd8\x24\x05\xd6\x49\x89\x36\x14\xe8\x2c\x49\xde\x33\x7f\xf0\xb2\xe5\x47\x59\xdd\xec\x8d\xd5\x6f\x2d\xdd\x9c\x8b\x2b\xea\x0d\xc1\x89\x69\x3b\x68\x69\xb2\x10\xd0\xd8\xf1\x79\xff\xcc\xa7\xf5\xc9\xdd\x36\x7c\x57\x86\x46\x74\x67\x15\xb4\x33\xb7\x57\x1e\x21\xb7\x87\xfe\xb1\x54\xc8\x19\x47\x8b\x68\x7d\x0e\xcd\xaf\xc8\x6f\xc9\xca\x68\x31\xec\x00\x10\x53\xa9\xdc\xf8\x40\x20\x93\x7c\x1e\x1b\xa8\x1f\xef\xd0\xd6\x5a\x6a\x63\x54\x04\x5e\x55\x0b\x7c\x0d\xea\x91\x81\x8e\x95\xa6\x32\x4c\x5b\x80\x19\x1d\xaf\x66\xab\x2c\x6e\x31\x1b\xab\x6a\x67\xd1\x20\x64\x59\x75\x67\x2a\x79\xf4\x35\xf9\xa5\xb4\x14\x05\x2c\xdb\xb2\x25\x47\xb2\x93\xcf\xd3\x3f\x9e\xf5\xf1\x77\xe4\x34\x3d\x7c\xc5\x71\xb7\x70\x5a\x56\x21\x62\xb3\xb2\x74\xa6\x70\xa4\x6c\x82\x3a\x90\xae\x44\xd7\xad\xa7\xb5\xa6\x11\x6e\x4b\x27\xb5\x6e\xc6\xd3\xcc\x91\x5f\x5f\xc9\xac\xce\xe0\xb9\xae\x17\x8d\x52\x7f\xb3\xf2\xdf\x18\x6c\x54\x4b\xbb\x62\xa2\xda\xf1\x07\xf8\x47\xe0\x80\xea\x52\x07\xd5\xf5\xb3\x54\x5b\x0d\x10\x8c\xa8\x4d\xc9\xe5\xd2\x03\x27\xe7\x74\xfe\xb0\xd7\xf9\x43\x5d\x0f\xc6\xd6\x6d\xb4\x28\x69\x54\x46\xa7\x8f\x7a\xe7\x8d\xe8\x73\x5b\xec\x5d\xfe\xd3\xfb\x9b\x67\x9e\x6f\x9b\x30\x70\x79\xce\xab\x9b\x95\x7a\x61\x1b\xa2\xea\x0d\xe8\x96\x38\x68\xd5\xe2\xae\x7d\x2f\xf7\x18\x30\x8a\x7c\x0e\x02\xbc\x5e\xf9\x40\x9e\x21\xbe\x4e\xfb\x97\x04\xcd\xe0\x94\x82\x79\xe6\xc0\x02\x80\x06\xe5\x6c\xdb\xe4\x79\x75\x66\xe1\xf4\x24\x5c\x5b\x76\xe0\x8e\x96\xe1\x70\x1e\xe2\x78\x4b\x16\x3e\xeb\x48\x4b\x6f\xbc\x2f\x7b\xd3\xc8\xc2\xa2\xad\xb7\xf1\x66\x3f\x2b\x21\xea\xcd\x4d\xf9\xce\xde\x94\xde\xdc\xf4\x72\x53\xea\x0b\xa3\x35\x9a\x5f\x13\x39\xd3\x0a\xf5\xce\xba\xe5\x6c\x3e\x33\x4e\x63\x07\x93\xa9\x2e\x4a\x51\x36\xd9\x25\x8d\x8b\x75\xe6\x71\x16\x5e\xc5\x13\xa0\x56\xf1\xa4\x50\x4c\xda\x99\xd4\x05\xf2\x82\x1e\x06\x50\xfc\x42\x9e\xe4\xb4\xfa\x6a\xe9\xce\x0f\xbf\xfe\xe2\xcb\xb1\x23\xd2\x97\x1c\xfe\xed\xc8\x44\x7b\x7c\x8b\xf7\xd2\xaf\x7e\xef\xa8\xa5\x7b\x6f\xcd\xea\xcc\xb8\x96\x45\x15\xfa\x0e\x45\xf1\xdf\x4c\x5e\x32\x52\xe8\x32\x71\x8d\x11\x87\x64\xfb\x1f\xe8\x35\xe0\xdd\xc2\x4d\xef\xf5\x1c\x95\x35\xb6\xab\x39\xff\x60\xf7\xee\x6f\xf4\x26\x95\x3f\x4c\xb4\x1f\x7b\x35\x32\x27\xb3\xa0\x53\xf2\xcf\x78\x6c\xf7\xd7\x52\x85\xc4\x3d\x79\x91\x0b\x56\x31\x2f\x3a\x8b\xad\x28\x97\xeb\x41\x38\xd4\xa5\xf1\xb1\xae\x5e\x7d\x15\xa7\xb7\xd7\x2a\x07\x67\xe0\x19\xe1\x3e\xb1\xe1\x17\xac\xab\x5a\xfd\x68\xe4\x47\x4a\x8c\x0b\xe2\xa8\x15\x24\x64\xa2\x90\xbb\xeb\x8a\x5c\x4b\x2c\xc3\xef\x98\x2c\x51\xe4\x4e\xb0\xa9\x10\xaa\xea\x1b\x84\xc4\x7f\x26\xdf\xcc\x5e\x74\x27\x73\xfc\xe5\xb9\x6f\x67\x36\x5c\x7a\xbb\x63\xd9\x82\x0f\x9a\x3c\xdc\xf7\x51\xc7\x11\x38\x3a\xef\xcd\x15\xdb\x76\xce\x99\xb9\x4e\x0a\x20\x8f\xc8\x5b\xa9\x45\x8e\xe5\x73\xef\x65\xaf\xfa\x61\xde\x84\x4f\x96\xae\x1e\x3a\xbf\x7f\xa3\xcd\xc9\x79\x73\x87\x55\xfc\xde\xb8\x59\xc7\x63\x3b\x97\x9c\xf8\xea\x30\xb3\x6b\xed\x61\xaf\x58\x5f\x2c\xa5\x47\x3e\x60\x81\xd7\x15\x5d\x50\x70\x55\xff\xa0\x9c\x6d\x8d\xe5\x6e\x9f\xb9\x60\xdf\x78\xf2\xae\x0a\x7b\xf6\xcb\xce\xf8\x67\xbf\xa1\xfa\x62\x60\xd5\x81\x9a\x66\xa1\xa7\xb1\x97\xf1\xe9\x91\x58\xbf\x22\x44\xf8\x8f\x63\x8b\x1a\x8c\xa9\x5b\xdc\xe7\x30\x6a\xca\xa3\xb2\xe6\xcd\x2b\x08\x3b\x68\x40\xc3\x33\x8e\xd8\x4c\x17\x13\xc5\xc3\xbc\x9a\x2c\x16\x86\x2a\x22\xcf\x00\xec\x22\x33\xa2\x6c\x6b\x75\xaf\x06\xd0\x54\x24\x58\x9d\xe2\x18\x6d\x15\xb9\xe3\xe5\xe8\xae\xe8\x8a\xee\x2a\xc1\xa1\x7e\x3c\xc6\x67\x15\x9b\xd5\x75\x02\xdc\xd4\x1a\xf9\xc0\x70\x0b\x6c\x56\x8d\xae\xcc\x6d\x2e\x1b\x8b\xfe\x59\xf7\xf3\xc7\x0e\xe8\x38\x23\xed\x93\x19\xf7\x96\x8f\xe8\xd5\x69\xc6\xb0\x8b\x25\x83\x51\xef\xe6\x1d\x16\xef\xc2\x69\xbd\xc9\xde\x94\xd6\x79\x3b\x69\x01\x81\x23\xaf\xce\xea\x8b\xeb\x49\x59\x11\xc9\xb5\xae\x39\xbf\x06\xa5\x7c\x3c\x0e\x1f\x8a\xba\x7d\xf0\xd0\x38\x47\x97\xe0\xcf\x4b\x00\xc3\x1a\x32\x48\xb4\x3d\xa7\xd6\xcf\xdb\xbd\x9a\xf2\x7f\xbe\x82\xc0\xf8\x4c\x05\x81\x8d\x57\x10\x94\x64\x7c\xfc\x9c\x02\x02\xdd\x16\xc7\xb9\x05\xda\x0a\x02\x04\x1b\xe8\xa6\xa7\xd8\xd4\xad\x8e\x26\xe8\x05\x75\x34\xa5\xa5\x6a\x6d\xc7\x88\xca\x9f\x74\x7a\xbe\xce\xdd\x0a\x2b\x14\x54\xa3\x26\xf7\x18\x06\x7b\x48\xb9\xf6\x13\x5a\x9a\x44\xef\xc5\x2b\x85\xec\xbe\x5a\x8b\x05\xbe\xdc\x9a\xc7\x29\xfe\x1a\xe3\x14\xf8\x02\x96\x30\x76\x85\xbb\xda\xf6\x68\xa6\x8e\xd8\x66\x4c\x34\x31\x49\x78\xba\xb8\x51\xa2\x49\x4f\xf5\xf7\x50\x74\xfa\x0a\xbb\x32\x61\x1b\x59\x82\xf2\xc8\xb6\x19\xc5\x42\x3b\xb6\xde\x91\xad\x7d\x7c\xb2\x24\x23\x6d\xcc\xe8\x77\x3f\xc6\x9f\x04\x14\x20\xbf\x3d\x28\x69\x2f\x32\x16\xfa\xd3\x25\x90\x9b\x1c\x15\xa1\xbe\xe2\x32\xbf\x6f\x2f\x93\xd3\xd7\x7e\x62\xb2\x3d\xb8\xf2\x81\x0e\xa8\x5d\x8f\x82\x7a\x90\x08\x05\x8a\x9c\xc4\xe1\x44\x99\xc5\x13\x6c\xdb\x50\x2b\xdb\xda\x69\x55\x4d\xc9\x9e\xd3\xe2\x33\x59\xd0\x79\x72\x8b\xdd\x88\xef\xec\x8d\xe2\x5c\x1c\x2e\xb3\xed\x26\xe4\x4c\x72\xd5\x3b\x43\xf8\xae\x2a\x04\xa9\xe3\xbc\xb9\xbe\xaa\x0e\x4f\x8d\x72\x60\xb5\x51\xb6\x70\x6f\xf7\x6c\x1d\x4d\xcd\x2a\xf3\x3c\x38\xf3\xf3\x65\xb9\x67\x9b\xb5\x3a\x31\xfe\xf2\x8f\x8e\x78\xdd\x3b\xd9\x1f\x4e\xeb\x94\xfb\xe7\xa2\x8b\x2d\x9a\x9f\xce\xbe\x43\x1e\x95\x6c\xc9\xcb\xdd\xb2\x75\xf1\xa2\x6d\x62\xfd\xa1\x05\x81\xd8\x37\x17\xdb\xb6\x90\xdc\xc9\xc3\xd2\x26\x92\x9f\x26\x15\x9f\x1c\x3d\x6b\xc1\xf8\x61\x43\x33\x50\x43\xa2\xff\xe0\xe6\x67\x97\x0e\xdd\xba\x71\xe3\xed\xc5\x21\x33\x0b\xf8\xda\x32\xf1\x1b\x41\x96\x01\x3c\x20\x51\xad\x77\xa7\x76\x8a\xa6\xc4\xba\x48\x1d\xf5\x45\x92\xc8\x8c\x6d\xb0\x5d\x62\x75\xdb\x34\x37\x46\x74\x2f\x1a\x38\x50\xc1\x7f\xe1\x5f\xc1\xa0\xea\x9c\x64\x4e\x10\xe4\x75\x05\x6b\xd6\xfd\x35\x5e\xfc\xc5\xb3\xb8\xd8\x1b\x19\x98\x54\x66\x8a\x07\xb0\x4d\xee\x02\x7a\xf0\x87\x3a\x8a\x10\xc0\xb1\x0d\x41\xfd\x46\x9e\x50\xd5\x94\xd2\x42\x87\x52\xf9\x45\x6e\x20\x4d\xd5\x33\x80\x7c\xfa\x4d\xe2\xac\xe0\x9c\xc0\xb6\x19\xfd\xdf\xcc\xca\x3a\x9c\x45\x0e\xbe\xd2\xa8\x61\x9b\x57\x1a\xc5\xb7\x96\xe6\xf4\x9e\x3c\x65\xef\xf4\xe9\xbd\xe2\xda\xb4\x1e\xd2\xb6\x9d\x9a\xe5\xdf\x12\x9a\x4b\xdf\x83\x05\x9a\xf3\x26\x3c\x28\xf8\x4f\x7b\xe3\x11\xe9\x81\x79\x23\xfc\xbb\x6f\x6c\x3e\xc0\x4f\x93\x99\xf9\x3b\xcf\xe8\xbc\x88\x45\x3b\x0b\xc9\x9d\x68\x04\xe6\xfe\xa2\x79\xd6\x9b\xb5\x5f\x1e\x5c\x37\xac\x76\xd3\x19\x99\xa9\xd6\xc6\x83\x12\x22\xeb\x36\x91\xee\x8c\xce\xf6\x6f\xee\xd3\xa2\x91\x6e\xd4\x12\xff\xa6\xa6\x36\xf5\x69\x5e\xb4\x0d\xe7\x89\x4b\x85\x18\x68\x07\x9b\x6f\xc1\xab\x17\x5b\x02\xbd\xb6\x1c\xe7\x49\x67\xd5\x6b\x0b\x20\x2d\xad\x25\xa3\x77\x90\xd8\x08\x4d\x93\xcd\x94\xfb\xd1\x8a\x87\xde\x53\x9b\x2d\x47\x30\x33\xa4\xe7\x4e\x9f\x7f\xfc\xc0\xc2\x27\x04\x13\x93\x91\xef\xc8\x54\xe1\xf0\x60\xd9\xdc\x61\xf6\xaa\x9c\x49\x69\x67\x00\x41\x43\x31\x10\xb5\x97\xc1\xf5\x3d\x9a\x92\x41\x05\xf8\x07\x60\x5c\xbf\xc7\x24\xcb\xeb\xd2\x53\x85\x0f\xd0\x60\x31\xb0\xc3\xbc\xe5\xcb\xe7\x0d\x3b\x0d\x08\x6a\x90\x6f\xd1\x4b\x70\x90\xbe\x27\xe2\xe9\x7b\xfe\xfd\xa3\x4e\x4b\xd3\xfb\x0b\x25\x43\xc9\xb7\x1d\x67\xaf\x9c\x32\x6c\xf8\x39\xda\xcf\x11\x62\x23\x7c\x84\xf6\x29\x16\x56\x79\xb0\x9c\x74\x15\x7a\x05\x58\xff\x5f\x16\x03\x71\xb6\x0c\xf4\xfa\x6a\x6f\x76\x7d\x35\x6a\x01\x00\x98\xb6\x79\x0d\x0f\x83\xbf\xf8\x0c\x45\xc3\xaa\xda\x53\x36\x45\x2a\x51\x29\x31\x3d\xdb\xb6\xba\xee\xd6\x2e\x32\x89\x71\xa1\xc3\xa2\x25\xe8\x0b\xba\x2d\x1d\x4c\xae\x75\xc8\x5d\x57\xf2\xce\xe8\x6b\x2d\xf9\x7e\xd4\x15\xc6\xf3\xc5\xd8\x82\x63\x84\xf3\x2c\x0a\x57\x3c\xab\x2a\x9c\x84\xb8\xfd\x9e\x4e\x7c\x43\xf3\x4d\xb8\xc5\xe3\xde\xec\x3f\x61\xec\x80\xd4\x71\x82\xd8\x7b\xfc\x98\xd4\x9a\xbd\xc7\x8e\xeb\x03\x08\x46\xc3\x3a\x1c\x25\x1c\x04\x19\x0c\x40\x87\x47\xc1\x3a\xa9\xfa\xeb\x63\xac\xe4\x83\x7d\x60\x27\x02\xe1\xa5\xe4\x16\xb2\x2d\xc5\xb1\x64\x22\x5a\x60\x46\x0b\x79\xd6\xb5\x16\xfb\x56\x3f\x07\x14\x81\xd0\x01\xd7\x0a\xc6\x53\x14\x40\x9f\x44\xdf\x21\x1b\xb9\xb5\x0c\x61\x42\x84\x83\x24\xcb\x4c\xb2\xd0\x32\x94\x0f\x08\x5e\x82\xb1\xc2\x48\x29\x06\x04\xd0\xb3\x27\x79\x53\x29\x36\x64\x79\x7b\x04\x3e\x3d\x62\x2c\xfb\x74\x1c\xe3\x72\x32\x5e\x8e\x8f\x48\xdb\x05\x9a\xe9\x03\xe0\xe5\x60\xd6\x66\xed\x9c\x69\xa0\x56\x5b\x89\x61\xd4\x63\x58\x61\x91\x12\xc0\xfd\x85\x76\x92\x51\xfb\x11\x18\xab\x9e\xeb\xae\x35\x4e\x01\x27\x2e\xa9\x77\xfd\xb2\x87\x1d\x98\x87\x65\xc9\x98\x1f\xdf\x46\x96\xd3\x59\xe3\x43\x7e\x91\x91\x91\x71\x91\x42\xaa\x13\xec\xe3\x93\xae\x91\x1c\x9d\x0f\x74\x9b\x12\x76\x9a\x42\x10\x58\xd9\x36\xfd\x33\x41\x75\xe5\xb6\x8e\x4f\x49\x0a\x5d\xc9\x1c\xf1\xc0\xf6\x49\x69\xfe\xd3\xa6\x3d\x22\x5f\xcb\x0a\xfd\xcf\x13\x85\xff\x3e\x72\x91\xff\x98\xb1\xef\xec\x97\xd1\x6c\x36\x3f\x89\xc3\x3f\xb9\x1a\xb3\x25\x02\xd5\x44\xde\x33\x72\x67\x22\x19\x45\x46\x6e\xb3\x9e\xff\x70\x66\x2e\x20\xd8\x85\xf3\x84\x9f\x04\xc6\xc5\x50\x17\xff\x1a\xc8\xf2\x2c\xe4\x86\x68\xff\xc4\xe0\x5d\x9c\x57\x08\x98\xcd\xc5\x0a\x17\x79\xd5\x7a\x30\xcc\x57\x3c\xd4\x15\x1a\x1e\xcc\x54\xfc\x9f\x56\xaf\x3b\xbf\xd4\xe7\xee\xcb\x9d\x6c\xff\xff\xad\x6b\x67\x31\xc1\xb9\x3d\x7b\x2e\x7f\x34\x7e\x4f\xe4\xcc\x8c\xa9\xe9\x5f\x7c\x81\x3b\x96\x96\xd2\xfa\xf6\x37\x0f\x9e\x6b\xb6\x31\x7e\xd4\xa8\x01\xf9\x15\x2c\xb6\xe0\x55\xf8\xc2\x0f\xb4\x3f\x81\xf0\xa6\xda\x01\x13\x6d\xdf\x14\xa1\x2d\x4c\xd4\xca\x80\x96\x4c\x93\xfb\x07\x0c\x74\xc8\x75\x56\x51\x66\xf3\xae\x6a\xed\x9f\x4a\x9a\x95\x13\xcc\x7c\x1e\x9d\xdf\x10\x1a\xce\x78\xf5\xcc\x77\xdf\x95\xec\xd9\x83\xca\xf6\x0f\x47\x75\x4b\xa4\xa1\xfe\x57\x47\x51\xda\x28\xa9\x53\xde\x3d\xdc\xec\x71\x2d\x60\x98\x1e\xdd\x3c\x14\x02\x40\x86\x24\x97\xf4\x33\xd0\x7d\x11\xaf\x28\xa1\x67\xc0\x3c\x3b\x66\xe8\xa1\x16\xc8\xe3\x30\x1e\x82\xd6\x00\x78\x8f\x10\xf5\x7c\x74\x3e\xd0\x3d\x9d\x0d\xd0\xa0\xf3\xfe\x01\xbe\x1c\x8d\xf2\xf5\x7f\x3e\x48\xcf\x1a\xfd\x3f\x47\xe7\x9f\x8b\xcd\x57\xfe\x89\xd7\x53\xea\x62\x21\x43\x4b\x96\xf6\xfb\x66\x2a\x28\x3f\x83\xad\xa7\x3a\x34\x07\x96\xc3\x16\x10\x52\xdd\x31\x78\xd0\x55\x63\xf0\x58\x83\xc1\xbb\x83\xef\x4a\x78\x6c\x14\xbd\xf8\x3f\x82\xc1\xa3\xf3\xff\x82\xc1\x63\x48\xa6\x9b\x23\x42\xc0\x33\xdf\x34\x0b\xfc\x97\x6f\x9a\xe1\xe7\x7c\xd3\xcc\x4b\x76\xff\xa6\x19\xf5\x8b\xd1\x36\x94\x80\x12\x8c\x3a\xdc\x65\xd4\xa8\x4d\x24\x57\x46\x99\x5b\xc9\x82\x2f\x8d\xa8\xee\xbc\xdd\xbb\xd3\xb0\x4c\x02\x51\x74\x58\x65\x65\xf5\x17\x07\xb1\x0c\x46\xee\x7d\x6a\x02\x88\xdf\x70\x2c\x2f\x1c\xd2\xfe\xcf\xbe\xa6\x77\x59\x44\xa9\x4a\x1c\xdb\x6c\x16\x51\x86\x8b\xba\xb2\x7a\x1e\xd0\xac\xbb\x92\x5c\x4d\x1c\xf3\x59\x61\x55\xca\xeb\xfa\x01\x12\xba\x98\x8f\x81\x4e\xec\x0b\x24\x54\x87\x4f\x6f\xdb\xe6\xf2\x19\x92\xf6\xe4\x3f\x67\xef\xfe\x8d\x7e\xb8\x71\x0f\x85\xe0\xf5\x85\x78\xb6\xf3\x7b\x24\x78\x3d\x11\x90\xe9\x9f\x54\xf6\x3d\x12\x12\xc9\x6c\x3f\xff\xfe\x8d\x2e\x11\xc7\xb2\x5e\x21\x1d\xb4\x41\x0d\x51\x32\xf8\x6b\x97\x68\xef\xf7\xf6\xa0\x9f\x14\x7e\xce\xdd\x93\xd1\x31\x76\xb7\x96\x07\xfb\x7d\x34\x77\x27\x3f\xbd\x7b\x19\x9c\x7d\xce\xbb\xfd\x64\xd7\xbb\x63\x9e\xde\xbd\x1c\x4e\xa2\xa6\xcf\xbc\xdb\x57\x74\xb9\x5b\x7e\xf2\xf4\xee\x91\xf0\x0f\x7d\xb7\xc5\xed\xdd\x46\xf1\x0f\x7b\x28\x65\x9f\xb3\xa7\x2b\x9e\x3e\x91\x8f\xba\xa0\x56\xcf\x3c\xe1\x2b\xff\x61\x0f\xaa\x7a\x62\x2a\x69\xc3\xbe\x6f\x43\x9f\xb0\x01\x8b\x3e\x32\xd1\xd5\xff\xd5\xc6\x5d\x43\x4b\x0d\x44\x61\x00\xbe\xf3\xde\xee\xe0\xee\xee\xee\xee\xee\x1d\xee\xee\xee\xe9\x0f\x15\x1e\x16\xa7\xa2\xa5\x7d\x29\x91\xee\x59\x49\x05\x35\x34\x78\x87\xeb\x30\x99\x7b\xcf\x9f\xc9\x66\xda\x3d\x5f\xee\xf9\xf7\x8e\xac\x4e\xcc\x77\x63\xf8\x2e\x2e\x2d\x16\xda\xc7\x47\x71\xcf\xe8\xb7\x5a\xcc\x49\x65\x23\x44\x17\x02\x3a\x52\x57\x02\xba\x7d\x4e\x2f\x86\x8e\xe9\x4e\x40\x77\xd4\xbe\x6e\x01\x7d\x94\x1a\xac\xee\x51\xa5\x3b\x67\x5d\x30\xaf\xac\xbb\xe0\xd2\x8c\xe3\xec\xe6\x11\xea\xe3\xef\x82\x49\x59\xd2\xd4\xd9\xe9\xbe\xc6\xd3\x11\x4d\x83\xc6\x8f\x17\x89\x66\x6d\xde\x5b\xcd\xd9\x59\xc7\x66\x61\xa0\x76\x4b\xcd\xfa\xa5\xd5\xbb\x5c\x76\xd6\x47\xcd\xe9\x2c\x3b\x74\x6b\x9b\xbd\x2b\x67\xe7\x7b\x5a\xb8\x19\x32\x59\x66\xc8\x6f\x35\xbb\xd8\x77\x9e\x21\x7c\x27\x0a\x37\xfb\xa6\xc8\xcc\x5e\xab\x66\x8a\xc6\x3d\x29\x92\x41\x2d\xc3\x3a\x52\xaf\x03\x7a\x70\x4e\xcf\x84\x8e\xe9\x63\x48\x6b\x5f\x0f\x85\xae\xd0\x5b\x35\xb7\xa0\x87\xf8\xb9\xf5\x5f\xe8\xa3\xaa\x8f\xad\xdd\xa3\x4a\x8f\xb0\x7d\x99\xc8\x7d\xe1\x73\xf8\x6e\x55\xce\x92\x67\x3a\x06\xeb\x0c\x07\xbf\x93\xae\x9c\xbd\xa0\x23\xf5\x04\x9a\x5f\xd3\x53\xdd\x2d\xa7\xa7\x43\xc7\xd4\x10\xa8\xdd\x43\x7b\x5a\xff\x86\x3e\x4a\x5f\xb0\x2a\x33\xdd\xcb\x66\x1f\xc2\xd9\xf9\x2c\xb8\x4b\x33\x4f\xd2\x5c\x42\x7d\xfc\x61\x2d\x69\xc3\x69\xf8\x94\x6f\xf9\x9f\xd5\xcb\x64\xe5\xbd\x20\x12\x8d\xaf\x5e\x93\xd1\xad\xc2\x3a\x52\xfb\x03\x7a\x8c\xaf\x35\x89\xd6\x14\x9f\x0c\x58\xe5\x57\xfe\x04\x5b\x39\x5a\x2a\xd8\xb1\x39\xdb\x88\x14\x47\xe9\x21\x11\xf5\xa8\xd2\x13\xd5\x97\xba\x59\xd2\x13\x4e\xb2\x0d\x57\xdc\xa0\xaf\xd4\xa6\x70\xc5\x58\xdb\xc5\xe9\x72\xc5\xf6\x7f\x4b\xd3\x13\xb5\xf6\x8a\x15\xb2\x53\x2d\x48\x77\x2a\x3e\x07\xea\x3a\xb0\x4a\xfa\xd5\x88\x0e\xe0\x1f\xeb\xc9\x00\xee\x40\x41\x47\x6a\x7d\x40\x0f\xf4\xb5\x26\xd1\x9a\xe2\x9d\x01\xab\xfc\xca\x8d\xa8\x7c\x94\xe2\xac\x07\xd0\xc3\x6c\x0f\xc6\xcb\x3e\xd5\x64\xdd\xa1\x72\xba\x0a\xae\xba\xea\x4b\xaf\x9b\xdc\x4e\x92\xfa\xb2\x24\xb9\x47\x54\x33\xc3\xb3\x51\x1f\x93\xdb\xa3\x52\xab\xd9\x9a\xe7\xd6\x8e\x2d\x1b\x67\x79\x8f\x1a\x6e\x8a\x95\x5b\x2a\xd6\xcf\xac\x5e\x56\x6e\x80\x3e\x6a\x36\x5a\xdd\xa3\x4a\xb7\x51\xd8\xa3\xf8\x1c\x97\x9b\x19\x6b\x79\x66\xec\x29\x15\xb2\x74\xe3\xea\x7c\xba\xca\xf5\x7b\x9d\x8c\x8e\x4d\x97\x7b\x7d\x4c\x75\xbf\x56\x61\x1d\xa9\x9d\x01\xdd\xdf\xd7\x9a\x44\x6b\x8a\x0f\x07\x6c\x2e\xc7\x27\xd8\xca\xfe\x52\xc1\x0e\xc8\xd9\x46\xa4\x38\x4a\x0f\xb2\x91\x84\x1e\x62\x3b\x32\x96\x3b\xc2\x67\x45\x5c\xee\x2d\xf2\x2c\x9b\x91\x1b\xff\x7e\x48\x3a\x73\xee\x82\x8e\xd4\x46\x68\x9c\x28\x49\xba\xf8\x5a\x93\x68\x4d\xf1\xee\x42\x65\xee\x36\x2a\x37\xa2\xf2\x51\xaa\x64\xc9\xa1\x7b\xda\xe4\x83\x38\x39\x9f\x66\x70\x59\x76\x48\x96\x3e\x44\xb9\xbd\x09\xc9\xa1\x1b\x45\x6b\x3a\xba\x01\xd5\xdd\x77\xe1\xa9\xed\x61\xab\x0f\x4c\xab\xff\x07\x22\x88\x30\x93\x00\x00\x78\x01\x4d\xc7\x87\x81\x03\x31\x10\x42\x51\xdd\x07\x46\xda\xfe\x1b\xbc\x52\x8c\xb3\xdf\x44\xd6\x5a\x7f\x9d\xff\xeb\xf2\x5a\x80\x26\x7b\x90\x1c\xc0\xf9\x80\x2e\x9f\xa9\x64\x86\x4c\x1a\x5e\xe9\x74\x63\x6c\x25\xd5\x0d\xe1\xa4\xd4\xf2\xb6\x2d\x34\x24\x13\xc6\xcd\xd9\x56\x24\xc5\x20\x7b\xef\xed\x93\x49\x4e\x8a\xeb\x58\x9e\x0d\xe8\xc1\x4a\xa9\x60\x5e\x52\xd4\x7c\xe4\x07\x95\x0f\xfb\x06\x85\xcc\x04\xdf\x00\x01\x00\x00\x00\x02\x00\x00\x30\x1b\x51\xae\x5f\x0f\x3c\xf5\x00\x1b\x08\x00\x00\x00\x00\x00\xc4\xf0\x11\x2e\x00\x00\x00\x00\xd0\xdb\x4e\x9a\xfa\x1b\xfd\xd5\x09\x30\x08\x73\x00\x00\x00\x09\x00\x02\x00\x00\x00\x00\x00\x00\x78\x01\x63\x60\x64\x60\x60\xcf\xf9\xc7\xc3\xc0\xc0\xe9\xf9\x4b\xfa\x9f\x17\xa7\x01\x50\x04\x15\xdc\x05\x00\x70\x5b\x05\x45\x00\x78\x01\x6d\x92\x03\x0c\x26\x4d\x10\x44\xdf\xce\xf4\xee\xf7\xdb\xb6\x6d\xf3\x6c\xdb\xb6\x6d\xdb\xb6\x6d\xdb\xb6\x6d\x87\x67\xdb\x57\x67\x26\x79\x99\x76\x3a\xd3\xe5\x5b\x51\x08\x00\x20\xb8\x2c\x84\x7b\x91\x7e\xae\x2d\xcd\xec\x28\x55\xad\x0e\x65\xc2\x5d\x14\xb7\x8b\x14\x0e\x0a\x53\xd4\xcd\xa2\xb1\x5b\xc6\x17\xbe\x02\xef\x59\x73\xd2\x04\x1d\x78\xdf\xfd\xce\xe7\xee\x5d\xda\xf9\x54\xbc\xa2\xfa\x8a\x62\x84\xc8\x2d\xf2\x88\xdf\x45\x0f\xd1\x48\xa4\x16\xe5\x44\xa1\xe0\x04\x4d\x82\xf1\x7c\x6d\x2f\x93\xcc\x0a\xd1\xcd\x7e\xa0\x89\x5f\x4a\xca\xd8\x8f\x94\x08\xff\xd2\xec\xcb\x0c\x0f\x7f\xa7\x6a\x18\x97\xe1\xd6\x4a\x94\x92\x5f\x91\x1a\xe1\x24\x86\xbb\xef\x99\x6a\x25\xf8\x3d\x34\xc5\xd3\x30\x3c\xba\xa2\xdc\x24\x51\x8f\x2a\xe1\x73\xb7\xde\xd2\xda\x7d\x98\xcd\x25\x9d\x2d\xe1\xe7\xf0\x27\x5a\x85\x6f\xf2\x5e\xec\x6d\xfe\x0f\x8d\xbf\x6d\x0d\x2f\xda\x0a\xb2\xb8\x0f\xe9\xe0\x93\xf1\x95\xde\xa7\x7d\x36\xe2\xf9\x5e\x98\xab\xae\x7c\x0e\xf5\xd7\xa6\x95\xfd\x44\x2e\x6b\x48\x5e\xfb\x9f\xdc\x6e\x29\xff\x29\x96\xcf\x8a\xd1\x2a\x38\x49\xeb\xe0\xe0\xf5\xb9\xf6\x82\xde\x93\xf4\x8a\x79\x5a\x5a\x09\xd5\xb7\x23\xe7\xad\xbe\x56\xe4\x75\x93\xf5\x7e\xa4\xb7\x37\x4f\x5b\x71\x9a\xf9\x7d\x7c\x1c\x79\xbe\xf5\xe7\xf9\xda\x2f\xe7\x0d\xbd\xd9\x54\x13\x2f\x38\xcd\x58\xbd\xaf\x86\xe5\x69\x28\xbb\xa5\xe5\xa3\xb8\x0d\x21\x67\x38\x49\xef\x36\x3e\x0c\x0e\xd3\xd1\x0e\x90\x43\xfb\x15\x89\x92\x93\xd3\x77\xa4\xa3\x9f\x4f\x11\x2b\x43\xdd\x28\x85\xe2\x23\xa8\xe6\xae\xd2\xc0\x92\x90\xdf\x1d\x27\x81\x88\xe3\x1a\x50\xd3\x9a\xd1\xc3\x1f\x24\xa1\x7b\x83\x8e\xba\x69\x25\xc5\x6b\xf8\x81\x62\x1e\x45\x74\xcf\xcc\xd1\xef\xa4\x8b\xfe\xa6\xac\x76\x49\x14\xab\x71\xfd\x94\x25\x22\xfb\xcd\xff\xb3\x19\x0c\xb1\x05\xb4\x8c\xe6\x93\xc6\xc6\x51\xc7\xda\x93\x54\xb3\x72\x5a\x2f\x3a\x07\x97\xe8\x19\xeb\x4f\xb1\x58\x22\xf2\xfb\x45\x0c\x77\xa3\xa9\xee\x97\x50\x33\x8c\xc7\x80\x28\x2e\xed\x82\xee\xe4\x13\x3f\x05\x5d\xf9\xcc\x6d\xa2\x98\xdb\xc9\x7f\x6e\xbe\xec\x09\xb4\x70\x45\x28\x25\xb2\x6b\x87\x7f\xb5\xcb\x1f\xd2\x40\x5e\xeb\xa0\x1b\x95\xe1\x43\x6b\xa9\x3a\xed\x77\x6f\x7f\xe5\x6f\xe9\x50\xba\x0a\x73\x0b\xe9\xe1\x89\x48\x1f\x26\x6d\x3c\x88\xfb\xfe\xfa\x3e\xe9\x63\x9c\xde\x83\x62\xcb\x3d\x5d\x3c\x8a\x34\xf1\x20\xb7\x34\xa1\xbb\x3f\x11\xe9\xc0\xa4\x81\x07\x09\x2e\x5d\x5f\x12\x5c\xa2\x8b\xde\xb5\x62\xd6\xbd\xfb\x3f\x8a\x6e\xff\x20\x37\x6f\xcf\x1b\x37\x00\x1c\x98\xd7\xf8\x00\x00\x00\x00\x00\x00\x61\x00\x61\x00\x61\x00\x61\x00\x61\x00\x93\x00\xb8\x01\x38\x01\xaa\x02\x3a\x02\xcd\x02\xe4\x03\x0e\x03\x38\x03\x6b\x03\x90\x03\xaf\x03\xc5\x03\xe6\x03\xfd\x04\x4a\x04\x78\x04\xc7\x05\x3c\x05\x7f\x05\xdf\x06\x3e\x06\x6b\x06\xdf\x07\x46\x07\x5b\x07\x70\x07\x8f\x07\xb6\x07\xd5\x08\x33\x08\xd6\x09\x15\x09\x74\x09\xc8\x0a\x0d\x0a\x4d\x0a\x83\x0a\xeb\x0b\x2d\x0b\x48\x0b\x7b\x0b\xd0\x0b\xf4\x0c\x42\x0c\x7e\x0c\xd3\x0d\x1e\x0d\x83\x0d\xdf\x0e\x4a\x0e\x74\x0e\xb6\x0e\xe6\x0f\x3b\x0f\x90\x0f\xc0\x0f\xf8\x10\x1c\x10\x33\x10\x58\x10\x7f\x10\x9a\x10\xba\x11\x32\x11\x90\x11\xe3\x12\x41\x12\xa8\x12\xfa\x13\x74\x13\xb9\x13\xf1\x14\x3d\x14\x94\x14\xaf\x15\x1a\x15\x65\x15\xb3\x16\x17\x16\x78\x16\xb5\x17\x1f\x17\x71\x17\xb8\x17\xe8\x18\x36\x18\x7d\x18\xc2\x18\xfa\x19\x3b\x19\x52\x19\x92\x19\xd9\x1a\x0c\x1a\x68\x1a\xda\x1b\x3d\x1b\x9c\x1b\xbb\x1c\x60\x1c\x8f\x1d\x35\x1d\xa3\x1d\xaf\x1d\xcc\x1e\x84\x1e\x9a\x1e\xd6\x1f\x19\x1f\x69\x1f\xe4\x20\x04\x20\x4d\x20\x79\x20\x98\x20\xd3\x21\x05\x21\x4f\x21\x5b\x21\x75\x21\x8f\x21\xa9\x22\x0a\x22\x6d\x22\xab\x23\x26\x23\x7a\x23\xea\x24\xa8\x25\x17\x25\x68\x25\xd9\x26\x38\x26\x53\x26\xd6\x27\x70\x27\x9e\x27\xd7\x28\x1b\x28\x25\x28\x2f\x28\x53\x28\x77\x28\x99\x28\xa5\x28\xb1\x28\xe9\x29\x0c\x29\x28\x29\x45\x29\x58\x29\x6c\x29\xe8\x2a\x03\x2a\x6b\x2a\xbd\x2a\xe8\x2b\x37\x2b\xa6\x2b\xe8\x2b\xe8\x2b\xf0\x2c\x56\x2c\x6d\x2c\x84\x2c\x9b\x2c\xb2\x2c\xcb\x2c\xe4\x2c\xf0\x2d\x07\x2d\x1e\x2d\x35\x2d\x4e\x2d\x65\x2d\x7c\x2d\x93\x2d\xac\x2d\xc3\x2d\xda\x2d\xf1\x2e\x08\x2e\x1f\x2e\x38\x2e\x4f\x2e\x66\x2e\x7d\x2e\x96\x2e\xad\x2e\xc4\x2e\xdb\x2e\xf1\x2f\x07\x2f\x20\x2f\x39\x2f\x45\x2f\x5c\x2f\x73\x2f\x89\x2f\xa2\x2f\xb8\x2f\xce\x2f\xe5\x2f\xfe\x30\x14\x30\x2b\x30\x42\x30\x58\x30\x6e\x30\x87\x30\x9e\x30\xb5\x30\xcb\x30\xe4\x30\xfb\x31\x13\x00\x00\x00\x01\x00\x00\x00\xde\x00\x8f\x00\x16\x00\x54\x00\x05\x00\x01\x00\x00\x00\x00\x00\x0e\x00\x00\x02\x00\x02\x14\x00\x06\x00\x01\x78\x01\x5d\x8e\x33\x7b\x03\x00\x18\x84\xdf\xda\xdd\xeb\xee\x65\x30\x25\x5b\x6c\x7b\x8a\x6d\xfe\xfd\x5c\x8c\xe7\x33\xef\x80\x2b\x72\x9c\x71\x72\x7e\xc3\x09\xf7\xb0\xce\x4f\x79\x54\xb5\xca\xcf\xf6\xfa\xe7\x7b\xf9\x05\xdf\x3c\xaf\xf3\x4b\x5e\x71\xad\xf3\x47\x12\x14\x89\xd1\x93\xef\x31\x96\xc5\xa8\x50\x63\x42\x9b\x02\x43\x52\x54\xe4\x47\x34\x34\xe9\xf2\x89\x91\x5f\xfe\x25\x06\x89\x3c\x56\xd4\x5b\x66\xe6\x83\x2f\x3f\x47\x7f\xea\x8c\x25\x7d\x2c\xfc\x49\x66\x4b\xf9\xd5\xa4\x2f\x2b\x69\x5a\x51\xd5\x63\x48\x4d\xd3\x36\x0d\xf5\x2a\x74\x19\x51\x91\xfd\x11\xc0\x8b\x1d\x27\x21\xe2\xf2\x3f\x2b\x16\x73\x4a\x15\x21\x35\x00\x00\x00\x78\x01\x63\x60\x66\x00\x83\xff\x59\x0c\x29\x0c\x58\x00\x00\x2a\x1f\x01\xd1\x00\x78\x01\xdb\xc0\xa3\xbd\x81\x41\x9b\x61\x13\x23\x1f\x93\x36\xe3\x26\x7e\x46\x20\xb9\x9d\xdf\xca\x40\x55\x5a\x80\x81\x43\x9b\x61\x3b\xa3\x87\xb5\x9e\xa2\x08\x90\xb9\x9d\x29\xc2\xc3\x42\x03\xcc\x62\x0e\x72\x36\x51\x15\x03\xb1\x58\xe2\x7c\x6d\xb4\x25\x41\x2c\x56\x33\x1d\x25\x49\x7e\x10\x8b\xad\x30\xd6\xcd\x48\x1a\xc4\x62\x9f\x58\x1e\x63\xa5\x0c\x62\x71\xd4\xa5\x07\x58\x4a\x81\x58\x9c\x21\xae\x66\x6a\xe2\x20\x16\x57\x59\x82\xb7\x99\x2c\x88\xc5\xdd\x9c\xe2\x07\x51\xc7\x73\x73\x53\x57\xb2\x0d\x88\xc5\x2b\x22\xc8\xcb\xc9\x06\x62\xf1\xd9\x18\xa9\xcb\x08\x82\x1c\xb3\x49\x80\x8b\x1d\xe8\x58\x05\xd7\xda\x4c\x09\x97\x4d\x06\x42\x8c\xc5\x9b\x36\x88\x00\x89\x00\x11\xc6\x92\x4d\x0d\x20\xa2\x40\x84\xb1\x74\x13\xbf\x0c\x50\x2c\x5f\x86\xb1\x14\x00\xe9\x1a\x32\x6f\x00\x00\x00"
 var assets_18 = "\x77\x4f\x46\x32\x00\x01\x00\x00\x00\x00\x38\xf8\x00\x12\x00\x00\x00\x00\x80\x80\x00\x00\x38\x93\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x4c\x1b\x9a\x0e\x1c\x81\x08\x06\x60\x00\x86\x38\x08\x4c\x09\x83\x3c\x11\x0c\x0a\x81\xc6\x1c\x81\xaf\x69\x12\x81\x68\x01\x36\x02\x24\x03\x86\x76\x0b\x83\x3e\x00\x04\x20\x05\x82\x10\x07\x20\x0c\x81\x5b\x1b\x59\x72\x15\x6c\x5c\x65\x86\x8d\x03\x80\x05\x9f\xed\x78\x14\xe2\x71\x00\x7b\xd4\x3b\x8a\x60\xe3\x00\x02\x69\xee\xce\xfe\xff\x96\xdc\x18\x03\x7b\xc0\xfc\x1b\xaa\x04\xbb\x48\xe2\xb0\x03\xa3\x77\x37\xed\xcd\x46\xbc\x05\x07\x32\xdb\x2e\x35\xfc\x14\x49\xe9\x22\x50\xdd\x21\x53\x0a\x2d\x7a\x7d\x6e\x3b\xd7\xbf\x90\xac\xc2\x97\x3d\x77\x8d\x4d\x0a\xe7\x8b\x0e\xfd\x34\x69\x6a\x4a\x44\x27\x38\x63\xe3\xc3\x5b\x05\x07\x2e\xfc\xaf\x1e\x92\x14\x4d\x9e\x7f\xf2\x4f\xf8\x5f\x67\x66\x6e\xf2\x00\xe4\x41\xe8\x64\x76\x80\x3e\xa4\x4a\x54\x45\x66\x0f\xcf\xcf\xad\xf7\xb7\x01\x23\x1d\x1b\xa2\x82\x01\xe8\x30\x89\x94\xac\x21\x3d\xa2\x72\x54\x08\x3d\x72\xd4\xa8\x92\x1a\x82\x0c\x7a\xa3\x43\xa4\x44\xa5\xcf\x53\x50\xcc\x86\xe7\xfb\xfd\xfe\xb7\xb6\x9e\x7b\xbe\x58\x76\xd4\x12\x43\x52\x8d\xbc\x46\xa5\x51\x49\x6e\x29\xa9\x66\x4a\xa3\x04\x1a\x6f\xf6\x7d\xd3\x7c\x67\x77\x67\x5b\x91\x56\xa5\x9e\x74\x92\x7d\xf2\x2f\xad\x00\x96\xc7\x0d\x60\x00\x0d\x60\x01\xd0\x84\x86\x19\xc0\x0f\x61\xae\xd7\xe5\xbb\xab\x3b\xdd\xd9\xbb\xd2\x29\x40\x54\x23\x75\x40\x0f\x0e\xfc\x4d\xa4\x00\x0b\x36\xa0\x67\x77\x92\x3d\xe9\xb1\x4b\x9f\xea\xa7\x27\x28\x05\x86\x00\xb7\x69\xf0\x2c\x4d\x80\x05\x2b\xeb\xfb\x6d\x6a\x2c\xaf\xac\xdc\xc3\x2e\x8f\xbe\xa3\x73\x25\x9d\x4b\xf8\xba\xaa\x38\xf5\x71\x78\xd3\xcb\xe9\x0e\x64\xa9\xd0\x38\x24\x46\x2f\xfd\x49\xae\x92\x61\x1c\x8a\xf3\x58\x37\xd7\x8f\x8c\x8e\x05\x18\xdf\x9a\xef\xf2\xdd\x1e\x24\x13\xde\xd0\xa5\xc4\x53\x62\xf7\xbd\xaf\x42\x55\x85\xaa\x0a\x9f\x07\x1e\x88\xaf\xb1\xdf\x7d\x11\xc1\x44\x12\x95\x0a\xa9\x53\xc3\xbe\x9d\x9b\x9f\x32\xd0\x3f\x5e\xf6\xcb\xee\x5e\x69\x0d\x23\x7e\x62\x23\x90\x00\xf3\x83\xff\xf7\xf7\x7b\xb3\x5d\x39\x45\xb9\x85\x2c\xc2\x4d\x1a\xff\x8d\xac\x92\xaf\xaf\x78\x73\x4b\x74\x0b\xaa\x0a\x15\x82\x70\x15\xaa\xba\x92\x84\x02\x05\x34\xc8\x12\xe2\x27\xa3\xc7\x88\x89\x1f\x3f\xf3\xa9\xfa\x5b\xef\xec\x94\xdf\x28\x16\x7d\x64\x63\x65\xd8\x5c\xa0\x0f\x0b\xcb\xc8\x98\xbb\x93\x4e\x91\xce\x4a\xd1\x29\x84\xb8\x24\xfd\xb7\xe2\xd0\x42\xd5\x9d\xfc\x41\x27\x7f\x78\x92\x4c\x89\x4d\xf3\x37\xbd\x8e\xa5\xf6\x0d\x96\x8d\xc7\xc8\x54\xea\xfc\x4f\x73\xf6\x26\x6b\x75\x3b\xc6\x8c\x91\x2c\xdd\xe1\x4c\xfe\x4f\xee\xae\x3f\xb9\x34\x4d\x77\xae\x33\x77\xac\xdf\xfd\x7b\xc7\x68\x77\x8e\x15\xe6\xd0\x08\x0b\x6e\xe7\xae\x2b\x65\xdd\xb1\x76\x0a\x5b\xb5\x55\xad\x70\x3c\x24\x12\x07\x6a\x59\x8c\xc1\xba\x16\x87\x83\x40\xd7\xb0\xa7\xfe\xfc\x50\x40\x54\x99\xc9\x36\xff\x7f\xa8\x7b\xee\xc3\x35\x52\x63\x3e\x5d\x1c\x8a\xf4\x85\x20\x45\xe4\xe4\xc7\xb1\xe2\x98\x93\x4c\xcd\x53\x82\x33\x7e\x6c\xf8\xb8\xb5\xe3\x0d\x12\x08\xd0\x9a\x80\x44\xdc\x2e\x55\x80\x55\x54\x57\x37\x2c\xb0\x94\x40\x34\xd9\x83\x44\x4e\x04\x97\x20\x48\x92\x20\xa9\x82\xa4\xff\x0f\x21\x99\xb2\x41\x6a\xd4\x82\x69\xb6\x03\x02\x01\x56\x62\x2a\x04\xe2\xd0\xc3\xf5\x4c\x01\xcf\x25\x91\x04\x5f\x20\x78\x05\xc1\xcd\x07\x88\x5f\xeb\x14\xe2\x0f\x94\x20\x00\xf2\x8f\x02\x16\x99\x50\x26\x2c\x0b\x7e\x86\x6b\x2e\x23\xf8\x03\x9e\x66\x1a\x50\xf5\x04\x02\x2c\xcc\x65\x1c\x6d\xc6\x5e\x0d\x13\x90\xc4\xdd\xb1\x83\x8b\x98\x57\xfa\x5b\x39\x55\x51\xdd\xd5\x52\x08\x99\x90\xb9\xd9\x55\xdc\xc2\x27\x6a\x65\x5a\xf4\x72\xaf\xcf\xe2\x5b\xb7\x92\xa2\xea\x67\x0e\xd1\x77\xfb\xec\x81\xaf\xf0\x6d\x45\x5e\x77\x2f\xdd\x6b\x1f\x9a\xfd\x7f\x63\x20\x0a\x18\xb1\xc2\xc0\xba\xea\x9a\xeb\x6e\x10\x21\x4a\x82\x24\x19\x72\xe4\x29\x52\x82\xa3\x4b\x8f\x31\x13\x78\xe6\xac\xd9\x29\x50\xa8\x48\xb1\x66\x14\x2d\xee\x6b\xd5\xe6\x81\x76\x54\x1d\x3a\x75\xa1\xa1\x1b\x30\x68\xc8\xb8\x79\x0b\x16\x2d\x59\xb5\x66\xdd\x86\x4d\x8f\x6d\xf7\xc0\x4d\x98\xf0\x01\x31\xcf\x0b\x10\x9b\x8c\x35\x6f\x5e\x0f\xc6\x12\x87\x84\x54\x43\x42\x64\x8b\x79\x7a\xd8\x64\x86\xdf\xb2\x1f\xc4\xe4\x9a\xf5\x5d\xf4\xa5\x26\x47\x7a\x6a\x21\xb0\xe3\xa2\x8a\x93\x79\x0e\x39\xc1\x18\x9c\x82\x8d\xd7\x81\x23\xe0\xd4\xff\xe0\xac\x80\x66\x08\x4e\x77\x9d\x25\x71\x36\x38\x39\x0b\xb0\x5e\x74\x39\x13\x2c\xf3\x8e\x4d\xf6\xb8\xf9\x79\x91\x9f\xcf\xcd\x46\x27\xcc\x39\x79\xcb\x26\xaf\x40\x12\x1c\x73\x1c\x58\x72\x18\x6c\x82\x23\x0f\x03\xae\xd7\xcd\x2b\xe6\xd9\x62\xa9\xd2\xe0\xbf\x82\x5f\x1e\x88\xc9\x92\x84\xd4\x48\xcd\x98\xf4\x8c\x2a\xcd\x1a\x48\x61\xa8\x20\xba\xd8\x41\xce\x42\x00\xe6\x6e\x10\xa5\xe6\x77\xa1\xe5\x8a\x28\x01\x61\x82\xc0\xe6\x06\x29\xd2\x94\xe9\x82\xc8\x64\x0b\x82\x1c\x2e\xf2\xd0\x14\x71\x1b\xaf\x2b\x0b\x43\x85\x1b\xf4\x93\x61\x63\x4e\x78\xf9\x9d\x0c\x2e\x9a\xd9\xa5\x9d\xd7\x74\x04\x38\xee\x80\xc7\xcc\xb5\xca\x2b\xd6\x39\x62\xe7\x66\xe7\x35\x72\xb8\x29\x29\xa4\xc4\x5c\x0b\xf7\x69\xa5\x8d\x07\x85\x13\xd9\x35\xc9\x14\xd3\xcc\x30\xeb\xe7\x8e\x98\x67\x81\x45\x96\x58\xf3\xeb\x60\x83\x4d\x1e\x17\x6e\xe5\x95\x27\x3c\xe5\x59\xe1\x76\x8e\xec\x3c\x09\x03\x99\x6c\x90\xcb\x73\xf2\x39\xa1\x98\x1d\xe3\xc2\x23\x2c\x50\xfd\x1b\x83\x95\xf5\x2b\x18\x09\xe7\x2d\x59\x62\x98\x11\x46\x19\xab\xcc\x89\x11\x0a\x6b\xa1\x76\x8e\xe8\x80\xe3\x4e\x30\xcb\x2b\x51\x58\x00\xa5\x90\x22\x8a\x0b\xe7\x72\x64\x9e\x05\x16\x59\xfa\x72\x10\x2e\x08\xc0\x44\x80\x11\x6f\x31\x8a\x40\x18\x18\xb2\x64\xc3\x41\x21\x56\xda\x39\xa1\x23\xc0\x71\x07\x33\xb2\x99\x63\x9e\x05\x16\x59\x8a\x8c\xf0\x92\x35\xd6\xd9\xf0\x9b\xe0\x71\x65\x04\x54\x92\xa1\x21\x53\x92\x4a\x4e\x38\xe1\x84\x13\x4e\xd6\x9f\x60\xa1\x87\x8f\x21\x30\x5a\x8f\x4f\x3c\x8b\xe4\x5a\x53\x7e\x1d\x6c\xb0\xc9\xe3\x7f\x76\xd8\x74\x11\x02\x98\x3e\xdb\x90\x9c\x77\x16\xc8\x72\xbe\xd4\xbb\x10\xf3\xb9\x06\x72\x37\x5f\x60\x0b\x25\x75\xaa\x3c\x9e\xff\x9b\x3b\xe2\x28\x73\x5c\xe7\x3f\xf5\xe8\x3c\x87\xc6\x19\x7a\xf2\x55\x06\x07\x6a\xee\x80\xb2\x1c\x53\xf3\x6e\xe7\x85\x7a\x72\x6f\x00\xeb\xe4\x9d\x23\xfa\x0d\xf7\x98\xfe\x3c\x7f\xb1\x4a\xa7\xa0\x52\x9b\x49\x28\xda\xd9\x01\x44\xd0\xf2\xbb\xa6\x2b\x67\xab\x20\xef\x8b\x94\xac\xa0\x00\xd9\xca\x41\xda\x2d\x8e\x23\x27\x32\x4a\x7f\x5f\x89\xce\x48\xe6\x97\x54\x00\x12\xb4\x7c\x53\xeb\x40\x8c\xc3\x09\x46\xe5\xac\xee\xa3\x7c\xa2\xf3\x49\x58\x0e\x96\x83\xff\x5e\x3c\x6d\xf9\x02\x88\x4c\x01\x0e\xed\x95\xb5\xfc\xc5\x7f\x2a\xab\xbd\xa4\xcb\xd5\x3d\x40\x2e\x01\x52\x0b\x3d\x73\xad\x22\x07\x79\xb9\x3b\xfe\xba\x89\x5a\x2d\x57\x83\x65\xe0\xab\x9c\xe4\xbf\xd4\x00\x19\xc1\x89\x33\x4f\x72\x08\x0d\x9d\x3a\xec\x11\xb2\xbc\x64\x8e\xb3\x98\xad\xbc\xaf\xbe\x4c\x73\x68\x45\x0a\x3a\xb6\x5a\xdf\x5c\x24\x1b\x40\x52\x2c\x3c\x93\x70\xf6\xac\xe5\xff\x7b\xd7\x48\x85\xf6\xa5\xb3\x2a\xa2\x5f\xe7\xca\x3e\xec\xdc\x7c\x43\xaa\x9b\x31\x5f\x58\xbe\x41\xa9\xc7\x92\xc6\xf6\xeb\x7a\xe1\x6f\xc8\x8f\xa9\x9c\x31\x25\x44\x1e\x95\x88\x62\xef\xe5\xc5\x21\x88\x5c\x3d\xc5\xc3\x08\x79\x00\x9a\xae\xec\x5a\xdd\xd7\xc8\x09\x57\x49\xb9\x6d\xa5\x65\xcb\x21\xa7\x26\x2d\x81\x29\x37\x0d\x1d\x27\x12\x72\x26\xf4\x6e\xe2\x71\xcf\x54\x84\x26\x59\x0e\xf5\x10\x62\xae\x9a\x69\x30\x18\x27\x02\x56\x16\x04\x25\x18\xf4\x9b\xc0\x61\x5d\x87\x70\x8b\x24\x66\x32\x94\xa0\x69\xd2\x76\x0e\x4e\xe8\x3c\x3d\xf7\x02\x63\xa6\x2e\x32\x97\x00\x6b\xf7\x32\x5b\xf6\xae\xc8\x78\x96\xab\x0a\x94\xb8\xa6\xd9\x12\x61\xab\x1e\x33\x7d\x16\x34\x67\x0d\x46\x2a\xa2\xf1\x18\x8f\xf1\x46\x5e\x82\x24\xc8\x55\x21\x5c\x13\xdc\x75\x97\xc1\x2d\x42\x60\x84\xc5\x42\x44\x4c\x44\x5d\x24\x31\xe2\x60\x24\xa6\xe0\x24\xd3\x4c\x88\x91\xac\x58\xc8\x89\x95\xbc\x38\x28\x88\x8d\xa2\xd8\x29\xe9\x14\x4d\xa1\x68\x09\x4d\x3b\x70\xe6\x65\x98\x7c\x2e\xde\xa4\xf1\x78\xd2\x69\xa6\x01\x9d\xa3\x9d\x81\x3b\xa1\xb3\xec\xc4\xcd\x3e\x9c\xcb\x37\x1f\x6d\xa6\x10\x0f\x32\x02\xef\xf3\xc5\xc8\xa3\x04\xe2\xd5\xec\xe1\x48\x44\x04\x2a\xd6\x33\xe8\xd6\x0f\x86\xb6\xbb\x1e\x6e\xd8\x18\x16\xe3\xe4\xb2\x9b\x30\x0b\x65\xce\xe5\x34\x6f\x09\xc6\xea\x62\x2e\x6b\x1e\x3b\x5d\x3c\xb8\x4d\xd2\x10\x86\x88\x1c\x38\x61\x68\x71\xc0\x06\xb6\x12\x46\x98\x9b\x68\x2d\x5b\x38\x53\xa4\xb4\xf4\xc9\x2a\x98\xdb\x81\xab\x54\xc6\x64\x70\x63\x31\x46\x63\xcf\x64\xf5\xa7\xe5\x0c\x0e\xca\x1d\x0a\xa8\xf2\x18\xc6\x18\x22\x86\x19\x84\x62\x91\xf0\x56\x08\xc8\x52\x19\x64\x9c\x06\x05\xe6\x51\x4b\x84\xc9\x22\xc1\x64\x2f\xa0\xe4\xc9\x2f\x9e\x21\x8c\xc3\x10\x91\x43\x13\x16\xa3\x51\x3c\xc0\x56\xc2\xd6\xb2\x19\xcc\xe0\xc6\xca\x69\xbf\x24\xd3\x82\x19\x66\x31\x83\x2d\xcf\x20\xa9\x7d\x24\x34\x77\x8b\x0e\x68\xdc\xb3\x1e\x89\x05\x74\xea\x69\x26\x7c\xd4\x3e\xfa\x69\x1f\xf9\x2c\x86\x5d\x7b\x95\x87\x13\xe0\x68\x56\x70\x03\x9a\x91\x0d\x50\x64\xc4\xb7\xbb\x1d\x28\x48\x90\x5b\xea\x78\x84\x1a\x30\xfa\xd6\x3c\x34\xb5\xc1\xc8\xf3\x79\x74\xc1\xc7\xdd\xca\xc5\x00\x4c\x2f\x9b\x24\x00\xb5\x39\x2d\xf4\x6f\xe0\xde\x2e\xa7\xb5\xfa\x2e\x3f\xc7\x12\x23\xfc\x77\x01\x60\x0b\x80\x23\x4e\x30\xd4\x06\x2a\x40\xfe\x24\xf8\x0b\x5c\x3e\x2f\x6b\x80\x0f\xf4\x6a\x80\x71\x60\x50\xf1\xe1\x6b\xdb\xdd\xeb\x85\x4d\xc0\x41\xea\x72\x16\xa0\x2e\x84\xa8\xa3\x80\x7d\x53\xdf\xcc\x0d\x58\x55\x9c\x9a\x31\xcd\xb7\x1c\x31\xed\xa1\x17\xde\xf9\x07\xbd\x10\x7c\x9b\xd6\xa7\xba\xbd\xca\xb5\x0e\xeb\x69\xdd\xd6\x8f\xeb\x6f\xbe\x10\xbe\xd6\x0d\xfd\xff\x3f\xf8\xe4\xf3\xc9\xaf\x75\xde\x03\xa3\x66\xac\x78\xe9\xbd\xc8\x31\x29\xc4\xac\xdd\x3a\x65\x7e\xf8\x11\x95\xaf\xf5\x1c\x63\xc6\xf3\xb3\xc6\x80\x1c\x01\x39\x04\x62\x1d\xb9\xf1\xec\xb8\xe7\x4f\xf7\x7e\x1c\x91\x4b\xac\x18\xbb\x75\xef\xe3\xee\xd3\xfe\xd8\x5b\xfd\x01\xdb\x03\xf6\x04\x9c\x4b\xec\x1b\xab\x1d\x4d\xd0\xac\x7f\x9c\xf6\xf7\x13\x25\x19\x36\x62\xd4\x98\x71\xc9\x52\x4c\x98\x34\x65\xda\x8c\x59\xa9\xd2\xc6\x6f\xb7\x13\xa5\xdb\xf2\xc4\x53\xcf\x6c\xcb\xb0\x33\x32\xef\xa9\xc7\x80\x03\xdb\xaa\x86\xca\xa3\x75\x5a\xdf\xdd\x01\x0b\xc0\x9d\x80\x01\x78\x8d\xb8\xfb\x4d\x80\xd7\x02\xde\x01\xb8\x04\xf0\x4e\xf1\x6c\x6f\x06\xbc\x0b\xf0\x31\xc0\x0d\x80\x8f\x03\x3e\x93\xc5\x14\xf0\x59\x24\xee\x02\x3e\x07\xf8\x1a\xe0\xdd\x80\xaf\xe3\xe7\xbf\x05\xf0\x0d\xc0\x77\x01\xef\x07\x7c\x0f\xf0\x7d\xc0\xbd\x80\x1f\x00\x7e\x02\xf8\x28\xe0\xa7\x80\x9f\x01\xee\x07\xfc\x1c\xf0\x1b\xc0\xa7\x00\xbf\x15\x77\x3f\x08\xf8\x1d\xe0\x8f\x80\x2f\x00\xfe\x24\x3e\xfc\x21\xc0\x9f\x01\xff\x00\x7c\x13\xf0\x4f\xc0\xbf\x00\x0f\x03\xfe\x0d\xf8\x7f\xac\xee\x25\x4a\x1f\x05\xa4\xe4\x27\x40\xed\xf1\x1c\x1e\x03\xea\x64\xa0\x4e\x01\x3c\x0e\xb4\xcf\x8a\x1a\x9e\x04\xda\x0f\x6b\x78\x0a\x68\x3f\xae\xe4\x69\x60\xb1\xb6\xbb\x0e\x00\xcf\x00\x8b\x0d\xdc\x8d\x00\x78\x0e\x58\x6c\xd2\x78\x33\x00\x5e\x04\x2c\xb6\xac\xe4\xa5\xc0\xe2\x80\xc6\x07\x02\xf0\x32\x60\x71\x68\x0d\xaf\x00\x16\xb7\xd6\xf0\x4a\x60\xf1\x8e\x1a\x5e\x05\x6c\xb9\x56\xe8\x82\x57\xbb\x61\xff\xe2\xf1\x32\x44\x58\xe7\xca\x80\xb5\x34\x8e\xcc\x49\x36\x05\x06\x4d\x02\xd6\x59\x3a\xfa\x86\xad\x1c\x5f\xe7\x87\x75\x1c\x80\x6b\x64\x43\x4d\x54\x5c\xb3\xb3\x9c\xae\x46\x9c\xb2\xf2\xf7\x33\xc8\x9f\x96\xf4\x0c\xfa\x45\xae\x45\x72\x46\x8d\xd4\x5d\xbf\xb0\xee\xe6\x70\xc8\x20\x3b\x88\xdc\xdb\x42\x2e\x67\xd0\x1d\x90\x6d\x26\x83\xed\xa0\x0a\xb6\xb1\x9a\x71\x22\xa4\xf9\xb2\xa7\x6e\xba\x5a\xd1\xeb\x7c\x83\x6f\x54\x68\xe5\x8b\xdc\x75\x54\x10\x98\xf3\xbb\xb9\xf4\x82\x0d\x0e\x8a\x3f\x6f\xbc\x8b\x94\x19\xbc\x8b\xac\x4f\xe8\xc8\x14\x7d\x5a\x67\x3f\x68\xeb\x14\xff\x4c\xec\xe8\x7f\x50\xdb\x34\xe1\x29\xe3\x86\xf5\x71\xd0\x8a\x6f\x0c\x39\xfc\x9e\x70\x1b\xb5\x73\x9f\x48\x86\x4c\x0f\xa9\xda\xf7\xed\x25\x0a\xda\x44\x6e\x1b\xe5\xc1\xfb\x15\xe6\x8c\x83\x3e\x10\x7d\xa4\xad\x49\x0c\x6e\xa8\xfa\x9a\x3c\x3d\x2e\xf2\xbb\x6b\x23\x6c\x17\x1c\x44\xd9\xef\x72\x8d\xdc\xb8\x68\x1d\xbd\xd1\x6d\x5f\x8e\x8c\x91\x44\xc9\xbd\x88\xd8\x0c\x7d\x47\x7f\xce\x6e\x1b\x9a\xed\x84\x9a\x5b\xaf\xaf\x34\x57\x60\xe1\xf5\x07\x73\xb7\xde\x1c\x10\x71\x91\xf1\xd7\x98\xa6\x02\x08\x2e\xef\x47\x02\xe2\xa9\x73\x2d\xb8\xd2\x8f\xae\xc6\x4b\xdd\x3b\xde\xc7\x47\xfc\x0f\x05\x4c\x9c\xe1\xbc\xbf\xf5\xfa\xfb\xfe\x6d\xf8\x80\xf5\x99\x1d\x58\xa0\xb1\x31\x0d\xb0\xfd\xe8\x68\x42\x00\x02\x83\x04\xcb\xbf\xf1\xca\xff\xff\x53\x80\xfa\x30\xe0\x97\xc0\x2a\x6f\x03\xd6\xfe\x02\x00\xfd\x69\xa0\xed\x0c\xd8\xf5\x5f\x80\xe1\x19\x15\x9e\x54\x21\x0c\xf7\xb9\x52\x84\x61\x0a\xd7\x36\x3c\xbc\xf1\xe6\x9a\x03\xf9\x85\xe1\x1c\xe9\x31\x0d\x3c\x68\x07\x27\x22\x2c\x88\x16\x9a\xa9\x7c\x77\xd8\x6f\x6f\x50\x12\x3c\x2c\xad\xbd\x21\x42\x78\xb8\x42\x2a\x97\x43\x84\x37\x02\x7c\xe1\xc9\xc4\x95\xa1\x00\xcd\x3c\x61\x5c\x16\x55\x7a\x1c\x70\xfd\x19\xa9\xa6\x3a\x7b\x01\xde\x23\x16\xfd\xd6\x78\xc1\xbb\x80\x8b\x76\xbc\x2c\xa3\xd3\x68\x30\x04\xd9\x1d\x16\x4f\xb5\x3f\x4b\x8c\xe9\x8e\xfe\x10\x65\xaf\xdf\x7a\xc5\xa2\xd2\xb3\x33\xf9\x30\xab\xc3\xe4\x44\x34\x65\xb0\xba\xf1\x51\x1f\x2a\xcb\xec\xb4\x51\xa8\xd6\x8c\x7d\x31\xd1\x9e\x92\x6d\x54\x33\xd8\x12\xb5\x37\x1e\x4f\x65\x72\xc9\x7a\x80\x70\x34\x85\xf4\x2e\x99\x1e\xfb\x0e\xf5\xb6\x4d\xb1\x45\x7c\x93\xb4\xbe\xde\x49\x9c\x8c\x88\xa9\xd1\xe8\xac\xc3\xf6\xc0\x6c\x4d\x94\x3e\xf4\x26\x31\xd7\x69\x1d\xf4\xa3\x47\x56\x9d\x02\x1a\xd3\x28\x9d\x2c\x76\xee\xec\x42\x32\xd6\x0e\x48\x2f\x48\x89\x57\xd8\x60\x2b\xdb\xb4\x07\x27\x85\x22\xe8\x01\x12\xa5\xb2\xbd\x3b\x17\x2f\x44\xbb\x18\x8f\xb6\x28\xe7\xd4\x7d\xaa\x57\xf1\x2c\xdf\x32\xa6\x19\xaf\x9c\x4b\x6f\x04\xdb\x98\x30\x9c\x05\xf5\x34\x1e\x27\x30\x90\x44\x8b\x96\x87\x10\xc7\xa6\x6d\xd1\x89\x45\x86\x86\x80\x1c\x53\x89\x50\xd0\x9f\x97\xed\x10\x05\x2a\x20\x3c\xa7\xcf\x05\x71\x25\xe2\x84\x2b\x82\x63\x35\x54\x32\xea\x97\x68\x88\x8e\x4b\x84\xcc\xc9\xe1\xf7\x3d\x0e\x0a\x88\x04\x2f\x1b\xd1\x33\xd8\x4c\x0b\xb0\x2e\x80\x28\xab\x8d\xee\x69\x00\xd0\x64\xea\x9a\xca\x7a\xa5\x32\x9b\x77\x77\x17\x92\x17\xca\x76\x5d\x2d\x06\xff\xf7\xb8\xe4\xc2\xa9\xd5\xf5\xd1\x58\xed\x25\x8d\x56\xad\x39\x49\x0a\x7b\xa4\x4c\x77\x3a\x43\xe7\x4f\x8c\x6d\x8e\x8f\x65\xd5\xae\x4c\x79\xbc\xa6\x97\x27\x6a\x3a\x36\x12\x42\xe0\x51\x6f\xa8\x81\x1e\x4f\xcc\x90\xe4\x02\x93\x5d\xce\x12\x86\x83\xcd\xed\x68\x12\x96\x3d\x4b\xa5\x9f\xe2\x7e\xe0\xca\x4d\x4c\x7b\xf9\xca\xeb\x75\xaf\x36\xa2\xf9\x65\x7c\x85\xcf\x3f\x07\xe3\x9e\x04\x80\x5c\xf3\x1d\x2f\xd7\x23\xe1\x13\xde\xfd\xc2\x3d\x1a\x86\x50\x85\x07\x35\x91\xc0\xed\x84\x21\x61\x20\x2c\x8c\x46\x28\x9c\x86\xfe\x1d\x4b\x4b\x11\x8c\xc6\x5d\x47\x12\x14\xed\xae\x67\xc3\xcd\x75\x7d\x50\x09\x4f\x0a\x50\x8d\xea\x02\xf9\x43\xdc\xff\xaf\x2d\xe0\x93\x03\xd0\x3a\x48\x4a\x72\x0e\x9e\x2b\x2d\x41\xf2\x77\x25\x29\x97\xb2\x80\xb9\x8d\xcf\x59\x27\x55\x72\x3d\x57\xdf\x00\x37\x10\xaa\x9e\x07\x7b\xed\x32\x21\x77\xb2\x3a\xbe\x1c\xe4\x71\xd3\xf8\x25\xc8\x51\x00\x2d\xd7\x47\xdd\xd7\xeb\x98\x41\x8a\x5b\x5d\x67\xed\xb8\xa7\x77\xd2\x00\xaa\x86\xb1\x78\xa1\xba\x8f\x0c\xef\xfc\x6c\xbb\xd3\xb3\x3e\xbd\x50\x53\x4e\xe7\xf4\xbf\xa0\x2c\x8f\x54\x7d\xfc\xe7\x9e\xef\x19\xfd\x33\x77\x95\x93\xd9\xe9\x29\xed\x02\xd5\x71\x5a\x53\x57\xbe\x78\xf6\x23\xf8\x48\x19\x8d\x49\x38\xa3\xb7\xc3\x45\x96\x90\x88\x0a\xed\xf6\xc3\x17\xdf\x7e\xbc\x5a\x45\x76\x4c\x81\xd4\x7f\xd0\xc1\xaf\x87\x0e\x46\xc4\xaf\xa6\x96\xa9\xb4\x00\x85\x43\x04\x6b\x3e\x64\x75\xac\xa1\xb0\x7f\xa8\x7c\x8e\xab\x80\xe8\xbc\x91\x51\x3f\x82\x73\xe4\x5c\x18\x79\xd1\xbf\x02\x6d\x01\x14\xd0\x17\x64\x68\x0b\x6a\x84\x6a\x3a\x89\x4b\x3b\x46\xd1\xe2\x85\x5e\x80\x91\x90\x7f\x1b\xc1\xfd\xc8\xe7\xf0\x6f\x99\xf0\x1c\x9d\x59\xae\xfa\x6d\xa2\xbc\x0b\x4d\x12\x7e\x33\xa3\x35\xd4\x3e\x96\xac\xff\x84\xbf\x94\x52\x7e\xa6\x91\x22\x94\xcf\x3d\x29\xd9\x3c\x4b\x5f\xd8\x6e\xbc\x6e\xe0\x0a\x98\x7d\x8f\xb3\x5d\xc9\xc1\xc6\x37\x96\x44\x55\xa5\xb0\xa4\x31\x5a\xcc\xb9\x2a\xac\x5a\x51\xab\x75\x93\x05\x87\xc1\x26\x81\x2d\x54\x50\xed\x00\xe1\x5c\xdf\xb9\x84\xcb\xe0\xd6\xf0\x0a\x0c\x31\x70\x7f\xb9\x4d\xc7\x12\x64\x24\x01\x9e\x2f\x83\xba\x55\x8f\x97\x3f\x33\xe4\xc1\x71\x8c\xc3\x57\x84\x07\xa0\xf0\x96\xb6\x7d\x87\x7f\xc0\x9e\x64\x14\x78\x14\x96\x98\xb7\x77\x68\x77\x9b\xe2\x5d\x4e\x62\x4e\xfb\xe4\xe7\xbc\x76\x2e\x9c\x87\x32\xd3\x12\x84\x32\x86\x40\x42\x22\x2c\x2f\x61\x16\x20\x0b\x39\xa6\x98\x77\x28\xbb\x14\x2a\xb4\xc9\xe7\x7e\x68\xc4\x55\xf0\x5c\xc3\xac\x4e\x44\xa6\x76\x69\xaf\x50\x20\x8e\x93\x74\xf0\xe2\x4a\xf1\x60\x2b\x02\x2f\x75\x1b\xe7\x69\xa5\x0b\xde\x93\xf5\xca\xeb\x04\xd7\xc5\xa5\x52\x08\xf4\x66\x04\x40\xb4\xc8\x4c\x34\x72\xa5\x7c\xce\xa8\x95\xb2\xb7\x40\xae\xe9\x3d\xd9\x2f\xe9\x12\x55\x00\x77\xd4\x7c\x7e\x5b\xab\xeb\xbc\x44\x15\x61\xcb\x3c\x24\x64\x05\xaa\x4e\xd4\x59\x48\xcb\x0c\x34\x78\x76\x2c\xe7\x3d\x63\xcd\x19\x46\x1a\xb2\xc5\x0e\xe9\xf8\x30\xa1\x03\xc1\xe0\x75\xd4\x80\x84\x43\x9a\xe7\xa9\x7a\x34\xce\xed\xc5\xe5\x14\x3f\xab\x67\xcd\x9f\x28\xf3\x86\x6d\x89\xcf\xea\x81\xdd\xe2\xf5\x1d\x92\xaf\x8b\xe9\x8e\x2b\xd5\x41\xca\x3a\xfc\x36\x19\x95\xe8\xec\xf9\x0b\x62\x07\x7c\x7e\x69\x1c\x4b\x5f\x80\x82\x10\xd4\xd8\x92\x42\x27\x1d\xec\x33\x3d\x56\xfd\x73\xa6\x4f\x87\xb3\x3e\x23\x9f\x3a\x19\xdf\x93\xb3\xdd\x90\x1a\xfb\x06\xe2\xf8\x2f\x53\x2d\x73\x06\xc4\x98\xe8\x66\xab\x41\x3c\x06\xea\x43\xe0\x23\xe0\x6b\x83\x4f\xc8\x3d\xf9\x67\xbb\xf1\xb9\x5f\xb8\x88\x19\xf2\x1c\xad\x49\x14\x93\x0a\x6f\x41\xe6\xc6\x33\x59\xda\x34\x1d\x5b\x5b\x47\x5f\xe4\x88\xee\x34\xf8\x44\x25\xd6\x8a\xd4\xd1\x11\x9c\x03\x47\x2f\xf9\x5a\xdb\x22\xba\x08\xab\x0e\x76\xdc\x7a\x19\x0f\x0a\x68\x00\x8e\xd5\xc6\xd0\x3e\xc2\x0c\x9d\x69\x11\xf7\xb5\x6d\x1a\x0d\x45\x0f\x4a\x6a\x70\x25\x0f\xba\x4c\x30\xd5\xff\x03\x9b\x27\x6e\xac\x3a\x5c\xfa\x6e\x0b\xed\x31\x6b\x0c\x39\x22\xae\x6d\xb7\x47\x74\xc1\xf5\xf9\x65\xe9\x0f\x8c\x75\x01\x91\x3a\x0f\xf9\x0b\xb1\x68\x08\x79\x4a\x8b\x85\x49\x08\xf2\x32\xaf\x95\xb1\x96\x9c\x31\x96\x7f\x6f\x63\xb5\x6a\xa0\x00\xad\xf2\x82\x9a\xca\x71\xe0\x8d\x5d\xeb\xce\xb6\x10\x02\xf0\x4b\x16\xfe\x9e\xd9\xe5\x89\xde\x5d\x7c\x48\x3e\x4b\x01\x4a\xba\x8f\x4d\x8b\xd6\x7f\xb8\x6d\x8b\xd6\xd0\xe9\x50\x55\x60\xb0\xbb\x79\xb9\x35\xf5\x0e\xbb\xa7\x95\x31\x11\xb9\xb1\xb5\x37\x9d\x0a\x11\xc8\x44\xc3\x99\xbc\xe4\xcb\x85\xfb\xf3\xb0\x39\x45\xe7\xff\x22\x53\x8d\xcc\x8c\x75\x58\x18\xe6\xaa\x9e\x7c\xda\x77\x2e\x8b\xcc\xe8\xea\x94\x0d\x84\xcb\x7b\x85\x5a\xad\xe4\x76\xa4\x76\xed\x95\x67\x4b\x39\x77\xa9\x9e\x65\xa1\xc6\xb8\xda\x2c\xad\x78\x34\xb4\xeb\x36\x30\xe8\xe5\x86\x40\x9f\xae\x87\xd6\x18\xee\xbe\x5d\x82\x64\x84\x91\xb8\xd0\xad\x54\xb2\x16\xe2\x44\x41\x5e\x1c\x9b\x90\x85\x64\x84\x5b\x67\x9c\xb7\xdd\x60\x87\xde\xa8\xaa\x42\x21\x54\xd4\xdb\x17\x2b\x60\xb1\x47\xe0\xe2\x62\xeb\x24\xfd\x86\x24\xd2\x36\x8c\xdb\xe1\x91\x44\x3e\xc6\x98\xb6\x73\x61\xb6\x14\x1c\xf0\xf0\x9a\xa6\xb6\x69\xf4\x0b\xee\xc6\xc6\xe4\x2b\x5c\xe3\xd0\x47\x1a\xe0\xcc\xa8\xa4\xfe\xe1\xa9\x2b\x0e\x3d\xae\xe9\x86\x9d\xa7\x3d\x83\x65\x23\xd4\x8a\x9c\x33\x75\x75\x9f\xf8\x5c\x18\x4d\x22\x18\x9d\x89\xf2\x70\x71\xb6\xb5\x69\x37\xb6\xf4\xfa\x86\xda\x5c\x77\x14\x17\xd1\x8a\x6c\xdb\x7b\x7d\x09\x65\x59\x35\x93\xb4\x50\xe2\xe7\x1a\x54\xe6\x74\xda\xb8\x8c\x65\xf1\xc2\x4a\x52\x10\x65\x96\x65\xd2\x0f\xbf\xcc\x86\x34\x3f\x72\x2c\x10\xc6\xf7\x63\xef\xb5\x3f\x10\x31\xd2\xc9\x7a\xff\x6e\x76\xa0\x6b\xd6\x86\x78\xc3\xb4\x81\xbc\x86\x03\xa6\x9a\xfb\xca\x2a\x79\x81\xd1\xf9\x24\xb6\xb2\xd6\xb1\x1c\x7b\x46\xc7\x1d\x55\xe4\xab\x56\x03\xe8\x9a\xdb\x68\xe5\xe9\x75\x5f\x0a\x28\x6c\x13\x7a\x06\xf1\x75\x92\x8a\x83\xad\x77\xa9\x16\x1c\x1d\xef\xa1\x61\x06\xed\x9f\x15\x2a\x8e\x09\x6a\x5e\x72\x7a\xfb\x87\xba\x1e\x43\xb6\xd2\x3e\x34\xb2\x79\x72\x4e\x0f\xaa\x4f\x4c\xcf\x85\x0d\xe3\xdb\x11\x78\xfc\x0d\x69\x65\xe6\x63\x39\xb6\x13\x76\x50\x93\x88\xd3\x4d\xf6\x65\x0c\xa7\x7b\x78\x12\x10\x36\x65\x38\x63\xed\xa9\x73\x52\xd1\xb2\xeb\x84\x37\xc0\x23\x21\xe7\xf6\x6c\x54\x3e\x49\x56\x70\x6a\x7a\xca\x6b\x5b\x52\xe6\x44\x42\xce\xe2\x1a\x63\x4f\xcf\xdb\x24\x1d\x26\x8e\xd6\x8d\x37\x68\x13\xb7\x58\x36\x60\x9d\x04\xa2\xc1\x88\x96\xa0\x97\xc4\x6f\x4a\xc8\xf8\x3b\xf7\xf4\x2f\x4f\xa9\x82\x57\x86\x04\x52\xb6\x8b\xe7\x62\x4f\xb2\x80\x8b\x28\x03\x76\xd9\x82\xa7\xcb\x70\xf4\xeb\x66\x19\x85\x82\xbc\x1f\x91\x22\xad\x85\xad\x01\xdf\x60\x20\x20\x0c\x4c\xcc\xeb\xdc\xbb\x85\xad\xbe\xc6\x0f\xc0\xe4\xa5\xc0\xdb\xd8\xec\x03\x91\x17\xde\xf2\x35\xf8\x9f\x6f\x7b\x1b\x0b\xe0\x0f\xcf\xdd\x7b\xe2\xed\xff\x39\xf9\xdb\xc7\x40\x2d\x1c\xb2\x23\x62\xeb\x13\xc3\x83\xac\xcb\x9a\xc0\xfa\xe9\x71\x36\x32\x5e\xc8\xd7\x37\xc6\x3a\xca\xd7\x5b\x84\xd3\x2c\xe2\x82\x5b\x00\x78\x93\xc5\x9e\xa6\x1a\x9f\xfb\x5d\x8a\x34\x2c\x25\xb9\xd2\xbb\x74\x66\x0d\x27\x58\xe6\x05\xe3\x6a\x52\x58\x90\x96\xdc\x6d\x16\x33\x5f\x6a\x38\x1d\xa4\xff\xfa\x53\x95\x5b\x29\x80\x7c\x46\x0d\x92\x24\x95\x10\x6e\x1b\x1f\x5b\x22\xbe\xae\x32\xbe\x52\x5b\x3f\xfc\xa8\x11\xf3\x8c\xbc\xdd\xc4\x4d\x7f\xbd\xd4\x9f\x9d\xd2\x40\x08\x8f\x8d\xf2\x77\x4f\x8e\x10\x75\x6e\x09\x33\xaa\xf4\xa6\xcc\x2f\xf5\xa2\x47\x0a\x47\x68\xa8\xd5\x17\xd3\xb4\xcc\x3c\x72\x6a\x12\x39\xd6\x9d\xb1\xb0\x24\xa9\x91\x92\x94\x52\xdf\x42\xd4\x4e\x6e\x6c\x4c\x48\xa4\x34\x66\xa6\x33\x1a\x58\xb8\xea\x6a\x9b\x3b\x99\xe1\x0c\xcc\xdc\x0a\x17\x5d\x93\xcb\x5d\x22\x49\x8d\xcd\x8c\x4d\x4d\x99\xda\x8a\x43\xb8\xa7\x32\x18\xc6\xa4\x63\xe6\x6c\xa6\x6b\x68\xee\x82\xd3\xb6\x74\x36\xfd\xfe\xd1\x53\xf5\x56\x08\xa1\x8f\x67\x11\xae\xa9\x5e\x21\xed\xf5\x21\x8e\xa1\xaa\x24\xed\xc6\x89\x81\xe6\xa2\x89\x07\x29\x96\x96\x85\xfe\xc6\xde\x06\xea\x89\xea\x09\xd5\x4d\xff\xf2\xa6\x87\xdf\x77\x1d\x09\x38\xd5\x69\x0d\x8f\x0d\xe8\x60\x53\xb7\x20\x32\xc0\xb9\x99\xa9\x31\x2a\x31\xac\x24\x1b\x76\xf9\x7f\xc7\x50\x52\xa8\x89\xba\x88\x98\xb9\x92\xba\x8b\xa5\x9e\x89\x83\x7f\xca\xa9\x8d\x3c\xae\x80\x04\xb0\x4b\x46\x25\x1b\x1c\x46\x54\xbf\xc3\xc7\x6b\x62\x23\xf3\x02\xe8\x0b\xc8\xf3\xc0\x34\x31\x8b\xd7\x94\x12\xe2\x80\x0d\x4c\xc6\x45\xb4\xbd\xc6\x33\x4b\x6b\xec\x85\x94\xbc\xb7\x4a\xd2\x11\x0c\x2c\x0d\xa5\xbf\x87\x84\xf9\x56\x2d\x3c\x2b\x2e\x38\x19\xbe\xcd\x4e\xd2\x79\x6b\x16\x56\xce\xcc\x75\xef\xb1\x90\x95\x50\x91\x50\x00\xd2\xa2\x94\xd9\xf6\x9a\x9f\x50\xb1\xb8\xf5\x14\x47\xb4\x1d\xed\x52\xd7\xd9\x18\x6b\xbe\xeb\xff\xb1\x17\xb5\x17\x2d\xd2\x17\x9b\xff\x17\x14\x3a\x4f\x0a\xbf\xa7\xb6\x5d\x22\x3c\xa3\xa9\x27\xcf\xcd\x79\xee\x0c\xdb\xc5\xa3\xe2\x23\x4e\x92\xa3\x28\x89\xd0\x2e\x2a\xdc\x46\xc8\xe6\xa5\xc6\x54\x0b\x32\x0a\x55\x61\x54\xea\xfc\x34\x77\xe4\x4f\x8e\xf7\x24\xe1\xe2\xdf\x54\x77\x6e\xbf\x79\xbd\x73\x0b\x26\xdb\xcf\xf6\x3a\x69\x93\x94\x93\xb0\x01\xed\x5c\x3b\x21\xee\x67\xa6\x27\xaf\xc1\xfe\x93\x7d\x42\x7f\x14\x42\x6c\xe6\x9c\xbe\x20\x2b\x41\x4f\xf9\x26\x12\x10\x67\x5a\x65\x46\x48\xcf\x0b\xce\x28\xa9\xc8\xa2\xcd\xc1\xbe\x3d\x59\x78\xbd\xde\xf1\x98\xf3\x5a\x4a\xe3\x33\xfd\xb3\x2e\xe2\x99\x89\x10\x39\x85\x00\xfe\xa5\x39\xc4\x20\x5a\xdb\xef\xe7\x13\xcb\xc7\x4f\xc6\x1d\xa2\x3a\xbc\x6e\x86\x63\x13\xdb\xef\x4e\xfe\xfb\x96\x81\x9d\x5e\x7a\x30\x39\x3d\x2e\x35\x3b\x33\xe9\x6e\x79\x5a\x3e\x94\x19\x21\xe3\x74\x3c\x5f\x3c\x6f\x42\x37\x29\xf6\xbb\x36\xf1\x61\xea\xea\xa7\x99\xa9\x79\x0b\xdc\xd2\x13\xdc\xc6\xab\xfb\xfa\x37\xb3\xbc\x95\x88\x33\xc7\x8c\xfb\x07\x1f\x61\x4f\xc7\x3e\x6d\xa0\x06\xdb\x51\x31\xa4\xb8\xb0\x84\xfc\xf6\xf2\xfc\x2a\x6a\x72\xa4\x86\x24\x93\x93\xe4\x97\x8e\x4a\x81\xc7\xf0\x7f\xdf\xf2\x3e\x21\x69\x66\xf8\x90\x75\xfd\xe0\x33\xfb\xf2\x23\xb8\xca\x1f\xed\x7c\xf3\x66\x13\xc4\x23\x92\x43\x75\xd0\x45\x33\x8d\xee\xda\x67\x91\xb1\x39\x7d\xe4\xa2\xdc\x7e\x03\x35\x33\x1d\x6c\x8f\xce\x38\x96\x7c\x96\xab\x64\x4b\x5c\x69\x52\xd2\x8f\x40\x71\x12\x09\x12\x4d\x1e\x08\x1f\xfa\xbb\xb7\xb2\xfa\x7b\x7f\x30\x2c\x7e\x88\x59\x8e\x22\x01\x14\xdf\xfe\xd7\x8f\xc7\x46\x4f\x1e\x33\x07\x3b\x44\x95\x95\xfe\x2c\x2a\xfe\x9e\x9f\x9d\x94\x58\x90\xc3\xe9\xca\xe3\x97\x9a\x1a\x12\x90\x4c\xdc\x3d\x1b\x2a\xe9\x99\x4e\x12\x87\xf1\xb2\x1a\x35\x78\xc7\x38\x7a\xb4\xdb\x3e\x31\xdf\xff\x78\x21\x9a\xb8\xf5\x5d\xed\x8c\x34\xc5\x30\xd5\x04\x71\xa5\xe2\xea\x73\xf8\x97\x17\x9c\x3f\xec\x2a\x82\x2e\xe3\x75\x7b\x2b\xff\x8b\x8c\x2a\xa0\x97\x92\x73\x46\x2d\x51\x28\xa6\xa4\xa0\xdf\x93\x12\xfc\xd1\xf9\x2d\xe6\xa8\x49\x6e\xc5\x7b\xc2\x6a\xb5\x6a\x2a\x6a\x35\x6a\x58\xb1\xce\x8c\x42\xd8\xb0\x94\x53\x54\x7e\xbc\xd1\xe8\x51\x6e\xd7\x52\x28\x4c\x2c\x5d\x0f\x9f\x98\x8a\xb7\xf7\xf3\xf2\x0b\xf3\x72\x8f\xf6\xb1\xa1\x41\xb5\x87\xd3\xbd\x43\xab\xf3\x1d\xed\xb5\x14\x5f\x1f\x1d\xa3\x92\x51\x85\x5b\xef\x04\x65\xec\x5b\xfb\x95\xf5\xc3\xe3\x33\x3d\x03\x92\x4d\x52\xa6\x19\x26\xba\x39\x79\x2f\xd1\x8b\x1c\xf5\x11\xb0\xe1\x02\x2f\xe3\xbb\x37\x0c\x95\x24\xae\xa3\x5f\x14\x04\x65\xee\x31\x74\xa1\x93\xb5\x8a\x0d\xe6\x6e\x4f\x77\x85\xb3\x7c\x61\xfd\x46\xf2\x15\x1c\x7d\x64\xdd\x7d\xb3\xf3\x0a\x61\x73\x51\xcc\xeb\xa7\xc7\x7e\x67\x23\x2b\x0f\x2b\x7a\xa7\x37\xfa\xed\xbb\x1f\x2e\x7b\x6d\x0d\x2a\x07\x81\xec\xd9\x46\xc5\x96\x0a\xfe\x6e\x97\xed\x91\x42\x5f\x63\x54\x42\x4b\xde\x78\x2a\x3c\x8a\x71\x26\x46\x4f\x0b\x4c\x51\x9b\x05\x36\xa8\x53\x9d\xe7\xde\x7d\x6b\xd6\xa2\x00\x06\xea\xf6\x9b\xb7\x13\xa3\xee\xc1\x15\x2e\x37\x42\xdf\x22\x1c\x39\xf1\x93\x7f\xf6\x8e\x64\x9a\xd9\x14\x53\xcb\x32\xa2\x98\x2e\x2a\x97\x0a\xc4\x15\xc5\xfa\x46\x64\xfa\x92\xce\x9b\xe9\x27\x2c\xf3\xbc\xb8\xf8\xf3\x2d\x4c\x3a\x48\x3a\x6b\x21\x57\x82\xb9\xbe\xfd\xe8\x0c\x8c\xfb\xf9\xd8\xd9\xb6\xc6\xf2\xa2\xfe\x95\x53\xbf\xb2\xdf\x4c\x9d\xe9\xac\x69\xc9\xe5\xed\xd2\xec\xf6\x0a\xe5\xc3\x97\x9b\xaa\xa4\xc0\xac\xdd\xf2\xf1\x50\x82\x0d\x13\x10\xbb\x14\x3a\x74\x7e\xf7\x2b\xe6\x9f\xd9\xf7\x23\xf4\x06\x24\xab\x04\xab\x0d\x47\xec\x54\x56\xae\xa7\x5b\x50\xb5\xb6\x1e\x6e\xa0\x30\x9e\xe4\x92\x34\xba\xfc\x61\x53\xab\xa6\x3f\xba\xa8\xe8\x8f\x8e\xe0\xbc\x90\x9e\xd4\x4e\x4c\x63\x91\x04\x34\x7f\x17\x27\xee\xc5\xfd\xfc\xf4\xd1\xc5\x8d\x51\x29\x3e\x81\xb1\x69\x0e\x00\x92\x2f\x7b\x7c\x23\xbe\xb5\x22\x3a\xda\xc6\x15\x28\x67\xa3\x78\x30\x56\xbb\x5b\xdb\x3a\x78\x94\xb0\x4b\x18\x33\x0b\x9f\x3f\xe6\x05\xd1\x1e\xea\x18\xd3\xa3\x9f\xaf\x1f\x3d\xf9\xb0\x33\x3b\x1a\x4b\xcc\x4c\x0c\xcf\x27\x83\x54\x61\x9e\xfa\x8e\xfe\x93\x8b\x0d\x57\x52\x1f\x1e\x29\x24\x0a\xde\x13\x7a\x11\xe2\x55\xd1\x59\x19\x6c\x36\x1b\xbb\xc6\x73\x9a\x7a\x61\xb2\xfe\x8c\xfb\x41\x91\x6f\x73\xba\x5c\x78\x5e\x5e\x70\x61\xbd\x04\xed\x78\xbb\x05\x67\x75\x59\xd5\x42\xeb\xce\xce\x63\xcc\x0f\x2a\xf4\xd9\xc3\x63\x44\xcf\x28\x9f\xb2\x49\x52\x62\x6a\xa2\xb6\x32\x37\xe7\xcb\x40\x4b\x5e\xab\x79\xaa\x2c\x2d\xad\xed\x2c\xcd\x24\x95\x8d\x61\x08\xfe\x90\x6b\xa0\x77\x85\x0b\xf6\xc5\xe7\xd7\xea\x45\x1a\x7d\x81\x03\xf6\x5b\xd6\x53\x56\x2e\xd0\x37\xe3\xdc\x3b\x8e\x4f\x73\xeb\xc8\xe7\xdf\xcc\x2b\xac\xca\xee\xe5\xa6\x27\xde\x2b\xcf\x81\xb9\xea\x1b\xa1\x29\x6b\x29\xa2\x11\x66\xf0\x85\xc4\x08\xf1\x4f\xda\x9c\x46\x9d\x46\xa7\xb2\x50\x48\x4a\x1b\x23\x00\x5a\xe4\x1e\xee\x11\xfe\x9f\x2b\x97\x42\xb6\xaa\xf5\x87\xf5\xcc\xf5\x93\x1a\xe6\x87\x5f\x91\x7b\x9b\xc4\xfc\xb9\x44\x31\x33\x2f\xb6\xe5\xbc\xe3\xab\xa4\xe6\x82\x98\xb4\x1a\x52\x31\x10\x74\x32\x23\x7e\xd5\xda\x5f\x2e\x69\x45\xef\x6f\x17\xbd\x0a\x8e\x5f\x88\x1c\x4b\x6e\x2a\x2a\xcd\xa9\x8a\x09\xb0\x2a\x8a\xf2\x76\x5b\x8c\x72\xbe\x17\xd0\x81\x59\x7f\x59\x04\x27\x64\xcd\xdc\x1d\x4b\x1e\x2f\xb9\x57\x52\x1b\xbd\x49\x0e\x71\xc2\x85\xa2\xd7\x01\x03\x7a\xa4\x1e\x62\x6b\x55\xee\xc2\xba\x0d\xd4\x5d\xb0\x04\x18\x9e\x9d\xe2\x7f\xc7\x0b\x41\x07\xfb\xc3\x4f\xab\xda\x1e\xd4\xde\x6b\x6d\xab\x7a\xa6\x90\xf1\x97\x07\xd1\xb1\xca\x3f\x45\x6d\xe1\xef\xa1\x3e\x92\x39\x9c\xe7\x1d\xb9\xd6\x8a\x86\x7f\xaf\xe3\x2c\xf8\x7e\xb5\xfd\xe2\x04\xc7\xe5\xe7\x63\xdc\xa7\xa9\xed\xe8\x49\x2c\xb7\x7f\x62\x4c\x08\xb6\x9d\xfb\xda\x36\xc1\xea\x9a\x7a\xa2\xad\xd0\xe1\xc2\xa3\xcd\x9d\xe7\x6e\xb7\x3d\x6e\xff\xf7\x78\x67\xf1\xd1\x81\x8f\xeb\x7a\xf4\xd3\xa7\x97\x5c\xf2\x2e\x8d\x5e\x6a\xcc\xd8\x16\x93\xa9\x50\xad\xbc\x92\x2b\xf6\x3c\x91\xb3\xea\x5b\xb6\x06\x5f\xcf\xd1\x08\x60\x24\x8e\x94\x44\x02\xa6\xfe\x48\x01\x58\x0a\x95\x7a\xe7\x4a\x50\x31\xa0\x28\x3e\xca\x90\xa3\x4a\xc6\x1c\xb1\x8c\x61\x79\xea\x07\x86\x0e\x5a\x6f\x6c\xde\xbd\xbe\x79\xff\xaa\x6c\x7c\x71\x61\x4e\x88\xbc\x17\xea\xb7\xaa\x21\xec\xde\xe5\x6b\xff\x98\x8d\xe3\x53\xba\x6a\x16\xbb\x33\x29\x80\x7e\xdc\x6d\xe6\x14\x4c\x08\x72\x49\x71\xd1\xa6\x1b\x13\x8a\x26\x2d\xc2\x06\xd6\xe8\xf0\x7b\x1f\xc9\x58\x0b\xd4\xe7\x2c\x8b\x72\x2b\x01\xed\xdd\x3c\xd3\x19\x8b\x60\xa7\x48\x35\x18\xe7\xf7\xff\x6e\x8d\x2e\x05\xe4\x22\x63\x5a\x00\x23\xa0\x29\x06\x64\x64\xec\x5f\x87\xf7\x23\x86\xf2\x96\xd2\xfb\x0c\x29\xeb\xa6\x94\xee\x94\x82\x25\x57\xc5\x44\x97\x82\x70\x26\xab\x76\x84\x6d\x68\xd3\xa4\x8b\xff\x0e\x42\x10\x5c\xaa\x0a\xfe\xb2\x95\x56\xd8\xfb\xfe\x09\x53\x5b\x3b\x93\x67\x4e\xaa\x57\x76\xd9\xfc\xe8\xe5\x81\xd2\x39\x9c\x3d\xf6\xc7\x6b\xe1\x91\x61\xff\x04\x49\x5a\x87\xa3\xa0\x05\x19\xec\x9f\x36\x3d\x78\x22\xf1\xbd\x9f\xb1\xbf\xf8\x61\x2a\xd5\xa8\xf2\x83\x49\x49\x2b\xb1\xf8\x91\xab\x1e\x66\x1b\xc3\xf7\x51\xa0\x36\xea\xf7\x76\x4e\xc2\xda\xf1\x69\xb8\xfe\x0e\xbc\xba\x73\x32\xc3\x39\xcd\x50\x5c\x35\xd0\x22\x6d\x05\x1d\x3c\x1f\x58\x5c\xc7\x99\x7f\x91\x83\x29\x2b\xad\xcc\xeb\xe5\xfd\xfb\xa4\xfb\x01\x0f\x68\x43\x2d\x2a\xfb\xcc\xe4\x23\x29\x6b\x27\x4f\x67\xc2\x4b\xd2\xb7\x91\xe6\xe2\x80\xfd\x73\x7c\x63\x6c\xda\x3f\x13\x19\x4e\xe1\x5b\xfd\xfe\x61\x35\x74\xcf\x45\xce\xdc\xc1\x3f\x2c\xbf\x35\xad\xa8\x38\xc3\xce\xff\xee\xe8\xf0\x2b\x31\xda\xc7\x3e\xf2\x4a\x5a\xbb\x71\xd5\x5b\xd3\xb2\x96\xb4\xe2\x47\x49\x5c\xf8\xbe\xa8\xa2\x29\x0b\x58\xe2\x02\x6d\x11\x84\x78\x06\x04\x58\xa7\x39\x60\xf5\xe1\x9b\x3c\x58\x34\x9c\x19\x4d\x8e\x36\x73\x51\xc8\xcf\x37\xd6\x3a\xda\xd7\x47\x89\xab\x5b\xae\x4f\x86\x57\x51\xac\xf0\x9b\xa4\x92\x8a\x1a\x7e\x00\x7d\xf1\x96\xbf\x94\xb4\x3d\xd8\x06\x47\xfb\x1c\x1c\x11\xe3\x11\xbd\xd6\x39\xcc\xc8\xe5\xa0\x7e\x62\x23\xf4\x49\xa8\x99\xf1\x4b\xb8\x80\xa9\x97\x43\x84\x87\x65\xce\xff\x67\xdc\x26\xa5\xdf\xea\x10\xe6\xca\x10\xb1\x41\x9b\x0f\xf1\x22\xc4\x7a\xa4\x45\xb1\x78\x2c\xd3\xa7\x4e\x5e\xf6\xaa\x95\x71\xd3\xe4\xc2\x31\xdd\x72\x0c\x69\xd1\xe5\xb3\xc9\xc4\x28\x87\xb7\x16\xac\x8e\xf9\xb3\x9b\x4e\xda\x41\x89\xfd\x65\xaf\x8a\xf8\xb9\x9f\x99\xbf\xfd\x0c\x9f\xdf\x34\x64\x77\xe3\x93\xac\xec\x86\xed\x99\xce\xe6\xed\xac\xf4\xa6\x27\xea\xaa\x87\x9c\xdf\x0e\x84\xc6\xa6\x02\x63\x98\x69\x80\xe1\x29\x05\x19\x1e\x98\x39\x37\x71\x88\x65\xec\x87\xf7\x95\xaf\x11\x9b\xf1\x85\x0c\x26\x05\xf7\x53\x2a\x56\xfd\xed\xb1\xff\x8e\xaf\x4d\x8d\xfa\xc7\x33\xf9\xb6\xb0\x26\x64\x1a\x22\x20\x9c\x3e\x79\x24\xfa\xbb\x9f\x89\x5e\xb1\x96\xd2\x60\x9e\x87\xb6\xc8\xa9\x4b\xad\x5c\xf7\xb9\x1e\x5c\x58\x24\xd6\xab\x52\x06\x68\x72\xdd\xb0\x18\xeb\xdc\x26\xe9\x21\x2d\x51\xfe\x4b\x77\x02\x4d\xce\xca\xd7\xe3\x0d\x82\x8d\x52\xf6\xfb\xda\xfb\x9f\x0f\x15\xd2\xf5\xe2\xfd\x9c\xdd\x82\x2c\xb4\xd5\xbd\xd4\xb9\x14\xef\x9b\x99\x44\x58\xe4\xbd\x7c\x40\x4b\x9c\xb1\x09\xac\x77\xa4\x21\x6c\x33\x7c\x09\x5e\x71\xa6\x4e\x67\x43\x55\x18\xe7\x49\xf3\xd5\xaa\x52\x2a\xaa\x7c\xd1\x45\x3a\x18\xcd\x98\x58\x72\x46\xf5\x14\x69\x8a\xd1\x41\x3c\xde\xc7\x27\xee\x51\x5b\xf7\x2c\x98\x1c\xa5\x0d\x7c\x9d\x5d\xd7\xac\xc4\xd0\xe4\xa8\xf8\xf7\x3f\x51\x13\xca\x43\x67\x35\xe4\xb2\xcf\x26\xcb\x0d\xc9\x68\xd4\xfc\x0b\xe1\xf2\xb4\x62\x35\x2b\x42\x05\x4b\xa2\xe0\x92\x45\x25\x8e\xad\x9f\x1d\x7f\xe3\xd4\xc4\x0d\xb2\xe3\xd7\x07\xef\x32\xb6\x9c\xb8\x7d\x05\x36\x7f\x14\x66\xf6\xe3\x93\x9e\x55\x9b\xf8\x37\x1c\xbf\xc7\xdc\xcd\x69\x5e\xc9\x03\x57\xf6\x6d\x01\x0d\xb6\x22\x8c\xbd\xe7\x1d\x7e\xd7\x6b\x7e\x67\xaf\x08\xcf\xe3\x64\x8b\x22\x8b\x6d\x88\x58\xfc\x39\xbd\x52\xc9\x24\x8d\x70\x3f\xaa\x58\xe7\xf3\xc1\x99\x4f\xeb\x79\xeb\x1f\x57\xa0\xde\x07\xfb\x9d\xe2\x58\xc2\x8a\x1a\xcf\xfc\x7f\x8d\x96\x8d\x56\xff\x2f\xc3\xd0\x4b\xaa\x62\xea\xec\x64\xc0\x38\x4c\xc6\xa0\x7f\xce\xab\x30\x32\x6f\xbf\xec\xc6\x77\x1b\x1f\x2f\xf0\xac\xa8\x61\x09\x54\xb1\x8e\x7d\xd0\xbf\xfa\xce\xf7\x7d\x7c\x9a\x1f\xec\x3e\x78\x20\x26\x66\xf0\x89\xb9\x8a\xbe\x95\xae\x73\x57\xb2\xb9\x00\x77\xf9\x86\x01\xde\xdb\xbe\x06\xaa\xe9\x5b\xca\xd4\xcb\x54\x6e\xaf\xf9\x31\xa6\x67\x1f\xec\xa0\x46\xb9\xdf\x0c\xd6\xb9\xc2\x71\x73\x8e\x9e\xd0\x7d\x25\xe2\xb8\x44\xd7\x82\x08\x26\xcb\x76\x86\xd8\xa9\x59\xd2\xb9\x63\xda\xab\x4e\xfe\x19\xde\xed\xcb\xeb\x73\xe7\xa9\x7c\x87\xb4\x3d\x32\xf7\xc8\x1a\x03\x59\xfd\xc6\x60\xd9\x9c\xc9\xa8\x1f\xd3\xb3\x32\x45\xeb\x1d\x50\x81\xac\xd4\x8f\x10\x4b\x84\x1c\x90\x4c\xdb\x0d\x95\x35\x48\xf8\xcc\xe7\x37\xb3\xc3\x3b\x6b\x1d\x23\x3e\x71\xd1\x01\x5e\x09\xa5\xb1\x48\xb8\x72\x65\x8c\xc6\xfb\xc0\xaa\xa4\x47\x0f\xab\x12\x52\xd2\x12\x52\x33\xb2\x3c\xca\xe7\x97\x03\xea\xf2\x8b\x12\xd2\xf2\xc9\x95\xe1\x13\x93\x55\x11\x85\xc5\x49\xc4\xa2\x52\xbf\xba\x85\x49\xb7\xaa\x1c\x52\x52\x9a\x1c\x2c\x3a\x9a\xb0\x36\x11\x79\x38\x11\x15\xb8\x1a\xfd\x7b\xfc\x9a\x8c\x08\x0c\x9a\x08\x27\xac\x29\x0b\xba\x70\xf8\xd4\x3e\xab\x7d\x28\x13\x1b\xdf\x10\x1f\x13\xaf\x36\xa6\x16\x15\x13\xd5\x10\x15\x2b\x5b\xfb\x70\xdb\x27\x9f\xfd\x0c\xfd\xcc\x2c\xfd\x2b\xfd\xac\x76\x5e\xfb\x10\xcd\x54\x43\x54\x3b\xcd\xb5\x19\x3e\xfd\x3c\x70\xf6\xb6\xa6\x4c\x46\xe0\x12\x0d\x4a\xec\x2a\xaa\x20\x36\x84\x38\x5a\x65\x7b\xae\x28\xf6\x88\x8c\xf8\xdb\x96\x05\xd0\x7b\x2c\x54\x45\xb5\xd2\x5c\x1b\x59\x66\x76\x8f\xe0\x07\x4b\xb4\xd8\xa6\xb2\xcc\x9c\x1a\x82\xb3\xe6\x6d\xc3\x54\x95\x8b\x86\xcd\xda\x81\x8e\xb2\xf1\x17\x5b\xac\xce\xe8\xf2\x18\xa2\x75\x5d\x35\xb5\xa4\x0c\x93\x55\x79\x8d\xa9\xfa\x18\xfc\x47\xb0\x65\x86\x6f\x3c\xa3\x9b\x4d\x18\x03\x88\x59\xd4\x76\x83\xac\x0f\x7c\x41\xf3\xa3\x59\x69\x6b\x4d\x55\xe5\xe0\x6a\xaf\x3f\x75\x78\xb5\xbc\xe6\xfe\x3d\x72\xbe\x8b\xbd\xa9\x8d\xbd\x4f\x7a\x74\x50\x44\xef\xcf\xde\xd6\x16\xef\xf6\x37\xa9\xb5\xfa\x0a\xba\x41\x85\xb3\x61\xc4\xd8\xda\x52\xc9\x8f\x6e\xac\xad\xc1\xd4\x57\x5d\x26\x50\xe6\xe9\x56\xba\xd3\xf1\xb8\xe9\xf8\xad\x30\x3f\xd5\x65\xa1\xbb\x32\x3d\xa5\xbb\xdc\x63\x41\xf6\x1a\xed\x30\xbb\x63\x16\x1f\x9e\x10\xed\x11\xd0\x18\xde\xea\x2b\x99\x16\xa3\x69\x20\x25\x51\xc3\xea\x71\x3f\x21\x3d\xa5\x34\x27\x55\xc1\xe9\x9c\x91\x4d\xd4\x4d\x8b\xab\xf4\x9c\x40\x13\x60\xa9\x24\x61\xef\x2b\x7f\x10\x4e\x47\xa6\xd4\x2a\xf3\x58\x6d\x21\xcc\x27\x4c\xf7\xa0\xa7\x9a\x5a\x4c\x73\x05\x7f\xeb\xfd\x0a\x3e\xcc\xc6\xe4\x91\xcf\x26\xd9\xc6\x5c\xa5\xcd\x89\xb1\xd1\x77\x55\x22\xc5\x40\xa9\xa2\x39\x03\x2a\x6f\x8d\xc6\x77\xca\x81\x00\x6f\xbf\x5b\xa1\x0d\x59\xa8\x4a\xe3\xf4\x15\x5c\x33\x28\x92\x58\x7e\x95\xfb\xfb\x72\x22\xaf\x8c\x7a\x16\x96\x7b\x7b\xe6\x97\x79\x79\xe7\x93\x3d\xbd\xc8\xe4\xbf\xa7\xa5\x71\x5a\xb2\x72\x38\x5d\xe9\xdb\xba\x06\xbe\xba\x38\x91\x6b\x05\xe6\xbe\xc5\xbe\x55\x4d\x55\x09\xc5\x09\x95\x4d\x5c\x5c\x9f\xd4\x4b\xd4\xb5\x5a\xca\x2c\x7c\x6f\x9e\xf3\x75\xd3\x14\xfb\xad\x70\x47\xeb\x8e\x6c\x25\xa1\xb1\x00\x59\x1a\xe7\xef\xe9\xed\x8d\x37\x68\xcf\x25\x99\xa2\x0a\xdd\x4c\x75\xb5\x15\x44\x85\x8e\xb5\x64\x75\xc5\xaf\x57\x3a\xd7\x45\xc5\xa4\x97\x47\x78\xde\xd6\xb4\x56\x3d\x5b\x78\x8d\xe4\x4d\x89\x21\x26\x37\xc4\xfa\x54\x09\x19\xc8\xab\x6b\xe9\xc8\x4b\x04\x95\x43\x96\xe9\x98\xa9\xbf\xfb\xac\x58\xf2\xe5\xd3\x92\x72\x91\xa0\xce\xbd\x3c\x64\x46\xb1\x98\x8f\x2e\xd7\x84\x24\xd8\xa6\xb6\x14\x18\xe9\x6c\x98\xb0\xdb\x31\x4a\x45\x9a\xbe\x40\xfe\xd9\xdc\x60\xfe\xfb\x82\x1d\x30\x50\x6f\xe6\x6f\xcd\x48\x86\x86\xc8\x03\x42\x0e\xf7\x85\xae\x12\x9e\x7c\xa7\xea\x40\x12\xa3\xb7\xa1\xb9\x84\xc8\xad\x1f\x4e\xc8\x4b\x29\xaa\x6f\x6b\x2b\xad\x1e\xcb\xc4\x3b\x87\xa0\x55\x98\xea\x5d\xaa\x06\xc6\xcb\xca\x07\x46\x1b\x75\xab\xe9\x63\x92\x43\x63\x0d\x75\x0c\xae\x8e\x41\xa6\x78\x7b\x82\x9b\x81\xcb\xb5\x0d\xde\xd8\xc1\x0f\x72\x54\x41\x15\xb3\xee\x34\x01\xaf\x6b\x71\x0e\xa1\x7d\xa3\x2c\xce\x5c\x7e\xc1\x04\x3f\xd7\x98\x40\x1b\x1a\x54\xb3\xbf\xd0\x37\xb9\x31\xdb\xd5\x56\xfd\xc0\x37\x50\x55\x3f\x3f\x2c\x06\x12\x24\x09\xdd\xda\x98\x69\x11\x1a\x08\x82\x39\x1c\x25\x17\x1d\x0f\x6f\x33\x50\xfb\x4a\xba\x30\x9b\x06\xc5\x3a\xa7\xd1\x63\x66\x9c\x11\xb3\x17\xb7\xc3\x2d\x75\x70\x86\xda\x06\x61\x51\x3a\x06\x3a\x25\x7d\xd3\x2f\x7a\xc9\xd5\x35\x4a\x51\x26\xf0\xea\xf6\x7e\xa2\xb1\x63\x59\x0e\xe9\xc5\xd0\x88\x27\x3e\x46\xba\xed\xcd\x82\x18\xf7\xfa\xec\xaa\xbf\x44\x3a\x84\x55\x54\x71\xf9\xec\x94\x75\x59\x9d\xe8\xe6\xdf\x18\x88\x45\x15\x33\x62\xef\xbd\x9d\x28\x80\x0f\xb2\x56\x8e\x8c\xc7\x90\x7b\xe8\x61\x84\x3d\x53\xf0\xcc\x33\x6c\x2c\x6e\x9c\xe9\xc5\x7f\x68\x59\x3a\x79\xb6\xa5\x0d\x0a\x9c\xce\xa9\x2e\x8a\x88\x6e\xff\x3e\x69\xc0\x42\x83\x66\xb1\xb2\xfc\x8a\xde\x3d\xd9\x59\x1d\x7b\x77\x5e\x53\x42\xd7\x7e\x80\x64\x96\xa6\x57\x3d\xdf\xd1\x6b\xdc\x7d\xd3\xe6\x46\xd9\xa9\xd4\x9b\x52\x3e\x81\x76\x26\x92\x38\x87\xc1\x7c\xf3\x74\x63\xab\x8c\x65\x53\x7b\x0f\x6f\xcb\xa7\x58\x2f\x83\x7d\x2e\x86\xb4\x2e\x15\xb3\x03\xbb\xec\xa6\x81\xd6\xe2\xca\xc3\xb6\x9d\x3d\x33\x1f\xbf\x28\x4f\xaf\x4c\x3f\xe3\x3e\x34\xe9\x43\xf7\x40\x70\xbb\x89\x98\x89\x92\x41\x9b\xb9\xd7\xea\x4b\x70\x1c\xbc\xc5\xd8\xde\x36\x4c\x2e\x8c\xb8\xf3\x56\x39\xad\x32\x42\x39\xb0\x48\xa7\x12\x77\xfa\xcd\xf3\x10\x28\xd3\x0f\xaf\xef\x65\x2b\x05\x97\x0c\x2e\x6c\x41\x65\xf0\x5c\x4a\x4e\xc9\x9f\x96\xd0\x3c\xfd\x9d\xa9\xd4\xae\x2a\xe4\xf7\x63\x52\x6a\xda\xb3\xf8\x88\xbd\xd4\x82\x80\xa3\xe5\x84\x84\x7b\x11\x41\x22\xe3\x59\x59\x4f\x0a\x74\x9c\x47\x12\x93\xac\x7b\x45\x65\xaa\xde\x4a\x3d\xc8\x18\x8c\xd7\x71\x61\x4c\x62\x3f\xc3\x96\xa1\x63\x9b\x57\x3b\xdc\x94\xd5\x38\x91\x18\xe8\xee\xa0\xdf\x54\x6d\xd5\xf2\x45\xff\x0b\x07\x59\xa9\x00\x73\x55\x1e\xb6\x45\xc2\xf2\x81\x0c\x7c\xe7\x77\x1d\xf5\x7b\x3f\x83\xe4\x6f\x77\x5d\xa7\x20\xc6\x6d\x71\xbc\x54\xa5\x2a\xfa\xcf\x1e\x31\xd7\xd3\x4c\x00\x57\x18\xe3\x32\xbf\x58\xd8\x2f\x3d\xee\xe8\x6f\xde\xb3\xa7\x93\xd1\xf4\xc4\xad\xbb\xd7\xdc\x1f\xdf\xee\xa9\x96\xd9\xf8\x94\xe6\xa2\x46\xf0\xa7\x67\xcb\x54\x43\x93\x0e\x4c\x1a\x2f\x1e\xd5\xd5\x8c\x2e\x47\xbf\x10\x98\x0b\x8c\x3a\xb8\x08\x75\x7f\xc8\x99\x1c\xa0\x45\xaa\x35\x03\xf5\xa7\xa8\x2e\x86\x82\xd1\x9a\x2f\xa0\xca\xd9\xc3\x28\xd0\x56\xe1\x0d\x72\x63\xcf\xf1\xe5\xf0\x6d\x1b\x7b\x0e\x2d\xcb\xae\x8d\xbf\xca\x73\x14\xac\x89\xf8\xf3\x94\x98\x93\x3e\x1b\xa2\xe0\xa2\xe2\x36\x41\x08\x7c\x78\xfc\xe7\x75\xc8\x32\x23\xe5\xde\x64\xea\x60\xb8\x0f\x2e\x96\x98\xf4\x25\x6d\x31\x40\xc9\x45\xd1\x79\x3a\x30\x75\x9b\x49\xe4\x6f\x3f\xb2\xaf\xfa\x71\x66\x7a\xf5\xe6\x72\x6f\xcd\x56\x46\x5a\xed\x86\xda\xca\xa6\x0b\xb9\xee\x74\x3a\x07\x8f\x07\x25\x17\x89\xba\xbe\x65\x29\x25\x53\x0a\x29\xa5\x6c\x5f\x4e\x05\x4a\x0a\x84\xc6\x5b\x44\x87\xd8\xd8\x46\x85\xb6\x46\x45\xf6\xd7\x61\xae\x6b\x26\xa2\xa6\x2e\x26\xaa\xae\xea\xac\xa6\x21\x26\xae\xaa\x79\x43\xe2\xff\x6d\xdf\xa8\x14\xdb\xeb\x5d\x8e\x8a\xad\xe7\xaf\x57\x89\x45\x33\xfa\x44\x64\xda\x5d\x0e\x76\x90\xe5\xbf\x74\x53\x46\x5d\x48\x09\xf5\x02\xe3\xa5\x6d\x1b\x07\xcb\x27\xf6\x87\xb8\x3b\xce\xf0\x33\x17\x72\x7b\x6a\xdb\x26\xc0\x48\xb9\x7d\x89\xc0\xc5\x71\x9a\x3f\x8b\xc7\x53\xc7\x3a\x0e\x96\x17\x46\x73\x5d\x3a\xb8\xfe\xc5\xac\x5b\x9e\xda\xd6\x44\x58\x09\x8d\x56\xef\xeb\xbc\xba\xad\xbc\xf7\x02\xe9\xf5\x3e\x8e\x2b\xa2\xc8\x7b\x08\x0b\xff\x00\x5b\x9b\x20\x5f\x3b\xac\x85\x9f\x9f\xbd\x9d\xbf\xbf\x25\xf7\x05\x2c\x94\x95\x2d\x98\x84\x11\x4c\xce\x46\x71\x04\xf7\x0c\xb0\x6d\x99\x03\x65\x43\x39\xa8\x3a\x3f\x8f\x05\x17\xba\x7f\x68\x66\x8c\x14\xfa\x42\x3c\x7c\xbc\xeb\x51\x43\x88\x1b\x3f\xa6\x3a\xfc\x42\xc4\xf7\xf3\x57\x18\x58\x3b\x63\x3a\xd3\x3b\x63\x3b\x99\x19\xce\x5f\xfc\xe4\x9d\x7a\x11\x53\xeb\x2b\xe0\x5f\x4f\x9d\x67\x18\xb8\x1b\x1b\x9f\x1e\x9f\xa6\xd4\x99\xa9\x5e\xac\xce\x3b\x1f\x3e\xc6\x6b\xdb\xb3\x3c\x37\x14\xd8\xa2\x70\xb1\x3c\x46\x2c\x28\xdc\xd3\xdb\xc7\x7e\x87\xb4\x73\x1f\xc7\xb6\x08\x49\x12\x0c\x2e\xd1\x18\xbd\xd9\x55\x9f\x90\x92\xa3\x5d\x79\xa3\x30\xd0\x3a\xd2\xd7\xe1\x76\x43\x01\x30\x6c\xd1\x6c\xdf\x99\x93\x13\x91\xba\x6f\x07\x4f\x32\x72\xbe\xf8\x12\x83\x0c\x6f\xf8\x24\xfa\x50\x2a\x29\xe1\x0c\x6e\x11\x0c\xb5\xd1\xc7\x7b\xfc\xac\xb8\x7a\xf1\xb9\x0f\xeb\x8a\xeb\xee\x4e\x4b\xe7\x6b\xbd\x94\xe7\xcf\x5c\x80\x13\x7c\x3f\x6f\x83\xf7\xff\xf2\xb8\x73\x51\x54\x79\x6d\x7b\xee\xaa\xd5\xb3\xed\xae\x34\x6e\x41\x10\xfa\x1f\x00\x9a\x83\xce\x37\x0b\x76\x55\x59\xf6\x88\x62\x04\xb5\x0a\x38\x4d\x2d\xfb\xcf\x5f\x55\x65\xa5\x11\x58\x50\xf9\xaa\x40\xc3\xe5\xe5\x0d\xb0\x70\x5d\x9e\xb5\x12\x8a\x56\x51\x67\x41\x56\xa3\xa1\x6d\xda\x6a\x2a\x9c\x40\xba\x2c\x9b\x00\x89\xf7\xfa\xc5\xe9\x55\xec\x6f\xe8\xe2\x44\x1b\xa8\x9e\x58\x2b\xa7\xa5\xfa\x07\x40\xef\x2b\x0f\x6f\x96\x76\x3f\x70\xbf\x3c\xe2\xb6\x0a\x56\x00\x60\xae\xa0\xd4\x69\xd3\x0a\x57\x21\x0a\x4b\x19\xd0\x63\x5c\x05\x68\x4f\x37\x46\x38\x81\xb6\x2d\xd8\x30\x0d\x1a\x25\x2a\xd2\x94\x3e\xb4\x46\xd2\xe7\x98\x5b\x96\x6b\xaa\xe4\x4e\xf7\xf6\xc3\xbe\x80\x54\x46\xa5\x9a\x7a\x15\xb0\x20\xb2\x56\xeb\x9b\xfd\x7a\x83\x86\x5f\xa5\x86\xf4\xa1\x95\x91\x3e\x47\x61\x82\xb7\x49\xbb\x50\x7a\x8d\x34\xa5\x0f\x25\x95\x3e\x27\x2a\x09\xde\x09\x64\x0b\x83\x72\x63\xc1\x14\x05\x89\xdc\x2a\x4d\x4a\x46\xa3\x69\x0f\x40\xd3\xbe\x06\xad\xf2\x65\x29\xa0\xd4\xbb\x78\xed\x82\x6c\x00\xfe\x66\xa6\x82\x2c\xb4\x74\x8d\xa6\x9d\x44\x82\xb7\xcf\x82\x22\xcb\x4e\xf4\x6a\x52\xbe\x8d\xa2\x0b\x9a\x10\x5d\xb5\xec\x19\xcd\xf5\x47\x95\xb9\x57\xf7\x19\xa7\x7b\xab\xe2\x2f\x20\xf9\x82\x55\xd0\x2e\xb1\x5d\xd9\x89\xe3\xa7\x58\x02\x0e\xa2\xa9\x06\x02\x6b\xce\xb7\x26\x78\xcb\xb1\x67\x56\x6a\x3c\xf1\x6f\x31\x2b\x74\x63\x58\xdc\xc3\x04\xeb\x98\xc6\x9b\xc3\x6b\x92\x9a\xed\x22\x01\x1f\xd1\xfe\xd0\x89\x17\xed\x09\x40\x73\xe5\xd1\x61\xb9\x97\xf2\x13\xbc\x95\x08\x56\xde\xda\x19\x8d\x26\x9a\x03\x40\x73\xa1\x35\xc1\x5b\xae\x60\xc7\x11\x79\x25\xad\xdc\xfb\x34\xf0\x49\x54\xb6\x8a\x30\x9c\x02\x2e\x19\xd0\xf5\x08\xa3\xff\xa4\xe6\x8b\xf8\x0d\x0d\xd7\x97\xbb\x62\xa6\x56\x74\x57\xdc\x5d\x7b\x17\x51\xd2\x23\x7a\x8f\xb6\xc4\xf4\xcc\xd4\xc6\x08\xd6\x2f\x58\x03\xae\xfc\xfb\x18\x50\xd7\x32\x47\x76\x17\x20\x37\xa6\x4e\x73\xc6\x9e\x43\x4c\x07\x66\x18\x14\x06\xb7\xa6\xce\x00\xd8\xf7\xb6\xf4\x39\x0d\x4c\x0d\xb0\xed\x92\xb2\xeb\x47\xe7\x81\x69\xd3\x26\x09\xa7\xb4\x43\xa0\x30\x7f\xe9\x9f\x2e\x4f\xf8\x52\xc3\xb7\x62\x12\x0a\x30\x0e\x9f\xab\xc0\xa9\x46\x32\xd5\x3e\x50\xf7\xd8\x36\x51\x81\x76\x92\xa9\x39\x06\x2e\x29\x9b\xc8\xbe\x00\xe4\x48\xd3\x0a\xa1\x71\x58\x4e\x26\xe6\x58\x61\x94\x73\xbf\x63\x43\x6a\x8e\xc9\x92\x46\x4b\x6a\x61\xe0\x90\x06\xc0\xa7\x80\xf1\x21\x9b\xc5\xef\x0c\xfd\x55\xfc\xa5\xc2\x04\x70\x9e\x38\xa7\xf4\x6c\xd8\x2e\x35\xec\xc4\x0d\xd8\x09\xf4\x89\xab\xa6\xc2\x04\xd4\xdf\x54\x4d\x03\x75\xc5\xf4\x87\x22\xa0\xed\x87\xab\xe1\x83\x77\x8e\x7f\xff\xbe\x03\x1d\x56\x0a\x9c\x05\xf4\x9f\x85\xb6\xd8\x48\x43\xd2\x57\x2d\x0b\x13\xe8\x6e\xd7\x9c\x29\x88\x53\xfb\xb6\x9a\x0a\x13\x10\x39\x05\xf7\xf2\x8e\x51\x89\xa5\x6d\xa7\xae\xa6\x3a\x0c\x06\xc3\x27\x36\x1f\xa0\x2d\xbb\xb0\xeb\xab\x7a\x25\x38\x3a\x13\xe5\x4a\xd0\xdd\xfb\xef\x92\x15\xa6\x00\x14\xd1\x99\x57\x81\x5c\x3a\xa4\x5d\x85\x84\xe9\xa4\x33\xec\xfe\xfc\xa0\x88\xd0\xe9\x52\x2c\x98\xd5\xda\xac\x81\x88\xd3\xf2\xb3\xb5\xe5\x7f\x2e\x49\xb1\xe7\xa2\xf1\xba\xab\x8c\xd2\x26\xdb\xbf\x06\x91\x82\x01\xa5\x10\xd0\x0d\x39\x98\xc6\xd2\x12\x09\x3e\x08\x38\x64\x06\x77\xee\xba\xbc\xda\x1f\x33\xe7\x82\x8f\x34\x01\xb1\xf9\x6a\xa3\x06\xd8\x1e\x4b\xd5\x05\x3b\x2d\x08\x02\x28\x81\x62\x15\x03\x34\xa3\x80\x75\xc6\xf8\x69\x53\xa0\x1e\xd2\x70\xb3\xb7\x4b\xcc\xd1\x13\x8d\x06\x34\x70\x92\x99\x34\x67\xb6\xc1\x7b\x28\xec\xb5\x9d\x1b\x58\x44\x99\xcb\xb4\x0f\xac\x34\x02\x64\x43\x0c\xee\x70\xc7\x25\xb9\x86\x11\x86\x16\xa1\x9b\x1e\x06\x3b\x6d\x61\x07\xf3\x86\x5b\x9c\xc6\x7c\xb8\x6a\xdb\x0c\x5f\xc8\xea\x37\x27\xcb\xdc\xf0\x86\xbe\xf3\xbf\x59\xfb\x77\xcc\x0c\x4e\x53\x13\x4e\x61\x07\xbb\x8a\xdb\xee\x6a\x2e\xfa\x30\xdf\xff\x69\xec\xc4\xb1\x96\x2e\x6c\x97\x8b\x15\xe5\x7c\x71\xb9\xa7\x5c\x33\x59\xda\xc7\x04\x97\x1c\x97\x9f\x0f\x83\x6b\x2b\xe9\x8b\x2b\x8e\xff\x0f\x06\x23\xd6\xfb\xcc\x10\x9c\xe2\x0d\x81\xc8\x70\x13\xd7\x68\x89\x0a\x9c\xa3\x15\xd0\xd8\x35\xd9\x69\xc1\x9d\x0e\x8b\x1a\x26\xeb\x9c\x18\xcd\x25\x10\xf0\x0a\xa0\xf5\x57\x4a\x88\x0f\x25\x93\x5c\x55\xe3\x71\x95\x72\x4d\x6f\x84\x66\xcc\x90\x8c\xf0\x0d\x83\x07\x1a\x58\x58\x26\xc9\xdb\x80\xbc\x05\x57\xb9\x8d\x86\xdf\x0f\xa4\x8c\x5a\x75\xea\x7b\x55\x91\x2b\x81\x59\x2b\xdc\x0a\xdb\x4e\xc9\x30\x83\xd1\x93\xa0\x3e\x65\x27\xf1\xec\xb5\x5e\xf5\x21\x41\x3f\xe4\x61\x9f\xe9\x39\xf4\x3c\x7b\x0b\xa0\x7f\xa0\x80\x77\x60\xab\xcf\xb6\xe0\xb6\xb3\xe1\x89\xcb\xbc\x2d\xe9\x01\x78\xc1\x36\x97\xcf\xa4\x03\xe8\xc1\xa4\xd2\xcf\xf9\x60\x49\x23\xfc\x44\x60\x51\x92\xbb\x26\x3d\x84\x52\xe8\x8b\x42\x10\xa5\x5d\x52\xa5\x99\xb9\x21\x57\x50\x28\x90\x0c\x11\x32\x12\x04\xee\x07\x81\x88\x8b\x26\x7b\xef\x91\x74\x38\xa2\xf1\xea\xba\xc3\x83\x42\x65\xcc\xaa\x33\x5b\xaa\x11\x85\x0e\xa1\x38\xc4\x01\x52\x83\xc0\xa0\x29\x35\x51\x6e\x82\x13\xc7\x49\xbf\x11\xe8\x80\x83\xd5\x85\x00\xeb\xa1\x9a\x90\x0c\x83\xca\x91\x0f\x9d\x81\x63\xf2\xcf\xc1\x13\xff\x98\x72\x6c\x73\xb0\x62\x3c\xbb\xac\x61\x3e\xc2\x3f\xb8\x1a\x6e\xf8\x10\xe3\x4c\x01\xcc\x30\x2f\xc8\x66\xad\xaf\xf8\x85\x83\xdf\xaa\x99\x3c\xa2\x38\xb7\x65\xd9\x4b\xe8\x94\x52\xde\x8d\x9a\x7f\x9f\x90\x01\x81\x3a\x54\xda\x0e\x1a\x67\x6a\x4f\xe0\x83\x9f\x8b\xa2\x73\xc1\xf5\x00\x6f\xcf\xa9\x14\x70\xcd\xac\xa1\xd1\xe5\xe4\x60\xdb\xbf\x7b\x22\x92\x62\x0c\x19\xbe\x8f\xaf\x50\x6d\x54\x68\x60\x32\x5e\xaf\x37\x69\x88\x94\xd7\x2b\x70\x3f\x05\x3f\x38\xc2\x3e\x8e\xf0\x12\x8e\xef\xb6\x2b\xf0\x50\x75\x1d\xdd\xbf\x3f\x68\x84\xdf\x63\x28\x3e\x7b\x26\x75\x6b\x50\x64\xc4\x26\x1d\x12\x68\xba\xdc\xad\x26\x84\x16\xf8\x6c\x02\x33\x72\x0f\xda\xbc\xa8\xa9\xf1\xa9\x07\x60\x1a\x7d\x4b\xc2\x40\x51\x30\x4c\xd3\xb6\xc3\x82\xa8\x32\x69\x29\x0d\x4a\xbf\x77\xdb\x99\xd4\x2b\xdc\xd5\xb0\x7b\x70\x00\x7d\xc7\x64\xe8\x72\x0b\xa1\xd9\x30\xa8\x56\x46\x92\x08\x7b\x71\xcf\xa1\xb2\x24\xee\xaf\x76\x1d\x55\x31\xa2\x1b\x54\xd0\xa1\x29\x63\xef\x3d\x29\xcf\x8b\x9f\x98\xe3\xb0\xb1\x5e\x24\x7f\x1b\xbe\xab\x9b\xe0\x3c\x56\xd1\xab\x98\x14\x13\xa8\x80\x3e\x55\xd0\xc2\xfb\xf9\xef\xb4\x6d\x3e\xcb\x0e\x01\x82\x23\x31\x8a\x22\xd8\xb2\x11\x6d\xc4\x00\x41\x54\x38\x67\x82\x2d\x17\xf1\x91\xc1\x12\x6b\x86\x7b\x2e\x38\x2b\xf6\x11\x3f\x07\xba\x69\x6a\x57\xae\xe4\x25\xef\xf9\x4a\x8d\x1f\x5c\x49\x45\xc4\xb8\xf4\xcf\x30\x92\xae\xfe\xab\xe6\x55\xf7\x6a\x58\x35\x35\x9f\xe6\xeb\xfc\x98\x19\x8e\x45\x43\xbf\xc4\x51\x88\xe3\xf5\x91\x4a\x50\x66\x33\xb8\x24\x4e\x27\x63\x3c\x65\x95\x35\x07\x5c\x5e\x49\x9a\xd9\x5b\xd0\xb8\x09\x82\x60\xbe\x50\x99\xa8\x49\x63\xd4\x4c\xaa\x60\x57\x6b\xf6\x29\xe1\xe1\x33\x8a\x60\x55\x33\x37\xf3\xb6\x31\x4e\x41\xe1\x21\x75\x20\x77\xa1\xf5\xae\x12\x0a\xd3\x4f\x04\x49\x53\x27\x77\x81\x1a\xec\x61\x91\x56\xec\x28\xb3\x28\xab\x37\x40\x2d\x14\x7a\x54\x29\x58\x35\x5e\x96\x6c\x1e\x2c\x1f\x94\x15\xa3\x5e\xd3\x33\x81\xc2\xc4\x2c\xb5\xf1\xb0\x0b\x9a\x39\x38\x7b\x48\x7b\x51\x2f\x68\xb8\xcf\x11\xe1\x7c\x8f\x80\x01\x92\x76\xd9\x54\xd5\x8b\xb3\x62\x43\xcc\xdd\x72\x70\x7e\x22\x52\x13\xe3\x11\xa2\x31\xb0\x35\xd6\xe9\xd9\x18\x17\x51\xd4\xc4\x48\x86\x6a\x90\x1f\x1f\x3c\x8c\xa3\xaa\xb1\x68\x0b\x45\x99\x32\x25\x67\x3d\xd8\x14\x19\x91\xd9\xa2\xdc\x89\x9c\x23\xd6\x62\x10\x30\xc2\xb8\x28\xf1\x53\x21\x2c\x8b\x2a\xeb\x83\xad\xb2\x68\x6c\x44\x85\x31\xc3\x61\x17\x2c\x8f\x5e\xec\xb3\x91\xca\x53\x5e\xf3\x91\xa0\xbf\xc6\xfb\x53\xa5\xe7\x8b\x7c\x97\x5f\x32\xc7\x8c\xb8\x5b\xea\x64\xed\x88\x76\x83\x02\x03\xb1\x18\x83\x37\xab\xf4\xd5\x68\xa4\xe8\x3c\x12\xf2\xba\x8e\xa3\xe6\x93\xce\x42\x66\xd0\x0b\x59\x52\xc9\x46\xe3\xfd\x61\x84\x50\x78\x29\x88\x23\x10\x1f\x7d\x5c\xd9\x2d\x49\x95\x91\x6b\x27\x5c\x5c\x0b\x30\x56\xb6\xb8\xe2\x69\x67\x02\xe3\x11\x44\x40\x07\xe4\xe8\x5e\xc5\xb0\x7b\x15\xdb\xf3\x33\xab\x3d\xb4\x60\xd5\x2b\xd8\xb6\x6d\x0b\x6b\x4c\x47\xef\xd2\x15\xe0\xda\x6a\x06\xcb\xba\x82\xcb\xdf\x5c\xb6\x2a\xc6\x83\xc0\xe6\xef\x8b\x96\x9b\x95\x2b\x9e\xaf\x04\x4c\x89\x81\x46\x32\xb2\xb8\x3d\x31\x3a\xc9\xf1\x3a\x2b\x9a\xae\x4a\xc5\xc5\x05\x4a\x8b\x7e\x2e\x0d\x1b\xd4\x83\x29\x35\x15\xdb\xa3\x80\xc5\xd9\x05\x13\x69\x5e\x1e\x95\xa0\xe4\xba\xed\xaa\x47\x87\x69\x32\x19\x64\x8a\xe6\x5d\x02\xbb\xca\xfd\xfc\x91\x54\x18\x1c\x5a\x9e\xa9\x71\x15\xc0\x38\x50\x0b\x47\x60\x44\x16\xc2\xe7\x86\xda\x7e\x25\x06\xe6\xfb\xe1\x7f\x0b\x46\x64\x33\x9c\xbc\xf9\xa6\x28\x65\xe9\x4e\x88\xcb\x19\x81\xaf\x0c\xc4\x56\x8a\xb6\x4b\x5b\xc6\x18\xab\xc4\x48\x2b\x5e\x76\x67\xc8\xa2\x1c\x82\x9f\xdb\x4e\xef\xbb\x6c\x16\xb3\x45\x42\x4b\x18\xa9\x14\x0f\xee\x4c\x77\x88\xe0\xe9\x54\xcb\x9c\xb4\xb8\x6a\x89\x21\x0f\x5f\x9f\xc6\x02\xa7\xce\xa4\x2c\xd8\xaa\x66\x3a\x1a\x61\xca\x1c\xc3\x78\x31\xf8\xc0\x3b\x30\x84\x42\x04\xf1\x00\x28\x34\x50\x72\xa2\x5f\x3e\x15\x04\x20\x4a\xaf\x26\x59\xc6\xe8\xb2\x49\x42\x44\xf1\xf3\x56\xf3\x09\xc0\xdd\x01\x94\xe4\x1b\x4c\xfd\x21\x64\x85\x43\x7c\xff\xd0\x35\x4d\x31\xac\x54\x06\x09\xbb\x1e\x25\x28\xda\x54\x47\xd7\x89\x5c\x7a\x9e\xf9\x95\x9a\x2f\xc6\xb9\x00\x70\xf8\xb7\x2a\x11\xd4\x15\x1f\xe2\xa2\x66\x89\xac\x0d\x90\xd9\xa8\x82\x15\xd9\x81\xd5\x50\x05\x47\x38\x56\x25\xf5\xcc\x9b\xf1\x2a\x89\x9a\x36\xd8\x9d\x5c\x39\x96\xa9\xf2\x36\xc5\x4e\xa8\xad\x90\xfa\x27\x38\x3c\x00\x06\x2d\x7b\xeb\x6e\xb7\xb6\x74\xa9\xe4\xe0\x42\xaa\xd7\x82\xe6\x2a\x18\xad\x20\x50\xce\xd6\x21\x94\x23\x27\x91\xc7\xc4\x6b\x8b\xfd\x8a\x9c\xba\xe1\x34\xde\x19\xb5\x66\xed\x84\xc4\x16\x1d\x84\x6e\x91\x6f\x91\xd3\x61\x88\x56\xa9\xe9\x97\x39\x1c\xad\x48\x4d\x58\x17\xcc\x61\x53\x9b\x14\x3b\x37\xaa\xd4\xed\x67\x84\x20\xac\xa3\x2b\x91\x0e\x34\xf9\x37\x2b\xdb\xa5\x36\x33\x5e\x60\xb9\xca\x62\xd6\x1d\x13\x38\xab\x4c\x78\x85\x63\x38\x18\x34\x48\x12\xa4\x24\x19\x5a\x94\x45\x1b\x3d\x31\x87\xde\xfc\x8e\x3a\xc1\xcf\xe5\x38\x96\xae\xb1\x4b\x81\xeb\x62\x26\xc3\xe8\x50\x44\x16\xac\x80\x30\x65\x53\xdb\x3e\x6c\x8a\x3c\x4a\x2b\x28\xc5\xcb\x40\xe4\x72\x5b\x14\xd4\x44\x64\x86\xc8\xa6\x26\xbc\xe8\x66\x68\xcb\xbc\xea\x6a\xda\x8c\xa0\x94\x12\xac\x9d\x8e\x92\x69\x3c\xc5\x83\x2c\x28\xe3\x42\x6e\x20\x08\x29\xb1\xbb\xe2\x98\xc1\x74\x49\x18\xf5\x75\x05\x9f\xd6\x89\x57\xb0\x76\xa6\x94\x25\xf3\x7a\x19\x93\xfb\x4f\x13\xe0\x72\x9a\xbb\x54\xb3\xc4\x71\x7f\xf5\x96\x9c\x82\x96\x63\xa7\xe4\xcd\xc1\x24\xe8\xb4\xa2\x3d\xc3\x02\x4e\xf2\xca\x63\xf8\x74\xc0\x2d\xa6\x2f\xa1\x64\x89\xc0\x72\x17\x20\x62\xa8\xa1\x54\x4b\xb0\xe8\x50\x26\x80\x49\x2b\x17\x34\x0d\x0a\x27\x29\x87\xc5\x8c\xc7\x94\x1b\x28\x7e\xd4\x89\x1d\x62\x67\x23\x4b\xec\x9e\x90\x0a\x7e\xb2\x86\x55\x02\x8f\xca\x4c\x97\x70\x14\xd7\xc8\x09\x99\x7c\x1e\xc2\x97\xb9\xff\xbc\xec\x42\x6f\x5f\x50\xf3\xdf\x7e\x74\xf5\x5e\xa1\x0b\x31\x01\x89\xba\xaf\xa1\xc4\x5c\xce\x92\xde\x68\xa2\xdb\x1a\xbd\x78\xaa\x41\x09\x0e\x17\x0c\xb1\x94\x84\x14\x48\xe7\x2d\x20\x45\x1f\xb3\x2f\xd8\xa8\xcd\x64\x36\x72\x67\x13\xd7\xc4\x59\xa9\x08\xdd\xf1\x69\x34\xcf\x55\x1b\xa3\x22\x64\x9a\x3c\x20\xc3\xd2\x2d\x14\x83\x68\xcb\x32\x66\x38\x16\x37\x92\x15\x3a\xd7\xdb\x21\xb7\x59\x7a\xaf\xed\x47\x5f\x60\x0f\xbd\x89\x76\xc9\xaa\x0b\xbe\x82\x37\x75\x96\xa4\xb7\x81\xdf\x92\xf1\x53\xcb\x8f\x78\x70\x32\xa8\x14\x02\x20\xed\xf9\xc9\x3a\x78\x1e\x7a\x77\xa4\x3e\xdf\xb9\x84\x5f\x39\x84\xec\x8c\x38\xfc\x78\xe3\x3a\xc3\x45\x58\x21\x41\x37\x84\x99\x76\xdc\x7f\x61\xc7\x38\x51\xe9\xbc\x25\x30\x7c\x3a\xf6\x94\x42\xf0\x6d\xe6\x6d\x8d\xdd\x3e\x4f\xd8\xbe\x54\x8e\xfe\x55\x0a\x34\x57\xee\xdf\x01\x73\x12\x34\xeb\x26\x0b\x2b\x6d\x9d\x16\xce\xa9\x98\x7b\xfc\x38\x37\xbc\x1f\x6f\xe1\x9a\x41\x02\x4f\x13\x47\x30\xe8\xee\x99\x83\xaf\x0f\x12\xc0\xac\x04\xf5\xb2\x78\x66\x92\xbd\x68\x00\x24\x0d\x80\x6b\xab\xe7\xc1\x92\x1a\x06\x0d\xf4\x8a\xf6\xb6\x0e\x38\x65\xe3\xfc\x9d\x1a\x73\xb8\x07\x21\x82\x70\x79\x0f\xf5\xa0\xf6\xf6\xf1\x07\x9e\x98\x5c\xa8\xcc\xe6\x96\xfb\x02\x89\x19\x74\xa9\x39\x77\x36\x3c\xf2\xab\x89\xdd\xcd\x4a\xb7\xa5\x3a\xb0\x9d\x08\x27\xfa\xdb\x96\xff\x2b\xc3\xc8\x98\xad\xbd\x7f\x63\xc9\x70\xab\x7f\x77\x22\xb4\xd0\x4b\xbb\x39\xdc\xfd\x46\x2b\x78\x26\x3c\x23\x1b\xfc\xe2\xa6\x7a\x37\x8e\xfa\x2c\xdf\x84\x53\xed\xa4\x9a\x1b\x6a\xd9\x34\xa5\xd3\x43\xb6\xd5\xc8\x72\x61\xe0\x5f\x02\xd9\xc4\x47\xbb\xb2\x8d\xb7\xca\x6c\x92\xa9\x68\x37\x94\x6c\xbb\x98\xba\xb2\xe6\x20\xd9\xb8\xf5\x23\x6f\x74\x64\x70\x4c\x75\x8a\x85\x55\x98\x6a\xb5\xec\x3e\xc6\x5d\x43\x07\x11\x1d\xc9\x23\x61\x50\x83\x75\x17\x7c\xa4\xc5\xdf\xf6\xbe\x0f\xf0\xf8\xcd\xd8\xba\x88\x66\x49\xdc\x23\xf9\x24\xd4\xc2\xb0\xac\x35\xa3\xdc\xd3\x3a\x78\x61\x0e\xab\xd6\x93\x3c\x67\x54\xe8\xf3\xd9\x56\xb7\x4b\x69\x74\xb3\x69\x54\xd8\x19\xf1\x84\x03\xd6\x98\xca\x12\x95\xe5\x06\x53\xf5\x89\x1b\xa5\x38\x4a\xa5\x44\x06\x91\xd3\x05\x2b\x7f\x26\xa9\x66\xab\xc5\xb8\xbb\xd0\x76\x3e\x55\x93\xa7\xdd\x6b\xc4\xa8\xeb\x1c\x96\x42\x5c\x50\x86\x82\x52\xfd\xf7\xb7\x1d\x55\xca\x78\x8d\x4d\x14\x62\xd0\x2b\xe6\x9c\x7e\xd5\x40\xe2\xab\xa7\x42\xd6\xf3\x20\x1e\x37\x2e\x89\x52\x02\x72\x89\x45\xe6\xc5\x9d\x27\x8b\x54\x01\x8b\x4a\x0a\xb3\xac\xd9\x96\x6c\x46\x87\xc9\x7f\xbb\x51\xad\x4f\x07\x4b\x57\xf5\xe8\x3e\x3c\x67\xcf\x9e\x7a\xe1\x11\xe8\xe9\x49\x93\x3f\x72\x09\x81\x05\x01\x5b\x5f\x6e\x92\x6c\x65\x66\x3d\x67\x64\x74\x82\x17\xf0\x82\x13\xe5\xdc\x82\x84\xb7\x8d\x82\xab\x04\xb3\xad\xc9\x2b\x48\x26\xee\x79\x61\xbc\x4c\x68\x2d\x1b\xd5\x13\x82\x9d\x9a\x9a\xbc\x5d\x14\xfd\xa4\x62\x24\xd6\x7a\x15\x18\xe0\x52\x86\xe9\x90\x49\xb0\xa0\xe1\x85\x13\x86\x6b\xf5\xa8\x6c\x77\x51\xee\xca\xc4\x38\x37\xa0\xcc\xdd\x2b\x00\x67\x4c\x80\xa8\x73\x52\xd3\xbe\x82\xe2\xcf\xbe\x62\x3a\xeb\x73\xdb\x90\xe6\xab\x98\xac\x34\x8d\x61\x9d\x18\x9b\x6f\x2d\xa7\x6d\x22\x91\xe8\x29\x04\x4b\x98\xec\xec\xe9\xfd\x5c\x8f\x1a\xb2\x86\x12\xe9\xcf\x99\x59\x65\xc5\x10\x77\xf9\x43\x7f\x96\xb2\xbc\x9e\x1e\xd8\x01\x0f\xdf\x3c\x78\x52\xc3\x0e\x28\x78\x8a\xca\x96\x1f\x12\x60\x70\x6e\x3b\x17\x86\x9b\x41\x8f\x4b\xf7\xa0\xaa\x8b\xcb\x12\x96\x5b\x98\x39\xda\xfd\x6f\xa7\xd0\x26\x63\xec\xee\x9c\xe7\x70\x25\xfb\x58\x4d\xf2\x11\x1c\xfd\xf1\x7f\x99\xf4\x2c\x08\x79\xd7\xe5\x9b\x6f\x4f\xfc\x48\x70\xf0\x99\xac\xda\x6f\xf9\xac\xc9\x4b\x67\x40\x3a\x37\x7d\x27\x46\x31\x72\x08\xd1\xeb\x71\x5d\xcb\x2b\xb2\x62\xeb\x5f\x2e\x41\x35\x25\x54\x29\xcb\x84\x3b\x93\xf6\x1f\x95\x69\x85\x3e\xc4\xb0\x07\x45\x38\x3b\x11\x0b\x61\x30\x2e\x88\x74\x24\xd4\x18\x20\x86\xd6\x9b\x83\x42\x15\x42\xd7\x29\x4e\xb2\xcd\x39\xfa\xf8\x0e\x96\x9f\x4f\x96\x15\x91\xd3\x49\x5e\x68\x3c\x5e\xef\xb7\xbd\xdc\x7f\x72\xd5\x84\xf8\x50\x5c\x32\xf1\xba\x9d\x74\x37\x9b\xfa\xc1\x36\x72\xe3\xa9\xbd\xa7\x04\x01\x83\xee\x2a\x0a\x70\x84\xd2\x4c\x4e\x0a\x41\x52\x36\x9a\x51\x07\x99\xd9\x05\x05\x12\x79\x92\xbe\xe1\x7e\x0b\x99\xbe\x15\xc5\xad\xfb\xf9\x82\x7b\x51\xd9\xf2\x39\xc1\xc5\x99\x1d\x4d\xbd\xf9\x44\x1e\x3f\x68\xb2\x39\xb1\x17\xd5\x7a\x5e\x50\x4f\xb1\x70\xe7\x95\x5b\xaa\x46\xbf\x49\xb7\x15\xa1\x87\xdf\x2f\x78\xa2\x64\xb9\xa5\xd7\x1d\x39\x56\xaf\x9a\xc8\xbb\x3c\xc8\xe1\x8b\x2d\xc1\x39\x87\x68\x89\x4a\xfe\x72\x44\x5c\x40\x4e\x62\xf7\x71\xc4\x62\x0a\xbd\x63\xb5\x77\xfb\xad\x72\x8e\x54\x91\x98\xa5\x93\x33\x40\x59\x0a\xf1\x52\xfd\xaa\xc7\x57\x72\x27\xd6\x03\x51\x30\x3a\x98\x50\xb5\xb7\x10\x41\xdb\xb0\x8f\x2a\x4f\xfd\x24\x18\xaa\xf2\xac\x79\x69\x9c\x67\x69\x22\xce\x13\xee\x25\x51\xe0\x21\xf8\x63\x40\x46\x42\xd2\xc5\x18\xeb\x5b\x49\x2c\x5c\xd6\xb3\xac\x78\xe8\xc4\xed\x3a\x26\xf1\xb2\xcd\x18\x46\x91\x9e\x27\x7d\x08\x4a\xab\xfe\xfe\xa5\x5d\x50\x56\x61\xe8\xb0\x7d\x7a\x54\x58\x31\x22\xc8\x58\x2d\xa0\x41\x73\x21\xf3\x7e\x0f\xbe\x67\x3a\x8f\xee\x42\x10\x8c\x29\xfa\xde\x08\x0e\xee\x40\x63\xfb\x54\x0d\x95\x60\x39\xc5\xc2\x45\x3c\x6c\xac\xdf\x28\x11\x2d\x71\x69\x42\x07\x72\x44\x33\x95\xf3\x2b\x11\x58\x8a\x77\x46\x79\xaa\x55\x01\x5b\xb1\xe3\xda\x4b\x50\xc3\xae\x75\x67\xa2\xc9\x20\xaf\xa5\xd4\xb5\xde\x21\x2c\xdb\x96\xc3\x72\x1a\x25\x93\x04\x6d\x64\xf9\xb1\x80\x90\x04\x24\x73\xd8\x49\x50\x24\x13\xb9\xcc\xab\x76\xc7\xe8\x21\xd4\xad\xa2\x0a\xa8\x23\x97\xee\x4f\xad\x41\xae\x5a\x74\xef\x8e\x6c\xb4\x8f\xab\x29\x16\x22\x24\xdf\x87\xb2\x48\xe4\x6b\xfd\x7d\xfe\x15\xe7\x8b\x7f\xe0\x09\xaf\xf0\x04\xce\x47\x1a\x59\xcc\x49\x22\xe6\xde\xf3\x99\xcd\xa4\x12\xa1\xdb\xa1\x2e\xef\xc6\x2a\xe5\xce\xa5\x1d\x0e\xea\x43\x6d\x0d\x7f\x10\xf1\xd9\x1f\x70\xa7\x54\x96\xb3\xe2\x1e\x32\xb5\x4c\x46\xe4\x8d\x0a\xde\x4f\x42\x57\x99\xfb\x86\xab\x45\x1f\x35\x16\xde\x34\x39\xe7\x12\xd3\xb9\x84\x30\x5a\x68\xc3\x78\x19\xd5\x90\xf4\x1a\xb7\xe4\x99\xa6\x10\xc5\x34\x4b\x85\x73\x49\x49\x2d\x41\xdf\xc0\x8a\x05\x6f\x4b\x9f\x8a\xf7\x0f\x51\x8b\xd3\x46\x25\x73\xbd\x09\xba\x91\xf8\x12\x4c\x0d\xfa\xf2\xc1\xad\xc4\xb9\x96\x25\xb7\x12\xe9\x5c\xf6\x00\x8e\xae\x3c\xd7\x67\x22\x63\x51\x75\x47\x6e\xb3\x18\xa1\xda\x34\x39\x6d\xda\x04\xab\x97\xd1\xfb\x17\x88\x62\x61\x41\x9f\x09\xde\x4a\x3c\xcc\x66\xc9\x47\x25\x6c\xa9\xc7\xe0\xf9\xad\xf0\x41\xeb\xf1\xa0\x27\x75\x92\x8f\x00\x63\xb1\x44\x31\xcd\x92\x6b\x09\x6b\x5d\x23\xf5\xa9\x9e\xbe\xf4\x0d\x9e\xf5\xa9\xb9\xfe\x21\xb2\x38\x6d\x94\x35\x13\xd6\x0e\x00\xb3\x6d\x4a\x6d\x07\x3a\x46\x9b\x39\x9b\x25\x4f\x41\x27\xad\x16\x80\x3d\x9d\xc7\x6f\x06\xf7\x8d\x32\xeb\x33\x81\xb1\xc8\x64\x72\xfc\x9b\x67\x12\xe8\xba\x72\xd6\xab\xdf\x42\x77\xe4\xa9\x29\x86\xd5\xae\x97\x59\x5f\x98\x7d\x02\x0a\xf0\xf3\x15\x99\x1d\xd0\xca\x8b\x58\x46\x4b\x35\xf3\x58\x9a\xf6\xc0\x08\xb4\x35\xe3\x43\xd2\x15\x22\x32\x35\x32\x88\x6c\x5b\x43\xb3\x4a\x5d\xdd\x8d\xc7\x6c\xdf\xd3\x61\x31\x92\x33\xde\x7c\x35\xea\xd6\xd2\x35\x2b\xa1\xa9\x4f\x46\xc3\xa8\x95\xc9\xf0\x78\x17\xba\x37\x93\x3e\x5c\xc0\xa2\xc5\x5a\xed\x83\x9b\x67\xd3\x03\x22\xf5\x4e\xf1\x6c\x84\x93\x52\x4f\xc8\xa7\xeb\xbb\xbe\x54\xc9\xae\x41\xdc\x3d\xc3\xb7\x6a\xc0\x45\xd7\x5c\x6f\xbc\x8d\x14\xfe\x01\xde\x15\x40\x75\xf2\xa7\xff\xc4\x29\x0c\xef\xfa\xf5\x1f\x19\xfc\x27\xef\x9f\x8d\x2e\x3a\x04\x03\x56\xfc\x23\xfa\xdf\xbe\x80\xf9\xb5\x4b\x36\x00\x56\x3c\xea\x3f\xbc\x7f\x75\xbb\xe8\xaf\xb2\xef\xfd\x49\xcf\xe2\xba\xe4\xd5\x7f\xeb\x9f\xb5\xad\x4e\x4d\x23\x21\x8e\x37\x84\x8f\x38\xfe\xd3\x36\x5e\xe3\x07\x6e\xe5\xc6\xa3\x0d\x4a\x69\xb3\x08\x76\x82\xf3\x23\x9d\x41\xe5\xbb\xd0\xa4\xc0\x36\x5e\x72\xbb\x1e\x34\xd7\x0a\x06\x6c\x81\x76\xd0\x1e\x4a\xc1\x32\x98\x0c\xf5\x61\xe0\x8f\xed\x5a\xef\x49\xad\x0e\xd7\x07\x27\x3a\xc3\x55\xc9\x10\x26\xb5\xcf\x70\x67\x2e\x82\xf7\x24\xc3\xf9\xf1\x0b\xca\x24\x45\xf8\xa4\x0c\x65\x64\x09\xe0\xfb\x21\xa6\x60\x51\x53\x37\x4a\x13\xa2\x6f\x78\x93\x9a\x10\x36\x60\x30\x31\xfb\x0d\x63\xd5\x14\x2f\x6c\x62\x53\x96\x5f\xda\x3f\x5e\xf3\x18\x61\x34\xa6\x11\x9b\x44\xc9\x9a\xce\x70\x7e\x7e\x0e\xc5\x09\x81\xec\x58\xe6\xd4\xf8\x83\x79\xbb\x44\x7e\xd7\x71\x0d\x2f\xc6\xdc\x2f\x51\xe9\x15\x10\xed\x9a\xcb\xb2\xf6\xfd\x30\xb1\xb2\x86\x28\xb6\x23\x89\xc3\x50\xc4\xae\xcd\xa0\x60\xbe\x99\xe3\xf0\x94\x55\x1f\xc8\xae\xe3\x1a\x19\x1c\x72\xed\x07\x2a\xe6\x70\x32\x87\x37\xb7\x9a\xcb\xc6\x67\x47\x16\x87\xd6\x43\x90\xef\xc3\x56\x62\x1e\x57\x10\xfb\x3e\xfc\x33\x38\x37\xfb\x37\xae\xf7\x39\xb8\x85\x65\xe9\x2e\xc7\x2a\xf5\x89\x76\x60\xcc\x14\x24\x69\xd1\x3c\x73\x38\xf2\x1a\x8d\x6c\x72\x79\x10\xaf\xb1\xc5\xa5\x7a\x4d\xc1\x38\xc2\xba\x5b\x72\x9f\xe1\xd8\xf4\x02\x05\x7d\x0c\x31\xfc\xdd\x9d\xe9\xda\x8c\x5a\x44\xb4\x3f\x24\x0e\x2d\x4e\xed\x1d\x6a\x75\x7c\x4a\x2d\x51\xf4\x20\x2a\xeb\xc7\xa8\x37\x6e\x0a\xea\x17\x21\x90\xa3\xfa\x3d\xc8\xa3\xb8\xf7\x65\x73\xb3\x99\x14\x46\x33\x59\x02\xd2\x68\xa9\x31\x8f\xca\x47\xd7\x60\x55\xba\xb8\x06\x5d\xe3\x18\x27\x73\x36\x86\xc1\xa0\x8a\x1b\x79\xb4\xc3\x98\x67\x33\x2a\x90\xeb\x27\xe5\xf3\x5a\x9e\x73\x0d\x4e\x7d\x12\x4a\x6b\x13\xd9\xa7\x89\x9e\x54\xd4\xcd\x94\xc9\xad\x52\x8e\xb5\x91\x68\x15\xbb\xd2\xd6\xf1\x6c\xcf\x50\x68\x63\x0b\xb2\x53\x46\x73\xe7\x0b\x64\x15\x3f\x1e\xf9\xf6\x0e\xe9\x11\xc7\x61\xe4\x6b\x1e\xfe\x2e\x8d\x4c\x0e\x48\xcd\x93\x3a\x70\x31\x43\x10\x0c\x29\x69\xb2\xab\xb3\x96\xe1\x3c\x18\x1a\xcc\xb0\xe8\x4a\x34\xa9\xf6\x25\xa2\x2a\x74\x2c\xc5\xcd\x21\x11\x16\x70\x38\x74\x05\x03\xd8\xe7\xdb\x25\x38\x04\x40\xff\xab\x28\xb5\x7e\xd6\xb4\x44\x91\x42\x0f\x21\x0e\xf6\x7d\x58\x80\x7e\x57\x01\x02\x4c\x48\x08\xf0\x53\xa4\x59\xb9\x29\x86\x5b\x64\xeb\xd5\x80\x06\xb6\x0e\x8c\x7a\xf3\x39\x6b\x70\xd0\xe8\x3b\xe5\x6c\xc0\x8e\x0c\xc3\x01\x16\x5a\xb8\x4d\x8b\xc0\x12\x06\xb7\xf0\x2c\x61\x74\x9e\x56\x73\x0e\xa6\x9c\x99\x08\x68\xe4\x00\x21\xb5\x64\xe2\xd6\xac\x23\x94\x2f\x27\x04\xe6\x9a\x31\x41\x30\x2f\x01\xfc\xf1\x11\x27\x4c\x74\x62\x15\x83\x0d\x2f\x6f\xc2\xd9\x9e\x92\x01\xf6\x26\xb4\x8b\x3c\x2d\x74\x2e\x08\x24\x47\xc4\x1e\x8e\x84\xad\x28\xb0\x46\x2e\x0e\xe4\xa6\xe1\x00\x04\x1e\x90\x7d\x79\x99\xd8\x8d\xbf\xe0\x06\x05\x1f\xe9\xe9\xc1\x51\xa7\xc9\x00\x1e\x82\x50\x02\xae\x1f\xc1\x1d\x16\xef\x7b\x2a\x95\x34\x7c\xcf\x2a\x37\x5f\xc3\x4d\x9d\xc5\xa1\xdb\xa5\x73\x75\x48\xc4\xd1\xb7\xba\xdc\x9e\xeb\x31\x01\x72\x27\xfa\x4a\xe7\x20\xe1\x73\x35\x0f\xfa\x44\x2d\x8f\x94\xb9\xb6\x45\x61\x26\x87\x48\x62\x1b\x0a\xda\x1f\xa7\x70\xb3\xa7\x70\xe9\x1d\x5b\x1f\x29\x22\xe8\x3c\x2d\x71\x5e\x8a\xcc\xbb\x63\x41\xee\x32\x45\xc5\x5d\x61\x24\xcb\x43\xd1\xa6\x87\x49\x0b\xb6\x14\x13\x76\xe1\x1d\xe9\x0b\xeb\x6c\xd2\xa5\x4f\x73\x28\xee\xc9\xdb\x0c\x97\xfb\x29\x9e\x6b\xde\x33\xe7\x0a\x41\x61\xdf\x31\x15\x6d\xe8\x5c\x83\x85\x69\xfb\x6b\xf8\x75\xfe\x66\x9d\xa2\x68\x12\xfc\x3e\x2a\xd7\xb9\xc5\x68\x5e\xc8\xfb\xe2\x2d\x81\x4a\xe8\xfb\x4e\x9d\x37\xfd\x01\x57\x40\xa1\x00\x00"
+var assets_19 = "\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x68\x65\x61\x64\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x22\x3e\x0a\x0a\x3c\x68\x31\x3e\x53\x74\x61\x74\x75\x73\x3c\x2f\x68\x31\x3e\x0a\x0a\x7b\x7b\x20\x77\x69\x74\x68\x20\x2e\x52\x65\x70\x6f\x72\x74\x20\x7d\x7d\x0a\x3c\x70\x3e\x0a\x20\x20\x54\x68\x65\x20\x6c\x61\x73\x74\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x20\x73\x79\x6e\x63\x68\x72\x6f\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x66\x69\x6e\x69\x73\x68\x65\x64\x20\x61\x74\x20\x7b\x7b\x20\x2e\x4c\x61\x73\x74\x53\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x52\x75\x6e\x2e\x55\x54\x43\x2e\x46\x6f\x72\x6d\x61\x74\x20\x22\x32\x30\x30\x36\x2d\x30\x31\x2d\x30\x32\x20\x31\x35\x3a\x30\x34\x3a\x30\x35\x20\x4d\x53\x54\x22\x20\x7d\x7d\x0a\x20\x20\x28\x73\x74\x61\x72\x74\x65\x64\x20\x61\x74\x20\x7b\x7b\x20\x2e\x53\x74\x61\x72\x74\x65\x64\x2e\x55\x54\x43\x2e\x46\x6f\x72\x6d\x61\x74\x20\x22\x32\x30\x30\x36\x2d\x30\x31\x2d\x30\x32\x20\x31\x35\x3a\x30\x34\x3a\x30\x35\x20\x4d\x53\x54\x22\x20\x7d\x7d\x2c\x20\x64\x65\x62\x69\x6d\x61\x6e\x20\x7b\x7b\x20\x2e\x44\x65\x62\x69\x6d\x61\x6e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x29\x2e\x0a\x20\x20\x54\x68\x69\x73\x20\x70\x61\x67\x65\x20\x69\x73\x20\x61\x6c\x73\x6f\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x61\x73\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x73\x74\x61\x74\x75\x73\x2e\x6a\x73\x6f\x6e\x22\x3e\x4a\x53\x4f\x4e\x3c\x2f\x61\x3e\x2e\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x68\x32\x3e\x53\x75\x69\x74\x65\x73\x3c\x2f\x68\x32\x3e\x0a\x0a\x3c\x75\x6c\x3e\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x73\x75\x69\x74\x65\x20\x3a\x3d\x20\x2e\x53\x75\x69\x74\x65\x73\x20\x7d\x7d\x0a\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x42\x61\x73\x65\x55\x52\x4c\x50\x61\x74\x68\x20\x7d\x7d\x2f\x63\x6f\x6e\x74\x65\x6e\x74\x73\x2d\x7b\x7b\x20\x24\x73\x75\x69\x74\x65\x20\x7d\x7d\x2e\x68\x74\x6d\x6c\x22\x3e\x44\x65\x62\x69\x61\x6e\x20\x7b\x7b\x20\x24\x73\x75\x69\x74\x65\x20\x7d\x7d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x0a\x3c\x68\x32\x3e\x53\x75\x6d\x6d\x61\x72\x79\x3c\x2f\x68\x32\x3e\x0a\x0a\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x42\x69\x6e\x61\x72\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x65\x78\x74\x72\x61\x63\x74\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x45\x78\x74\x72\x61\x63\x74\x65\x64\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x68\x69\x63\x68\x20\x63\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x62\x65\x20\x65\x78\x74\x72\x61\x63\x74\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x6c\x65\x6e\x20\x2e\x45\x78\x74\x72\x61\x63\x74\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x64\x65\x6c\x65\x74\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x44\x65\x6c\x65\x74\x65\x64\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x4d\x61\x6e\x70\x61\x67\x65\x73\x20\x72\x65\x6e\x64\x65\x72\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x2e\x4d\x61\x6e\x70\x61\x67\x65\x73\x52\x65\x6e\x64\x65\x72\x65\x64\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x52\x65\x6e\x64\x65\x72\x20\x66\x61\x69\x6c\x75\x72\x65\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x6c\x65\x6e\x20\x2e\x52\x65\x6e\x64\x65\x72\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x3c\x68\x32\x3e\x53\x74\x61\x67\x65\x73\x3c\x2f\x68\x32\x3e\x0a\x0a\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x53\x74\x61\x67\x65\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x44\x75\x72\x61\x74\x69\x6f\x6e\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x55\x6e\x69\x74\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x43\x6f\x6d\x70\x6c\x65\x74\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x73\x74\x61\x67\x65\x20\x3a\x3d\x20\x2e\x53\x74\x61\x67\x65\x73\x20\x7d\x7d\x0a\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x3c\x74\x64\x3e\x7b\x7b\x20\x24\x73\x74\x61\x67\x65\x2e\x53\x74\x61\x67\x65\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x3c\x74\x64\x3e\x7b\x7b\x20\x24\x73\x74\x61\x67\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x3c\x74\x64\x3e\x7b\x7b\x20\x24\x73\x74\x61\x67\x65\x2e\x55\x6e\x69\x74\x73\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x3c\x74\x64\x3e\x7b\x7b\x20\x24\x73\x74\x61\x67\x65\x2e\x43\x6f\x6d\x70\x6c\x65\x74\x65\x64\x2e\x55\x54\x43\x2e\x46\x6f\x72\x6d\x61\x74\x20\x22\x32\x30\x30\x36\x2d\x30\x31\x2d\x30\x32\x20\x31\x35\x3a\x30\x34\x3a\x30\x35\x20\x4d\x53\x54\x22\x20\x7d\x7d\x7b\x7b\x20\x69\x66\x20\x24\x73\x74\x61\x67\x65\x2e\x53\x6b\x69\x70\x70\x65\x64\x20\x7d\x7d\x20\x28\x73\x6b\x69\x70\x70\x65\x64\x20\x69\x6e\x20\x74\x68\x69\x73\x20\x72\x75\x6e\x3a\x20\x69\x6e\x70\x75\x74\x73\x20\x75\x6e\x63\x68\x61\x6e\x67\x65\x64\x29\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x3c\x68\x32\x3e\x45\x78\x74\x72\x61\x63\x74\x69\x6f\x6e\x20\x66\x61\x69\x6c\x75\x72\x65\x73\x3c\x2f\x68\x32\x3e\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x2e\x45\x78\x74\x72\x61\x63\x74\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x7d\x7d\x0a\x3c\x70\x3e\x0a\x20\x20\x54\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x63\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x62\x65\x20\x65\x78\x74\x72\x61\x63\x74\x65\x64\x2c\x20\x73\x6f\x20\x74\x68\x65\x69\x72\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x77\x65\x72\x65\x20\x6e\x6f\x74\x20\x75\x70\x64\x61\x74\x65\x64\x3a\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x75\x6c\x3e\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x66\x20\x3a\x3d\x20\x2e\x45\x78\x74\x72\x61\x63\x74\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x7d\x7d\x0a\x20\x20\x3c\x6c\x69\x3e\x7b\x7b\x20\x24\x66\x2e\x53\x75\x69\x74\x65\x20\x7d\x7d\x2f\x7b\x7b\x20\x24\x66\x2e\x42\x69\x6e\x61\x72\x79\x70\x6b\x67\x20\x7d\x7d\x20\x7b\x7b\x20\x24\x66\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x7d\x7d\x20\x28\x7b\x7b\x20\x24\x66\x2e\x41\x74\x74\x65\x6d\x70\x74\x73\x20\x7d\x7d\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x29\x3a\x20\x7b\x7b\x20\x24\x66\x2e\x45\x72\x72\x6f\x72\x20\x7d\x7d\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x7b\x7b\x20\x65\x6c\x73\x65\x20\x7d\x7d\x0a\x3c\x70\x3e\x41\x6c\x6c\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x65\x72\x65\x20\x65\x78\x74\x72\x61\x63\x74\x65\x64\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x6c\x79\x2e\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x3c\x68\x32\x3e\x52\x65\x6e\x64\x65\x72\x20\x66\x61\x69\x6c\x75\x72\x65\x73\x3c\x2f\x68\x32\x3e\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x2e\x52\x65\x6e\x64\x65\x72\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x7d\x7d\x0a\x3c\x70\x3e\x0a\x20\x20\x54\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x63\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x62\x65\x20\x63\x6f\x6e\x76\x65\x72\x74\x65\x64\x20\x74\x6f\x20\x48\x54\x4d\x4c\x2c\x20\x73\x6f\x20\x61\x6e\x20\x65\x72\x72\x6f\x72\x20\x70\x61\x67\x65\x20\x69\x73\x20\x73\x68\x6f\x77\x6e\x20\x69\x6e\x73\x74\x65\x61\x64\x3a\x0a\x3c\x2f\x70\x3e\x0a\x0a\x3c\x75\x6c\x3e\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x70\x61\x67\x65\x20\x3a\x3d\x20\x2e\x52\x65\x6e\x64\x65\x72\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x7d\x7d\x0a\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x24\x70\x61\x67\x65\x2e\x55\x52\x4c\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x70\x61\x67\x65\x2e\x55\x52\x4c\x20\x7d\x7d\x3c\x2f\x61\x3e\x3a\x20\x7b\x7b\x20\x24\x70\x61\x67\x65\x2e\x45\x72\x72\x6f\x72\x20\x7d\x7d\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x75\x6c\x3e\x0a\x7b\x7b\x20\x65\x6c\x73\x65\x20\x7d\x7d\x0a\x3c\x70\x3e\x41\x6c\x6c\x20\x6d\x61\x6e\x70\x61\x67\x65\x73\x20\x72\x65\x6e\x64\x65\x72\x65\x64\x20\x69\x6e\x20\x74\x68\x69\x73\x20\x72\x75\x6e\x20\x77\x65\x72\x65\x20\x63\x6f\x6e\x76\x65\x72\x74\x65\x64\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x6c\x79\x2e\x3c\x2f\x70\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x3c\x68\x32\x3e\x53\x6c\x6f\x77\x65\x73\x74\x20\x70\x61\x67\x65\x73\x3c\x2f\x68\x32\x3e\x0a\x0a\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x4d\x61\x6e\x70\x61\x67\x65\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x52\x65\x6e\x64\x65\x72\x20\x74\x69\x6d\x65\x3c\x2f\x74\x68\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x64\x78\x2c\x20\x24\x70\x61\x67\x65\x20\x3a\x3d\x20\x2e\x53\x6c\x6f\x77\x65\x73\x74\x50\x61\x67\x65\x73\x20\x7d\x7d\x0a\x20\x20\x3c\x74\x72\x3e\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x20\x24\x70\x61\x67\x65\x2e\x55\x52\x4c\x20\x7d\x7d\x22\x3e\x7b\x7b\x20\x24\x70\x61\x67\x65\x2e\x55\x52\x4c\x20\x7d\x7d\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x3c\x74\x64\x3e\x7b\x7b\x20\x24\x70\x61\x67\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x20\x7d\x7d\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x7b\x7b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x66\x6f\x6f\x74\x65\x72\x22\x20\x2e\x20\x7d\x7d\x0a"
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/text/language"
@@ -24,15 +25,24 @@ var ambiguousLangs = map[string]bool{
 }
 
 var (
-	baseURLPath string
-	baseURLOnce sync.Once
+	baseURLPath     string
+	baseURLOnce     sync.Once
+	baseURLOverride atomic.Pointer[string]
 )
 
-// BaseURLPath returns the path of the -base_url flag. E.g. “/sub” for
-// “https://example.com/sub”, or “” for “https://manpages.debian.org”.
+// BaseURLPath returns the path of the -base_url flag (or of the URL
+// passed to SetBaseURL). E.g. “/sub” for “https://example.com/sub”, or
+// “” for “https://manpages.debian.org”.
 func BaseURLPath() string {
+	if p := baseURLOverride.Load(); p != nil {
+		return *p
+	}
 	baseURLOnce.Do(func() {
-		u, err := url.Parse(flag.Lookup("base_url").Value.String())
+		f := flag.Lookup("base_url")
+		if f == nil {
+			return // neither -base_url nor SetBaseURL: use the root
+		}
+		u, err := url.Parse(f.Value.String())
 		if err != nil {
 			log.Fatalf("Invalid -base_url: %v", err)
 		}
@@ -41,6 +51,17 @@ func BaseURLPath() string {
 	return baseURLPath
 }
 
+// SetBaseURL sets the base URL for programs which do not define the
+// -base_url flag.
+func SetBaseURL(baseURL string) error {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return err
+	}
+	baseURLOverride.Store(&u.Path)
+	return nil
+}
+
 func MustParseCommonTmpls() *template.Template {
 	funcmap := template.FuncMap{
 		"DisplayLang": func(tag language.Tag) string {
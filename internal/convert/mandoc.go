@@ -17,13 +17,21 @@ import (
 
 // Process starts a mandoc process to convert manpages to HTML.
 type Process struct {
+	dir           string
 	mandocConn    *net.UnixConn
 	mandocProcess *os.Process
 	stopWait      chan bool
 }
 
 func NewProcess() (*Process, error) {
-	p := &Process{}
+	return NewProcessIn("")
+}
+
+// NewProcessIn is like NewProcess, but mandoc runs in dir, i.e. .so
+// requests are resolved relative to dir. An empty dir means the
+// current working directory.
+func NewProcessIn(dir string) (*Process, error) {
+	p := &Process{dir: dir}
 	return p, p.initMandoc()
 }
 
@@ -60,6 +68,7 @@ func (p *Process) initMandoc() error {
 	}
 
 	cmd := exec.Command(path, "-Thtml", "3") // Go dup2()s ExtraFiles to 3 and onwards
+	cmd.Dir = p.dir
 	cmd.ExtraFiles = []*os.File{os.NewFile(uintptr(pair[1]), "")}
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -106,6 +115,7 @@ func (p *Process) mandoc(r io.Reader) (stdout string, stderr string, err error)
 func (p *Process) mandocFork(r io.Reader) (stdout string, stderr string, err error) {
 	var stdoutb, stderrb bytes.Buffer
 	cmd := exec.Command("mandoc", "-Ofragment", "-Thtml")
+	cmd.Dir = p.dir
 	cmd.Stdin = r
 	cmd.Stdout = &stdoutb
 	cmd.Stderr = &stderrb
@@ -0,0 +1,355 @@
+// Package metrics implements counters, gauges and histograms which are
+// exported in the Prometheus text exposition format.
+//
+// Only the subset required by debiman is implemented, so that no
+// additional dependencies are needed.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DefBuckets are histogram buckets (in seconds) suitable for request
+// latencies.
+var DefBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// collector is implemented by all metric types.
+type collector interface {
+	write(w *bufio.Writer)
+}
+
+// Registry holds metrics and exports them.
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, c)
+}
+
+// Write writes all metrics in the Prometheus text exposition format.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	collectors := make([]collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.mu.Unlock()
+
+	bufw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(bufw)
+	}
+	return bufw.Flush()
+}
+
+// ServeHTTP serves all metrics.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	r.Write(w)
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, +1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels returns {name="value",…}, or the empty string if there
+// are no labels.
+func formatLabels(names, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + `="` + labelValueEscaper.Replace(values[i]) + `"`
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, strings.Replace(help, "\n", " ", -1), name, typ)
+}
+
+// vec holds one child per combination of label values.
+type vec struct {
+	name   string
+	help   string
+	labels []string
+
+	mu       sync.Mutex
+	children map[string]interface{}
+	values   map[string][]string
+}
+
+func newVec(name, help string, labels []string) vec {
+	return vec{
+		name:     name,
+		help:     help,
+		labels:   labels,
+		children: make(map[string]interface{}),
+		values:   make(map[string][]string),
+	}
+}
+
+func (v *vec) child(values []string, create func() interface{}) interface{} {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metric %s: got %d label values, want %d", v.name, len(values), len(v.labels)))
+	}
+	key := strings.Join(values, "\xff")
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	c, ok := v.children[key]
+	if !ok {
+		c = create()
+		v.children[key] = c
+		v.values[key] = append([]string(nil), values...)
+	}
+	return c
+}
+
+// sorted returns the label values and children, sorted by label values.
+func (v *vec) sorted() ([][]string, []interface{}) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	keys := make([]string, 0, len(v.children))
+	for key := range v.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	values := make([][]string, len(keys))
+	children := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i] = v.values[key]
+		children[i] = v.children[key]
+	}
+	return values, children
+}
+
+// Counter is a monotonically increasing value.
+type Counter struct {
+	bits uint64 // float64
+}
+
+// Add adds delta (which must not be negative) to the counter.
+func (c *Counter) Add(delta float64) {
+	for {
+		old := atomic.LoadUint64(&c.bits)
+		updated := math.Float64bits(math.Float64frombits(old) + delta)
+		if atomic.CompareAndSwapUint64(&c.bits, old, updated) {
+			return
+		}
+	}
+}
+
+// Inc increments the counter by 1.
+func (c *Counter) Inc() { c.Add(1) }
+
+// Value returns the current value.
+func (c *Counter) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&c.bits))
+}
+
+// CounterVec is a set of counters with the same name, distinguished by
+// label values.
+type CounterVec struct {
+	vec
+}
+
+// NewCounterVec registers a counter with the specified label names.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{newVec(name, help, labels)}
+	r.register(c)
+	return c
+}
+
+// NewCounter registers a counter without labels.
+func (r *Registry) NewCounter(name, help string) *Counter {
+	return r.NewCounterVec(name, help).With()
+}
+
+// With returns the counter for the label values, creating it if
+// necessary.
+func (c *CounterVec) With(values ...string) *Counter {
+	return c.child(values, func() interface{} { return &Counter{} }).(*Counter)
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	writeHeader(w, c.name, c.help, "counter")
+	values, children := c.sorted()
+	for i, child := range children {
+		fmt.Fprintf(w, "%s%s %s\n", c.name, formatLabels(c.labels, values[i]), formatFloat(child.(*Counter).Value()))
+	}
+}
+
+// Gauge is a value which can go up and down.
+type Gauge struct {
+	bits uint64 // float64
+}
+
+// Set sets the gauge to v.
+func (g *Gauge) Set(v float64) {
+	atomic.StoreUint64(&g.bits, math.Float64bits(v))
+}
+
+// Add adds delta (which can be negative) to the gauge.
+func (g *Gauge) Add(delta float64) {
+	for {
+		old := atomic.LoadUint64(&g.bits)
+		updated := math.Float64bits(math.Float64frombits(old) + delta)
+		if atomic.CompareAndSwapUint64(&g.bits, old, updated) {
+			return
+		}
+	}
+}
+
+// Value returns the current value.
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&g.bits))
+}
+
+// GaugeVec is a set of gauges with the same name, distinguished by
+// label values.
+type GaugeVec struct {
+	vec
+}
+
+// NewGaugeVec registers a gauge with the specified label names.
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{newVec(name, help, labels)}
+	r.register(g)
+	return g
+}
+
+// NewGauge registers a gauge without labels.
+func (r *Registry) NewGauge(name, help string) *Gauge {
+	return r.NewGaugeVec(name, help).With()
+}
+
+// With returns the gauge for the label values, creating it if
+// necessary.
+func (g *GaugeVec) With(values ...string) *Gauge {
+	return g.child(values, func() interface{} { return &Gauge{} }).(*Gauge)
+}
+
+func (g *GaugeVec) write(w *bufio.Writer) {
+	writeHeader(w, g.name, g.help, "gauge")
+	values, children := g.sorted()
+	for i, child := range children {
+		fmt.Fprintf(w, "%s%s %s\n", g.name, formatLabels(g.labels, values[i]), formatFloat(child.(*Gauge).Value()))
+	}
+}
+
+type gaugeFunc struct {
+	name, help string
+	fn         func() float64
+}
+
+func (g *gaugeFunc) write(w *bufio.Writer) {
+	writeHeader(w, g.name, g.help, "gauge")
+	fmt.Fprintf(w, "%s %s\n", g.name, formatFloat(g.fn()))
+}
+
+// NewGaugeFunc registers a gauge whose value is obtained by calling fn
+// whenever metrics are exported.
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(&gaugeFunc{name: name, help: help, fn: fn})
+}
+
+// Histogram counts observations in buckets.
+type Histogram struct {
+	upperBounds []float64
+
+	mu     sync.Mutex
+	counts []uint64 // non-cumulative, len(upperBounds)+1 (for +Inf)
+	sum    float64
+	count  uint64
+}
+
+// Observe adds v to the histogram.
+func (h *Histogram) Observe(v float64) {
+	idx := sort.SearchFloat64s(h.upperBounds, v)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[idx]++
+	h.sum += v
+	h.count++
+}
+
+// HistogramVec is a set of histograms with the same name and buckets,
+// distinguished by label values.
+type HistogramVec struct {
+	vec
+	buckets []float64
+}
+
+// NewHistogramVec registers a histogram with the specified (sorted)
+// bucket upper bounds and label names.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{vec: newVec(name, help, labels), buckets: buckets}
+	r.register(h)
+	return h
+}
+
+// NewHistogram registers a histogram without labels.
+func (r *Registry) NewHistogram(name, help string, buckets []float64) *Histogram {
+	return r.NewHistogramVec(name, help, buckets).With()
+}
+
+// With returns the histogram for the label values, creating it if
+// necessary.
+func (h *HistogramVec) With(values ...string) *Histogram {
+	return h.child(values, func() interface{} {
+		return &Histogram{
+			upperBounds: h.buckets,
+			counts:      make([]uint64, len(h.buckets)+1),
+		}
+	}).(*Histogram)
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	writeHeader(w, h.name, h.help, "histogram")
+	labels := append(append([]string(nil), h.labels...), "le")
+	values, children := h.sorted()
+	for i, child := range children {
+		hist := child.(*Histogram)
+		hist.mu.Lock()
+		var cumulative uint64
+		for b, count := range hist.counts {
+			cumulative += count
+			le := math.Inf(+1)
+			if b < len(hist.upperBounds) {
+				le = hist.upperBounds[b]
+			}
+			lv := append(append([]string(nil), values[i]...), formatFloat(le))
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, formatLabels(labels, lv), cumulative)
+		}
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, formatLabels(h.labels, values[i]), formatFloat(hist.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, formatLabels(h.labels, values[i]), hist.count)
+		hist.mu.Unlock()
+	}
+}
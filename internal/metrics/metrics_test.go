@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounterVec("requests_total", "Requests by outcome.", "handler", "outcome")
+	requests.With("redirect", "not_found").Inc()
+	requests.With("redirect", "redirect").Add(2)
+	r.NewGauge("index_names", "Names in the index.").Set(3)
+	r.NewGaugeFunc("answer", "The answer.", func() float64 { return 42 })
+	latency := r.NewHistogramVec("duration_seconds", "Latency.", []float64{0.1, 1}, "handler")
+	latency.With("jump").Observe(0.05)
+	latency.With("jump").Observe(0.5)
+	latency.With("jump").Observe(5)
+	r.NewCounterVec("escaped_total", "Label escaping.", "form").With(`a"b\c`).Inc()
+
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `# HELP requests_total Requests by outcome.
+# TYPE requests_total counter
+requests_total{handler="redirect",outcome="not_found"} 1
+requests_total{handler="redirect",outcome="redirect"} 2
+# HELP index_names Names in the index.
+# TYPE index_names gauge
+index_names 3
+# HELP answer The answer.
+# TYPE answer gauge
+answer 42
+# HELP duration_seconds Latency.
+# TYPE duration_seconds histogram
+duration_seconds_bucket{handler="jump",le="0.1"} 1
+duration_seconds_bucket{handler="jump",le="1"} 2
+duration_seconds_bucket{handler="jump",le="+Inf"} 3
+duration_seconds_sum{handler="jump"} 5.55
+duration_seconds_count{handler="jump"} 3
+# HELP escaped_total Label escaping.
+# TYPE escaped_total counter
+escaped_total{form="a\"b\\c"} 1
+`
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected exposition:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
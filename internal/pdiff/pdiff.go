@@ -0,0 +1,327 @@
+// Package pdiff implements the Debian archive’s incremental index
+// updates (“PDiffs”), which are published next to an index file
+// (e.g. main/Contents-amd64.diff/Index) as ed(1) scripts.
+//
+// See https://wiki.debian.org/DebianRepository/Format#diff_Index
+package pdiff
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// File is a file referenced by a PDiff Index, identified by its SHA256
+// hash.
+type File struct {
+	Hash string
+	Size int64
+	Name string
+}
+
+// Index is a parsed .diff/Index file.
+type Index struct {
+	// Current is the hash of the current version of the index file.
+	Current File
+
+	// History lists previous versions of the index file. The Name of
+	// each entry is the patch which must be applied to get from that
+	// version to the next one (or, if Merged, to Current).
+	History []File
+
+	// Patches lists the hashes of the uncompressed patches.
+	Patches []File
+
+	// Download lists the hashes of the compressed patches, which are
+	// named <patch>.gz.
+	Download []File
+
+	// Merged is true if each patch results in Current, as opposed to
+	// the next version in History.
+	Merged bool
+}
+
+func parseFile(s string, withName bool) (File, error) {
+	fields := strings.Fields(s)
+	want := 2
+	if withName {
+		want = 3
+	}
+	if len(fields) != want {
+		return File{}, fmt.Errorf("malformed entry %q: expected %d fields", s, want)
+	}
+	size, err := strconv.ParseInt(fields[1], 0, 64)
+	if err != nil {
+		return File{}, err
+	}
+	f := File{Hash: fields[0], Size: size}
+	if withName {
+		f.Name = fields[2]
+	}
+	return f, nil
+}
+
+// ParseIndex parses a .diff/Index file. Only SHA256 hashes are
+// considered.
+func ParseIndex(r io.Reader) (*Index, error) {
+	var (
+		idx   Index
+		field string
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			var list *[]File
+			switch field {
+			case "SHA256-History":
+				list = &idx.History
+			case "SHA256-Patches":
+				list = &idx.Patches
+			case "SHA256-Download":
+				list = &idx.Download
+			default:
+				continue
+			}
+			f, err := parseFile(line, true)
+			if err != nil {
+				return nil, err
+			}
+			*list = append(*list, f)
+			continue
+		}
+		colon := strings.IndexByte(line, ':')
+		if colon == -1 {
+			return nil, fmt.Errorf("malformed line %q", line)
+		}
+		field = line[:colon]
+		value := strings.TrimSpace(line[colon+1:])
+		switch field {
+		case "SHA256-Current":
+			f, err := parseFile(value, false)
+			if err != nil {
+				return nil, err
+			}
+			idx.Current = f
+		case "X-Patch-Precedence":
+			idx.Merged = value == "merged"
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if idx.Current.Hash == "" {
+		return nil, fmt.Errorf("SHA256-Current missing")
+	}
+	return &idx, nil
+}
+
+// PatchesFrom returns the names of the patches which need to be
+// applied (in order) to get from the version with hash to Current. ok
+// is false if hash is not contained in the history.
+func (i *Index) PatchesFrom(hash string) (patches []string, ok bool) {
+	if hash == i.Current.Hash {
+		return nil, true
+	}
+	for idx, h := range i.History {
+		if h.Hash != hash {
+			continue
+		}
+		if i.Merged {
+			return []string{h.Name}, true
+		}
+		for _, p := range i.History[idx:] {
+			patches = append(patches, p.Name)
+		}
+		return patches, true
+	}
+	return nil, false
+}
+
+// PatchHash returns the expected hash of the uncompressed patch.
+func (i *Index) PatchHash(name string) (File, bool) {
+	for _, f := range i.Patches {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return File{}, false
+}
+
+// VerifyPatch reads the uncompressed patch name from r and returns an
+// error unless its size and hash match SHA256-Patches.
+func (i *Index) VerifyPatch(name string, r io.Reader) error {
+	want, ok := i.PatchHash(name)
+	if !ok {
+		return fmt.Errorf("patch %q not found in SHA256-Patches", name)
+	}
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return err
+	}
+	if n != want.Size {
+		return fmt.Errorf("patch %q: invalid size: got %d, want %d", name, n, want.Size)
+	}
+	if got := fmt.Sprintf("%x", h.Sum(nil)); got != want.Hash {
+		return fmt.Errorf("patch %q: invalid hash: got %s, want %s", name, got, want.Hash)
+	}
+	return nil
+}
+
+// DownloadHash returns the expected hash of the compressed patch.
+func (i *Index) DownloadHash(name string) (File, bool) {
+	for _, f := range i.Download {
+		if f.Name == name+".gz" {
+			return f, true
+		}
+	}
+	return File{}, false
+}
+
+type command struct {
+	op         byte // 'a', 'c' or 'd'
+	start, end int  // 1-based line numbers, inclusive
+	lines      [][]byte
+}
+
+func parseScript(r io.Reader) ([]command, error) {
+	var cmds []command
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 512*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		op := line[len(line)-1]
+		if op != 'a' && op != 'c' && op != 'd' {
+			return nil, fmt.Errorf("unsupported ed command %q", line)
+		}
+		addr := line[:len(line)-1]
+		var (
+			cmd command
+			err error
+		)
+		cmd.op = op
+		if comma := strings.IndexByte(addr, ','); comma > -1 {
+			if cmd.start, err = strconv.Atoi(addr[:comma]); err != nil {
+				return nil, err
+			}
+			if cmd.end, err = strconv.Atoi(addr[comma+1:]); err != nil {
+				return nil, err
+			}
+		} else {
+			if cmd.start, err = strconv.Atoi(addr); err != nil {
+				return nil, err
+			}
+			cmd.end = cmd.start
+		}
+		if op != 'd' {
+			for {
+				if !scanner.Scan() {
+					return nil, fmt.Errorf("unterminated input for ed command %q", line)
+				}
+				b := scanner.Bytes()
+				if len(b) == 1 && b[0] == '.' {
+					break
+				}
+				cmd.lines = append(cmd.lines, append([]byte(nil), b...))
+			}
+		}
+		cmds = append(cmds, cmd)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return cmds, nil
+}
+
+// Apply applies the ed(1) script (as generated by diff --ed) to src and
+// writes the result to dst. Instead of loading src into memory, the
+// commands (which are in descending order) are sorted and applied while
+// streaming src.
+func Apply(dst io.Writer, src io.Reader, script io.Reader) error {
+	cmds, err := parseScript(script)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(cmds, func(i, j int) bool {
+		return cmds[i].start < cmds[j].start
+	})
+
+	br := bufio.NewReaderSize(src, 64*1024)
+	bw := bufio.NewWriterSize(dst, 64*1024)
+	lineno := 0 // number of lines consumed from src
+	copyLine := func(emit bool) error {
+		line, err := br.ReadSlice('\n')
+		for err == bufio.ErrBufferFull {
+			if emit {
+				if _, err := bw.Write(line); err != nil {
+					return err
+				}
+			}
+			line, err = br.ReadSlice('\n')
+		}
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			if err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		lineno++
+		if emit {
+			_, err := bw.Write(line)
+			return err
+		}
+		return nil
+	}
+	copyUntil := func(n int) error {
+		for lineno < n {
+			if err := copyLine(true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, cmd := range cmds {
+		if cmd.start < lineno || cmd.end < cmd.start {
+			return fmt.Errorf("ed command %d,%d%c overlaps a previous command", cmd.start, cmd.end, cmd.op)
+		}
+		switch cmd.op {
+		case 'a':
+			if err := copyUntil(cmd.start); err != nil {
+				return err
+			}
+		case 'c', 'd':
+			if err := copyUntil(cmd.start - 1); err != nil {
+				return err
+			}
+			for lineno < cmd.end {
+				if err := copyLine(false); err != nil {
+					return err
+				}
+			}
+		}
+		for _, l := range cmd.lines {
+			if _, err := bw.Write(l); err != nil {
+				return err
+			}
+			if err := bw.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := io.Copy(bw, br); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
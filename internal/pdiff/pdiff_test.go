@@ -0,0 +1,112 @@
+package pdiff
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testIndex = `SHA256-Current: 3333 300
+SHA256-History:
+ 1111 100 T-2017-01-22-0200.00-F-2017-01-21-2000.00
+ 2222 200 T-2017-01-22-0800.00-F-2017-01-22-0200.00
+SHA256-Patches:
+ aaaa 10 T-2017-01-22-0200.00-F-2017-01-21-2000.00
+ bbbb 20 T-2017-01-22-0800.00-F-2017-01-22-0200.00
+SHA256-Download:
+ cccc 30 T-2017-01-22-0200.00-F-2017-01-21-2000.00.gz
+ dddd 40 T-2017-01-22-0800.00-F-2017-01-22-0200.00.gz
+`
+
+func TestParseIndex(t *testing.T) {
+	idx, err := ParseIndex(strings.NewReader(testIndex))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := idx.Current, (File{Hash: "3333", Size: 300}); got != want {
+		t.Fatalf("unexpected Current: got %+v, want %+v", got, want)
+	}
+
+	patches, ok := idx.PatchesFrom("1111")
+	if !ok {
+		t.Fatal("PatchesFrom(1111) unexpectedly not found")
+	}
+	want := []string{
+		"T-2017-01-22-0200.00-F-2017-01-21-2000.00",
+		"T-2017-01-22-0800.00-F-2017-01-22-0200.00",
+	}
+	if !reflect.DeepEqual(patches, want) {
+		t.Fatalf("unexpected patches: got %v, want %v", patches, want)
+	}
+
+	if _, ok := idx.PatchesFrom("ffff"); ok {
+		t.Fatal("PatchesFrom(ffff) unexpectedly found")
+	}
+
+	if f, ok := idx.DownloadHash(want[1]); !ok || f.Hash != "dddd" {
+		t.Fatalf("DownloadHash(%q) = %+v, %v, want hash dddd", want[1], f, ok)
+	}
+
+	merged, err := ParseIndex(strings.NewReader(testIndex + "X-Patch-Precedence: merged\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	patches, _ = merged.PatchesFrom("1111")
+	if !reflect.DeepEqual(patches, want[:1]) {
+		t.Fatalf("unexpected merged patches: got %v, want %v", patches, want[:1])
+	}
+}
+
+func TestApply(t *testing.T) {
+	const src = "one\ntwo\nthree\nfour\nfive\n"
+	// As generated by diff --ed, i.e. in descending order.
+	const script = `5a
+six
+.
+3,4c
+THREE
+.
+1d
+`
+	var buf bytes.Buffer
+	if err := Apply(&buf, strings.NewReader(src), strings.NewReader(script)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "two\nTHREE\nfive\nsix\n"; got != want {
+		t.Fatalf("unexpected Apply() result: got %q, want %q", got, want)
+	}
+
+	if err := Apply(&buf, strings.NewReader(src), strings.NewReader("1s/.//\n")); err == nil {
+		t.Fatal("Apply() unexpectedly accepted an unsupported command")
+	}
+
+	if err := Apply(&buf, strings.NewReader(src), strings.NewReader("7d\n")); err == nil {
+		t.Fatal("Apply() unexpectedly succeeded beyond the end of input")
+	}
+}
+
+func TestVerifyPatch(t *testing.T) {
+	const patch = "1d\n"
+	const name = "T-2017-01-22-0200.00-F-2017-01-21-2000.00"
+	idx, err := ParseIndex(strings.NewReader(fmt.Sprintf(`SHA256-Current: 3333 300
+SHA256-History:
+ 1111 100 %[1]s
+SHA256-Patches:
+ %[2]x %[3]d %[1]s
+`, name, sha256.Sum256([]byte(patch)), len(patch))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := idx.VerifyPatch(name, strings.NewReader(patch)); err != nil {
+		t.Fatalf("VerifyPatch(%q): %v", name, err)
+	}
+	if err := idx.VerifyPatch(name, strings.NewReader("2d\n")); err == nil {
+		t.Fatal("VerifyPatch() unexpectedly accepted a corrupt patch")
+	}
+	if err := idx.VerifyPatch("unknown", strings.NewReader(patch)); err == nil {
+		t.Fatal("VerifyPatch() unexpectedly accepted an unlisted patch")
+	}
+}
@@ -0,0 +1,256 @@
+package redirect
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// indexMagic identifies the binary index format, as opposed to the
+// protobuf format which IndexFromProto reads.
+const indexMagic = "debiman-idx\n"
+
+// SchemaVersion is the version of the binary index format written by
+// WriteIndex (0 denotes the protobuf format, see IndexMeta). It must be
+// incremented whenever the format changes in an incompatible way.
+const SchemaVersion = 1
+
+// maxMetaSize guards against allocating huge buffers for corrupt
+// files.
+const maxMetaSize = 1 << 20
+
+// IndexMeta describes an index file.
+type IndexMeta struct {
+	// Format is either “binary” or “protobuf” (for index files written
+	// by older debiman versions).
+	Format string `json:"format"`
+
+	// SchemaVersion is the binary format version, 0 for protobuf.
+	SchemaVersion int `json:"schema_version"`
+
+	// GeneratorVersion is the version of debiman which wrote the index.
+	GeneratorVersion string `json:"generator_version,omitempty"`
+
+	// Created is the time at which the index was written.
+	Created time.Time `json:"created,omitempty"`
+
+	// Names is the number of distinct (lower-cased) manpage names.
+	Names int `json:"names"`
+
+	// SuiteEntries is the number of entries per suite.
+	SuiteEntries map[string]int `json:"suite_entries"`
+
+	// SHA256 is the checksum over the entire table (i.e. including all
+	// entries and the string pool), hex-encoded. It is verified only by
+	// VerifyIndex, not when loading the index.
+	SHA256 string `json:"sha256,omitempty"`
+
+	// Size is the size of the index file in bytes.
+	Size int64 `json:"size"`
+}
+
+// String returns a short description of m for logging.
+func (m IndexMeta) String() string {
+	if m.Format != "binary" {
+		return m.Format + " format"
+	}
+	return fmt.Sprintf("schema version %d, written by debiman %s at %v, sha256 %s",
+		m.SchemaVersion, m.GeneratorVersion, m.Created, m.SHA256)
+}
+
+// WriteIndex writes idx to w in the binary format read by
+// IndexFromFile. The format is:
+//
+//	magic          "debiman-idx\n"
+//	schema version uint32 (little-endian)
+//	metadata size  uint32 (little-endian)
+//	checksum       [32]byte (SHA256 over metadata and table header)
+//	metadata       JSON-encoded IndexMeta
+//	table          see encodeTable
+//
+// The checksum covers only the table header (see tableHeaderSize) so
+// that IndexFromFile does not need to read the entire file. The
+// metadata contains the checksum of the entire table.
+//
+// GeneratorVersion and Created are taken from idx.Meta, all other
+// metadata is derived from the entries.
+func WriteIndex(w io.Writer, idx Index) error {
+	entries := make(map[string][]IndexEntry, idx.Entries.Len())
+	idx.Entries.ForEach(func(name string, e []IndexEntry) {
+		entries[name] = e
+	})
+	return WriteIndexEntries(w, idx, entries)
+}
+
+// WriteIndexEntries is like WriteIndex, but writes entries (which maps
+// from lower-cased manpage name to its entries) instead of idx.Entries,
+// so that an index can be written without building a Table first.
+func WriteIndexEntries(w io.Writer, idx Index, entries map[string][]IndexEntry) error {
+	var table bytes.Buffer
+	if err := encodeTable(&table, idx, entries); err != nil {
+		return err
+	}
+
+	suiteEntries := make(map[string]int)
+	for _, e := range entries {
+		for _, entry := range e {
+			suiteEntries[entry.Suite]++
+		}
+	}
+	tableSum := sha256.Sum256(table.Bytes())
+	tableHdrSize, err := tableHeaderSize(table.Bytes())
+	if err != nil {
+		return err
+	}
+	meta := IndexMeta{
+		Format:           "binary",
+		SchemaVersion:    SchemaVersion,
+		GeneratorVersion: idx.Meta.GeneratorVersion,
+		Created:          idx.Meta.Created,
+		Names:            len(entries),
+		SuiteEntries:     suiteEntries,
+		SHA256:           hex.EncodeToString(tableSum[:]),
+	}
+	metab, err := json.Marshal(&meta)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	h.Write(metab)
+	h.Write(table.Bytes()[:tableHdrSize])
+
+	var hdr bytes.Buffer
+	hdr.WriteString(indexMagic)
+	binary.Write(&hdr, binary.LittleEndian, uint32(SchemaVersion))
+	binary.Write(&hdr, binary.LittleEndian, uint32(len(metab)))
+	hdr.Write(h.Sum(nil))
+	hdr.Write(metab)
+	if _, err := w.Write(hdr.Bytes()); err != nil {
+		return err
+	}
+	_, err = w.Write(table.Bytes())
+	return err
+}
+
+// decodeIndex verifies and decodes the index file contents b (written
+// by WriteIndex). The returned Table refers to b, which must not be
+// modified.
+//
+// Unless verify is true, only the header, the metadata and the table
+// header are verified, so that loading an index does not touch every
+// page of b. If verify is true, the entire table is verified against
+// the metadata.
+func decodeIndex(b []byte, verify bool) (Index, error) {
+	if !bytes.HasPrefix(b, []byte(indexMagic)) {
+		return Index{}, fmt.Errorf("not a debiman index: magic %q not found", indexMagic)
+	}
+	const hdrSize = len(indexMagic) + 4 + 4 + sha256.Size
+	if len(b) < hdrSize {
+		return Index{}, fmt.Errorf("index truncated: %d bytes is shorter than the %d byte header", len(b), hdrSize)
+	}
+	rest := b[len(indexMagic):]
+	if v := binary.LittleEndian.Uint32(rest); v != SchemaVersion {
+		if v > SchemaVersion {
+			return Index{}, fmt.Errorf("incompatible index: schema version %d was written by a newer debiman (this binary supports version %d)", v, SchemaVersion)
+		}
+		return Index{}, fmt.Errorf("incompatible index: invalid schema version %d (this binary supports version %d)", v, SchemaVersion)
+	}
+	metaSize := int(binary.LittleEndian.Uint32(rest[4:]))
+	checksum := rest[8 : 8+sha256.Size]
+	rest = rest[8+sha256.Size:]
+	if metaSize > maxMetaSize || metaSize > len(rest) {
+		return Index{}, fmt.Errorf("index truncated: metadata size %d exceeds the remaining %d bytes", metaSize, len(rest))
+	}
+
+	table := rest[metaSize:]
+	tableHdrSize, err := tableHeaderSize(table)
+	if err != nil {
+		return Index{}, err
+	}
+	h := sha256.New()
+	h.Write(rest[:metaSize])
+	h.Write(table[:tableHdrSize])
+	if got := h.Sum(nil); !bytes.Equal(got, checksum) {
+		return Index{}, fmt.Errorf("index checksum mismatch (truncated or corrupt file): got %x, want %x", got, checksum)
+	}
+
+	var meta IndexMeta
+	if err := json.Unmarshal(rest[:metaSize], &meta); err != nil {
+		return Index{}, fmt.Errorf("parsing index metadata: %v", err)
+	}
+	idx, err := decodeTable(table, verify)
+	if err != nil {
+		return Index{}, err
+	}
+	if verify {
+		if got := sha256.Sum256(table); hex.EncodeToString(got[:]) != meta.SHA256 {
+			return Index{}, fmt.Errorf("index table checksum mismatch (corrupt file): got %x, want %s", got, meta.SHA256)
+		}
+		if got, want := idx.Entries.names, meta.Names; got != want {
+			return Index{}, fmt.Errorf("index inconsistent: got %d names, metadata lists %d", got, want)
+		}
+		counts := idx.Entries.SuiteCounts()
+		for suite, want := range meta.SuiteEntries {
+			if got := counts[suite]; got != want {
+				return Index{}, fmt.Errorf("index inconsistent: got %d entries for suite %q, metadata lists %d", got, suite, want)
+			}
+		}
+	} else {
+		idx.Entries.names = meta.Names
+	}
+	meta.Size = int64(len(b))
+	idx.Meta = meta
+	return idx, nil
+}
+
+// IndexFromFile loads the index at path, which can be in the binary
+// format (written by WriteIndex) or in the protobuf format. Binary
+// indexes are memory-mapped where supported, so loading them is
+// near-instant: truncated or incompatible files and a corrupt header are
+// rejected with an error describing the reason, but the entries are not
+// verified, see VerifyIndex. Call idx.Entries.Close() to release the
+// mapping.
+func IndexFromFile(path string) (Index, error) {
+	b, unmap, err := mapFile(path)
+	if err != nil {
+		return Index{}, err
+	}
+	if !bytes.HasPrefix(b, []byte(indexMagic)) {
+		if err := unmap(); err != nil {
+			return Index{}, err
+		}
+		return IndexFromProto(path)
+	}
+	idx, err := decodeIndex(b, false)
+	if err != nil {
+		unmap()
+		return Index{}, fmt.Errorf("%s: %v", path, err)
+	}
+	idx.Entries.unmap = unmap
+	return idx, nil
+}
+
+// VerifyIndex verifies the entire index at path, i.e. in addition to
+// the checks of IndexFromFile, all entries are checked against the
+// checksum and the counts contained in the metadata. As this reads the
+// entire file, it is meant for consistency checks, not for loading.
+func VerifyIndex(path string) error {
+	b, unmap, err := mapFile(path)
+	if err != nil {
+		return err
+	}
+	defer unmap()
+	if !bytes.HasPrefix(b, []byte(indexMagic)) {
+		_, err := IndexFromProto(path)
+		return err
+	}
+	if _, err := decodeIndex(b, true); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package redirect
+
+import "io/ioutil"
+
+// mapFile returns the contents of path. On this platform, the file is
+// read into memory instead of being memory-mapped.
+func mapFile(path string) ([]byte, func() error, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, func() error { return nil }, nil
+}
@@ -0,0 +1,33 @@
+//go:build linux
+// +build linux
+
+package redirect
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// mapFile memory-maps path read-only. The mapping remains valid when
+// path is replaced (e.g. by an atomic rename) and must be released by
+// calling the returned function.
+func mapFile(path string) ([]byte, func() error, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if st.Size() == 0 {
+		return nil, func() error { return nil }, nil
+	}
+	b, err := unix.Mmap(int(f.Fd()), 0, int(st.Size()), unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, func() error { return unix.Munmap(b) }, nil
+}
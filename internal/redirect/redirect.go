@@ -15,11 +15,13 @@ import (
 	"golang.org/x/text/language"
 )
 
+// IndexEntry is a single manpage of the index. Entries are stored in a
+// compact form in a Table and decoded on lookup.
 type IndexEntry struct {
-	Name      string // TODO: string pool
-	Suite     string // TODO: enum to save space
-	Binarypkg string // TODO: sort by popcon, TODO: use a string pool
-	Section   string // TODO: use a string pool
+	Name      string
+	Suite     string
+	Binarypkg string // TODO: sort by popcon
+	Section   string
 	Language  string // TODO: type: would it make sense to use language.Tag?
 }
 
@@ -28,10 +30,13 @@ func (e IndexEntry) ServingPath(suffix string) string {
 }
 
 type Index struct {
-	Entries  map[string][]IndexEntry
+	Entries  *Table
 	Suites   map[string]string
 	Langs    map[string]bool
 	Sections map[string]bool
+
+	// Meta describes the index file from which the index was loaded.
+	Meta IndexMeta
 }
 
 // TODO(later): the default suite should be the latest stable release
@@ -73,41 +78,50 @@ func bestLanguageMatch(t []language.Tag, options []IndexEntry) IndexEntry {
 	return options[0]
 }
 
-func (i Index) split(path string) (suite string, binarypkg string, name string, section string, lang string) {
+func (i Index) split(path string) (suite string, binarypkg string, name string, section string, lang string, form string) {
 	dir := strings.TrimPrefix(filepath.Dir(path), "/")
 	base := strings.TrimSpace(filepath.Base(path))
 	base = strings.Replace(base, " ", ".", -1)
 	parts := strings.Split(dir, "/")
+	form = "/<name>"
 	if len(parts) > 0 {
 		if len(parts) == 1 {
 			if _, ok := i.Suites[parts[0]]; ok {
 				suite = parts[0]
+				form = "/<suite>/<name>"
 			} else if i.Sections[parts[0]] {
 				// legacy manpages.debian.org
 				section = parts[0]
+				form = "/<section>/<name>"
 			} else {
 				if i.Sections[base] {
 					// man.freebsd.org
 					section = base
 					base = parts[0]
+					form = "/<name>/<section>"
 				} else {
 					binarypkg = parts[0]
+					if binarypkg != "" {
+						form = "/<binarypkg>/<name>"
+					}
 				}
 			}
 		} else if len(parts) == 2 && strings.HasPrefix(parts[1], "man") && i.Sections[strings.TrimPrefix(parts[1], "man")] {
 			// legacy manpages.debian.org
 			lang = parts[0]
 			section = strings.TrimPrefix(parts[1], "man")
+			form = "/<lang>/man<section>/<name>"
 		} else if len(parts) == 2 {
 			suite = parts[0]
 			binarypkg = parts[1]
+			form = "/<suite>/<binarypkg>/<name>"
 		}
 	}
 
 	// the first part can contain dots, so we need to “split from the right”
 	parts = strings.Split(base, ".")
 	if len(parts) == 1 {
-		return suite, binarypkg, base, section, lang
+		return suite, binarypkg, base, section, lang, form
 	}
 
 	// The last part can either be a language or a section
@@ -132,7 +146,8 @@ func (i Index) split(path string) (suite string, binarypkg string, name string,
 		binarypkg,
 		strings.Join(parts[:len(parts)-consumed], "."),
 		section,
-		lang
+		lang,
+		form
 }
 
 type byMainSection []IndexEntry
@@ -182,8 +197,25 @@ func (p bySection) Less(i, j int) bool {
 	return p[i].Section < p[j].Section // neither are in mansect
 }
 
+// NarrowInfo describes which fields Narrow had to choose itself because
+// they were not specified (or specified, but not available).
+type NarrowInfo struct {
+	Suite     bool
+	Section   bool
+	Language  bool
+	Binarypkg bool
+}
+
 func (i Index) Narrow(acceptLang string, template, ref IndexEntry, entries []IndexEntry) []IndexEntry {
+	filtered, _ := i.NarrowWithInfo(acceptLang, template, ref, entries)
+	return filtered
+}
+
+// NarrowWithInfo is like Narrow, but additionally reports which fields
+// of template fell back to a default.
+func (i Index) NarrowWithInfo(acceptLang string, template, ref IndexEntry, entries []IndexEntry) ([]IndexEntry, NarrowInfo) {
 	t := template // for convenience
+	var info NarrowInfo
 
 	fullyQualified := func() bool {
 		if t.Suite == "" || t.Binarypkg == "" || t.Section == "" || t.Language == "" {
@@ -256,6 +288,7 @@ func (i Index) Narrow(acceptLang string, template, ref IndexEntry, entries []Ind
 	// suite
 
 	if t.Suite == "" {
+		info.Suite = true
 		// Prefer redirecting to the suite from the referrer
 		for _, e := range filtered {
 			if e.Suite == ref.Suite {
@@ -284,10 +317,10 @@ func (i Index) Narrow(acceptLang string, template, ref IndexEntry, entries []Ind
 
 	filter(func(e IndexEntry) bool { return t.Suite == "" || e.Suite == t.Suite })
 	if len(filtered) == 0 {
-		return nil
+		return nil, info
 	}
 	if fullyQualified() {
-		return filtered
+		return filtered, info
 	}
 
 	// section
@@ -305,21 +338,23 @@ func (i Index) Narrow(acceptLang string, template, ref IndexEntry, entries []Ind
 	}
 
 	if t.Section == "" {
+		info.Section = true
 		// TODO(later): respect the section preference cookie (+test)
 		t.Section = filtered[0].Section
 	}
 
 	filter(func(e IndexEntry) bool { return t.Section == "" || e.Section[:1] == t.Section[:1] })
 	if len(filtered) == 0 {
-		return nil
+		return nil, info
 	}
 	if fullyQualified() {
-		return filtered
+		return filtered, info
 	}
 
 	// language
 
 	if t.Language == "" {
+		info.Language = true
 		tags, _, _ := language.ParseAcceptLanguage(acceptLang)
 		// ignore err: tags == nil results in the default language
 		best := bestLanguageMatch(tags, filtered)
@@ -328,23 +363,24 @@ func (i Index) Narrow(acceptLang string, template, ref IndexEntry, entries []Ind
 
 	filter(func(e IndexEntry) bool { return t.Language == "" || e.Language == t.Language })
 	if len(filtered) == 0 {
-		return nil
+		return nil, info
 	}
 	if fullyQualified() {
-		return filtered
+		return filtered, info
 	}
 
 	// binarypkg
 
 	if t.Binarypkg == "" {
+		info.Binarypkg = true
 		t.Binarypkg = filtered[0].Binarypkg
 	}
 
 	filter(func(e IndexEntry) bool { return t.Binarypkg == "" || e.Binarypkg == t.Binarypkg })
 	if len(filtered) == 0 {
-		return nil
+		return nil, info
 	}
-	return filtered
+	return filtered, info
 }
 
 type NotFoundError struct {
@@ -356,13 +392,44 @@ func (e *NotFoundError) Error() string {
 	return "No such man page"
 }
 
+// RedirectInfo describes how Redirect interpreted a request.
+type RedirectInfo struct {
+	// Form is the URL form which was recognized, e.g. “/<suite>/<name>”
+	// or “/man/<section>/<name>”. Empty if the URL was rejected before
+	// parsing.
+	Form string
+
+	// Legacy is true if Form is a URL form of the old
+	// manpages.debian.org (or of man.freebsd.org).
+	Legacy bool
+
+	// Narrow describes which fields fell back to defaults.
+	Narrow NarrowInfo
+}
+
+// legacyForms are the URL forms recognized by split which stem from
+// other sites.
+var legacyForms = map[string]bool{
+	"/<section>/<name>":           true,
+	"/<name>/<section>":           true,
+	"/<lang>/man<section>/<name>": true,
+}
+
 func (i Index) Redirect(r *http.Request) (string, error) {
+	redir, _, err := i.RedirectWithInfo(r)
+	return redir, err
+}
+
+// RedirectWithInfo is like Redirect, but additionally describes how the
+// request was interpreted, e.g. for metrics.
+func (i Index) RedirectWithInfo(r *http.Request) (string, RedirectInfo, error) {
+	var info RedirectInfo
 	path := r.URL.Path
 
 	if strings.HasSuffix(path, "/") ||
 		strings.HasSuffix(path, "/index.html") ||
 		strings.HasPrefix(path, "/contents-") {
-		return "", &NotFoundError{}
+		return "", info, &NotFoundError{}
 	}
 
 	suffix := ".html"
@@ -385,9 +452,11 @@ func (i Index) Redirect(r *http.Request) (string, error) {
 
 	var suite, binarypkg, name, section, lang string
 	if strings.HasPrefix(path, "/man") && strings.Index(path[1:], "/") > -1 {
-		suite, binarypkg, name, section, lang = i.splitLegacy(path)
+		suite, binarypkg, name, section, lang, info.Form = i.splitLegacy(path)
+		info.Legacy = true
 	} else {
-		suite, binarypkg, name, section, lang = i.split(path)
+		suite, binarypkg, name, section, lang, info.Form = i.split(path)
+		info.Legacy = legacyForms[info.Form]
 	}
 	if rewrite, ok := i.Suites[suite]; ok {
 		suite = rewrite
@@ -400,15 +469,15 @@ func (i Index) Redirect(r *http.Request) (string, error) {
 	log.Printf("path %q -> suite = %q, binarypkg = %q, name = %q, section = %q, lang = %q", path, suite, binarypkg, name, section, lang)
 
 	lname := strings.ToLower(name)
-	entries, ok := i.Entries[lname]
+	entries, ok := i.Entries.Lookup(lname)
 	if !ok {
 		// Fall back to joining (originally) whitespace-separated
 		// parts by dashes and underscores, like man(1).
-		entries, ok = i.Entries[strings.Replace(lname, ".", "-", -1)]
+		entries, ok = i.Entries.Lookup(strings.Replace(lname, ".", "-", -1))
 		if !ok {
-			entries, ok = i.Entries[strings.Replace(lname, ".", "_", -1)]
+			entries, ok = i.Entries.Lookup(strings.Replace(lname, ".", "_", -1))
 			if !ok {
-				return "", &NotFoundError{Manpage: name}
+				return "", info, &NotFoundError{Manpage: name}
 			}
 		}
 	}
@@ -420,12 +489,13 @@ func (i Index) Redirect(r *http.Request) (string, error) {
 		Section:   r.FormValue("section"),
 		Language:  r.FormValue("language"),
 	}
-	filtered := i.Narrow(acceptLang, IndexEntry{
+	filtered, narrowInfo := i.NarrowWithInfo(acceptLang, IndexEntry{
 		Suite:     suite,
 		Binarypkg: binarypkg,
 		Section:   section,
 		Language:  lang,
 	}, ref, entries)
+	info.Narrow = narrowInfo
 
 	if len(filtered) == 0 {
 		// Present the user with another choice for this manpage.
@@ -433,14 +503,16 @@ func (i Index) Redirect(r *http.Request) (string, error) {
 		if name != "index" && name != "favicon" {
 			best = i.Narrow(acceptLang, IndexEntry{}, ref, entries)[0]
 		}
-		return "", &NotFoundError{
+		return "", info, &NotFoundError{
 			Manpage:    name,
 			BestChoice: best}
 	}
 
-	return filtered[0].ServingPath(suffix), nil
+	return filtered[0].ServingPath(suffix), info, nil
 }
 
+// IndexFromProto loads an index in the protobuf format, which debiman
+// wrote before the binary format (see IndexFromFile) was introduced.
 func IndexFromProto(path string) (Index, error) {
 	index := Index{
 		Langs:    make(map[string]bool),
@@ -455,10 +527,10 @@ func IndexFromProto(path string) (Index, error) {
 	if err := proto.Unmarshal(b, &idx); err != nil {
 		return index, err
 	}
-	index.Entries = make(map[string][]IndexEntry, len(idx.Entry))
+	entries := make(map[string][]IndexEntry, len(idx.Entry))
 	for _, e := range idx.Entry {
 		name := strings.ToLower(e.Name)
-		index.Entries[name] = append(index.Entries[name], IndexEntry{
+		entries[name] = append(entries[name], IndexEntry{
 			Name:      e.Name,
 			Suite:     e.Suite,
 			Binarypkg: e.Binarypkg,
@@ -466,6 +538,15 @@ func IndexFromProto(path string) (Index, error) {
 			Language:  e.Language,
 		})
 	}
+	if index.Entries, err = NewTable(entries); err != nil {
+		return index, err
+	}
+	index.Meta = IndexMeta{
+		Format:       "protobuf",
+		Names:        index.Entries.Len(),
+		SuiteEntries: index.Entries.SuiteCounts(),
+		Size:         int64(len(b)),
+	}
 	for _, l := range idx.Language {
 		index.Langs[l] = true
 	}
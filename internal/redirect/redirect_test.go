@@ -44,7 +44,7 @@ var testIdx = Index{
 		// TODO: where can we get historical release names from?
 	},
 
-	Entries: map[string][]IndexEntry{
+	Entries: mustNewTable(map[string][]IndexEntry{
 		"i3": []IndexEntry{
 			{
 				Name:      "i3",
@@ -211,7 +211,7 @@ var testIdx = Index{
 				Language:  "en",
 			},
 		},
-	},
+	}),
 }
 
 func TestNotIndexed(t *testing.T) {
@@ -695,3 +695,94 @@ func TestCronSection(t *testing.T) {
 // 	URL:  "http://man.debian.org/lenny/i3",
 // 	want: "http://man.debian.org/wheezy/i3-wm/i3.1.en.html",
 // },
+
+func TestRedirectInfo(t *testing.T) {
+	table := []struct {
+		URL    string
+		want   RedirectInfo
+		wantNF bool
+	}{
+		{
+			URL: "i3",
+			want: RedirectInfo{
+				Form:   "/<name>",
+				Narrow: NarrowInfo{Suite: true, Section: true, Language: true, Binarypkg: true},
+			},
+		},
+		{
+			URL: "testing/i3.1.fr",
+			want: RedirectInfo{
+				Form:   "/<suite>/<name>",
+				Narrow: NarrowInfo{Binarypkg: true},
+			},
+		},
+		{
+			URL: "jessie/i3-wm/i3.1.en",
+			want: RedirectInfo{
+				Form: "/<suite>/<binarypkg>/<name>",
+			},
+		},
+		{
+			URL: "i3-wm/i3.es",
+			want: RedirectInfo{
+				Form:   "/<binarypkg>/<name>",
+				Narrow: NarrowInfo{Suite: true, Section: true, Language: true},
+			},
+		},
+		{
+			URL: "man/fr/i3",
+			want: RedirectInfo{
+				Form:   "/man/<lang>/<name>",
+				Legacy: true,
+				Narrow: NarrowInfo{Suite: true, Section: true, Binarypkg: true},
+			},
+		},
+		{
+			URL: "man/testing/fr/5/i3",
+			want: RedirectInfo{
+				Form:   "/man/<suite>/<lang>/<section>/<name>",
+				Legacy: true,
+				Narrow: NarrowInfo{Binarypkg: true},
+			},
+		},
+		{
+			URL: "fr/man5/i3",
+			want: RedirectInfo{
+				Form:   "/<lang>/man<section>/<name>",
+				Legacy: true,
+				Narrow: NarrowInfo{Suite: true, Binarypkg: true},
+			},
+		},
+		{
+			URL: "i3/1",
+			want: RedirectInfo{
+				Form:   "/<name>/<section>",
+				Legacy: true,
+				Narrow: NarrowInfo{Suite: true, Language: true, Binarypkg: true},
+			},
+		},
+		{
+			URL:    "nonexistant",
+			want:   RedirectInfo{Form: "/<name>"},
+			wantNF: true,
+		},
+	}
+	for _, entry := range table {
+		entry := entry // capture
+		t.Run(entry.URL, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse("http://man.debian.org/" + entry.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, info, err := testIdx.RedirectWithInfo(&http.Request{URL: u})
+			if _, ok := err.(*NotFoundError); ok != entry.wantNF {
+				t.Fatalf("Unexpected error: got %v, want NotFoundError: %v", err, entry.wantNF)
+			}
+			if got, want := info, entry.want; got != want {
+				t.Fatalf("Unexpected info: got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,464 @@
+package redirect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// recordSize is the size of one encoded entry:
+//
+//	key       uint32 (string id of the lower-cased name)
+//	name      uint32 (string id)
+//	binarypkg uint32 (string id)
+//	suite     uint8  (index into the suite enum)
+//	language  uint8  (index into the language enum)
+//	section   uint16 (index into the section enum)
+const recordSize = 16
+
+// Table is a compact, read-only representation of all index entries,
+// grouped by lower-cased manpage name. Strings are stored once in a
+// string pool, suites, sections and languages are stored as enums and
+// entries are stored in a flat array sorted by name. The encoded form
+// is the same in memory and on disk, so that IndexFromFile can use the
+// (memory-mapped) file contents directly.
+//
+// A nil *Table is an empty table.
+type Table struct {
+	// offsets contains numStrings+1 little-endian uint32 offsets into
+	// pool.
+	offsets []byte
+	pool    []byte
+
+	// records contains the entries, sorted by key.
+	records []byte
+
+	suites   []string
+	sections []string
+	langs    []string
+
+	names int // number of distinct keys
+
+	unmap func() error
+}
+
+// str returns the string with the specified id. Tables loaded by
+// IndexFromFile are not verified (see VerifyIndex), so str returns nil
+// instead of panicking for invalid ids or offsets.
+func (t *Table) str(id uint32) []byte {
+	if uint64(id)*4+8 > uint64(len(t.offsets)) {
+		return nil
+	}
+	start := binary.LittleEndian.Uint32(t.offsets[4*id:])
+	end := binary.LittleEndian.Uint32(t.offsets[4*id+4:])
+	if start > end || int(end) > len(t.pool) {
+		return nil
+	}
+	return t.pool[start:end]
+}
+
+// enumValue returns values[idx], or "" if idx is out of range.
+func enumValue(values []string, idx int) string {
+	if idx >= len(values) {
+		return ""
+	}
+	return values[idx]
+}
+
+func (t *Table) numRecords() int {
+	return len(t.records) / recordSize
+}
+
+func (t *Table) key(idx int) uint32 {
+	return binary.LittleEndian.Uint32(t.records[idx*recordSize:])
+}
+
+// entry decodes the record at idx. All strings are copied, so the
+// result remains valid after the Table is closed.
+func (t *Table) entry(idx int) IndexEntry {
+	r := t.records[idx*recordSize : (idx+1)*recordSize]
+	return IndexEntry{
+		Name:      string(t.str(binary.LittleEndian.Uint32(r[4:]))),
+		Binarypkg: string(t.str(binary.LittleEndian.Uint32(r[8:]))),
+		Suite:     enumValue(t.suites, int(r[12])),
+		Language:  enumValue(t.langs, int(r[13])),
+		Section:   enumValue(t.sections, int(binary.LittleEndian.Uint16(r[14:]))),
+	}
+}
+
+// span returns the records [start, end) with the same key as the
+// record at start.
+func (t *Table) span(start int) int {
+	key := t.key(start)
+	end := start + 1
+	for end < t.numRecords() && t.key(end) == key {
+		end++
+	}
+	return end
+}
+
+func (t *Table) entries(start, end int) []IndexEntry {
+	result := make([]IndexEntry, 0, end-start)
+	for idx := start; idx < end; idx++ {
+		result = append(result, t.entry(idx))
+	}
+	return result
+}
+
+// Len returns the number of distinct (lower-cased) manpage names.
+func (t *Table) Len() int {
+	if t == nil {
+		return 0
+	}
+	return t.names
+}
+
+// SuiteCounts returns the number of entries per suite.
+func (t *Table) SuiteCounts() map[string]int {
+	counts := make(map[string]int)
+	if t == nil {
+		return counts
+	}
+	for idx := 0; idx < t.numRecords(); idx++ {
+		counts[enumValue(t.suites, int(t.records[idx*recordSize+12]))]++
+	}
+	return counts
+}
+
+// Lookup returns the entries for the lower-cased manpage name.
+func (t *Table) Lookup(name string) ([]IndexEntry, bool) {
+	if t == nil {
+		return nil, false
+	}
+	b := []byte(name)
+	n := t.numRecords()
+	start := sort.Search(n, func(i int) bool {
+		return bytes.Compare(t.str(t.key(i)), b) >= 0
+	})
+	if start == n || !bytes.Equal(t.str(t.key(start)), b) {
+		return nil, false
+	}
+	return t.entries(start, t.span(start)), true
+}
+
+// ForEach calls fn for each lower-cased manpage name, in sorted order.
+func (t *Table) ForEach(fn func(name string, entries []IndexEntry)) {
+	if t == nil {
+		return
+	}
+	for start := 0; start < t.numRecords(); {
+		end := t.span(start)
+		fn(string(t.str(t.key(start))), t.entries(start, end))
+		start = end
+	}
+}
+
+// Close releases the memory mapping (if any) backing the table. The
+// table must not be used afterwards.
+func (t *Table) Close() error {
+	if t == nil || t.unmap == nil {
+		return nil
+	}
+	unmap := t.unmap
+	t.unmap = nil
+	return unmap()
+}
+
+// NewTable returns a Table containing entries, which maps from
+// lower-cased manpage name to its entries. An error is returned if
+// entries cannot be encoded, e.g. because they contain more than 256
+// languages.
+func NewTable(entries map[string][]IndexEntry) (*Table, error) {
+	var buf bytes.Buffer
+	if err := encodeTable(&buf, Index{}, entries); err != nil {
+		return nil, err
+	}
+	idx, err := decodeTable(buf.Bytes(), true)
+	if err != nil {
+		return nil, err
+	}
+	return idx.Entries, nil
+}
+
+// stringPool assigns ids to strings, storing each string only once.
+type stringPool struct {
+	ids     map[string]uint32
+	offsets []uint32
+	pool    bytes.Buffer
+}
+
+func (p *stringPool) id(s string) uint32 {
+	if id, ok := p.ids[s]; ok {
+		return id
+	}
+	id := uint32(len(p.ids))
+	p.ids[s] = id
+	p.pool.WriteString(s)
+	p.offsets = append(p.offsets, uint32(p.pool.Len()))
+	return id
+}
+
+// enum assigns indexes to the sorted values of set.
+func enum(set map[string]bool) ([]string, map[string]int) {
+	values := make([]string, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	idx := make(map[string]int, len(values))
+	for i, v := range values {
+		idx[v] = i
+	}
+	return values, idx
+}
+
+// encodeTable writes idx with entries (keyed by lower-cased manpage
+// name) instead of idx.Entries. The order of entries with the same key
+// is retained. See writeContainer for the file format.
+func encodeTable(w io.Writer, idx Index, entries map[string][]IndexEntry) error {
+	suiteSet := make(map[string]bool)
+	for _, suite := range idx.Suites {
+		suiteSet[suite] = true
+	}
+	langSet := make(map[string]bool, len(idx.Langs))
+	for lang := range idx.Langs {
+		langSet[lang] = true
+	}
+	sectionSet := make(map[string]bool, len(idx.Sections))
+	for section := range idx.Sections {
+		sectionSet[section] = true
+	}
+	keys := make([]string, 0, len(entries))
+	for key, e := range entries {
+		keys = append(keys, key)
+		for _, entry := range e {
+			suiteSet[entry.Suite] = true
+			langSet[entry.Language] = true
+			sectionSet[entry.Section] = true
+		}
+	}
+	sort.Strings(keys)
+	suites, suiteIdx := enum(suiteSet)
+	langs, langIdx := enum(langSet)
+	sections, sectionIdx := enum(sectionSet)
+	if len(suites) > 256 {
+		return fmt.Errorf("too many suites: got %d, at most 256 are supported", len(suites))
+	}
+	if len(langs) > 256 {
+		return fmt.Errorf("too many languages: got %d, at most 256 are supported", len(langs))
+	}
+	if len(sections) > 65536 {
+		return fmt.Errorf("too many sections: got %d, at most 65536 are supported", len(sections))
+	}
+
+	pool := &stringPool{
+		ids:     make(map[string]uint32),
+		offsets: []uint32{0},
+	}
+	var records []byte
+	var numRecords int
+	for _, key := range keys {
+		keyID := pool.id(key)
+		for _, e := range entries[key] {
+			var r [recordSize]byte
+			binary.LittleEndian.PutUint32(r[0:], keyID)
+			binary.LittleEndian.PutUint32(r[4:], pool.id(e.Name))
+			binary.LittleEndian.PutUint32(r[8:], pool.id(e.Binarypkg))
+			r[12] = uint8(suiteIdx[e.Suite])
+			r[13] = uint8(langIdx[e.Language])
+			binary.LittleEndian.PutUint16(r[14:], uint16(sectionIdx[e.Section]))
+			records = append(records, r[:]...)
+			numRecords++
+		}
+	}
+	enumIDs := func(values []string) []uint32 {
+		ids := make([]uint32, len(values))
+		for i, v := range values {
+			ids[i] = pool.id(v)
+		}
+		return ids
+	}
+	suiteIDs := enumIDs(suites)
+	langIDs := enumIDs(langs)
+	sectionIDs := enumIDs(sections)
+	aliases := make([]string, 0, len(idx.Suites))
+	for alias := range idx.Suites {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	var aliasIDs []uint32
+	for _, alias := range aliases {
+		aliasIDs = append(aliasIDs, pool.id(alias), pool.id(idx.Suites[alias]))
+	}
+
+	// Header: the number of elements of each section which follows.
+	var hdr bytes.Buffer
+	for _, v := range []uint32{
+		uint32(len(suiteIDs)),
+		uint32(len(langIDs)),
+		uint32(len(sectionIDs)),
+		uint32(len(aliases)),
+		uint32(len(pool.offsets)),
+		uint32(numRecords),
+		uint32(pool.pool.Len()),
+	} {
+		binary.Write(&hdr, binary.LittleEndian, v)
+	}
+	for _, ids := range [][]uint32{suiteIDs, langIDs, sectionIDs, aliasIDs, pool.offsets} {
+		binary.Write(&hdr, binary.LittleEndian, ids)
+	}
+	if _, err := w.Write(hdr.Bytes()); err != nil {
+		return err
+	}
+	if _, err := w.Write(records); err != nil {
+		return err
+	}
+	_, err := w.Write(pool.pool.Bytes())
+	return err
+}
+
+// tableHeaderSize returns the size of the header of the table encoded
+// in b, i.e. of the section sizes and the suite, language, section and
+// alias ids, which are covered by the index file checksum.
+func tableHeaderSize(b []byte) (int, error) {
+	if len(b) < 7*4 {
+		return 0, fmt.Errorf("index truncated: need %d more bytes, have %d", 7*4, len(b))
+	}
+	size := uint64(7 * 4)
+	for i := 0; i < 4; i++ {
+		n := uint64(binary.LittleEndian.Uint32(b[4*i:]))
+		if i == 3 {
+			n *= 2 // aliases are stored as (alias, suite) pairs
+		}
+		size += 4 * n
+	}
+	if size > uint64(len(b)) {
+		return 0, fmt.Errorf("index truncated: need %d more bytes, have %d", size, len(b))
+	}
+	return int(size), nil
+}
+
+// decodeTable returns the index encoded in b (by encodeTable). The
+// returned Table refers to b, which must not be modified.
+//
+// Only the section sizes and the (small) enum sections are validated
+// unless verify is true, in which case the string pool offsets and all
+// records are validated (and the distinct names counted) as well, which
+// touches every page of b. Otherwise, the caller must set Table.names.
+func decodeTable(b []byte, verify bool) (Index, error) {
+	var index Index
+	rest := b
+	next := func(n int) ([]byte, error) {
+		if n < 0 || n > len(rest) {
+			return nil, fmt.Errorf("index truncated: need %d more bytes, have %d", n, len(rest))
+		}
+		result := rest[:n]
+		rest = rest[n:]
+		return result, nil
+	}
+	hdr, err := next(7 * 4)
+	if err != nil {
+		return index, err
+	}
+	var counts [7]int
+	for i := range counts {
+		counts[i] = int(binary.LittleEndian.Uint32(hdr[4*i:]))
+	}
+	numSuites, numLangs, numSections, numAliases := counts[0], counts[1], counts[2], counts[3]
+	numOffsets, numRecords, poolLen := counts[4], counts[5], counts[6]
+
+	var ids [4][]byte
+	for i, n := range []int{numSuites, numLangs, numSections, 2 * numAliases} {
+		if ids[i], err = next(4 * n); err != nil {
+			return index, err
+		}
+	}
+	t := &Table{}
+	if numOffsets < 1 {
+		return index, fmt.Errorf("corrupt index: string pool has no offsets")
+	}
+	if t.offsets, err = next(4 * numOffsets); err != nil {
+		return index, err
+	}
+	if t.records, err = next(recordSize * numRecords); err != nil {
+		return index, err
+	}
+	if t.pool, err = next(poolLen); err != nil {
+		return index, err
+	}
+	if len(rest) > 0 {
+		return index, fmt.Errorf("corrupt index: %d trailing bytes", len(rest))
+	}
+	numStrings := uint32(numOffsets - 1)
+	if verify {
+		prev := uint32(0)
+		for i := 0; i < numOffsets; i++ {
+			off := binary.LittleEndian.Uint32(t.offsets[4*i:])
+			if off < prev || int(off) > poolLen {
+				return index, fmt.Errorf("corrupt index: invalid string pool offset %d", off)
+			}
+			prev = off
+		}
+	}
+	strs := func(b []byte) ([]string, error) {
+		result := make([]string, len(b)/4)
+		for i := range result {
+			id := binary.LittleEndian.Uint32(b[4*i:])
+			s := t.str(id)
+			if id >= numStrings || s == nil {
+				return nil, fmt.Errorf("corrupt index: invalid string id %d", id)
+			}
+			result[i] = string(s)
+		}
+		return result, nil
+	}
+	if t.suites, err = strs(ids[0]); err != nil {
+		return index, err
+	}
+	if t.langs, err = strs(ids[1]); err != nil {
+		return index, err
+	}
+	if t.sections, err = strs(ids[2]); err != nil {
+		return index, err
+	}
+	aliases, err := strs(ids[3])
+	if err != nil {
+		return index, err
+	}
+	for i := 0; verify && i < numRecords; i++ {
+		r := t.records[i*recordSize:]
+		for _, id := range []uint32{
+			binary.LittleEndian.Uint32(r[0:]),
+			binary.LittleEndian.Uint32(r[4:]),
+			binary.LittleEndian.Uint32(r[8:]),
+		} {
+			if id >= numStrings {
+				return index, fmt.Errorf("corrupt index: invalid string id %d", id)
+			}
+		}
+		if int(r[12]) >= numSuites || int(r[13]) >= numLangs || int(binary.LittleEndian.Uint16(r[14:])) >= numSections {
+			return index, fmt.Errorf("corrupt index: invalid enum value in entry %d", i)
+		}
+		if i == 0 || t.key(i) != t.key(i-1) {
+			t.names++
+		}
+	}
+
+	index.Entries = t
+	index.Suites = make(map[string]string, numAliases)
+	for i := 0; i < len(aliases); i += 2 {
+		index.Suites[aliases[i]] = aliases[i+1]
+	}
+	index.Langs = make(map[string]bool, len(t.langs))
+	for _, l := range t.langs {
+		index.Langs[l] = true
+	}
+	index.Sections = make(map[string]bool, len(t.sections)+1)
+	for _, s := range t.sections {
+		index.Sections[s] = true
+	}
+	index.Sections["0"] = true
+	return index, nil
+}
@@ -0,0 +1,244 @@
+package redirect
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Debian/debiman/internal/proto"
+	"github.com/golang/protobuf/proto"
+)
+
+func mustNewTable(entries map[string][]IndexEntry) *Table {
+	t, err := NewTable(entries)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestNewTableTooManyLanguages(t *testing.T) {
+	entries := make(map[string][]IndexEntry)
+	for i := 0; i < 257; i++ {
+		entries["i3"] = append(entries["i3"], IndexEntry{
+			Name:      "i3",
+			Suite:     "jessie",
+			Binarypkg: "i3-wm",
+			Section:   "1",
+			Language:  fmt.Sprintf("l%d", i),
+		})
+	}
+	if _, err := NewTable(entries); err == nil || !strings.Contains(err.Error(), "too many languages") {
+		t.Fatalf("NewTable: got error %v, want too many languages", err)
+	}
+}
+
+func TestTableLookup(t *testing.T) {
+	entries, ok := testIdx.Entries.Lookup("i3")
+	if !ok {
+		t.Fatalf("Lookup(i3) unexpectedly failed")
+	}
+	if got, want := len(entries), 8; got != want {
+		t.Fatalf("unexpected number of i3 entries: got %d, want %d", got, want)
+	}
+	// The order of entries must be retained.
+	if got, want := entries[1], (IndexEntry{Name: "i3", Suite: "jessie", Binarypkg: "i3-wm", Section: "5", Language: "fr"}); got != want {
+		t.Fatalf("unexpected second i3 entry: got %+v, want %+v", got, want)
+	}
+	for _, name := range []string{"", "i", "i30", "zzz"} {
+		if _, ok := testIdx.Entries.Lookup(name); ok {
+			t.Errorf("Lookup(%q) unexpectedly succeeded", name)
+		}
+	}
+	if _, ok := (*Table)(nil).Lookup("i3"); ok {
+		t.Errorf("Lookup on nil Table unexpectedly succeeded")
+	}
+
+	var names []string
+	testIdx.Entries.ForEach(func(name string, entries []IndexEntry) {
+		names = append(names, name)
+	})
+	if got, want := len(names), testIdx.Entries.Len(); got != want {
+		t.Fatalf("ForEach: got %d names, want %d", got, want)
+	}
+}
+
+func TestIndexFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman-redirect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	idxWithMeta := testIdx
+	idxWithMeta.Meta = IndexMeta{
+		GeneratorVersion: "v1.2.3",
+		Created:          time.Date(2017, 1, 22, 14, 29, 53, 0, time.UTC),
+	}
+	var buf bytes.Buffer
+	if err := WriteIndex(&buf, idxWithMeta); err != nil {
+		t.Fatal(err)
+	}
+	// Writing the entries directly must result in the same file.
+	entries := make(map[string][]IndexEntry)
+	testIdx.Entries.ForEach(func(name string, e []IndexEntry) {
+		entries[name] = e
+	})
+	var direct bytes.Buffer
+	if err := WriteIndexEntries(&direct, idxWithMeta, entries); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(direct.Bytes(), buf.Bytes()) {
+		t.Errorf("WriteIndexEntries and WriteIndex results differ")
+	}
+	path := filepath.Join(dir, "auxserver.idx")
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	idx, err := IndexFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idx.Entries.Close()
+	if !reflect.DeepEqual(idx.Suites, testIdx.Suites) {
+		t.Fatalf("unexpected suites: got %v, want %v", idx.Suites, testIdx.Suites)
+	}
+	testIdx.Entries.ForEach(func(name string, want []IndexEntry) {
+		got, _ := idx.Entries.Lookup(name)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Lookup(%q): got %+v, want %+v", name, got, want)
+		}
+	})
+	if got, want := idx.Meta.GeneratorVersion, "v1.2.3"; got != want {
+		t.Errorf("unexpected generator version: got %q, want %q", got, want)
+	}
+	if !idx.Meta.Created.Equal(idxWithMeta.Meta.Created) {
+		t.Errorf("unexpected creation time: got %v, want %v", idx.Meta.Created, idxWithMeta.Meta.Created)
+	}
+	if got, want := idx.Meta.SuiteEntries, testIdx.Entries.SuiteCounts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected suite entries: got %v, want %v", got, want)
+	}
+
+	if err := VerifyIndex(path); err != nil {
+		t.Fatalf("VerifyIndex: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(b []byte) []byte
+		// loads is true if only VerifyIndex (not IndexFromFile)
+		// detects the problem.
+		loads   bool
+		wantErr string
+	}{
+		{
+			name:    "Truncated",
+			modify:  func(b []byte) []byte { return b[:len(b)-1] },
+			wantErr: "truncated",
+		},
+
+		{
+			name:    "TruncatedHeader",
+			modify:  func(b []byte) []byte { return b[:len(indexMagic)+2] },
+			wantErr: "truncated",
+		},
+
+		{
+			name: "CorruptMetadata",
+			modify: func(b []byte) []byte {
+				b[len(indexMagic)+4+4+sha256.Size+1] ^= 0xff
+				return b
+			},
+			wantErr: "checksum mismatch",
+		},
+
+		{
+			name: "Corrupt",
+			modify: func(b []byte) []byte {
+				b[len(b)-1] ^= 0xff
+				return b
+			},
+			loads:   true,
+			wantErr: "table checksum mismatch",
+		},
+
+		{
+			name: "Newer",
+			modify: func(b []byte) []byte {
+				b[len(indexMagic)] = SchemaVersion + 1
+				return b
+			},
+			wantErr: "newer debiman",
+		},
+
+		{
+			name: "Invalid",
+			modify: func(b []byte) []byte {
+				b[len(indexMagic)] = 0
+				return b
+			},
+			wantErr: "invalid schema version 0",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.modify(append([]byte(nil), buf.Bytes()...))
+			if err := ioutil.WriteFile(path, b, 0644); err != nil {
+				t.Fatal(err)
+			}
+			idx, err := IndexFromFile(path)
+			if tt.loads {
+				if err != nil {
+					t.Fatalf("IndexFromFile: %v", err)
+				}
+				// Lookups in unverified tables must not panic.
+				idx.Entries.ForEach(func(string, []IndexEntry) {})
+				idx.Entries.Close()
+				err = VerifyIndex(path)
+				if err == nil {
+					t.Fatalf("VerifyIndex unexpectedly succeeded")
+				}
+			} else if err == nil {
+				t.Fatalf("IndexFromFile unexpectedly succeeded")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("Protobuf", func(t *testing.T) {
+		b, err := proto.Marshal(&pb.Index{
+			Entry: []*pb.IndexEntry{
+				{Name: "I3", Suite: "jessie", Binarypkg: "i3-wm", Section: "1", Language: "en"},
+			},
+			Language: []string{"en"},
+			Section:  []string{"1"},
+			Suite:    map[string]string{"jessie": "jessie"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		idx, err := IndexFromFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := idx.Entries.Lookup("i3")
+		want := []IndexEntry{{Name: "I3", Suite: "jessie", Binarypkg: "i3-wm", Section: "1", Language: "en"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Lookup(i3): got %+v, want %+v", got, want)
+		}
+		if got, want := idx.Meta.Format, "protobuf"; got != want {
+			t.Fatalf("unexpected format: got %q, want %q", got, want)
+		}
+	})
+}
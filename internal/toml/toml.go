@@ -0,0 +1,404 @@
+// Package toml parses the subset of TOML (https://toml.io/) which is
+// used by debiman configuration files:
+//
+//   - comments (# until the end of the line)
+//   - tables ([name] and [dotted.name], with bare or quoted keys)
+//   - key/value pairs with bare or quoted keys
+//   - basic ("…") and literal ('…') strings, integers, floats, booleans
+//   - arrays of the above, which may span multiple lines
+//
+// Inline tables, arrays of tables, multi-line strings and dates are not
+// supported and result in an error.
+package toml
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Table maps keys to values, which are of type string, int64, float64,
+// bool, []interface{} or Table.
+type Table map[string]interface{}
+
+// Parse parses the TOML document read from r.
+func Parse(r io.Reader) (Table, error) {
+	p := &parser{root: make(Table)}
+	p.current = p.root
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var pending string // accumulates lines of a multi-line array
+	pendingLine := 0
+	for scanner.Scan() {
+		p.line++
+		line := scanner.Text()
+		if pending != "" {
+			pending += "\n" + line
+			if !arrayComplete(pending) {
+				continue
+			}
+			line, pending = pending, ""
+		} else if !arrayComplete(line) {
+			pending = line
+			pendingLine = p.line
+			continue
+		}
+		if err := p.parseLine(line); err != nil {
+			return nil, fmt.Errorf("line %d: %v", p.line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if pending != "" {
+		return nil, fmt.Errorf("line %d: unterminated array", pendingLine)
+	}
+	return p.root, nil
+}
+
+type parser struct {
+	root    Table
+	current Table
+	line    int
+
+	// defined contains the tables which were declared via [name], so
+	// that duplicate declarations are rejected.
+	defined map[string]bool
+}
+
+// arrayComplete returns true if all brackets outside of strings and
+// comments in s are balanced.
+func arrayComplete(s string) bool {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"', '\'':
+			end := stringEnd(s, i)
+			if end == -1 {
+				return true // let the parser report the error
+			}
+			i = end
+		case '#':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+// stringEnd returns the index of the closing quote of the string which
+// starts at s[start], or -1.
+func stringEnd(s string, start int) int {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case '\n':
+			return -1
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *parser) parseLine(line string) error {
+	s := strings.TrimSpace(line)
+	if s == "" || s[0] == '#' {
+		return nil
+	}
+	if s[0] == '[' {
+		return p.parseTableHeader(s)
+	}
+	keys, rest, err := parseKey(s)
+	if err != nil {
+		return err
+	}
+	rest = strings.TrimLeft(rest, " \t")
+	if !strings.HasPrefix(rest, "=") {
+		return fmt.Errorf("expected = after key %q", strings.Join(keys, "."))
+	}
+	val, rest, err := parseValue(strings.TrimLeft(rest[1:], " \t\n"))
+	if err != nil {
+		return err
+	}
+	if err := expectEnd(rest); err != nil {
+		return err
+	}
+	t, err := subTable(p.current, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+	if _, ok := t[last]; ok {
+		return fmt.Errorf("duplicate key %q", strings.Join(keys, "."))
+	}
+	t[last] = val
+	return nil
+}
+
+func (p *parser) parseTableHeader(s string) error {
+	if strings.HasPrefix(s, "[[") {
+		return fmt.Errorf("arrays of tables are not supported")
+	}
+	keys, rest, err := parseKey(strings.TrimLeft(s[1:], " \t"))
+	if err != nil {
+		return err
+	}
+	rest = strings.TrimLeft(rest, " \t")
+	if !strings.HasPrefix(rest, "]") {
+		return fmt.Errorf("expected ] after table name")
+	}
+	if err := expectEnd(rest[1:]); err != nil {
+		return err
+	}
+	name := strings.Join(keys, "\x00")
+	if p.defined == nil {
+		p.defined = make(map[string]bool)
+	}
+	if p.defined[name] {
+		return fmt.Errorf("duplicate table [%s]", strings.Join(keys, "."))
+	}
+	p.defined[name] = true
+	t, err := subTable(p.root, keys)
+	if err != nil {
+		return err
+	}
+	p.current = t
+	return nil
+}
+
+// subTable returns the table at path keys underneath t, creating it if
+// necessary.
+func subTable(t Table, keys []string) (Table, error) {
+	for idx, key := range keys {
+		v, ok := t[key]
+		if !ok {
+			sub := make(Table)
+			t[key] = sub
+			t = sub
+			continue
+		}
+		sub, ok := v.(Table)
+		if !ok {
+			return nil, fmt.Errorf("key %q is not a table", strings.Join(keys[:idx+1], "."))
+		}
+		t = sub
+	}
+	return t, nil
+}
+
+// expectEnd returns an error unless rest is empty or a comment.
+func expectEnd(rest string) error {
+	rest = strings.TrimSpace(rest)
+	if rest != "" && rest[0] != '#' {
+		return fmt.Errorf("unexpected %q", rest)
+	}
+	return nil
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' ||
+		c >= 'A' && c <= 'Z' ||
+		c >= '0' && c <= '9' ||
+		c == '_' || c == '-'
+}
+
+// parseKey parses a (possibly dotted) key at the start of s.
+func parseKey(s string) (keys []string, rest string, err error) {
+	for {
+		s = strings.TrimLeft(s, " \t")
+		if s == "" {
+			return nil, "", fmt.Errorf("expected key")
+		}
+		var key string
+		if s[0] == '"' || s[0] == '\'' {
+			v, r, err := parseString(s)
+			if err != nil {
+				return nil, "", err
+			}
+			key, s = v, r
+		} else {
+			i := 0
+			for i < len(s) && isBareKeyChar(s[i]) {
+				i++
+			}
+			if i == 0 {
+				return nil, "", fmt.Errorf("invalid key at %q", s)
+			}
+			key, s = s[:i], s[i:]
+		}
+		keys = append(keys, key)
+		s = strings.TrimLeft(s, " \t")
+		if !strings.HasPrefix(s, ".") {
+			return keys, s, nil
+		}
+		s = s[1:]
+	}
+}
+
+// parseString parses the basic or literal string at the start of s.
+func parseString(s string) (string, string, error) {
+	if strings.HasPrefix(s, `"""`) || strings.HasPrefix(s, `'''`) {
+		return "", "", fmt.Errorf("multi-line strings are not supported")
+	}
+	end := stringEnd(s, 0)
+	if end == -1 {
+		return "", "", fmt.Errorf("unterminated string")
+	}
+	if s[0] == '\'' {
+		return s[1:end], s[end+1:], nil
+	}
+	var b strings.Builder
+	for i := 1; i < end; i++ {
+		c := s[i]
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case '"', '\\':
+			b.WriteByte(s[i])
+		case 'b':
+			b.WriteByte('\b')
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'f':
+			b.WriteByte('\f')
+		case 'r':
+			b.WriteByte('\r')
+		case 'u', 'U':
+			n := 4
+			if s[i] == 'U' {
+				n = 8
+			}
+			if i+n >= end {
+				return "", "", fmt.Errorf("invalid unicode escape")
+			}
+			r, err := strconv.ParseUint(s[i+1:i+1+n], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return "", "", fmt.Errorf("invalid unicode escape %q", s[i-1:i+1+n])
+			}
+			b.WriteRune(rune(r))
+			i += n
+		default:
+			return "", "", fmt.Errorf("invalid escape sequence \\%c", s[i])
+		}
+	}
+	return b.String(), s[end+1:], nil
+}
+
+// parseValue parses the value at the start of s.
+func parseValue(s string) (interface{}, string, error) {
+	if s == "" {
+		return nil, "", fmt.Errorf("expected value")
+	}
+	switch s[0] {
+	case '"', '\'':
+		return parseString(s)
+	case '[':
+		return parseArray(s)
+	case '{':
+		return nil, "", fmt.Errorf("inline tables are not supported")
+	}
+	i := 0
+	for i < len(s) && (isBareKeyChar(s[i]) || s[i] == '+' || s[i] == '.') {
+		i++
+	}
+	word, rest := s[:i], s[i:]
+	switch word {
+	case "true":
+		return true, rest, nil
+	case "false":
+		return false, rest, nil
+	}
+	if isFloat(word) {
+		f, err := strconv.ParseFloat(strings.Replace(word, "_", "", -1), 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid float %q", word)
+		}
+		return f, rest, nil
+	}
+	n, err := strconv.ParseInt(strings.Replace(word, "_", "", -1), 0, 64)
+	if err != nil {
+		return nil, "", fmt.Errorf("unsupported value %q", strings.TrimSpace(s))
+	}
+	return n, rest, nil
+}
+
+// isFloat returns true if word is a TOML float, i.e. a decimal number
+// with a fractional part and/or exponent, or ±inf or ±nan. Unlike Go,
+// TOML requires digits on both sides of the decimal point.
+func isFloat(word string) bool {
+	digits := strings.TrimLeft(word, "+-")
+	switch digits {
+	case "inf", "nan":
+		return len(word)-len(digits) <= 1
+	}
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0o") || strings.HasPrefix(digits, "0b") {
+		return false
+	}
+	mantissa := digits
+	exp := strings.IndexAny(digits, "eE")
+	if exp > -1 {
+		mantissa = digits[:exp]
+	}
+	if dot := strings.IndexByte(mantissa, '.'); dot > -1 {
+		isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+		return dot > 0 && dot < len(mantissa)-1 &&
+			isDigit(mantissa[dot-1]) && isDigit(mantissa[dot+1])
+	}
+	return exp > 0
+}
+
+// skipSpace skips whitespace, newlines and comments.
+func skipSpace(s string) string {
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		if !strings.HasPrefix(s, "#") {
+			return s
+		}
+		if idx := strings.IndexByte(s, '\n'); idx > -1 {
+			s = s[idx:]
+		} else {
+			return ""
+		}
+	}
+}
+
+func parseArray(s string) (interface{}, string, error) {
+	arr := []interface{}{}
+	s = skipSpace(s[1:])
+	for {
+		if strings.HasPrefix(s, "]") {
+			return arr, s[1:], nil
+		}
+		v, rest, err := parseValue(s)
+		if err != nil {
+			return nil, "", err
+		}
+		arr = append(arr, v)
+		s = skipSpace(rest)
+		if strings.HasPrefix(s, ",") {
+			s = skipSpace(s[1:])
+			continue
+		}
+		if !strings.HasPrefix(s, "]") {
+			return nil, "", fmt.Errorf("expected , or ] in array")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package toml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	const input = `
+# debiman configuration
+serving_dir = "/srv/man"   # trailing comment
+sync_codenames = ["bookworm", "trixie"]
+concurrency_render = 8
+mirror_rate_limit = 2.5
+ratios = [1e3, -0.5, 1_000.25, 6.626E-34]
+force_rerender = false
+escaped = "tab\there \"quoted\" \u00e9"
+literal = 'C:\path'
+
+[suite.trixie]
+remote_mirror = "https://deb.debian.org/"
+components = [
+  "main",
+  "contrib", # with a comment
+  "non-free-firmware",
+]
+
+[suite."bookworm-backports"]
+keyring = '/etc/debiman/backports.gpg'
+`
+	got, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Table{
+		"serving_dir":        "/srv/man",
+		"sync_codenames":     []interface{}{"bookworm", "trixie"},
+		"concurrency_render": int64(8),
+		"mirror_rate_limit":  2.5,
+		"ratios":             []interface{}{1e3, -0.5, 1000.25, 6.626e-34},
+		"force_rerender":     false,
+		"escaped":            "tab\there \"quoted\" é",
+		"literal":            `C:\path`,
+		"suite": Table{
+			"trixie": Table{
+				"remote_mirror": "https://deb.debian.org/",
+				"components":    []interface{}{"main", "contrib", "non-free-firmware"},
+			},
+			"bookworm-backports": Table{
+				"keyring": "/etc/debiman/backports.gpg",
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result:\ngot  %#v\nwant %#v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		want  string
+	}{
+		{`a = "unterminated`, "line 1: unterminated string"},
+		{"a = 1\na = 2", "line 2: duplicate key"},
+		{"[t]\n[t]", "line 2: duplicate table"},
+		{`a = .5`, "line 1: unsupported value"},
+		{`a = 1.`, "line 1: unsupported value"},
+		{`a = 1.2.3`, "line 1: invalid float"},
+		{`a = { b = 1 }`, "inline tables are not supported"},
+		{"[[servers]]", "arrays of tables are not supported"},
+		{"a = [\n1,\n", "line 1: unterminated array"},
+		{"a = 1\n[a]", "line 2: key \"a\" is not a table"},
+		{`a = "\x"`, `invalid escape sequence \x`},
+	} {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q): got error %v, want %q", tt.input, err, tt.want)
+		}
+	}
+}
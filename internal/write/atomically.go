@@ -7,8 +7,43 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
+var (
+	pendingMu sync.Mutex
+	pending   = make(map[string]bool) // temporary files being written
+)
+
+func track(name string) {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	pending[name] = true
+}
+
+func untrack(name string) {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	delete(pending, name)
+}
+
+// RemoveTempFiles removes the temporary files of all writes which are
+// currently in progress and returns how many files were removed. It is
+// meant to be called right before the process exits without waiting for
+// these writes to complete, e.g. on a second SIGINT.
+func RemoveTempFiles() int {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	var removed int
+	for name := range pending {
+		if err := os.Remove(name); err == nil {
+			removed++
+		}
+		delete(pending, name)
+	}
+	return removed
+}
+
 func tempDir(dest string) string {
 	tempdir := os.Getenv("TMPDIR")
 	if tempdir == "" {
@@ -25,6 +60,8 @@ func Atomically(dest string, compress bool, write func(w io.Writer) error) (err
 	if err != nil {
 		return err
 	}
+	track(f.Name())
+	defer untrack(f.Name())
 	defer func() {
 		// Remove the tempfile if an error occurred
 		if err != nil {
@@ -75,6 +112,8 @@ func AtomicallyWithGz(dest string, gzipw *gzip.Writer, write func(w io.Writer) e
 	if err != nil {
 		return err
 	}
+	track(f.Name())
+	defer untrack(f.Name())
 	defer func() {
 		// Remove the tempfile if an error occurred
 		if err != nil {
@@ -0,0 +1,169 @@
+package pipeline
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Debian/debiman/internal/manpage"
+	"github.com/Debian/debiman/internal/write"
+)
+
+// auxFiles extracts the non-manpage files which manpages reference (via
+// .so or symlinks) into the aux/ directory of a binary package while
+// downloadPkg reads data.tar, so that data.tar is decompressed only
+// once:
+//
+// Files underneath a man root (see manRoots) which are not manpages are
+// the only candidates for .so references (see findFile). They are
+// extracted unconditionally, because manpages of other packages (e.g. of
+// a package which depends on a -common package) might reference them.
+// References to other files (i.e. symlink targets) are extracted when
+// they are passed. Only references to files which were passed before
+// the reference was known require another pass over data.tar.
+type auxFiles struct {
+	dir    string // aux directory of the binary package
+	logger *pkgLogger
+
+	refs      map[string]bool // referenced paths, e.g. /usr/share/doc/foo/foo.1.gz
+	extracted map[string]bool // paths which were extracted
+	passed    map[string]bool // paths which were passed without being extracted
+}
+
+func newAuxFiles(logger *pkgLogger, dir string) *auxFiles {
+	return &auxFiles{
+		dir:       dir,
+		logger:    logger,
+		refs:      make(map[string]bool),
+		extracted: make(map[string]bool),
+		passed:    make(map[string]bool),
+	}
+}
+
+// extract writes the referenced file path with contents r.
+func (a *auxFiles) extract(path string, r io.Reader) error {
+	destPath := filepath.Join(a.dir, path)
+	a.logger.auxFiles++
+	a.logger.Debug("extracting referenced non-manpage file", "path", path, "dest", destPath)
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return err
+	}
+	if err := write.Atomically(destPath, false, func(w io.Writer) error {
+		_, err := io.Copy(w, r)
+		return err
+	}); err != nil {
+		return err
+	}
+	a.extracted[path] = true
+	return nil
+}
+
+// reference records that path is referenced.
+func (a *auxFiles) reference(path string) {
+	a.refs[path] = true
+}
+
+// pass is called for every entry of data.tar, with r reading its
+// contents. Referenced entries are extracted. The returned io.Reader
+// must be used to read the contents of the entry instead of r.
+func (a *auxFiles) pass(header *tar.Header, r io.Reader) (io.Reader, error) {
+	if header.Typeflag != tar.TypeReg &&
+		header.Typeflag != tar.TypeRegA &&
+		header.Typeflag != tar.TypeSymlink {
+		return r, nil
+	}
+	if header.FileInfo().IsDir() {
+		return r, nil
+	}
+	path := strings.TrimPrefix(header.Name, ".")
+	if !a.refs[path] || a.extracted[path] {
+		a.passed[path] = true
+		return r, nil
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.extract(path, bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
+
+// keep extracts path (which was just passed, with r reading its
+// contents) regardless of whether it is referenced, see auxFiles.
+func (a *auxFiles) keep(path string, r io.Reader) error {
+	if a.extracted[path] {
+		return nil
+	}
+	delete(a.passed, path)
+	return a.extract(path, r)
+}
+
+// waiting returns whether there are references which were not
+// extracted and which might still be passed.
+func (a *auxFiles) waiting() bool {
+	for path := range a.refs {
+		if !a.extracted[path] && !a.passed[path] {
+			return true
+		}
+	}
+	return false
+}
+
+// needsPass returns whether another pass over data.tar is required to
+// extract all references, i.e. whether a reference was passed without
+// being buffered or (if complete is false, meaning the last pass did
+// not reach the end of data.tar) might come later.
+func (a *auxFiles) needsPass(complete bool) bool {
+	for path := range a.refs {
+		if a.extracted[path] {
+			continue
+		}
+		if a.passed[path] || !complete {
+			return true
+		}
+	}
+	return false
+}
+
+// pending returns the number of references which were not extracted.
+func (a *auxFiles) pending() int {
+	var n int
+	for path := range a.refs {
+		if !a.extracted[path] {
+			n++
+		}
+	}
+	return n
+}
+
+// providesAux returns whether p ships non-manpage files underneath a man
+// root, which auxFiles extracts so that manpages of other packages can
+// reference them (see findFile).
+func (gv globalView) providesAux(p pkgEntry) bool {
+	for _, e := range gv.contentByPkg[p.suite+"/"+p.binarypkg] {
+		if _, err := manpage.FromManPath(e.filename, &manpage.PkgMeta{
+			Binarypkg: p.binarypkg,
+			Suite:     p.suite,
+		}); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// missingAux returns whether p provides aux files (see providesAux), but
+// its aux/ directory does not exist, e.g. because p was extracted by an
+// older debiman. Such packages are extracted again even if unchanged.
+func (gv globalView) missingAux(p pkgEntry) bool {
+	if !gv.providesAux(p) {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(gv.opts.ServingDir, p.suite, p.binarypkg, "aux"))
+	return os.IsNotExist(err)
+}
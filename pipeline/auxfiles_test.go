@@ -0,0 +1,328 @@
+package pipeline
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pault.ag/go/archive"
+)
+
+// testMirror serves synthetic packages (see buildDeb) which are added to
+// gv via add.
+type testMirror struct {
+	gv       globalView
+	debs     map[string][]byte // by filename
+	requests int64
+}
+
+func newTestMirror(t *testing.T, dir string) (*testMirror, *httptest.Server) {
+	r, err := newRun(testOptions(t, dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(r.close)
+	if err := r.discover(); err != nil {
+		t.Fatal(err)
+	}
+	r.gv.opts.PartialFetchMinSize = 1
+	m := &testMirror{gv: r.gv, debs: make(map[string][]byte)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&m.requests, 1)
+		b, ok := m.debs[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "pkg.deb", time.Now(), bytes.NewReader(b))
+	}))
+	return m, srv
+}
+
+// add adds the binary package pkg containing files to the mirror and to
+// the Contents of m.gv.
+func (m *testMirror) add(t *testing.T, pkg string, files []testFile, depends ...string) pkgEntry {
+	return m.addDeb(t, pkg, buildDeb(t, files), files, depends...)
+}
+
+// addDeb is like add, but for a .deb archive built by the caller from
+// files (e.g. with maintainer scripts).
+func (m *testMirror) addDeb(t *testing.T, pkg string, debContent []byte, files []testFile, depends ...string) pkgEntry {
+	p := *m.gv.pkgs[0]
+	p.binarypkg = pkg
+	p.filename = "pool/main/" + pkg[:1] + "/" + pkg + "/" + pkg + "_1_all.deb"
+	p.bytes = int64(len(debContent))
+	sum := sha256.Sum256(debContent)
+	p.sha256 = sum[:]
+	p.depends = depends
+	m.debs[p.filename] = debContent
+	m.gv.pkgs = append(m.gv.pkgs, &p)
+	for _, f := range files {
+		rest, ok := m.gv.manRoots.split(f.name)
+		if !ok {
+			continue
+		}
+		c := &contentEntry{suite: p.suite, binarypkg: pkg, arch: p.arch, filename: rest}
+		m.gv.contentByPath[c.filename] = append(m.gv.contentByPath[c.filename], c)
+		m.gv.contentByPkg[p.suite+"/"+pkg] = append(m.gv.contentByPkg[p.suite+"/"+pkg], c)
+	}
+	return p
+}
+
+func TestAuxFilesKeep(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := newAuxFiles(newPkgLogger(pkgEntry{suite: "sid", binarypkg: "test"}), dir)
+	const path = "/usr/share/man/man1/common.inc"
+	header := &tar.Header{Name: "." + path, Typeflag: tar.TypeReg}
+
+	// An unreferenced file is passed without being extracted…
+	if _, err := a.pass(header, strings.NewReader("first")); err != nil {
+		t.Fatal(err)
+	}
+	a.reference(path)
+	if !a.needsPass(true) {
+		t.Fatalf("needsPass: got false, want true for a reference passed before")
+	}
+	// …unless it is kept, e.g. because it is underneath a man root.
+	if err := a.keep(path, strings.NewReader("first")); err != nil {
+		t.Fatal(err)
+	}
+	if a.needsPass(true) || a.waiting() || a.pending() != 0 {
+		t.Errorf("kept reference not considered extracted: needsPass %v, waiting %v, pending %d", a.needsPass(true), a.waiting(), a.pending())
+	}
+
+	// Keeping or passing an extracted file again does not overwrite it.
+	if err := a.keep(path, strings.NewReader("second")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.pass(header, strings.NewReader("third")); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), "first"; got != want {
+		t.Errorf("unexpected contents: got %q, want %q", got, want)
+	}
+}
+
+func TestAuxFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, srv := newTestMirror(t, dir)
+	defer srv.Close()
+	ar := &archive.Downloader{Mirror: srv.URL}
+
+	for _, tt := range []struct {
+		name         string
+		files        []testFile
+		wantAux      []string
+		wantRequests int64
+	}{
+		{
+			name: "single pass",
+			files: []testFile{
+				// Referenced by foo.1 via .so, i.e. only after it was
+				// passed.
+				{name: "./usr/share/man/man1/common.inc.gz", content: gzipped(t, ".SH NAME\n")},
+				{name: "./usr/share/man/man1/foo.1.gz", content: gzipped(t, ".TH FOO 1\n.so man1/common.inc\n")},
+				// Referenced before it is passed.
+				{name: "./usr/share/man/man1/bar.1.gz", linkname: "../../doc/single/bar.1.gz"},
+				{name: "./usr/share/doc/single/bar.1.gz", content: gzipped(t, ".TH BAR 1\n")},
+			},
+			wantAux: []string{
+				"usr/share/man/man1/common.inc.gz",
+				"usr/share/doc/single/bar.1.gz",
+			},
+			wantRequests: 1,
+		},
+
+		{
+			name: "fallback",
+			files: []testFile{
+				// Referenced only after it was passed, but not
+				// buffered because it is not underneath
+				// /usr/share/man.
+				{name: "./usr/share/doc/fallback/baz.1.gz", content: gzipped(t, ".TH BAZ 1\n")},
+				{name: "./usr/share/man/man1/baz.1.gz", linkname: "../../doc/fallback/baz.1.gz"},
+			},
+			wantAux: []string{
+				"usr/share/doc/fallback/baz.1.gz",
+			},
+			wantRequests: 2,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := strings.Replace(tt.name, " ", "", -1)
+			p := m.add(t, pkg, tt.files)
+			before := atomic.LoadInt64(&m.requests)
+			if err := downloadPkg(ar, p, m.gv); err != nil {
+				t.Fatal(err)
+			}
+			for _, path := range tt.wantAux {
+				fn := filepath.Join(dir, p.suite, pkg, "aux", path)
+				if _, err := os.Stat(fn); err != nil {
+					t.Errorf("referenced file not extracted: %v", err)
+				}
+			}
+			if got, want := atomic.LoadInt64(&m.requests)-before, tt.wantRequests; got != want {
+				t.Errorf("requests: got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestCrossPackageAux(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, srv := newTestMirror(t, dir)
+	defer srv.Close()
+	ar := &archive.Downloader{Mirror: srv.URL}
+
+	// Both packages ship man1/common.inc, foo depends on foo-common.
+	pkgs := make(map[string]pkgEntry)
+	for _, pkg := range []string{"aaa-common", "foo-common"} {
+		pkgs[pkg] = m.add(t, pkg, []testFile{
+			{name: "./usr/share/man/man1/common.inc.gz", content: gzipped(t, ".SH NAME\n")},
+		})
+	}
+	foo := m.add(t, "foo", []testFile{
+		{name: "./usr/share/man/man1/foo.1.gz", content: gzipped(t, ".TH FOO 1\n.so man1/common.inc\n")},
+		{name: "./usr/share/man/man1/bar.1.gz", linkname: "common.inc.gz"},
+	}, "libc6", "foo-common")
+	pkgs["foo"] = foo
+
+	// foo is extracted before foo-common, but resolves its references
+	// to the files which foo-common extracts.
+	for _, pkg := range []string{"foo", "aaa-common", "foo-common"} {
+		if err := downloadPkg(ar, pkgs[pkg], m.gv); err != nil {
+			t.Fatalf("%s: %v", pkg, err)
+		}
+	}
+
+	common := filepath.Join(foo.suite, "foo-common", "aux", "usr", "share", "man", "man1", "common.inc.gz")
+	if _, err := os.Stat(filepath.Join(dir, common)); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(filepath.Join(dir, foo.suite, "foo", "foo.1.en.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(gzr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := ".so " + filepath.ToSlash(common) + "\n"; !strings.Contains(string(b), want) {
+		t.Errorf("foo.1: got %q, want it to contain %q", b, want)
+	}
+
+	target, err := os.Readlink(filepath.Join(dir, foo.suite, "foo", "bar.1.en.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := filepath.Clean(filepath.Join(foo.suite, "foo", target)), common; got != want {
+		t.Errorf("bar.1 symlink: got target %q, want %q", got, want)
+	}
+}
+
+func TestUnchangedAuxProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, srv := newTestMirror(t, dir)
+	defer srv.Close()
+	ar := &archive.Downloader{Mirror: srv.URL}
+
+	// foo-common was extracted (in the same version) before aux files
+	// were extracted.
+	p := m.add(t, "foo-common", []testFile{
+		{name: "./usr/share/man/man1/foo-common.1.gz", content: gzipped(t, ".TH FOO-COMMON 1\n")},
+		{name: "./usr/share/man/man1/common.inc.gz", content: gzipped(t, ".SH NAME\n")},
+	})
+	pkgdir := filepath.Join(dir, p.suite, p.binarypkg)
+	if err := os.MkdirAll(pkgdir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkgdir, "VERSION"), []byte(p.version.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []int64{1, 0} {
+		before := atomic.LoadInt64(&m.requests)
+		if err := downloadPkg(ar, p, m.gv); err != nil {
+			t.Fatal(err)
+		}
+		if got := atomic.LoadInt64(&m.requests) - before; got != want {
+			t.Errorf("requests: got %d, want %d", got, want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(pkgdir, "aux", "usr", "share", "man", "man1", "common.inc.gz")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCrossPackageOutsideManRoots documents that symlinks to files
+// outside of the man roots are only resolved within the same package,
+// see findClosestFile.
+func TestCrossPackageOutsideManRoots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, srv := newTestMirror(t, dir)
+	defer srv.Close()
+	ar := &archive.Downloader{Mirror: srv.URL}
+
+	other := m.add(t, "other", []testFile{
+		{name: "./usr/share/man/man1/other.1.gz", content: gzipped(t, ".TH OTHER 1\n")},
+		{name: "./usr/share/doc/other/baz.1.gz", content: gzipped(t, ".TH BAZ 1\n")},
+	})
+	baz := m.add(t, "baz", []testFile{
+		{name: "./usr/share/man/man1/baz.1.gz", linkname: "/usr/share/doc/other/baz.1.gz"},
+	}, "other")
+	for _, p := range []pkgEntry{other, baz} {
+		if err := downloadPkg(ar, p, m.gv); err != nil {
+			t.Fatalf("%s: %v", p.binarypkg, err)
+		}
+	}
+	link := filepath.Join(dir, baz.suite, "baz", "baz.1.en.gz")
+	if _, err := os.Lstat(link); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(link); !os.IsNotExist(err) {
+		t.Errorf("baz.1 symlink: got %v, want a dangling symlink", err)
+	}
+}
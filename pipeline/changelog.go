@@ -0,0 +1,355 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Debian/debiman/internal/write"
+)
+
+// maxChangelogRuns caps the number of runs kept in the per-suite
+// changelog (and hence the number of feed entries).
+const maxChangelogRuns = 50
+
+type changeKind string
+
+const (
+	manpageAdded   changeKind = "added"
+	manpageUpdated changeKind = "updated"
+	manpageRemoved changeKind = "removed"
+)
+
+type manpageChange struct {
+	Binarypkg string     `json:"binarypkg"`
+	Manpage   string     `json:"manpage"` // e.g. “i3.1.en”
+	Change    changeKind `json:"change"`
+
+	// OldVersion is empty for manpages of newly extracted packages,
+	// NewVersion is empty for manpages of deleted packages.
+	OldVersion string `json:"old_version,omitempty"`
+	NewVersion string `json:"new_version"`
+}
+
+type changelogRun struct {
+	Time    time.Time       `json:"time"`
+	Changes []manpageChange `json:"changes"`
+}
+
+// changelog collects the manpage changes of the current run. It is
+// safe for concurrent use by the stage 2 workers.
+type changelog struct {
+	mu      sync.Mutex
+	bySuite map[string][]manpageChange
+}
+
+func newChangelog() *changelog {
+	return &changelog{bySuite: make(map[string][]manpageChange)}
+}
+
+func (c *changelog) record(suite string, changes []manpageChange) {
+	if len(changes) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bySuite[suite] = append(c.bySuite[suite], changes...)
+}
+
+// diffManpages compares the manpage file names (e.g. “i3.1.en.gz”)
+// which were present in a binary package directory before extraction
+// with the ones which were extracted from the new package version.
+func diffManpages(binarypkg, oldVersion, newVersion string, before, after map[string]bool) []manpageChange {
+	var changes []manpageChange
+	add := func(fn string, kind changeKind) {
+		changes = append(changes, manpageChange{
+			Binarypkg:  binarypkg,
+			Manpage:    strings.TrimSuffix(fn, ".gz"),
+			Change:     kind,
+			OldVersion: oldVersion,
+			NewVersion: newVersion,
+		})
+	}
+	for fn := range after {
+		if !before[fn] {
+			add(fn, manpageAdded)
+		} else if oldVersion != newVersion {
+			add(fn, manpageUpdated)
+		}
+	}
+	for fn := range before {
+		if !after[fn] {
+			add(fn, manpageRemoved)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Manpage < changes[j].Manpage
+	})
+	return changes
+}
+
+// recordDeletedPackages records the manpages of the binary packages
+// which were extracted before, but which are no longer in the archive
+// (or no longer ship manpages), as removed. Their manpages are left in
+// place, but their VERSION file is removed so that they are reported
+// only once (and re-extracted should they re-appear).
+func recordDeletedPackages(gv globalView) error {
+	present := make(map[string]bool, len(gv.pkgs))
+	for _, p := range gv.pkgs {
+		present[p.suite+"/"+p.binarypkg] = true
+	}
+	for suite := range gv.suites {
+		entries, err := ioutil.ReadDir(filepath.Join(gv.opts.ServingDir, suite))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		for _, e := range entries {
+			if !e.IsDir() || present[suite+"/"+e.Name()] {
+				continue
+			}
+			dir := filepath.Join(gv.opts.ServingDir, suite, e.Name())
+			vPath := filepath.Join(dir, "VERSION")
+			b, err := ioutil.ReadFile(vPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue // not a binary package, or already reported
+				}
+				return err
+			}
+			before, err := extractedManpages(dir)
+			if err != nil {
+				return err
+			}
+			slog.Info("binary package deleted", "stage", StageExtract, "suite", suite, "binarypkg", e.Name(), "manpages", len(before))
+			gv.changelog.record(suite, diffManpages(e.Name(), strings.TrimSpace(string(b)), "", before, nil))
+			if err := os.Remove(vPath); err != nil {
+				return err
+			}
+			atomic.AddUint64(&gv.stats.PackagesDeleted, 1)
+		}
+	}
+	return nil
+}
+
+func readChangelogRuns(path string) ([]changelogRun, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var runs []changelogRun
+	if err := json.NewDecoder(r).Decode(&runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// feedEntry groups the changes of one binary package within one run.
+type feedEntry struct {
+	ID      string
+	Title   string
+	Link    string
+	Updated time.Time
+	Summary string
+}
+
+func feedEntries(base, suite string, runs []changelogRun) []feedEntry {
+	var entries []feedEntry
+	for _, run := range runs {
+		var binarypkgs []string
+		byPkg := make(map[string][]manpageChange)
+		for _, c := range run.Changes {
+			if _, ok := byPkg[c.Binarypkg]; !ok {
+				binarypkgs = append(binarypkgs, c.Binarypkg)
+			}
+			byPkg[c.Binarypkg] = append(byPkg[c.Binarypkg], c)
+		}
+		sort.Strings(binarypkgs)
+		for _, binarypkg := range binarypkgs {
+			changes := byPkg[binarypkg]
+			title := fmt.Sprintf("%s %s", binarypkg, changes[0].NewVersion)
+			if old := changes[0].OldVersion; changes[0].NewVersion == "" {
+				title = fmt.Sprintf("%s %s (deleted)", binarypkg, old)
+			} else if old != "" && old != changes[0].NewVersion {
+				title = fmt.Sprintf("%s %s → %s", binarypkg, old, changes[0].NewVersion)
+			}
+			lines := make([]string, len(changes))
+			for idx, c := range changes {
+				lines[idx] = fmt.Sprintf("%s: %s", c.Change, c.Manpage)
+			}
+			entries = append(entries, feedEntry{
+				ID:      fmt.Sprintf("%s/%s/%s#%s", base, suite, binarypkg, run.Time.UTC().Format(iso8601Format)),
+				Title:   title,
+				Link:    fmt.Sprintf("%s/%s/%s/index.html", base, suite, binarypkg),
+				Updated: run.Time,
+				Summary: strings.Join(lines, "\n"),
+			})
+		}
+	}
+	return entries
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Link    atomLink `xml:"link"`
+	Updated string   `xml:"updated"`
+	Summary string   `xml:"summary"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Links   []atomLink  `xml:"link"`
+	Updated string      `xml:"updated"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+func writeAtom(w io.Writer, base, suite string, updated time.Time, entries []feedEntry) error {
+	feed := atomFeed{
+		ID:    fmt.Sprintf("%s/changes-%s.atom", base, suite),
+		Title: fmt.Sprintf("Manpage changes in Debian %s", suite),
+		Links: []atomLink{
+			{Href: fmt.Sprintf("%s/changes-%s.atom", base, suite), Rel: "self"},
+			{Href: fmt.Sprintf("%s/contents-%s.html", base, suite)},
+		},
+		Updated: updated.UTC().Format(iso8601Format),
+		Entries: make([]atomEntry, len(entries)),
+	}
+	for idx, e := range entries {
+		feed.Entries[idx] = atomEntry{
+			ID:      e.ID,
+			Title:   e.Title,
+			Link:    atomLink{Href: e.Link},
+			Updated: e.Updated.UTC().Format(iso8601Format),
+			Summary: e.Summary,
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(feed)
+}
+
+type rssItem struct {
+	GUID        string `xml:"guid"`
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	PubDate     string `xml:"pubDate"`
+	Description string `xml:"description"`
+}
+
+type rssFeed struct {
+	XMLName     xml.Name  `xml:"rss"`
+	Version     string    `xml:"version,attr"`
+	Title       string    `xml:"channel>title"`
+	Link        string    `xml:"channel>link"`
+	Description string    `xml:"channel>description"`
+	Items       []rssItem `xml:"channel>item"`
+}
+
+func writeRSS(w io.Writer, base, suite string, entries []feedEntry) error {
+	feed := rssFeed{
+		Version:     "2.0",
+		Title:       fmt.Sprintf("Manpage changes in Debian %s", suite),
+		Link:        fmt.Sprintf("%s/contents-%s.html", base, suite),
+		Description: fmt.Sprintf("Manpages added, updated and removed in Debian %s", suite),
+		Items:       make([]rssItem, len(entries)),
+	}
+	for idx, e := range entries {
+		feed.Items[idx] = rssItem{
+			GUID:        e.ID,
+			Title:       e.Title,
+			Link:        e.Link,
+			PubDate:     e.Updated.UTC().Format(time.RFC1123Z),
+			Description: e.Summary,
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(feed)
+}
+
+// writeChangelogs prepends the changes of the current run to the
+// per-suite changelog and renders the JSON changelog plus Atom and RSS
+// feeds into destDir.
+func writeChangelogs(destDir string, gv globalView, now time.Time) error {
+	for suite := range gv.suites {
+		jsonPath := filepath.Join(destDir, fmt.Sprintf("changes-%s.json.gz", suite))
+		runs, err := readChangelogRuns(jsonPath)
+		if err != nil {
+			return fmt.Errorf("reading %q: %v", jsonPath, err)
+		}
+		gv.changelog.mu.Lock()
+		changes := gv.changelog.bySuite[suite]
+		gv.changelog.mu.Unlock()
+		if len(changes) > 0 {
+			sort.SliceStable(changes, func(i, j int) bool {
+				return changes[i].Binarypkg < changes[j].Binarypkg
+			})
+			runs = append([]changelogRun{{Time: now, Changes: changes}}, runs...)
+		}
+		if len(runs) > maxChangelogRuns {
+			runs = runs[:maxChangelogRuns]
+		}
+		if runs == nil {
+			runs = []changelogRun{}
+		}
+
+		if err := write.Atomically(jsonPath, true, func(w io.Writer) error {
+			return json.NewEncoder(w).Encode(runs)
+		}); err != nil {
+			return err
+		}
+
+		updated := now
+		if len(runs) > 0 {
+			updated = runs[0].Time
+		}
+		entries := feedEntries(gv.opts.BaseURL, suite, runs)
+		if err := write.Atomically(filepath.Join(destDir, fmt.Sprintf("changes-%s.atom.gz", suite)), true, func(w io.Writer) error {
+			return writeAtom(w, gv.opts.BaseURL, suite, updated, entries)
+		}); err != nil {
+			return err
+		}
+		if err := write.Atomically(filepath.Join(destDir, fmt.Sprintf("changes-%s.rss.gz", suite)), true, func(w io.Writer) error {
+			return writeRSS(w, gv.opts.BaseURL, suite, entries)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package pipeline
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffManpages(t *testing.T) {
+	before := map[string]bool{
+		"i3.1.en.gz":        true,
+		"i3-msg.1.en.gz":    true,
+		"i3-nagbar.1.en.gz": true,
+	}
+	after := map[string]bool{
+		"i3.1.en.gz":     true,
+		"i3-msg.1.en.gz": true,
+		"i3bar.1.en.gz":  true,
+	}
+	got := diffManpages("i3-wm", "4.12-1", "4.13-1", before, after)
+	want := []manpageChange{
+		{Binarypkg: "i3-wm", Manpage: "i3-msg.1.en", Change: manpageUpdated, OldVersion: "4.12-1", NewVersion: "4.13-1"},
+		{Binarypkg: "i3-wm", Manpage: "i3-nagbar.1.en", Change: manpageRemoved, OldVersion: "4.12-1", NewVersion: "4.13-1"},
+		{Binarypkg: "i3-wm", Manpage: "i3.1.en", Change: manpageUpdated, OldVersion: "4.12-1", NewVersion: "4.13-1"},
+		{Binarypkg: "i3-wm", Manpage: "i3bar.1.en", Change: manpageAdded, OldVersion: "4.12-1", NewVersion: "4.13-1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected diffManpages() result: got %+v, want %+v", got, want)
+	}
+
+	// Re-extracting the same version must not result in updates.
+	if got := diffManpages("i3-wm", "4.13-1", "4.13-1", after, after); len(got) != 0 {
+		t.Fatalf("unexpected changes when re-extracting the same version: %+v", got)
+	}
+}
+
+func TestFeeds(t *testing.T) {
+	now := time.Date(2017, 1, 22, 14, 29, 53, 0, time.UTC)
+	runs := []changelogRun{
+		{
+			Time: now,
+			Changes: []manpageChange{
+				{Binarypkg: "i3-wm", Manpage: "i3.1.en", Change: manpageUpdated, OldVersion: "4.12-1", NewVersion: "4.13-1"},
+				{Binarypkg: "cron", Manpage: "crontab.5.en", Change: manpageAdded, NewVersion: "3.0pl1-128"},
+			},
+		},
+	}
+	entries := feedEntries("https://manpages.debian.org", "testing", runs)
+	if got, want := len(entries), 2; got != want {
+		t.Fatalf("unexpected number of feed entries: got %d, want %d", got, want)
+	}
+	if got, want := entries[1].Title, "i3-wm 4.12-1 → 4.13-1"; got != want {
+		t.Fatalf("unexpected feed entry title: got %q, want %q", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := writeAtom(&buf, "https://manpages.debian.org", "testing", now, entries); err != nil {
+		t.Fatal(err)
+	}
+	var atom atomFeed
+	if err := xml.Unmarshal(buf.Bytes(), &atom); err != nil {
+		t.Fatalf("parsing Atom feed: %v", err)
+	}
+	if got, want := len(atom.Entries), 2; got != want {
+		t.Fatalf("unexpected number of Atom entries: got %d, want %d", got, want)
+	}
+
+	buf.Reset()
+	if err := writeRSS(&buf, "https://manpages.debian.org", "testing", entries); err != nil {
+		t.Fatal(err)
+	}
+	var rss rssFeed
+	if err := xml.Unmarshal(buf.Bytes(), &rss); err != nil {
+		t.Fatalf("parsing RSS feed: %v", err)
+	}
+	if got, want := rss.Items[0].Link, "https://manpages.debian.org/testing/cron/index.html"; got != want {
+		t.Fatalf("unexpected RSS item link: got %q, want %q", got, want)
+	}
+}
+
+func TestRecordDeletedPackages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for fn, content := range map[string]string{
+		"testing/i3-wm/VERSION":             "4.13-1",
+		"testing/i3-wm/i3.1.en.gz":          "",
+		"testing/gone/VERSION":              "1.0-1",
+		"testing/gone/MANPAGES":             "gone.1.en.gz\n",
+		"testing/gone/gone.1.en.gz":         "",
+		"testing/gone/stale.1.en.gz":        "", // removed by an earlier version
+		"testing/reported/reported.1.en.gz": "",
+	} {
+		path := filepath.Join(dir, fn)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var stats Stats
+	gv := globalView{
+		pkgs:      []*pkgEntry{{suite: "testing", binarypkg: "i3-wm"}},
+		suites:    map[string]bool{"testing": true},
+		changelog: newChangelog(),
+		opts:      &Options{ServingDir: dir},
+		stats:     &stats,
+	}
+	for i := 0; i < 2; i++ {
+		if err := recordDeletedPackages(gv); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []manpageChange{
+		{Binarypkg: "gone", Manpage: "gone.1.en", Change: manpageRemoved, OldVersion: "1.0-1"},
+	}
+	if got := gv.changelog.bySuite["testing"]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected changes: got %+v, want %+v", got, want)
+	}
+	if got, want := stats.PackagesDeleted, uint64(1); got != want {
+		t.Errorf("PackagesDeleted: got %d, want %d", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "testing/gone/gone.1.en.gz")); err != nil {
+		t.Errorf("manpage of deleted package removed: %v", err)
+	}
+
+	entries := feedEntries("https://manpages.debian.org", "testing", []changelogRun{{Changes: want}})
+	if got, want := entries[0].Title, "gone 1.0-1 (deleted)"; got != want {
+		t.Errorf("unexpected feed entry title: got %q, want %q", got, want)
+	}
+}
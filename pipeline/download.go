@@ -0,0 +1,839 @@
+package pipeline
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Debian/debiman/internal/manpage"
+	"github.com/Debian/debiman/internal/recode"
+	"github.com/Debian/debiman/internal/write"
+
+	"pault.ag/go/archive"
+	"pault.ag/go/debian/control"
+	"pault.ag/go/debian/version"
+)
+
+// canSkip returns true if the package is present in the same (or a
+// newer) version on disk already, and was extracted with the same
+// settings (see extractSettings).
+func canSkip(p pkgEntry, vPath, settings string) bool {
+	v, err := ioutil.ReadFile(vPath)
+	if err != nil {
+		return false
+	}
+
+	recorded, err := ioutil.ReadFile(filepath.Join(filepath.Dir(vPath), "SETTINGS"))
+	if err != nil && !os.IsNotExist(err) {
+		return false
+	}
+	if string(recorded) != settings {
+		return false
+	}
+
+	vCurrent, err := version.Parse(string(v))
+	if err != nil {
+		slog.Warn("could not parse current package version", "path", vPath, "err", err)
+		return false
+	}
+
+	return version.Compare(vCurrent, p.version) >= 0
+}
+
+// extractSettings returns the options which determine which files
+// downloadPkg extracts, or "" for the defaults. They are recorded in the
+// SETTINGS file of each binary package directory, so that packages are
+// extracted again (e.g. from newly added Options.ExtraManRoots) once
+// the settings change.
+func extractSettings(opts *Options) string {
+	if len(opts.ExtraManRoots) == 0 {
+		return ""
+	}
+	return "extra_man_roots=" + strings.Join(opts.ExtraManRoots, ",") + "\n"
+}
+
+// writeExtractSettings records settings in dir, see canSkip.
+func writeExtractSettings(dir, settings string) error {
+	path := filepath.Join(dir, "SETTINGS")
+	if settings == "" {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return ioutil.WriteFile(path, []byte(settings), 0644)
+}
+
+type contentByBinarypkg []*contentEntry
+
+func (p contentByBinarypkg) Len() int           { return len(p) }
+func (p contentByBinarypkg) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p contentByBinarypkg) Less(i, j int) bool { return p[i].binarypkg < p[j].binarypkg }
+
+// chooseCandidate returns the entry of c (which all provide the same
+// path) which p most likely uses at runtime: the entry of p itself, or
+// else the entry of a package which p depends on, or else (to at least
+// make a deterministic choice) the first entry by package name. Only
+// entries in the suite of p are considered, nil is returned if there
+// are none.
+//
+// The user will see the conflicting packages in the navigation panel to
+// ultimately resolve the situation, if necessary.
+func chooseCandidate(p pkgEntry, c []*contentEntry) *contentEntry {
+	filtered := make([]*contentEntry, 0, len(c))
+	for _, e := range c {
+		if e.suite != p.suite {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	sort.Sort(contentByBinarypkg(filtered))
+	for _, e := range filtered {
+		if e.binarypkg == p.binarypkg {
+			return e
+		}
+	}
+	for _, e := range filtered {
+		for _, dep := range p.depends {
+			if e.binarypkg == dep {
+				return e
+			}
+		}
+	}
+	return filtered[0]
+}
+
+// findClosestFile returns the serving path for name, if name exists in
+// the same suite (see chooseCandidate). Non-manpage files are served
+// from the aux directory of the package which ships them.
+//
+// Only files underneath a man root can be found in other packages, as
+// the Contents files are parsed only for these paths. For other names,
+// "" is returned and the caller tries to extract name from p itself,
+// i.e. a symlink to a file outside of the man roots which another
+// package ships remains dangling.
+func findClosestFile(logger *pkgLogger, p pkgEntry, src, name string, roots manRoots, contentByPath map[string][]*contentEntry) string {
+	logger.lookups++
+	logger.Debug("findClosestFile", "src", src, "name", name)
+	rest, ok := roots.split(name)
+	if !ok {
+		return ""
+	}
+	c := chooseCandidate(p, contentByPath[rest])
+	if c == nil {
+		return ""
+	}
+
+	m, err := manpage.FromManPath(rest, &manpage.PkgMeta{
+		Binarypkg: c.binarypkg,
+		Suite:     c.suite,
+	})
+	logger.Debug("parsing as man", "path", name, "err", err)
+	if err == nil {
+		return m.ServingPath() + ".gz"
+	}
+
+	if c.binarypkg == p.binarypkg {
+		// Extracted as non-manpage file of p via the returned
+		// reference, see createAlternativesLinks and downloadPkg.
+		return ""
+	}
+	// downloadPkg extracts all non-manpage files underneath the man
+	// roots (see auxFiles), so the file will be present in
+	// the aux directory of the package which ships it.
+	return c.suite + "/" + c.binarypkg + "/aux" + name
+}
+
+// findFile resolves the .so reference name in src (belonging to p). It
+// returns the serving path of the referenced file and, if the file is
+// a non-manpage file of p which needs to be extracted, its path.
+func findFile(logger *pkgLogger, p pkgEntry, src, name string, roots manRoots, contentByPath map[string][]*contentEntry) (string, string, bool) {
+	// TODO(later): why is "/"+ in front of src necessary?
+	searchPath := []string{
+		"/" + filepath.Dir(src), // “.”
+		// To prefer manpages in the same language, add “..”, e.g.:
+		// /usr/share/man/fr/man7/bash-builtins.7 references
+		// man1/bash.1, which should be taken from
+		// /usr/share/man/fr/man1/bash.1 instead of
+		// /usr/share/man/man1/bash.1.
+		"/" + filepath.Dir(src) + "/..",
+		"/usr/share/man",
+	}
+	logger.lookups++
+	logger.Debug("searching reference", "so", name)
+	for _, search := range searchPath {
+		var check string
+		if filepath.IsAbs(name) {
+			check = filepath.Clean(name)
+		} else {
+			check = filepath.Join(search, name)
+		}
+		// Some references include the .gz suffix, some don’t.
+		if !strings.HasSuffix(check, ".gz") {
+			check = check + ".gz"
+		}
+
+		rest, ok := roots.split(check)
+		if !ok {
+			logger.Debug("reference candidate is not underneath a man root", "path", check)
+			continue
+		}
+		candidates, ok := contentByPath[rest]
+		if !ok {
+			logger.Debug("reference candidate does not exist", "path", check)
+			continue
+		}
+
+		c := chooseCandidate(p, candidates)
+		if c == nil {
+			// Only shipped in other suites: make a deterministic
+			// choice.
+			sorted := append([]*contentEntry{}, candidates...)
+			sort.Sort(contentByBinarypkg(sorted))
+			c = sorted[0]
+		}
+
+		m, err := manpage.FromManPath(rest, &manpage.PkgMeta{
+			Binarypkg: c.binarypkg,
+			Suite:     c.suite,
+		})
+		logger.Debug("parsing as man", "path", check, "err", err)
+		if err == nil {
+			return m.ServingPath() + ".gz", "", true
+		}
+
+		// Non-manpage files of other packages are extracted by
+		// downloadPkg of that package (see auxFiles).
+		var ref string
+		if c.suite == p.suite && c.binarypkg == p.binarypkg {
+			ref = check
+		}
+		return c.suite + "/" + c.binarypkg + "/aux" + check, ref, true
+	}
+	return name, "", false
+}
+
+func soElim(logger *pkgLogger, p pkgEntry, src string, r io.Reader, w io.Writer, roots manRoots, contentByPath map[string][]*contentEntry) ([]string, error) {
+	var refs []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, ".so ") {
+			fmt.Fprintln(w, line)
+			continue
+		}
+		so := strings.TrimSpace(line[len(".so "):])
+
+		resolved, ref, ok := findFile(logger, p, src, so, roots, contentByPath)
+		if !ok {
+			// Omitting .so lines which cannot be found is consistent
+			// with what man(1) and other online man viewers do.
+			logger.unresolvedSo++
+			logger.Warn("could not find .so referenced file, omitting the .so line", "manpage", src, "so", so)
+			continue
+		}
+
+		fmt.Fprintf(w, ".so %s\n", resolved)
+		if ref != "" {
+			refs = append(refs, ref)
+		}
+	}
+	return refs, scanner.Err()
+}
+
+func writeManpage(logger *pkgLogger, p pkgEntry, src, dest string, r io.Reader, m *manpage.Meta, roots manRoots, contentByPath map[string][]*contentEntry) ([]string, error) {
+	var refs []string
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(content) {
+		content, err = ioutil.ReadAll(recode.Reader(bytes.NewReader(content), m.Language))
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = write.Atomically(dest, true, func(w io.Writer) error {
+		var err error
+		refs, err = soElim(logger, p, src, bytes.NewReader(content), w, roots, contentByPath)
+		return err
+	})
+	return refs, err
+}
+
+// listManpageFiles returns the file names of all raw manpages
+// (e.g. “i3.1.en.gz”) in dir, which does not need to exist.
+func listManpageFiles(dir string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return names, nil
+		}
+		return nil, err
+	}
+	for _, e := range entries {
+		fn := e.Name()
+		if e.IsDir() ||
+			!strings.HasSuffix(fn, ".gz") ||
+			strings.HasSuffix(fn, ".html.gz") {
+			continue
+		}
+		names[fn] = true
+	}
+	return names, nil
+}
+
+// extractedManpages returns the file names of the manpages (e.g.
+// “i3.1.en.gz”) which were extracted into dir from the previous package
+// version, as recorded by writeManpageNames. Manpages which the previous
+// version no longer shipped might still be present in dir.
+func extractedManpages(dir string) (map[string]bool, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, "MANPAGES"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Extracted by an older debiman (or not at all).
+			return listManpageFiles(dir)
+		}
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, fn := range strings.Split(string(b), "\n") {
+		if fn != "" {
+			names[fn] = true
+		}
+	}
+	return names, nil
+}
+
+// writeManpageNames records the file names of the extracted manpages in
+// dir, see extractedManpages.
+func writeManpageNames(dir string, names map[string]bool) error {
+	sorted := make([]string, 0, len(names))
+	for fn := range names {
+		sorted = append(sorted, fn)
+	}
+	sort.Strings(sorted)
+	var b bytes.Buffer
+	for _, fn := range sorted {
+		b.WriteString(fn + "\n")
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "MANPAGES"), b.Bytes(), 0644)
+}
+
+// writePartialMarker creates the PARTIAL file in dir if the package was
+// read partially (see fetchPartially), and removes it otherwise.
+func writePartialMarker(dir string, partial bool) error {
+	path := filepath.Join(dir, "PARTIAL")
+	if partial {
+		return ioutil.WriteFile(path, nil, 0644)
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// readPartially returns whether the package in dir was read partially,
+// in which case it is read again completely once it is no longer
+// fetched partially (e.g. with -partial_fetch_min_size=0), so that the
+// manpages which the Contents files did not list are found.
+func readPartially(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "PARTIAL"))
+	return err == nil
+}
+
+// alternativesNames returns the file names of the slave alternative
+// links which createAlternativesLinks creates for p.
+func alternativesNames(p pkgEntry, gv globalView) map[string]bool {
+	names := make(map[string]bool)
+	for _, link := range gv.alternativesFor(p.suite + "/" + p.binarypkg) {
+		rest, ok := gv.manRoots.split(link.from)
+		if !ok {
+			continue
+		}
+		m, err := manpage.FromManPath(rest, &manpage.PkgMeta{
+			Binarypkg: p.binarypkg,
+			Suite:     p.suite,
+		})
+		if err != nil {
+			continue
+		}
+		names[filepath.Base(m.ServingPath())+".gz"] = true
+	}
+	return names
+}
+
+func createAlternativesLinks(logger *pkgLogger, p pkgEntry, gv globalView) (map[string]bool, error) {
+	refs := make(map[string]bool)
+	links := gv.alternativesFor(p.suite + "/" + p.binarypkg)
+	if len(links) == 0 {
+		return nil, nil
+	}
+	logger.Debug("creating alternatives links", "links", len(links))
+	for _, link := range links {
+		rest, ok := gv.manRoots.split(link.from)
+		if !ok {
+			continue
+		}
+
+		m, err := manpage.FromManPath(rest, &manpage.PkgMeta{
+			Binarypkg: p.binarypkg,
+			Suite:     p.suite,
+		})
+		if err != nil {
+			logger.unparseable++
+			logger.Debug("file name (underneath the man root) cannot be parsed", "manpage", link.from, "err", err)
+			continue
+		}
+
+		resolved := link.to
+		if !strings.HasSuffix(resolved, ".gz") {
+			resolved = resolved + ".gz"
+		}
+
+		destsp := findClosestFile(logger, p, link.from, resolved, gv.manRoots, gv.contentByPath)
+		if destsp == "" {
+			// Try to extract the resolved file as non-manpage
+			// file. If the resolved file does not live in this
+			// package, this will result in a dangling symlink.
+			refs[resolved] = true
+			destsp = filepath.Join(filepath.Dir(m.ServingPath()), "aux", resolved)
+			logger.danglingSymlinks++
+			logger.Warn("possibly dangling symlink", "manpage", link.from, "target", link.to, "resolved", destsp)
+		}
+
+		rel, err := filepath.Rel(filepath.Dir(m.ServingPath()), destsp)
+		if err != nil {
+			logger.Warn("cannot compute relative symlink target", "err", err)
+			continue
+		}
+
+		dest := filepath.Join(gv.opts.ServingDir, m.ServingPath()+".gz")
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return refs, err
+		}
+
+		if err := os.Symlink(rel, dest); err != nil {
+			if os.IsExist(err) {
+				continue
+			}
+			return refs, err
+		}
+	}
+	return refs, nil
+}
+
+func downloadPkg(ar *archive.Downloader, p pkgEntry, gv globalView) error {
+	vPath := filepath.Join(gv.opts.ServingDir, p.suite, p.binarypkg, "VERSION")
+
+	logger := newPkgLogger(p)
+
+	if !gv.forceReextract(p.suite) && canSkip(p, vPath, extractSettings(gv.opts)) && !gv.missingAux(p) &&
+		!(readPartially(filepath.Dir(vPath)) && !fetchPartially(ar, p, gv.opts)) {
+		// Even when skipping the package, the alternatives data we get from
+		// piuparts might have changed, see issue #119.
+		if _, err := createAlternativesLinks(logger, p, gv); err != nil {
+			return err
+		}
+
+		logger.Debug("package unchanged, skipping extraction")
+		gv.opts.Metrics.packagesDone.With("skipped").Inc()
+		return nil
+	}
+
+	// in is the package, fetched partially (see fetchPartially) or
+	// downloaded as a whole into a temporary file. remaining contains
+	// the paths underneath the man roots which were not yet seen; it
+	// is nil unless the package is fetched partially.
+	var (
+		in        io.ReaderAt
+		remaining map[string]bool
+	)
+	partial := fetchPartially(ar, p, gv.opts)
+	if partial {
+		remaining = gv.manEntries(p)
+		if len(remaining) == 0 {
+			// The Contents files might lag behind: without knowing
+			// which manpages to expect, only reading the whole
+			// package reveals them.
+			logger.Debug("no manpages listed in Contents, downloading the whole package")
+			partial = false
+			remaining = nil
+		}
+	}
+	if partial {
+		rr := newRangeReader(gv.opts.mirrors.httpClient(), ar.Mirror+"/"+p.filename, p.bytes, p.sha256)
+		defer func() {
+			rr.Close()
+			gv.opts.Metrics.recordDownload(ar, rr.fetched)
+			logger.Debug("fetched package partially", "fetched", rr.fetched, "size", p.bytes, "verified", rr.verified)
+		}()
+		in = rr
+	} else {
+		tmp, err := ar.TempFile(control.FileHash{
+			Filename:  p.filename,
+			Algorithm: "sha256",
+			Hash:      fmt.Sprintf("%x", p.sha256),
+		})
+		if err != nil {
+			return fmt.Errorf("archive download: %w", err)
+		}
+		gv.opts.Metrics.recordDownload(ar, p.bytes)
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		in = tmp
+	}
+
+	// Remember the previously extracted version for the changelog.
+	destdir := filepath.Join(gv.opts.ServingDir, p.suite, p.binarypkg)
+	var oldVersion string
+	if b, err := ioutil.ReadFile(vPath); err == nil {
+		oldVersion = string(b)
+	}
+	before, err := extractedManpages(destdir)
+	if err != nil {
+		return err
+	}
+	extracted := make(map[string]bool)
+
+	aux := newAuxFiles(logger, filepath.Join(destdir, "aux"))
+
+	var control func(*tar.Reader) error
+	if gv.derived != nil {
+		control = func(tr *tar.Reader) error {
+			links, err := postinstAlternatives(tr)
+			if err != nil {
+				return fmt.Errorf("postinst: %w", err)
+			}
+			var manLinks []link
+			for _, l := range links {
+				if _, ok := gv.manRoots.split(l.from); ok {
+					manLinks = append(manLinks, l)
+				}
+			}
+			logger.Debug("derived alternatives from postinst", "links", len(manLinks))
+			gv.derived.set(p.suite+"/"+p.binarypkg, manLinks)
+			return nil
+		}
+	}
+
+	data, closer, err := dataTar(in, control)
+	if err != nil {
+		return fmt.Errorf("loading %q: %w", p.filename, err)
+	}
+	defer closer.Close()
+	var complete bool // whether the end of data.tar was reached
+	for {
+		// seen is true when all manpages and referenced files were
+		// seen. The rest of the package is skipped unless data.tar
+		// ends with the next header anyway.
+		seen := remaining != nil && len(remaining) == 0 && !aux.waiting()
+		header, err := data.Next()
+		if err == io.EOF {
+			complete = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if seen {
+			break
+		}
+		contents, err := aux.pass(header, data)
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg &&
+			header.Typeflag != tar.TypeRegA &&
+			header.Typeflag != tar.TypeSymlink &&
+			header.Typeflag != tar.TypeLink {
+			continue
+		}
+		if header.FileInfo().IsDir() {
+			continue
+		}
+		rest, ok := gv.manRoots.split(header.Name)
+		if !ok {
+			continue
+		}
+		delete(remaining, rest)
+
+		if err := os.MkdirAll(destdir, 0755); err != nil {
+			return err
+		}
+
+		// TODO: return m?
+		m, err := manpage.FromManPath(rest, &manpage.PkgMeta{
+			Binarypkg: p.binarypkg,
+			Suite:     p.suite,
+		})
+
+		if err != nil {
+			logger.unparseable++
+			logger.Debug("file name (underneath the man root) cannot be parsed", "manpage", header.Name, "err", err)
+			if header.Typeflag == tar.TypeReg || header.Typeflag == tar.TypeRegA {
+				// The file might be referenced by a .so statement.
+				if err := aux.keep(strings.TrimPrefix(header.Name, "."), contents); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		destPath := filepath.Join(gv.opts.ServingDir, m.ServingPath()+".gz")
+		extracted[filepath.Base(destPath)] = true
+		if header.Typeflag == tar.TypeLink {
+			linkRest, ok := gv.manRoots.split(header.Linkname)
+			if !ok {
+				logger.unparseable++
+				logger.Debug("hard link target is not underneath a man root", "manpage", header.Linkname)
+				continue
+			}
+			d, err := manpage.FromManPath(linkRest, &manpage.PkgMeta{
+				Binarypkg: p.binarypkg,
+				Suite:     p.suite,
+			})
+			if err != nil {
+				logger.unparseable++
+				logger.Debug("hard link name (underneath the man root) cannot be parsed", "manpage", header.Linkname, "err", err)
+				continue
+			}
+			if err := os.Link(filepath.Join(gv.opts.ServingDir, d.ServingPath()+".gz"), destPath); err != nil {
+				if os.IsExist(err) {
+					continue
+				}
+				return err
+			}
+			continue
+		}
+		if header.Typeflag == tar.TypeSymlink {
+			// filepath.Join calls filepath.Abs
+			resolved := filepath.Join(filepath.Dir(strings.TrimPrefix(header.Name, ".")), header.Linkname)
+			if filepath.IsAbs(header.Linkname) {
+				// E.g. /usr/share/man/man1/foo.1.gz ->
+				// /opt/foo/share/man/man1/foo.1.gz, or a symlink into
+				// a directory which is not a man root, in which case
+				// the target is extracted to aux/ below.
+				resolved = filepath.Clean(header.Linkname)
+			}
+			if !strings.HasSuffix(resolved, ".gz") {
+				resolved = resolved + ".gz"
+			}
+
+			destsp := findClosestFile(logger, p, header.Name, resolved, gv.manRoots, gv.contentByPath)
+			if destsp == m.ServingPath()+".gz" {
+				// The symlink points to the same manpage in another
+				// man root, which is extracted itself.
+				continue
+			}
+			if destsp == "" {
+				// Try to extract the resolved file as non-manpage
+				// file. If the resolved file does not live in this
+				// package, this will result in a dangling symlink.
+				aux.reference(resolved)
+				destsp = filepath.Join(filepath.Dir(m.ServingPath()), "aux", resolved)
+				logger.danglingSymlinks++
+				logger.Warn("possibly dangling symlink", "manpage", header.Name, "target", header.Linkname)
+			}
+
+			rel, err := filepath.Rel(filepath.Dir(m.ServingPath()), destsp)
+			if err != nil {
+				logger.Warn("cannot compute relative symlink target", "err", err)
+				continue
+			}
+			if err := os.Symlink(rel, destPath); err != nil {
+				if os.IsExist(err) {
+					continue
+				}
+				return err
+			}
+			if err := maybeSetLinkMtime(destPath, header.ModTime); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		r := contents
+		var gzr *gzip.Reader
+		if strings.HasSuffix(header.Name, ".gz") {
+			gzr, err = gzip.NewReader(contents)
+			if err != nil {
+				return err
+			}
+			r = gzr
+		}
+		refs, err := writeManpage(logger, p, header.Name, destPath, r, m, gv.manRoots, gv.contentByPath)
+		if err != nil {
+			return err
+		}
+		if err := os.Chtimes(destPath, header.ModTime, header.ModTime); err != nil {
+			return err
+		}
+		if gzr != nil {
+			if err := gzr.Close(); err != nil {
+				return err
+			}
+		}
+
+		for _, r := range refs {
+			aux.reference(r)
+		}
+	}
+
+	// Create all symlinks for slave alternatives.
+	refs, err := createAlternativesLinks(logger, p, gv)
+	if err != nil {
+		return err
+	}
+	for r := range refs {
+		aux.reference(r)
+	}
+
+	// Extract the remaining referenced non-manpage files, if any, which
+	// requires another pass over data.tar (see auxFiles).
+	if aux.needsPass(complete) {
+		data, closer, err := dataTar(in, nil)
+		if err != nil {
+			return err
+		}
+		defer closer.Close()
+		for aux.pending() > 0 {
+			header, err := data.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := aux.pass(header, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	logger.summarize(len(extracted))
+	if hook := gv.opts.Hooks.PackageExtracted; hook != nil {
+		hook(p.suite, p.binarypkg, len(extracted))
+	}
+
+	for fn := range alternativesNames(p, gv) {
+		extracted[fn] = true
+	}
+	names := extracted
+	if !complete {
+		// The partial fetch stopped once all manpages listed in the
+		// Contents files were seen. As these might lag behind,
+		// manpages which were not seen cannot be considered removed:
+		// only the seen ones are compared, the others are carried
+		// over.
+		logger.Debug("package read partially")
+		seenBefore := make(map[string]bool)
+		names = make(map[string]bool, len(before)+len(extracted))
+		for fn := range extracted {
+			names[fn] = true
+			if before[fn] {
+				seenBefore[fn] = true
+			}
+		}
+		for fn := range before {
+			names[fn] = true
+		}
+		before = seenBefore
+	}
+	changes := diffManpages(p.binarypkg, oldVersion, p.version.String(), before, extracted)
+
+	if _, err := os.Stat(destdir); os.IsNotExist(err) {
+		// If the directory does not exist, we did not extract any
+		// manpages. Since Contents files are not precise (they
+		// might lag behind), this can happen occasionally.
+		return nil
+	}
+	if err := writeManpageNames(destdir, names); err != nil {
+		return err
+	}
+	if err := writePartialMarker(destdir, !complete); err != nil {
+		return err
+	}
+	if err := writeExtractSettings(destdir, extractSettings(gv.opts)); err != nil {
+		return err
+	}
+	if gv.providesAux(p) {
+		// Create aux/ even if the files listed in the Contents file
+		// were not found, so that p is not extracted again by every
+		// run (see missingAux).
+		if err := os.MkdirAll(filepath.Join(destdir, "aux"), 0755); err != nil {
+			return err
+		}
+	}
+	if err := ioutil.WriteFile(vPath, []byte(p.version.String()), 0644); err != nil {
+		return fmt.Errorf("Writing version file %q: %w", vPath, err)
+	}
+
+	gv.changelog.record(p.suite, changes)
+	atomic.AddUint64(&gv.stats.PackagesExtracted, 1)
+	gv.opts.Metrics.packagesDone.With("extracted").Inc()
+
+	return nil
+}
+
+// parallelDownload extracts all packages of gv. Packages which cannot
+// be extracted are quarantined (see extractPkg). When ctx is canceled,
+// no further packages are started, but the packages which are being
+// extracted are completed. Packages which the interrupted previous run
+// completed (see stageCheckpoint.Done) are skipped.
+func parallelDownload(ctx context.Context, ar *archive.Downloader, gv globalView) error {
+	eg, egctx := errgroup.WithContext(ctx)
+	downloadChan := make(chan pkgEntry)
+	for i := 0; i < gv.opts.DownloadConcurrency; i++ {
+		eg.Go(func() error {
+			for p := range downloadChan {
+				err := extractPkg(egctx, gv.downloader(p.suite, ar), p, gv)
+				gv.opts.Metrics.packagesQueued.Add(-1)
+				if err != nil {
+					return fmt.Errorf("downloading %s/src:%s %v: %v", p.suite, p.source, p.version, err)
+				}
+			}
+			return nil
+		})
+	}
+	gv.opts.Metrics.packagesQueued.Set(float64(len(gv.pkgs)))
+feed:
+	for _, p := range gv.pkgs {
+		if _, ok := gv.progress.done(p.suite + "/" + p.binarypkg); ok {
+			// Completed by the interrupted previous run.
+			gv.opts.Metrics.packagesQueued.Add(-1)
+			gv.opts.Metrics.packagesDone.With("skipped").Inc()
+			continue
+		}
+		select {
+		case downloadChan <- *p:
+		case <-egctx.Done():
+			break feed
+		}
+	}
+	close(downloadChan)
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
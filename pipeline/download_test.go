@@ -1,11 +1,15 @@
-package main
+package pipeline
 
 import (
 	"bytes"
-	"log"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+
+	"pault.ag/go/debian/version"
 )
 
 func TestWriteManpage(t *testing.T) {
@@ -96,6 +100,58 @@ func TestWriteManpage(t *testing.T) {
 			},
 		},
 
+		{
+			src:      "/usr/share/man/man1/otherpkgaux.1",
+			manpage:  ".so man1/common.inc\n",
+			want:     ".so jessie/foo-common/aux/usr/share/man/man1/common.inc.gz\n",
+			wantRefs: nil, // extracted by foo-common
+			pkg: pkgEntry{
+				binarypkg: "foo",
+				suite:     "jessie",
+				depends:   []string{"libc6", "foo-common"},
+			},
+			contentByPath: map[string][]*contentEntry{
+				"man1/common.inc.gz": []*contentEntry{
+					&contentEntry{
+						binarypkg: "aaa-common",
+						suite:     "jessie",
+					},
+					&contentEntry{
+						binarypkg: "foo-common",
+						suite:     "jessie",
+					},
+					&contentEntry{
+						binarypkg: "foo",
+						suite:     "stretch",
+					},
+				},
+			},
+		},
+
+		{
+			src:      "/usr/share/man/man1/dependency.1",
+			manpage:  ".so man1/shared.1\n",
+			want:     ".so jessie/zzz/shared.1.en.gz\n",
+			wantRefs: nil,
+			pkg: pkgEntry{
+				binarypkg: "bash",
+				suite:     "jessie",
+				depends:   []string{"zzz"},
+			},
+			contentByPath: map[string][]*contentEntry{
+				"man1/shared.1.gz": []*contentEntry{
+					&contentEntry{
+						binarypkg: "aaa",
+						suite:     "jessie",
+					},
+					&contentEntry{
+						binarypkg: "zzz",
+						suite:     "jessie",
+					},
+				},
+			},
+		},
+
 		// example for an absolute path: isdnutils-base/isdnctrl.8.en.gz uses .so /usr/share/man/man8/.isdnctrl_conf.8
 		{
 			src:      "/usr/share/man/man1/absolute.1",
@@ -160,8 +216,7 @@ func TestWriteManpage(t *testing.T) {
 
 			r := strings.NewReader(entry.manpage)
 			var buf bytes.Buffer
-			logger := log.New(os.Stderr, "", log.LstdFlags)
-			refs, err := soElim(logger, entry.src, r, &buf, entry.contentByPath)
+			refs, err := soElim(newPkgLogger(entry.pkg), entry.pkg, entry.src, r, &buf, newManRoots(nil), entry.contentByPath)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -179,3 +234,77 @@ func TestWriteManpage(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractedManpages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, fn := range []string{"i3.1.en.gz", "i3.1.en.html.gz", "stale.1.en.gz"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, fn), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Without MANPAGES (extracted by an older debiman), the manpages in
+	// dir are used.
+	got, err := extractedManpages(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]bool{"i3.1.en.gz": true, "stale.1.en.gz": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("extractedManpages: got %v, want %v", got, want)
+	}
+
+	// stale.1.en.gz is no longer shipped, but stays in dir: it must not
+	// be reported as removed again by the next version.
+	want := map[string]bool{"i3.1.en.gz": true}
+	if err := writeManpageNames(dir, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err = extractedManpages(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("extractedManpages: got %v, want %v", got, want)
+	}
+}
+
+func TestCanSkipSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	v, err := version.Parse("4.13-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := pkgEntry{suite: "testing", binarypkg: "i3-wm", version: v}
+	vPath := filepath.Join(dir, "VERSION")
+	if err := ioutil.WriteFile(vPath, []byte("4.13-1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !canSkip(p, vPath, extractSettings(&Options{})) {
+		t.Fatalf("canSkip: got false, want true for an unchanged package")
+	}
+
+	// Adding man roots must result in the package being extracted
+	// again (once).
+	settings := extractSettings(&Options{ExtraManRoots: []string{"/opt/*/share/man"}})
+	if canSkip(p, vPath, settings) {
+		t.Fatalf("canSkip: got true, want false after adding man roots")
+	}
+	if err := writeExtractSettings(dir, settings); err != nil {
+		t.Fatal(err)
+	}
+	if !canSkip(p, vPath, settings) {
+		t.Fatalf("canSkip: got false, want true after extracting with the new man roots")
+	}
+	if canSkip(p, vPath, extractSettings(&Options{})) {
+		t.Fatalf("canSkip: got true, want false after removing man roots")
+	}
+}
@@ -0,0 +1,52 @@
+package pipeline
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEndToEnd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err := Run(context.Background(), testOptions(t, dir)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResumeSkipsUnchangedStages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	opts := testOptions(t, dir)
+	if _, err := Run(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	journalPath := filepath.Join(opts.stateDir(), "journal.json")
+	first, err := loadJournal(journalPath, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Run(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	second, err := loadJournal(journalPath, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stage := range []Stage{StageExtract, StageRender, StageIndex} {
+		if got, want := second.Stages[stage].Started, first.Stages[stage].Started; !got.Equal(want) {
+			t.Errorf("stage %q unexpectedly re-run: started %v, previously started %v", stage, got, want)
+		}
+	}
+	if got, want := second.Stages[StageDiscover].Started, first.Stages[StageDiscover].Started; got.Equal(want) {
+		t.Errorf("stage %q unexpectedly skipped", StageDiscover)
+	}
+}
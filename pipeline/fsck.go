@@ -0,0 +1,278 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"html"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+// FsckKind identifies a kind of inconsistency in Options.ServingDir.
+type FsckKind string
+
+const (
+	// FsckOrphanedTempFile is a temporary file which was left behind
+	// by a write that never completed, e.g. because debiman was
+	// killed.
+	FsckOrphanedTempFile FsckKind = "orphaned temp file"
+
+	// FsckMissingHTML is a manpage without a rendered .html.gz file.
+	FsckMissingHTML FsckKind = "missing HTML"
+
+	// FsckStaleHTML is a rendered .html.gz file which is older than
+	// its manpage.
+	FsckStaleHTML FsckKind = "stale HTML"
+
+	// FsckDanglingSymlink is a symlink (e.g. for a slave alternative)
+	// whose target does not exist.
+	FsckDanglingSymlink FsckKind = "dangling symlink"
+
+	// FsckVersionMismatch is a binary package whose VERSION file
+	// disagrees with the package version in its rendered manpages.
+	FsckVersionMismatch FsckKind = "version mismatch"
+
+	// FsckCorruptIndex is a debiman-auxserver index which fails
+	// verification, see redirect.VerifyIndex.
+	FsckCorruptIndex FsckKind = "corrupt index"
+)
+
+// FsckProblem is an inconsistency found by Fsck.
+type FsckProblem struct {
+	Kind FsckKind
+
+	// Path is the affected file, relative to Options.ServingDir.
+	Path string
+
+	// Detail optionally describes the problem further.
+	Detail string
+}
+
+func (p FsckProblem) String() string {
+	if p.Detail == "" {
+		return fmt.Sprintf("%s: %s", p.Kind, p.Path)
+	}
+	return fmt.Sprintf("%s: %s (%s)", p.Kind, p.Path, p.Detail)
+}
+
+// orphanedTempFileAge is the minimum age of a debiman-* temporary file
+// before Fsck considers it orphaned. Younger files might belong to a
+// debiman run which is in progress.
+const orphanedTempFileAge = time.Hour
+
+var pkgVersionRe = regexp.MustCompile(`<span class="pkgversion" title="([^"]*)">`)
+
+// renderedVersion returns the package version contained in the
+// rendered manpage at path, or "" if it contains none.
+func renderedVersion(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	matches := pkgVersionRe.FindSubmatch(b)
+	if matches == nil {
+		return "", nil
+	}
+	return html.UnescapeString(string(matches[1])), nil
+}
+
+// Fsck verifies the consistency of Options.ServingDir, i.e. it finds
+// orphaned temporary files, manpages whose HTML version is missing or
+// out of date, dangling symlinks, binary packages whose VERSION file
+// disagrees with their rendered manpages and a corrupt debiman-auxserver
+// index. To keep the check cheap, only one rendered manpage per binary
+// package is compared with its VERSION file.
+//
+// If repair is true, orphaned temporary files, dangling symlinks (and
+// their HTML versions) and a corrupt index (which the next Run writes
+// again) are removed. Missing or stale HTML versions
+// and version mismatches are repaired by the next Run: Fsck removes the
+// VERSION files of mismatching packages and records in the run journal
+// that the extract and render stages must be re-run.
+func Fsck(ctx context.Context, opts Options, repair bool) ([]FsckProblem, error) {
+	servingDir, err := filepath.Abs(opts.ServingDir)
+	if err != nil {
+		return nil, err
+	}
+	opts.ServingDir = servingDir
+	if err := opts.migrateStateDir(); err != nil {
+		return nil, err
+	}
+	stateDir, err := filepath.Abs(opts.stateDir())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		problems  []FsckProblem
+		versions  = make(map[string]string) // by binary package directory
+		checked   = make(map[string]bool)   // binary package directories
+		reextract bool
+		rerender  bool
+		now       = time.Now()
+	)
+	report := func(kind FsckKind, path, detail string) {
+		rel, err := filepath.Rel(servingDir, path)
+		if err != nil {
+			rel = path
+		}
+		problems = append(problems, FsckProblem{Kind: kind, Path: rel, Detail: detail})
+	}
+	err = filepath.WalkDir(servingDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if strings.HasPrefix(name, "debiman-") {
+			fi, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if age := now.Sub(fi.ModTime()); age >= orphanedTempFileAge {
+				report(FsckOrphanedTempFile, path, fmt.Sprintf("last modified %v ago", age.Truncate(time.Minute)))
+				if repair {
+					if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+
+		if strings.HasPrefix(path, stateDir+string(filepath.Separator)) {
+			return nil // e.g. the index cache, which is not served
+		}
+
+		symlink := d.Type()&os.ModeSymlink != 0
+		if symlink {
+			if _, err := os.Stat(path); err != nil {
+				target, _ := os.Readlink(path)
+				report(FsckDanglingSymlink, path, "target "+target)
+				if repair {
+					for _, fn := range []string{path, strings.TrimSuffix(path, ".gz") + ".html.gz"} {
+						if err := os.Remove(fn); err != nil && !os.IsNotExist(err) {
+							return err
+						}
+					}
+				}
+				return nil
+			}
+		}
+
+		// Manpages live in <suite>/<binarypkg>/, see
+		// manpage.Meta.ServingPath.
+		rel, err := filepath.Rel(servingDir, path)
+		if err != nil {
+			return err
+		}
+		if strings.Count(rel, string(filepath.Separator)) != 2 ||
+			!strings.HasSuffix(name, ".gz") {
+			return nil
+		}
+		dir := filepath.Dir(path)
+
+		if strings.HasSuffix(name, ".html.gz") {
+			if name == "index.html.gz" || symlink || checked[dir] {
+				return nil
+			}
+			checked[dir] = true
+			want, ok := versions[dir]
+			if !ok {
+				b, err := ioutil.ReadFile(filepath.Join(dir, "VERSION"))
+				if err != nil {
+					if os.IsNotExist(err) {
+						return nil
+					}
+					return err
+				}
+				want = strings.TrimSpace(string(b))
+				versions[dir] = want
+			}
+			got, err := renderedVersion(path)
+			if err != nil {
+				return fmt.Errorf("%s: %v", path, err)
+			}
+			if got != "" && got != want {
+				report(FsckVersionMismatch, filepath.Join(dir, "VERSION"), fmt.Sprintf("VERSION %s, %s rendered from %s", want, name, got))
+				reextract = true
+				if repair {
+					if err := os.Remove(filepath.Join(dir, "VERSION")); err != nil && !os.IsNotExist(err) {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+
+		// The invariant is: each file ending in .gz must have a
+		// corresponding .html.gz file, whose modtime is >= the modtime
+		// of the .gz file (see walkManContents).
+		st, err := os.Lstat(path)
+		if err != nil {
+			return err
+		}
+		htmlst, err := os.Stat(strings.TrimSuffix(path, ".gz") + ".html.gz")
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			report(FsckMissingHTML, path, "")
+			rerender = true
+		} else if htmlst.ModTime().Before(st.ModTime()) {
+			report(FsckStaleHTML, path, fmt.Sprintf("HTML is %v older", st.ModTime().Sub(htmlst.ModTime())))
+			rerender = true
+		}
+		return nil
+	})
+	if err != nil {
+		return problems, err
+	}
+
+	indexPath := opts.indexPath()
+	if err := redirect.VerifyIndex(indexPath); err != nil && !os.IsNotExist(err) {
+		report(FsckCorruptIndex, indexPath, err.Error())
+		if repair {
+			if err := os.Remove(indexPath); err != nil && !os.IsNotExist(err) {
+				return problems, err
+			}
+		}
+	}
+
+	if repair && (reextract || rerender) {
+		j, err := loadJournal(filepath.Join(opts.stateDir(), "journal.json"), &opts)
+		if err != nil {
+			return problems, fmt.Errorf("loading run journal: %v", err)
+		}
+		if reextract {
+			delete(j.Stages, StageExtract)
+		}
+		delete(j.Stages, StageRender)
+		if err := j.persist(); err != nil {
+			return problems, fmt.Errorf("writing run journal: %v", err)
+		}
+	}
+	return problems, nil
+}
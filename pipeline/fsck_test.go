@@ -0,0 +1,346 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Debian/debiman/internal/redirect"
+)
+
+func TestFsck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	opts := testOptions(t, dir)
+	ctx := context.Background()
+	if _, err := Run(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	problems, err := Fsck(ctx, opts, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Fatalf("Fsck after a complete run: got %v, want no problems", problems)
+	}
+
+	// Find a binary package directory with at least two regular manpages.
+	var pkgdir string
+	var manpages []string
+	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Name() != "VERSION" || pkgdir != "" {
+			return nil
+		}
+		matches, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.html.gz"))
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			if filepath.Base(m) == "index.html.gz" {
+				continue
+			}
+			src := strings.TrimSuffix(m, ".html.gz") + ".gz"
+			if st, err := os.Lstat(src); err != nil || !st.Mode().IsRegular() {
+				continue
+			}
+			manpages = append(manpages, src)
+		}
+		if len(manpages) >= 2 {
+			pkgdir = filepath.Dir(path)
+		} else {
+			manpages = nil
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if pkgdir == "" {
+		t.Fatal("no binary package with two regular manpages found in testdata")
+	}
+	rel := func(path string) string {
+		r, err := filepath.Rel(dir, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	old := time.Now().Add(-2 * orphanedTempFileAge)
+	orphaned := filepath.Join(pkgdir, "debiman-123456")
+	inProgress := filepath.Join(pkgdir, "debiman-654321")
+	for _, fn := range []string{orphaned, inProgress} {
+		if err := ioutil.WriteFile(fn, []byte("partial"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chtimes(orphaned, old, old); err != nil {
+		t.Fatal(err)
+	}
+	missing, stale := manpages[0], manpages[1]
+	if err := os.Remove(strings.TrimSuffix(missing, ".gz") + ".html.gz"); err != nil {
+		t.Fatal(err)
+	}
+	newer := time.Now().Add(time.Minute)
+	if err := os.Chtimes(stale, newer, newer); err != nil {
+		t.Fatal(err)
+	}
+	dangling := filepath.Join(pkgdir, "dangling.1.en.gz")
+	if err := os.Symlink("aux/usr/share/man/man1/nonexistent.1.gz", dangling); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkgdir, "VERSION"), []byte("0.0-1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	problems, err = Fsck(ctx, opts, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, string(p.Kind)+" "+p.Path)
+	}
+	sort.Strings(got)
+	want := []string{
+		string(FsckDanglingSymlink) + " " + rel(dangling),
+		string(FsckMissingHTML) + " " + rel(missing),
+		string(FsckOrphanedTempFile) + " " + rel(orphaned),
+		string(FsckStaleHTML) + " " + rel(stale),
+		string(FsckVersionMismatch) + " " + rel(filepath.Join(pkgdir, "VERSION")),
+	}
+	sort.Strings(want)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Fsck: unexpected problems:\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	for _, fn := range []string{orphaned, dangling} {
+		if _, err := os.Lstat(fn); !os.IsNotExist(err) {
+			t.Errorf("%s not removed by repair (err = %v)", fn, err)
+		}
+	}
+	if _, err := os.Stat(inProgress); err != nil {
+		t.Errorf("temp file of a possibly running write removed: %v", err)
+	}
+	os.Remove(inProgress)
+
+	// The next run re-extracts and re-renders the affected manpages.
+	if _, err := Run(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+	problems, err = Fsck(ctx, opts, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Errorf("Fsck after repair and run: got %v, want no problems", problems)
+	}
+}
+
+func TestFsckCorruptIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	opts := Options{ServingDir: dir}
+	var buf bytes.Buffer
+	if err := redirect.WriteIndexEntries(&buf, redirect.Index{}, map[string][]redirect.IndexEntry{
+		"i3": {{Name: "i3", Suite: "jessie", Binarypkg: "i3-wm", Section: "1", Language: "en"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	b[len(b)-1] ^= 0xff // in the string pool, so only verification notices
+	if err := ioutil.WriteFile(opts.indexPath(), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	problems, err := Fsck(ctx, opts, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 1 || problems[0].Kind != FsckCorruptIndex || problems[0].Path != "auxserver.idx" {
+		t.Fatalf("Fsck: got %v, want one %s problem for auxserver.idx", problems, FsckCorruptIndex)
+	}
+	if _, err := os.Stat(opts.indexPath()); !os.IsNotExist(err) {
+		t.Fatalf("corrupt index not removed by repair (err = %v)", err)
+	}
+	// A missing index is not a problem: the next Run writes it.
+	if problems, err := Fsck(ctx, opts, false); err != nil || len(problems) > 0 {
+		t.Fatalf("Fsck without index: got %v, %v, want no problems", problems, err)
+	}
+}
+
+func TestFsckChecks(t *testing.T) {
+	old := time.Now().Add(-2 * orphanedTempFileAge)
+	now := time.Now()
+	// write creates the file path in pkgdir, last modified at mtime.
+	write := func(t *testing.T, pkgdir, path string, content []byte, mtime time.Time) {
+		t.Helper()
+		fn := filepath.Join(pkgdir, path)
+		if err := ioutil.WriteFile(fn, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(fn, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rendered := func(t *testing.T, version string) []byte {
+		return gzipped(t, `<span class="pkgversion" title="`+version+`">`+version+`</span>`)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		setup func(t *testing.T, pkgdir string)
+		// want is the problem found, if any, with its path relative
+		// to the binary package directory.
+		want      FsckKind
+		wantPath  string
+		removed   []string // by repair, relative to the package directory
+		wantRerun []Stage  // stages which the next Run must re-run
+	}{
+		{
+			name: "consistent",
+			setup: func(t *testing.T, pkgdir string) {
+				write(t, pkgdir, "VERSION", []byte("1.0-1"), old)
+				write(t, pkgdir, "test.1.en.gz", gzipped(t, ".TH TEST 1\n"), old)
+				write(t, pkgdir, "test.1.en.html.gz", rendered(t, "1.0-1"), now)
+			},
+		},
+
+		{
+			name: "orphaned temp file",
+			setup: func(t *testing.T, pkgdir string) {
+				write(t, pkgdir, "debiman-123456", []byte("partial"), old)
+			},
+			want:     FsckOrphanedTempFile,
+			wantPath: "debiman-123456",
+			removed:  []string{"debiman-123456"},
+		},
+
+		{
+			name: "temp file of a running write",
+			setup: func(t *testing.T, pkgdir string) {
+				write(t, pkgdir, "debiman-123456", []byte("partial"), now)
+			},
+		},
+
+		{
+			name: "missing HTML",
+			setup: func(t *testing.T, pkgdir string) {
+				write(t, pkgdir, "test.1.en.gz", gzipped(t, ".TH TEST 1\n"), old)
+			},
+			want:      FsckMissingHTML,
+			wantPath:  "test.1.en.gz",
+			wantRerun: []Stage{StageRender},
+		},
+
+		{
+			name: "stale HTML",
+			setup: func(t *testing.T, pkgdir string) {
+				write(t, pkgdir, "test.1.en.gz", gzipped(t, ".TH TEST 1\n"), now)
+				write(t, pkgdir, "test.1.en.html.gz", rendered(t, "1.0-1"), old)
+			},
+			want:      FsckStaleHTML,
+			wantPath:  "test.1.en.gz",
+			wantRerun: []Stage{StageRender},
+		},
+
+		{
+			name: "dangling symlink",
+			setup: func(t *testing.T, pkgdir string) {
+				if err := os.Symlink("nonexistent.1.en.gz", filepath.Join(pkgdir, "test.1.en.gz")); err != nil {
+					t.Fatal(err)
+				}
+				write(t, pkgdir, "test.1.en.html.gz", rendered(t, "1.0-1"), now)
+			},
+			want:     FsckDanglingSymlink,
+			wantPath: "test.1.en.gz",
+			removed:  []string{"test.1.en.gz", "test.1.en.html.gz"},
+		},
+
+		{
+			name: "version mismatch",
+			setup: func(t *testing.T, pkgdir string) {
+				write(t, pkgdir, "VERSION", []byte("2.0-1"), now)
+				write(t, pkgdir, "test.1.en.gz", gzipped(t, ".TH TEST 1\n"), old)
+				write(t, pkgdir, "test.1.en.html.gz", rendered(t, "1.0-1"), old)
+			},
+			want:      FsckVersionMismatch,
+			wantPath:  "VERSION",
+			removed:   []string{"VERSION"},
+			wantRerun: []Stage{StageExtract, StageRender},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "debiman")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			opts := Options{ServingDir: dir}
+			defer os.RemoveAll(opts.stateDir())
+			pkgdir := filepath.Join(dir, "sid", "test")
+			if err := os.MkdirAll(pkgdir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			tt.setup(t, pkgdir)
+
+			journalPath := filepath.Join(opts.stateDir(), "journal.json")
+			j, err := loadJournal(journalPath, &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, stage := range Stages {
+				j.Stages[stage] = &stageCheckpoint{Completed: now}
+			}
+			if err := j.persist(); err != nil {
+				t.Fatal(err)
+			}
+
+			problems, err := Fsck(context.Background(), opts, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []FsckProblem
+			if tt.want != "" {
+				want = []FsckProblem{{Kind: tt.want, Path: filepath.Join("sid", "test", tt.wantPath)}}
+			}
+			if len(problems) != len(want) || (len(want) > 0 && (problems[0].Kind != want[0].Kind || problems[0].Path != want[0].Path)) {
+				t.Fatalf("Fsck: got %v, want %v", problems, want)
+			}
+			for _, fn := range tt.removed {
+				if _, err := os.Lstat(filepath.Join(pkgdir, fn)); !os.IsNotExist(err) {
+					t.Errorf("%s not removed by repair (err = %v)", fn, err)
+				}
+			}
+
+			j, err = loadJournal(journalPath, &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rerun := make(map[Stage]bool)
+			for _, stage := range tt.wantRerun {
+				rerun[stage] = true
+			}
+			for _, stage := range Stages {
+				if _, ok := j.Stages[stage]; ok == rerun[stage] {
+					t.Errorf("stage %q recorded as completed: got %v, want %v", stage, ok, !rerun[stage])
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,9 @@
-package main
+package pipeline
 
 import (
 	"bufio"
 	"bytes"
-	"fmt"
 	"io"
-	"log"
-	"os"
 
 	"golang.org/x/sync/errgroup"
 
@@ -21,12 +18,10 @@ type contentEntry struct {
 	filename  string
 }
 
-var manPrefix = []byte("usr/share/man/")
-
-func parseContentsEntry(scanner *bufio.Scanner) ([]*contentEntry, error) {
+func parseContentsEntry(scanner *bufio.Scanner, roots manRoots) ([]*contentEntry, error) {
 	for scanner.Scan() {
 		text := scanner.Bytes()
-		if !bytes.HasPrefix(text, manPrefix) {
+		if !roots.candidate(text) {
 			continue
 		}
 
@@ -34,6 +29,10 @@ func parseContentsEntry(scanner *bufio.Scanner) ([]*contentEntry, error) {
 		if idx == -1 {
 			continue
 		}
+		filename, ok := roots.split(string(bytes.TrimSpace(text[:idx])))
+		if !ok {
+			continue
+		}
 		parts := bytes.Split(text[idx:], []byte{','})
 		entries := make([]*contentEntry, 0, len(parts))
 		for _, part := range parts {
@@ -43,7 +42,7 @@ func parseContentsEntry(scanner *bufio.Scanner) ([]*contentEntry, error) {
 			}
 			entries = append(entries, &contentEntry{
 				binarypkg: string(part[idx2+1:]),
-				filename:  string(bytes.TrimSpace(text[len(manPrefix):idx])),
+				filename:  filename,
 			})
 		}
 		if len(entries) > 0 {
@@ -58,8 +57,8 @@ func parseContentsEntry(scanner *bufio.Scanner) ([]*contentEntry, error) {
 	return nil, io.EOF
 }
 
-func getContents(ar *archive.Downloader, suite string, component string, archs []string, hashByFilename map[string]*control.SHA256FileHash) ([]*contentEntry, error) {
-	files := make([]*os.File, len(archs))
+func getContents(ar *archive.Downloader, rd *archive.ReleaseDownloader, ic *indexCache, suite string, component string, archs []string, hashByFilename map[string]*control.SHA256FileHash, roots manRoots) ([]*contentEntry, error) {
+	files := make([]*indexFile, len(archs))
 	scanners := make([]*bufio.Scanner, len(archs))
 	contents := make([][]*contentEntry, len(archs))
 	advance := make([]bool, len(archs))
@@ -70,14 +69,7 @@ func getContents(ar *archive.Downloader, suite string, component string, archs [
 		arch := arch // copy
 		eg.Go(func() error {
 			path := component + "/Contents-" + arch + ".gz"
-			fh, ok := hashByFilename[path]
-			if !ok {
-				return fmt.Errorf("ERROR: expected path %q not found in Release file", path)
-			}
-
-			log.Printf("getting %q (hash %v)", suite+"/"+path, fh.Hash)
-			fh.Filename = "dists/" + suite + "/" + fh.Filename
-			r, err := ar.TempFile(fh.FileHash)
+			r, err := ic.fetch(ar, rd, suite, path, hashByFilename)
 			if err != nil {
 				return err
 			}
@@ -87,7 +79,7 @@ func getContents(ar *archive.Downloader, suite string, component string, archs [
 			// Some packages have excessively large fields, see e.g.:
 			// https://bugs.debian.org/942487
 			scanners[idx].Buffer(nil, 512*1024)
-			contents[idx], err = parseContentsEntry(scanners[idx])
+			contents[idx], err = parseContentsEntry(scanners[idx], roots)
 			if err != nil {
 				if err == io.EOF {
 					exhausted[idx] = true
@@ -102,7 +94,6 @@ func getContents(ar *archive.Downloader, suite string, component string, archs [
 	defer func() {
 		for _, f := range files {
 			if f != nil {
-				os.Remove(f.Name())
 				f.Close()
 			}
 		}
@@ -118,7 +109,7 @@ func getContents(ar *archive.Downloader, suite string, component string, archs [
 				continue
 			}
 			var err error
-			contents[idx], err = parseContentsEntry(scanners[idx])
+			contents[idx], err = parseContentsEntry(scanners[idx], roots)
 			if err != nil {
 				if err == io.EOF {
 					exhausted[idx] = true
@@ -176,12 +167,11 @@ func getContents(ar *archive.Downloader, suite string, component string, archs [
 	return entries, nil
 }
 
-func getAllContents(ar *archive.Downloader, suite string, release *archive.Release, hashByFilename map[string]*control.SHA256FileHash) ([]*contentEntry, error) {
+func getAllContents(ar *archive.Downloader, rd *archive.ReleaseDownloader, ic *indexCache, suite string, components []string, release *archive.Release, hashByFilename map[string]*control.SHA256FileHash, roots manRoots) ([]*contentEntry, error) {
 	// We skip archAll, because there is no Contents-all file. The
 	// contents of Architecture: all packages are included in the
 	// architecture-specific Contents-* files.
 
-	var components = [...]string{"main", "contrib"}
 	parts := make([][]*contentEntry, len(components))
 	var sum int
 	for idx, component := range components {
@@ -190,7 +180,7 @@ func getAllContents(ar *archive.Downloader, suite string, release *archive.Relea
 			archs[idx] = arch.String()
 		}
 
-		part, err := getContents(ar, suite, component, archs, hashByFilename)
+		part, err := getContents(ar, rd, ic, suite, component, archs, hashByFilename, roots)
 		if err != nil {
 			return nil, err
 		}
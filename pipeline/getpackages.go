@@ -1,13 +1,11 @@
-package main
+package pipeline
 
 import (
 	"bufio"
 	"bytes"
 	"encoding/hex"
-	"fmt"
 	"io"
-	"log"
-	"os"
+	"log/slog"
 	"strconv"
 	"strings"
 
@@ -25,11 +23,16 @@ type pkgEntry struct {
 	suite     string
 	binarypkg string
 	arch      string
+	component string
 	filename  string
 	version   version.Version
 	sha256    []byte
 	bytes     int64
 	replaces  []string
+
+	// depends contains the names of the packages in the Depends and
+	// Pre-Depends fields, including all alternatives.
+	depends []string
 }
 
 // TODO(later): containsMans could be a map[string]bool, if only all
@@ -58,7 +61,7 @@ func buildContainsMains(content []*contentEntry, links map[string][]link) map[st
 			containsMans[binarypkg] = map[string]bool{mostPopularArchitecture: true}
 		}
 	}
-	log.Printf("%d content entries, %d packages\n", len(content), len(containsMans))
+	slog.Info("identified packages containing manpages", "stage", StageDiscover, "content_entries", len(content), "packages", len(containsMans))
 	return containsMans
 }
 
@@ -71,8 +74,28 @@ var (
 	prefixSize     = []byte("Size")
 	prefixSHA256   = []byte("SHA256")
 	prefixReplaces = []byte("Replaces")
+
+	prefixDepends    = []byte("Depends")
+	prefixPreDepends = []byte("Pre-Depends")
 )
 
+// relationNames returns the package names of a relationship field such
+// as Depends, e.g. “libc6 (>= 2.4), foo | bar:any” results in libc6,
+// foo and bar.
+func relationNames(field string) []string {
+	var names []string
+	for _, rel := range strings.FieldsFunc(field, func(r rune) bool { return r == ',' || r == '|' }) {
+		rel = strings.TrimSpace(rel)
+		if idx := strings.IndexAny(rel, " (:[<"); idx > -1 {
+			rel = rel[:idx]
+		}
+		if rel != "" {
+			names = append(names, rel)
+		}
+	}
+	return names
+}
+
 func parsePackageParagraph(scanner *bufio.Scanner, arch string, containsMans map[string]map[string]bool) (pkgEntry, error) {
 	var entry pkgEntry
 	for scanner.Scan() {
@@ -121,6 +144,8 @@ func parsePackageParagraph(scanner *bufio.Scanner, arch string, containsMans map
 				}
 				entry.replaces = append(entry.replaces, pkg)
 			}
+		} else if bytes.Equal(key, prefixDepends) || bytes.Equal(key, prefixPreDepends) {
+			entry.depends = append(entry.depends, relationNames(string(text[idx+2:]))...)
 		}
 
 		if entry.binarypkg != "" &&
@@ -165,8 +190,8 @@ func done(exhausted []bool) bool {
 	return true
 }
 
-func getPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite string, component string, archs []string, hashByFilename map[string]*control.SHA256FileHash, containsMans map[string]map[string]bool) ([]*pkgEntry, map[string]*manpage.PkgMeta, error) {
-	files := make([]*os.File, len(archs))
+func getPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, ic *indexCache, suite string, component string, archs []string, hashByFilename map[string]*control.SHA256FileHash, containsMans map[string]map[string]bool) ([]*pkgEntry, map[string]*manpage.PkgMeta, error) {
+	files := make([]*indexFile, len(archs))
 	scanners := make([]*bufio.Scanner, len(archs))
 	pkgs := make([]pkgEntry, len(archs))
 	advance := make([]bool, len(archs))
@@ -178,17 +203,10 @@ func getPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite st
 		eg.Go(func() error {
 			// Prefer gzip over xz because gzip uncompresses faster.
 			path := component + "/binary-" + arch + "/Packages.gz"
-			fh, ok := hashByFilename[path]
-			if !ok {
+			if _, ok := hashByFilename[path]; !ok {
 				path = component + "/binary-" + arch + "/Packages.xz"
-				fh, ok = hashByFilename[path]
-				if !ok {
-					return fmt.Errorf("ERROR: expected path %q not found in Release file", path)
-				}
 			}
-
-			log.Printf("getting %q (hash %v)", suite+"/"+path, fh.Hash)
-			r, err := rd.TempFile(fh.FileHash)
+			r, err := ic.fetch(ar, rd, suite, path, hashByFilename)
 			if err != nil {
 				return err
 			}
@@ -205,7 +223,6 @@ func getPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite st
 	defer func() {
 		for _, f := range files {
 			if f != nil {
-				os.Remove(f.Name())
 				f.Close()
 			}
 		}
@@ -309,25 +326,15 @@ func getPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite st
 	}
 
 	result := make([]*pkgEntry, 0, len(byVersion))
-	latestVersion := make(map[string]*manpage.PkgMeta, len(byVersion))
-	for key, p := range byVersion {
+	for _, p := range byVersion {
+		p.component = component
 		result = append(result, p)
-		latestVersion[key] = &manpage.PkgMeta{
-			Replaces:  p.replaces,
-			Component: component,
-			Filename:  p.filename,
-			Sourcepkg: p.source,
-			Binarypkg: p.binarypkg,
-			Suite:     p.suite,
-			Version:   p.version,
-		}
 	}
 
-	return result, latestVersion, nil
+	return result, latestVersions(result), nil
 }
 
-func getAllPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite string, release *archive.Release, hashByFilename map[string]*control.SHA256FileHash, containsMans map[string]map[string]bool) ([]*pkgEntry, map[string]*manpage.PkgMeta, error) {
-	var components = [...]string{"main", "contrib"}
+func getAllPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, ic *indexCache, suite string, components []string, release *archive.Release, hashByFilename map[string]*control.SHA256FileHash, containsMans map[string]map[string]bool) ([]*pkgEntry, map[string]*manpage.PkgMeta, error) {
 	partsp := make([][]*pkgEntry, len(components))
 	partsl := make([]map[string]*manpage.PkgMeta, len(components))
 	latestVersion := make(map[string]*manpage.PkgMeta)
@@ -337,7 +344,7 @@ func getAllPackages(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite
 		for idx, arch := range release.Architectures {
 			archs[idx] = arch.String()
 		}
-		partp, partl, err := getPackages(ar, rd, suite, component, archs, hashByFilename, containsMans)
+		partp, partl, err := getPackages(ar, rd, ic, suite, component, archs, hashByFilename, containsMans)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -0,0 +1,22 @@
+package pipeline
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRelationNames(t *testing.T) {
+	for _, tt := range []struct {
+		field string
+		want  []string
+	}{
+		{"", nil},
+		{"libc6 (>= 2.4)", []string{"libc6"}},
+		{"libc6 (>= 2.4), groff-base | mandoc, perl:any", []string{"libc6", "groff-base", "mandoc", "perl"}},
+		{"foo [amd64], bar (<< 2) <!nocheck>", []string{"foo", "bar"}},
+	} {
+		if got, want := strings.Join(relationNames(tt.field), ","), strings.Join(tt.want, ","); got != want {
+			t.Errorf("relationNames(%q): got %q, want %q", tt.field, got, want)
+		}
+	}
+}
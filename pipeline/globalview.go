@@ -0,0 +1,548 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Debian/debiman/internal/manpage"
+
+	"pault.ag/go/archive"
+	"pault.ag/go/debian/control"
+)
+
+// mostPopularArchitecture is used as preferred architecture when we
+// need to pick an arbitrary architecture. The rationale is that
+// downloading the package for the most popular architecture has the
+// least bad influence on the mirror server’s caches.
+const mostPopularArchitecture = "amd64"
+
+// Stats summarizes the work done by a run.
+type Stats struct {
+	Packages          uint64 // binary packages in all suites
+	PackagesExtracted uint64
+	PackagesFailed    uint64 // packages which could not be extracted
+	PackagesDeleted   uint64
+	ManpagesRendered  uint64
+	ManpageBytes      uint64
+	HTMLBytes         uint64
+	IndexBytes        uint64
+}
+
+type link struct {
+	from string
+	to   string
+}
+
+type globalView struct {
+	// pkgs contains all binary packages we know of.
+	pkgs []*pkgEntry
+
+	// suites contains the Debian suites that we know of. Can either be a codename or a suite,
+	// depending on Options.SyncCodenames and Options.SyncSuites.
+	// e.g. “stretch” (codename) or “stable” (suite)
+	suites map[string]bool
+
+	// idxSuites maps codename, suite and command-line argument to suite (as in
+	// suites).
+	// e.g. map[oldoldstable:wheezy wheezy:wheezy]
+	idxSuites map[string]string
+
+	// contentByPath maps from paths underneath a man root (see
+	// manRoots) to a contentEntry.
+	contentByPath map[string][]*contentEntry
+
+	// manRoots are the directories underneath which manpages are
+	// extracted, see Options.ExtraManRoots.
+	manRoots manRoots
+
+	// contentByPkg maps from suite/binarypkg to the contentEntries of
+	// that binary package (see fetchPartially).
+	contentByPkg map[string][]*contentEntry
+
+	// xref maps from manpage.Meta.Name (e.g. “w3m” or “systemd.service”) to
+	// the corresponding manpage.Meta.
+	xref map[string][]*manpage.Meta
+
+	// alternatives maps from Debian binary package to a slice of
+	// links (from→to pairs).
+	alternatives map[string][]link
+
+	// derived are the slave alternative links which were derived from
+	// maintainer scripts (see Options.AlternativesFromMaintscripts), or
+	// nil. Use alternativesFor to look up the links of a package.
+	derived *derivedAlternatives
+
+	// releaseHashes maps from suite to a fingerprint of the SHA256
+	// entries of its Release file (see releaseFingerprint).
+	releaseHashes map[string]string
+
+	// suiteConfigs maps from suite to the settings which apply to it
+	// (see Options.Suites).
+	suiteConfigs map[string]suiteConfig
+
+	// changelog collects the manpages added, updated and removed by
+	// this run.
+	changelog *changelog
+
+	// opts are the options of the run, with defaults applied.
+	opts *Options
+
+	// diagnostics collects render failures and the slowest pages for
+	// the run report.
+	diagnostics *renderDiagnostics
+
+	// quarantine collects the packages which could not be extracted.
+	quarantine *quarantine
+
+	// progress records the binary packages completed by the running
+	// extract or render stage, or is nil.
+	progress *stageCheckpoint
+
+	stats *Stats
+	start time.Time
+}
+
+type distributionIdentifier int
+
+const (
+	fromCodename = iota
+	fromSuite
+)
+
+type distribution struct {
+	name       string
+	identifier distributionIdentifier
+}
+
+// distributions returns a list of all distributions (either codenames
+// [e.g. wheezy, jessie] or suites [e.g. testing, unstable]) from the
+// SyncCodenames and SyncSuites options.
+func distributions(codenames []string, suites []string) []distribution {
+	distributions := make([]distribution, 0, len(codenames)+len(suites))
+	for _, e := range codenames {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		distributions = append(distributions, distribution{
+			name:       e,
+			identifier: fromCodename})
+	}
+	for _, e := range suites {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		distributions = append(distributions, distribution{
+			name:       e,
+			identifier: fromSuite})
+	}
+	return distributions
+}
+
+func parseAlternativesFile(fn, prefix string) (map[string][]link, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	res := make(map[string][]link)
+	dec := json.NewDecoder(r)
+	// read open bracket
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	for dec.More() {
+		var m struct {
+			Binpackage string
+			From       string
+			To         string
+		}
+		if err := dec.Decode(&m); err != nil {
+			return nil, err
+		}
+		slog.Debug("adding alternatives link", "from", m.From, "to", m.To, "binarypkg", m.Binpackage)
+		key := prefix + "/" + m.Binpackage
+		res[key] = append(res[key], link{
+			from: m.From,
+			to:   m.To,
+		})
+	}
+	return res, nil
+}
+
+func parseAlternativesDir(dir string) (map[string][]link, error) {
+	if dir == "" {
+		return map[string][]link{}, nil
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]map[string][]link, len(infos))
+	var eg errgroup.Group
+	for idx, fi := range infos {
+		idx, fi := idx, fi // copy
+		eg.Go(func() error {
+			suite := strings.TrimSuffix(fi.Name(), ".json.gz")
+			res, err := parseAlternativesFile(filepath.Join(dir, fi.Name()), suite)
+			results[idx] = res
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	// Merge all subresults into one map. This is non-destructive
+	// because the keys are prefixed by the Debian suite, which is
+	// derived from the filename and hence unique.
+	merged := make(map[string][]link)
+	for idx := range infos {
+		for key, val := range results[idx] {
+			merged[key] = val
+		}
+	}
+	return merged, nil
+}
+
+func markPresent(latestVersion map[string]*manpage.PkgMeta, xref map[string][]*manpage.Meta, filename string, key string) error {
+	if _, ok := latestVersion[key]; !ok {
+		return fmt.Errorf("Could not determine latest version")
+	}
+	m, err := manpage.FromManPath(strings.TrimPrefix(filename, "usr/share/man/"), latestVersion[key])
+	if err != nil {
+		return fmt.Errorf("Trying to interpret path %q: %v", filename, err)
+	}
+	// NOTE(stapelberg): this additional verification step
+	// is necessary because manpages such as the French
+	// manpage for qelectrotech(1) are present in multiple
+	// encodings. manpageFromManPath ignores encodings, so
+	// if we didn’t filter, we would end up with what
+	// looks like duplicates.
+	present := false
+	for _, x := range xref[m.Name] {
+		if x.ServingPath() == m.ServingPath() {
+			present = true
+			break
+		}
+	}
+	if !present {
+		xref[m.Name] = append(xref[m.Name], m)
+	}
+	return nil
+}
+
+// downloader returns the archive.Downloader for suite, or fallback if
+// suite uses the default mirror.
+func (gv globalView) downloader(suite string, fallback *archive.Downloader) *archive.Downloader {
+	if cfg, ok := gv.suiteConfigs[suite]; ok && cfg.ar != nil {
+		return cfg.ar
+	}
+	return fallback
+}
+
+// forceRerender returns whether all manpages of suite must be
+// re-rendered (see Options.ForceRerender).
+func (gv globalView) forceRerender(suite string) bool {
+	if cfg, ok := gv.suiteConfigs[suite]; ok {
+		return cfg.forceRerender
+	}
+	return gv.opts.ForceRerender
+}
+
+// forceReextract returns whether all packages of suite must be
+// re-extracted (see Options.ForceReextract).
+func (gv globalView) forceReextract(suite string) bool {
+	if cfg, ok := gv.suiteConfigs[suite]; ok {
+		return cfg.forceReextract
+	}
+	return gv.opts.ForceReextract
+}
+
+// anyForced returns whether force returns true for any suite.
+func (gv globalView) anyForced(force func(suite string) bool) bool {
+	for suite := range gv.suites {
+		if force(suite) {
+			return true
+		}
+	}
+	return false
+}
+
+// newGlobalView returns a globalView without any suites, which
+// buildGlobalView and loadGlobalView populate.
+func newGlobalView(opts *Options, start time.Time) (globalView, error) {
+	res := globalView{
+		suites:        make(map[string]bool),
+		idxSuites:     make(map[string]string),
+		contentByPath: make(map[string][]*contentEntry),
+		contentByPkg:  make(map[string][]*contentEntry),
+		manRoots:      newManRoots(opts.ExtraManRoots),
+		xref:          make(map[string][]*manpage.Meta),
+		releaseHashes: make(map[string]string),
+		suiteConfigs:  make(map[string]suiteConfig),
+		changelog:     newChangelog(),
+		opts:          opts,
+		diagnostics:   &renderDiagnostics{servingDir: opts.ServingDir},
+		quarantine:    &quarantine{},
+		stats:         &Stats{},
+		start:         start,
+	}
+
+	var err error
+	res.alternatives, err = parseAlternativesDir(opts.AlternativesDir)
+	if err != nil {
+		return res, err
+	}
+	if opts.AlternativesFromMaintscripts {
+		res.derived, err = loadDerivedAlternatives(filepath.Join(opts.stateDir(), "alternatives"))
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func buildGlobalView(ar *archive.Downloader, opts *Options, dists []distribution, start time.Time) (globalView, error) {
+	res, err := newGlobalView(opts, start)
+	if err != nil {
+		return res, err
+	}
+	alternatives, err := alternativesFingerprint(opts.AlternativesDir)
+	if err != nil {
+		return res, err
+	}
+
+	ic := newIndexCache(opts)
+	for _, dist := range dists {
+		cfg, err := opts.suiteConfig(dist.name, ar)
+		if err != nil {
+			return res, err
+		}
+		ar := cfg.ar
+		release, rd, err := ar.Release(dist.name)
+		if err != nil {
+			return res, err
+		}
+
+		var suite string
+		if dist.identifier == fromCodename {
+			suite = release.Codename // e.g. “stretch”
+		} else {
+			suite = release.Suite // e.g. “stable”
+		}
+
+		res.suites[suite] = true
+		res.idxSuites[release.Suite] = suite
+		res.idxSuites[release.Codename] = suite
+		res.idxSuites[dist.name] = suite
+		res.suiteConfigs[suite] = cfg
+		// Changing the components or man roots changes the set of
+		// packages, so cached results must not be re-used.
+		res.releaseHashes[suite] = fingerprint(releaseFingerprint(release), strings.Join(cfg.components, ","), strings.Join(opts.ExtraManRoots, ","))
+
+		var latestVersion map[string]*manpage.PkgMeta
+		cachePath := suiteCachePath(opts.stateDir(), suite)
+		cacheKey := suiteCacheKey(res.releaseHashes[suite], alternatives)
+		content, pkgs, cached, err := loadSuiteCache(cachePath, suite, cacheKey)
+		if err != nil {
+			slog.Warn("ignoring unreadable suite cache", "stage", StageDiscover, "suite", suite, "path", cachePath, "err", err)
+			cached = false
+		}
+		if cached {
+			slog.Info("release unchanged, loaded suite cache", "stage", StageDiscover, "suite", suite, "content_entries", len(content), "packages", len(pkgs), "path", cachePath)
+			latestVersion = latestVersions(pkgs)
+		} else {
+			hashByFilename := make(map[string]*control.SHA256FileHash, len(release.SHA256))
+			for idx, fh := range release.SHA256 {
+				// fh.Filename contains e.g. “non-free/source/Sources”
+				hashByFilename[fh.Filename] = &(release.SHA256[idx])
+			}
+
+			content, err = getAllContents(ar, rd, ic, suite, cfg.components, release, hashByFilename, res.manRoots)
+			if err != nil {
+				return res, err
+			}
+
+			// Collect package download work units
+			pkgs, latestVersion, err = getAllPackages(ar, rd, ic, suite, cfg.components, release, hashByFilename, buildContainsMains(content, res.alternatives))
+			if err != nil {
+				return res, err
+			}
+
+			if err := writeSuiteCache(cachePath, cacheKey, content, pkgs); err != nil {
+				return res, fmt.Errorf("writing cache %q: %v", cachePath, err)
+			}
+		}
+
+		res.addSuite(suite, content, pkgs, latestVersion)
+	}
+	res.stats.Packages = uint64(len(res.pkgs))
+	return res, nil
+}
+
+// addSuite adds the content and package entries of suite to gv.
+func (gv *globalView) addSuite(suite string, content []*contentEntry, pkgs []*pkgEntry, latestVersion map[string]*manpage.PkgMeta) {
+	for _, c := range content {
+		gv.contentByPath[c.filename] = append(gv.contentByPath[c.filename], c)
+		key := c.suite + "/" + c.binarypkg
+		gv.contentByPkg[key] = append(gv.contentByPkg[key], c)
+	}
+
+	slog.Info("adding packages", "stage", StageDiscover, "suite", suite, "packages", len(pkgs))
+	gv.pkgs = append(gv.pkgs, pkgs...)
+
+	knownIssues := make(map[string][]error)
+
+	// Build a global view of all the manpages (required for cross-referencing).
+	// TODO(issue): edge case: packages which got renamed between releases
+	for _, c := range content {
+		key := c.suite + "/" + c.binarypkg
+		if err := markPresent(latestVersion, gv.xref, c.filename, key); err != nil {
+			knownIssues[key] = append(knownIssues[key], err)
+		}
+	}
+
+	for key, links := range gv.allAlternatives() {
+		knownIssues[key] = append(knownIssues[key], gv.markLinksPresent(latestVersion, key, links)...)
+	}
+
+	for key, errors := range knownIssues {
+		if len(errors) == 0 {
+			continue
+		}
+		// TODO: write these to a known-issues file, parse bug numbers from an auxiliary file
+		slog.Warn("package has errors", "stage", StageDiscover, "package", key, "errors", errors)
+	}
+}
+
+// markLinksPresent adds the manpages which the slave alternative links
+// of key (suite/binarypkg) provide to gv.xref.
+func (gv *globalView) markLinksPresent(latestVersion map[string]*manpage.PkgMeta, key string, links []link) []error {
+	var errs []error
+	for _, link := range links {
+		slog.Debug("marking alternatives link present", "key", key, "from", link.from, "to", link.to, "latest", latestVersion[key])
+		from := strings.TrimPrefix(link.from, "/")
+		if rest, ok := gv.manRoots.split(from); ok {
+			from = defaultManRoot + "/" + rest
+		}
+		if err := markPresent(latestVersion, gv.xref, from, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// discoveredSuite is the output of the discover stage for one suite,
+// persisted in the run journal so that the subsequent stages can be
+// run separately (see subcommands).
+type discoveredSuite struct {
+	// Names contains the codenames, suites and command-line arguments
+	// which refer to the suite, see globalView.idxSuites.
+	Names []string `json:"names"`
+
+	// ReleaseHash identifies the suite’s cached Contents and Packages
+	// parsing results, see globalView.releaseHashes.
+	ReleaseHash string `json:"release_hash"`
+
+	Components []string `json:"components"`
+
+	// ExtraManRoots are the Options.ExtraManRoots with which the
+	// suite was discovered.
+	ExtraManRoots []string `json:"extra_man_roots,omitempty"`
+}
+
+// discovered returns the discover stage outputs of gv.
+func (gv globalView) discovered() map[string]*discoveredSuite {
+	res := make(map[string]*discoveredSuite, len(gv.suites))
+	for suite := range gv.suites {
+		res[suite] = &discoveredSuite{
+			ReleaseHash:   gv.releaseHashes[suite],
+			Components:    gv.suiteConfigs[suite].components,
+			ExtraManRoots: gv.opts.ExtraManRoots,
+		}
+	}
+	for name, suite := range gv.idxSuites {
+		if d, ok := res[suite]; ok {
+			d.Names = append(d.Names, name)
+		}
+	}
+	for _, d := range res {
+		sort.Strings(d.Names)
+	}
+	return res
+}
+
+// loadGlobalView is like buildGlobalView, but instead of fetching the
+// Release, Contents and Packages files from the archive, it loads the
+// results of the last discover stage from discovered and the suite
+// caches in -state_dir.
+func loadGlobalView(ar *archive.Downloader, opts *Options, dists []distribution, discovered map[string]*discoveredSuite, start time.Time) (globalView, error) {
+	res, err := newGlobalView(opts, start)
+	if err != nil {
+		return res, err
+	}
+	alternatives, err := alternativesFingerprint(opts.AlternativesDir)
+	if err != nil {
+		return res, err
+	}
+
+	bySuite := make(map[string]string)
+	for suite, d := range discovered {
+		for _, name := range d.Names {
+			bySuite[name] = suite
+		}
+	}
+
+	for _, dist := range dists {
+		suite, ok := bySuite[dist.name]
+		if !ok {
+			return res, fmt.Errorf("%q was not discovered yet, run debiman discover first", dist.name)
+		}
+		d := discovered[suite]
+		cfg, err := opts.suiteConfig(dist.name, ar)
+		if err != nil {
+			return res, err
+		}
+		if strings.Join(cfg.components, ",") != strings.Join(d.Components, ",") {
+			return res, fmt.Errorf("components of %q changed since it was discovered, run debiman discover first", dist.name)
+		}
+		if strings.Join(opts.ExtraManRoots, ",") != strings.Join(d.ExtraManRoots, ",") {
+			return res, fmt.Errorf("extra man roots changed since %q was discovered, run debiman discover first", dist.name)
+		}
+
+		res.suites[suite] = true
+		for _, name := range d.Names {
+			res.idxSuites[name] = suite
+		}
+		res.suiteConfigs[suite] = cfg
+		res.releaseHashes[suite] = d.ReleaseHash
+
+		cachePath := suiteCachePath(opts.stateDir(), suite)
+		content, pkgs, cached, err := loadSuiteCache(cachePath, suite, suiteCacheKey(d.ReleaseHash, alternatives))
+		if err != nil {
+			return res, fmt.Errorf("loading cache %q: %v", cachePath, err)
+		}
+		if !cached {
+			return res, fmt.Errorf("no up-to-date cache for suite %q in %q, run debiman discover first", suite, cachePath)
+		}
+		res.addSuite(suite, content, pkgs, latestVersions(pkgs))
+	}
+	res.stats.Packages = uint64(len(res.pkgs))
+	return res, nil
+}
@@ -0,0 +1,262 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Debian/debiman/internal/pdiff"
+	"github.com/Debian/debiman/internal/write"
+
+	"pault.ag/go/archive"
+	"pault.ag/go/debian/control"
+)
+
+// indexCache keeps uncompressed copies of the Contents and Packages
+// files for which the archive publishes PDiffs (see Options.IndexCache).
+type indexCache struct {
+	dir     string // if empty, the cache is disabled
+	metrics *Metrics
+}
+
+func newIndexCache(opts *Options) *indexCache {
+	c := &indexCache{metrics: opts.Metrics}
+	if opts.IndexCache {
+		c.dir = filepath.Join(opts.stateDir(), "indices")
+	}
+	return c
+}
+
+// indexFile is an uncompressed Contents or Packages file. Temporary
+// files are removed when closed, cached files are kept.
+type indexFile struct {
+	*os.File
+	temporary bool
+}
+
+func (f *indexFile) Close() error {
+	err := f.File.Close()
+	if f.temporary {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// indexCacheMeta describes a cached index file.
+type indexCacheMeta struct {
+	// Source is the hash of the (compressed) file listed in the
+	// Release file from which the cached file was derived.
+	Source string
+
+	// SHA256 is the hash of the uncompressed cached file, which is
+	// what PDiff histories refer to.
+	SHA256 string
+}
+
+func readIndexCacheMeta(path string) (indexCacheMeta, error) {
+	var meta indexCacheMeta
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return meta, err
+	}
+	return meta, json.Unmarshal(b, &meta)
+}
+
+func writeIndexCacheMeta(path string, meta indexCacheMeta) error {
+	return write.Atomically(path, false, func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(&meta)
+	})
+}
+
+// fetchIndex returns the uncompressed contents of path (e.g.
+// main/Contents-amd64.gz) within suite. With Options.IndexCache, a local
+// copy is kept and updated by applying PDiffs, falling back to
+// downloading the entire file. Files without PDiffs are not cached.
+// Every file (and every patch) is verified against the hashes in
+// hashByFilename and the PDiff Index.
+func (c *indexCache) fetch(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite, path string, hashByFilename map[string]*control.SHA256FileHash) (*indexFile, error) {
+	fh, ok := hashByFilename[path]
+	if !ok {
+		return nil, fmt.Errorf("ERROR: expected path %q not found in Release file", path)
+	}
+
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	cachePath := filepath.Join(c.dir, suite, base)
+	metaPath := cachePath + ".json"
+	diffIndex, hasPDiffs := hashByFilename[base+".diff/Index"]
+	if c.dir != "" && !hasPDiffs {
+		// Caching is only worthwhile for files which can be updated
+		// using PDiffs, so remove copies cached before the archive
+		// stopped publishing PDiffs.
+		os.Remove(cachePath)
+		os.Remove(metaPath)
+	}
+
+	if c.dir == "" || ar.LocalMirror != "" || !hasPDiffs {
+		slog.Info("getting index", "stage", StageDiscover, "suite", suite, "path", path, "hash", fh.Hash)
+		f, err := rd.TempFile(fh.FileHash)
+		if err != nil {
+			return nil, err
+		}
+		c.metrics.recordDownload(ar, fh.Size)
+		return &indexFile{File: f, temporary: true}, nil
+	}
+
+	meta, err := readIndexCacheMeta(metaPath)
+	if err == nil && meta.Source == fh.Hash {
+		if f, err := os.Open(cachePath); err == nil {
+			slog.Info("using cached index", "stage", StageDiscover, "suite", suite, "path", path, "hash", fh.Hash)
+			return &indexFile{File: f}, nil
+		}
+	}
+
+	if err == nil && meta.SHA256 != "" {
+		updated, err := c.applyPDiffs(ar, rd, suite, base, cachePath, meta.SHA256, diffIndex, hashByFilename)
+		if err == nil {
+			meta = indexCacheMeta{Source: fh.Hash, SHA256: updated}
+			if err := writeIndexCacheMeta(metaPath, meta); err != nil {
+				return nil, err
+			}
+			f, err := os.Open(cachePath)
+			if err != nil {
+				return nil, err
+			}
+			return &indexFile{File: f}, nil
+		}
+		slog.Warn("updating index using PDiffs failed, downloading the entire file", "stage", StageDiscover, "suite", suite, "path", path, "err", err)
+	}
+
+	slog.Info("getting index", "stage", StageDiscover, "suite", suite, "path", path, "hash", fh.Hash)
+	f, err := rd.TempFile(fh.FileHash)
+	if err != nil {
+		return nil, err
+	}
+	c.metrics.recordDownload(ar, fh.Size)
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(f.Name(), cachePath); err != nil {
+		// The archive temp directory might be on a different file
+		// system, so fall back to using the temporary file.
+		slog.Warn("cannot cache index", "stage", StageDiscover, "suite", suite, "path", cachePath, "err", err)
+		f, err := os.Open(f.Name())
+		if err != nil {
+			return nil, err
+		}
+		return &indexFile{File: f, temporary: true}, nil
+	}
+	if err := writeIndexCacheMeta(metaPath, indexCacheMeta{
+		Source: fh.Hash,
+		SHA256: fmt.Sprintf("%x", h.Sum(nil)),
+	}); err != nil {
+		return nil, err
+	}
+	cached, err := os.Open(cachePath)
+	if err != nil {
+		return nil, err
+	}
+	return &indexFile{File: cached}, nil
+}
+
+// applyPDiffs updates the cached file at cachePath (whose hash is
+// current) to the version listed in the Release file and returns its
+// new hash.
+func (c *indexCache) applyPDiffs(ar *archive.Downloader, rd *archive.ReleaseDownloader, suite, base, cachePath, current string, diffIndex *control.SHA256FileHash, hashByFilename map[string]*control.SHA256FileHash) (string, error) {
+	idxf, err := rd.TempFile(diffIndex.FileHash)
+	if err != nil {
+		return "", err
+	}
+	c.metrics.recordDownload(ar, diffIndex.Size)
+	defer os.Remove(idxf.Name())
+	defer idxf.Close()
+	idx, err := pdiff.ParseIndex(idxf)
+	if err != nil {
+		return "", fmt.Errorf("parsing %s.diff/Index: %v", base, err)
+	}
+	// If the Release file lists the uncompressed file, its hash must
+	// match the PDiff Index.
+	if uncompressed, ok := hashByFilename[base]; ok && uncompressed.Hash != idx.Current.Hash {
+		return "", fmt.Errorf("%s.diff/Index is out of date: current %s, Release lists %s", base, idx.Current.Hash, uncompressed.Hash)
+	}
+	patches, ok := idx.PatchesFrom(current)
+	if !ok {
+		return "", fmt.Errorf("cached version %s not found in %s.diff/Index history", current, base)
+	}
+	slog.Info("updating index by applying PDiffs", "stage", StageDiscover, "suite", suite, "path", base, "pdiffs", len(patches))
+
+	src := cachePath
+	for _, patch := range patches {
+		dl, ok := idx.DownloadHash(patch)
+		if !ok {
+			return "", fmt.Errorf("patch %q not found in SHA256-Download", patch)
+		}
+		pf, err := ar.TempFile(control.FileHash{
+			Algorithm: "sha256",
+			Hash:      dl.Hash,
+			Size:      dl.Size,
+			Filename:  "dists/" + suite + "/" + base + ".diff/" + dl.Name,
+		})
+		if err != nil {
+			return "", err
+		}
+		c.metrics.recordDownload(ar, dl.Size)
+		err = idx.VerifyPatch(patch, pf)
+		if err == nil {
+			_, err = pf.Seek(0, io.SeekStart)
+		}
+		if err == nil {
+			err = applyPatch(src, cachePath, pf)
+		}
+		pf.Close()
+		os.Remove(pf.Name())
+		if err != nil {
+			return "", fmt.Errorf("applying %q: %v", patch, err)
+		}
+	}
+
+	f, err := os.Open(cachePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	if got, want := fmt.Sprintf("%x", h.Sum(nil)), idx.Current.Hash; got != want {
+		// Remove the corrupt file so that the next run starts from
+		// scratch.
+		os.Remove(cachePath)
+		return "", fmt.Errorf("invalid hash after applying PDiffs: got %s, want %s", got, want)
+	}
+	return idx.Current.Hash, nil
+}
+
+// applyPatch applies the ed script to src and atomically replaces dest
+// with the result.
+func applyPatch(src, dest string, script io.Reader) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return write.Atomically(dest, false, func(w io.Writer) error {
+		return pdiff.Apply(w, in, script)
+	})
+}
@@ -0,0 +1,327 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Debian/debiman/internal/bundled"
+	"github.com/Debian/debiman/internal/write"
+
+	"pault.ag/go/archive"
+)
+
+// stageCheckpoint records the progress of one stage. A checkpoint whose
+// Completed timestamp is zero belongs to an interrupted stage.
+type stageCheckpoint struct {
+	Started   time.Time `json:"started"`
+	Completed time.Time `json:"completed"`
+
+	// Inputs is a fingerprint of everything the stage depends on.
+	Inputs string `json:"inputs"`
+
+	// Units is the number of completed work units, e.g. packages
+	// extracted or manpages rendered.
+	Units uint64 `json:"units"`
+
+	// Done maps from the key (suite/binarypkg) of each binary package
+	// which an interrupted extract or render stage completed, so that
+	// the next run with the same inputs can skip it. Done is cleared
+	// once the stage completes.
+	Done map[string]unitCheckpoint `json:"done,omitempty"`
+
+	mu sync.Mutex // guards Done while the stage is running
+}
+
+// unitCheckpoint records a binary package completed by a stage.
+type unitCheckpoint struct {
+	Completed time.Time `json:"completed"`
+
+	// Newest is the modification time of the newest manpage rendered
+	// for the binary package, which the render stage needs for the
+	// sitemap.
+	Newest time.Time `json:"newest,omitzero"`
+}
+
+// done returns the checkpoint of the binary package key, if c records
+// it as completed. c may be nil.
+func (c *stageCheckpoint) done(key string) (unitCheckpoint, bool) {
+	if c == nil {
+		return unitCheckpoint{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	u, ok := c.Done[key]
+	return u, ok
+}
+
+// record marks the binary package key as completed. c may be nil.
+func (c *stageCheckpoint) record(key string, u unitCheckpoint) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Done[key] = u
+}
+
+// runJournal is persisted in -state_dir after every stage so that a
+// subsequent run can skip stages whose inputs did not change.
+type runJournal struct {
+	DebimanVersion string    `json:"debiman_version"`
+	Started        time.Time `json:"started"`
+	Completed      time.Time `json:"completed"`
+
+	// ReleaseHashes maps from suite to a fingerprint of the SHA256
+	// entries of the suite’s Release file.
+	ReleaseHashes map[string]string `json:"release_hashes"`
+
+	// SuiteDirs maps from suite to the modification time of its
+	// directory in Options.ServingDir at the end of the last complete
+	// run. Deleting a binary package directory (to force
+	// re-extraction) changes the modification time.
+	SuiteDirs map[string]time.Time `json:"suite_dirs"`
+
+	// Discovered maps from suite to the output of the most recent
+	// discover stage, see loadGlobalView.
+	Discovered map[string]*discoveredSuite `json:"discovered"`
+
+	// FailedPackages are the packages which the most recent extract
+	// stage quarantined.
+	FailedPackages []packageFailure `json:"failed_packages"`
+
+	Stages map[Stage]*stageCheckpoint `json:"stages"`
+
+	path string
+	opts *Options // for Metrics and Hooks
+}
+
+// loadJournal reads the journal from path. A missing journal results
+// in an empty journal.
+func loadJournal(path string, opts *Options) (*runJournal, error) {
+	j := &runJournal{
+		ReleaseHashes: make(map[string]string),
+		SuiteDirs:     make(map[string]time.Time),
+		Stages:        make(map[Stage]*stageCheckpoint),
+		path:          path,
+		opts:          opts,
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return j, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(b, j); err != nil {
+		return nil, fmt.Errorf("parsing %q: %v", path, err)
+	}
+	if j.Stages == nil {
+		j.Stages = make(map[Stage]*stageCheckpoint)
+	}
+	return j, nil
+}
+
+func (j *runJournal) persist() error {
+	if err := os.MkdirAll(filepath.Dir(j.path), 0755); err != nil {
+		return err
+	}
+	return write.Atomically(j.path, false, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(j)
+	})
+}
+
+// unchanged returns true if stage was completed by a previous run with
+// the same inputs.
+func (j *runJournal) unchanged(stage Stage, inputs string) bool {
+	c, ok := j.Stages[stage]
+	return ok && !c.Completed.IsZero() && c.Inputs == inputs
+}
+
+// begin records that stage was started with the specified inputs. If
+// the previous run was interrupted during stage with the same inputs,
+// its completed work units are carried over (see stageCheckpoint.Done).
+func (j *runJournal) begin(stage Stage, inputs string) error {
+	c := &stageCheckpoint{
+		Started: time.Now(),
+		Inputs:  inputs,
+		Done:    make(map[string]unitCheckpoint),
+	}
+	if prev, ok := j.Stages[stage]; ok && prev.Completed.IsZero() {
+		if prev.Inputs == inputs {
+			slog.Info("resuming interrupted stage", "stage", stage, "units", prev.Units, "done", len(prev.Done))
+			for key, u := range prev.Done {
+				c.Done[key] = u
+			}
+		} else {
+			slog.Info("restarting interrupted stage, inputs changed", "stage", stage)
+		}
+	}
+	j.Stages[stage] = c
+	j.opts.Metrics.beginStage(stage)
+	slog.Info("stage started", "stage", stage)
+	if hook := j.opts.Hooks.StageStarted; hook != nil {
+		hook(stage)
+	}
+	return j.persist()
+}
+
+// complete records that stage finished after units work units.
+func (j *runJournal) complete(stage Stage, units uint64) error {
+	c := j.Stages[stage]
+	c.Completed = time.Now()
+	c.Units = units
+	c.Done = nil
+	j.opts.Metrics.endStage()
+	slog.Info("stage completed", "stage", stage, "units", units, "duration", c.Completed.Sub(c.Started))
+	if err := j.persist(); err != nil {
+		return err
+	}
+	if hook := j.opts.Hooks.StageCompleted; hook != nil {
+		hook(stage, units, c.Completed.Sub(c.Started))
+	}
+	return nil
+}
+
+// interrupt records that stage was interrupted after units work units.
+// The stage remains incomplete, so that the next run resumes it,
+// skipping the work units recorded in its Done map.
+func (j *runJournal) interrupt(stage Stage, units uint64) error {
+	c, ok := j.Stages[stage]
+	if !ok {
+		return nil // not yet started
+	}
+	c.Units = units
+	j.opts.Metrics.endStage()
+	slog.Warn("stage interrupted", "stage", stage, "units", units, "duration", time.Since(c.Started))
+	return j.persist()
+}
+
+// finish records the end of a successful run.
+func (j *runJournal) finish(servingDir string, gv globalView) error {
+	j.DebimanVersion = Version
+	j.Completed = time.Now()
+	j.ReleaseHashes = gv.releaseHashes
+	j.SuiteDirs = suiteDirModTimes(servingDir, gv)
+	return j.persist()
+}
+
+func suiteDirModTimes(servingDir string, gv globalView) map[string]time.Time {
+	res := make(map[string]time.Time, len(gv.suites))
+	for suite := range gv.suites {
+		st, err := os.Stat(filepath.Join(servingDir, suite))
+		if err != nil {
+			continue
+		}
+		res[suite] = st.ModTime()
+	}
+	return res
+}
+
+// suiteDirsUnchanged returns true if no suite directory was modified
+// since the last complete run.
+func (j *runJournal) suiteDirsUnchanged(servingDir string, gv globalView) bool {
+	current := suiteDirModTimes(servingDir, gv)
+	if len(current) != len(gv.suites) {
+		return false
+	}
+	for suite, t := range current {
+		if prev, ok := j.SuiteDirs[suite]; !ok || !prev.Equal(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// releaseFingerprint returns a fingerprint of all SHA256 entries of
+// release.
+func releaseFingerprint(release *archive.Release) string {
+	lines := make([]string, len(release.SHA256))
+	for idx, fh := range release.SHA256 {
+		lines[idx] = fh.Filename + " " + fh.Hash
+	}
+	sort.Strings(lines)
+	return fingerprint(lines...)
+}
+
+// fingerprint returns a hex-encoded SHA256 hash over parts.
+func fingerprint(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		io.WriteString(h, p)
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// alternativesFingerprint identifies the files in -alternatives_dir
+// by name, size and modification time.
+func alternativesFingerprint(dir string) (string, error) {
+	if dir == "" {
+		return "", nil
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	parts := make([]string, len(infos))
+	for idx, fi := range infos {
+		parts[idx] = fmt.Sprintf("%s %d %d", fi.Name(), fi.Size(), fi.ModTime().UnixNano())
+	}
+	return fingerprint(parts...), nil
+}
+
+// stageFingerprints contains the input fingerprints of the stages
+// following the discover stage.
+type stageFingerprints struct {
+	extract string
+	render  string
+	index   string
+	aux     string
+}
+
+// stageInputs returns the input fingerprints of the extract, render,
+// index and aux stages for gv.
+func stageInputs(gv globalView) (stageFingerprints, error) {
+	var res stageFingerprints
+	suites := make([]string, 0, len(gv.releaseHashes))
+	for suite, hash := range gv.releaseHashes {
+		suites = append(suites, suite+"="+hash)
+	}
+	sort.Strings(suites)
+	alternatives, err := alternativesFingerprint(gv.opts.AlternativesDir)
+	if err != nil {
+		return res, err
+	}
+	res.extract = fingerprint(append(suites, Version, alternatives)...)
+
+	assets := bundled.AssetsFiltered(func(string) bool { return true })
+	names := make([]string, 0, len(assets))
+	for name := range assets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := []string{res.extract, gv.opts.BaseURL, fmt.Sprint(gv.opts.GzipLevel), strings.Join(gv.opts.OnlyRenderPkgs, ",")}
+	for _, name := range names {
+		parts = append(parts, name, assets[name])
+	}
+	res.render = fingerprint(parts...)
+
+	res.index = fingerprint(res.extract, gv.opts.indexPath())
+
+	// The aux pages (index, FAQ, changelog feeds, …) are rendered from
+	// the same assets and suites as the manpages.
+	res.aux = fingerprint(res.render, string(StageAux))
+	return res, nil
+}
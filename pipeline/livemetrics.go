@@ -0,0 +1,120 @@
+package pipeline
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Debian/debiman/internal/metrics"
+
+	"pault.ag/go/archive"
+)
+
+// renderBuckets are histogram buckets (in seconds) for rendering a
+// single manpage, which takes anywhere from milliseconds (re-used
+// content) to the mandoc(1) timeout.
+var renderBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Metrics are updated while the pipeline runs (see Options.Metrics).
+// cmd/debiman serves them on its debug listener, so that stuck runs can
+// be detected while they happen. metrics.txt is written only at the end
+// of a successful run.
+type Metrics struct {
+	registry *metrics.Registry
+
+	stage           *metrics.GaugeVec
+	stageDuration   *metrics.GaugeVec
+	packagesQueued  *metrics.Gauge
+	packagesDone    *metrics.CounterVec
+	pagesRendered   *metrics.Counter
+	pagesFailed     *metrics.Counter
+	bytesDownloaded *metrics.Counter
+	mirrorRequests  *metrics.CounterVec
+	mirrorErrors    *metrics.CounterVec
+	renderLatency   *metrics.Histogram
+
+	mu               sync.Mutex // guards the fields below
+	currentStage     Stage
+	currentStageTime time.Time
+}
+
+// NewMetrics returns Metrics, which are served in the Prometheus text
+// format by ServeHTTP.
+func NewMetrics() *Metrics {
+	r := metrics.NewRegistry()
+	return &Metrics{
+		registry: r,
+		stage: r.NewGaugeVec("debiman_stage",
+			"1 for the stage which is currently running, 0 otherwise.",
+			"stage"),
+		stageDuration: r.NewGaugeVec("debiman_stage_duration_seconds",
+			"Wall-clock time spent in the stage so far (or in total, once completed).",
+			"stage"),
+		packagesQueued: r.NewGauge("debiman_packages_queued",
+			"Binary packages which still need to be processed in the extract stage."),
+		packagesDone: r.NewCounterVec("debiman_packages_total",
+			"Binary packages processed in the extract stage, by result (extracted, skipped, failed).",
+			"result"),
+		pagesRendered: r.NewCounter("debiman_pages_rendered_total",
+			"Manpages rendered to HTML, including error pages."),
+		pagesFailed: r.NewCounter("debiman_pages_failed_total",
+			"Manpages which could not be converted, so that an error page was rendered instead."),
+		bytesDownloaded: r.NewCounter("debiman_downloaded_bytes_total",
+			"Bytes of packages and indices downloaded from the mirror."),
+		mirrorRequests: r.NewCounterVec("debiman_mirror_requests_total",
+			"HTTP requests sent to the mirror.",
+			"mirror"),
+		mirrorErrors: r.NewCounterVec("debiman_mirror_errors_total",
+			"HTTP requests to the mirror which failed with a connection error or a transient HTTP status (5xx, 429), including interrupted downloads.",
+			"mirror"),
+		renderLatency: r.NewHistogram("debiman_render_duration_seconds",
+			"Time to render a single manpage.",
+			renderBuckets),
+	}
+}
+
+// beginStage marks stage as the current stage. Stages are only ever run
+// sequentially, by Run or RunStage.
+func (m *Metrics) beginStage(stage Stage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.endStageLocked()
+	m.currentStage = stage
+	m.currentStageTime = time.Now()
+	m.stage.With(string(stage)).Set(1)
+}
+
+// endStage records the duration of the current stage, if any.
+func (m *Metrics) endStage() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.endStageLocked()
+}
+
+func (m *Metrics) endStageLocked() {
+	if m.currentStage == "" {
+		return
+	}
+	m.stageDuration.With(string(m.currentStage)).Set(time.Since(m.currentStageTime).Seconds())
+	m.stage.With(string(m.currentStage)).Set(0)
+	m.currentStage = ""
+}
+
+// recordDownload accounts size bytes as downloaded, unless they were
+// read from a local mirror.
+func (m *Metrics) recordDownload(ar *archive.Downloader, size int64) {
+	if ar.LocalMirror != "" {
+		return
+	}
+	m.bytesDownloaded.Add(float64(size))
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	if m.currentStage != "" {
+		// Update the duration of the running stage.
+		m.stageDuration.With(string(m.currentStage)).Set(time.Since(m.currentStageTime).Seconds())
+	}
+	m.mu.Unlock()
+	m.registry.ServeHTTP(w, r)
+}
@@ -0,0 +1,43 @@
+package pipeline
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"pault.ag/go/archive"
+)
+
+func TestRunMetrics(t *testing.T) {
+	m := NewMetrics()
+	m.beginStage(StageDiscover)
+	m.beginStage(StageExtract)
+	m.packagesQueued.Set(3)
+	m.packagesDone.With("skipped").Inc()
+	m.recordDownload(&archive.Downloader{}, 1024)
+	m.recordDownload(&archive.Downloader{LocalMirror: "/srv/mirrors/debian"}, 2048)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		`debiman_stage{stage="discover"} 0`,
+		`debiman_stage{stage="extract"} 1`,
+		`debiman_stage_duration_seconds{stage="discover"} `,
+		`debiman_stage_duration_seconds{stage="extract"} `,
+		`debiman_packages_queued 3`,
+		`debiman_packages_total{result="skipped"} 1`,
+		`debiman_downloaded_bytes_total 1024`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, body)
+		}
+	}
+
+	m.endStage()
+	rec = httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if want := `debiman_stage{stage="extract"} 0`; !strings.Contains(rec.Body.String(), want) {
+		t.Errorf("metrics do not contain %q after endStage:\n%s", want, rec.Body.String())
+	}
+}
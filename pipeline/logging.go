@@ -0,0 +1,36 @@
+package pipeline
+
+import "log/slog"
+
+// pkgLogger logs the extraction of a single binary package. Individual
+// lookups are logged at debug level, whereas their outcome is
+// accumulated into a single summary message (see summarize).
+type pkgLogger struct {
+	*slog.Logger
+
+	lookups          int // findFile and findClosestFile calls
+	unresolvedSo     int // .so references which could not be found
+	danglingSymlinks int
+	unparseable      int // file names which are not valid manpage names
+	auxFiles         int // referenced non-manpage files
+}
+
+func newPkgLogger(p pkgEntry) *pkgLogger {
+	return &pkgLogger{
+		Logger: slog.Default().With(
+			"stage", StageExtract,
+			"suite", p.suite,
+			"binarypkg", p.binarypkg),
+	}
+}
+
+// summarize logs the accumulated counts.
+func (l *pkgLogger) summarize(manpages int) {
+	l.Info("package extracted",
+		"manpages", manpages,
+		"lookups", l.lookups,
+		"unresolved_so", l.unresolvedSo,
+		"dangling_symlinks", l.danglingSymlinks,
+		"unparseable_names", l.unparseable,
+		"aux_files", l.auxFiles)
+}
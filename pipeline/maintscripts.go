@@ -0,0 +1,367 @@
+package pipeline
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Debian/debiman/internal/write"
+)
+
+// Slave alternative links (e.g. /usr/share/man/man1/editor.1.gz ->
+// /usr/share/man/man1/nano.1.gz) are only known once a package is
+// installed, which is why Options.AlternativesDir contains the links
+// which piuparts observed. With Options.AlternativesFromMaintscripts,
+// downloadPkg additionally derives the links from the update-alternatives
+// invocations in the postinst maintainer script of each package. As
+// unchanged packages are not downloaded again, the derived links are
+// persisted in the state directory, in the same format as the files in
+// Options.AlternativesDir.
+
+// postinstAlternatives returns the slave alternative links which the
+// postinst maintainer script in the control.tar member control installs.
+func postinstAlternatives(control *tar.Reader) ([]link, error) {
+	for {
+		header, err := control.Next()
+		if err == io.EOF {
+			return nil, nil // no postinst
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimPrefix(header.Name, "./") != "postinst" || header.FileInfo().IsDir() {
+			continue
+		}
+		return parseAlternatives(control)
+	}
+}
+
+// parseAlternatives returns the slave links of all
+// “update-alternatives --install … --slave …” invocations in the shell
+// script read from r. Links whose paths contain shell expansions (e.g.
+// $variant in a loop) cannot be resolved and are skipped.
+func parseAlternatives(r io.Reader) ([]link, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	script := strings.Replace(string(b), "\\\n", " ", -1)
+	var links []link
+	for _, line := range strings.Split(script, "\n") {
+		words := shellWords(line)
+		for idx, w := range words {
+			if path.Base(w) != "update-alternatives" {
+				continue
+			}
+			links = append(links, updateAlternativesLinks(words[idx+1:])...)
+		}
+	}
+	return links, nil
+}
+
+// updateAlternativesLinks returns the slave links of the
+// update-alternatives invocation with the arguments args (which might
+// be followed by further shell commands).
+func updateAlternativesLinks(args []string) []link {
+	var (
+		install bool
+		links   []link
+	)
+args:
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case ";", "&", "&&", "|", "||":
+			break args
+
+		case "--install":
+			// --install <link> <name> <path> <priority>
+			if i+4 >= len(args) {
+				break args
+			}
+			install = true
+			i += 4
+
+		case "--slave":
+			// --slave <link> <name> <path>
+			if i+3 >= len(args) {
+				break args
+			}
+			from, to := args[i+1], args[i+3]
+			if resolvable(from) && resolvable(to) {
+				links = append(links, link{from: from, to: to})
+			}
+			i += 3
+
+		case "--altdir", "--admindir", "--instdir", "--root", "--log":
+			i++ // skip the option’s argument
+		}
+	}
+	if !install {
+		return nil
+	}
+	return links
+}
+
+// resolvable returns whether the shell word w is an absolute path
+// without expansions.
+func resolvable(w string) bool {
+	return strings.HasPrefix(w, "/") && !strings.ContainsAny(w, "$`*?")
+}
+
+// shellWords splits the shell command line into words, removing quotes
+// and comments. The control operators ;, &, &&, | and || are returned as
+// separate words. This is not a full shell parser, but sufficient for
+// the update-alternatives invocations in maintainer scripts.
+func shellWords(line string) []string {
+	var (
+		words  []string
+		word   strings.Builder
+		inWord bool
+		quote  rune // ' or ", if inside quotes
+	)
+	flush := func() {
+		if inWord {
+			words = append(words, word.String())
+		}
+		word.Reset()
+		inWord = false
+	}
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' && i+1 < len(runes) {
+				i++
+				word.WriteRune(runes[i])
+			} else {
+				word.WriteRune(c)
+			}
+
+		case c == '\'' || c == '"':
+			quote = c
+			inWord = true
+
+		case c == '\\':
+			if i+1 < len(runes) {
+				i++
+				word.WriteRune(runes[i])
+			}
+			inWord = true
+
+		case c == ' ' || c == '\t':
+			flush()
+
+		case c == '#' && !inWord:
+			return words // comment
+
+		case c == ';' || c == '&' || c == '|':
+			flush()
+			op := string(c)
+			if (c == '&' || c == '|') && i+1 < len(runes) && runes[i+1] == c {
+				op += string(c)
+				i++
+			}
+			words = append(words, op)
+
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	flush()
+	return words
+}
+
+// derivedAlternatives are the slave alternative links which were
+// derived from maintainer scripts, by suite/binarypkg.
+type derivedAlternatives struct {
+	dir string // in the state directory
+
+	mu      sync.Mutex
+	links   map[string][]link
+	updated map[string]bool // keys which were derived by this run
+}
+
+func loadDerivedAlternatives(dir string) (*derivedAlternatives, error) {
+	links, err := parseAlternativesDir(dir)
+	if os.IsNotExist(err) {
+		slog.Info("no alternatives derived from maintainer scripts yet, unchanged packages are only inspected when extracted again (see Options.ForceReextract)", "stage", StageDiscover, "dir", dir)
+	} else if err != nil {
+		return nil, err
+	}
+	if links == nil {
+		links = make(map[string][]link)
+	}
+	return &derivedAlternatives{
+		dir:     dir,
+		links:   links,
+		updated: make(map[string]bool),
+	}, nil
+}
+
+func (d *derivedAlternatives) get(key string) []link {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.links[key]
+}
+
+func (d *derivedAlternatives) set(key string, links []link) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(links) == 0 {
+		delete(d.links, key)
+	} else {
+		d.links[key] = links
+	}
+	d.updated[key] = true
+}
+
+// all returns a copy of the links.
+func (d *derivedAlternatives) all() map[string][]link {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	res := make(map[string][]link, len(d.links))
+	for key, links := range d.links {
+		res[key] = links
+	}
+	return res
+}
+
+// save writes the links of the packages in pkgs to one file per suite.
+func (d *derivedAlternatives) save(suites map[string]bool, pkgs []*pkgEntry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	type entry struct {
+		Binpackage string
+		From       string
+		To         string
+	}
+	bySuite := make(map[string][]entry, len(suites))
+	seen := make(map[string]bool)
+	for _, p := range pkgs {
+		key := p.suite + "/" + p.binarypkg
+		if seen[key] {
+			continue // multiple architectures
+		}
+		seen[key] = true
+		for _, l := range d.links[key] {
+			bySuite[p.suite] = append(bySuite[p.suite], entry{
+				Binpackage: p.binarypkg,
+				From:       l.from,
+				To:         l.to,
+			})
+		}
+	}
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return err
+	}
+	for suite := range suites {
+		entries := bySuite[suite]
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].Binpackage != entries[j].Binpackage {
+				return entries[i].Binpackage < entries[j].Binpackage
+			}
+			return entries[i].From < entries[j].From
+		})
+		if entries == nil {
+			entries = []entry{}
+		}
+		dest := filepath.Join(d.dir, suite+".json.gz")
+		if err := write.Atomically(dest, true, func(w io.Writer) error {
+			return json.NewEncoder(w).Encode(entries)
+		}); err != nil {
+			return err
+		}
+	}
+	slog.Debug("saved alternatives derived from maintainer scripts", "dir", d.dir, "suites", len(suites))
+	return nil
+}
+
+// alternativesFor returns the slave alternative links of key
+// (suite/binarypkg). Links from Options.AlternativesDir take precedence
+// over derived links with the same name.
+func (gv globalView) alternativesFor(key string) []link {
+	derived := gv.derived.get(key)
+	if len(derived) == 0 {
+		return gv.alternatives[key]
+	}
+	res := append([]link{}, gv.alternatives[key]...)
+	known := make(map[string]bool, len(res))
+	for _, l := range res {
+		known[l.from] = true
+	}
+	for _, l := range derived {
+		if !known[l.from] {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+// allAlternatives returns the slave alternative links of all packages,
+// see alternativesFor.
+func (gv globalView) allAlternatives() map[string][]link {
+	derived := gv.derived.all()
+	if len(derived) == 0 {
+		return gv.alternatives
+	}
+	res := make(map[string][]link, len(gv.alternatives)+len(derived))
+	for key := range gv.alternatives {
+		res[key] = gv.alternativesFor(key)
+	}
+	for key := range derived {
+		res[key] = gv.alternativesFor(key)
+	}
+	return res
+}
+
+// saveDerivedAlternatives persists the links which downloadPkg derived
+// and adds the manpages they provide to gv.xref, so that the following
+// stages of this run can cross-reference them.
+func (gv globalView) saveDerivedAlternatives() error {
+	if gv.derived == nil {
+		return nil
+	}
+	gv.derived.mu.Lock()
+	updated := make(map[string]bool, len(gv.derived.updated))
+	for key := range gv.derived.updated {
+		updated[key] = true
+	}
+	gv.derived.mu.Unlock()
+	if len(updated) == 0 {
+		return nil
+	}
+	if err := gv.derived.save(gv.suites, gv.pkgs); err != nil {
+		return err
+	}
+	var pkgs []*pkgEntry
+	for _, p := range gv.pkgs {
+		if updated[p.suite+"/"+p.binarypkg] {
+			pkgs = append(pkgs, p)
+		}
+	}
+	latestVersion := latestVersions(pkgs)
+	for key := range updated {
+		if errs := gv.markLinksPresent(latestVersion, key, gv.alternativesFor(key)); len(errs) > 0 {
+			slog.Warn("package has errors", "stage", StageExtract, "package", key, "errors", errs)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package pipeline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"pault.ag/go/archive"
+)
+
+func TestParseAlternatives(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		script string
+		want   []link
+	}{
+		{
+			name: "continuation lines",
+			script: `#!/bin/sh
+set -e
+if [ "$1" = configure ]; then
+  update-alternatives --install /usr/bin/editor editor /bin/nano 40 \
+    --slave /usr/share/man/man1/editor.1.gz editor.1.gz \
+    /usr/share/man/man1/nano.1.gz
+fi
+`,
+			want: []link{
+				{from: "/usr/share/man/man1/editor.1.gz", to: "/usr/share/man/man1/nano.1.gz"},
+			},
+		},
+
+		{
+			name:   "quotes and options",
+			script: `update-alternatives --quiet --log /var/log/alt.log --install "/usr/bin/vi" vi /usr/bin/nvi 20 --slave '/usr/share/man/man1/vi.1.gz' vi.1.gz /usr/share/man/man1/nvi.1.gz --slave /usr/bin/view view /usr/bin/nview && echo done`,
+			want: []link{
+				{from: "/usr/share/man/man1/vi.1.gz", to: "/usr/share/man/man1/nvi.1.gz"},
+				{from: "/usr/bin/view", to: "/usr/bin/nview"},
+			},
+		},
+
+		{
+			name: "expansions",
+			script: `for i in vi view; do
+  /usr/bin/update-alternatives --install /usr/bin/$i $i /usr/bin/vim.basic 30 \
+    --slave /usr/share/man/man1/$i.1.gz $i.1.gz /usr/share/man/man1/vim.1.gz \
+    --slave /usr/share/man/man1/rvim.1.gz rvim.1.gz /usr/share/man/man1/vim.1.gz
+done`,
+			want: []link{
+				{from: "/usr/share/man/man1/rvim.1.gz", to: "/usr/share/man/man1/vim.1.gz"},
+			},
+		},
+
+		{
+			name: "not an install",
+			script: `update-alternatives --remove editor /bin/nano # --slave /usr/share/man/man1/editor.1.gz editor.1.gz /usr/share/man/man1/nano.1.gz
+update-alternatives --install /usr/bin/pager pager /bin/less 77; update-alternatives --slave /usr/share/man/man1/pager.1.gz pager.1.gz /usr/share/man/man1/less.1.gz`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAlternatives(strings.NewReader(tt.script))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseAlternatives: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaintscriptAlternatives(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, srv := newTestMirror(t, dir)
+	defer srv.Close()
+	ar := &archive.Downloader{Mirror: srv.URL}
+
+	files := []testFile{
+		{name: "./usr/share/man/man1/nano.1.gz", content: gzipped(t, ".TH NANO 1\n")},
+		{name: "./usr/share/man/man1/rnano.1.gz", content: gzipped(t, ".TH RNANO 1\n")},
+	}
+	postinst := testFile{name: "./postinst", content: []byte(`#!/bin/sh
+update-alternatives --install /usr/bin/editor editor /bin/nano 40 \
+  --slave /usr/share/man/man1/editor.1.gz editor.1.gz /usr/share/man/man1/nano.1.gz \
+  --slave /usr/share/man/man1/pico.1.gz pico.1.gz /usr/share/man/man1/nano.1.gz \
+  --slave /usr/bin/pico pico /bin/nano
+`)}
+	p := m.addDeb(t, "nano", buildDeb(t, files, postinst), files)
+	key := p.suite + "/nano"
+	// Links from -alternatives_dir take precedence.
+	m.gv.alternatives[key] = []link{
+		{from: "/usr/share/man/man1/pico.1.gz", to: "/usr/share/man/man1/rnano.1.gz"},
+	}
+
+	if err := downloadPkg(ar, p, m.gv); err != nil {
+		t.Fatal(err)
+	}
+	pkgdir := filepath.Join(dir, p.suite, "nano")
+	for _, tt := range []struct {
+		name   string
+		target string
+	}{
+		{name: "editor.1.en.gz", target: "nano.1.en.gz"},
+		{name: "pico.1.en.gz", target: "rnano.1.en.gz"},
+	} {
+		target, err := os.Readlink(filepath.Join(pkgdir, tt.name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target != tt.target {
+			t.Errorf("%s: got symlink target %q, want %q", tt.name, target, tt.target)
+		}
+	}
+
+	if err := m.gv.saveDerivedAlternatives(); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.gv.xref["editor"]) == 0 {
+		t.Errorf("editor(1) not cross-referenced")
+	}
+
+	// The next run loads the derived links from the state directory.
+	derived, err := loadDerivedAlternatives(m.gv.derived.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []link{
+		{from: "/usr/share/man/man1/editor.1.gz", to: "/usr/share/man/man1/nano.1.gz"},
+		{from: "/usr/share/man/man1/pico.1.gz", to: "/usr/share/man/man1/nano.1.gz"},
+	}
+	if got := derived.get(key); !reflect.DeepEqual(got, want) {
+		t.Errorf("loaded derived links: got %v, want %v", got, want)
+	}
+}
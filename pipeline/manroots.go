@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"bytes"
+	"path"
+	"strings"
+)
+
+// defaultManRoot is where Debian policy requires manpages to be
+// installed.
+const defaultManRoot = "usr/share/man"
+
+// manRoots are the directories underneath which packages ship
+// manpages: defaultManRoot and Options.ExtraManRoots. Files are
+// identified by their path underneath the man root (e.g.
+// “man1/ls.1.gz”), so a manpage in /opt/foo/share/man/man1/foo.1.gz is
+// treated like /usr/share/man/man1/foo.1.gz.
+type manRoots struct {
+	// patterns are the man roots, split into path components which may
+	// contain path.Match wildcards.
+	patterns [][]string
+
+	// prefixes are the literal prefixes of patterns (up to the first
+	// wildcard), used to quickly skip Contents lines.
+	prefixes [][]byte
+}
+
+func newManRoots(extra []string) manRoots {
+	var r manRoots
+	for _, root := range append([]string{defaultManRoot}, extra...) {
+		root = strings.Trim(path.Clean("/"+strings.TrimSpace(root)), "/")
+		if root == "" {
+			continue
+		}
+		prefix := root + "/"
+		if idx := strings.IndexAny(prefix, `*?[\`); idx > -1 {
+			prefix = prefix[:idx]
+		}
+		r.patterns = append(r.patterns, strings.Split(root, "/"))
+		r.prefixes = append(r.prefixes, []byte(prefix))
+	}
+	return r
+}
+
+// split returns the path of name underneath the first matching man
+// root, or ok == false if name is not underneath a man root. name may
+// be absolute (/usr/share/man/man1/ls.1.gz), relative to the root
+// directory (usr/share/man/man1/ls.1.gz) or a tar entry name
+// (./usr/share/man/man1/ls.1.gz).
+func (r manRoots) split(name string) (rest string, ok bool) {
+	name = strings.TrimPrefix(strings.TrimPrefix(name, "."), "/")
+	for idx, pattern := range r.patterns {
+		if !strings.HasPrefix(name, string(r.prefixes[idx])) {
+			continue
+		}
+		parts := strings.SplitN(name, "/", len(pattern)+1)
+		if len(parts) != len(pattern)+1 || parts[len(pattern)] == "" {
+			continue
+		}
+		matches := true
+		for i, p := range pattern {
+			if ok, _ := path.Match(p, parts[i]); !ok {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return parts[len(pattern)], true
+		}
+	}
+	return "", false
+}
+
+// candidate returns whether the Contents line text might refer to a
+// file underneath a man root.
+func (r manRoots) candidate(text []byte) bool {
+	for _, prefix := range r.prefixes {
+		if bytes.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package pipeline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pault.ag/go/archive"
+)
+
+func TestManRoots(t *testing.T) {
+	roots := newManRoots([]string{"/usr/lib/*/man", "opt/*/share/man/", "/usr/local/share/man"})
+	for _, tt := range []struct {
+		name string
+		rest string
+		ok   bool
+	}{
+		{name: "./usr/share/man/man1/ls.1.gz", rest: "man1/ls.1.gz", ok: true},
+		{name: "/usr/share/man/fr/man1/ls.1.gz", rest: "fr/man1/ls.1.gz", ok: true},
+		{name: "usr/share/man/man1/ls.1.gz", rest: "man1/ls.1.gz", ok: true},
+		{name: "./usr/lib/erlang/man/man3/lists.3.gz", rest: "man3/lists.3.gz", ok: true},
+		{name: "./opt/foo/share/man/man1/foo.1.gz", rest: "man1/foo.1.gz", ok: true},
+		{name: "/usr/local/share/man/man8/bar.8", rest: "man8/bar.8", ok: true},
+		// * matches a single path component only.
+		{name: "./usr/lib/x86_64-linux-gnu/foo/man/man1/foo.1.gz", ok: false},
+		{name: "./opt/share/man/man1/foo.1.gz", ok: false},
+		{name: "./usr/share/man/", ok: false},
+		{name: "./usr/share/doc/foo/foo.1.gz", ok: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rest, ok := roots.split(tt.name)
+			if rest != tt.rest || ok != tt.ok {
+				t.Errorf("split(%q): got (%q, %v), want (%q, %v)", tt.name, rest, ok, tt.rest, tt.ok)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		text string
+		want bool
+	}{
+		{text: "usr/share/man/man1/ls.1.gz  utils/coreutils", want: true},
+		{text: "usr/lib/erlang/man/man3/lists.3.gz  devel/erlang-manpages", want: true},
+		{text: "opt/foo/share/man/man1/foo.1.gz  misc/foo", want: true},
+		{text: "usr/bin/ls  utils/coreutils", want: false},
+	} {
+		if got := roots.candidate([]byte(tt.text)); got != tt.want {
+			t.Errorf("candidate(%q): got %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestExtraManRoots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, srv := newTestMirror(t, dir)
+	defer srv.Close()
+	m.gv.opts.ExtraManRoots = []string{"/opt/*/share/man"}
+	m.gv.manRoots = newManRoots(m.gv.opts.ExtraManRoots)
+	ar := &archive.Downloader{Mirror: srv.URL}
+
+	p := m.add(t, "foo", []testFile{
+		// The same manpage in /usr/share/man and in /opt.
+		{name: "./usr/share/man/man1/foo.1.gz", linkname: "/opt/foo/share/man/man1/foo.1.gz"},
+		{name: "./opt/foo/share/man/man1/foo.1.gz", content: gzipped(t, ".TH FOO 1\n")},
+		{name: "./usr/share/man/man1/foo-alias.1.gz", linkname: "/opt/foo/share/man/man1/foo.1.gz"},
+		// Absolute symlink to a file which is not underneath a man root.
+		{name: "./opt/foo/share/man/man8/bar.8.gz", linkname: "/opt/foo/doc/bar.8.gz"},
+		{name: "./opt/foo/doc/bar.8.gz", content: gzipped(t, ".TH BAR 8\n")},
+	})
+	if err := downloadPkg(ar, p, m.gv); err != nil {
+		t.Fatal(err)
+	}
+
+	pkgdir := filepath.Join(dir, p.suite, "foo")
+	fi, err := os.Lstat(filepath.Join(pkgdir, "foo.1.en.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fi.Mode().IsRegular() {
+		t.Errorf("foo.1.en.gz: got mode %v, want a regular file", fi.Mode())
+	}
+	for _, tt := range []struct {
+		name   string
+		target string
+	}{
+		{name: "foo-alias.1.en.gz", target: "foo.1.en.gz"},
+		{name: "bar.8.en.gz", target: "aux/opt/foo/doc/bar.8.gz"},
+	} {
+		target, err := os.Readlink(filepath.Join(pkgdir, tt.name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target != tt.target {
+			t.Errorf("%s: got symlink target %q, want %q", tt.name, target, tt.target)
+		}
+		if _, err := os.Stat(filepath.Join(pkgdir, tt.name)); err != nil {
+			t.Errorf("%s: dangling symlink: %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package pipeline
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/Debian/debiman/internal/manpage"
+)
+
+// Estimated memory usage per worker. These are upper bounds observed
+// on manpages.debian.org, not exact numbers.
+const (
+	// A download worker holds the decompressor state for data.tar.xz
+	// (xz dictionaries of up to 64 MiB) and the manpage being recoded.
+	downloadWorkerBytes = 64 << 20
+
+	// A render worker holds the HTML of the manpage being rendered, a
+	// gzip.Writer and the mandoc(1) process.
+	renderWorkerBytes = 32 << 20
+
+	// A manwalk worker holds a directory listing and its goroutine.
+	manwalkWorkerBytes = 256 << 10
+
+	// An archive access holds a decompressor and a HTTP response.
+	fetchBytes = 8 << 20
+
+	// runtimeBytes covers the Go runtime, templates and bundled assets.
+	runtimeBytes = 64 << 20
+)
+
+// concurrency holds the concurrency levels of all stages.
+type concurrency struct {
+	Download int // packages downloaded and extracted in parallel
+	Manwalk  int // binary package directories walked in parallel
+	Render   int // mandoc(1) processes
+}
+
+// ParseSize parses a size like “2G”, “1.5GiB”, “512M” or “1048576”.
+// Suffixes are powers of 1024.
+func ParseSize(s string) (uint64, error) {
+	num := strings.TrimSpace(s)
+	num = strings.TrimSuffix(strings.TrimSuffix(num, "iB"), "B")
+	var shift uint
+	if len(num) > 0 {
+		switch num[len(num)-1] {
+		case 'K', 'k':
+			shift = 10
+		case 'M', 'm':
+			shift = 20
+		case 'G', 'g':
+			shift = 30
+		case 'T', 't':
+			shift = 40
+		}
+	}
+	if shift > 0 {
+		num = num[:len(num)-1]
+	}
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return uint64(f * float64(uint64(1)<<shift)), nil
+}
+
+// formatSize returns b in MiB, which is precise enough for logging.
+func formatSize(b uint64) string {
+	return fmt.Sprintf("%d MiB", b>>20)
+}
+
+// availableMemory returns the memory available to debiman, i.e. the
+// minimum of MemAvailable and the cgroup memory limit, or 0 if
+// unknown.
+func availableMemory() uint64 {
+	var avail uint64
+	if f, err := os.Open("/proc/meminfo"); err == nil {
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 3 && fields[0] == "MemAvailable:" && fields[2] == "kB" {
+				if kb, err := strconv.ParseUint(fields[1], 0, 64); err == nil {
+					avail = kb << 10
+				}
+				break
+			}
+		}
+	}
+	for _, path := range []string{
+		"/sys/fs/cgroup/memory.max",                   // cgroup v2
+		"/sys/fs/cgroup/memory/memory.limit_in_bytes", // cgroup v1
+	} {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		limit, err := strconv.ParseUint(strings.TrimSpace(string(b)), 0, 64)
+		if err != nil {
+			continue // e.g. “max”
+		}
+		if avail == 0 || limit < avail {
+			avail = limit
+		}
+		break
+	}
+	return avail
+}
+
+// ParseMemoryBudget returns the memory budget s (e.g. “1.5G”, “512M”,
+// “auto” for the available memory respecting cgroup limits, or
+// “unlimited”) in bytes, or 0 if memory usage is unlimited.
+func ParseMemoryBudget(s string) (uint64, error) {
+	switch s {
+	case "", "unlimited":
+		return 0, nil
+	case "auto":
+		return availableMemory(), nil
+	}
+	return ParseSize(s)
+}
+
+// estimateGlobalView returns a rough estimate of the heap memory
+// retained by gv, which is live throughout all stages.
+func estimateGlobalView(gv globalView) uint64 {
+	const (
+		// mapEntryOverhead approximates the per-entry cost of a Go map
+		// (bucket slot, tophash, load factor).
+		mapEntryOverhead = 48
+		// versionBytes approximates the strings of a version.Version.
+		versionBytes = 32
+	)
+	var n uint64
+	for _, p := range gv.pkgs {
+		n += uint64(unsafe.Sizeof(*p)) + versionBytes + uint64(len(p.sha256))
+		n += uint64(len(p.source) + len(p.binarypkg) + len(p.filename))
+		for _, r := range p.replaces {
+			n += uint64(unsafe.Sizeof(r)) + uint64(len(r))
+		}
+		for _, d := range p.depends {
+			n += uint64(unsafe.Sizeof(d)) + uint64(len(d))
+		}
+	}
+	for path, entries := range gv.contentByPath {
+		n += mapEntryOverhead + uint64(len(path))
+		for _, e := range entries {
+			n += uint64(unsafe.Sizeof(e)+unsafe.Sizeof(*e)) + uint64(len(e.binarypkg))
+		}
+	}
+	for key, entries := range gv.contentByPkg {
+		n += mapEntryOverhead + uint64(len(key)) + uint64(len(entries))*uint64(unsafe.Sizeof(entries[0]))
+	}
+	for name, metas := range gv.xref {
+		n += mapEntryOverhead + uint64(len(name))
+		for _, m := range metas {
+			n += uint64(unsafe.Sizeof(m)+unsafe.Sizeof(*m)+unsafe.Sizeof(manpage.PkgMeta{})) + versionBytes
+			n += uint64(len(m.Name) + len(m.Section) + len(m.Language))
+		}
+	}
+	for pkg, links := range gv.alternatives {
+		n += mapEntryOverhead + uint64(len(pkg))
+		for _, l := range links {
+			n += uint64(unsafe.Sizeof(l)) + uint64(len(l.from)+len(l.to))
+		}
+	}
+	for pkg, links := range gv.derived.all() {
+		n += mapEntryOverhead + uint64(len(pkg))
+		for _, l := range links {
+			n += uint64(unsafe.Sizeof(l)) + uint64(len(l.from)+len(l.to))
+		}
+	}
+	return n
+}
+
+// clampWorkers returns the number of workers (at most want, at least 1)
+// of size perWorker which fit into avail bytes.
+func clampWorkers(want int, avail, perWorker uint64) int {
+	if fit := avail / perWorker; fit < uint64(want) {
+		want = int(fit)
+	}
+	if want < 1 {
+		want = 1
+	}
+	return want
+}
+
+// planFetch returns the archive.Downloader parallelism, which needs to
+// be set before the global view is built.
+func planFetch(budget uint64, want int) int {
+	if budget == 0 {
+		return want
+	}
+	if budget <= runtimeBytes {
+		return 1
+	}
+	return clampWorkers(want, budget-runtimeBytes, fetchBytes)
+}
+
+// planConcurrency lowers the concurrency levels in want so that the
+// workers of each stage fit into budget (0 means unlimited), given
+// that the global view (of size view) is kept in memory. The Go
+// garbage collector lets the heap grow to twice the live data before
+// collecting (GOGC=100), hence view is accounted twice.
+func planConcurrency(budget, view uint64, want concurrency) (concurrency, error) {
+	if budget == 0 {
+		return want, nil
+	}
+	fixed := runtimeBytes + 2*view
+	if fixed >= budget {
+		one := concurrency{Download: 1, Manwalk: 1, Render: 1}
+		return one, fmt.Errorf("memory budget of %s is exceeded by the estimated %s of package metadata", formatSize(budget), formatSize(fixed))
+	}
+	avail := budget - fixed
+	plan := concurrency{
+		// Stage 2: each download worker accesses the archive.
+		Download: clampWorkers(want.Download, avail, downloadWorkerBytes+fetchBytes),
+		// Stage 3: manwalk workers feed the render workers, which are
+		// the more important ones to keep busy.
+		Render: clampWorkers(want.Render, avail, renderWorkerBytes),
+	}
+	rest := avail
+	if used := uint64(plan.Render) * renderWorkerBytes; used < rest {
+		rest -= used
+	} else {
+		rest = 0
+	}
+	plan.Manwalk = clampWorkers(want.Manwalk, rest, manwalkWorkerBytes)
+	return plan, nil
+}
+
+// applyMemoryBudget sets the Go runtime’s soft memory limit to budget
+// so that garbage is collected more aggressively when approaching it.
+func applyMemoryBudget(budget uint64) {
+	if budget == 0 {
+		return
+	}
+	debug.SetMemoryLimit(int64(budget))
+	slog.Info("memory budget set", "stage", StageDiscover, "budget", formatSize(budget))
+}
+
+// logConcurrency logs the concurrency levels which differ from the
+// requested ones.
+func logConcurrency(budget, view uint64, want, plan concurrency) {
+	slog.Info("global view estimated", "stage", StageDiscover, "size", formatSize(view), "budget", formatSize(budget))
+	for _, c := range []struct {
+		name       string
+		want, plan int
+	}{
+		{"download", want.Download, plan.Download},
+		{"manwalk", want.Manwalk, plan.Manwalk},
+		{"render", want.Render, plan.Render},
+	} {
+		if c.plan < c.want {
+			slog.Info("lowering concurrency to fit the memory budget", "stage", StageDiscover, "concurrency", c.name, "requested", c.want, "planned", c.plan)
+		} else {
+			slog.Info("concurrency planned", "stage", StageDiscover, "concurrency", c.name, "planned", c.plan)
+		}
+	}
+}
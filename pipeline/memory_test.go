@@ -0,0 +1,93 @@
+package pipeline
+
+import "testing"
+
+func TestParseSize(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want uint64
+	}{
+		{"1048576", 1 << 20},
+		{"512M", 512 << 20},
+		{"2G", 2 << 30},
+		{"2GiB", 2 << 30},
+		{"1.5G", 3 << 29},
+		{"64k", 64 << 10},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSize(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseSize(%q): got %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "G", "-1G", "lots"} {
+		if _, err := ParseSize(in); err == nil {
+			t.Errorf("ParseSize(%q) unexpectedly succeeded", in)
+		}
+	}
+}
+
+func TestPlanConcurrency(t *testing.T) {
+	want := concurrency{Download: 10, Manwalk: 1000, Render: 5}
+
+	for _, tt := range []struct {
+		name    string
+		budget  uint64
+		view    uint64
+		want    concurrency
+		wantErr bool
+	}{
+		{
+			name: "unlimited",
+			view: 4 << 30,
+			want: want,
+		},
+
+		{
+			name:   "plenty",
+			budget: 32 << 30,
+			view:   1 << 30,
+			want:   want,
+		},
+
+		{
+			// A 2 GB build VM: 2048 MiB - 64 MiB runtime - 2*600 MiB
+			// view leaves 784 MiB.
+			name:   "2G",
+			budget: 2 << 30,
+			view:   600 << 20,
+			want:   concurrency{Download: 10, Manwalk: 1000, Render: 5},
+		},
+
+		{
+			// 2048 MiB - 64 MiB - 2*800 MiB leaves 384 MiB.
+			name:   "2G-large",
+			budget: 2 << 30,
+			view:   800 << 20,
+			want:   concurrency{Download: 5, Manwalk: 896, Render: 5},
+		},
+
+		{
+			name:    "exceeded",
+			budget:  1 << 30,
+			view:    600 << 20,
+			want:    concurrency{Download: 1, Manwalk: 1, Render: 1},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planConcurrency(tt.budget, tt.view, want)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("planConcurrency: unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("planConcurrency: got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Each Run fetches files from its remote mirrors using a mirrorTransport,
+// whose client fails over to the next healthy mirror of a mirrorSet.
+//
+// archive.Downloader fetches files using http.Get and does not accept
+// an *http.Client. Instead of modifying http.DefaultClient (which would
+// affect every importer of this package), each registered mirrorSet is
+// served to archive.Downloader by a proxy on the loopback interface,
+// which forwards requests using the client. The proxy only serves
+// requests underneath a random path, and is shut down when the Run
+// returns (see mirrorTransport.close).
+
+// mirrorAttempts is the number of requests made for one file (across
+// all mirrors of a mirrorSet) before giving up.
+const mirrorAttempts = 8
+
+var (
+	// mirrorBackoff is how long a mirror is avoided after a failure.
+	// It doubles with every consecutive failure, up to
+	// mirrorMaxBackoff.
+	mirrorBackoff    = 1 * time.Second
+	mirrorMaxBackoff = 1 * time.Minute
+)
+
+type mirror struct {
+	base string // e.g. http://deb.debian.org/debian, without trailing slash
+
+	mu          sync.Mutex
+	failures    int       // consecutive failures
+	retryAt     time.Time // the mirror is unhealthy until retryAt
+	nextRequest time.Time // for rate limiting
+}
+
+// reserve returns how long to wait before sending a request to m so
+// that requests are at least interval apart.
+func (m *mirror) reserve(interval time.Duration) time.Duration {
+	if interval == 0 {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	t := m.nextRequest
+	if t.Before(now) {
+		t = now
+	}
+	m.nextRequest = t.Add(interval)
+	return t.Sub(now)
+}
+
+// mirrorSet fetches files from an ordered list of mirrors.
+type mirrorSet struct {
+	mirrors  []*mirror
+	interval time.Duration // minimum interval between requests to one mirror
+	next     http.RoundTripper
+	metrics  *Metrics
+}
+
+// pick returns the first healthy mirror or, if all mirrors are
+// unhealthy, the mirror which becomes healthy first and how long that
+// takes.
+func (s *mirrorSet) pick() (*mirror, time.Duration) {
+	now := time.Now()
+	var (
+		best    *mirror
+		retryAt time.Time
+	)
+	for _, m := range s.mirrors {
+		m.mu.Lock()
+		r := m.retryAt
+		m.mu.Unlock()
+		if !r.After(now) {
+			return m, 0
+		}
+		if best == nil || r.Before(retryAt) {
+			best, retryAt = m, r
+		}
+	}
+	return best, retryAt.Sub(now)
+}
+
+func (s *mirrorSet) succeeded(m *mirror) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures = 0
+	m.retryAt = time.Time{}
+}
+
+func (s *mirrorSet) failed(m *mirror, err error) {
+	m.mu.Lock()
+	m.failures++
+	backoff := mirrorBackoff << uint(m.failures-1)
+	if backoff > mirrorMaxBackoff || backoff <= 0 {
+		backoff = mirrorMaxBackoff
+	}
+	m.retryAt = time.Now().Add(backoff)
+	failures := m.failures
+	m.mu.Unlock()
+
+	s.metrics.mirrorErrors.With(m.base).Inc()
+	slog.Warn("mirror request failed, avoiding mirror",
+		"mirror", m.base,
+		"err", err,
+		"consecutive_failures", failures,
+		"backoff", backoff)
+}
+
+// transientStatus returns true for HTTP status codes which indicate that
+// the mirror (or a proxy such as apt-cacher-ng) is temporarily
+// unavailable.
+func transientStatus(code int) bool {
+	return code >= 500 || code == http.StatusTooManyRequests
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fetch requests path (relative to the mirror base) starting at offset
+// from the healthiest mirror, failing over to the next mirror on
+// connection errors and transient HTTP errors.
+func (s *mirrorSet) fetch(req *http.Request, path string, offset int64) (*http.Response, *mirror, error) {
+	ctx := req.Context()
+	var lastErr error
+	for attempt := 0; attempt < mirrorAttempts; attempt++ {
+		m, wait := s.pick()
+		if err := sleepContext(ctx, wait+m.reserve(s.interval)); err != nil {
+			return nil, nil, err
+		}
+		u, err := url.Parse(m.base + path)
+		if err != nil {
+			return nil, nil, err
+		}
+		r := req.Clone(ctx)
+		r.URL = u
+		r.Host = u.Host
+		if offset > 0 {
+			r.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+		s.metrics.mirrorRequests.With(m.base).Inc()
+		resp, err := s.next.RoundTrip(r)
+		if err == nil && !transientStatus(resp.StatusCode) {
+			s.succeeded(m)
+			return resp, m, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("%s: unexpected HTTP status %s", u, resp.Status)
+			resp.Body.Close()
+		}
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		lastErr = err
+		s.failed(m, err)
+	}
+	return nil, nil, fmt.Errorf("all mirrors failed after %d attempts, last error: %v", mirrorAttempts, lastErr)
+}
+
+// roundTrip fetches path (relative to the mirror base) for req.
+func (s *mirrorSet) roundTrip(req *http.Request, path string) (*http.Response, error) {
+	resp, m, err := s.fetch(req, path, 0)
+	if err != nil {
+		return nil, err
+	}
+	if req.Method == http.MethodGet && req.Header.Get("Range") == "" && resp.StatusCode == http.StatusOK {
+		resp.Body = &resumingBody{set: s, req: req, path: path, mirror: m, body: resp.Body}
+	}
+	return resp, nil
+}
+
+// resumingBody continues reading from the next healthy mirror (using a
+// range request) when reading the response body fails, e.g. because
+// apt-cacher-ng was restarted during the download.
+type resumingBody struct {
+	set     *mirrorSet
+	req     *http.Request
+	path    string
+	mirror  *mirror
+	body    io.ReadCloser
+	read    int64
+	resumed int
+}
+
+func (b *resumingBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	b.read += int64(n)
+	if err == nil || err == io.EOF || b.resumed >= mirrorAttempts || b.req.Context().Err() != nil {
+		return n, err
+	}
+	b.set.failed(b.mirror, err)
+	b.body.Close()
+	resp, m, ferr := b.set.fetch(b.req, b.path, b.read)
+	if ferr != nil {
+		return n, err
+	}
+	b.resumed++
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// The mirror does not support range requests, skip the
+		// part which was already read.
+		if _, err := io.CopyN(ioutil.Discard, resp.Body, b.read); err != nil {
+			resp.Body.Close()
+			return n, err
+		}
+	default:
+		resp.Body.Close()
+		return n, fmt.Errorf("resuming %s: unexpected HTTP status %s", b.path, resp.Status)
+	}
+	slog.Info("resumed download", "path", b.path, "mirror", m.base, "offset", b.read)
+	b.body, b.mirror = resp.Body, m
+	if n > 0 {
+		return n, nil
+	}
+	return b.Read(p)
+}
+
+func (b *resumingBody) Close() error {
+	return b.body.Close()
+}
+
+// mirrorTransport dispatches requests underneath the URL of a registered
+// mirror proxy to its mirrorSet, so that client does not need to send
+// requests through the proxy. All other requests are passed through
+// unmodified.
+type mirrorTransport struct {
+	next   http.RoundTripper
+	client *http.Client // uses the mirrorTransport
+
+	mu      sync.Mutex
+	sets    map[string]*mirrorSet // by proxy URL
+	servers []*http.Server
+}
+
+func newMirrorTransport(next http.RoundTripper) *mirrorTransport {
+	t := &mirrorTransport{
+		next: next,
+		sets: make(map[string]*mirrorSet),
+	}
+	t.client = &http.Client{Transport: t}
+	return t
+}
+
+// httpClient returns the client which fails over between mirrors, or
+// http.DefaultClient if t is nil.
+func (t *mirrorTransport) httpClient() *http.Client {
+	if t == nil {
+		return http.DefaultClient
+	}
+	return t.client
+}
+
+// set returns the mirrorSet of the proxy URL which u is underneath and
+// the path of u relative to the proxy URL, or nil.
+func (t *mirrorTransport) set(u string) (*mirrorSet, string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for proxy, s := range t.sets {
+		if strings.HasPrefix(u, proxy+"/") {
+			return s, strings.TrimPrefix(u, proxy)
+		}
+	}
+	return nil, ""
+}
+
+func (t *mirrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if set, path := t.set(req.URL.String()); set != nil {
+		return set.roundTrip(req, path)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// mirrorProxy serves requests underneath prefix by requesting the same
+// path underneath url (its own URL) using client, whose mirrorTransport
+// dispatches them to the mirrorSet.
+type mirrorProxy struct {
+	client *http.Client
+	prefix string // random path, see register
+	url    string
+}
+
+func (p *mirrorProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, p.prefix+"/") {
+		http.NotFound(w, r)
+		return
+	}
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, p.url+strings.TrimPrefix(r.URL.RequestURI(), p.prefix), nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if rng := r.Header.Get("Range"); rng != "" {
+		req.Header.Set("Range", rng)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		// archive.Downloader retries on HTTP 5xx.
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(resp.StatusCode)
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		// Abort the connection so that the client does not mistake
+		// the truncated body for the entire file.
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// register makes requests to the returned proxy URL (which is to be used
+// as archive.Downloader.Mirror) fail over between bases, in order.
+// rateLimit is the maximum number of requests per second to each mirror
+// (0 means unlimited).
+func (t *mirrorTransport) register(bases []string, rateLimit float64, metrics *Metrics) (string, error) {
+	s := &mirrorSet{
+		next:    t.next,
+		metrics: metrics,
+	}
+	if rateLimit > 0 {
+		s.interval = time.Duration(float64(time.Second) / rateLimit)
+	}
+	for _, base := range bases {
+		s.mirrors = append(s.mirrors, &mirror{base: strings.TrimSuffix(base, "/")})
+	}
+	var token [16]byte
+	if _, err := rand.Read(token[:]); err != nil {
+		return "", err
+	}
+	prefix := "/" + hex.EncodeToString(token[:])
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("starting mirror proxy: %v", err)
+	}
+	proxy := "http://" + ln.Addr().String() + prefix
+	srv := &http.Server{Handler: &mirrorProxy{client: t.client, prefix: prefix, url: proxy}}
+	go srv.Serve(ln)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sets[proxy] = s
+	t.servers = append(t.servers, srv)
+	return proxy, nil
+}
+
+// close shuts down the mirror proxies. t may be nil.
+func (t *mirrorTransport) close() error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var firstErr error
+	for _, srv := range t.servers {
+		if err := srv.Shutdown(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	t.servers = nil
+	return firstErr
+}
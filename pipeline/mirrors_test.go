@@ -0,0 +1,247 @@
+package pipeline
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pault.ag/go/archive"
+	"pault.ag/go/debian/control"
+)
+
+var mirrorContent = bytes.Repeat([]byte("debiman "), 16*1024)
+
+func serveContent(w http.ResponseWriter, r *http.Request) {
+	http.ServeContent(w, r, "Packages", time.Now(), bytes.NewReader(mirrorContent))
+}
+
+// newTestMirrors registers bases with a new mirrorTransport and returns
+// its client and the proxy URL, underneath which requests fail over
+// between bases.
+func newTestMirrors(t *testing.T, metrics *Metrics, rateLimit float64, bases ...string) (*http.Client, string) {
+	t.Helper()
+	transport := newMirrorTransport(http.DefaultTransport)
+	t.Cleanup(func() { transport.close() })
+	proxy, err := transport.register(bases, rateLimit, metrics)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return transport.client, proxy
+}
+
+func get(t *testing.T, client *http.Client, u string) []byte {
+	t.Helper()
+	resp, err := client.Get(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("GET %s: unexpected HTTP status: got %d, want %d", u, got, want)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("GET %s: %v", u, err)
+	}
+	return b
+}
+
+func TestMirrorFailover(t *testing.T) {
+	var brokenRequests int64
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&brokenRequests, 1)
+		http.Error(w, "apt-cacher-ng restarting", http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(serveContent))
+	defer healthy.Close()
+
+	metrics := NewMetrics()
+	client, primary := newTestMirrors(t, metrics, 0, broken.URL+"/debian", healthy.URL+"/debian")
+	for i := 0; i < 2; i++ {
+		if got := get(t, client, primary+"/dists/sid/main/binary-amd64/Packages"); !bytes.Equal(got, mirrorContent) {
+			t.Fatalf("unexpected content (%d bytes)", len(got))
+		}
+	}
+	// The second request must skip the broken mirror, which is backing
+	// off.
+	if got, want := atomic.LoadInt64(&brokenRequests), int64(1); got != want {
+		t.Errorf("requests to the broken mirror: got %d, want %d", got, want)
+	}
+	if got, want := metrics.mirrorErrors.With(broken.URL+"/debian").Value(), 1.0; got != want {
+		t.Errorf("broken mirror errors: got %v, want %v", got, want)
+	}
+	if got, want := metrics.mirrorErrors.With(healthy.URL+"/debian").Value(), 0.0; got != want {
+		t.Errorf("healthy mirror errors: got %v, want %v", got, want)
+	}
+	if got, want := metrics.mirrorRequests.With(healthy.URL+"/debian").Value(), 2.0; got != want {
+		t.Errorf("healthy mirror requests: got %v, want %v", got, want)
+	}
+}
+
+func TestMirrorBackoff(t *testing.T) {
+	defer func(old time.Duration) { mirrorBackoff = old }(mirrorBackoff)
+	mirrorBackoff = 10 * time.Millisecond
+
+	// A single mirror which is unavailable for its first two requests,
+	// e.g. while apt-cacher-ng restarts.
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&requests, 1) <= 2 {
+			http.Error(w, "restarting", http.StatusBadGateway)
+			return
+		}
+		serveContent(w, r)
+	}))
+	defer srv.Close()
+
+	metrics := NewMetrics()
+	client, primary := newTestMirrors(t, metrics, 0, srv.URL+"/debian")
+	start := time.Now()
+	if got := get(t, client, primary+"/pool/main/i/i3-wm/i3-wm.deb"); !bytes.Equal(got, mirrorContent) {
+		t.Fatalf("unexpected content (%d bytes)", len(got))
+	}
+	// 10ms after the first failure, 20ms after the second.
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("request succeeded after %v, want exponential backoff of at least 30ms", elapsed)
+	}
+	if got, want := metrics.mirrorErrors.With(srv.URL+"/debian").Value(), 2.0; got != want {
+		t.Errorf("mirror errors: got %v, want %v", got, want)
+	}
+}
+
+func TestMirrorResume(t *testing.T) {
+	// truncated sends half of the content, then drops the connection.
+	truncated := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(mirrorContent)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(mirrorContent[:len(mirrorContent)/2])
+		w.(http.Flusher).Flush()
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		conn.Close()
+	}))
+	defer truncated.Close()
+	var ranges []string
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		serveContent(w, r)
+	}))
+	defer healthy.Close()
+
+	metrics := NewMetrics()
+	client, primary := newTestMirrors(t, metrics, 0, truncated.URL+"/debian", healthy.URL+"/debian")
+	if got := get(t, client, primary+"/pool/main/i/i3-wm/i3-wm.deb"); !bytes.Equal(got, mirrorContent) {
+		t.Fatalf("unexpected content (%d bytes, want %d)", len(got), len(mirrorContent))
+	}
+	if len(ranges) != 1 || ranges[0] == "" {
+		t.Errorf("healthy mirror: got Range headers %q, want one range request", ranges)
+	}
+	if got, want := metrics.mirrorErrors.With(truncated.URL+"/debian").Value(), 1.0; got != want {
+		t.Errorf("truncated mirror errors: got %v, want %v", got, want)
+	}
+}
+
+func TestMirrorRateLimit(t *testing.T) {
+	m := &mirror{base: "http://deb.debian.org/debian"}
+	if got := m.reserve(100 * time.Millisecond); got != 0 {
+		t.Errorf("first reservation: got %v, want 0", got)
+	}
+	if got := m.reserve(100 * time.Millisecond); got < 90*time.Millisecond {
+		t.Errorf("second reservation: got %v, want ≈100ms", got)
+	}
+	if got := m.reserve(0); got != 0 {
+		t.Errorf("unlimited reservation: got %v, want 0", got)
+	}
+}
+
+func TestMirrorSetsIndependent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(serveContent))
+	defer srv.Close()
+
+	// Two runs using the same mirror must not share their mirrorSets
+	// (and hence their Metrics).
+	first, second := NewMetrics(), NewMetrics()
+	firstClient, firstProxy := newTestMirrors(t, first, 0, srv.URL+"/debian")
+	secondClient, secondProxy := newTestMirrors(t, second, 0, srv.URL+"/debian")
+	for i := 0; i < 10; i++ {
+		get(t, firstClient, firstProxy+"/pool/main/i/i3-wm/i3-wm.deb")
+	}
+	get(t, secondClient, secondProxy+"/pool/main/i/i3-wm/i3-wm.deb")
+	for _, tt := range []struct {
+		metrics *Metrics
+		want    float64
+	}{
+		{first, 10},
+		{second, 1},
+	} {
+		if got := tt.metrics.mirrorRequests.With(srv.URL + "/debian").Value(); got != tt.want {
+			t.Errorf("requests to mirror %s: got %v, want %v", srv.URL, got, tt.want)
+		}
+	}
+}
+
+func TestMirrorProxy(t *testing.T) {
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "apt-cacher-ng restarting", http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(serveContent))
+	defer healthy.Close()
+
+	transport := newMirrorTransport(http.DefaultTransport)
+	proxy, err := transport.register([]string{broken.URL + "/debian", healthy.URL + "/debian"}, 0, NewMetrics())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ar := &archive.Downloader{Mirror: proxy, Parallel: 1}
+	sum := sha256.Sum256(mirrorContent)
+	f, err := ar.TempFile(control.FileHash{
+		Algorithm: "sha256",
+		Hash:      fmt.Sprintf("%x", sum),
+		Size:      int64(len(mirrorContent)),
+		Filename:  "pool/main/i/i3-wm/i3-wm.deb",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if http.DefaultClient.Transport != nil {
+		t.Errorf("http.DefaultClient.Transport unexpectedly modified")
+	}
+
+	// Requests outside of the random path are not served.
+	u, err := url.Parse(proxy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get("http://" + u.Host + "/pool/main/i/i3-wm/i3-wm.deb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("request outside of the proxy path: got HTTP status %d, want %d", got, want)
+	}
+
+	// The proxy is shut down with the transport.
+	if err := transport.close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := http.Get(proxy + "/pool/main/i/i3-wm/i3-wm.deb"); err == nil {
+		t.Errorf("mirror proxy still serving after close")
+	}
+}
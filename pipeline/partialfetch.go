@@ -0,0 +1,215 @@
+package pipeline
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"pault.ag/go/archive"
+	"pault.ag/go/debian/deb"
+)
+
+// Manpages typically make up a tiny fraction of a package: -doc or game
+// data packages can be hundreds of megabytes, but ship only a few
+// kilobytes of manpages. Packages of at least Options.PartialFetchMinSize
+// bytes are therefore not downloaded as a whole. Instead, downloadPkg
+// reads the ar archive and its data.tar member using HTTP range requests
+// and stops reading once all files underneath the man roots (according
+// to the Contents files, see manRoots) have been seen.
+
+// rangeSkip is the maximum number of bytes which rangeReader reads and
+// discards to get to a later offset, instead of sending a new request.
+const rangeSkip = 64 * 1024
+
+// rangeReader is an io.ReaderAt for a file on an HTTP server. Sequential
+// reads are served from a single streaming response; a new range
+// request is only sent when reading from an earlier offset or from far
+// ahead.
+//
+// While the file is read sequentially from offset 0, its SHA256 hash is
+// computed, so that the hash is verified if the whole file ends up being
+// read.
+type rangeReader struct {
+	client *http.Client
+	url    string
+	size   int64
+	sha256 []byte
+
+	mu       sync.Mutex
+	body     io.ReadCloser
+	offset   int64     // offset of the next byte of body
+	hash     hash.Hash // nil unless body was read sequentially from offset 0
+	fetched  int64     // bytes read from the server
+	verified bool      // whether the whole file was read and its hash matched
+}
+
+func newRangeReader(client *http.Client, url string, size int64, sha256 []byte) *rangeReader {
+	return &rangeReader{
+		client: client,
+		url:    url,
+		size:   size,
+		sha256: sha256,
+	}
+}
+
+// open sends a request for the file, starting at offset off.
+func (r *rangeReader) open(off int64) error {
+	if r.body != nil {
+		r.body.Close()
+		r.body = nil
+	}
+	req, err := http.NewRequest(http.MethodGet, r.url, nil)
+	if err != nil {
+		return err
+	}
+	if off > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", off))
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	switch {
+	case off > 0 && resp.StatusCode == http.StatusPartialContent:
+		r.offset = off
+		r.hash = nil
+	case resp.StatusCode == http.StatusOK:
+		// Either off is 0 or the mirror does not support range
+		// requests, in which case ReadAt skips to off.
+		r.offset = 0
+		r.hash = sha256.New()
+	default:
+		resp.Body.Close()
+		return fmt.Errorf("%s: unexpected HTTP status %s", r.url, resp.Status)
+	}
+	r.body = resp.Body
+	return nil
+}
+
+// read reads from the current response body. The caller must hold r.mu.
+func (r *rangeReader) read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	r.fetched += int64(n)
+	if r.hash != nil {
+		r.hash.Write(p[:n])
+		if r.offset == r.size {
+			if sum := r.hash.Sum(nil); !bytes.Equal(sum, r.sha256) {
+				return n, fmt.Errorf("%s: hash mismatch: got sha256 %x, want %x", r.url, sum, r.sha256)
+			}
+			r.verified = true
+			r.hash = nil
+		}
+	}
+	if err == io.EOF && r.offset < r.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (r *rangeReader) ReadAt(p []byte, off int64) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	if r.body == nil || off < r.offset || off-r.offset > rangeSkip {
+		if err := r.open(off); err != nil {
+			return 0, err
+		}
+	}
+	var discard [4096]byte
+	for r.offset < off {
+		skip := off - r.offset
+		if skip > int64(len(discard)) {
+			skip = int64(len(discard))
+		}
+		if _, err := r.read(discard[:skip]); err != nil {
+			return 0, err
+		}
+	}
+	var n int
+	for n < len(p) {
+		nn, err := r.read(p[n:])
+		n += nn
+		if err == io.EOF && n == len(p) {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// Close closes the current response body, if any.
+func (r *rangeReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
+
+// dataTar returns a tar.Reader for the data.tar member of the .deb
+// archive in and a Closer for its decompressor. If control is non-nil,
+// it is called with the control.tar member, which precedes data.tar, so
+// that both are read in a single pass.
+func dataTar(in io.ReaderAt, control func(*tar.Reader) error) (*tar.Reader, io.Closer, error) {
+	a, err := deb.LoadAr(in)
+	if err != nil {
+		return nil, nil, err
+	}
+	for {
+		e, err := a.Next()
+		if err == io.EOF {
+			return nil, nil, fmt.Errorf("no data.tar member found")
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if control != nil && strings.HasPrefix(e.Name, "control.tar") {
+			tr, closer, err := e.Tarfile()
+			if err != nil {
+				return nil, nil, fmt.Errorf("control.tar: %w", err)
+			}
+			err = control(tr)
+			closer.Close()
+			if err != nil {
+				return nil, nil, fmt.Errorf("control.tar: %w", err)
+			}
+		}
+		if strings.HasPrefix(e.Name, "data.tar") {
+			return e.Tarfile()
+		}
+	}
+}
+
+// fetchPartially returns whether p is to be fetched using HTTP range
+// requests, see Options.PartialFetchMinSize.
+func fetchPartially(ar *archive.Downloader, p pkgEntry, opts *Options) bool {
+	return ar.LocalMirror == "" &&
+		opts.PartialFetchMinSize > 0 &&
+		p.bytes >= 0 &&
+		uint64(p.bytes) >= opts.PartialFetchMinSize
+}
+
+// manEntries returns the set of paths underneath the man roots which the
+// Contents files list for p.
+func (gv globalView) manEntries(p pkgEntry) map[string]bool {
+	entries := gv.contentByPkg[p.suite+"/"+p.binarypkg]
+	res := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		res[e.filename] = true
+	}
+	return res
+}
@@ -0,0 +1,445 @@
+package pipeline
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pault.ag/go/archive"
+)
+
+func TestRangeReader(t *testing.T) {
+	content := make([]byte, 4*rangeSkip)
+	rand.New(rand.NewSource(1)).Read(content)
+	sum := sha256.Sum256(content)
+
+	for _, tt := range []struct {
+		name         string
+		ignoreRanges bool
+	}{
+		{name: "ranges"},
+		{name: "no ranges", ignoreRanges: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int64
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt64(&requests, 1)
+				if tt.ignoreRanges {
+					r.Header.Del("Range")
+				}
+				http.ServeContent(w, r, "pkg.deb", time.Now(), bytes.NewReader(content))
+			}))
+			defer srv.Close()
+
+			rr := newRangeReader(http.DefaultClient, srv.URL+"/pkg.deb", int64(len(content)), sum[:])
+			defer rr.Close()
+			buf := make([]byte, 100)
+			for _, off := range []int64{0, 100, 300, 3 * rangeSkip, 10} {
+				if _, err := rr.ReadAt(buf, off); err != nil {
+					t.Fatalf("ReadAt(%d): %v", off, err)
+				}
+				if !bytes.Equal(buf, content[off:off+100]) {
+					t.Fatalf("ReadAt(%d): unexpected content", off)
+				}
+			}
+			// Sequential reads and small skips are served from the
+			// same response, 3*rangeSkip and 10 need a new request
+			// each.
+			if got, want := atomic.LoadInt64(&requests), int64(3); got != want {
+				t.Errorf("requests: got %d, want %d", got, want)
+			}
+			if rr.verified {
+				t.Errorf("verified unexpectedly, not all of the file was read")
+			}
+
+			if _, err := rr.ReadAt(make([]byte, len(content)), 0); err != nil {
+				t.Fatal(err)
+			}
+			if !rr.verified {
+				t.Errorf("not verified after reading the whole file")
+			}
+		})
+	}
+
+	t.Run("hash mismatch", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeContent(w, r, "pkg.deb", time.Now(), bytes.NewReader(content))
+		}))
+		defer srv.Close()
+		rr := newRangeReader(http.DefaultClient, srv.URL+"/pkg.deb", int64(len(content)), make([]byte, sha256.Size))
+		defer rr.Close()
+		_, err := rr.ReadAt(make([]byte, len(content)), 0)
+		if err == nil || !strings.Contains(err.Error(), "hash mismatch") {
+			t.Fatalf("ReadAt: got %v, want hash mismatch", err)
+		}
+	})
+}
+
+func TestDataTarPartial(t *testing.T) {
+	// A package whose manpage is followed by a large file.
+	blob := make([]byte, 1<<20)
+	rand.New(rand.NewSource(1)).Read(blob)
+	deb := buildDeb(t, []testFile{
+		{name: "./usr/share/man/man1/huge.1.gz", content: gzipped(t, ".TH HUGE 1\n")},
+		{name: "./usr/share/games/huge/data.bin", content: blob},
+	})
+	sum := sha256.Sum256(deb)
+
+	for _, tt := range []struct {
+		name         string
+		ignoreRanges bool
+	}{
+		{name: "ranges"},
+		{name: "no ranges", ignoreRanges: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.ignoreRanges {
+					r.Header.Del("Range")
+				}
+				http.ServeContent(w, r, "huge.deb", time.Now(), bytes.NewReader(deb))
+			}))
+			defer srv.Close()
+
+			rr := newRangeReader(http.DefaultClient, srv.URL+"/huge.deb", int64(len(deb)), sum[:])
+			data, closer, err := dataTar(rr, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			header, err := data.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := header.Name, "./usr/share/man/man1/huge.1.gz"; got != want {
+				t.Fatalf("first data.tar entry: got %q, want %q", got, want)
+			}
+			// Stop reading once the manpage was seen, like downloadPkg.
+			closer.Close()
+			if err := rr.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if rr.fetched >= int64(len(deb))/2 {
+				t.Errorf("fetched %d of %d bytes, want only the beginning", rr.fetched, len(deb))
+			}
+			if rr.verified {
+				t.Errorf("verified unexpectedly, not all of the package was read")
+			}
+		})
+	}
+}
+
+func TestPartialMarker(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, partial := range []bool{true, true, false, false} {
+		if err := writePartialMarker(dir, partial); err != nil {
+			t.Fatal(err)
+		}
+		if got := readPartially(dir); got != partial {
+			t.Fatalf("readPartially after writePartialMarker(%v): got %v", partial, got)
+		}
+	}
+}
+
+type testFile struct {
+	name     string
+	linkname string // for symlinks
+	content  []byte
+}
+
+// buildDeb returns a .deb archive with the specified data.tar.gz
+// contents and maintainer scripts (e.g. ./postinst) in control.tar.gz.
+func buildDeb(t *testing.T, files []testFile, control ...testFile) []byte {
+	t.Helper()
+	control = append([]testFile{{name: "./control", content: []byte("Package: test\n")}}, control...)
+	var b bytes.Buffer
+	b.WriteString("!<arch>\n")
+	for _, m := range []struct {
+		name    string
+		content []byte
+	}{
+		{"debian-binary", []byte("2.0\n")},
+		{"control.tar.gz", tarGz(t, control)},
+		{"data.tar.gz", tarGz(t, files)},
+	} {
+		fmt.Fprintf(&b, "%-16s%-12d%-6d%-6d%-8s%-10d`\n", m.name, 1500000000, 0, 0, "100644", len(m.content))
+		b.Write(m.content)
+		if len(m.content)%2 == 1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.Bytes()
+}
+
+// tarGz returns a .tar.gz archive containing files.
+func tarGz(t *testing.T, files []testFile) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	gzw := gzip.NewWriter(&b)
+	tw := tar.NewWriter(gzw)
+	for _, f := range files {
+		hdr := &tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(f.content)),
+			ModTime: time.Unix(1500000000, 0),
+		}
+		if f.linkname != "" {
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = f.linkname
+			hdr.Size = 0
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(f.content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := io.WriteString(w, s); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// listFiles returns the names of all files underneath dir.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var names []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		names = append(names, rel)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPartialFetch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := newRun(testOptions(t, filepath.Join(dir, "full")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.close()
+	if err := r.discover(); err != nil {
+		t.Fatal(err)
+	}
+	// Extract the packages from the local mirror for comparison.
+	if err := r.extract(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	i3 := *r.gv.pkgs[0]
+
+	// A package whose manpage is followed by a large file.
+	blob := make([]byte, 4<<20)
+	rand.New(rand.NewSource(1)).Read(blob)
+	hugeDeb := buildDeb(t, []testFile{
+		{name: "./usr/share/man/man1/huge.1.gz", content: gzipped(t, ".TH HUGE 1\n.SH NAME\nhuge \\- test\n")},
+		{name: "./usr/share/games/huge/data.bin", content: blob},
+	})
+	huge := i3
+	huge.binarypkg = "huge"
+	huge.filename = "pool/main/h/huge/huge_1_all.deb"
+	huge.bytes = int64(len(hugeDeb))
+	sum := sha256.Sum256(hugeDeb)
+	huge.sha256 = sum[:]
+	c := &contentEntry{suite: huge.suite, binarypkg: huge.binarypkg, arch: huge.arch, filename: "man1/huge.1.gz"}
+	r.gv.contentByPath[c.filename] = append(r.gv.contentByPath[c.filename], c)
+	r.gv.contentByPkg[huge.suite+"/"+huge.binarypkg] = []*contentEntry{c}
+
+	mirror, err := filepath.Abs("../testdata/tinymirror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileServer := http.FileServer(http.Dir(mirror))
+	var hugeRequests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+huge.filename {
+			atomic.AddInt64(&hugeRequests, 1)
+			http.ServeContent(w, r, "huge.deb", time.Now(), bytes.NewReader(hugeDeb))
+			return
+		}
+		fileServer.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	opts := *r.gv.opts
+	opts.ServingDir = filepath.Join(dir, "partial")
+	opts.PartialFetchMinSize = 1
+	opts.Metrics = NewMetrics()
+	gv := r.gv
+	gv.opts = &opts
+	ar := &archive.Downloader{Mirror: srv.URL, Parallel: 1}
+
+	t.Run("i3-wm", func(t *testing.T) {
+		if err := downloadPkg(ar, i3, gv); err != nil {
+			t.Fatal(err)
+		}
+		pkgdir := filepath.Join(i3.suite, i3.binarypkg)
+		want := listFiles(t, filepath.Join(r.gv.opts.ServingDir, pkgdir))
+		got := listFiles(t, filepath.Join(opts.ServingDir, pkgdir))
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("partially fetched package differs: got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("huge", func(t *testing.T) {
+		pkgdir := filepath.Join(opts.ServingDir, huge.suite, "huge")
+		// A manpage which the Contents files do not (yet) list must
+		// not be considered removed.
+		if err := os.MkdirAll(pkgdir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		unlisted := filepath.Join(pkgdir, "unlisted.1.en.gz")
+		if err := ioutil.WriteFile(unlisted, gzipped(t, ".TH UNLISTED 1\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		before := opts.Metrics.bytesDownloaded.Value()
+		if err := downloadPkg(ar, huge, gv); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(filepath.Join(pkgdir, "huge.1.en.gz")); err != nil {
+			t.Error(err)
+		}
+		fetched := opts.Metrics.bytesDownloaded.Value() - before
+		if fetched >= float64(len(hugeDeb))/2 {
+			t.Errorf("fetched %v of %d bytes, want less than half", fetched, len(hugeDeb))
+		}
+		if _, err := os.Stat(unlisted); err != nil {
+			t.Errorf("manpage which was not seen was removed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(pkgdir, "VERSION")); err != nil {
+			t.Error(err)
+		}
+		if !readPartially(pkgdir) {
+			t.Errorf("package not marked as read partially")
+		}
+		names, err := extractedManpages(pkgdir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !names["huge.1.en.gz"] || !names["unlisted.1.en.gz"] {
+			t.Errorf("MANPAGES: got %v, want huge.1.en.gz and unlisted.1.en.gz", names)
+		}
+		var changes []string
+		for _, c := range gv.changelog.bySuite[huge.suite] {
+			if c.Binarypkg == huge.binarypkg {
+				changes = append(changes, c.Manpage+" "+string(c.Change))
+			}
+		}
+		if got, want := strings.Join(changes, ","), "huge.1.en added"; got != want {
+			t.Errorf("changelog: got %q, want %q", got, want)
+		}
+
+		// The next run skips the package until its version changes.
+		requests := atomic.LoadInt64(&hugeRequests)
+		if err := downloadPkg(ar, huge, gv); err != nil {
+			t.Fatal(err)
+		}
+		if got := atomic.LoadInt64(&hugeRequests) - requests; got != 0 {
+			t.Errorf("package fetched again (%d requests), want it to be skipped", got)
+		}
+	})
+
+	t.Run("no longer fetched partially", func(t *testing.T) {
+		whole := opts
+		whole.PartialFetchMinSize = 0
+		gv := gv
+		gv.opts = &whole
+		requests := atomic.LoadInt64(&hugeRequests)
+		if err := downloadPkg(ar, huge, gv); err != nil {
+			t.Fatal(err)
+		}
+		if got := atomic.LoadInt64(&hugeRequests) - requests; got != 1 {
+			t.Errorf("package fetched with %d requests, want 1", got)
+		}
+		pkgdir := filepath.Join(opts.ServingDir, huge.suite, "huge")
+		if readPartially(pkgdir) {
+			t.Errorf("package still marked as read partially after a complete read")
+		}
+	})
+
+	t.Run("not in Contents", func(t *testing.T) {
+		unlisted := huge
+		unlisted.binarypkg = "unlisted"
+		before := opts.Metrics.bytesDownloaded.Value()
+		if err := downloadPkg(ar, unlisted, gv); err != nil {
+			t.Fatal(err)
+		}
+		pkgdir := filepath.Join(opts.ServingDir, unlisted.suite, "unlisted")
+		if _, err := os.Stat(filepath.Join(pkgdir, "huge.1.en.gz")); err != nil {
+			t.Error(err)
+		}
+		if _, err := os.Stat(filepath.Join(pkgdir, "VERSION")); err != nil {
+			t.Error(err)
+		}
+		if fetched := opts.Metrics.bytesDownloaded.Value() - before; fetched != float64(len(hugeDeb)) {
+			t.Errorf("fetched %v of %d bytes, want the whole package", fetched, len(hugeDeb))
+		}
+	})
+
+	t.Run("hash mismatch", func(t *testing.T) {
+		// The symlink at the end of i3-wm’s data.tar requires reading
+		// the whole package, so its hash is verified.
+		broken := i3
+		broken.binarypkg = "broken"
+		broken.sha256 = make([]byte, sha256.Size)
+		r.gv.contentByPkg[broken.suite+"/broken"] = r.gv.contentByPkg[i3.suite+"/"+i3.binarypkg]
+		err := downloadPkg(ar, broken, gv)
+		if err == nil || !strings.Contains(err.Error(), "hash mismatch") {
+			t.Fatalf("downloadPkg: got %v, want hash mismatch", err)
+		}
+	})
+}
@@ -0,0 +1,687 @@
+// Package pipeline implements debiman’s processing pipeline, which
+// turns the manpages contained in a Debian archive into a static
+// website. It is used by cmd/debiman, but can also be embedded into
+// other programs:
+//
+//	opts := pipeline.DefaultOptions()
+//	opts.ServingDir = "/srv/man"
+//	opts.SyncSuites = []string{"testing"}
+//	opts.Hooks.StageCompleted = func(stage pipeline.Stage, units uint64, d time.Duration) {
+//		slog.Info("stage completed", "stage", stage, "units", units, "duration", d)
+//	}
+//	stats, err := pipeline.Run(ctx, opts)
+//
+// The pipeline consists of the following stages, which are run in
+// order:
+//
+//  1. StageDiscover fetches the Release, Contents and Packages files
+//     of all suites and identifies the binary packages containing
+//     manpages.
+//  2. StageExtract downloads these packages and extracts the manpages
+//     (and auxiliary files referenced via .so) into Options.ServingDir.
+//  3. StageRender renders the manpages into HTML using mandoc(1).
+//  4. StageIndex writes the debiman-auxserver index.
+//  5. StageAux renders the pages which are not specific to a manpage
+//     (index, FAQ, about) and the changelog feeds.
+//
+// Log messages are written via the default log/slog logger.
+//
+// The templates and the base URL (see Options.InjectAssets and
+// Options.BaseURL) are process-wide, so concurrent runs must use the
+// same values for these options.
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Debian/debiman/internal/bundled"
+	"github.com/Debian/debiman/internal/commontmpl"
+
+	"pault.ag/go/archive"
+)
+
+// Version is the debiman version, which is included in the rendered
+// pages and in the run journal.
+var Version = "HEAD"
+
+// Stage identifies a stage of the pipeline.
+type Stage string
+
+const (
+	StageDiscover Stage = "discover"
+	StageExtract  Stage = "extract"
+	StageRender   Stage = "render"
+	StageIndex    Stage = "index"
+	StageAux      Stage = "aux"
+)
+
+// Stages lists all stages in the order in which Run runs them.
+var Stages = []Stage{StageDiscover, StageExtract, StageRender, StageIndex, StageAux}
+
+// Hooks are called while the pipeline runs. All hooks are optional.
+// Hooks which are documented to be called concurrently must be safe for
+// concurrent use.
+type Hooks struct {
+	// StageStarted is called when stage starts.
+	StageStarted func(stage Stage)
+
+	// StageCompleted is called after stage completed. units is the
+	// number of work units, e.g. packages extracted or manpages
+	// rendered.
+	StageCompleted func(stage Stage, units uint64, duration time.Duration)
+
+	// StageSkipped is called when Run skips stage because its inputs
+	// did not change since the last complete run.
+	StageSkipped func(stage Stage)
+
+	// PackageExtracted is called (concurrently) after the manpages of
+	// a binary package were extracted.
+	PackageExtracted func(suite, binarypkg string, manpages int)
+
+	// PackageFailed is called (concurrently) when a binary package
+	// could not be extracted, even after retrying, and was
+	// quarantined: its previously extracted manpages are left as-is.
+	PackageFailed func(suite, binarypkg string, err error)
+
+	// ManpageRendered is called (concurrently) after the manpage at
+	// path (underneath Options.ServingDir) was rendered. err is
+	// non-nil if the manpage could not be converted, in which case an
+	// error page was rendered instead.
+	ManpageRendered func(path string, err error)
+}
+
+// InterruptedError is returned by Run and RunStage when their context
+// was canceled. Work which was in progress at the time was completed
+// and recorded in the run journal, so that the next run resumes where
+// the interrupted run stopped.
+type InterruptedError struct {
+	// Stage is the stage which was interrupted.
+	Stage Stage
+
+	// Units is the number of work units of Stage which were completed,
+	// e.g. packages extracted or manpages rendered.
+	Units uint64
+
+	// Err is the context’s error.
+	Err error
+}
+
+func (e *InterruptedError) Error() string {
+	return fmt.Sprintf("interrupted during stage %s after %d units (%v), run again to resume", e.Stage, e.Units, e.Err)
+}
+
+func (e *InterruptedError) Unwrap() error { return e.Err }
+
+// SuiteOptions override Options for one suite. Nil fields do not
+// override anything.
+type SuiteOptions struct {
+	RemoteMirrors  []string
+	LocalMirror    *string
+	Keyring        *string
+	Components     []string
+	ForceRerender  *bool
+	ForceReextract *bool
+}
+
+// Options configure the pipeline. Use DefaultOptions to obtain the
+// defaults of cmd/debiman.
+type Options struct {
+	// ServingDir is the directory in which to place the manpages
+	// which should be served.
+	ServingDir string
+
+	// IndexPath is the path of the debiman-auxserver index to
+	// generate. Defaults to auxserver.idx in ServingDir.
+	IndexPath string
+
+	// StateDir is the directory in which to persist state between
+	// runs, e.g. the run journal. It must not be served publicly.
+	// Defaults to ServingDir with a .debiman suffix (e.g.
+	// /srv/man.debiman for /srv/man), see also migrateStateDir.
+	StateDir string
+
+	// SyncCodenames and SyncSuites are the Debian codenames (e.g.
+	// jessie) and suites (e.g. testing) to synchronize.
+	SyncCodenames []string
+	SyncSuites    []string
+
+	// Components are the archive components to synchronize.
+	Components []string
+
+	// Suites maps from codename or suite (as in SyncCodenames or
+	// SyncSuites) to overrides for that suite.
+	Suites map[string]SuiteOptions
+
+	// ExtraManRoots are directories (in addition to /usr/share/man)
+	// underneath which packages ship manpages, e.g. /opt/*/share/man.
+	// path.Match wildcards match a single path component. Manpages are
+	// served as if they were shipped in /usr/share/man.
+	ExtraManRoots []string
+
+	// OnlyRenderPkgs, if non-empty, restricts rendering to the
+	// specified binary packages (for developing).
+	OnlyRenderPkgs []string
+
+	// ForceRerender forces all manpages to be re-rendered, even if
+	// they are up to date.
+	ForceRerender bool
+
+	// ForceReextract forces all manpages to be re-extracted, even if
+	// there is no newer package version.
+	ForceReextract bool
+
+	// RemoteMirrors are the URLs of Debian mirrors to fetch packages
+	// and indices from, in order of preference. Requests fail over to
+	// the next mirror on connection errors and transient HTTP errors
+	// (5xx, 429), interrupted downloads are resumed on the next mirror,
+	// and a failing mirror is avoided for an exponentially increasing
+	// period.
+	RemoteMirrors []string
+
+	// MirrorRateLimit is the maximum number of requests per second to
+	// each of RemoteMirrors. 0 means unlimited.
+	MirrorRateLimit float64
+
+	// PartialFetchMinSize is the size (in bytes) from which on
+	// packages are not downloaded as a whole, but read using HTTP range
+	// requests until all their manpages were seen. The SHA256 hash of a
+	// partially fetched package cannot be verified unless the whole
+	// file ends up being read. 0 disables partial fetches. Ignored with
+	// LocalMirror.
+	PartialFetchMinSize uint64
+
+	// LocalMirror, if non-empty, is a file system path to a Debian
+	// mirror, which is used instead of RemoteMirrors.
+	LocalMirror string
+
+	// Keyring, if non-empty, is the path of a GPG public keyring used
+	// for validating archive signatures instead of
+	// archive.DebianArchiveKeyring.
+	Keyring string
+
+	// AlternativesDir, if non-empty, is a directory containing
+	// JSON-encoded lists of slave alternative links, named after the
+	// suite (e.g. sid.json.gz).
+	AlternativesDir string
+
+	// AlternativesFromMaintscripts derives slave alternative links from
+	// the update-alternatives invocations in the postinst maintainer
+	// script of each extracted package, in addition to AlternativesDir.
+	AlternativesFromMaintscripts bool
+
+	// InjectAssets, if non-empty, is a directory containing assets
+	// which overwrite the bundled ones.
+	InjectAssets string
+
+	// IndexCache keeps uncompressed copies of the Contents and
+	// Packages files for which the archive publishes PDiffs in
+	// StateDir and updates them using these PDiffs. As uncompressed
+	// Contents files are large, the cache is disabled by default.
+	// Ignored with LocalMirror.
+	IndexCache bool
+
+	// MemoryBudget is the amount of memory (in bytes) the pipeline may
+	// use. Concurrency levels are lowered to fit. 0 means unlimited,
+	// see also ParseMemoryBudget.
+	MemoryBudget uint64
+
+	// DownloadConcurrency, ManwalkConcurrency and RenderConcurrency
+	// are the concurrency levels for downloading and extracting
+	// packages, walking binary package directories and rendering
+	// manpages, respectively.
+	DownloadConcurrency int
+	ManwalkConcurrency  int
+	RenderConcurrency   int
+
+	// GzipLevel is the compression level for the HTML versions of
+	// manpages.
+	GzipLevel int
+
+	// BaseURL (without trailing slash) is used where absolute URLs are
+	// required, e.g. sitemaps.
+	BaseURL string
+
+	// Metrics, if non-nil, is updated while the pipeline runs.
+	Metrics *Metrics
+
+	Hooks Hooks
+
+	// mirrors fetches files from RemoteMirrors for the duration of one
+	// Run, see newRun.
+	mirrors *mirrorTransport
+}
+
+// DefaultOptions returns the default options of cmd/debiman.
+func DefaultOptions() Options {
+	return Options{
+		ServingDir:          "/srv/man",
+		SyncSuites:          []string{"testing"},
+		Components:          []string{"main", "contrib"},
+		RemoteMirrors:       []string{"http://localhost:3142/deb.debian.org/"},
+		PartialFetchMinSize: 64 << 20,
+		DownloadConcurrency: 10,
+		ManwalkConcurrency:  1000, // below the default 1024 open file descriptor limit
+		RenderConcurrency:   5,
+		GzipLevel:           9,
+		BaseURL:             "https://manpages.debian.org",
+
+		AlternativesFromMaintscripts: true,
+	}
+}
+
+func (o *Options) indexPath() string {
+	if o.IndexPath == "" {
+		return filepath.Join(o.ServingDir, "auxserver.idx")
+	}
+	return o.IndexPath
+}
+
+func (o *Options) stateDir() string {
+	if o.StateDir == "" {
+		return filepath.Clean(o.ServingDir) + ".debiman"
+	}
+	return o.StateDir
+}
+
+// migrateStateDir moves the state directory from its previous default
+// location, .debiman in ServingDir (where it was served publicly), to
+// the current default location, unless StateDir is set.
+func (o *Options) migrateStateDir() error {
+	if o.StateDir != "" {
+		return nil
+	}
+	legacy := filepath.Join(o.ServingDir, ".debiman")
+	if _, err := os.Stat(legacy); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if _, err := os.Stat(o.stateDir()); err == nil {
+		slog.Warn("ignoring state directory in the serving directory, remove it", "path", legacy, "state_dir", o.stateDir())
+		return nil
+	}
+	if err := os.Rename(legacy, o.stateDir()); err != nil {
+		return fmt.Errorf("moving state directory out of the serving directory: %v", err)
+	}
+	slog.Info("moved state directory out of the serving directory", "from", legacy, "to", o.stateDir())
+	return nil
+}
+
+// Run runs all stages, skipping the stages whose inputs did not change
+// since the last complete run (like debiman sync).
+func Run(ctx context.Context, opts Options) (*Stats, error) {
+	r, err := newRun(opts)
+	if err != nil {
+		return nil, err
+	}
+	defer r.close()
+	if err := r.sync(ctx); err != nil {
+		return r.gv.stats, err
+	}
+	return r.gv.stats, nil
+}
+
+// RunStage runs only stage, regardless of whether its inputs changed.
+// All stages but StageDiscover operate on the outputs of the most
+// recent StageDiscover, which are persisted in Options.StateDir.
+func RunStage(ctx context.Context, opts Options, stage Stage) (*Stats, error) {
+	r, err := newRun(opts)
+	if err != nil {
+		return nil, err
+	}
+	defer r.close()
+	if stage == StageDiscover {
+		if err := r.discover(); err != nil {
+			return r.gv.stats, err
+		}
+		return r.gv.stats, nil
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	switch stage {
+	case StageExtract:
+		err = r.extract(ctx)
+	case StageRender:
+		err = r.render(ctx)
+	case StageIndex:
+		err = r.index()
+	case StageAux:
+		err = r.aux()
+	default:
+		return nil, fmt.Errorf("unknown stage %q", stage)
+	}
+	return r.gv.stats, err
+}
+
+// prepareAssets applies the process-wide settings of opts.
+func prepareAssets(opts *Options) error {
+	if err := commontmpl.SetBaseURL(opts.BaseURL); err != nil {
+		return fmt.Errorf("invalid base URL: %v", err)
+	}
+	if opts.InjectAssets == "" {
+		return nil
+	}
+	if err := bundled.Inject(opts.InjectAssets); err != nil {
+		return err
+	}
+
+	commonTmpls = commontmpl.MustParseCommonTmpls()
+	contentsTmpl = mustParseContentsTmpl()
+	pkgindexTmpl = mustParsePkgindexTmpl()
+	srcpkgindexTmpl = mustParseSrcPkgindexTmpl()
+	indexTmpl = mustParseIndexTmpl()
+	faqTmpl = mustParseFaqTmpl()
+	statusTmpl = mustParseStatusTmpl()
+	aboutTmpl = mustParseAboutTmpl()
+	manpageTmpl = mustParseManpageTmpl()
+	manpageerrorTmpl = mustParseManpageerrorTmpl()
+	manpagefooterextraTmpl = mustParseManpagefooterextraTmpl()
+	return nil
+}
+
+// run holds the state shared by the stages of one invocation.
+type run struct {
+	opts    Options
+	start   time.Time
+	ar      *archive.Downloader
+	journal *runJournal
+	gv      globalView
+	inputs  stageFingerprints
+}
+
+func newRun(opts Options) (*run, error) {
+	r := &run{
+		opts:  opts,
+		start: time.Now(),
+	}
+	if r.opts.Metrics == nil {
+		r.opts.Metrics = NewMetrics()
+	}
+	if err := prepareAssets(&r.opts); err != nil {
+		return nil, err
+	}
+	// All of our .so references are relative to ServingDir, hence it
+	// must be an absolute path (see issue #152).
+	abs, err := filepath.Abs(r.opts.ServingDir)
+	if err != nil {
+		return nil, err
+	}
+	r.opts.ServingDir = abs
+	if err := os.MkdirAll(r.opts.ServingDir, 0755); err != nil {
+		return nil, err
+	}
+	if err := r.opts.migrateStateDir(); err != nil {
+		return nil, err
+	}
+
+	applyMemoryBudget(r.opts.MemoryBudget)
+
+	r.opts.mirrors = newMirrorTransport(http.DefaultTransport)
+	r.ar, err = r.opts.newDownloader(planFetch(r.opts.MemoryBudget, r.opts.DownloadConcurrency), r.opts.RemoteMirrors, r.opts.LocalMirror, r.opts.Keyring)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+
+	r.journal, err = loadJournal(filepath.Join(r.opts.stateDir(), "journal.json"), &r.opts)
+	if err != nil {
+		r.close()
+		return nil, fmt.Errorf("loading run journal: %v", err)
+	}
+	r.journal.Started = r.start
+	return r, nil
+}
+
+// close releases the resources of the run, i.e. shuts down its mirror
+// proxies.
+func (r *run) close() {
+	if err := r.opts.mirrors.close(); err != nil {
+		slog.Warn("shutting down mirror proxies failed", "err", err)
+	}
+}
+
+// discover runs stage 1: all Debian packages of all architectures of
+// the specified suites are discovered.
+func (r *run) discover() error {
+	if err := r.journal.begin(StageDiscover, ""); err != nil {
+		return err
+	}
+	var err error
+	r.gv, err = buildGlobalView(r.ar, &r.opts, distributions(r.opts.SyncCodenames, r.opts.SyncSuites), r.start)
+	if err != nil {
+		return fmt.Errorf("gathering packages: %v", err)
+	}
+	r.journal.Discovered = r.gv.discovered()
+	if err := r.journal.complete(StageDiscover, uint64(len(r.gv.pkgs))); err != nil {
+		return err
+	}
+
+	slog.Info("gathered packages of all suites", "stage", StageDiscover, "packages", len(r.gv.pkgs))
+	return r.prepare()
+}
+
+// load restores the outputs of the most recent discover stage.
+func (r *run) load() error {
+	var err error
+	r.gv, err = loadGlobalView(r.ar, &r.opts, distributions(r.opts.SyncCodenames, r.opts.SyncSuites), r.journal.Discovered, r.start)
+	if err != nil {
+		return fmt.Errorf("loading packages: %v", err)
+	}
+	slog.Info("loaded packages of all suites", "stage", StageDiscover, "packages", len(r.gv.pkgs))
+	return r.prepare()
+}
+
+// prepare plans the concurrency of the stages within the memory budget
+// and computes the stage input fingerprints.
+func (r *run) prepare() error {
+	if budget := r.opts.MemoryBudget; budget > 0 {
+		view := estimateGlobalView(r.gv)
+		want := concurrency{
+			Download: r.opts.DownloadConcurrency,
+			Manwalk:  r.opts.ManwalkConcurrency,
+			Render:   r.opts.RenderConcurrency,
+		}
+		plan, err := planConcurrency(budget, view, want)
+		if err != nil {
+			slog.Warn("continuing with minimal concurrency", "stage", StageDiscover, "err", err)
+		}
+		logConcurrency(budget, view, want, plan)
+		r.opts.DownloadConcurrency = plan.Download
+		r.opts.ManwalkConcurrency = plan.Manwalk
+		r.opts.RenderConcurrency = plan.Render
+	}
+
+	var err error
+	r.inputs, err = stageInputs(r.gv)
+	return err
+}
+
+// extract runs stage 2: man pages and auxiliary files (e.g. content
+// fragment files which are included by a number of manpages) are
+// extracted from the identified Debian packages.
+func (r *run) extract(ctx context.Context) error {
+	if err := r.journal.begin(StageExtract, r.inputs.extract); err != nil {
+		return err
+	}
+	r.gv.progress = r.journal.Stages[StageExtract]
+	defer func() { r.gv.progress = nil }()
+	err := parallelDownload(ctx, r.ar, r.gv)
+	// Even when interrupted: the extracted packages are skipped by the
+	// next run, so their alternatives cannot be derived again.
+	if serr := r.gv.saveDerivedAlternatives(); serr != nil {
+		return fmt.Errorf("saving derived alternatives: %v", serr)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return r.interrupted(ctx, StageExtract, r.gv.stats.PackagesExtracted)
+		}
+		return fmt.Errorf("extracting manpages: %v", err)
+	}
+	if err := recordDeletedPackages(r.gv); err != nil {
+		return fmt.Errorf("recording deleted packages: %v", err)
+	}
+	r.journal.FailedPackages = r.gv.quarantine.list()
+	if n := len(r.journal.FailedPackages); n > 0 {
+		slog.Warn("packages could not be extracted, see the run report", "stage", StageExtract, "packages", n)
+	}
+	return r.journal.complete(StageExtract, r.gv.stats.PackagesExtracted)
+}
+
+// render runs stage 3: all man pages are rendered into an HTML
+// representation using mandoc(1), directory index files are rendered,
+// contents files are rendered.
+func (r *run) render(ctx context.Context) error {
+	if err := r.journal.begin(StageRender, r.inputs.render); err != nil {
+		return err
+	}
+	r.gv.progress = r.journal.Stages[StageRender]
+	defer func() { r.gv.progress = nil }()
+	if err := renderAll(ctx, r.gv); err != nil {
+		if ctx.Err() != nil {
+			return r.interrupted(ctx, StageRender, r.gv.stats.ManpagesRendered)
+		}
+		return fmt.Errorf("rendering manpages: %v", err)
+	}
+	return r.journal.complete(StageRender, r.gv.stats.ManpagesRendered)
+}
+
+// index runs stage 4: the debiman-auxserver index is written. This must
+// happen only after all rendering is complete, otherwise
+// debiman-auxserver might serve redirects to pages which cannot be
+// served yet.
+func (r *run) index() error {
+	if err := r.journal.begin(StageIndex, r.inputs.index); err != nil {
+		return err
+	}
+	path := r.opts.indexPath()
+	slog.Info("writing debiman-auxserver index", "stage", StageIndex, "path", path)
+	if err := writeIndex(path, r.gv); err != nil {
+		return fmt.Errorf("writing index: %v", err)
+	}
+	return r.journal.complete(StageIndex, r.gv.stats.IndexBytes)
+}
+
+// aux runs stage 5: the pages which are not specific to a manpage
+// (index, FAQ, about) and the changelog feeds are rendered.
+func (r *run) aux() error {
+	if err := r.journal.begin(StageAux, r.inputs.aux); err != nil {
+		return err
+	}
+	if err := renderAux(r.opts.ServingDir, r.gv); err != nil {
+		return fmt.Errorf("rendering aux files: %v", err)
+	}
+	if err := writeChangelogs(r.opts.ServingDir, r.gv, time.Now()); err != nil {
+		return fmt.Errorf("writing changelogs: %v", err)
+	}
+	return r.journal.complete(StageAux, uint64(len(r.gv.suites)))
+}
+
+// interrupted records that stage was interrupted after units work
+// units and returns an *InterruptedError.
+func (r *run) interrupted(ctx context.Context, stage Stage, units uint64) error {
+	if err := r.journal.interrupt(stage, units); err != nil {
+		return fmt.Errorf("writing run journal: %v", err)
+	}
+	return &InterruptedError{Stage: stage, Units: units, Err: ctx.Err()}
+}
+
+// checkInterrupted returns an *InterruptedError if ctx was canceled
+// before next was started.
+func (r *run) checkInterrupted(ctx context.Context, next Stage) error {
+	if ctx.Err() == nil {
+		return nil
+	}
+	return r.interrupted(ctx, next, 0)
+}
+
+// skip records that stage was skipped because its inputs did not
+// change.
+func (r *run) skip(stage Stage) {
+	slog.Info("skipping stage, inputs unchanged", "stage", stage, "completed", r.journal.Stages[stage].Completed)
+	if hook := r.opts.Hooks.StageSkipped; hook != nil {
+		hook(stage)
+	}
+}
+
+// sync runs all stages, skipping the stages whose inputs did not change
+// since the last complete run.
+func (r *run) sync(ctx context.Context) error {
+	if err := r.discover(); err != nil {
+		return err
+	}
+
+	// Stages which do not process individual packages or manpages run
+	// to completion once started, so cancellation is checked in between
+	// stages.
+	if err := r.checkInterrupted(ctx, StageExtract); err != nil {
+		return err
+	}
+
+	// Deleting a binary package directory (to force re-extraction)
+	// modifies the suite directory.
+	suiteDirsUnchanged := r.journal.suiteDirsUnchanged(r.opts.ServingDir, r.gv)
+
+	extracted := true
+	// Quarantined packages are retried by the next run.
+	if !r.gv.anyForced(r.gv.forceReextract) && suiteDirsUnchanged && len(r.journal.FailedPackages) == 0 &&
+		r.journal.unchanged(StageExtract, r.inputs.extract) {
+		r.skip(StageExtract)
+		extracted = false
+	} else {
+		if err := r.extract(ctx); err != nil {
+			return err
+		}
+		slog.Info("extracted all manpages, now rendering", "stage", StageExtract)
+	}
+
+	rendered := true
+	if !r.gv.anyForced(r.gv.forceRerender) && (!extracted || r.gv.stats.PackagesExtracted == 0) &&
+		suiteDirsUnchanged && r.journal.unchanged(StageRender, r.inputs.render) {
+		r.skip(StageRender)
+		rendered = false
+	} else {
+		if err := r.render(ctx); err != nil {
+			return err
+		}
+		slog.Info("rendered all manpages, writing index", "stage", StageRender)
+	}
+
+	if err := r.checkInterrupted(ctx, StageIndex); err != nil {
+		return err
+	}
+
+	_, statErr := os.Stat(r.opts.indexPath())
+	if !rendered && statErr == nil && r.journal.unchanged(StageIndex, r.inputs.index) {
+		r.skip(StageIndex)
+	} else if err := r.index(); err != nil {
+		return err
+	}
+
+	if err := r.checkInterrupted(ctx, StageAux); err != nil {
+		return err
+	}
+
+	if !rendered && r.journal.unchanged(StageAux, r.inputs.aux) {
+		r.skip(StageAux)
+	} else if err := r.aux(); err != nil {
+		return err
+	}
+
+	if err := r.journal.finish(r.opts.ServingDir, r.gv); err != nil {
+		return fmt.Errorf("writing run journal: %v", err)
+	}
+
+	if err := writeRunReport(r.opts.ServingDir, newRunReport(r.gv, r.journal, r.gv.diagnostics)); err != nil {
+		return fmt.Errorf("writing run report: %v", err)
+	}
+
+	return writeMetricsFile(filepath.Join(r.opts.ServingDir, "metrics.txt"), r.gv, r.start)
+}
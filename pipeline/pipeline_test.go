@@ -0,0 +1,258 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testOptions returns Options which synchronize the testdata mirror into
+// servingDir.
+func testOptions(t *testing.T, servingDir string) Options {
+	mirror, err := filepath.Abs("../testdata/tinymirror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := DefaultOptions()
+	opts.ServingDir = servingDir
+	opts.LocalMirror = mirror
+	t.Cleanup(func() { os.RemoveAll(opts.stateDir()) })
+	return opts
+}
+
+func TestHooks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		mu        sync.Mutex
+		events    []string
+		extracted int
+		rendered  int
+	)
+	opts := testOptions(t, dir)
+	opts.Hooks = Hooks{
+		StageStarted: func(stage Stage) {
+			events = append(events, "started "+string(stage))
+		},
+		StageCompleted: func(stage Stage, units uint64, duration time.Duration) {
+			events = append(events, "completed "+string(stage))
+		},
+		StageSkipped: func(stage Stage) {
+			events = append(events, "skipped "+string(stage))
+		},
+		PackageExtracted: func(suite, binarypkg string, manpages int) {
+			mu.Lock()
+			defer mu.Unlock()
+			extracted++
+		},
+		ManpageRendered: func(path string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			rendered++
+		},
+	}
+	stats, err := Run(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for _, stage := range Stages {
+		want = append(want, "started "+string(stage), "completed "+string(stage))
+	}
+	if got := strings.Join(events, ", "); got != strings.Join(want, ", ") {
+		t.Errorf("unexpected hook calls: got %q, want %q", got, strings.Join(want, ", "))
+	}
+	if got, want := uint64(extracted), stats.PackagesExtracted; got != want {
+		t.Errorf("PackageExtracted called %d times, want %d", got, want)
+	}
+	if got, want := uint64(rendered), stats.ManpagesRendered; got != want {
+		t.Errorf("ManpageRendered called %d times, want %d", got, want)
+	}
+
+	events = nil
+	if _, err := Run(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"started discover", "completed discover"}
+	for _, stage := range Stages[1:] {
+		want = append(want, "skipped "+string(stage))
+	}
+	if got := strings.Join(events, ", "); got != strings.Join(want, ", ") {
+		t.Errorf("unexpected hook calls: got %q, want %q", got, strings.Join(want, ", "))
+	}
+}
+
+func TestStagesWithoutArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	opts := testOptions(t, dir)
+	ctx := context.Background()
+
+	// Without a preceding discover stage, there is nothing to render.
+	if _, err := RunStage(ctx, opts, StageRender); err == nil || !strings.Contains(err.Error(), "debiman discover") {
+		t.Fatalf("render before discover: got %v, want an error mentioning debiman discover", err)
+	}
+
+	for _, stage := range []Stage{StageDiscover, StageExtract} {
+		if _, err := RunStage(ctx, opts, stage); err != nil {
+			t.Fatalf("%s: %v", stage, err)
+		}
+	}
+
+	// The remaining stages must work without access to the archive.
+	opts.LocalMirror = filepath.Join(dir, "nonexistent")
+	for _, stage := range []Stage{StageRender, StageIndex, StageAux} {
+		if _, err := RunStage(ctx, opts, stage); err != nil {
+			t.Fatalf("%s: %v", stage, err)
+		}
+	}
+
+	for _, path := range []string{opts.indexPath(), filepath.Join(dir, "index.html.gz")} {
+		if _, err := os.Stat(path); err != nil {
+			t.Error(err)
+		}
+	}
+
+	journal, err := loadJournal(filepath.Join(opts.stateDir(), "journal.json"), &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stage := range Stages {
+		if c, ok := journal.Stages[stage]; !ok || c.Completed.IsZero() {
+			t.Errorf("stage %q not recorded as completed in the journal", stage)
+		}
+	}
+}
+
+func TestInterrupt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := testOptions(t, dir)
+	opts.DownloadConcurrency = 1
+	opts.Hooks.PackageExtracted = func(suite, binarypkg string, manpages int) {
+		cancel()
+	}
+	_, err = Run(ctx, opts)
+	var interrupted *InterruptedError
+	if !errors.As(err, &interrupted) {
+		t.Fatalf("Run: got %v, want an *InterruptedError", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want an error wrapping context.Canceled", err)
+	}
+	if got, want := interrupted.Stage, StageExtract; got != want {
+		t.Errorf("interrupted stage: got %q, want %q", got, want)
+	}
+	if interrupted.Units == 0 {
+		t.Errorf("interrupted after 0 units, want the package in progress to be completed")
+	}
+
+	// Work in progress was completed, hence no temporary files remain.
+	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), "debiman-") {
+			t.Errorf("temporary file %q left behind", path)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	journalPath := filepath.Join(opts.stateDir(), "journal.json")
+	journal, err := loadJournal(journalPath, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := journal.Stages[StageExtract]
+	if !ok || !c.Completed.IsZero() {
+		t.Fatalf("extract stage not recorded as interrupted: %+v", c)
+	}
+	if got, want := c.Units, interrupted.Units; got != want {
+		t.Errorf("journal units: got %d, want %d", got, want)
+	}
+	if got, want := uint64(len(c.Done)), interrupted.Units; got != want {
+		t.Errorf("journal completed packages: got %d (%v), want %d", got, c.Done, want)
+	}
+	if _, ok := journal.Stages[StageRender]; ok {
+		t.Errorf("render stage unexpectedly started")
+	}
+
+	// The next run resumes and completes all stages, without extracting
+	// the packages completed by the interrupted run again.
+	opts.Hooks = Hooks{
+		PackageExtracted: func(suite, binarypkg string, manpages int) {
+			if _, ok := c.Done[suite+"/"+binarypkg]; ok {
+				t.Errorf("package %s/%s extracted again", suite, binarypkg)
+			}
+		},
+	}
+	if _, err := Run(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	journal, err = loadJournal(journalPath, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stage := range Stages {
+		c, ok := journal.Stages[stage]
+		if !ok || c.Completed.IsZero() {
+			t.Errorf("stage %q not recorded as completed in the journal", stage)
+			continue
+		}
+		if len(c.Done) > 0 {
+			t.Errorf("stage %q: completed packages not cleared: %v", stage, c.Done)
+		}
+	}
+}
+
+func TestMigrateStateDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A state directory in the serving directory, where previous
+	// versions kept it by default.
+	opts := testOptions(t, filepath.Join(dir, "www"))
+	legacy := filepath.Join(opts.ServingDir, ".debiman")
+	if err := os.MkdirAll(legacy, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(legacy, "journal.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Run(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Errorf("state directory still in the serving directory: %v", err)
+	}
+	if got, want := opts.stateDir(), filepath.Join(dir, "www.debiman"); got != want {
+		t.Errorf("state directory: got %q, want %q", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(opts.stateDir(), "journal.json")); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,9 +1,12 @@
-package main
+package pipeline
 
 import (
+	"fmt"
 	"html/template"
 	"io"
 	"time"
+
+	"github.com/Debian/debiman/internal/write"
 )
 
 const metricsTmplContent = `
@@ -15,6 +18,10 @@ packages_total {{ .Packages }}
 # TYPE packages_extracted gauge
 packages_extracted {{ .Stats.PackagesExtracted }}
 
+# HELP packages_failed Number of Debian binary packages which could not be extracted and were quarantined.
+# TYPE packages_failed gauge
+packages_failed {{ .Stats.PackagesFailed }}
+
 # HELP packages_deleted Number of Debian binary packages deleted because they were no longer present.
 # TYPE packages_deleted gauge
 packages_deleted {{ .Stats.PackagesDeleted }}
@@ -47,7 +54,7 @@ func writeMetrics(w io.Writer, gv globalView, start time.Time) error {
 	now := time.Now()
 	return metricsTmpl.Execute(w, struct {
 		Packages          int
-		Stats             *stats
+		Stats             *Stats
 		Now               time.Time
 		Seconds           int
 		LastSuccessfulRun int64
@@ -59,3 +66,13 @@ func writeMetrics(w io.Writer, gv globalView, start time.Time) error {
 		LastSuccessfulRun: now.Unix(),
 	})
 }
+
+// writeMetricsFile writes the metrics of the run to path.
+func writeMetricsFile(path string, gv globalView, start time.Time) error {
+	return write.Atomically(path, false, func(w io.Writer) error {
+		if err := writeMetrics(w, gv, start); err != nil {
+			return fmt.Errorf("writing metrics: %v", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,143 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"pault.ag/go/archive"
+)
+
+// extractAttempts is the number of times extracting a package is
+// attempted before the package is quarantined.
+const extractAttempts = 3
+
+// extractRetryDelay is the delay before the first retry. It doubles
+// with every further retry.
+var extractRetryDelay = 5 * time.Second
+
+// packageFailure is a package which could not be extracted.
+type packageFailure struct {
+	Suite     string `json:"suite"`
+	Binarypkg string `json:"binarypkg"`
+	Version   string `json:"version"`
+	Attempts  int    `json:"attempts"`
+	Error     string `json:"error"`
+}
+
+// quarantine collects the packages which could not be extracted. Their
+// previously extracted manpages (if any) are left untouched and go
+// stale, whereas all other packages are extracted as usual.
+type quarantine struct {
+	mu       sync.Mutex
+	failures []packageFailure
+}
+
+func (q *quarantine) add(f packageFailure) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.failures = append(q.failures, f)
+}
+
+// list returns the failures, sorted by suite and binary package.
+func (q *quarantine) list() []packageFailure {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	res := append([]packageFailure{}, q.failures...)
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Suite != res[j].Suite {
+			return res[i].Suite < res[j].Suite
+		}
+		return res[i].Binarypkg < res[j].Binarypkg
+	})
+	return res
+}
+
+// isFatal returns true if err affects all packages (e.g. the file
+// system is full), i.e. if the extract stage must be aborted instead of
+// quarantining the package.
+func isFatal(err error) bool {
+	for _, errno := range []syscall.Errno{syscall.ENOSPC, syscall.EDQUOT, syscall.EROFS} {
+		if errors.Is(err, errno) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryExtract calls extract until it succeeds, it fails with a fatal
+// error (see isFatal) or extractAttempts attempts failed, waiting
+// extractRetryDelay (doubling with every retry) in between. It returns
+// whether ctx was done while waiting for a retry, and the error of the
+// last attempt.
+func retryExtract(ctx context.Context, extract func(attempt int) error) (interrupted bool, err error) {
+	delay := extractRetryDelay
+	for attempt := 1; attempt <= extractAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return true, err
+			}
+			delay *= 2
+		}
+		err = extract(attempt)
+		if err == nil || isFatal(err) {
+			return false, err
+		}
+	}
+	return false, err
+}
+
+// extractPkg extracts p using downloadPkg, retrying failed attempts.
+// Packages which cannot be extracted are quarantined and nil is
+// returned, unless the error is fatal (see isFatal).
+func extractPkg(ctx context.Context, ar *archive.Downloader, p pkgEntry, gv globalView) error {
+	interrupted, err := retryExtract(ctx, func(attempt int) error {
+		err := downloadPkg(ar, p, gv)
+		if err != nil && !isFatal(err) {
+			slog.Warn("extracting package failed",
+				"stage", StageExtract,
+				"suite", p.suite,
+				"binarypkg", p.binarypkg,
+				"attempt", attempt,
+				"err", err)
+		}
+		return err
+	})
+	if interrupted {
+		return nil // see parallelDownload
+	}
+	if err == nil {
+		gv.progress.record(p.suite+"/"+p.binarypkg, unitCheckpoint{Completed: time.Now()})
+		return nil
+	}
+	if isFatal(err) {
+		return err
+	}
+
+	slog.Error("quarantining package: extraction failed repeatedly, its manpages will not be updated",
+		"stage", StageExtract,
+		"suite", p.suite,
+		"binarypkg", p.binarypkg,
+		"version", p.version.String(),
+		"err", err)
+	gv.quarantine.add(packageFailure{
+		Suite:     p.suite,
+		Binarypkg: p.binarypkg,
+		Version:   p.version.String(),
+		Attempts:  extractAttempts,
+		Error:     err.Error(),
+	})
+	atomic.AddUint64(&gv.stats.PackagesFailed, 1)
+	gv.opts.Metrics.packagesDone.With("failed").Inc()
+	if hook := gv.opts.Hooks.PackageFailed; hook != nil {
+		hook(p.suite, p.binarypkg, err)
+	}
+	return nil
+}
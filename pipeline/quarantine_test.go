@@ -0,0 +1,157 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestIsFatal(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("unexpected EOF"), false},
+		{fmt.Errorf("loading %q: %w", "pool/main/i/i3-wm.deb", errors.New("bad magic")), false},
+		{&os.PathError{Op: "write", Path: "/srv/man/x", Err: syscall.ENOSPC}, true},
+		{fmt.Errorf("Writing version file %q: %w", "VERSION", &os.PathError{Op: "open", Path: "VERSION", Err: syscall.EROFS}), true},
+	} {
+		if got := isFatal(tt.err); got != tt.want {
+			t.Errorf("isFatal(%v): got %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRetryExtract(t *testing.T) {
+	defer func(old time.Duration) { extractRetryDelay = old }(extractRetryDelay)
+
+	transient := errors.New("unexpected EOF")
+	fatal := &os.PathError{Op: "write", Path: "/srv/man/x", Err: syscall.ENOSPC}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, tt := range []struct {
+		name            string
+		ctx             context.Context
+		delay           time.Duration
+		errs            []error // returned by the attempts, in order
+		wantAttempts    int
+		wantInterrupted bool
+		wantErr         error
+	}{
+		{
+			name:         "Success",
+			errs:         []error{nil},
+			wantAttempts: 1,
+		},
+
+		{
+			name:         "SuccessAfterRetry",
+			errs:         []error{transient, nil},
+			wantAttempts: 2,
+		},
+
+		{
+			name:         "Quarantined",
+			errs:         []error{transient, transient, transient},
+			wantAttempts: extractAttempts,
+			wantErr:      transient,
+		},
+
+		{
+			name:         "Fatal",
+			errs:         []error{transient, fatal},
+			wantAttempts: 2,
+			wantErr:      fatal,
+		},
+
+		{
+			name:            "Interrupted",
+			ctx:             canceled,
+			delay:           time.Hour,
+			errs:            []error{transient},
+			wantAttempts:    1,
+			wantInterrupted: true,
+			wantErr:         transient,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			extractRetryDelay = tt.delay
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			var attempts int
+			interrupted, err := retryExtract(ctx, func(attempt int) error {
+				attempts++
+				if attempt != attempts {
+					t.Errorf("attempt %d passed as %d", attempts, attempt)
+				}
+				if attempts > len(tt.errs) {
+					t.Fatalf("unexpected attempt %d", attempts)
+				}
+				return tt.errs[attempts-1]
+			})
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts: got %d, want %d", attempts, tt.wantAttempts)
+			}
+			if interrupted != tt.wantInterrupted {
+				t.Errorf("interrupted: got %v, want %v", interrupted, tt.wantInterrupted)
+			}
+			if err != tt.wantErr {
+				t.Errorf("err: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	defer func(old time.Duration) { extractRetryDelay = old }(extractRetryDelay)
+	extractRetryDelay = 0
+
+	dir, err := ioutil.TempDir("", "debiman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var failed []string
+	opts := testOptions(t, dir)
+	opts.Hooks.PackageFailed = func(suite, binarypkg string, err error) {
+		failed = append(failed, suite+"/"+binarypkg)
+	}
+	r, err := newRun(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.close()
+	if err := r.discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A package whose .deb does not match the Packages file’s hash.
+	broken := *r.gv.pkgs[0]
+	broken.binarypkg = "broken"
+	broken.sha256 = make([]byte, len(broken.sha256))
+	r.gv.pkgs = append(r.gv.pkgs, &broken)
+
+	if err := r.extract(context.Background()); err != nil {
+		t.Fatalf("extract: %v, want the broken package to be quarantined", err)
+	}
+	if got, want := r.gv.stats.PackagesExtracted, uint64(len(r.gv.pkgs)-1); got != want {
+		t.Errorf("packages extracted: got %d, want %d", got, want)
+	}
+	if got, want := r.gv.stats.PackagesFailed, uint64(1); got != want {
+		t.Errorf("packages failed: got %d, want %d", got, want)
+	}
+	if got, want := fmt.Sprint(failed), fmt.Sprintf("[%s/broken]", broken.suite); got != want {
+		t.Errorf("PackageFailed calls: got %s, want %s", got, want)
+	}
+	if got := r.journal.FailedPackages; len(got) != 1 || got[0].Binarypkg != "broken" || got[0].Attempts != extractAttempts {
+		t.Errorf("journal failed packages: got %+v, want broken after %d attempts", got, extractAttempts)
+	}
+}
@@ -1,14 +1,14 @@
-package main
+package pipeline
 
 import (
 	"compress/gzip"
 	"encoding/json"
-	"flag"
 	"fmt"
 	"html/template"
 	"io"
 	"io/ioutil"
 	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"sort"
@@ -27,24 +27,6 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-var (
-	manwalkConcurrency = flag.Int("concurrency_manwalk",
-		1000, // below the default 1024 open file descriptor limit
-		"Concurrency level for walking through binary package man directories (ulimit -n must be higher!)")
-
-	renderConcurrency = flag.Int("concurrency_render",
-		5,
-		"Concurrency level for rendering manpages using mandoc")
-
-	gzipLevel = flag.Int("gzip",
-		9,
-		"gzip compression level to use for compressing HTML versions of manpages. defaults to 9 to keep network traffic minimal, but useful to reduce for development/disaster recovery (level 1 results in a 2x speedup!)")
-
-	baseURL = flag.String("base_url",
-		"https://manpages.debian.org",
-		"Base URL (without trailing slash) to the site. Used where absolute URLs are required, e.g. sitemaps.")
-)
-
 type breadcrumb struct {
 	Link string
 	Text string
@@ -102,7 +84,7 @@ const (
 
 // listManpages lists all files in dir (non-recursively) and returns a map from
 // filename (within dir) to *manpage.Meta.
-func listManpages(dir string) (map[string]*manpage.Meta, error) {
+func listManpages(servingDir, dir string) (map[string]*manpage.Meta, error) {
 	manpageByName := make(map[string]*manpage.Meta)
 
 	files, err := os.Open(dir)
@@ -144,11 +126,11 @@ func listManpages(dir string) (map[string]*manpage.Meta, error) {
 			}
 			full := filepath.Join(dir, fn)
 
-			m, err := manpage.FromServingPath(*servingDir, full)
+			m, err := manpage.FromServingPath(servingDir, full)
 			if err != nil {
 				// If we run into this case, our code cannot correctly
 				// interpret the result of ServingPath().
-				log.Printf("BUG: cannot parse manpage from serving path %q: %v", full, err)
+				slog.Error("BUG: cannot parse manpage from serving path", "stage", StageRender, "manpage", full, "err", err)
 				continue
 			}
 
@@ -158,19 +140,19 @@ func listManpages(dir string) (map[string]*manpage.Meta, error) {
 	return manpageByName, nil
 }
 
-func renderDirectoryIndex(dir string, newestModTime time.Time) error {
+func renderDirectoryIndex(servingDir, dir string, newestModTime time.Time, force bool) error {
 	st, err := os.Stat(filepath.Join(dir, "index.html.gz"))
-	if !*forceRerender && err == nil && st.ModTime().After(newestModTime) {
+	if !force && err == nil && st.ModTime().After(newestModTime) {
 		return nil
 	}
 
-	manpageByName, err := listManpages(dir)
+	manpageByName, err := listManpages(servingDir, dir)
 	if err != nil {
 		return err
 	}
 
 	if len(manpageByName) == 0 {
-		log.Printf("WARNING: empty directory %q, not generating package index", dir)
+		slog.Warn("empty directory, not generating package index", "stage", StageRender, "dir", dir)
 		return nil
 	}
 
@@ -184,6 +166,8 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 	// the invariant is: each file ending in .gz must have a corresponding .html.gz file
 	// the .html.gz must have a modtime that is >= the modtime of the .gz file
 
+	force := gv.forceRerender(filepath.Base(filepath.Dir(dir)))
+
 	files, err := os.Open(dir)
 	if err != nil {
 		return newestModTime, err
@@ -247,12 +231,12 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 			if err == nil {
 				atomic.AddUint64(&gv.stats.HTMLBytes, uint64(htmlst.Size()))
 			}
-			if err != nil || *forceRerender || htmlst.ModTime().Before(st.ModTime()) {
-				m, err := manpage.FromServingPath(*servingDir, full)
+			if err != nil || force || htmlst.ModTime().Before(st.ModTime()) {
+				m, err := manpage.FromServingPath(gv.opts.ServingDir, full)
 				if err != nil {
 					// If we run into this case, our code cannot correctly
 					// interpret the result of ServingPath().
-					log.Printf("BUG: cannot parse manpage from serving path %q: %v", full, err)
+					slog.Error("BUG: cannot parse manpage from serving path", "stage", StageRender, "manpage", full, "err", err)
 					continue
 				}
 
@@ -270,12 +254,12 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 				// Render dependent manpages first to properly resume
 				// in case debiman is interrupted.
 				for _, v := range versions {
-					if v == m || *forceRerender {
+					if v == m || force {
 						continue
 					}
 
-					vfull := filepath.Join(*servingDir, v.RawPath())
-					vfn := filepath.Join(*servingDir, v.ServingPath()+".html.gz")
+					vfull := filepath.Join(gv.opts.ServingDir, v.RawPath())
+					vfn := filepath.Join(gv.opts.ServingDir, v.ServingPath()+".html.gz")
 					vhtmlst, err := os.Stat(vfn)
 					if err == nil && vhtmlst.ModTime().After(gv.start) {
 						// The variant was already re-rendered with this globalView.
@@ -284,7 +268,7 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 
 					vst, err := os.Stat(vfull)
 					if err != nil {
-						log.Printf("WARNING: stat %q: %v", vfull, err)
+						slog.Warn("cannot stat manpage variant", "stage", StageRender, "manpage", vfull, "err", err)
 						continue
 					}
 
@@ -293,7 +277,7 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 						vreuse = vfn
 					}
 
-					log.Printf("%s invalidated by %s", vfn, full)
+					slog.Debug("manpage variant invalidated", "stage", StageRender, "manpage", vfn, "by", full)
 
 					select {
 					case renderChan <- renderJob{
@@ -306,7 +290,7 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 						reuse:    vreuse,
 					}:
 					case <-ctx.Done():
-						break
+						return newestModTime, ctx.Err()
 					}
 				}
 
@@ -330,7 +314,7 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 					reuse:    reuse,
 				}:
 				case <-ctx.Done():
-					break
+					return newestModTime, ctx.Err()
 				}
 			}
 		}
@@ -342,7 +326,7 @@ func walkManContents(ctx context.Context, renderChan chan<- renderJob, dir strin
 func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist map[string]bool, gv globalView) error {
 	sitemaps := make(map[string]time.Time)
 
-	suitedirs, err := ioutil.ReadDir(*servingDir)
+	suitedirs, err := ioutil.ReadDir(gv.opts.ServingDir)
 	if err != nil {
 		return err
 	}
@@ -353,7 +337,7 @@ func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist ma
 		if !gv.suites[sfi.Name()] {
 			continue
 		}
-		bins, err := os.Open(filepath.Join(*servingDir, sfi.Name()))
+		bins, err := os.Open(filepath.Join(gv.opts.ServingDir, sfi.Name()))
 		if err != nil {
 			return err
 		}
@@ -366,7 +350,10 @@ func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist ma
 		var sitemapEntriesMu sync.RWMutex
 
 		for {
-			names, err := bins.Readdirnames(*manwalkConcurrency)
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			names, err := bins.Readdirnames(gv.opts.ManwalkConcurrency)
 			if err != nil {
 				if err == io.EOF {
 					break
@@ -388,8 +375,22 @@ func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist ma
 				}
 
 				bfn := bfn // copy
-				dir := filepath.Join(*servingDir, sfi.Name(), bfn)
+				dir := filepath.Join(gv.opts.ServingDir, sfi.Name(), bfn)
+				key := sfi.Name() + "/" + bfn
 				wg.Go(func() error {
+					if u, ok := gv.progress.done(key); ok {
+						// Completed by the interrupted previous run,
+						// unless the package was extracted since.
+						if st, err := os.Stat(dir); err == nil && !st.ModTime().After(u.Completed) {
+							if !u.Newest.IsZero() {
+								sitemapEntriesMu.Lock()
+								defer sitemapEntriesMu.Unlock()
+								sitemapEntries[bfn] = u.Newest
+							}
+							return nil
+						}
+					}
+
 					// Iterating through the same directory in all
 					// modes increases the chance for the dirents to
 					// still be cached. This is important for machines
@@ -413,9 +414,13 @@ func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist ma
 
 					// and finally render the package index files which need to
 					// consider both regular files and symlinks.
-					if err := renderDirectoryIndex(dir, newestModTime); err != nil {
+					if err := renderDirectoryIndex(gv.opts.ServingDir, dir, newestModTime, gv.forceRerender(sfi.Name())); err != nil {
 						return err
 					}
+					gv.progress.record(key, unitCheckpoint{
+						Completed: time.Now(),
+						Newest:    newestModTime,
+					})
 
 					if !newestModTime.IsZero() {
 						sitemapEntriesMu.Lock()
@@ -432,9 +437,9 @@ func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist ma
 		}
 		bins.Close()
 
-		sitemapPath := filepath.Join(*servingDir, sfi.Name(), "sitemap.xml.gz")
+		sitemapPath := filepath.Join(gv.opts.ServingDir, sfi.Name(), "sitemap.xml.gz")
 		if err := write.Atomically(sitemapPath, true, func(w io.Writer) error {
-			return sitemap.WriteTo(w, *baseURL+"/"+sfi.Name(), sitemapEntries)
+			return sitemap.WriteTo(w, gv.opts.BaseURL+"/"+sfi.Name(), sitemapEntries)
 		}); err != nil {
 			return err
 		}
@@ -443,8 +448,8 @@ func walkContents(ctx context.Context, renderChan chan<- renderJob, whitelist ma
 			sitemaps[sfi.Name()] = st.ModTime()
 		}
 	}
-	return write.Atomically(filepath.Join(*servingDir, "sitemapindex.xml.gz"), true, func(w io.Writer) error {
-		return sitemap.WriteIndexTo(w, *baseURL, sitemaps)
+	return write.Atomically(filepath.Join(gv.opts.ServingDir, "sitemapindex.xml.gz"), true, func(w io.Writer) error {
+		return sitemap.WriteIndexTo(w, gv.opts.BaseURL, sitemaps)
 	})
 }
 
@@ -461,17 +466,17 @@ func writeSourceIndex(gv globalView, newestForSource map[string]time.Time) error
 		}
 
 		for src, binaries := range binariesBySource {
-			srcDir := filepath.Join(*servingDir, suite, "src:"+src)
+			srcDir := filepath.Join(gv.opts.ServingDir, suite, "src:"+src)
 			// skip if current index file is more recent than newestForSource
 			st, err := os.Stat(filepath.Join(srcDir, "index.html.gz"))
-			if !*forceRerender && err == nil && st.ModTime().After(newestForSource[src]) {
+			if !gv.forceRerender(suite) && err == nil && st.ModTime().After(newestForSource[src]) {
 				continue
 			}
 
 			// Aggregate manpages of all binary packages for this source package
 			manpages := make(map[string]*manpage.Meta)
 			for _, binary := range binaries {
-				m, err := listManpages(filepath.Join(*servingDir, suite, binary))
+				m, err := listManpages(gv.opts.ServingDir, filepath.Join(gv.opts.ServingDir, suite, binary))
 				if err != nil {
 					if os.IsNotExist(err) {
 						continue // The package might not contain any manpages.
@@ -511,7 +516,7 @@ func writeSourcesWithManpages(gv globalView) error {
 			sourcesWithManpages = append(sourcesWithManpages, source)
 		}
 		sort.Strings(sourcesWithManpages)
-		dest := filepath.Join(*servingDir, suite, "sourcesWithManpages.txt.gz")
+		dest := filepath.Join(gv.opts.ServingDir, suite, "sourcesWithManpages.txt.gz")
 		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
 			return err
 		}
@@ -529,21 +534,22 @@ func writeSourcesWithManpages(gv globalView) error {
 	return nil
 }
 
-func renderAll(gv globalView) error {
-	log.Printf("Preparing inverted maps")
+func renderAll(ctx context.Context, gv globalView) error {
+	slog.Debug("preparing inverted maps", "stage", StageRender)
 	sourceByBinary := make(map[string]string, len(gv.pkgs))
 	newestForSource := make(map[string]time.Time)
 	for _, p := range gv.pkgs {
 		sourceByBinary[p.suite+"/"+p.binarypkg] = p.source
 		newestForSource[p.source] = time.Time{}
 	}
-	log.Printf("%d sourceByBinary entries, %d newestForSource entries", len(sourceByBinary), len(newestForSource))
+	slog.Debug("prepared inverted maps", "stage", StageRender, "source_by_binary", len(sourceByBinary), "newest_for_source", len(newestForSource))
 
-	eg, ctx := errgroup.WithContext(context.Background())
+	eg, egctx := errgroup.WithContext(ctx)
 	renderChan := make(chan renderJob)
-	for i := 0; i < *renderConcurrency; i++ {
+	for i := 0; i < gv.opts.RenderConcurrency; i++ {
 		eg.Go(func() error {
-			converter, err := convert.NewProcess()
+			// All of our .so references are relative to ServingDir.
+			converter, err := convert.NewProcessIn(gv.opts.ServingDir)
 			if err != nil {
 				return err
 			}
@@ -552,13 +558,14 @@ func renderAll(gv globalView) error {
 			// NOTE(stapelberg): gzip’s decompression phase takes the same
 			// time, regardless of compression level. Hence, we invest the
 			// maximum CPU time once to achieve the best compression.
-			gzipw, err := gzip.NewWriterLevel(nil, *gzipLevel)
+			gzipw, err := gzip.NewWriterLevel(nil, gv.opts.GzipLevel)
 			if err != nil {
 				return err
 			}
 
 			for r := range renderChan {
-				n, err := rendermanpage(gzipw, converter, r)
+				start := time.Now()
+				n, renderErr, err := rendermanpage(gzipw, converter, r)
 				if err != nil {
 					// rendermanpage writes an error page if rendering
 					// failed, any returned error is severe (e.g. file
@@ -568,30 +575,45 @@ func renderAll(gv globalView) error {
 
 				atomic.AddUint64(&gv.stats.HTMLBytes, n)
 				atomic.AddUint64(&gv.stats.ManpagesRendered, 1)
+				if renderErr != nil {
+					gv.opts.Metrics.pagesFailed.Inc()
+					gv.diagnostics.failed(r.dest, renderErr)
+				}
+				gv.diagnostics.rendered(r.dest, time.Since(start))
+				gv.opts.Metrics.renderLatency.Observe(time.Since(start).Seconds())
+				gv.opts.Metrics.pagesRendered.Inc()
+				if hook := gv.opts.Hooks.ManpageRendered; hook != nil {
+					hook(r.dest, renderErr)
+				}
 			}
 			return nil
 		})
 	}
 
 	var whitelist map[string]bool
-	if *onlyRender != "" {
+	if len(gv.opts.OnlyRenderPkgs) > 0 {
 		whitelist = make(map[string]bool)
-		log.Printf("Restricting rendering to the following binary packages:")
-		for _, e := range strings.Split(strings.TrimSpace(*onlyRender), ",") {
+		for _, e := range gv.opts.OnlyRenderPkgs {
 			whitelist[e] = true
-			log.Printf("  %q", e)
 		}
-		log.Printf("(total: %d whitelist entries)", len(whitelist))
-	}
-
-	if err := walkContents(ctx, renderChan, whitelist, gv); err != nil {
-		return err
+		slog.Info("restricting rendering to binary packages", "stage", StageRender, "binarypkgs", gv.opts.OnlyRenderPkgs, "total", len(whitelist))
 	}
 
+	// When ctx is canceled, walkContents stops sending jobs, but the
+	// manpages which are being rendered are completed. Directory
+	// indexes and sitemaps of partially walked directories are not
+	// written.
+	walkErr := walkContents(egctx, renderChan, whitelist, gv)
 	close(renderChan)
 	if err := eg.Wait(); err != nil {
 		return err
 	}
+	if walkErr != nil {
+		return walkErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
 	if err := writeSourceIndex(gv, newestForSource); err != nil {
 		return fmt.Errorf("writing source index: %v", err)
@@ -601,7 +623,7 @@ func renderAll(gv globalView) error {
 		return fmt.Errorf("writing sourcesWithManpages: %v", err)
 	}
 
-	suitedirs, err := ioutil.ReadDir(*servingDir)
+	suitedirs, err := ioutil.ReadDir(gv.opts.ServingDir)
 	if err != nil {
 		return err
 	}
@@ -612,7 +634,7 @@ func renderAll(gv globalView) error {
 		if !gv.suites[sfi.Name()] {
 			continue
 		}
-		bins, err := os.Open(filepath.Join(*servingDir, sfi.Name()))
+		bins, err := os.Open(filepath.Join(gv.opts.ServingDir, sfi.Name()))
 		if err != nil {
 			return err
 		}
@@ -623,7 +645,7 @@ func renderAll(gv globalView) error {
 			return err
 		}
 
-		if err := renderContents(filepath.Join(*servingDir, fmt.Sprintf("contents-%s.html.gz", sfi.Name())), sfi.Name(), names); err != nil {
+		if err := renderContents(gv.opts.ServingDir, filepath.Join(gv.opts.ServingDir, fmt.Sprintf("contents-%s.html.gz", sfi.Name())), sfi.Name(), names); err != nil {
 			return err
 		}
 
@@ -1,4 +1,4 @@
-package main
+package pipeline
 
 import (
 	"fmt"
@@ -63,7 +63,7 @@ func renderAux(destDir string, gv globalView) error {
 		}{
 			Title:          "index",
 			Suites:         suites,
-			DebimanVersion: debimanVersion,
+			DebimanVersion: Version,
 		})
 	}); err != nil {
 		return err
@@ -79,7 +79,7 @@ func renderAux(destDir string, gv globalView) error {
 			HrefLangs      []*manpage.Meta
 		}{
 			Title:          "FAQ",
-			DebimanVersion: debimanVersion,
+			DebimanVersion: Version,
 		})
 	}); err != nil {
 		return err
@@ -95,7 +95,7 @@ func renderAux(destDir string, gv globalView) error {
 			HrefLangs      []*manpage.Meta
 		}{
 			Title:          "About",
-			DebimanVersion: debimanVersion,
+			DebimanVersion: Version,
 		})
 	}); err != nil {
 		return err
@@ -1,4 +1,4 @@
-package main
+package pipeline
 
 import (
 	"fmt"
@@ -19,7 +19,7 @@ func mustParseContentsTmpl() *template.Template {
 	return template.Must(template.Must(commonTmpls.Clone()).New("contents").Parse(bundled.Asset("contents.tmpl")))
 }
 
-func renderContents(dest, suite string, bins []string) error {
+func renderContents(servingDir, dest, suite string, bins []string) error {
 	sort.Strings(bins)
 
 	if err := write.Atomically(dest, true, func(w io.Writer) error {
@@ -34,7 +34,7 @@ func renderContents(dest, suite string, bins []string) error {
 			HrefLangs      []*manpage.Meta
 		}{
 			Title:          fmt.Sprintf("Contents of Debian %s", suite),
-			DebimanVersion: debimanVersion,
+			DebimanVersion: Version,
 			Breadcrumbs: breadcrumbs{
 				{fmt.Sprintf("/contents-%s.html", suite), suite},
 				{"", "Contents"},
@@ -46,7 +46,7 @@ func renderContents(dest, suite string, bins []string) error {
 		return err
 	}
 
-	destPath := filepath.Join(*servingDir, suite, "index.html.gz")
+	destPath := filepath.Join(servingDir, suite, "index.html.gz")
 	link := fmt.Sprintf("../contents-%s.html.gz", suite)
 	if err := os.Symlink(link, destPath); err != nil && !os.IsExist(err) {
 		return err
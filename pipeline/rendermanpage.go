@@ -1,4 +1,4 @@
-package main
+package pipeline
 
 import (
 	"bytes"
@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"html/template"
 	"io"
-	"log"
+	"log/slog"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -289,7 +289,7 @@ func rendermanpageprep(converter *convert.Process, job renderJob) (*template.Tem
 	if job.reuse != "" {
 		content, toc, renderErr = reuse(job.reuse)
 		if renderErr != nil {
-			log.Printf("WARNING: re-using %q failed: %v", job.reuse, renderErr)
+			slog.Warn("re-using rendered manpage failed", "stage", StageRender, "manpage", job.dest, "reuse", job.reuse, "err", renderErr)
 		}
 	}
 	if renderErr != nil {
@@ -321,7 +321,7 @@ func rendermanpageprep(converter *convert.Process, job renderJob) (*template.Tem
 		})
 	}
 
-	log.Printf("rendering %q", job.dest)
+	slog.Debug("rendering", "stage", StageRender, "manpage", job.dest)
 
 	suites := make([]*manpage.Meta, 0, len(job.versions))
 	for _, v := range job.versions {
@@ -442,7 +442,7 @@ func rendermanpageprep(converter *convert.Process, job renderJob) (*template.Tem
 
 	return t, manpagePrepData{
 		Title:          title,
-		DebimanVersion: debimanVersion,
+		DebimanVersion: Version,
 		Breadcrumbs: breadcrumbs{
 			{fmt.Sprintf("/contents-%s.html", meta.Package.Suite), meta.Package.Suite},
 			{fmt.Sprintf("/%s/%s/index.html", meta.Package.Suite, meta.Package.Binarypkg), meta.Package.Binarypkg},
@@ -470,18 +470,21 @@ func (c *countingWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-func rendermanpage(gzipw *gzip.Writer, converter *convert.Process, job renderJob) (uint64, error) {
+// rendermanpage renders job and returns the number of bytes written.
+// renderErr is non-nil if the manpage could not be converted, in which
+// case an error page was written.
+func rendermanpage(gzipw *gzip.Writer, converter *convert.Process, job renderJob) (written uint64, renderErr error, err error) {
 	t, data, err := rendermanpageprep(converter, job)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
-	var written countingWriter
-	if err := write.AtomicallyWithGz(job.dest, gzipw, func(w io.Writer) error {
-		return t.Execute(io.MultiWriter(w, &written), data)
+	var w countingWriter
+	if err := write.AtomicallyWithGz(job.dest, gzipw, func(f io.Writer) error {
+		return t.Execute(io.MultiWriter(f, &w), data)
 	}); err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
-	return uint64(written), nil
+	return uint64(w), data.Error, nil
 }
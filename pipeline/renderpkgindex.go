@@ -1,4 +1,4 @@
-package main
+package pipeline
 
 import (
 	"fmt"
@@ -48,7 +48,7 @@ func renderPkgindex(dest string, manpageByName map[string]*manpage.Meta) error {
 			HrefLangs      []*manpage.Meta
 		}{
 			Title:          fmt.Sprintf("Manpages of %s in Debian %s", first.Package.Binarypkg, first.Package.Suite),
-			DebimanVersion: debimanVersion,
+			DebimanVersion: Version,
 			Breadcrumbs: breadcrumbs{
 				{fmt.Sprintf("/contents-%s.html", first.Package.Suite), first.Package.Suite},
 				{fmt.Sprintf("/%s/%s/index.html", first.Package.Suite, first.Package.Binarypkg), first.Package.Binarypkg},
@@ -89,7 +89,7 @@ func renderSrcPkgindex(dest string, src string, manpageByName map[string]*manpag
 			Src            string
 		}{
 			Title:          fmt.Sprintf("Manpages of src:%s in Debian %s", src, first.Package.Suite),
-			DebimanVersion: debimanVersion,
+			DebimanVersion: Version,
 			Breadcrumbs: breadcrumbs{
 				{fmt.Sprintf("/contents-%s.html", first.Package.Suite), first.Package.Suite},
 				{fmt.Sprintf("/%s/src:%s/index.html", first.Package.Suite, src), "src:" + src},
@@ -0,0 +1,185 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Debian/debiman/internal/bundled"
+	"github.com/Debian/debiman/internal/commontmpl"
+	"github.com/Debian/debiman/internal/manpage"
+	"github.com/Debian/debiman/internal/write"
+)
+
+// maxSlowestPages is the number of slowest pages listed in the run
+// report.
+const maxSlowestPages = 25
+
+var statusTmpl = mustParseStatusTmpl()
+
+func mustParseStatusTmpl() *template.Template {
+	return template.Must(template.Must(commonTmpls.Clone()).New("status").Parse(bundled.Asset("status.tmpl")))
+}
+
+// renderedPage is a manpage listed in the run report.
+type renderedPage struct {
+	// URL is the path of the rendered page, e.g.
+	// “/jessie/i3-wm/i3.1.en.html”.
+	URL      string        `json:"url"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration_ns,omitempty"`
+}
+
+// renderDiagnostics collects the render failures and the slowest pages
+// of a run for the run report.
+type renderDiagnostics struct {
+	servingDir string
+
+	mu       sync.Mutex
+	failures []renderedPage
+	slowest  []renderedPage // sorted by descending Duration
+}
+
+// pageURL returns the URL path at which the rendered page dest (an
+// absolute .html.gz path underneath servingDir) is served.
+func (d *renderDiagnostics) pageURL(dest string) string {
+	rel, err := filepath.Rel(d.servingDir, dest)
+	if err != nil {
+		rel = dest
+	}
+	return commontmpl.BaseURLPath() + "/" + strings.TrimSuffix(filepath.ToSlash(rel), ".gz")
+}
+
+// failed records that dest was rendered as an error page.
+func (d *renderDiagnostics) failed(dest string, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.failures = append(d.failures, renderedPage{
+		URL:   d.pageURL(dest),
+		Error: err.Error(),
+	})
+}
+
+// rendered records that rendering dest took duration.
+func (d *renderDiagnostics) rendered(dest string, duration time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.slowest) == maxSlowestPages && d.slowest[len(d.slowest)-1].Duration >= duration {
+		return
+	}
+	idx := sort.Search(len(d.slowest), func(i int) bool {
+		return d.slowest[i].Duration < duration
+	})
+	d.slowest = append(d.slowest, renderedPage{})
+	copy(d.slowest[idx+1:], d.slowest[idx:])
+	d.slowest[idx] = renderedPage{URL: d.pageURL(dest), Duration: duration}
+	if len(d.slowest) > maxSlowestPages {
+		d.slowest = d.slowest[:maxSlowestPages]
+	}
+}
+
+type stageReport struct {
+	Stage     Stage         `json:"stage"`
+	Started   time.Time     `json:"started"`
+	Completed time.Time     `json:"completed"`
+	Duration  time.Duration `json:"duration_ns"`
+	Units     uint64        `json:"units"`
+
+	// Skipped is true if the stage’s inputs were unchanged, i.e. the
+	// timings refer to a previous run.
+	Skipped bool `json:"skipped"`
+}
+
+// runReport is written to status.json and status.html after every
+// successful run.
+type runReport struct {
+	DebimanVersion    string           `json:"debiman_version"`
+	Started           time.Time        `json:"started"`
+	LastSuccessfulRun time.Time        `json:"last_successful_run"`
+	Suites            []string         `json:"suites"`
+	Packages          int              `json:"packages"`
+	PackagesExtracted uint64           `json:"packages_extracted"`
+	PackagesDeleted   uint64           `json:"packages_deleted"`
+	ManpagesRendered  uint64           `json:"manpages_rendered"`
+	ExtractFailures   []packageFailure `json:"extract_failures"`
+	RenderFailures    []renderedPage   `json:"render_failures"`
+	SlowestPages      []renderedPage   `json:"slowest_pages"`
+	Stages            []stageReport    `json:"stages"`
+}
+
+func newRunReport(gv globalView, journal *runJournal, d *renderDiagnostics) runReport {
+	suites := make([]string, 0, len(gv.suites))
+	for suite := range gv.suites {
+		suites = append(suites, suite)
+	}
+	sort.Stable(bySuiteStr(suites))
+
+	d.mu.Lock()
+	failures := append([]renderedPage{}, d.failures...)
+	slowest := append([]renderedPage{}, d.slowest...)
+	d.mu.Unlock()
+	sort.Slice(failures, func(i, j int) bool { return failures[i].URL < failures[j].URL })
+
+	var stages []stageReport
+	for _, stage := range []Stage{StageDiscover, StageExtract, StageRender, StageIndex, StageAux} {
+		c, ok := journal.Stages[stage]
+		if !ok {
+			continue
+		}
+		stages = append(stages, stageReport{
+			Stage:     stage,
+			Started:   c.Started,
+			Completed: c.Completed,
+			Duration:  c.Completed.Sub(c.Started),
+			Units:     c.Units,
+			Skipped:   c.Started.Before(journal.Started),
+		})
+	}
+
+	return runReport{
+		DebimanVersion:    Version,
+		Started:           journal.Started,
+		LastSuccessfulRun: journal.Completed,
+		Suites:            suites,
+		Packages:          len(gv.pkgs),
+		PackagesExtracted: gv.stats.PackagesExtracted,
+		PackagesDeleted:   gv.stats.PackagesDeleted,
+		ManpagesRendered:  gv.stats.ManpagesRendered,
+		ExtractFailures:   journal.FailedPackages,
+		RenderFailures:    failures,
+		SlowestPages:      slowest,
+		Stages:            stages,
+	}
+}
+
+// writeRunReport renders status.json and status.html into destDir.
+func writeRunReport(destDir string, report runReport) error {
+	if err := write.Atomically(filepath.Join(destDir, "status.json.gz"), true, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(&report)
+	}); err != nil {
+		return err
+	}
+
+	return write.Atomically(filepath.Join(destDir, "status.html.gz"), true, func(w io.Writer) error {
+		return statusTmpl.Execute(w, struct {
+			Title          string
+			DebimanVersion string
+			Breadcrumbs    breadcrumbs
+			FooterExtra    string
+			Meta           *manpage.Meta
+			HrefLangs      []*manpage.Meta
+			Report         runReport
+		}{
+			Title:          "Status",
+			DebimanVersion: Version,
+			Report:         report,
+		})
+	})
+}
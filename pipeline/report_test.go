@@ -0,0 +1,102 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderDiagnostics(t *testing.T) {
+	d := &renderDiagnostics{servingDir: "/srv/man"}
+	for i := 0; i < 2*maxSlowestPages; i++ {
+		dest := filepath.Join(d.servingDir, fmt.Sprintf("jessie/pkg/page%d.1.en.html.gz", i))
+		d.rendered(dest, time.Duration(i)*time.Millisecond)
+	}
+	if got, want := len(d.slowest), maxSlowestPages; got != want {
+		t.Fatalf("unexpected number of slowest pages: got %d, want %d", got, want)
+	}
+	if got, want := d.slowest[0].URL, fmt.Sprintf("/jessie/pkg/page%d.1.en.html", 2*maxSlowestPages-1); got != want {
+		t.Fatalf("unexpected slowest page: got %q, want %q", got, want)
+	}
+	for i := 1; i < len(d.slowest); i++ {
+		if d.slowest[i-1].Duration < d.slowest[i].Duration {
+			t.Fatalf("slowest pages not sorted: %v", d.slowest)
+		}
+	}
+}
+
+func TestWriteRunReport(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "debiman-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	d := &renderDiagnostics{servingDir: "/srv/man"}
+	d.failed(filepath.Join(d.servingDir, "jessie/i3-wm/i3.1.en.html.gz"), fmt.Errorf("mandoc: syntax error"))
+	started := time.Now().Add(-time.Hour)
+	journal := &runJournal{
+		Started:   started,
+		Completed: time.Now(),
+		Stages: map[Stage]*stageCheckpoint{
+			StageDiscover: {Started: started, Completed: started.Add(time.Minute), Units: 2},
+			// completed by a previous run:
+			StageExtract: {Started: started.Add(-24 * time.Hour), Completed: started.Add(-23 * time.Hour)},
+		},
+	}
+	gv := globalView{
+		suites: map[string]bool{"jessie": true},
+		stats:  &Stats{ManpagesRendered: 1},
+	}
+	if err := writeRunReport(tmpdir, newRunReport(gv, journal, d)); err != nil {
+		t.Fatal(err)
+	}
+
+	readGz := func(fn string) []byte {
+		f, err := os.Open(filepath.Join(tmpdir, fn))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		r, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	var report runReport
+	if err := json.Unmarshal(readGz("status.json.gz"), &report); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(report.RenderFailures), 1; got != want {
+		t.Fatalf("unexpected number of render failures: got %d, want %d", got, want)
+	}
+	if got, want := len(report.Stages), 2; got != want {
+		t.Fatalf("unexpected number of stages: got %d, want %d", got, want)
+	}
+	if report.Stages[0].Skipped || !report.Stages[1].Skipped {
+		t.Fatalf("unexpected skipped stages: got %+v", report.Stages)
+	}
+
+	html := string(readGz("status.html.gz"))
+	for _, want := range []string{
+		`<a href="/jessie/i3-wm/i3.1.en.html">`,
+		`mandoc: syntax error`,
+		`Debian jessie`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("status.html does not contain %q", want)
+		}
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package pipeline
 
 import (
 	"compress/gzip"
@@ -54,7 +54,7 @@ baz
 		t.Fatal(err)
 	}
 
-	if _, err := rendermanpage(gzipw, converter, renderJob{
+	if _, _, err := rendermanpage(gzipw, converter, renderJob{
 		dest:     f.Name(),
 		src:      f.Name(),
 		meta:     meta,
@@ -0,0 +1,108 @@
+package pipeline
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+
+	"pault.ag/go/archive"
+)
+
+// suiteConfig holds the settings which apply to one suite.
+type suiteConfig struct {
+	remoteMirrors  []string
+	localMirror    string
+	keyring        string
+	components     []string
+	forceRerender  bool
+	forceReextract bool
+
+	// ar downloads from the suite’s mirror.
+	ar *archive.Downloader
+}
+
+// suiteConfig returns the settings for the distribution named dist,
+// i.e. o, overridden by o.Suites[dist]. ar is used if the suite uses
+// the default mirror and keyring.
+func (o *Options) suiteConfig(dist string, ar *archive.Downloader) (suiteConfig, error) {
+	cfg := suiteConfig{
+		remoteMirrors:  o.RemoteMirrors,
+		localMirror:    o.LocalMirror,
+		keyring:        o.Keyring,
+		components:     o.Components,
+		forceRerender:  o.ForceRerender,
+		forceReextract: o.ForceReextract,
+		ar:             ar,
+	}
+	override, ok := o.Suites[dist]
+	if !ok {
+		return cfg, nil
+	}
+	if override.RemoteMirrors != nil {
+		cfg.remoteMirrors = override.RemoteMirrors
+	}
+	if override.LocalMirror != nil {
+		cfg.localMirror = *override.LocalMirror
+	}
+	if override.Keyring != nil {
+		cfg.keyring = *override.Keyring
+	}
+	if override.Components != nil {
+		cfg.components = override.Components
+	}
+	if override.ForceRerender != nil {
+		cfg.forceRerender = *override.ForceRerender
+	}
+	if override.ForceReextract != nil {
+		cfg.forceReextract = *override.ForceReextract
+	}
+	if strings.Join(cfg.remoteMirrors, ",") != strings.Join(o.RemoteMirrors, ",") ||
+		cfg.localMirror != o.LocalMirror ||
+		cfg.keyring != o.Keyring {
+		var err error
+		cfg.ar, err = o.newDownloader(ar.Parallel, cfg.remoteMirrors, cfg.localMirror, cfg.keyring)
+		if err != nil {
+			return cfg, fmt.Errorf("suite %s: %v", dist, err)
+		}
+	}
+	return cfg, nil
+}
+
+// newDownloader returns an archive.Downloader for the specified
+// mirrors (see mirrorTransport.register) which verifies signatures using
+// keyringPath (if non-empty).
+func (o *Options) newDownloader(parallel int, remoteMirrors []string, localMirror, keyringPath string) (*archive.Downloader, error) {
+	ar := &archive.Downloader{
+		Parallel:            parallel,
+		MaxTransientRetries: 3,
+		LocalMirror:         localMirror,
+	}
+	if localMirror == "" {
+		if len(remoteMirrors) == 0 {
+			return nil, fmt.Errorf("no remote mirror specified")
+		}
+		bases := make([]string, len(remoteMirrors))
+		for idx, m := range remoteMirrors {
+			bases[idx] = m + "/debian"
+		}
+		var err error
+		ar.Mirror, err = o.mirrors.register(bases, o.MirrorRateLimit, o.Metrics)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if keyringPath != "" {
+		f, err := os.Open(keyringPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading keyring: %v", err)
+		}
+		defer f.Close()
+		ar.Keyring, err = openpgp.ReadKeyRing(f)
+		if err != nil {
+			return nil, fmt.Errorf("ReadKeyRing(%s): %v", keyringPath, err)
+		}
+	}
+	return ar, nil
+}
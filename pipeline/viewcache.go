@@ -0,0 +1,167 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Debian/debiman/internal/manpage"
+	"github.com/Debian/debiman/internal/write"
+
+	"pault.ag/go/debian/version"
+)
+
+// suiteCacheFormat must be incremented whenever the semantics of the
+// cached data change, e.g. when Contents parsing is modified.
+const suiteCacheFormat = "2"
+
+type cachedContent struct {
+	Arch      string
+	Binarypkg string
+	Filename  string
+}
+
+type cachedPkg struct {
+	Source    string
+	Binarypkg string
+	Arch      string
+	Component string
+	Filename  string
+	Version   string
+	SHA256    []byte
+	Bytes     int64
+	Replaces  []string
+	Depends   []string
+}
+
+// suiteCache is the parsed result of a suite’s Contents and Packages
+// files, stored as gzip-compressed gob in -state_dir.
+type suiteCache struct {
+	// Key identifies the inputs from which the cache was built, see
+	// suiteCacheKey.
+	Key     string
+	Content []cachedContent
+	Pkgs    []cachedPkg
+}
+
+// suiteCacheKey returns the key under which the parsed Contents and
+// Packages files of a suite are cached. The Packages parsing result
+// depends on the alternatives data (see buildContainsMains).
+func suiteCacheKey(releaseHash, alternatives string) string {
+	return fingerprint(suiteCacheFormat, releaseHash, alternatives)
+}
+
+func suiteCachePath(stateDir, suite string) string {
+	return filepath.Join(stateDir, "cache", suite+".gob.gz")
+}
+
+// loadSuiteCache returns the cached content and package entries for
+// suite, or ok == false if there is no cache for key.
+func loadSuiteCache(path, suite, key string) (content []*contentEntry, pkgs []*pkgEntry, ok bool, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, false, nil
+		}
+		return nil, nil, false, err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer r.Close()
+	var c suiteCache
+	if err := gob.NewDecoder(r).Decode(&c); err != nil {
+		return nil, nil, false, err
+	}
+	if c.Key != key {
+		return nil, nil, false, nil
+	}
+
+	content = make([]*contentEntry, len(c.Content))
+	for idx, e := range c.Content {
+		content[idx] = &contentEntry{
+			suite:     suite,
+			arch:      e.Arch,
+			binarypkg: e.Binarypkg,
+			filename:  e.Filename,
+		}
+	}
+	pkgs = make([]*pkgEntry, len(c.Pkgs))
+	for idx, e := range c.Pkgs {
+		v, err := version.Parse(e.Version)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		pkgs[idx] = &pkgEntry{
+			source:    e.Source,
+			suite:     suite,
+			binarypkg: e.Binarypkg,
+			arch:      e.Arch,
+			component: e.Component,
+			filename:  e.Filename,
+			version:   v,
+			sha256:    e.SHA256,
+			bytes:     e.Bytes,
+			replaces:  e.Replaces,
+			depends:   e.Depends,
+		}
+	}
+	return content, pkgs, true, nil
+}
+
+func writeSuiteCache(path, key string, content []*contentEntry, pkgs []*pkgEntry) error {
+	c := suiteCache{
+		Key:     key,
+		Content: make([]cachedContent, len(content)),
+		Pkgs:    make([]cachedPkg, len(pkgs)),
+	}
+	for idx, e := range content {
+		c.Content[idx] = cachedContent{
+			Arch:      e.arch,
+			Binarypkg: e.binarypkg,
+			Filename:  e.filename,
+		}
+	}
+	for idx, p := range pkgs {
+		c.Pkgs[idx] = cachedPkg{
+			Source:    p.source,
+			Binarypkg: p.binarypkg,
+			Arch:      p.arch,
+			Component: p.component,
+			Filename:  p.filename,
+			Version:   p.version.String(),
+			SHA256:    p.sha256,
+			Bytes:     p.bytes,
+			Replaces:  p.replaces,
+			Depends:   p.depends,
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return write.Atomically(path, true, func(w io.Writer) error {
+		return gob.NewEncoder(w).Encode(&c)
+	})
+}
+
+// latestVersions returns the manpage.PkgMeta for each suite/binarypkg
+// key, like getAllPackages does.
+func latestVersions(pkgs []*pkgEntry) map[string]*manpage.PkgMeta {
+	latestVersion := make(map[string]*manpage.PkgMeta, len(pkgs))
+	for _, p := range pkgs {
+		latestVersion[p.suite+"/"+p.binarypkg] = &manpage.PkgMeta{
+			Replaces:  p.replaces,
+			Component: p.component,
+			Filename:  p.filename,
+			Sourcepkg: p.source,
+			Binarypkg: p.binarypkg,
+			Suite:     p.suite,
+			Version:   p.version,
+		}
+	}
+	return latestVersion
+}
@@ -0,0 +1,65 @@
+package pipeline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"pault.ag/go/debian/version"
+)
+
+func TestSuiteCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debiman-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cache", "testing.gob.gz")
+
+	v, err := version.Parse("4.13-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []*contentEntry{
+		{suite: "testing", arch: "amd64", binarypkg: "i3-wm", filename: "man1/i3.1.gz"},
+	}
+	pkgs := []*pkgEntry{
+		{
+			source:    "i3-wm",
+			suite:     "testing",
+			binarypkg: "i3-wm",
+			arch:      "amd64",
+			component: "main",
+			filename:  "pool/main/i/i3-wm/i3-wm_4.13-1_amd64.deb",
+			version:   v,
+			sha256:    []byte{0xde, 0xad, 0xbe, 0xef},
+			bytes:     1234,
+		},
+	}
+	if err := writeSuiteCache(path, "key", content, pkgs); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, ok, err := loadSuiteCache(path, "testing", "otherkey"); err != nil || ok {
+		t.Fatalf("loadSuiteCache(otherkey) = ok %v, err %v, want a cache miss", ok, err)
+	}
+
+	gotContent, gotPkgs, ok, err := loadSuiteCache(path, "testing", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("loadSuiteCache(key) unexpectedly missed")
+	}
+	if !reflect.DeepEqual(gotContent, content) {
+		t.Fatalf("unexpected content entries: got %+v, want %+v", gotContent[0], content[0])
+	}
+	if !reflect.DeepEqual(gotPkgs, pkgs) {
+		t.Fatalf("unexpected package entries: got %+v, want %+v", gotPkgs[0], pkgs[0])
+	}
+	if got, want := latestVersions(gotPkgs)["testing/i3-wm"].Component, "main"; got != want {
+		t.Fatalf("unexpected component: got %q, want %q", got, want)
+	}
+}
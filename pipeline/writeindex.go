@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"io"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Debian/debiman/internal/redirect"
+	"github.com/Debian/debiman/internal/write"
+)
+
+// writeIndex serializes an index for the redirect package (used in
+// debiman-auxserver) to dest.
+func writeIndex(dest string, gv globalView) error {
+	idx := redirect.Index{
+		Langs:    make(map[string]bool),
+		Sections: make(map[string]bool),
+		Suites:   gv.idxSuites,
+		Meta: redirect.IndexMeta{
+			GeneratorVersion: Version,
+			Created:          time.Now(),
+		},
+	}
+
+	entries := make(map[string][]redirect.IndexEntry, len(gv.xref))
+	for _, x := range gv.xref {
+		for _, m := range x {
+			name := strings.ToLower(m.Name)
+			entries[name] = append(entries[name], redirect.IndexEntry{
+				Name:      m.Name,
+				Suite:     m.Package.Suite,
+				Binarypkg: m.Package.Binarypkg,
+				Section:   m.Section,
+				Language:  m.Language,
+			})
+			idx.Langs[m.Language] = true
+			idx.Sections[m.Section] = true
+			idx.Sections[m.MainSection()] = true
+		}
+	}
+
+	return write.Atomically(dest, false, func(w io.Writer) error {
+		var cw countingWriter
+		if err := redirect.WriteIndexEntries(io.MultiWriter(w, &cw), idx, entries); err != nil {
+			return err
+		}
+		atomic.AddUint64(&gv.stats.IndexBytes, uint64(cw))
+		return nil
+	})
+}